@@ -0,0 +1,105 @@
+package block
+
+import (
+	"bytes"
+	"encoding/json"
+	"strconv"
+	"time"
+
+	"github.com/spf13/cobra"
+	"github.com/trufnetwork/kwil-db/app/rpc"
+	"github.com/trufnetwork/kwil-db/app/shared/display"
+	types "github.com/trufnetwork/kwil-db/core/types/admin"
+)
+
+func profileCmd() *cobra.Command {
+	var limit int
+	var namespace string
+
+	cmd := &cobra.Command{
+		Use:   "profile",
+		Short: "Get the slowest actions and statements executed in blocks.",
+		Long: `Get the actions and statements that took the most total time to execute in blocks since the node started.
+
+The node must be started with the exec_profile setting enabled. The time and rows of an action or
+statement include those of the statements and actions it runs.`,
+		Example: `# The 10 slowest actions and statements
+kwild block profile
+
+# The 5 slowest actions and statements of the namespace 'users'
+kwild block profile --limit 5 --namespace users`,
+		Args: cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			ctx := cmd.Context()
+			clt, err := rpc.AdminSvcClient(ctx, cmd)
+			if err != nil {
+				return display.PrintErr(cmd, err)
+			}
+
+			profile, err := clt.ExecProfile(ctx, limit, namespace)
+			if err != nil {
+				return display.PrintErr(cmd, err)
+			}
+
+			return display.PrintCmd(cmd, &respExecProfile{Profile: profile, cmd: cmd})
+		},
+	}
+
+	cmd.Flags().IntVarP(&limit, "limit", "l", 10, "number of actions and statements to list")
+	cmd.Flags().StringVarP(&namespace, "namespace", "n", "", "only list the actions of this namespace")
+	display.BindTableFlags(cmd)
+
+	return cmd
+}
+
+type respExecProfile struct {
+	Profile *types.ExecProfile
+	cmd     *cobra.Command
+}
+
+func (r *respExecProfile) MarshalJSON() ([]byte, error) {
+	return json.MarshalIndent(r.Profile, "", "  ")
+}
+
+func (r *respExecProfile) MarshalText() ([]byte, error) {
+	var msg bytes.Buffer
+
+	msg.WriteString("Actions:\n")
+	var rows [][]string
+	for _, e := range r.Profile.Actions {
+		rows = append(rows, append([]string{e.Namespace, e.Action}, profileStats(e)...))
+	}
+	tbl, err := display.FormatTable(r.cmd, []string{"namespace", "action", "calls", "total", "mean", "max", "rows"}, rows)
+	if err != nil {
+		return nil, err
+	}
+	msg.Write(tbl)
+
+	msg.WriteString("\nStatements:\n")
+	rows = nil
+	for _, e := range r.Profile.Statements {
+		rows = append(rows, append([]string{e.Namespace, e.Action, strconv.Itoa(e.Line), e.Statement}, profileStats(e)...))
+	}
+	tbl, err = display.FormatTable(r.cmd, []string{"namespace", "action", "line", "statement", "calls", "total", "mean", "max", "rows"}, rows)
+	if err != nil {
+		return nil, err
+	}
+	msg.Write(tbl)
+
+	return msg.Bytes(), nil
+}
+
+// profileStats formats the calls, times and rows of a profile entry.
+func profileStats(e *types.ExecProfileEntry) []string {
+	var mean time.Duration
+	if e.Calls > 0 {
+		mean = e.TotalTime / time.Duration(e.Calls)
+	}
+	return []string{
+		strconv.FormatInt(e.Calls, 10),
+		e.TotalTime.String(),
+		mean.String(),
+		e.MaxTime.String(),
+		strconv.FormatInt(e.Rows, 10),
+	}
+}
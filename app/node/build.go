@@ -1,8 +1,10 @@
 package node
 
 import (
+	"bytes"
 	"compress/gzip"
 	"context"
+	"crypto/sha256"
 	"crypto/tls"
 	"crypto/x509"
 	"errors"
@@ -28,6 +30,7 @@ import (
 	"github.com/trufnetwork/kwil-db/node/engine/interpreter"
 	_ "github.com/trufnetwork/kwil-db/node/exts/erc20-bridge/erc20"
 	"github.com/trufnetwork/kwil-db/node/exts/erc20-bridge/signersvc"
+	"github.com/trufnetwork/kwil-db/node/exts/wasm"
 	"github.com/trufnetwork/kwil-db/node/listeners"
 	"github.com/trufnetwork/kwil-db/node/mempool"
 	"github.com/trufnetwork/kwil-db/node/meta"
@@ -84,9 +87,27 @@ func buildServer(ctx context.Context, d *coreDependencies) *server {
 	e := buildEngine(d, ctx, db, accounts, vs, d.namespaceManager)
 	d.namespaceManager.Ready()
 
+	var adminOpts []adminsvc.Opt
+	if d.cfg.ExecProfile {
+		profile := interpreter.NewProfile()
+		e.SetProfile(profile)
+		adminOpts = append(adminOpts, adminsvc.WithExecProfile(profile))
+	}
+
 	// Mempool
 	txSz := min(d.cfg.Mempool.MaxTxBytes, d.genesisCfg.MaxBlockSize) // txSz shouldn't exceed MaxBlockSize
 	mp := mempool.New(d.cfg.Mempool.MaxSize, txSz)
+	mp.SetSenderLimits(d.cfg.Mempool.MaxSenderTxs, d.cfg.Mempool.MaxSenderBytes)
+	mp.SetMaxFutureAge(time.Duration(d.cfg.Mempool.MaxFutureAge))
+	if d.cfg.Mempool.Journal {
+		journalFile := config.MempoolJournalFilePath(d.rootDir)
+		loaded, err := mp.LoadJournal(journalFile, time.Duration(d.cfg.Mempool.JournalMaxAge), d.logger.New("MEMPOOL"))
+		if err != nil {
+			failBuild(err, "failed to load mempool journal")
+		}
+		closers.addCloser(mp.CloseJournal, "Closing mempool journal")
+		d.logger.Info("Loaded mempool transactions from journal", "count", loaded, "file", journalFile)
+	}
 
 	// TxAPP
 	txApp := buildTxApp(ctx, d, db, accounts, vs, e)
@@ -146,7 +167,7 @@ func buildServer(ctx context.Context, d *coreDependencies) *server {
 		// account information (nonce and balance).
 		txSigner := auth.GetNodeSigner(d.privKey)
 		jsonAdminSvc := adminsvc.NewService(db, node, bp, vs, node.Whitelister(),
-			txSigner, d.cfg, d.genesisCfg.ChainID, adminServerLogger)
+			txSigner, d.cfg, d.genesisCfg.ChainID, adminServerLogger, adminOpts...)
 		jsonRPCAdminServer = buildJRPCAdminServer(d)
 		jsonRPCAdminServer.RegisterSvc(jsonAdminSvc)
 		jsonRPCAdminServer.RegisterSvc(jsonRPCTxSvc)
@@ -586,6 +607,8 @@ func failBuild(err error, msg string) {
 }
 
 func buildEngine(d *coreDependencies, ctx context.Context, db *pg.DB, accounts common.Accounts, validators common.Validators, namespaceManager engine.NamespaceRegister) *interpreter.ThreadSafeInterpreter {
+	registerWasmPrecompiles(d, ctx)
+
 	extensions := precompiles.RegisteredPrecompiles()
 	for name := range extensions {
 		d.logger.Info("registered extension", "name", name)
@@ -610,6 +633,39 @@ func buildEngine(d *coreDependencies, ctx context.Context, db *pg.DB, accounts c
 	return interp
 }
 
+// registerWasmPrecompiles loads the configured WebAssembly precompiles. Every
+// module must match the hash pinned in genesis, and every pinned module must
+// be configured, so that all nodes run the same code.
+func registerWasmPrecompiles(d *coreDependencies, ctx context.Context) {
+	for name := range d.genesisCfg.WasmPrecompiles {
+		if _, ok := d.cfg.WasmPrecompiles[name]; !ok {
+			failBuild(nil, fmt.Sprintf("wasm precompile %s is pinned in genesis, but is not configured", name))
+		}
+	}
+
+	for name, path := range d.cfg.WasmPrecompiles {
+		pinned, ok := d.genesisCfg.WasmPrecompiles[name]
+		if !ok {
+			failBuild(nil, fmt.Sprintf("wasm precompile %s is not pinned in genesis", name))
+		}
+
+		code, err := os.ReadFile(rootedPath(path, d.rootDir))
+		if err != nil {
+			failBuild(err, "failed to read wasm precompile "+name)
+		}
+
+		hash := sha256.Sum256(code)
+		if !bytes.Equal(hash[:], pinned.Hash) {
+			failBuild(nil, fmt.Sprintf("wasm precompile %s has hash %x, but genesis pins %s", name, hash, pinned.Hash))
+		}
+
+		err = wasm.Register(ctx, name, code, pinned.GasLimit)
+		if err != nil {
+			failBuild(err, "failed to load wasm precompile "+name)
+		}
+	}
+}
+
 func buildSnapshotStore(d *coreDependencies, bs *store.BlockStore) *snapshotter.SnapshotStore {
 	snapshotDir := config.LocalSnapshotsDir(d.rootDir)
 	cfg := &snapshotter.SnapshotConfig{
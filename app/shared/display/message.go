@@ -187,5 +187,19 @@ Height: %d`,
 		msg += "\n  " + strings.ReplaceAll(r.Result.Log, "\n", "\n  ")
 	}
 
+	if r.Result != nil && len(r.Result.Operations) > 0 {
+		msg += "\nOperations:"
+		for i, op := range r.Result.Operations {
+			status := "success"
+			if op.Code != uint32(types.CodeOk) {
+				status = fmt.Sprintf("failed (code %d)", op.Code)
+			}
+			msg += fmt.Sprintf("\n  %d: %s", i, status)
+			if op.Log != "" {
+				msg += "\n    " + strings.ReplaceAll(op.Log, "\n", "\n    ")
+			}
+		}
+	}
+
 	return msg
 }
@@ -279,6 +279,24 @@ func TestRespTxQuery_MarshalText(t *testing.T) {
 			},
 			expected: "Transaction ID: 0300000000000000000000000000000000000000000000000000000000000000\nStatus: pending\nHeight: -1\nLogs:\n  transaction pending",
 		},
+		{
+			name: "multi-operation failed status",
+			input: &RespTxQuery{
+				Msg: &types.TxQueryResponse{
+					Hash:   types.Hash{0x4},
+					Height: 60,
+					Result: &types.TxResult{
+						Code: uint32(types.CodeInsufficientBalance),
+						Log:  "ERROR: operation 1: insufficient funds",
+						Operations: []types.OperationResult{
+							{Code: uint32(types.CodeOk), Log: "registered"},
+							{Code: uint32(types.CodeInsufficientBalance), Log: "insufficient funds"},
+						},
+					},
+				},
+			},
+			expected: "Transaction ID: 0400000000000000000000000000000000000000000000000000000000000000\nStatus: failed\nHeight: 60\nLogs:\n  ERROR: operation 1: insufficient funds\nOperations:\n  0: success\n    registered\n  1: failed (code 6)\n    insufficient funds",
+		},
 		{
 			name: "pending status",
 			input: &RespTxQuery{
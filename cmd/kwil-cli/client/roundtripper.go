@@ -2,9 +2,11 @@ package client
 
 import (
 	"context"
+	"encoding/hex"
 	"errors"
 	"fmt"
 	"net/http"
+	"strings"
 
 	"github.com/manifoldco/promptui"
 	"github.com/spf13/cobra"
@@ -53,8 +55,24 @@ func DialClient(ctx context.Context, cmd *cobra.Command, flags uint8, fn RoundTr
 	needPrivateKey := flags&WithoutPrivateKey == 0
 	authCalls := flags&AuthenticatedCalls != 0
 
+	signer, err := multisigFlags(cmd)
+	if err != nil {
+		return err
+	}
+
 	clientConfig := clientType.DefaultOptions()
-	if conf.PrivateKey != nil {
+	if signer != nil {
+		// transactions are authored for the multisig account, and signed by
+		// the private key if it is of a member
+		if conf.PrivateKey != nil {
+			member := &auth.EthPersonalSigner{Key: *conf.PrivateKey}
+			if signer.Multisig.MemberIndex(member.AuthType(), member.CompactID()) >= 0 {
+				signer.Signers = append(signer.Signers, member)
+			}
+		}
+		clientConfig.Signer = signer
+		clientConfig.ChainID = conf.ChainID
+	} else if conf.PrivateKey != nil {
 		clientConfig.Signer = &auth.EthPersonalSigner{Key: *conf.PrivateKey}
 		if needPrivateKey { // only check chain ID if signing something
 			clientConfig.ChainID = conf.ChainID
@@ -151,6 +169,38 @@ func DialClient(ctx context.Context, cmd *cobra.Command, flags uint8, fn RoundTr
 	return nil
 }
 
+// multisigFlags returns a signer, without member signers, for the multisig
+// account of the --multisig and --multisig-id flags, if the command has the
+// flags and they are set.
+func multisigFlags(cmd *cobra.Command) (*auth.MultisigSigner, error) {
+	flag := cmd.Flags().Lookup("multisig")
+	if flag == nil || flag.Value.String() == "" {
+		return nil, nil
+	}
+
+	def, err := hex.DecodeString(strings.TrimPrefix(flag.Value.String(), "0x"))
+	if err != nil {
+		return nil, fmt.Errorf("failed to decode multisig definition: %w", err)
+	}
+	multisig, err := auth.UnmarshalMultisig(def)
+	if err != nil {
+		return nil, err
+	}
+	signer := &auth.MultisigSigner{Multisig: multisig}
+
+	if idFlag := cmd.Flags().Lookup("multisig-id"); idFlag != nil && idFlag.Value.String() != "" {
+		signer.ID, err = hex.DecodeString(strings.TrimPrefix(idFlag.Value.String(), "0x"))
+		if err != nil {
+			return nil, fmt.Errorf("failed to decode multisig account ID: %w", err)
+		}
+		if len(signer.ID) != auth.MultisigIDSize {
+			return nil, fmt.Errorf("multisig account ID must be %d bytes", auth.MultisigIDSize)
+		}
+	}
+
+	return signer, nil
+}
+
 // promptMessage prompts the user to sign a message. Return an error if user
 // declines to sign.
 func promptMessage(msg string) error {
@@ -37,13 +37,16 @@ var idCmd = &cobra.Command{
 var (
 	nonceOverride int64
 	syncBcast     bool
+	validAfter    int64
+	validUntil    int64
+	multisig      string
 )
 
 func NewCmdAccount() *cobra.Command {
 	var cmd = &cobra.Command{
 		Use:   "account",
 		Short: "Account related commands.",
-		Long:  "Commands related to Kwil account, such as balance checks and transfers.",
+		Long:  "Commands related to Kwil account, such as balance checks, transfers, and session keys.",
 	}
 
 	trCmd := transferCmd() // gets the nonce override flag
@@ -52,10 +55,16 @@ func NewCmdAccount() *cobra.Command {
 		idCmd,
 		balanceCmd(),
 		trCmd,
+		multisigCmd(),
+		sessionCmd(),
 	)
 
 	trCmd.Flags().Int64VarP(&nonceOverride, "nonce", "N", -1, "nonce override (-1 means request from server)")
 	trCmd.Flags().BoolVar(&syncBcast, "sync", false, "synchronous broadcast (wait for it to be included in a block)")
+	trCmd.Flags().Int64Var(&validAfter, "valid-after", 0, "height that a block must be above to include the transaction (0 means no limit)")
+	trCmd.Flags().Int64Var(&validUntil, "valid-until", 0, "highest block that may include the transaction, after which it expires (0 means no limit)")
+	trCmd.Flags().StringVar(&multisig, "multisig", "", "hex definition of the multisig account to author the transaction for, which is printed for the members to sign instead of broadcast")
+	trCmd.Flags().String("multisig-id", "", "hex ID of the multisig account, if it changed its definition since it was registered")
 
 	return cmd
 }
@@ -0,0 +1,376 @@
+package account
+
+import (
+	"bytes"
+	"context"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"strings"
+
+	"github.com/spf13/cobra"
+
+	"github.com/trufnetwork/kwil-db/app/shared/display"
+	"github.com/trufnetwork/kwil-db/cmd/kwil-cli/client"
+	"github.com/trufnetwork/kwil-db/cmd/kwil-cli/cmds/common"
+	"github.com/trufnetwork/kwil-db/cmd/kwil-cli/config"
+	clientType "github.com/trufnetwork/kwil-db/core/client/types"
+	"github.com/trufnetwork/kwil-db/core/crypto/auth"
+	rpcclient "github.com/trufnetwork/kwil-db/core/rpc/client"
+	"github.com/trufnetwork/kwil-db/core/rpc/client/user"
+	"github.com/trufnetwork/kwil-db/core/types"
+)
+
+func multisigCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "multisig",
+		Short: "Multisignature account commands.",
+		Long: `Commands to define and register multisignature accounts, and to collect the signatures of their
+transactions.
+
+A multisig account authorizes transactions signed by at least a threshold of its members. The
+account is defined by its members and threshold, and it is registered with this definition by any
+account with 'account multisig register'. The account ID is the hash of the definition it was
+registered with, and it is the @caller of the account's transactions and the account to transfer
+to. The account may replace its definition with 'account multisig update', and it keeps its ID.
+
+A transaction of a multisig account is authored with the --multisig flag of a command such as
+'exec-action' or 'account transfer', set to the current definition, which prints the transaction
+instead of broadcasting it. If the account changed its definition, the --multisig-id flag must also
+be set to its ID. The members then sign it with 'account multisig sign', either one after another
+or separately, in which case the signed copies are combined with 'account multisig combine'. Once
+signed by enough members, it is broadcast with 'account multisig broadcast'.`,
+	}
+
+	cmd.AddCommand(
+		multisigCreateCmd(),
+		multisigRegisterCmd(),
+		multisigUpdateCmd(),
+		multisigSignCmd(),
+		multisigCombineCmd(),
+		multisigBroadcastCmd(),
+	)
+
+	return cmd
+}
+
+func multisigCreateCmd() *cobra.Command {
+	var threshold uint8
+	var memberStrs []string
+
+	cmd := &cobra.Command{
+		Use:   "create",
+		Short: "Define a multisig account.",
+		Long: `Define a multisig account from the members that may sign for it and the number of them that must.
+
+Each member is given as <auth type>:<hex ID>, the auth type and compact ID of its signer. For an
+Ethereum wallet, these are 'secp256k1_ep' and its address. The printed definition is registered
+with 'account multisig register', and it is the --multisig flag of the commands that author
+transactions. The printed ID is the ID that the account will have once it is registered.`,
+		Example: `# An account of three Ethereum wallets, of which two must sign
+kwil-cli account multisig create --threshold 2 \
+  --member secp256k1_ep:0xc89D42189f0450C2b2c3c61f58Ec5d628176A1E7 \
+  --member secp256k1_ep:0x7C4239345790560b00bcA7bF5bC7c6BC3C34a4D5 \
+  --member secp256k1_ep:0x6ACF3E1F0d25bB2FBbD57d9bC0E1CF2e3E1bE2b4`,
+		Args: cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			members := make([]*auth.MultisigMember, len(memberStrs))
+			for i, str := range memberStrs {
+				authType, idStr, ok := strings.Cut(str, ":")
+				if !ok {
+					return display.PrintErr(cmd, fmt.Errorf("member %q is not <auth type>:<hex ID>", str))
+				}
+				id, err := hex.DecodeString(strings.TrimPrefix(idStr, "0x"))
+				if err != nil {
+					return display.PrintErr(cmd, fmt.Errorf("failed to decode member ID: %w", err))
+				}
+				members[i] = &auth.MultisigMember{AuthType: authType, ID: id}
+			}
+
+			multisig, err := auth.NewMultisig(threshold, members)
+			if err != nil {
+				return display.PrintErr(cmd, err)
+			}
+
+			return display.PrintCmd(cmd, &respMultisig{
+				Definition: hex.EncodeToString(multisig.Bytes()),
+				ID:         hex.EncodeToString(multisig.ID()),
+				Threshold:  threshold,
+				Members:    memberStrs,
+			})
+		},
+	}
+
+	cmd.Flags().Uint8VarP(&threshold, "threshold", "t", 1, "number of members that must sign a transaction")
+	cmd.Flags().StringArrayVarP(&memberStrs, "member", "m", nil, "member that may sign, as <auth type>:<hex ID>")
+
+	return cmd
+}
+
+type respMultisig struct {
+	Definition string   `json:"definition"`
+	ID         string   `json:"id"`
+	Threshold  uint8    `json:"threshold"`
+	Members    []string `json:"members"`
+}
+
+func (r *respMultisig) MarshalJSON() ([]byte, error) {
+	type res respMultisig // prevent recursion
+	return json.Marshal((*res)(r))
+}
+
+func (r *respMultisig) MarshalText() ([]byte, error) {
+	return fmt.Appendf(nil, "Definition: %s\nID: %s\nThreshold: %d of %d members\n",
+		r.Definition, r.ID, r.Threshold, len(r.Members)), nil
+}
+
+// decodeMultisigDefinition decodes a hex multisig account definition.
+func decodeMultisigDefinition(defHex string) ([]byte, error) {
+	def, err := hex.DecodeString(strings.TrimPrefix(strings.TrimSpace(defHex), "0x"))
+	if err != nil {
+		return nil, fmt.Errorf("failed to decode multisig definition: %w", err)
+	}
+	if _, err := auth.UnmarshalMultisig(def); err != nil {
+		return nil, err
+	}
+	return def, nil
+}
+
+func multisigRegisterCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "register <hex definition>",
+		Short: "Register a multisig account.",
+		Long: `Register a multisig account with the definition printed by 'account multisig create'.
+
+The transaction is sent by the account of the configured private key, which need not be a member.
+The registered account's ID is the printed ID of the definition.`,
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			txFlags, err := common.GetTxFlags(cmd)
+			if err != nil {
+				return display.PrintErr(cmd, err)
+			}
+
+			def, err := decodeMultisigDefinition(args[0])
+			if err != nil {
+				return display.PrintErr(cmd, err)
+			}
+
+			return client.DialClient(cmd.Context(), cmd, 0, func(ctx context.Context, cl clientType.Client, conf *config.KwilCliConfig) error {
+				txHash, err := cl.RegisterMultisig(ctx, def, txFlags.Opts()...)
+				if err != nil {
+					return display.PrintErr(cmd, fmt.Errorf("register multisig failed: %w", err))
+				}
+
+				return txFlags.DisplayTxResult(ctx, cl, txHash, cmd)
+			})
+		},
+	}
+
+	common.BindTxFlags(cmd)
+
+	return cmd
+}
+
+func multisigUpdateCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "update <hex new definition>",
+		Short: "Replace the definition of a multisig account.",
+		Long: `Replace the members and threshold of a multisig account with a definition printed by
+'account multisig create'. The account keeps its ID.
+
+The transaction is authored for the account given by the --multisig flag, and by --multisig-id if
+the account changed its definition before, and it is printed for the members to sign. Once it is
+executed, the transactions of the account are authored with --multisig set to the new definition
+and --multisig-id set to the account ID.`,
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			txFlags, err := common.GetTxFlags(cmd)
+			if err != nil {
+				return display.PrintErr(cmd, err)
+			}
+			if !txFlags.Multisig {
+				return display.PrintErr(cmd, errors.New("the --multisig flag must be set to the current definition of the account"))
+			}
+
+			def, err := decodeMultisigDefinition(args[0])
+			if err != nil {
+				return display.PrintErr(cmd, err)
+			}
+
+			return client.DialClient(cmd.Context(), cmd, 0, func(ctx context.Context, cl clientType.Client, conf *config.KwilCliConfig) error {
+				txHash, err := cl.UpdateMultisig(ctx, def, txFlags.Opts()...)
+				if err != nil {
+					return display.PrintErr(cmd, fmt.Errorf("update multisig failed: %w", err))
+				}
+
+				return txFlags.DisplayTxResult(ctx, cl, txHash, cmd)
+			})
+		},
+	}
+
+	common.BindTxFlags(cmd)
+
+	return cmd
+}
+
+// decodeMultisigTx decodes a hex transaction of a multisig account, and its
+// signature, which has the definition the transaction is signed with, and no
+// partial signatures if no member signed it yet.
+func decodeMultisigTx(txHex string) (*types.Transaction, *auth.Multisig, *auth.MultisigSignature, error) {
+	txBts, err := hex.DecodeString(strings.TrimSpace(txHex))
+	if err != nil {
+		return nil, nil, nil, fmt.Errorf("failed to decode transaction: %w", err)
+	}
+	tx := &types.Transaction{}
+	if err := tx.UnmarshalBinary(txBts); err != nil {
+		return nil, nil, nil, fmt.Errorf("failed to decode transaction: %w", err)
+	}
+	if tx.Body == nil {
+		return nil, nil, nil, errors.New("transaction has no body")
+	}
+
+	if tx.Signature == nil || tx.Signature.Type != auth.MultisigAuth {
+		return nil, nil, nil, errors.New("transaction is not of a multisig account")
+	}
+	sig, err := auth.UnmarshalMultisigSignature(tx.Signature.Data)
+	if err != nil {
+		return nil, nil, nil, err
+	}
+
+	return tx, sig.Multisig, sig, nil
+}
+
+// setMultisigSignature sets the signature of a multisig transaction.
+func setMultisigSignature(tx *types.Transaction, sig *auth.MultisigSignature) {
+	tx.Signature = &auth.Signature{
+		Data: sig.Bytes(),
+		Type: auth.MultisigAuth,
+	}
+}
+
+func multisigSignCmd() *cobra.Command {
+	return &cobra.Command{
+		Use:   "sign <hex transaction>",
+		Short: "Sign a transaction of a multisig account.",
+		Long: `Sign a transaction of a multisig account with the configured private key, which must be of a member.
+
+The transaction is printed with the signature added. Review the transaction with 'utils decode-tx'
+before signing it.`,
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			conf, err := config.ActiveConfig()
+			if err != nil {
+				return display.PrintErr(cmd, err)
+			}
+			if conf.PrivateKey == nil {
+				return display.PrintErr(cmd, errors.New("no private key configured"))
+			}
+
+			tx, multisig, sig, err := decodeMultisigTx(args[0])
+			if err != nil {
+				return display.PrintErr(cmd, err)
+			}
+
+			// The message of a sponsored transaction includes the sender's
+			// signature type, so set it before serializing.
+			setMultisigSignature(tx, sig)
+			msg, err := tx.SerializeMsg()
+			if err != nil {
+				return display.PrintErr(cmd, err)
+			}
+			partial, err := auth.SignMultisigPartial(multisig, &auth.EthPersonalSigner{Key: *conf.PrivateKey}, msg)
+			if err != nil {
+				return display.PrintErr(cmd, err)
+			}
+			sig.Add(partial)
+			setMultisigSignature(tx, sig)
+
+			return common.DisplayMultisigTx(cmd, tx)
+		},
+	}
+}
+
+func multisigCombineCmd() *cobra.Command {
+	return &cobra.Command{
+		Use:   "combine <hex transaction> <hex transaction>...",
+		Short: "Combine the signatures of copies of a multisig transaction.",
+		Long:  `Combine the signatures of copies of a transaction of a multisig account that were signed by different members.`,
+		Args:  cobra.MinimumNArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			tx, _, sig, err := decodeMultisigTx(args[0])
+			if err != nil {
+				return display.PrintErr(cmd, err)
+			}
+
+			for _, txHex := range args[1:] {
+				other, _, otherSig, err := decodeMultisigTx(txHex)
+				if err != nil {
+					return display.PrintErr(cmd, err)
+				}
+				if !bytes.Equal(other.Sender, tx.Sender) || !bytes.Equal(other.Body.Bytes(), tx.Body.Bytes()) ||
+					other.Serialization != tx.Serialization {
+					return display.PrintErr(cmd, errors.New("transactions are not copies of the same transaction"))
+				}
+				if !otherSig.Multisig.Equals(sig.Multisig) {
+					return display.PrintErr(cmd, errors.New("transactions are signed with different account definitions"))
+				}
+				for _, partial := range otherSig.Partials {
+					sig.Add(partial)
+				}
+			}
+			setMultisigSignature(tx, sig)
+
+			return common.DisplayMultisigTx(cmd, tx)
+		},
+	}
+}
+
+func multisigBroadcastCmd() *cobra.Command {
+	var sync bool
+	cmd := &cobra.Command{
+		Use:   "broadcast <hex transaction>",
+		Short: "Broadcast a signed transaction of a multisig account.",
+		Long:  `Broadcast a transaction of a multisig account that was signed by at least the threshold of its members.`,
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			tx, multisig, sig, err := decodeMultisigTx(args[0])
+			if err != nil {
+				return display.PrintErr(cmd, err)
+			}
+			if len(sig.Partials) < int(multisig.Threshold) {
+				return display.PrintErr(cmd, fmt.Errorf("transaction is signed by %d of the %d required members",
+					len(sig.Partials), multisig.Threshold))
+			}
+
+			return client.DialClient(cmd.Context(), cmd, client.WithoutPrivateKey, func(ctx context.Context, cl clientType.Client, conf *config.KwilCliConfig) error {
+				svc, ok := cl.(interface{ SvcClient() user.TxSvcClient })
+				if !ok {
+					return display.PrintErr(cmd, errors.New("client cannot broadcast transactions"))
+				}
+
+				wait := rpcclient.BroadcastWaitAccept
+				if sync {
+					wait = rpcclient.BroadcastWaitCommit
+				}
+				txHash, err := svc.SvcClient().Broadcast(ctx, tx, wait)
+				if err != nil {
+					return display.PrintErr(cmd, fmt.Errorf("broadcast failed: %w", err))
+				}
+				if sync {
+					resp, err := cl.TxQuery(ctx, txHash)
+					if err != nil {
+						return display.PrintErr(cmd, fmt.Errorf("tx query failed: %w", err))
+					}
+					return display.PrintCmd(cmd, display.NewTxHashAndExecResponse(resp))
+				}
+				return display.PrintCmd(cmd, display.RespTxHash(txHash))
+			})
+		},
+	}
+
+	cmd.Flags().BoolVar(&sync, "sync", false, "synchronous broadcast (wait for it to be included in a block)")
+
+	return cmd
+}
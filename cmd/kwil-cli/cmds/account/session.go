@@ -0,0 +1,147 @@
+package account
+
+import (
+	"context"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"math/big"
+	"strings"
+
+	"github.com/spf13/cobra"
+
+	"github.com/trufnetwork/kwil-db/app/shared/display"
+	"github.com/trufnetwork/kwil-db/cmd/kwil-cli/client"
+	"github.com/trufnetwork/kwil-db/cmd/kwil-cli/cmds/common"
+	"github.com/trufnetwork/kwil-db/cmd/kwil-cli/config"
+	clientType "github.com/trufnetwork/kwil-db/core/client/types"
+	"github.com/trufnetwork/kwil-db/core/crypto/auth"
+	"github.com/trufnetwork/kwil-db/core/types"
+)
+
+func sessionCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "session",
+		Short: "Session key commands.",
+		Long: `Commands to authorize and revoke session keys.
+
+A session key is a key that an account authorizes to sign transactions for it, so that an
+application can act for the account without asking its main key to sign each transaction. A
+session is limited to executing the actions in its namespaces and actions, until its expiry height,
+and to spending at most its spend cap in transaction fees, which are paid by the account.
+
+A transaction is signed with a session key by configuring it as the private key, and setting the
+--delegator flag of a command such as 'exec-action' to the account that authorized it. The
+transaction is executed as if the account sent it, so @caller is the account.`,
+	}
+
+	cmd.AddCommand(
+		sessionCreateCmd(),
+		sessionRevokeCmd(),
+	)
+
+	return cmd
+}
+
+func sessionCreateCmd() *cobra.Command {
+	var authType, spendCapStr string
+	var namespaces, actions []string
+	var expiry int64
+
+	cmd := &cobra.Command{
+		Use:   "create <hex session key ID>",
+		Short: "Authorize a session key to sign transactions for the account.",
+		Long: `Authorize a session key to sign transactions for the account of the configured private key.
+
+The session key is given by its compact ID, and its auth type. For an Ethereum wallet, these are
+its address and 'secp256k1_ep'. Creating a session for a key that already has one replaces it.`,
+		Example: `# Authorize a key to call the move and attack actions in the game namespace until block 50000,
+# spending at most 1000000 in fees
+kwil-cli account session create 0xc89D42189f0450C2b2c3c61f58Ec5d628176A1E7 \
+  --namespace game --action move --action attack --expiry 50000 --spend-cap 1000000`,
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			txFlags, err := common.GetTxFlags(cmd)
+			if err != nil {
+				return display.PrintErr(cmd, err)
+			}
+
+			sessionKey, err := hex.DecodeString(strings.TrimPrefix(args[0], "0x"))
+			if err != nil {
+				return display.PrintErr(cmd, fmt.Errorf("failed to decode session key ID: %w", err))
+			}
+			if expiry <= 0 {
+				return display.PrintErr(cmd, errors.New("the session expiry height must be set"))
+			}
+
+			session := &types.CreateSession{
+				SessionKey: sessionKey,
+				AuthType:   authType,
+				Namespaces: namespaces,
+				Actions:    actions,
+				Expiry:     expiry,
+			}
+			if spendCapStr != "" {
+				var ok bool
+				session.SpendCap, ok = big.NewInt(0).SetString(spendCapStr, 10)
+				if !ok {
+					return display.PrintErr(cmd, fmt.Errorf("invalid decimal spend cap %q", spendCapStr))
+				}
+			}
+
+			return client.DialClient(cmd.Context(), cmd, 0, func(ctx context.Context, cl clientType.Client, conf *config.KwilCliConfig) error {
+				txHash, err := cl.CreateSession(ctx, session, txFlags.Opts()...)
+				if err != nil {
+					return display.PrintErr(cmd, fmt.Errorf("create session failed: %w", err))
+				}
+
+				return txFlags.DisplayTxResult(ctx, cl, txHash, cmd)
+			})
+		},
+	}
+
+	cmd.Flags().StringVar(&authType, "auth-type", auth.EthPersonalSignAuth, "auth type of the session key")
+	cmd.Flags().StringArrayVar(&namespaces, "namespace", nil, "namespace that the session may execute actions in (default any)")
+	cmd.Flags().StringArrayVar(&actions, "action", nil, "action that the session may execute (default any)")
+	cmd.Flags().Int64Var(&expiry, "expiry", 0, "last block height at which the session is valid")
+	cmd.Flags().StringVar(&spendCapStr, "spend-cap", "", "maximum total fees the session may spend (default no cap)")
+	common.BindTxFlags(cmd)
+
+	return cmd
+}
+
+func sessionRevokeCmd() *cobra.Command {
+	var authType string
+
+	cmd := &cobra.Command{
+		Use:   "revoke <hex session key ID>",
+		Short: "Revoke a session key of the account.",
+		Long:  `Revoke a session key that the account of the configured private key authorized.`,
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			txFlags, err := common.GetTxFlags(cmd)
+			if err != nil {
+				return display.PrintErr(cmd, err)
+			}
+
+			sessionKey, err := hex.DecodeString(strings.TrimPrefix(args[0], "0x"))
+			if err != nil {
+				return display.PrintErr(cmd, fmt.Errorf("failed to decode session key ID: %w", err))
+			}
+
+			return client.DialClient(cmd.Context(), cmd, 0, func(ctx context.Context, cl clientType.Client, conf *config.KwilCliConfig) error {
+				txHash, err := cl.RevokeSession(ctx, sessionKey, authType, txFlags.Opts()...)
+				if err != nil {
+					return display.PrintErr(cmd, fmt.Errorf("revoke session failed: %w", err))
+				}
+
+				return txFlags.DisplayTxResult(ctx, cl, txHash, cmd)
+			})
+		},
+	}
+
+	cmd.Flags().StringVar(&authType, "auth-type", auth.EthPersonalSignAuth, "auth type of the session key")
+	common.BindTxFlags(cmd)
+
+	return cmd
+}
@@ -12,6 +12,7 @@ import (
 	"github.com/spf13/cobra"
 	"github.com/trufnetwork/kwil-db/app/shared/display"
 	"github.com/trufnetwork/kwil-db/cmd/kwil-cli/client"
+	"github.com/trufnetwork/kwil-db/cmd/kwil-cli/cmds/common"
 	"github.com/trufnetwork/kwil-db/cmd/kwil-cli/config"
 	clientType "github.com/trufnetwork/kwil-db/core/client/types"
 	"github.com/trufnetwork/kwil-db/core/crypto"
@@ -50,11 +51,24 @@ func transferCmd() *cobra.Command {
 			}
 
 			return client.DialClient(cmd.Context(), cmd, 0, func(ctx context.Context, cl clientType.Client, conf *config.KwilCliConfig) error {
-				txHash, err := cl.Transfer(ctx, to, amount, clientType.WithNonce(nonceOverride),
-					clientType.WithSyncBroadcast(syncBcast))
+				opts := []clientType.TxOpt{clientType.WithNonce(nonceOverride),
+					clientType.WithSyncBroadcast(syncBcast), clientType.WithValidAfterHeight(validAfter),
+					clientType.WithValidUntilHeight(validUntil)}
+				var multisigTx *types.Transaction
+				if multisig != "" {
+					opts = append(opts, clientType.WithTxHandler(func(tx *types.Transaction) error {
+						multisigTx = tx
+						return nil
+					}))
+				}
+
+				txHash, err := cl.Transfer(ctx, to, amount, opts...)
 				if err != nil {
 					return display.PrintErr(cmd, fmt.Errorf("transfer failed: %w", err))
 				}
+				if multisigTx != nil {
+					return common.DisplayMultisigTx(cmd, multisigTx)
+				}
 				// If sycnBcast, and we have a txHash (error or not), do a query-tx.
 				if len(txHash) != 0 && syncBcast {
 					time.Sleep(500 * time.Millisecond) // otherwise it says not found at first
@@ -23,7 +23,11 @@ This command calls a view action against the database, and formats the results i
 It can only be used to call view actions, not write actions.
 
 It is not required to have a private key configured, unless the RPC you are calling is in
-private mode, or you are talking to Kwil Gateway.`
+private mode, or you are talking to Kwil Gateway.
+
+With --trace, the result also includes a trace of the call: each statement executed, each
+variable assigned, each SQL statement with its parameters and the rows it affected, and each
+action or extension method it called, shown as a tree.`
 
 	callActionExample = `# Call the action 'get-accounts' with no parameters
 kwil-cli call-action get-accounts
@@ -41,13 +45,16 @@ kwil-cli call-action get-account --namespace users
 kwil-cli call-action get-account --rpc-auth
 
 # Call the action 'get-account' and authenticate with Kwil Gateway
-kwil-cli call-action get-account --gateway-auth`
+kwil-cli call-action get-account --gateway-auth
+
+# Call the action 'get-posts' and show the statements it executed
+kwil-cli call-action get-posts int:1 --trace`
 )
 
 func callActionCmd() *cobra.Command {
 	var namespace string
 	var namedParams []string
-	var gwAuth, rpcAuth, logs bool
+	var gwAuth, rpcAuth, logs, trace bool
 
 	cmd := &cobra.Command{
 		Use:     "call-action",
@@ -106,21 +113,38 @@ func callActionCmd() *cobra.Command {
 					// there is a case where an action has 3 parameters, but only 2 are specified positionally,
 					// with the 3rd being specified as a named parameter. In this case, we need to ensure that the
 					// length of params is the same as the length of actionParams
+					given := make([]bool, len(params), len(paramList))
+					for i := range given {
+						given[i] = true
+					}
 					for i, p := range pos {
 						if p >= len(params) {
 							params = append(params, make([]any, p-len(params)+1)...)
+							given = append(given, make([]bool, p-len(given)+1)...)
 						}
 
 						params[p] = values[i]
+						given[p] = true
 					}
+
+					tuples, err := omitDefaults(ctx, cl, namespace, args[0], [][]any{params}, given)
+					if err != nil {
+						return display.PrintErr(cmd, err)
+					}
+					params = tuples[0]
+				}
+
+				var opts []clientType.CallOpt
+				if trace {
+					opts = append(opts, clientType.WithTrace())
 				}
 
-				res, err := cl.Call(ctx, namespace, args[0], params)
+				res, err := cl.Call(ctx, namespace, args[0], params, opts...)
 				if err != nil {
 					return display.PrintErr(cmd, err)
 				}
 
-				return display.PrintCmd(cmd, &respCall{Data: res, PrintLogs: logs, cmd: cmd})
+				return display.PrintCmd(cmd, &respCall{Data: res, PrintLogs: logs, PrintTrace: trace, cmd: cmd})
 			})
 		},
 	}
@@ -132,19 +156,21 @@ func callActionCmd() *cobra.Command {
 	cmd.Flags().BoolVar(&rpcAuth, "rpc-auth", false, "signals that the call is being made to a kwil node and should be authenticated with the private key")
 	cmd.Flags().BoolVar(&gwAuth, "gateway-auth", false, "signals that the call is being made to a gateway and should be authenticated with the private key")
 	cmd.Flags().BoolVar(&logs, "logs", false, "result will include logs from notices raised during the call")
+	cmd.Flags().BoolVar(&trace, "trace", false, "result will include a trace of the statements executed by the call")
 	display.BindTableFlags(cmd)
 
 	return cmd
 }
 
 type respCall struct {
-	Data      *types.CallResult
-	PrintLogs bool
-	cmd       *cobra.Command
+	Data       *types.CallResult
+	PrintLogs  bool
+	PrintTrace bool
+	cmd        *cobra.Command
 }
 
 func (r *respCall) MarshalJSON() ([]byte, error) {
-	if !r.PrintLogs {
+	if !r.PrintLogs && !r.PrintTrace {
 		return json.Marshal(r.Data.QueryResult) // this is for backwards compatibility
 	}
 
@@ -193,13 +219,53 @@ func (r *respCall) MarshalText() (text []byte, err error) {
 		str += "\n\nError: " + *r.Data.Error
 	}
 
-	if !r.PrintLogs {
-		return []byte(str), nil
+	if r.PrintLogs && len(r.Data.Logs) > 0 {
+		str += "\nLogs:\n  " + strings.ReplaceAll(r.Data.Logs, "\n", "\n  ")
 	}
 
-	if len(r.Data.Logs) > 0 {
-		str += "\nLogs:\n  " + strings.ReplaceAll(r.Data.Logs, "\n", "\n  ")
+	if r.PrintTrace && r.Data.Trace != nil {
+		var sb strings.Builder
+		sb.WriteString("\nTrace:\n")
+		writeTrace(&sb, r.Data.Trace, "", "")
+		if r.Data.Trace.Truncated {
+			sb.WriteString("(trace truncated)\n")
+		}
+		str += strings.TrimSuffix(sb.String(), "\n")
 	}
 
 	return []byte(str), nil
 }
+
+// writeTrace writes an event of a call trace and the events nested in it as a
+// tree. prefix is written before the event, and indent before its children.
+func writeTrace(sb *strings.Builder, event *types.CallTrace, prefix, indent string) {
+	sb.WriteString(prefix)
+	switch event.Kind {
+	case types.TraceKindStatement:
+		fmt.Fprintf(sb, "%d: %s", event.Line, event.Text)
+	case types.TraceKindAssign:
+		sb.WriteString(event.Text)
+	case types.TraceKindSQL:
+		sb.WriteString("sql: " + strings.Join(strings.Fields(event.Text), " "))
+		if len(event.Params) > 0 {
+			sb.WriteString(" [" + strings.Join(event.Params, ", ") + "]")
+		}
+		if event.RowsAffected != nil {
+			fmt.Fprintf(sb, " (%d rows)", *event.RowsAffected)
+		}
+	default:
+		sb.WriteString("call " + event.Text + "(" + strings.Join(event.Params, ", ") + ")")
+	}
+	if event.Error != "" {
+		sb.WriteString(" ERROR: " + event.Error)
+	}
+	sb.WriteString("\n")
+
+	for i, child := range event.Children {
+		if i == len(event.Children)-1 {
+			writeTrace(sb, child, indent+"└── ", indent+"    ")
+		} else {
+			writeTrace(sb, child, indent+"├── ", indent+"│   ")
+		}
+	}
+}
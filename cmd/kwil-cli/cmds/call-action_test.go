@@ -0,0 +1,39 @@
+package cmds
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/trufnetwork/kwil-db/core/types"
+)
+
+func Test_WriteTrace(t *testing.T) {
+	rows := int64(1)
+	trace := &types.CallTrace{
+		Kind:   types.TraceKindCall,
+		Text:   "main.act",
+		Params: []string{"1"},
+		Children: []*types.CallTrace{
+			{Kind: types.TraceKindAssign, Text: "$a = 1"},
+			{Kind: types.TraceKindStatement, Line: 2, Text: "add_item($a);", Children: []*types.CallTrace{
+				{Kind: types.TraceKindCall, Text: "main.add_item", Params: []string{"1"}, Children: []*types.CallTrace{
+					{Kind: types.TraceKindSQL, Text: "INSERT INTO items (id)\n  VALUES ($id);", Params: []string{"$id = 1"}, RowsAffected: &rows},
+				}},
+			}},
+			{Kind: types.TraceKindStatement, Line: 3, Text: "error('no');", Error: "no"},
+		},
+	}
+
+	var sb strings.Builder
+	writeTrace(&sb, trace, "", "")
+
+	assert.Equal(t, `call main.act(1)
+├── $a = 1
+├── 2: add_item($a);
+│   └── call main.add_item(1)
+│       └── sql: INSERT INTO items (id) VALUES ($id); [$id = 1] (1 rows)
+└── 3: error('no'); ERROR: no
+`, sb.String())
+}
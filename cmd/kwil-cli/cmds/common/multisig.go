@@ -0,0 +1,50 @@
+package common
+
+import (
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+
+	"github.com/spf13/cobra"
+
+	"github.com/trufnetwork/kwil-db/app/shared/display"
+	"github.com/trufnetwork/kwil-db/core/crypto/auth"
+	"github.com/trufnetwork/kwil-db/core/types"
+)
+
+// DisplayMultisigTx prints a transaction of a multisig account, with the
+// number of its members that signed it.
+func DisplayMultisigTx(cmd *cobra.Command, tx *types.Transaction) error {
+	if tx.Signature == nil || tx.Signature.Type != auth.MultisigAuth {
+		return display.PrintErr(cmd, errors.New("transaction has no multisig signature"))
+	}
+	// the signature has the account definition, even before any member signs
+	sig, err := auth.UnmarshalMultisigSignature(tx.Signature.Data)
+	if err != nil {
+		return display.PrintErr(cmd, err)
+	}
+
+	return display.PrintCmd(cmd, &respMultisigTx{
+		TxHash:    tx.Hash(),
+		Tx:        hex.EncodeToString(tx.Bytes()),
+		Signed:    len(sig.Partials),
+		Threshold: int(sig.Multisig.Threshold),
+	})
+}
+
+type respMultisigTx struct {
+	TxHash    types.Hash `json:"tx_hash"`
+	Tx        string     `json:"tx"`
+	Signed    int        `json:"signed"`
+	Threshold int        `json:"threshold"`
+}
+
+func (r *respMultisigTx) MarshalJSON() ([]byte, error) {
+	type res respMultisigTx // prevent recursion
+	return json.Marshal((*res)(r))
+}
+
+func (r *respMultisigTx) MarshalText() ([]byte, error) {
+	return fmt.Appendf(nil, "Transaction: %s\nSigned by %d of the %d required members\n", r.Tx, r.Signed, r.Threshold), nil
+}
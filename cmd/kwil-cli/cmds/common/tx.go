@@ -2,6 +2,9 @@ package common
 
 import (
 	"context"
+	"encoding/hex"
+	"fmt"
+	"strings"
 
 	"github.com/spf13/cobra"
 	"github.com/trufnetwork/kwil-db/app/shared/display"
@@ -14,11 +17,51 @@ import (
 func BindTxFlags(cmd *cobra.Command) {
 	cmd.Flags().Int64P("nonce", "N", -1, "nonce override (-1 means request from server)")
 	cmd.Flags().Bool("sync", false, "synchronous broadcast (wait for it to be included in a block)")
+	cmd.Flags().Int64("valid-after", 0, "height that a block must be above to include the transaction (0 means no limit)")
+	cmd.Flags().Int64("valid-until", 0, "highest block that may include the transaction, after which it expires (0 means no limit)")
+	cmd.Flags().String("delegator", "", "account to sign the transaction for with the private key as its session key, as <auth type>:<hex ID>")
+	BindMultisigFlag(cmd)
+}
+
+// BindMultisigFlag binds the --multisig flag, which makes a command author its
+// transaction for a multisig account. The transaction is signed by the
+// configured private key if it is of a member, and printed instead of being
+// broadcast so that the other members can sign it.
+func BindMultisigFlag(cmd *cobra.Command) {
+	cmd.Flags().String("multisig", "", "hex definition of the multisig account to author the transaction for, which is printed for the members to sign instead of broadcast")
+	cmd.Flags().String("multisig-id", "", "hex ID of the multisig account, if it changed its definition since it was registered")
 }
 
 type TxFlags struct {
-	NonceOverride int64
-	SyncBroadcast bool
+	NonceOverride    int64
+	SyncBroadcast    bool
+	ValidAfterHeight int64
+	ValidUntilHeight int64
+	Multisig         bool
+	Delegator        *types.Delegator
+
+	// multisigTx is the transaction authored for a multisig account.
+	multisigTx *types.Transaction
+}
+
+// Opts returns the options to make and broadcast a transaction with.
+func (f *TxFlags) Opts() []client.TxOpt {
+	opts := []client.TxOpt{
+		client.WithNonce(f.NonceOverride),
+		client.WithSyncBroadcast(f.SyncBroadcast),
+		client.WithValidAfterHeight(f.ValidAfterHeight),
+		client.WithValidUntilHeight(f.ValidUntilHeight),
+	}
+	if f.Delegator != nil {
+		opts = append(opts, client.WithDelegator(f.Delegator.Identifier, f.Delegator.AuthType))
+	}
+	if f.Multisig {
+		opts = append(opts, client.WithTxHandler(func(tx *types.Transaction) error {
+			f.multisigTx = tx
+			return nil
+		}))
+	}
+	return opts
 }
 
 func GetTxFlags(cmd *cobra.Command) (*TxFlags, error) {
@@ -30,22 +73,63 @@ func GetTxFlags(cmd *cobra.Command) (*TxFlags, error) {
 	if err != nil {
 		return nil, err
 	}
+	validAfter, err := cmd.Flags().GetInt64("valid-after")
+	if err != nil {
+		return nil, err
+	}
+	validUntil, err := cmd.Flags().GetInt64("valid-until")
+	if err != nil {
+		return nil, err
+	}
+	multisig, err := cmd.Flags().GetString("multisig")
+	if err != nil {
+		return nil, err
+	}
+
+	delegatorStr, err := cmd.Flags().GetString("delegator")
+	if err != nil {
+		return nil, err
+	}
+	var delegator *types.Delegator
+	if delegatorStr != "" {
+		delegator, err = parseDelegator(delegatorStr)
+		if err != nil {
+			return nil, err
+		}
+	}
 
 	return &TxFlags{
-		NonceOverride: nonce,
-		SyncBroadcast: sync,
+		NonceOverride:    nonce,
+		SyncBroadcast:    sync,
+		ValidAfterHeight: validAfter,
+		ValidUntilHeight: validUntil,
+		Multisig:         multisig != "",
+		Delegator:        delegator,
 	}, nil
 }
 
-// DisplayTxResult takes a tx hash and decides whether to wait for it and print the tx result,
-// or just print the tx hash. It will display the result of the transaction.
-func DisplayTxResult(ctx context.Context, client1 client.Client, txHash types.Hash, cmd *cobra.Command) error {
-	txFlags, err := GetTxFlags(cmd)
+// parseDelegator parses a delegator given as <auth type>:<hex ID>.
+func parseDelegator(s string) (*types.Delegator, error) {
+	authType, idStr, ok := strings.Cut(s, ":")
+	if !ok {
+		return nil, fmt.Errorf("delegator %q is not <auth type>:<hex ID>", s)
+	}
+	id, err := hex.DecodeString(strings.TrimPrefix(idStr, "0x"))
 	if err != nil {
-		return display.PrintErr(cmd, err)
+		return nil, fmt.Errorf("failed to decode delegator ID: %w", err)
+	}
+	return &types.Delegator{Identifier: id, AuthType: authType}, nil
+}
+
+// DisplayTxResult takes a tx hash and decides whether to wait for it and print the tx result,
+// or just print the tx hash. It will display the result of the transaction. If
+// the transaction was authored for a multisig account, it is printed instead.
+func (f *TxFlags) DisplayTxResult(ctx context.Context, client1 client.Client, txHash types.Hash, cmd *cobra.Command) error {
+	if f.multisigTx != nil {
+		return DisplayMultisigTx(cmd, f.multisigTx)
 	}
 
-	if len(txHash) > 0 && txFlags.SyncBroadcast {
+	if len(txHash) > 0 && f.SyncBroadcast {
 		// time.Sleep(500 * time.Millisecond) // TODO: remove once we have fixed race condition
 		resp, err := client1.TxQuery(ctx, txHash)
 		if err != nil {
@@ -93,7 +93,8 @@ func batchCmd() *cobra.Command {
 				}
 
 				txHash, err := cl.Execute(ctx, namespace, strings.ToLower(action), tuples,
-					clientType.WithNonce(nonceOverride), clientType.WithSyncBroadcast(syncBcast))
+					clientType.WithNonce(nonceOverride), clientType.WithSyncBroadcast(syncBcast),
+					clientType.WithValidAfterHeight(validAfter), clientType.WithValidUntilHeight(validUntil))
 				if err != nil {
 					return display.PrintErr(cmd, fmt.Errorf("error executing action: %w", err))
 				}
@@ -14,6 +14,8 @@ var (
 
 	nonceOverride int64
 	syncBcast     bool
+	validAfter    int64
+	validUntil    int64
 )
 
 func NewCmdDatabase() *cobra.Command {
@@ -21,6 +23,7 @@ func NewCmdDatabase() *cobra.Command {
 	readOnlyCmds := []*cobra.Command{
 		queryCmd(),
 		callCmd(), // no tx, but may required key for signature, for now
+		exportSchemaCmd(),
 	}
 	dbCmd.AddCommand(readOnlyCmds...)
 
@@ -31,11 +34,17 @@ func NewCmdDatabase() *cobra.Command {
 	}
 	dbCmd.AddCommand(writeCmds...)
 
+	// diff only creates a transaction if --execute is passed, and binds its
+	// own transaction flags.
+	dbCmd.AddCommand(diffCmd())
+
 	// The write commands may also specify a nonce to use instead of asking the
 	// node for the latest confirmed nonce.
 	for _, cmd := range writeCmds {
 		cmd.Flags().Int64VarP(&nonceOverride, "nonce", "N", -1, "nonce override (-1 means request from server)")
 		cmd.Flags().BoolVar(&syncBcast, "sync", false, "synchronous broadcast (wait for it to be included in a block)")
+		cmd.Flags().Int64Var(&validAfter, "valid-after", 0, "height that a block must be above to include the transaction (0 means no limit)")
+		cmd.Flags().Int64Var(&validUntil, "valid-until", 0, "highest block that may include the transaction, after which it expires (0 means no limit)")
 	}
 
 	return dbCmd
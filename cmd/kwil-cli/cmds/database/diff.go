@@ -0,0 +1,145 @@
+package database
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"os"
+	"strings"
+
+	"github.com/spf13/cobra"
+
+	"github.com/trufnetwork/kwil-db/app/shared/display"
+	"github.com/trufnetwork/kwil-db/cmd/kwil-cli/client"
+	"github.com/trufnetwork/kwil-db/cmd/kwil-cli/cmds/common"
+	"github.com/trufnetwork/kwil-db/cmd/kwil-cli/config"
+	"github.com/trufnetwork/kwil-db/cmd/kwil-cli/helpers"
+	clientType "github.com/trufnetwork/kwil-db/core/client/types"
+	"github.com/trufnetwork/kwil-db/core/types"
+	"github.com/trufnetwork/kwil-db/node/engine/diff"
+)
+
+var (
+	diffLong = `Compare the live schema of a namespace with a schema file, and print the migration between them.
+
+The schema file describes the desired state of the namespace, and may only contain CREATE TABLE,
+CREATE INDEX, and CREATE ACTION statements. The live schema is read from the info views of the
+node. The migration is an ordered script of DDL statements that makes the live schema match the
+schema file: tables, columns, defaults, NOT NULL constraints, unique, check, and foreign key
+constraints, indexes, and actions are created, altered, or dropped as needed.
+
+Steps that drop data or actions are marked as destructive. Changes that cannot be migrated, such
+as changes to a primary key, are reported as unsupported and must be made by hand.
+
+If ` + "`--execute`" + ` is passed, the migration is submitted as a single transaction, as it would be with
+` + "`kwil-cli exec-sql`" + `. Migrations with destructive steps are only submitted if ` + "`--allow-destructive`" + `
+is also passed, and migrations with unsupported changes are never submitted.`
+
+	diffExample = `# Print the migration from the live "mydb" namespace to schema.sql
+kwil-cli database diff --namespace mydb schema.sql
+
+# Apply the migration, even if it drops data
+kwil-cli database diff --namespace mydb schema.sql --execute --allow-destructive --sync`
+)
+
+func diffCmd() *cobra.Command {
+	var execute, allowDestructive bool
+
+	cmd := &cobra.Command{
+		Use:     "diff <schema_file>",
+		Short:   "Compare the live schema of a namespace with a schema file.",
+		Long:    diffLong,
+		Example: diffExample,
+		Args:    cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			namespace, _, err := getSelectedNamespace(cmd)
+			if err != nil {
+				return display.PrintErr(cmd, fmt.Errorf("error getting selected namespace from CLI flags: %w", err))
+			}
+
+			path, err := helpers.ExpandPath(args[0])
+			if err != nil {
+				return display.PrintErr(cmd, err)
+			}
+			src, err := os.ReadFile(path)
+			if err != nil {
+				return display.PrintErr(cmd, err)
+			}
+
+			desired, err := diff.FromSource(string(src), namespace)
+			if err != nil {
+				return display.PrintErr(cmd, fmt.Errorf("error reading schema file: %w", err))
+			}
+
+			var flags uint8
+			if !execute {
+				flags = client.WithoutPrivateKey
+			}
+
+			return client.DialClient(cmd.Context(), cmd, flags, func(ctx context.Context, cl clientType.Client, conf *config.KwilCliConfig) error {
+				query := func(ctx context.Context, query string, params map[string]any) (*types.QueryResult, error) {
+					return cl.Query(ctx, query, params, false)
+				}
+
+				live, err := diff.LoadSchema(ctx, query, namespace)
+				if errors.Is(err, diff.ErrNamespaceNotFound) {
+					live = nil // the migration creates the namespace
+				} else if err != nil {
+					return display.PrintErr(cmd, fmt.Errorf("error reading live schema: %w", err))
+				}
+
+				plan, err := diff.Compare(namespace, live, desired)
+				if err != nil {
+					return display.PrintErr(cmd, err)
+				}
+
+				if !execute || len(plan.Steps) == 0 {
+					return display.PrintCmd(cmd, &diffResult{plan: plan})
+				}
+
+				if len(plan.Unsupported) > 0 {
+					return display.PrintErr(cmd, fmt.Errorf("the migration contains changes that must be made by hand: %s", strings.Join(plan.Unsupported, "; ")))
+				}
+				if plan.Destructive() && !allowDestructive {
+					return display.PrintErr(cmd, errors.New("the migration contains destructive steps; review them and pass --allow-destructive to execute it"))
+				}
+
+				txFlags, err := common.GetTxFlags(cmd)
+				if err != nil {
+					return display.PrintErr(cmd, err)
+				}
+
+				txHash, err := cl.ExecuteSQL(ctx, plan.SQL(), nil, txFlags.Opts()...)
+				if err != nil {
+					return display.PrintErr(cmd, err)
+				}
+
+				return txFlags.DisplayTxResult(ctx, cl, txHash, cmd)
+			})
+		},
+	}
+
+	cmd.Flags().StringP(nameFlag, "n", "", "the target database namespace")
+	cmd.Flags().BoolVar(&execute, "execute", false, "submit the migration in a transaction instead of printing it")
+	cmd.Flags().BoolVar(&allowDestructive, "allow-destructive", false, "allow executing a migration that drops data or actions")
+	common.BindTxFlags(cmd)
+	return cmd
+}
+
+type diffResult struct {
+	plan *diff.Plan
+}
+
+func (d *diffResult) MarshalJSON() ([]byte, error) {
+	return json.Marshal(d.plan)
+}
+
+func (d *diffResult) MarshalText() ([]byte, error) {
+	if len(d.plan.Steps) == 0 && len(d.plan.Unsupported) == 0 {
+		return []byte("No changes."), nil
+	}
+
+	// the output is printed with a trailing newline
+	return []byte(strings.TrimSuffix(d.plan.Script(), "\n")), nil
+}
@@ -86,7 +86,8 @@ func executeCmd() *cobra.Command {
 					// Could actually just directly pass nonce to the client method,
 					// but those methods don't need tx details in the inputs.
 					txHash, err := cl.Execute(ctx, namespace, action, inputs,
-						clientType.WithNonce(nonceOverride), clientType.WithSyncBroadcast(syncBcast))
+						clientType.WithNonce(nonceOverride), clientType.WithSyncBroadcast(syncBcast),
+						clientType.WithValidAfterHeight(validAfter), clientType.WithValidUntilHeight(validUntil))
 					if err != nil {
 						return display.PrintErr(cmd, fmt.Errorf("error executing database: %w", err))
 					}
@@ -149,7 +150,8 @@ func executeCmd() *cobra.Command {
 				}
 
 				// If we're here, we're executing a SQL statement.
-				txHash, err := cl.ExecuteSQL(ctx, stmt, args, clientType.WithNonce(nonceOverride), clientType.WithSyncBroadcast(syncBcast))
+				txHash, err := cl.ExecuteSQL(ctx, stmt, args, clientType.WithNonce(nonceOverride), clientType.WithSyncBroadcast(syncBcast),
+					clientType.WithValidAfterHeight(validAfter), clientType.WithValidUntilHeight(validUntil))
 				if err != nil {
 					return display.PrintErr(cmd, fmt.Errorf("error executing SQL statement: %w", err))
 				}
@@ -0,0 +1,102 @@
+package database
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"os"
+	"strings"
+
+	"github.com/spf13/cobra"
+
+	"github.com/trufnetwork/kwil-db/app/shared/display"
+	"github.com/trufnetwork/kwil-db/cmd/kwil-cli/client"
+	"github.com/trufnetwork/kwil-db/cmd/kwil-cli/config"
+	"github.com/trufnetwork/kwil-db/cmd/kwil-cli/helpers"
+	clientType "github.com/trufnetwork/kwil-db/core/client/types"
+)
+
+var (
+	exportLong = `Export the schema of a deployed namespace as a Kuneiform script.
+
+The script recreates the namespace's tables, columns, defaults, constraints, indexes, and
+actions, the extensions that its actions use, and the privileges that are granted or revoked
+on the namespace, along with the roles that hold them and their members. Data is not exported.
+
+The script is canonically formatted, so exporting the same schema always gives the same
+output. It can be deployed to the same or another network with ` + "`kwil-cli exec-sql --file`" + `,
+which makes it suitable for disaster recovery, audits, and moving a namespace between
+networks.
+
+Note that schema exports will be rejected on RPC servers that are operating with
+authenticated call requests enabled.`
+
+	exportExample = `# Print the schema of the "mydb" namespace
+kwil-cli database export-schema mydb
+
+# Write the schema to a file, and deploy it to another network
+kwil-cli database export-schema mydb --out mydb.sql
+kwil-cli exec-sql --file mydb.sql --provider http://other-network:8484 --sync`
+)
+
+func exportSchemaCmd() *cobra.Command {
+	var out string
+
+	cmd := &cobra.Command{
+		Use:     "export-schema <namespace>",
+		Short:   "Export the schema of a deployed namespace as a Kuneiform script.",
+		Long:    exportLong,
+		Example: exportExample,
+		Args:    cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			return client.DialClient(cmd.Context(), cmd, client.WithoutPrivateKey,
+				func(ctx context.Context, cl clientType.Client, conf *config.KwilCliConfig) error {
+					schema, err := cl.ExportSchema(ctx, args[0])
+					if err != nil {
+						return display.PrintErr(cmd, fmt.Errorf("error exporting schema: %w", err))
+					}
+
+					res := &exportResult{
+						Namespace: strings.ToLower(args[0]),
+						Schema:    schema,
+					}
+
+					if out != "" {
+						res.File, err = helpers.ExpandPath(out)
+						if err != nil {
+							return display.PrintErr(cmd, err)
+						}
+
+						if err = os.WriteFile(res.File, []byte(schema), 0644); err != nil {
+							return display.PrintErr(cmd, err)
+						}
+					}
+
+					return display.PrintCmd(cmd, res)
+				})
+		},
+	}
+
+	cmd.Flags().StringVarP(&out, "out", "o", "", "file to write the schema to, instead of printing it")
+	return cmd
+}
+
+type exportResult struct {
+	Namespace string `json:"namespace"`
+	Schema    string `json:"schema"`
+	File      string `json:"file,omitempty"`
+}
+
+func (e *exportResult) MarshalJSON() ([]byte, error) {
+	type result exportResult // avoid recursion
+	return json.Marshal((*result)(e))
+}
+
+func (e *exportResult) MarshalText() ([]byte, error) {
+	if e.File != "" {
+		return []byte(fmt.Sprintf("Schema of namespace %s written to %s", e.Namespace, e.File)), nil
+	}
+
+	// the output is printed with a trailing newline
+	return []byte(strings.TrimSuffix(e.Schema, "\n")), nil
+}
@@ -5,6 +5,7 @@ import (
 	"errors"
 	"fmt"
 	"os"
+	"slices"
 	"strconv"
 	"strings"
 
@@ -110,17 +111,22 @@ func execActionCmd() *cobra.Command {
 						return display.PrintErr(cmd, err)
 					}
 
-					inputs, err := csvToParams(paramList, csv, csvParams, namedParams)
+					inputs, given, err := csvToParams(paramList, csv, csvParams, namedParams)
 					if err != nil {
 						return display.PrintErr(cmd, err)
 					}
 
-					tx, err := cl.Execute(ctx, namespace, args[0], inputs, clientType.WithNonce(txFlags.NonceOverride), clientType.WithSyncBroadcast(txFlags.SyncBroadcast))
+					inputs, err = omitDefaults(ctx, cl, namespace, args[0], inputs, given)
 					if err != nil {
 						return display.PrintErr(cmd, err)
 					}
 
-					return common.DisplayTxResult(ctx, cl, tx, cmd)
+					tx, err := cl.Execute(ctx, namespace, args[0], inputs, txFlags.Opts()...)
+					if err != nil {
+						return display.PrintErr(cmd, err)
+					}
+
+					return txFlags.DisplayTxResult(ctx, cl, tx, cmd)
 				})
 			}
 
@@ -152,21 +158,33 @@ func execActionCmd() *cobra.Command {
 					// there is a case where an action has 3 parameters, but only 2 are specified positionally,
 					// with the 3rd being specified as a named parameter. In this case, we need to ensure that the
 					// length of params is the same as the length of actionParams
+					given := make([]bool, len(params), len(paramList))
+					for i := range given {
+						given[i] = true
+					}
 					for i, p := range pos {
 						if p >= len(params) {
 							params = append(params, make([]any, p-len(params)+1)...)
+							given = append(given, make([]bool, p-len(given)+1)...)
 						}
 
 						params[p] = values[i]
+						given[p] = true
 					}
+
+					tuples, err := omitDefaults(ctx, cl, namespace, args[0], [][]any{params}, given)
+					if err != nil {
+						return display.PrintErr(cmd, err)
+					}
+					params = tuples[0]
 				}
 
-				tx, err := cl.Execute(ctx, namespace, args[0], [][]any{params}, clientType.WithNonce(txFlags.NonceOverride), clientType.WithSyncBroadcast(txFlags.SyncBroadcast))
+				tx, err := cl.Execute(ctx, namespace, args[0], [][]any{params}, txFlags.Opts()...)
 				if err != nil {
 					return display.PrintErr(cmd, err)
 				}
 
-				return common.DisplayTxResult(ctx, cl, tx, cmd)
+				return txFlags.DisplayTxResult(ctx, cl, tx, cmd)
 			})
 		},
 	}
@@ -199,11 +217,12 @@ func actionParamInfoMap(p []NamedParameter) map[string]*actionParamInfo {
 }
 
 // csvToParams takes a CSV file, a mapping of CSV columns to action parameters, and named parameters and returns the ordered parameters.
-func csvToParams(namedParams []NamedParameter, c *csv.CSV, mapping []string, named []string) ([][]any, error) {
+// It also returns, by position, whether each parameter was given a value.
+func csvToParams(namedParams []NamedParameter, c *csv.CSV, mapping []string, named []string) ([][]any, []bool, error) {
 	// we need to take the CSV file and its mappings and match that up with the action parameters.
 	splitMapping, err := splitMapping(mapping)
 	if err != nil {
-		return nil, err
+		return nil, nil, err
 	}
 
 	// actionParamTypeMap maps the name of the action parameter to its type
@@ -211,17 +230,18 @@ func csvToParams(namedParams []NamedParameter, c *csv.CSV, mapping []string, nam
 
 	namedVals, values, _, err := getNamedParams(namedParams, named)
 	if err != nil {
-		return nil, fmt.Errorf("error getting named parameters: %w", err)
+		return nil, nil, fmt.Errorf("error getting named parameters: %w", err)
 	}
 
 	// for all found namedVals in the named params, we need to ensure they arent specified in the CSV mapping
 	for _, name := range namedVals {
 		if _, ok := splitMapping[name]; ok {
-			return nil, fmt.Errorf(`parameter "%s" cannot be both mapped to a CSV column and a named parameter`, name)
+			return nil, nil, fmt.Errorf(`parameter "%s" cannot be both mapped to a CSV column and a named parameter`, name)
 		}
 	}
 
 	// now, we will construct a 2d array of parameters to pass to the action.
+	given := make([]bool, len(namedParams))
 	vals := make([][]any, len(c.Records))
 	for i := range c.Records {
 		vals[i] = make([]any, len(namedParams))
@@ -230,7 +250,7 @@ func csvToParams(namedParams []NamedParameter, c *csv.CSV, mapping []string, nam
 	for csvColName, paramName := range splitMapping {
 		idx := c.GetColumnIndex(csvColName)
 		if idx == -1 {
-			return nil, fmt.Errorf("column %s not found in CSV file", csvColName)
+			return nil, nil, fmt.Errorf("column %s not found in CSV file", csvColName)
 		}
 
 		getInfo := func(name string) (*actionParamInfo, error) {
@@ -257,34 +277,43 @@ func csvToParams(namedParams []NamedParameter, c *csv.CSV, mapping []string, nam
 			return info, nil
 		}
 
-		for i, rec := range c.Records {
-			info, err := getInfo(paramName)
-			if err != nil {
-				return nil, fmt.Errorf("error getting parameter info: %w", err)
-			}
+		info, err := getInfo(paramName)
+		if err != nil {
+			return nil, nil, fmt.Errorf("error getting parameter info: %w", err)
+		}
+
+		// values of user-defined types are sent as their text representation
+		// (e.g. an enum label), which the engine casts to the type.
+		datatype := info.datatype
+		if datatype.IsUserDefined() && !datatype.IsArray {
+			datatype = types.TextType
+		}
 
-			val, err := stringAndTypeToVal(rec[idx], info.datatype)
+		for i, rec := range c.Records {
+			val, err := stringAndTypeToVal(rec[idx], datatype)
 			if err != nil {
-				return nil, fmt.Errorf("error converting value: %w", err)
+				return nil, nil, fmt.Errorf("error converting value: %w", err)
 			}
 
 			vals[i][info.pos] = val
 		}
+		given[info.pos] = true
 	}
 
 	// now we need to fill in the named parameters
 	for i, name := range namedVals {
 		info, ok := actionParamTypeMap[name]
 		if !ok {
-			return nil, fmt.Errorf(`action does not have a parameter named "%s"`, name)
+			return nil, nil, fmt.Errorf(`action does not have a parameter named "%s"`, name)
 		}
 
 		for j := range vals {
 			vals[j][info.pos] = values[i]
 		}
+		given[info.pos] = true
 	}
 
-	return vals, nil
+	return vals, given, nil
 }
 
 // splitMapping takes a list of strings of the form "csv_column:action_param" and returns the two parts.
@@ -302,6 +331,62 @@ func splitMapping(mapping []string) (map[string]string, error) {
 	return m, nil
 }
 
+// omitDefaults removes the arguments that were not given for trailing parameters
+// with default values, so that the engine uses the defaults instead of NULL.
+// Arguments are passed by position, so the default of a parameter cannot be
+// used if an argument for a later parameter is given. Given reports, by
+// position, whether an argument was given.
+func omitDefaults(ctx context.Context, cl clientType.Client, namespace, action string, tuples [][]any, given []bool) ([][]any, error) {
+	if !slices.Contains(given, false) {
+		return tuples, nil
+	}
+
+	schema, err := cl.Schema(ctx, namespace)
+	if err != nil {
+		return nil, fmt.Errorf("error getting action defaults: %w", err)
+	}
+
+	idx := slices.IndexFunc(schema.Actions, func(a *types.Action) bool {
+		return a.Name == strings.ToLower(action)
+	})
+	if idx == -1 {
+		return nil, fmt.Errorf(`action "%s" not found in namespace "%s"`, action, schema.Namespace)
+	}
+
+	return trimDefaults(schema.Actions[idx].Parameters, tuples, given)
+}
+
+// trimDefaults cuts the tuples before the trailing parameters whose arguments
+// were not given and that have default values.
+func trimDefaults(params []*types.ActionParameter, tuples [][]any, given []bool) ([][]any, error) {
+	last := slices.Index(given, true) // -1 if none were given
+	for i := len(given) - 1; i >= 0; i-- {
+		if given[i] {
+			last = i
+			break
+		}
+	}
+
+	for i := 0; i < last && i < len(params); i++ {
+		if !given[i] && params[i].HasDefault() {
+			return nil, fmt.Errorf(`parameter %s has a default value, but it cannot be omitted because a later parameter is given`, params[i].Name)
+		}
+	}
+
+	keep := len(params)
+	for keep > last+1 && params[keep-1].HasDefault() {
+		keep--
+	}
+
+	for i, tuple := range tuples {
+		if len(tuple) > keep {
+			tuples[i] = tuple[:keep]
+		}
+	}
+
+	return tuples, nil
+}
+
 // getNamedParams gets the named parameters.
 // It returns the values, their positions in the action, and an error if any.
 func getNamedParams(actionNamedParams []NamedParameter, unparsedNamedValues []string) (names []string, values []any, positions []int, err error) {
@@ -113,7 +113,7 @@ func Test_CSVParams(t *testing.T) {
 				})
 			}
 
-			res, err := csvToParams(actionParams, tt.csv, tt.mapping, tt.namedParams)
+			res, _, err := csvToParams(actionParams, tt.csv, tt.mapping, tt.namedParams)
 			if tt.wantErr {
 				require.Error(t, err)
 				return
@@ -211,6 +211,65 @@ func Test_NamedParameter(t *testing.T) {
 	}
 }
 
+func Test_TrimDefaults(t *testing.T) {
+	param := func(name, def string) *types.ActionParameter {
+		return &types.ActionParameter{Name: name, Type: types.IntType, Default: def}
+	}
+	params := []*types.ActionParameter{param("$a", ""), param("$b", "2"), param("$c", "3")}
+
+	tests := []struct {
+		name  string
+		in    []any
+		given []bool
+		out   []any
+		err   bool
+	}{
+		{
+			name:  "all given",
+			in:    []any{1, 2, 3},
+			given: []bool{true, true, true},
+			out:   []any{1, 2, 3},
+		},
+		{
+			name:  "trailing defaults omitted",
+			in:    []any{1, nil, nil},
+			given: []bool{true, false, false},
+			out:   []any{1},
+		},
+		{
+			name:  "last default omitted",
+			in:    []any{1, 2, nil},
+			given: []bool{true, true, false},
+			out:   []any{1, 2},
+		},
+		{
+			name:  "required parameter omitted",
+			in:    []any{nil, 2},
+			given: []bool{false, true},
+			out:   []any{nil, 2},
+		},
+		{
+			name:  "default before a given parameter",
+			in:    []any{1, nil, 3},
+			given: []bool{true, false, true},
+			err:   true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			out, err := trimDefaults(params, [][]any{tt.in}, tt.given)
+			if tt.err {
+				require.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+
+			assert.Equal(t, tt.out, out[0])
+		})
+	}
+}
+
 func np(name, dt string) NamedParameter {
 	dt2, err := types.ParseDataType(dt)
 	if err != nil {
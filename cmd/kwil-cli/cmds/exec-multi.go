@@ -0,0 +1,194 @@
+package cmds
+
+import (
+	"context"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"math/big"
+	"os"
+	"strings"
+
+	"github.com/spf13/cobra"
+	"github.com/trufnetwork/kwil-db/app/shared/display"
+	"github.com/trufnetwork/kwil-db/cmd/kwil-cli/client"
+	"github.com/trufnetwork/kwil-db/cmd/kwil-cli/cmds/common"
+	"github.com/trufnetwork/kwil-db/cmd/kwil-cli/config"
+	"github.com/trufnetwork/kwil-db/cmd/kwil-cli/helpers"
+	kwilClient "github.com/trufnetwork/kwil-db/core/client"
+	clientType "github.com/trufnetwork/kwil-db/core/client/types"
+	"github.com/trufnetwork/kwil-db/core/crypto"
+	"github.com/trufnetwork/kwil-db/core/types"
+	"github.com/trufnetwork/kwil-db/node/engine/parse"
+)
+
+var (
+	execMultiLong = `Execute a list of operations atomically in one transaction.
+
+This command executes action calls, SQL statements, and transfers in the order given, in a single transaction.
+Either all of the operations take effect, or, if any of them fails, none of them do. The result of the
+transaction includes the result of each operation that was executed.
+
+The operations are read from a JSON file, or from stdin if the file is "-". The file holds a list of objects,
+each with a "type" of "execute", "raw_statement", or "transfer":
+
+  - "execute" calls the "action" in the "namespace" with the positional "inputs", each of format type:value.
+  - "raw_statement" executes the SQL "statement" with the named "params", each of format name:type=value.
+  - "transfer" transfers the "amount" to the account ID "to", which has key type "key_type" (default secp256k1).
+
+This command requires a private key, and it will author a transaction from the private key to the network.`
+
+	execMultiExample = `# Execute the operations in ops.json, which contains:
+# [
+#   {"type": "execute", "namespace": "main", "action": "register", "inputs": ["text:satoshi", "int:30"]},
+#   {"type": "raw_statement", "statement": "UPDATE users SET age = $age WHERE name = $name",
+#    "params": ["age:int=31", "name:text=satoshi"]},
+#   {"type": "transfer", "to": "0xc89D42189f0450C2b2c3c61f58Ec5d628176A1E7", "amount": "100"}
+# ]
+kwil-cli exec-multi ops.json`
+)
+
+// multiOperation is an operation of the exec-multi command. The fields that
+// are used depend on its type.
+type multiOperation struct {
+	Type types.PayloadType `json:"type"`
+
+	// execute
+	Namespace string   `json:"namespace"`
+	Action    string   `json:"action"`
+	Inputs    []string `json:"inputs"`
+
+	// raw_statement
+	Statement string   `json:"statement"`
+	Params    []string `json:"params"`
+
+	// transfer
+	To      string `json:"to"`
+	KeyType string `json:"key_type"`
+	Amount  string `json:"amount"`
+}
+
+// payload creates the transaction payload of the operation.
+func (op *multiOperation) payload() (types.Payload, error) {
+	switch op.Type {
+	case types.PayloadTypeExecute:
+		if op.Action == "" {
+			return nil, errors.New("no action provided")
+		}
+		inputs := make([]any, len(op.Inputs))
+		for i, p := range op.Inputs {
+			_, param, err := parseTypedParam(p)
+			if err != nil {
+				return nil, err
+			}
+			inputs[i] = param
+		}
+		return kwilClient.NewActionExecution(op.Namespace, op.Action, [][]any{inputs})
+
+	case types.PayloadTypeRawStatement:
+		if op.Statement == "" {
+			return nil, errors.New("no SQL statement provided")
+		}
+		if _, err := parse.Parse(op.Statement); err != nil {
+			return nil, fmt.Errorf("failed to parse SQL statement: %w", err)
+		}
+		params, err := parseParams(op.Params)
+		if err != nil {
+			return nil, err
+		}
+		return kwilClient.NewRawStatement(op.Statement, params)
+
+	case types.PayloadTypeTransfer:
+		amount, ok := big.NewInt(0).SetString(op.Amount, 10)
+		if !ok {
+			return nil, fmt.Errorf("invalid decimal amount %q", op.Amount)
+		}
+		id, err := hex.DecodeString(strings.TrimPrefix(op.To, "0x"))
+		if err != nil {
+			return nil, fmt.Errorf("failed to decode account ID: %w", err)
+		}
+		keyType := crypto.KeyTypeSecp256k1
+		if op.KeyType != "" {
+			keyType = crypto.KeyType(op.KeyType)
+		}
+		return &types.Transfer{
+			To:     &types.AccountID{Identifier: id, KeyType: keyType},
+			Amount: amount,
+		}, nil
+
+	default:
+		return nil, fmt.Errorf("unsupported operation type %q", op.Type)
+	}
+}
+
+// parseMultiOperations reads the JSON list of operations of the exec-multi
+// command, and creates their payloads.
+func parseMultiOperations(r io.Reader) ([]types.Payload, error) {
+	var ops []*multiOperation
+	if err := json.NewDecoder(r).Decode(&ops); err != nil {
+		return nil, fmt.Errorf("failed to decode operations: %w", err)
+	}
+	if len(ops) == 0 {
+		return nil, errors.New("no operations provided")
+	}
+
+	payloads := make([]types.Payload, len(ops))
+	for i, op := range ops {
+		var err error
+		payloads[i], err = op.payload()
+		if err != nil {
+			return nil, fmt.Errorf("operation %d: %w", i, err)
+		}
+	}
+
+	return payloads, nil
+}
+
+func execMultiCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "exec-multi <file>",
+		Short:   "Execute a list of operations atomically in one transaction.",
+		Long:    execMultiLong,
+		Example: execMultiExample,
+		Args:    cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			txFlags, err := common.GetTxFlags(cmd)
+			if err != nil {
+				return display.PrintErr(cmd, err)
+			}
+
+			r := cmd.InOrStdin()
+			if args[0] != "-" {
+				path, err := helpers.ExpandPath(args[0])
+				if err != nil {
+					return display.PrintErr(cmd, err)
+				}
+				file, err := os.Open(path)
+				if err != nil {
+					return display.PrintErr(cmd, err)
+				}
+				defer file.Close()
+				r = file
+			}
+
+			ops, err := parseMultiOperations(r)
+			if err != nil {
+				return display.PrintErr(cmd, err)
+			}
+
+			return client.DialClient(cmd.Context(), cmd, 0, func(ctx context.Context, cl clientType.Client, conf *config.KwilCliConfig) error {
+				txHash, err := cl.ExecuteMulti(ctx, ops, txFlags.Opts()...)
+				if err != nil {
+					return display.PrintErr(cmd, err)
+				}
+
+				return txFlags.DisplayTxResult(ctx, cl, txHash, cmd)
+			})
+		},
+	}
+
+	common.BindTxFlags(cmd)
+	return cmd
+}
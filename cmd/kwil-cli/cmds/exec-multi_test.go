@@ -0,0 +1,50 @@
+package cmds
+
+import (
+	"math/big"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"github.com/trufnetwork/kwil-db/core/crypto"
+	"github.com/trufnetwork/kwil-db/core/types"
+)
+
+func Test_ParseMultiOperations(t *testing.T) {
+	ops, err := parseMultiOperations(strings.NewReader(`[
+		{"type": "execute", "namespace": "main", "action": "register", "inputs": ["text:satoshi", "int:30"]},
+		{"type": "raw_statement", "statement": "UPDATE users SET age = $age;", "params": ["age:int=31"]},
+		{"type": "transfer", "to": "0x0102", "amount": "100"}
+	]`))
+	require.NoError(t, err)
+	require.Len(t, ops, 3)
+
+	exec, ok := ops[0].(*types.ActionExecution)
+	require.True(t, ok)
+	require.Equal(t, "main", exec.Namespace)
+	require.Equal(t, "register", exec.Action)
+	require.Len(t, exec.Arguments, 1)
+	require.Len(t, exec.Arguments[0], 2)
+
+	raw, ok := ops[1].(*types.RawStatement)
+	require.True(t, ok)
+	require.Len(t, raw.Parameters, 1)
+	require.Equal(t, "age", raw.Parameters[0].Name)
+
+	transfer, ok := ops[2].(*types.Transfer)
+	require.True(t, ok)
+	require.Equal(t, types.HexBytes{1, 2}, transfer.To.Identifier)
+	require.Equal(t, crypto.KeyTypeSecp256k1, transfer.To.KeyType)
+	require.Equal(t, big.NewInt(100), transfer.Amount)
+
+	for _, invalid := range []string{
+		`[]`,
+		`[{"type": "validator_leave"}]`,
+		`[{"type": "execute"}]`,
+		`[{"type": "raw_statement", "statement": "not sql"}]`,
+		`[{"type": "transfer", "to": "0x0102", "amount": "1.5"}]`,
+	} {
+		_, err = parseMultiOperations(strings.NewReader(invalid))
+		require.Error(t, err, invalid)
+	}
+}
@@ -94,12 +94,12 @@ func execSQLCmd() *cobra.Command {
 			}
 
 			return client.DialClient(cmd.Context(), cmd, 0, func(ctx context.Context, cl clientType.Client, conf *config.KwilCliConfig) error {
-				txHash, err := cl.ExecuteSQL(ctx, stmt, params, clientType.WithNonce(txFlags.NonceOverride), clientType.WithSyncBroadcast(txFlags.SyncBroadcast))
+				txHash, err := cl.ExecuteSQL(ctx, stmt, params, txFlags.Opts()...)
 				if err != nil {
 					return display.PrintErr(cmd, err)
 				}
 
-				return common.DisplayTxResult(ctx, cl, txHash, cmd)
+				return txFlags.DisplayTxResult(ctx, cl, txHash, cmd)
 			})
 		},
 	}
@@ -0,0 +1,41 @@
+// Package lsp implements a Language Server Protocol server for Kuneiform.
+package lsp
+
+import (
+	"os"
+
+	"github.com/spf13/cobra"
+)
+
+var (
+	lspLong = `Starts a Language Server Protocol server for Kuneiform, communicating over stdio.
+
+The server is meant to be started by an editor, and provides:
+
+  - diagnostics for syntax and type errors, from the same checks as ` + "`kwil-cli utils lint`" + `
+  - go-to-definition for tables, columns, actions, and action parameters
+  - hover with the types of tables, columns, actions, variables, and functions
+  - completion of built-in functions, tables, columns, actions, and extension methods
+
+All open documents are analyzed together, so tables and actions declared in one
+file can be referenced from any other. The server does not connect to a node.`
+
+	lspExample = `# Configure your editor to start the language server for .sql and .kf files
+kwil-cli lsp`
+)
+
+// NewCmdLSP creates the command that runs the language server.
+func NewCmdLSP() *cobra.Command {
+	return &cobra.Command{
+		Use:     "lsp",
+		Short:   "Starts a Kuneiform language server over stdio.",
+		Long:    lspLong,
+		Example: lspExample,
+		Args:    cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			// stdout is used for protocol messages, so nothing else may be
+			// printed to it.
+			return NewServer(os.Stdin, os.Stdout).Serve(cmd.Context())
+		},
+	}
+}
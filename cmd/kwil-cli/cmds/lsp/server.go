@@ -0,0 +1,263 @@
+package lsp
+
+import (
+	"bufio"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/textproto"
+	"sort"
+	"strconv"
+	"strings"
+
+	jsonrpc "github.com/trufnetwork/kwil-db/core/rpc/json"
+)
+
+// Server is a Language Server Protocol server for Kuneiform. It
+// communicates using JSON-RPC 2.0 messages framed with Content-Length
+// headers, as described in the LSP base protocol.
+type Server struct {
+	r *bufio.Reader
+	w io.Writer
+
+	ws       *workspace
+	shutdown bool
+}
+
+// NewServer creates a new language server that reads requests from r
+// and writes responses and notifications to w.
+func NewServer(r io.Reader, w io.Writer) *Server {
+	return &Server{
+		r:  bufio.NewReader(r),
+		w:  w,
+		ws: newWorkspace(),
+	}
+}
+
+// Serve handles messages until the client sends an exit notification,
+// the input is closed, or the context is cancelled.
+func (s *Server) Serve(ctx context.Context) error {
+	for {
+		if err := ctx.Err(); err != nil {
+			return err
+		}
+
+		msg, err := s.readMessage()
+		if err != nil {
+			if errors.Is(err, io.EOF) {
+				return nil
+			}
+			return err
+		}
+
+		var req jsonrpc.Request
+		if err := json.Unmarshal(msg, &req); err != nil {
+			if err := s.writeError(nil, jsonrpc.ErrorParse, err.Error()); err != nil {
+				return err
+			}
+			continue
+		}
+
+		if req.Method == methodExit {
+			return nil
+		}
+
+		result, rpcErr := s.handle(&req)
+		if req.ID == nil {
+			continue // notifications do not get a response
+		}
+
+		if rpcErr != nil {
+			err = s.write(jsonrpc.NewErrorResponse(req.ID, rpcErr))
+		} else {
+			err = s.writeResult(req.ID, result)
+		}
+		if err != nil {
+			return err
+		}
+	}
+}
+
+// handle dispatches a request or notification to its handler.
+func (s *Server) handle(req *jsonrpc.Request) (any, *jsonrpc.Error) {
+	if s.shutdown && req.ID != nil {
+		return nil, jsonrpc.NewError(jsonrpc.ErrorInvalidRequest, "server is shutting down", nil)
+	}
+
+	switch req.Method {
+	case methodInitialize:
+		return &initializeResult{
+			Capabilities: serverCapabilities{
+				TextDocumentSync:   textDocumentSyncFull,
+				HoverProvider:      true,
+				DefinitionProvider: true,
+				CompletionProvider: &completionOptions{
+					TriggerCharacters: []string{".", "$", "@"},
+				},
+			},
+			ServerInfo: &serverInfo{Name: "kuneiform-lsp"},
+		}, nil
+	case methodInitialized:
+		return nil, nil
+	case methodShutdown:
+		s.shutdown = true
+		return nil, nil
+	case methodDidOpen:
+		var params didOpenParams
+		if err := json.Unmarshal(req.Params, &params); err != nil {
+			return nil, invalidParams(err)
+		}
+		s.ws.open(params.TextDocument.URI, params.TextDocument.Text)
+		return nil, s.publishDiagnostics()
+	case methodDidChange:
+		var params didChangeParams
+		if err := json.Unmarshal(req.Params, &params); err != nil {
+			return nil, invalidParams(err)
+		}
+		if len(params.ContentChanges) == 0 {
+			return nil, nil
+		}
+		// with full sync, the last change contains the whole document
+		s.ws.open(params.TextDocument.URI, params.ContentChanges[len(params.ContentChanges)-1].Text)
+		return nil, s.publishDiagnostics()
+	case methodDidClose:
+		var params didCloseParams
+		if err := json.Unmarshal(req.Params, &params); err != nil {
+			return nil, invalidParams(err)
+		}
+		s.ws.close(params.TextDocument.URI)
+		// clear the diagnostics of the closed document
+		if err := s.notify(methodPublishDiagnostics, &publishDiagnosticsParams{
+			URI:         params.TextDocument.URI,
+			Diagnostics: []diagnostic{},
+		}); err != nil {
+			return nil, jsonrpc.NewError(jsonrpc.ErrorInternal, err.Error(), nil)
+		}
+		return nil, s.publishDiagnostics()
+	case methodDefinition:
+		var params textDocumentPositionParams
+		if err := json.Unmarshal(req.Params, &params); err != nil {
+			return nil, invalidParams(err)
+		}
+		loc := s.ws.definition(params.TextDocument.URI, params.Position)
+		if loc == nil {
+			return nil, nil
+		}
+		return loc, nil
+	case methodHover:
+		var params textDocumentPositionParams
+		if err := json.Unmarshal(req.Params, &params); err != nil {
+			return nil, invalidParams(err)
+		}
+		text := s.ws.hover(params.TextDocument.URI, params.Position)
+		if text == "" {
+			return nil, nil
+		}
+		return &hover{Contents: markupContent{Kind: "markdown", Value: text}}, nil
+	case methodCompletion:
+		var params textDocumentPositionParams
+		if err := json.Unmarshal(req.Params, &params); err != nil {
+			return nil, invalidParams(err)
+		}
+		return &completionList{Items: s.ws.complete(params.TextDocument.URI, params.Position)}, nil
+	}
+
+	// unknown notifications (e.g. $/cancelRequest) are ignored
+	if req.ID == nil {
+		return nil, nil
+	}
+	return nil, jsonrpc.NewError(jsonrpc.ErrorUnknownMethod, "unknown method: "+req.Method, nil)
+}
+
+func invalidParams(err error) *jsonrpc.Error {
+	return jsonrpc.NewError(jsonrpc.ErrorInvalidParams, err.Error(), nil)
+}
+
+// publishDiagnostics lints all open documents together and sends the
+// diagnostics for each of them.
+func (s *Server) publishDiagnostics() *jsonrpc.Error {
+	diags := s.ws.diagnostics()
+
+	uris := make([]string, 0, len(diags))
+	for uri := range diags {
+		uris = append(uris, uri)
+	}
+	sort.Strings(uris)
+
+	for _, uri := range uris {
+		if err := s.notify(methodPublishDiagnostics, &publishDiagnosticsParams{
+			URI:         uri,
+			Diagnostics: diags[uri],
+		}); err != nil {
+			return jsonrpc.NewError(jsonrpc.ErrorInternal, err.Error(), nil)
+		}
+	}
+	return nil
+}
+
+// readMessage reads a single Content-Length framed message.
+func (s *Server) readMessage() ([]byte, error) {
+	header, err := textproto.NewReader(s.r).ReadMIMEHeader()
+	if err != nil {
+		return nil, err
+	}
+
+	length, err := strconv.Atoi(strings.TrimSpace(header.Get("Content-Length")))
+	if err != nil {
+		return nil, fmt.Errorf("invalid Content-Length header: %w", err)
+	}
+
+	msg := make([]byte, length)
+	if _, err := io.ReadFull(s.r, msg); err != nil {
+		return nil, err
+	}
+	return msg, nil
+}
+
+// writeResult writes a successful response. Unlike jsonrpc.NewResponse,
+// it allows a zero ID, which some clients use for their first request.
+func (s *Server) writeResult(id any, result any) error {
+	bts, err := json.Marshal(result)
+	if err != nil {
+		return s.writeError(id, jsonrpc.ErrorInternal, err.Error())
+	}
+
+	return s.write(&jsonrpc.Response{
+		JSONRPC: "2.0",
+		ID:      id,
+		Result:  bts,
+	})
+}
+
+func (s *Server) writeError(id any, code jsonrpc.ErrorCode, msg string) error {
+	return s.write(jsonrpc.NewErrorResponse(id, jsonrpc.NewError(code, msg, nil)))
+}
+
+// notify sends a notification to the client.
+func (s *Server) notify(method string, params any) error {
+	bts, err := json.Marshal(params)
+	if err != nil {
+		return err
+	}
+
+	return s.write(&jsonrpc.Request{
+		JSONRPC: "2.0",
+		Method:  method,
+		Params:  bts,
+	})
+}
+
+func (s *Server) write(msg any) error {
+	bts, err := json.Marshal(msg)
+	if err != nil {
+		return err
+	}
+
+	if _, err := fmt.Fprintf(s.w, "Content-Length: %d\r\n\r\n", len(bts)); err != nil {
+		return err
+	}
+	_, err = s.w.Write(bts)
+	return err
+}
@@ -0,0 +1,207 @@
+package lsp
+
+import (
+	"bufio"
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/textproto"
+	"strconv"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	jsonrpc "github.com/trufnetwork/kwil-db/core/rpc/json"
+)
+
+const testDoc = `CREATE TABLE users (
+	id INT PRIMARY KEY,
+	name TEXT NOT NULL
+);
+
+CREATE ACTION get_name($id int) public view returns (name text) {
+	for $row in SELECT u.name FROM users AS u WHERE id = $id ORDER BY name {
+		return $row.name;
+	}
+	error('not found');
+};
+`
+
+// session builds the input of a client session.
+type session struct {
+	buf    bytes.Buffer
+	nextID int
+}
+
+func (s *session) send(id any, method string, params any) {
+	bts, err := json.Marshal(params)
+	if err != nil {
+		panic(err)
+	}
+	msg, err := json.Marshal(&jsonrpc.Request{JSONRPC: "2.0", ID: id, Method: method, Params: bts})
+	if err != nil {
+		panic(err)
+	}
+	fmt.Fprintf(&s.buf, "Content-Length: %d\r\n\r\n%s", len(msg), msg)
+}
+
+// request sends a request and returns its ID.
+func (s *session) request(method string, params any) int {
+	id := s.nextID
+	s.nextID++
+	s.send(id, method, params)
+	return id
+}
+
+func (s *session) notify(method string, params any) {
+	s.send(nil, method, params)
+}
+
+// message is a response or notification sent by the server.
+type message struct {
+	ID     any             `json:"id"`
+	Method string          `json:"method"`
+	Params json.RawMessage `json:"params"`
+	Result json.RawMessage `json:"result"`
+	Error  *jsonrpc.Error  `json:"error"`
+}
+
+// run runs the session against a server, returning the responses by ID
+// and the notifications in order.
+func (s *session) run(t *testing.T) (map[int]*message, []*message) {
+	s.notify(methodExit, nil)
+
+	var out bytes.Buffer
+	require.NoError(t, NewServer(&s.buf, &out).Serve(context.Background()))
+
+	responses := make(map[int]*message)
+	var notifications []*message
+
+	r := bufio.NewReader(&out)
+	for {
+		header, err := textproto.NewReader(r).ReadMIMEHeader()
+		if err == io.EOF {
+			break
+		}
+		require.NoError(t, err)
+
+		length, err := strconv.Atoi(header.Get("Content-Length"))
+		require.NoError(t, err)
+
+		bts := make([]byte, length)
+		_, err = io.ReadFull(r, bts)
+		require.NoError(t, err)
+
+		var msg message
+		require.NoError(t, json.Unmarshal(bts, &msg))
+		if msg.ID == nil {
+			notifications = append(notifications, &msg)
+		} else {
+			responses[int(msg.ID.(float64))] = &msg
+		}
+	}
+
+	return responses, notifications
+}
+
+func positionParams(uri string, line, char int) *textDocumentPositionParams {
+	return &textDocumentPositionParams{
+		TextDocument: textDocumentIdentifier{URI: uri},
+		Position:     position{Line: line, Character: char},
+	}
+}
+
+func Test_Server(t *testing.T) {
+	const uri = "file:///schema.sql"
+
+	s := &session{}
+	initID := s.request(methodInitialize, map[string]any{})
+	s.notify(methodInitialized, map[string]any{})
+	s.notify(methodDidOpen, &didOpenParams{TextDocument: textDocumentItem{URI: uri, LanguageID: "sql", Text: testDoc}})
+
+	tableDef := s.request(methodDefinition, positionParams(uri, 6, 36))  // users
+	columnDef := s.request(methodDefinition, positionParams(uri, 6, 23)) // u.name
+	hoverAction := s.request(methodHover, positionParams(uri, 5, 15))    // get_name
+	hoverParam := s.request(methodHover, positionParams(uri, 6, 55))     // $id
+	hoverFunc := s.request(methodHover, positionParams(uri, 9, 2))       // error
+	completeAll := s.request(methodCompletion, positionParams(uri, 9, 0))
+	completeCol := s.request(methodCompletion, positionParams(uri, 6, 22)) // u.
+	unknown := s.request("textDocument/rename", map[string]any{})
+
+	// introduce a type error
+	s.notify(methodDidChange, map[string]any{
+		"textDocument":   textDocumentIdentifier{URI: uri},
+		"contentChanges": []map[string]string{{"text": testDoc + "\nSELECT * FROM users WHERE id = 'a';"}},
+	})
+	shutdownID := s.request(methodShutdown, nil)
+
+	responses, notifications := s.run(t)
+
+	var initRes initializeResult
+	require.NoError(t, json.Unmarshal(responses[initID].Result, &initRes))
+	require.True(t, initRes.Capabilities.HoverProvider)
+	require.True(t, initRes.Capabilities.DefinitionProvider)
+
+	var loc location
+	require.NoError(t, json.Unmarshal(responses[tableDef].Result, &loc))
+	require.Equal(t, lspRange{Start: position{0, 13}, End: position{0, 18}}, loc.Range)
+
+	require.NoError(t, json.Unmarshal(responses[columnDef].Result, &loc))
+	require.Equal(t, lspRange{Start: position{2, 1}, End: position{2, 5}}, loc.Range)
+
+	hoverValue := func(id int) string {
+		var h hover
+		require.NoError(t, json.Unmarshal(responses[id].Result, &h))
+		return h.Contents.Value
+	}
+	require.Contains(t, hoverValue(hoverAction), "ACTION get_name($id int8) PUBLIC VIEW RETURNS (name text)")
+	require.Contains(t, hoverValue(hoverParam), "$id int8")
+	require.Contains(t, hoverValue(hoverFunc), "scalar function error")
+
+	labels := func(id int) []string {
+		var list completionList
+		require.NoError(t, json.Unmarshal(responses[id].Result, &list))
+		var labels []string
+		for _, item := range list.Items {
+			labels = append(labels, item.Label)
+		}
+		return labels
+	}
+	all := labels(completeAll)
+	require.Contains(t, all, "users")
+	require.Contains(t, all, "get_name")
+	require.Contains(t, all, "abs")
+	require.Equal(t, []string{"id", "name"}, labels(completeCol))
+
+	require.NotNil(t, responses[unknown].Error)
+	require.EqualValues(t, jsonrpc.ErrorUnknownMethod, responses[unknown].Error.Code)
+
+	require.Contains(t, responses, shutdownID)
+
+	// the first diagnostics are empty, and the second contain the type error
+	require.Len(t, notifications, 2)
+	var diags publishDiagnosticsParams
+	require.NoError(t, json.Unmarshal(notifications[0].Params, &diags))
+	require.Equal(t, uri, diags.URI)
+	require.Empty(t, diags.Diagnostics)
+
+	require.NoError(t, json.Unmarshal(notifications[1].Params, &diags))
+	require.Len(t, diags.Diagnostics, 1)
+	require.Equal(t, "type-mismatch", diags.Diagnostics[0].Code)
+	require.Equal(t, 12, diags.Diagnostics[0].Range.Start.Line)
+}
+
+func Test_ServerLeadingWhitespace(t *testing.T) {
+	const uri = "file:///schema.sql"
+
+	s := &session{}
+	s.notify(methodDidOpen, &didOpenParams{TextDocument: textDocumentItem{URI: uri, Text: "\n\n" + testDoc}})
+	def := s.request(methodDefinition, positionParams(uri, 8, 36)) // users
+
+	responses, _ := s.run(t)
+
+	var loc location
+	require.NoError(t, json.Unmarshal(responses[def].Result, &loc))
+	require.Equal(t, lspRange{Start: position{2, 13}, End: position{2, 18}}, loc.Range)
+}
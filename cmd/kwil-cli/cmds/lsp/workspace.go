@@ -0,0 +1,728 @@
+package lsp
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/trufnetwork/kwil-db/common"
+	"github.com/trufnetwork/kwil-db/core/log"
+	"github.com/trufnetwork/kwil-db/extensions/precompiles"
+	"github.com/trufnetwork/kwil-db/node/engine"
+	"github.com/trufnetwork/kwil-db/node/engine/lint"
+	"github.com/trufnetwork/kwil-db/node/engine/parse"
+)
+
+// document is an open Kuneiform document.
+type document struct {
+	uri   string
+	text  string
+	lines []string
+	// stmts are the statements of the last version of the document that
+	// parsed without errors. They are kept while the user is typing, so
+	// that navigation keeps working in a document that is being edited.
+	stmts []parse.TopLevelStatement
+	// lineOffset and colOffset are the whitespace trimmed from the start
+	// of the document by the parser, which AST positions do not include.
+	lineOffset, colOffset int
+}
+
+// workspace holds all open documents. They are analyzed together, the
+// same way that `kwil-cli utils lint` analyzes all files passed to it.
+type workspace struct {
+	docs map[string]*document
+	// methods caches the methods of initialized extensions, keyed by
+	// the extension name and its configuration.
+	methods map[string][]precompiles.Method
+}
+
+func newWorkspace() *workspace {
+	return &workspace{
+		docs:    make(map[string]*document),
+		methods: make(map[string][]precompiles.Method),
+	}
+}
+
+func (w *workspace) open(uri, text string) {
+	doc, ok := w.docs[uri]
+	if !ok {
+		doc = &document{uri: uri}
+		w.docs[uri] = doc
+	}
+
+	doc.text = text
+	doc.lines = strings.Split(text, "\n")
+
+	res, err := parse.ParseWithErrListener(text)
+	if err == nil && res.ParseErrs.Err() == nil {
+		doc.stmts = res.Statements
+		doc.lineOffset, doc.colOffset = parse.TrimmedOffset(text)
+	}
+}
+
+func (w *workspace) close(uri string) {
+	delete(w.docs, uri)
+}
+
+// sorted returns the open documents, sorted by URI.
+func (w *workspace) sorted() []*document {
+	docs := make([]*document, 0, len(w.docs))
+	for _, doc := range w.docs {
+		docs = append(docs, doc)
+	}
+	sort.Slice(docs, func(i, j int) bool { return docs[i].uri < docs[j].uri })
+	return docs
+}
+
+// diagnostics lints all open documents, returning the diagnostics for
+// each of them. Every open document has an entry, so that diagnostics
+// that were fixed are cleared.
+func (w *workspace) diagnostics() map[string][]diagnostic {
+	docs := w.sorted()
+	files := make([]*lint.File, len(docs))
+	res := make(map[string][]diagnostic, len(docs))
+	for i, doc := range docs {
+		files[i] = &lint.File{Name: doc.uri, Source: doc.text}
+		res[doc.uri] = []diagnostic{}
+	}
+
+	for _, d := range lint.Lint(files...) {
+		doc, ok := w.docs[d.File]
+		if !ok {
+			continue
+		}
+
+		sev := severityError
+		if d.Severity == lint.SeverityWarning {
+			sev = severityWarning
+		}
+
+		// lint lines and columns are 1-indexed, and 0 if unknown
+		var rng lspRange
+		if d.Line > 0 {
+			rng.Start = position{Line: d.Line - 1, Character: d.Column - 1}
+			rng.End = rng.Start
+			if d.EndLine > 0 {
+				rng.End = position{Line: d.EndLine - 1, Character: d.EndColumn - 1}
+			}
+			// the end column is the start of the last token, so we extend
+			// the range to the end of that token.
+			rng.End.Character = doc.wordEnd(rng.End)
+		}
+
+		res[d.File] = append(res[d.File], diagnostic{
+			Range:    rng,
+			Severity: sev,
+			Code:     string(d.Rule),
+			Source:   "kwil-lint",
+			Message:  d.Message,
+		})
+	}
+
+	return res
+}
+
+// symbol is something that can be navigated to or hovered over.
+type symbol struct {
+	// loc is the location of the symbol's declaration. It is nil for
+	// symbols that are not declared in a document, like built-in functions.
+	loc *location
+	// detail is a short description of the symbol, e.g. its signature.
+	detail string
+}
+
+func (w *workspace) definition(uri string, pos position) *location {
+	sym := w.resolve(uri, pos)
+	if sym == nil {
+		return nil
+	}
+	return sym.loc
+}
+
+func (w *workspace) hover(uri string, pos position) string {
+	sym := w.resolve(uri, pos)
+	if sym == nil || sym.detail == "" {
+		return ""
+	}
+	return "```sql\n" + sym.detail + "\n```"
+}
+
+// resolve finds the symbol at the given position.
+func (w *workspace) resolve(uri string, pos position) *symbol {
+	doc, ok := w.docs[uri]
+	if !ok {
+		return nil
+	}
+
+	word, qualifier := doc.wordAt(pos)
+	if word == "" {
+		return nil
+	}
+	name := strings.ToLower(word)
+	stmt := doc.statementAt(pos)
+
+	switch word[0] {
+	case '$':
+		action, ok := stmt.(*parse.CreateActionStatement)
+		if !ok {
+			return nil
+		}
+		for _, param := range action.Parameters {
+			if param.Name == name {
+				return &symbol{
+					loc:    doc.find(action, name),
+					detail: param.Name + " " + param.Type.String(),
+				}
+			}
+		}
+		return nil
+	case '@':
+		if typ, ok := lint.ContextualVariables()[name]; ok {
+			return &symbol{detail: name + " " + typ.String()}
+		}
+		return nil
+	}
+
+	if qualifier != "" {
+		qualifier = strings.ToLower(qualifier)
+
+		if methods, ok := w.extensionMethods(qualifier); ok {
+			for _, m := range methods {
+				if strings.EqualFold(m.Name, name) {
+					return &symbol{detail: methodSignature(qualifier, &m)}
+				}
+			}
+			return nil
+		}
+
+		table := qualifier
+		if stmt != nil {
+			if t, ok := tableAliases(stmt)[qualifier]; ok {
+				table = t
+			}
+		}
+		if sym := w.column(table, name); sym != nil {
+			return sym
+		}
+
+		// the qualifier might be a namespace
+		if sym := w.actionSymbol(name); sym != nil {
+			return sym
+		}
+		return w.tableSymbol(name)
+	}
+
+	if sym := w.tableSymbol(name); sym != nil {
+		return sym
+	}
+
+	if sym := w.actionSymbol(name); sym != nil {
+		return sym
+	}
+
+	if fn, ok := engine.Functions[name]; ok {
+		return &symbol{detail: functionKind(fn) + " function " + name}
+	}
+
+	// finally, we look for a column in the tables referenced by the
+	// statement, and then in all tables.
+	if stmt != nil {
+		aliases := tableAliases(stmt)
+		tables := make([]string, 0, len(aliases))
+		for _, table := range aliases {
+			tables = append(tables, table)
+		}
+		sort.Strings(tables)
+
+		for _, table := range tables {
+			if sym := w.column(table, name); sym != nil {
+				return sym
+			}
+		}
+	}
+	for _, doc := range w.sorted() {
+		for _, stmt := range doc.stmts {
+			if ct, ok := stmt.(*parse.CreateTableStatement); ok {
+				if sym := w.column(ct.Name, name); sym != nil {
+					return sym
+				}
+			}
+		}
+	}
+
+	return nil
+}
+
+// complete returns the completion items for the given position.
+func (w *workspace) complete(uri string, pos position) []completionItem {
+	doc, ok := w.docs[uri]
+	if !ok {
+		return nil
+	}
+
+	word, qualifier := doc.wordAt(pos)
+	items := []completionItem{}
+
+	if qualifier != "" {
+		qualifier = strings.ToLower(qualifier)
+
+		if methods, ok := w.extensionMethods(qualifier); ok {
+			for _, m := range methods {
+				items = append(items, completionItem{
+					Label:  m.Name,
+					Kind:   completionKindMethod,
+					Detail: methodSignature(qualifier, &m),
+				})
+			}
+			return items
+		}
+
+		table := qualifier
+		if stmt := doc.statementAt(pos); stmt != nil {
+			if t, ok := tableAliases(stmt)[qualifier]; ok {
+				table = t
+			}
+		}
+		if _, ct := w.table(table); ct != nil {
+			for _, col := range ct.Columns {
+				items = append(items, completionItem{
+					Label:  col.Name,
+					Kind:   completionKindField,
+					Detail: col.Type.String(),
+				})
+			}
+		}
+		return items
+	}
+
+	if strings.HasPrefix(word, "$") {
+		if action, ok := doc.statementAt(pos).(*parse.CreateActionStatement); ok {
+			for _, param := range action.Parameters {
+				items = append(items, completionItem{
+					Label:  param.Name,
+					Kind:   completionKindVariable,
+					Detail: param.Type.String(),
+				})
+			}
+		}
+		return items
+	}
+
+	if strings.HasPrefix(word, "@") {
+		for name, typ := range lint.ContextualVariables() {
+			items = append(items, completionItem{
+				Label:  name,
+				Kind:   completionKindVariable,
+				Detail: typ.String(),
+			})
+		}
+		sortItems(items)
+		return items
+	}
+
+	for name, fn := range engine.Functions {
+		items = append(items, completionItem{
+			Label:  name,
+			Kind:   completionKindFunction,
+			Detail: functionKind(fn) + " function",
+		})
+	}
+
+	for _, doc := range w.sorted() {
+		for _, stmt := range doc.stmts {
+			switch s := stmt.(type) {
+			case *parse.CreateTableStatement:
+				items = append(items, completionItem{
+					Label:  s.Name,
+					Kind:   completionKindClass,
+					Detail: "table",
+				})
+			case *parse.CreateActionStatement:
+				items = append(items, completionItem{
+					Label:  s.Name,
+					Kind:   completionKindFunction,
+					Detail: actionSignature(s),
+				})
+			case *parse.CreateTypeStatement:
+				kind := completionKindStruct
+				if s.IsEnum() {
+					kind = completionKindEnum
+				}
+				items = append(items, completionItem{
+					Label:  s.Name,
+					Kind:   kind,
+					Detail: "type",
+				})
+			case *parse.UseExtensionStatement:
+				items = append(items, completionItem{
+					Label:  s.Alias,
+					Kind:   completionKindVariable,
+					Detail: "extension " + s.ExtName,
+				})
+			}
+		}
+	}
+
+	sortItems(items)
+	return items
+}
+
+func sortItems(items []completionItem) {
+	sort.SliceStable(items, func(i, j int) bool { return items[i].Label < items[j].Label })
+}
+
+// table finds the CREATE TABLE statement for a table.
+func (w *workspace) table(name string) (*document, *parse.CreateTableStatement) {
+	for _, doc := range w.sorted() {
+		for _, stmt := range doc.stmts {
+			if ct, ok := stmt.(*parse.CreateTableStatement); ok && strings.EqualFold(ct.Name, name) {
+				return doc, ct
+			}
+		}
+	}
+	return nil, nil
+}
+
+func (w *workspace) tableSymbol(name string) *symbol {
+	doc, ct := w.table(name)
+	if ct == nil {
+		return nil
+	}
+
+	var sb strings.Builder
+	fmt.Fprintf(&sb, "TABLE %s (", ct.Name)
+	for i, col := range ct.Columns {
+		if i > 0 {
+			sb.WriteString(",")
+		}
+		fmt.Fprintf(&sb, "\n    %s %s", col.Name, col.Type.String())
+	}
+	sb.WriteString("\n)")
+
+	return &symbol{
+		loc:    doc.find(ct, ct.Name),
+		detail: sb.String(),
+	}
+}
+
+func (w *workspace) column(table, name string) *symbol {
+	doc, ct := w.table(table)
+	if ct == nil {
+		return nil
+	}
+
+	for _, col := range ct.Columns {
+		if strings.EqualFold(col.Name, name) {
+			return &symbol{
+				loc:    doc.find(col, col.Name),
+				detail: fmt.Sprintf("%s.%s %s", ct.Name, col.Name, col.Type.String()),
+			}
+		}
+	}
+	return nil
+}
+
+func (w *workspace) actionSymbol(name string) *symbol {
+	for _, doc := range w.sorted() {
+		for _, stmt := range doc.stmts {
+			if ca, ok := stmt.(*parse.CreateActionStatement); ok && strings.EqualFold(ca.Name, name) {
+				return &symbol{
+					loc:    doc.find(ca, ca.Name),
+					detail: actionSignature(ca),
+				}
+			}
+		}
+	}
+	return nil
+}
+
+// extensionMethods returns the methods of the extension used with the
+// given alias. It returns false if no extension is used with the alias.
+func (w *workspace) extensionMethods(alias string) ([]precompiles.Method, bool) {
+	var use *parse.UseExtensionStatement
+	for _, doc := range w.sorted() {
+		for _, stmt := range doc.stmts {
+			if u, ok := stmt.(*parse.UseExtensionStatement); ok && strings.EqualFold(u.Alias, alias) {
+				use = u
+			}
+		}
+	}
+	if use == nil {
+		return nil, false
+	}
+
+	metadata := make(map[string]any, len(use.Config))
+	for _, kv := range use.Config {
+		// only literal values can be known without a node
+		if lit, ok := kv.Value.(*parse.ExpressionLiteral); ok {
+			metadata[kv.Key] = lit.Value
+		}
+	}
+
+	key := fmt.Sprintf("%s %v", use.ExtName, metadata)
+	if methods, ok := w.methods[key]; ok {
+		return methods, true
+	}
+
+	methods := initializeExtension(use.ExtName, alias, metadata)
+	w.methods[key] = methods
+	return methods, true
+}
+
+// initializeExtension initializes an extension without a database to
+// discover its methods. Extensions that need a database or a node to
+// initialize will fail, in which case no methods are returned.
+func initializeExtension(name, alias string, metadata map[string]any) (methods []precompiles.Method) {
+	initializer, ok := precompiles.RegisteredPrecompiles()[strings.ToLower(name)]
+	if !ok {
+		return nil
+	}
+
+	defer func() {
+		if r := recover(); r != nil {
+			methods = nil
+		}
+	}()
+
+	precompile, err := initializer(context.Background(), &common.Service{Logger: log.DiscardLogger}, nil, alias, metadata)
+	if err != nil {
+		return nil
+	}
+	return precompile.Methods
+}
+
+func functionKind(fn engine.FunctionDefinition) string {
+	switch fn.(type) {
+	case *engine.AggregateFunctionDefinition:
+		return "aggregate"
+	case *engine.WindowFunctionDefinition:
+		return "window"
+	default:
+		return "scalar"
+	}
+}
+
+func actionSignature(ca *parse.CreateActionStatement) string {
+	var sb strings.Builder
+	fmt.Fprintf(&sb, "ACTION %s(", ca.Name)
+	for i, param := range ca.Parameters {
+		if i > 0 {
+			sb.WriteString(", ")
+		}
+		fmt.Fprintf(&sb, "%s %s", param.Name, param.Type.String())
+	}
+	sb.WriteString(")")
+
+	for _, mod := range ca.Modifiers {
+		sb.WriteString(" ")
+		sb.WriteString(strings.ToUpper(mod))
+	}
+
+	if ca.Returns != nil {
+		sb.WriteString(" RETURNS ")
+		if ca.Returns.IsTable {
+			sb.WriteString("TABLE ")
+		}
+		sb.WriteString("(")
+		for i, field := range ca.Returns.Fields {
+			if i > 0 {
+				sb.WriteString(", ")
+			}
+			fmt.Fprintf(&sb, "%s %s", field.Name, field.Type.String())
+		}
+		sb.WriteString(")")
+	}
+
+	return sb.String()
+}
+
+func methodSignature(alias string, m *precompiles.Method) string {
+	var sb strings.Builder
+	fmt.Fprintf(&sb, "%s.%s(", alias, m.Name)
+	for i, param := range m.Parameters {
+		if i > 0 {
+			sb.WriteString(", ")
+		}
+		fmt.Fprintf(&sb, "%s %s", param.Name, param.Type.String())
+	}
+	sb.WriteString(")")
+
+	if m.Returns != nil {
+		sb.WriteString(" RETURNS ")
+		if m.Returns.IsTable {
+			sb.WriteString("TABLE ")
+		}
+		sb.WriteString("(")
+		for i, field := range m.Returns.Fields {
+			if i > 0 {
+				sb.WriteString(", ")
+			}
+			fmt.Fprintf(&sb, "%s %s", field.Name, field.Type.String())
+		}
+		sb.WriteString(")")
+	}
+
+	return sb.String()
+}
+
+// tableAliases maps the names and aliases of all tables referenced in a
+// statement to the table name.
+func tableAliases(stmt parse.TopLevelStatement) map[string]string {
+	aliases := make(map[string]string)
+	add := func(table, alias string) {
+		table = strings.ToLower(table)
+		aliases[table] = table
+		if alias != "" {
+			aliases[strings.ToLower(alias)] = table
+		}
+	}
+
+	visitNodes(stmt, func(gp parse.GetPositioner) {
+		switch n := gp.(type) {
+		case *parse.CreateTableStatement:
+			add(n.Name, "")
+		case *parse.RelationTable:
+			add(n.Table, n.Alias)
+		case *parse.InsertStatement:
+			add(n.Table, n.Alias)
+		case *parse.UpdateStatement:
+			add(n.Table, n.Alias)
+		case *parse.DeleteStatement:
+			add(n.Table, n.Alias)
+		}
+	})
+	return aliases
+}
+
+// visitNodes calls fn once for every node in the tree.
+// parse.RecursivelyVisitPositions can visit nodes held in interface
+// fields more than once.
+func visitNodes(node any, fn func(parse.GetPositioner)) {
+	seen := make(map[parse.GetPositioner]struct{})
+	parse.RecursivelyVisitPositions(node, func(gp parse.GetPositioner) {
+		if _, ok := seen[gp]; ok {
+			return
+		}
+		seen[gp] = struct{}{}
+		fn(gp)
+	})
+}
+
+// statementAt returns the top-level statement containing the position.
+func (d *document) statementAt(pos position) parse.TopLevelStatement {
+	line := pos.Line + 1 - d.lineOffset // antlr lines are 1-indexed
+	for _, stmt := range d.stmts {
+		p := stmt.GetPosition()
+		if p.StartLine != nil && p.EndLine != nil && *p.StartLine <= line && line <= *p.EndLine {
+			return stmt
+		}
+	}
+	return nil
+}
+
+func isWordChar(c byte) bool {
+	return c == '_' || ('a' <= c && c <= 'z') || ('A' <= c && c <= 'Z') || ('0' <= c && c <= '9')
+}
+
+// wordAt returns the identifier at the position, including a leading $ or
+// @, and the identifier before it if the two are separated by a dot. The
+// position may be at the end of the identifier, as it is when completing.
+func (d *document) wordAt(pos position) (word, qualifier string) {
+	if pos.Line < 0 || pos.Line >= len(d.lines) {
+		return "", ""
+	}
+	line := d.lines[pos.Line]
+
+	end := min(max(pos.Character, 0), len(line))
+	for end < len(line) && isWordChar(line[end]) {
+		end++
+	}
+	start := min(max(pos.Character, 0), len(line))
+	for start > 0 && isWordChar(line[start-1]) {
+		start--
+	}
+	if start > 0 && (line[start-1] == '$' || line[start-1] == '@') {
+		start--
+	}
+	word = line[start:end]
+
+	if start > 0 && line[start-1] == '.' {
+		qEnd := start - 1
+		qStart := qEnd
+		for qStart > 0 && isWordChar(line[qStart-1]) {
+			qStart--
+		}
+		if qStart > 0 && line[qStart-1] == '$' {
+			// a record field, such as $row.id
+			return word, ""
+		}
+		qualifier = line[qStart:qEnd]
+	}
+
+	return word, qualifier
+}
+
+// wordEnd returns the character at which the identifier starting at the
+// position ends.
+func (d *document) wordEnd(pos position) int {
+	if pos.Line < 0 || pos.Line >= len(d.lines) {
+		return pos.Character
+	}
+	line := d.lines[pos.Line]
+
+	end := pos.Character
+	for end < len(line) && isWordChar(line[end]) {
+		end++
+	}
+	return end
+}
+
+// find returns the location of the first occurrence of name as a whole
+// identifier at or after the start of the node. It is used to find the
+// name of a declaration, since the parser only records the position of
+// the whole declaration.
+func (d *document) find(node parse.GetPositioner, name string) *location {
+	p := node.GetPosition()
+	if p.StartLine == nil || p.StartCol == nil {
+		return nil
+	}
+
+	startLine, startCol := *p.StartLine-1+d.lineOffset, *p.StartCol
+	if *p.StartLine == 1 {
+		startCol += d.colOffset
+	}
+
+	name = strings.ToLower(name)
+	for i := startLine; i < len(d.lines); i++ {
+		line := strings.ToLower(d.lines[i])
+		from := 0
+		if i == startLine {
+			from = min(startCol, len(line))
+		}
+
+		for from < len(line) {
+			idx := strings.Index(line[from:], name)
+			if idx < 0 {
+				break
+			}
+			start, end := from+idx, from+idx+len(name)
+			if (start == 0 || !isWordChar(line[start-1])) && (end == len(line) || !isWordChar(line[end])) {
+				return &location{
+					URI: d.uri,
+					Range: lspRange{
+						Start: position{Line: i, Character: start},
+						End:   position{Line: i, Character: end},
+					},
+				}
+			}
+			from = end
+		}
+
+		if p.EndLine != nil && i+1 >= *p.EndLine+d.lineOffset {
+			break
+		}
+	}
+
+	return nil
+}
@@ -12,6 +12,7 @@ import (
 	"github.com/trufnetwork/kwil-db/cmd/kwil-cli/cmds/account"
 	"github.com/trufnetwork/kwil-db/cmd/kwil-cli/cmds/configure"
 	"github.com/trufnetwork/kwil-db/cmd/kwil-cli/cmds/database"
+	"github.com/trufnetwork/kwil-db/cmd/kwil-cli/cmds/lsp"
 	"github.com/trufnetwork/kwil-db/cmd/kwil-cli/cmds/utils"
 	"github.com/trufnetwork/kwil-db/cmd/kwil-cli/config"
 	"github.com/trufnetwork/kwil-db/cmd/kwil-cli/helpers"
@@ -75,9 +76,11 @@ func NewRootCmd() *cobra.Command {
 		configure.NewCmdConfigure(),
 		database.NewCmdDatabase(),
 		utils.NewCmdUtils(),
+		lsp.NewCmdLSP(),
 		version.NewVersionCmd(),
 		execSQLCmd(),
 		execActionCmd(),
+		execMultiCmd(),
 		callActionCmd(),
 		queryCmd(),
 	)
@@ -0,0 +1,131 @@
+package utils
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+	"strings"
+
+	"github.com/spf13/cobra"
+	"github.com/trufnetwork/kwil-db/app/shared/display"
+	"github.com/trufnetwork/kwil-db/cmd/kwil-cli/helpers"
+	"github.com/trufnetwork/kwil-db/node/engine/format"
+)
+
+var (
+	fmtLong = `Formats Kuneiform files in a canonical style.
+
+SQL and DDL keywords and data types are uppercased, action bodies are indented
+with four spaces, and SQL statements that do not fit on a single line are broken
+before each clause. Comments are preserved.
+
+By default, the formatted source is printed. If ` + "`-w`" + ` is given, the files are
+rewritten in place instead. Files that do not parse are left unchanged, and
+the command exits with an error.`
+
+	fmtExample = `# Print a formatted schema
+kwil-cli utils fmt schema.sql
+
+# Format all schema files in place
+kwil-cli utils fmt -w schema/*.sql`
+)
+
+func fmtCmd() *cobra.Command {
+	var write bool
+
+	cmd := &cobra.Command{
+		Use:     "fmt <files...>",
+		Short:   "Formats Kuneiform files.",
+		Long:    fmtLong,
+		Example: fmtExample,
+		Args:    cobra.MinimumNArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			res := &fmtResult{written: write}
+			for _, arg := range args {
+				path, err := helpers.ExpandPath(arg)
+				if err != nil {
+					return display.PrintErr(cmd, err)
+				}
+
+				bts, err := os.ReadFile(path)
+				if err != nil {
+					return display.PrintErr(cmd, err)
+				}
+
+				formatted, err := format.Format(string(bts))
+				if err != nil {
+					return display.PrintErr(cmd, fmt.Errorf("%s: %w", arg, err))
+				}
+
+				changed := formatted != string(bts)
+				if write && changed {
+					info, err := os.Stat(path)
+					if err != nil {
+						return display.PrintErr(cmd, err)
+					}
+
+					if err := os.WriteFile(path, []byte(formatted), info.Mode().Perm()); err != nil {
+						return display.PrintErr(cmd, err)
+					}
+				}
+
+				file := &formattedFile{
+					File:    arg,
+					Changed: changed,
+				}
+				if !write {
+					file.Source = formatted
+				}
+				res.Files = append(res.Files, file)
+			}
+
+			return display.PrintCmd(cmd, res)
+		},
+	}
+
+	cmd.Flags().BoolVarP(&write, "write", "w", false, "write the formatted source back to the files")
+	return cmd
+}
+
+type formattedFile struct {
+	File string `json:"file"`
+	// Changed is true if the formatted source differs from the file.
+	Changed bool `json:"changed"`
+	// Source is the formatted source. It is empty if the file was rewritten.
+	Source string `json:"source,omitempty"`
+}
+
+type fmtResult struct {
+	Files []*formattedFile `json:"files"`
+	// written is true if the files were rewritten in place.
+	written bool
+}
+
+func (f *fmtResult) MarshalJSON() ([]byte, error) {
+	return json.Marshal(&struct {
+		Files []*formattedFile `json:"files"`
+	}{
+		Files: f.Files,
+	})
+}
+
+func (f *fmtResult) MarshalText() ([]byte, error) {
+	var sb strings.Builder
+	if f.written {
+		for _, file := range f.Files {
+			if file.Changed {
+				fmt.Fprintf(&sb, "Formatted %s\n", file.File)
+			}
+		}
+		if sb.Len() == 0 {
+			return []byte("All files are already formatted."), nil
+		}
+	} else {
+		for _, file := range f.Files {
+			sb.WriteString(file.Source)
+		}
+	}
+
+	// the output is printed with a trailing newline
+	return []byte(strings.TrimSuffix(sb.String(), "\n")), nil
+}
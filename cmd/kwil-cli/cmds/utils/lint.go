@@ -0,0 +1,125 @@
+package utils
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"os"
+	"strings"
+
+	"github.com/spf13/cobra"
+	"github.com/trufnetwork/kwil-db/app/shared"
+	"github.com/trufnetwork/kwil-db/app/shared/display"
+	"github.com/trufnetwork/kwil-db/cmd/kwil-cli/helpers"
+	"github.com/trufnetwork/kwil-db/node/engine/lint"
+)
+
+var (
+	lintLong = `Type-checks Kuneiform files offline, without a node or Postgres.
+
+All files are treated as a single deployment, applied in the order given: tables
+and actions declared in one file can be referenced from any other. The DDL in the
+files is used to build a virtual catalog, and every SQL statement and action body
+is checked against it. The linter reports:
+
+  - syntax errors
+  - unknown tables, columns, variables, actions, and functions
+  - type mismatches in queries, assignments, calls, and return values
+  - unused variables
+  - unreachable code after RETURN, BREAK, CONTINUE, or error()
+  - actions missing one of the PUBLIC, PRIVATE, or SYSTEM access modifiers
+  - results that depend on an implicit row ordering
+
+The command exits with a non-zero code if any errors are found. Use
+` + "`--output json`" + ` for machine-readable output.`
+
+	lintExample = `# Lint a schema and its actions
+kwil-cli utils lint tables.sql actions.sql
+
+# Lint for CI, failing on warnings as well as errors
+kwil-cli utils lint --fail-on-warning --output json schema/*.sql`
+)
+
+func lintCmd() *cobra.Command {
+	var failOnWarning bool
+
+	cmd := &cobra.Command{
+		Use:     "lint <files...>",
+		Short:   "Type-checks Kuneiform files offline.",
+		Long:    lintLong,
+		Example: lintExample,
+		Args:    cobra.MinimumNArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			files := make([]*lint.File, len(args))
+			for i, arg := range args {
+				path, err := helpers.ExpandPath(arg)
+				if err != nil {
+					return display.PrintErr(cmd, err)
+				}
+
+				bts, err := os.ReadFile(path)
+				if err != nil {
+					return display.PrintErr(cmd, err)
+				}
+
+				files[i] = &lint.File{
+					Name:   arg,
+					Source: string(bts),
+				}
+			}
+
+			res := &lintResult{
+				Diagnostics: lint.Lint(files...),
+			}
+
+			// we still print the diagnostics, but exit with a non-zero code
+			if lint.HasErrors(res.Diagnostics) || (failOnWarning && len(res.Diagnostics) > 0) {
+				shared.SetCmdCtxErr(cmd, errors.New("lint failed"))
+			}
+
+			return display.PrintCmd(cmd, res)
+		},
+	}
+
+	cmd.Flags().BoolVar(&failOnWarning, "fail-on-warning", false, "exit with a non-zero code if there are any warnings")
+	return cmd
+}
+
+type lintResult struct {
+	Diagnostics []*lint.Diagnostic `json:"diagnostics"`
+}
+
+func (l *lintResult) MarshalJSON() ([]byte, error) {
+	diags := l.Diagnostics
+	if diags == nil {
+		diags = []*lint.Diagnostic{} // print [] instead of null
+	}
+
+	return json.Marshal(&struct {
+		Diagnostics []*lint.Diagnostic `json:"diagnostics"`
+	}{
+		Diagnostics: diags,
+	})
+}
+
+func (l *lintResult) MarshalText() ([]byte, error) {
+	if len(l.Diagnostics) == 0 {
+		return []byte("No problems found."), nil
+	}
+
+	var errs, warnings int
+	var sb strings.Builder
+	for _, d := range l.Diagnostics {
+		sb.WriteString(d.String())
+		sb.WriteString("\n")
+
+		if d.Severity == lint.SeverityError {
+			errs++
+		} else {
+			warnings++
+		}
+	}
+	fmt.Fprintf(&sb, "\n%d error(s), %d warning(s)", errs, warnings)
+
+	return []byte(sb.String()), nil
+}
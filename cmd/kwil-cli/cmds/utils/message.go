@@ -76,6 +76,21 @@ Signature: %s
 		t.Tx.Signature.Type,
 		base64.StdEncoding.EncodeToString(t.Tx.Signature.Data),
 	)
+	if t.Tx.Body.ValidAfterHeight != 0 {
+		msg += fmt.Sprintf("Valid after height: %d\n", t.Tx.Body.ValidAfterHeight)
+	}
+	if t.Tx.Body.ValidUntilHeight != 0 {
+		msg += fmt.Sprintf("Valid until height: %d\n", t.Tx.Body.ValidUntilHeight)
+	}
+	if fp := t.Tx.Body.FeePayer; fp != nil {
+		msg += fmt.Sprintf("Fee payer: %s (%s)\n", fp.Identifier.String(), fp.AuthType)
+		if t.Tx.FeePayerSignature != nil {
+			msg += "Fee payer signature: " + base64.StdEncoding.EncodeToString(t.Tx.FeePayerSignature.Data) + "\n"
+		}
+	}
+	if d := t.Tx.Body.Delegator; d != nil {
+		msg += fmt.Sprintf("Delegator: %s (%s)\n", d.Identifier.String(), d.AuthType)
+	}
 
 	if t.WithPayload { // put it at the end regardless since it' can be big
 		// First try to decode the transaction (RLP), then create readable JSON
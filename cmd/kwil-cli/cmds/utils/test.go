@@ -1,12 +1,15 @@
 package utils
 
 import (
+	"bytes"
 	"encoding/json"
 	"errors"
 	"fmt"
 	"os"
 	"path/filepath"
+	"slices"
 	"strings"
+	"time"
 
 	"github.com/manifoldco/promptui"
 	"github.com/spf13/cobra"
@@ -14,20 +17,38 @@ import (
 	"github.com/trufnetwork/kwil-db/app/shared/display"
 	"github.com/trufnetwork/kwil-db/cmd/kwil-cli/helpers"
 	"github.com/trufnetwork/kwil-db/core/log"
+	"github.com/trufnetwork/kwil-db/node/engine/interpreter"
 	"github.com/trufnetwork/kwil-db/testing"
 )
 
 var (
-	testLong = `Runs Kuneiform JSON tests.
+	testLong = `Runs Kuneiform JSON and YAML tests.
 	
 The ` + "`" + `test` + "`" + ` command executes runs tests for Kuneiform.
-Custom tests for user-defined schemas can be defined using JSON.
+Custom tests for user-defined schemas can be defined using JSON or YAML.
 For information on how to create JSON tests for Kuneiform schemas,
 reference the Kwil docs (https://docs.kwil.com).
 
-Paths to JSON files for tests are relative to the working directory, but
-schema filepaths specified in the JSON will be accessed relative to the
-respective JSON file.
+Files ending in ` + "`.yaml` or `.yml`" + ` are YAML tests. Each of their
+tests is a sequence of steps, which call actions or execute statements as
+any caller, at any block height and time. Steps can expect an error, and
+assert the contents of tables after they run. Tests can share named
+fixtures, and can be parameterized with a table of cases.
+
+Paths to test files are relative to the working directory, but
+schema filepaths specified in the files will be accessed relative to the
+respective file.
+
+YAML tests can be run in parallel with the ` + "`--parallel`" + ` flag. Each
+parallel worker creates its own database in the PostgreSQL instance, so the
+user must be able to create databases. The results of all tests can be
+written as JUnit XML with the ` + "`--junit`" + ` flag, for CI systems.
+
+The ` + "`--coverage`" + ` flag records which statements of actions the tests
+execute, and writes a report mapping them back to the seed scripts that
+define the actions. The report is written to ` + "`--coverage-out`" + `, as
+HTML if the file ends in ` + "`.html`" + `, and otherwise as an lcov tracefile.
+Actions that are not defined in a seed script are not reported.
 
 Test cases can be run by specyfing the path to the JSON using the
 ` + "`" + `file` + "`" + ` flag. Multiple test files can be specified by simply
@@ -44,16 +65,26 @@ kwil-cli utils test --file ./test1.json --file ./test2.json --test-container
 
 # Run tests against a manually set up local Postgres instance
 kwil-cli utils test --file ./test1.json --host localhost --port 5432 \
---user postgres --password password --database postgres`
+--user postgres --password password --database postgres
+
+# Run YAML tests 4 at a time, and write a JUnit report
+kwil-cli utils test --file ./token.yaml --test-container --parallel 4 --junit ./report.xml
+
+# Run tests, and write an HTML report of the statements they executed
+kwil-cli utils test --file ./token.yaml --test-container --coverage --coverage-out ./coverage.html`
 )
 
 func testCmd() *cobra.Command {
 	var testCases []string
 	var host, port, user, pass, dbName string
 	var useTestContainer bool
+	var parallel int
+	var junitPath string
+	var coverage bool
+	var coverageOut string
 	cmd := &cobra.Command{
 		Use:     "test",
-		Short:   "Runs Kuneiform JSON tests.",
+		Short:   "Runs Kuneiform JSON and YAML tests.",
 		Long:    testLong,
 		Example: testExample,
 		RunE: func(cmd *cobra.Command, args []string) error {
@@ -62,6 +93,9 @@ func testCmd() *cobra.Command {
 			opts := testing.Options{
 				Logger: testing.LoggerFromKwilLogger(l),
 			}
+			if coverage {
+				opts.Coverage = interpreter.NewCoverage()
+			}
 
 			userHasSetPgConn := false
 			setPgConnFlag := ""
@@ -128,12 +162,26 @@ func testCmd() *cobra.Command {
 			}
 
 			// run the tests
+			report := &testing.Report{}
+			var yamlFiles []*testing.TestFile
+			var failures []string
+			var seedScripts []string // the files that coverage is reported for
 			for _, path := range testCases {
 				_, err := expandHome(&path)
 				if err != nil {
 					return display.PrintErr(cmd, err)
 				}
 
+				if ext := filepath.Ext(path); ext == ".yaml" || ext == ".yml" {
+					f, err := testing.LoadTestFile(path)
+					if err != nil {
+						return display.PrintErr(cmd, err)
+					}
+					yamlFiles = append(yamlFiles, f)
+					seedScripts = append(seedScripts, f.SeedScripts...)
+					continue
+				}
+
 				bts, err := os.ReadFile(path)
 				if err != nil {
 					return display.PrintErr(cmd, err)
@@ -147,18 +195,87 @@ func testCmd() *cobra.Command {
 				if err = makeSchemaPathsRelative(&schemaTest, path); err != nil {
 					return display.PrintErr(cmd, err)
 				}
+				seedScripts = append(seedScripts, schemaTest.SeedScripts...)
+
+				// JSON tests are reported as a single test
+				start := time.Now()
+				err = schemaTest.Run(cmd.Context(), &opts)
+				report.Suites = append(report.Suites, &testing.SuiteResult{
+					Name:  schemaTest.Name,
+					Tests: []*testing.TestResult{{Name: schemaTest.Name, Err: err, Duration: time.Since(start)}},
+				})
+				if err != nil {
+					failures = append(failures, err.Error())
+				}
+			}
 
-				if err = schemaTest.Run(cmd.Context(), &opts); err != nil {
-					return display.PrintCmd(cmd, &testsPassed{
-						Passing: false,
-						Reason:  err.Error(),
-					})
+			if len(yamlFiles) > 0 {
+				yamlReport, err := testing.RunTestFiles(cmd.Context(), yamlFiles, &opts, parallel)
+				if err != nil {
+					return display.PrintErr(cmd, err)
+				}
+				report.Suites = append(report.Suites, yamlReport.Suites...)
+
+				for _, suite := range yamlReport.Suites {
+					for _, test := range suite.Tests {
+						if test.Err != nil {
+							failures = append(failures, fmt.Sprintf(`test "%s/%s" failed: %v`, suite.Name, test.Name, test.Err))
+						}
+					}
 				}
 			}
 
-			return display.PrintCmd(cmd, &testsPassed{
-				Passing: true,
-			})
+			if junitPath != "" {
+				_, err := expandHome(&junitPath)
+				if err != nil {
+					return display.PrintErr(cmd, err)
+				}
+
+				var buf bytes.Buffer
+				if err = testing.WriteJUnit(&buf, report); err != nil {
+					return display.PrintErr(cmd, err)
+				}
+				if err = os.WriteFile(junitPath, buf.Bytes(), 0644); err != nil {
+					return display.PrintErr(cmd, err)
+				}
+			}
+
+			result := &testsPassed{Passing: len(failures) == 0}
+			if len(failures) > 0 {
+				result.Reason = strings.Join(failures, "\n")
+			}
+
+			if coverage {
+				_, err := expandHome(&coverageOut)
+				if err != nil {
+					return display.PrintErr(cmd, err)
+				}
+
+				// the same script may be used by many tests
+				slices.Sort(seedScripts)
+				seedScripts = slices.Compact(seedScripts)
+
+				var buf bytes.Buffer
+				if ext := filepath.Ext(coverageOut); ext == ".html" || ext == ".htm" {
+					err = testing.WriteCoverageHTML(&buf, opts.Coverage, seedScripts)
+				} else {
+					err = testing.WriteLCOV(&buf, opts.Coverage, seedScripts)
+				}
+				if err != nil {
+					return display.PrintErr(cmd, err)
+				}
+				if err = os.WriteFile(coverageOut, buf.Bytes(), 0644); err != nil {
+					return display.PrintErr(cmd, err)
+				}
+
+				percent, err := testing.CoveragePercent(opts.Coverage, seedScripts)
+				if err != nil {
+					return display.PrintErr(cmd, err)
+				}
+				result.Coverage = &percent
+			}
+
+			return display.PrintCmd(cmd, result)
 		},
 	}
 
@@ -169,6 +286,10 @@ func testCmd() *cobra.Command {
 	cmd.Flags().StringVar(&pass, "password", "", "password for the database user")
 	cmd.Flags().StringVar(&host, "host", "localhost", "host of the database")
 	cmd.Flags().StringVar(&port, "port", "5432", "port of the database")
+	cmd.Flags().IntVar(&parallel, "parallel", 1, "number of YAML tests to run at once")
+	cmd.Flags().StringVar(&junitPath, "junit", "", "path to write a JUnit XML report of the results")
+	cmd.Flags().BoolVar(&coverage, "coverage", false, "record the statements of actions that the tests execute")
+	cmd.Flags().StringVar(&coverageOut, "coverage-out", "coverage.lcov", "path to write the coverage report, as HTML if it ends in .html and otherwise as lcov")
 	helpers.BindAssumeYesFlag(cmd)
 
 	return cmd
@@ -177,6 +298,9 @@ func testCmd() *cobra.Command {
 type testsPassed struct {
 	Passing bool   `json:"passing"`
 	Reason  string `json:"reason,omitempty"`
+	// Coverage is the percentage of statements in the seed scripts that
+	// were executed. It is only set if coverage was recorded.
+	Coverage *float64 `json:"coverage,omitempty"`
 }
 
 func (t *testsPassed) MarshalJSON() ([]byte, error) {
@@ -189,11 +313,16 @@ func (t *testsPassed) MarshalJSON() ([]byte, error) {
 }
 
 func (t *testsPassed) MarshalText() (text []byte, err error) {
+	var coverage string
+	if t.Coverage != nil {
+		coverage = fmt.Sprintf("\nCoverage: %.1f%% of statements", *t.Coverage)
+	}
+
 	if !t.Passing {
-		return []byte("\nTests failed:\n" + t.Reason), nil
+		return []byte("\nTests failed:\n" + t.Reason + coverage), nil
 	}
 
-	return []byte("\nAll tests passed successfully."), nil
+	return []byte("\nAll tests passed successfully." + coverage), nil
 }
 
 // adjustPath expands a path relative to another path.
@@ -21,6 +21,8 @@ func NewCmdUtils() *cobra.Command {
 		chainInfoCmd(),
 		kgwAuthnCmd(),
 		testCmd(),
+		lintCmd(),
+		fmtCmd(),
 		generateKeyCmd(),
 	)
 
@@ -115,6 +115,10 @@ type EngineContext struct {
 	// and make sure to create a fake transaction context.
 	// If InvalidTxCtx is set to true, OverrideAuthz should also be set to true.
 	InvalidTxCtx bool
+	// Trace records an execution trace of an action call in the Trace field
+	// of the CallResult. It slows down execution, and is meant for debugging
+	// read-only calls.
+	Trace bool
 }
 
 func (e *EngineContext) Valid() error {
@@ -154,6 +158,9 @@ type CallResult struct {
 	// It is explicitly used for user-defined exceptions thrown
 	// with the `error` function.
 	Error error // TODO: implement
+	// Trace is the execution trace of the call. It is only set if the
+	// EngineContext requested it.
+	Trace *types.CallTrace
 }
 
 // FormatLogs formats the logs into a string.
@@ -44,6 +44,10 @@ type BlockContext struct {
 	Timestamp int64
 	// Proposer gets the proposer public key of the current block.
 	Proposer crypto.PublicKey
+	// ScheduleResults are the results of the actions called by schedules
+	// at the end of the block. They are added by the engine's EndBlockHook,
+	// and are included in the block results.
+	ScheduleResults []*types.ScheduleResult
 }
 
 // MigrationContext provides context for all migration operations.
@@ -2,6 +2,7 @@ package config
 
 import (
 	"bytes"
+	"crypto/sha256"
 	"encoding/hex"
 	"encoding/json"
 	"errors"
@@ -57,6 +58,14 @@ func isValidRPCNamespace(ns string) bool {
 	}
 }
 
+// WasmPrecompile pins a WebAssembly precompile extension in the genesis config.
+type WasmPrecompile struct {
+	// Hash is the SHA-256 hash of the module.
+	Hash types.HexBytes `json:"hash"`
+	// GasLimit is the gas available to each call of a method of the module.
+	GasLimit int64 `json:"gas_limit"`
+}
+
 type GenesisAlloc struct {
 	ID      KeyHexBytes `json:"id"`
 	KeyType string      `json:"key_type"`
@@ -131,6 +140,10 @@ type GenesisConfig struct {
 	// Migration specifies the migration configuration required for zero downtime migration.
 	Migration MigrationParams `json:"migration"`
 
+	// WasmPrecompiles pins the WebAssembly precompile extensions that every
+	// node must load, by the name they are registered with.
+	WasmPrecompiles map[string]*WasmPrecompile `json:"wasm_precompiles,omitempty"`
+
 	// NetworkParameters are network level configurations that can be
 	// evolved over the lifetime of a network.
 	types.NetworkParameters
@@ -180,6 +193,15 @@ func (gc *GenesisConfig) SanityChecks() error {
 		return errors.New("both start and end height should be set or unset")
 	}
 
+	for name, wp := range gc.WasmPrecompiles {
+		if wp == nil || len(wp.Hash) != sha256.Size {
+			return fmt.Errorf("wasm precompile %s: hash must be 32 bytes", name)
+		}
+		if wp.GasLimit <= 0 {
+			return fmt.Errorf("wasm precompile %s: gas limit must be positive", name)
+		}
+	}
+
 	// ensure that the leader is part of the validator set
 	isValidator := slices.ContainsFunc(gc.Validators, func(v *types.Validator) bool {
 		if v.KeyType != gc.Leader.Type() {
@@ -309,8 +331,12 @@ func DefaultConfig() *Config {
 			BlockAnnInterval:      types.Duration(3 * time.Second),
 		},
 		Mempool: MempoolConfig{
-			MaxSize:    200 * 1024 * 1024, // 200 MiB
-			MaxTxBytes: 4 * 1024 * 1024,   // 4 MiB
+			MaxSize:        200 * 1024 * 1024, // 200 MiB
+			MaxTxBytes:     4 * 1024 * 1024,   // 4 MiB
+			MaxSenderTxs:   1000,
+			MaxSenderBytes: 20 * 1024 * 1024, // 20 MiB
+			MaxFutureAge:   types.Duration(time.Hour),
+			JournalMaxAge:  types.Duration(time.Hour),
 		},
 		Store: StoreConfig{
 			Compression: true,
@@ -368,7 +394,8 @@ func DefaultConfig() *Config {
 			TxGetTimeout:         types.Duration(20 * time.Second),
 			TxAnnTimeout:         types.Duration(5 * time.Second),
 		},
-		Extensions: make(map[string]map[string]string),
+		Extensions:      make(map[string]map[string]string),
+		WasmPrecompiles: make(map[string]string),
 		Checkpoint: Checkpoint{
 			Height: 0,
 			Hash:   "",
@@ -389,24 +416,26 @@ type Config struct {
 
 	ProfileMode string `toml:"profile_mode,commented" comment:"profile mode (http, cpu, mem, mutex, or block)"`
 	ProfileFile string `toml:"profile_file,commented" comment:"profile output file path (e.g. cpu.pprof)"`
+	ExecProfile bool   `toml:"exec_profile" comment:"record the wall time and rows of the actions and statements executed in blocks, reported by the admin exec_profile method and by telemetry"`
 
 	Telemetry Telemetry `toml:"telemetry" comment:"telemetry (metrics and traces) configuration"`
 
-	P2P          PeerConfig                   `toml:"p2p" comment:"P2P related configuration"`
-	Consensus    ConsensusConfig              `toml:"consensus" comment:"Consensus related configuration"`
-	Mempool      MempoolConfig                `toml:"mempool" comment:"Mempool related configuration"`
-	DB           DBConfig                     `toml:"db" comment:"DB (PostgreSQL) related configuration"`
-	Store        StoreConfig                  `toml:"store" comment:"Block store configuration"`
-	RPC          RPCConfig                    `toml:"rpc" comment:"User RPC service configuration"`
-	Admin        AdminConfig                  `toml:"admin" comment:"Admin RPC service configuration"`
-	Snapshots    SnapshotConfig               `toml:"snapshots" comment:"Snapshot creation and provider configuration"`
-	StateSync    StateSyncConfig              `toml:"state_sync" comment:"Statesync configuration (vs block sync)"`
-	Extensions   map[string]map[string]string `toml:"extensions" comment:"extension configuration"`
-	GenesisState string                       `toml:"genesis_state" comment:"path to the genesis state file, relative to the root directory"`
-	Migrations   MigrationConfig              `toml:"migrations" comment:"zero downtime migration configuration"`
-	Checkpoint   Checkpoint                   `toml:"checkpoint" comment:"checkpoint info for the leader to sync to before proposing a new block"`
-	Erc20Bridge  ERC20BridgeConfig            `toml:"erc20_bridge" comment:"ERC20 bridge configuration"`
-	BlockSync    BlockSyncConfig              `toml:"block_sync" comment:"Block synchronization configuration"`
+	P2P             PeerConfig                   `toml:"p2p" comment:"P2P related configuration"`
+	Consensus       ConsensusConfig              `toml:"consensus" comment:"Consensus related configuration"`
+	Mempool         MempoolConfig                `toml:"mempool" comment:"Mempool related configuration"`
+	DB              DBConfig                     `toml:"db" comment:"DB (PostgreSQL) related configuration"`
+	Store           StoreConfig                  `toml:"store" comment:"Block store configuration"`
+	RPC             RPCConfig                    `toml:"rpc" comment:"User RPC service configuration"`
+	Admin           AdminConfig                  `toml:"admin" comment:"Admin RPC service configuration"`
+	Snapshots       SnapshotConfig               `toml:"snapshots" comment:"Snapshot creation and provider configuration"`
+	StateSync       StateSyncConfig              `toml:"state_sync" comment:"Statesync configuration (vs block sync)"`
+	Extensions      map[string]map[string]string `toml:"extensions" comment:"extension configuration"`
+	WasmPrecompiles map[string]string            `toml:"wasm_precompiles" comment:"WebAssembly precompile extensions to load at startup, mapping each extension name to the path of its module, relative to the root directory. Every module must be pinned in the genesis file."`
+	GenesisState    string                       `toml:"genesis_state" comment:"path to the genesis state file, relative to the root directory"`
+	Migrations      MigrationConfig              `toml:"migrations" comment:"zero downtime migration configuration"`
+	Checkpoint      Checkpoint                   `toml:"checkpoint" comment:"checkpoint info for the leader to sync to before proposing a new block"`
+	Erc20Bridge     ERC20BridgeConfig            `toml:"erc20_bridge" comment:"ERC20 bridge configuration"`
+	BlockSync       BlockSyncConfig              `toml:"block_sync" comment:"Block synchronization configuration"`
 
 	SkipDependencyVerification bool `toml:"skip_dependency_verification" comment:"skip runtime dependency verification (the pg_dump and psql binaries)"`
 	// PGDump: used by the snapshot and the migration module for producing snapshots.
@@ -432,6 +461,26 @@ type MempoolConfig struct {
 
 	// MaxTxBytes limits the size of any one transaction in mempool.
 	MaxTxBytes int64 `mapstructure:"max_tx_bytes"`
+
+	// MaxSenderTxs limits the number of transactions of any one sender in
+	// mempool, including those queued with a nonce gap.
+	MaxSenderTxs int `toml:"max_sender_txs" comment:"maximum number of transactions from one sender in the mempool, including those queued with a nonce gap (0 for no limit)"`
+
+	// MaxSenderBytes limits the total size of the transactions of any one
+	// sender in mempool, including those queued with a nonce gap.
+	MaxSenderBytes int64 `toml:"max_sender_bytes" comment:"maximum total size in bytes of the transactions from one sender in the mempool (0 for no limit)"`
+
+	// MaxFutureAge is the maximum age of the transactions queued with a nonce
+	// gap, which are removed once they are older.
+	MaxFutureAge types.Duration `toml:"max_future_age" comment:"maximum age of the transactions queued in the mempool with a nonce gap, after which they are removed (0 for no limit)"`
+
+	// Journal enables journaling the transactions accepted into mempool to
+	// disk, so they are restored when the node restarts.
+	Journal bool `toml:"journal" comment:"journal the transactions accepted into the mempool to disk, and restore them when the node restarts"`
+
+	// JournalMaxAge is the maximum age of the journaled transactions that are
+	// restored when the node restarts.
+	JournalMaxAge types.Duration `toml:"journal_max_age" comment:"maximum age of the journaled transactions restored when the node restarts (0 for no limit)"`
 }
 
 // PeerConfig corresponds to the [p2p] section of the config.
@@ -24,6 +24,8 @@ const (
 	genesisFileName      = "genesis.json"
 
 	leaderUpdatesFileName = "leader-updates.json"
+
+	mempoolJournalFileName = "mempool.journal"
 )
 
 // BlockstoreDir returns the blockstore directory in the root directory.
@@ -67,3 +69,9 @@ func NodeKeyFilePath(rootDir string) string {
 func LeaderUpdatesFilePath(rootDir string) string {
 	return filepath.Join(rootDir, leaderUpdatesFileName)
 }
+
+// MempoolJournalFilePath returns the path of the mempool journal file in the
+// root directory.
+func MempoolJournalFilePath(rootDir string) string {
+	return filepath.Join(rootDir, mempoolJournalFileName)
+}
@@ -196,6 +196,18 @@ func syncBcastFlag(syncBcast bool) rpcclient.BroadcastWait {
 	return syncFlag
 }
 
+// broadcast broadcasts a signed transaction, or gives it to the TxHandler of
+// the options.
+func (c *Client) broadcast(ctx context.Context, tx *types.Transaction, txOpts *clientType.TxOptions) (types.Hash, error) {
+	if txOpts.TxHandler != nil {
+		if err := txOpts.TxHandler(tx); err != nil {
+			return types.Hash{}, err
+		}
+		return tx.Hash(), nil
+	}
+	return c.txClient.Broadcast(ctx, tx, syncBcastFlag(txOpts.SyncBcast))
+}
+
 // Transfer transfers balance to a given address.
 func (c *Client) Transfer(ctx context.Context, to *types.AccountID, amount *big.Int, opts ...clientType.TxOpt) (types.Hash, error) {
 	// Get account balance to ensure we can afford the transfer, and use the
@@ -231,7 +243,7 @@ func (c *Client) Transfer(ctx context.Context, to *types.AccountID, amount *big.
 	c.logger.Debug("transfer", "to", to,
 		"amount", amount.String())
 
-	return c.txClient.Broadcast(ctx, tx, syncBcastFlag(txOpts.SyncBcast))
+	return c.broadcast(ctx, tx, txOpts)
 }
 
 // ChainInfo get the current blockchain information like chain ID and best block
@@ -245,45 +257,76 @@ func (c *Client) ChainInfo(ctx context.Context) (*types.ChainInfo, error) {
 // It can take any number of inputs, and if multiple tuples of inputs are passed,
 // it will execute them in the same transaction.
 func (c *Client) Execute(ctx context.Context, namespace string, action string, tuples [][]any, opts ...clientType.TxOpt) (types.Hash, error) {
+	executionBody, err := NewActionExecution(namespace, action, tuples)
+	if err != nil {
+		return types.Hash{}, err
+	}
+
+	txOpts := clientType.GetTxOpts(opts)
+	tx, err := c.newTx(ctx, executionBody, txOpts)
+	if err != nil {
+		return types.Hash{}, err
+	}
+
+	c.logger.Debug("execute action",
+		"Namespace", namespace, "action", action,
+		"signature_type", tx.Signature.Type,
+		"signature", base64.StdEncoding.EncodeToString(tx.Signature.Data),
+		"fee", tx.Body.Fee.String(), "nonce", tx.Body.Nonce)
+
+	return c.broadcast(ctx, tx, txOpts)
+}
+
+// NewActionExecution creates the payload that executes an action once for each
+// tuple of inputs.
+func NewActionExecution(namespace string, action string, tuples [][]any) (*types.ActionExecution, error) {
 	encodedTuples := make([][]*types.EncodedValue, len(tuples))
 	for i, tuple := range tuples {
 		encoded, err := EncodeInputs(tuple)
 		if err != nil {
-			return types.Hash{}, err
+			return nil, err
 		}
 		encodedTuples[i] = encoded
 	}
 
-	executionBody := &types.ActionExecution{
+	return &types.ActionExecution{
 		Action:    action,
 		Namespace: namespace,
 		Arguments: encodedTuples,
+	}, nil
+}
+
+// ExecuteSQL executes a SQL statement.
+func (c *Client) ExecuteSQL(ctx context.Context, stmt string, params map[string]any, opts ...clientType.TxOpt) (types.Hash, error) {
+	execTx, err := NewRawStatement(stmt, params)
+	if err != nil {
+		return types.Hash{}, err
 	}
 
 	txOpts := clientType.GetTxOpts(opts)
-	tx, err := c.newTx(ctx, executionBody, txOpts)
+	tx, err := c.newTx(ctx, execTx, txOpts)
 	if err != nil {
 		return types.Hash{}, err
 	}
 
-	c.logger.Debug("execute action",
-		"Namespace", namespace, "action", action,
+	c.logger.Debug("execute SQL",
+		"statement", stmt,
 		"signature_type", tx.Signature.Type,
 		"signature", base64.StdEncoding.EncodeToString(tx.Signature.Data),
 		"fee", tx.Body.Fee.String(), "nonce", tx.Body.Nonce)
 
-	return c.txClient.Broadcast(ctx, tx, syncBcastFlag(txOpts.SyncBcast))
+	return c.broadcast(ctx, tx, txOpts)
 }
 
-// ExecuteSQL executes a SQL statement.
-func (c *Client) ExecuteSQL(ctx context.Context, stmt string, params map[string]any, opts ...clientType.TxOpt) (types.Hash, error) {
+// NewRawStatement creates the payload that executes a SQL statement.
+func NewRawStatement(stmt string, params map[string]any) (*types.RawStatement, error) {
 	execTx := &types.RawStatement{}
 	execTx.Statement = stmt
 
 	for k, v := range params {
 		encoded, err := types.EncodeValue(v)
 		if err != nil {
-			return types.Hash{}, err
+			return nil, err
 		}
 
 		execTx.Parameters = append(execTx.Parameters, &types.NamedValue{
@@ -292,23 +335,106 @@ func (c *Client) ExecuteSQL(ctx context.Context, stmt string, params map[string]
 		})
 	}
 
+	return execTx, nil
+}
+
+// ExecuteMulti executes a list of operations atomically in one transaction.
+// The operations may be action executions, SQL statements, and transfers, such
+// as those created with NewActionExecution and NewRawStatement. If any
+// operation fails, none of them take effect.
+func (c *Client) ExecuteMulti(ctx context.Context, ops []types.Payload, opts ...clientType.TxOpt) (types.Hash, error) {
+	multi := &types.MultiOperation{Operations: ops}
+
 	txOpts := clientType.GetTxOpts(opts)
-	tx, err := c.newTx(ctx, execTx, txOpts)
+	tx, err := c.newTx(ctx, multi, txOpts)
 	if err != nil {
 		return types.Hash{}, err
 	}
 
-	c.logger.Debug("execute SQL",
-		"statement", stmt,
+	c.logger.Debug("execute multi-operation",
+		"operations", len(ops),
 		"signature_type", tx.Signature.Type,
 		"signature", base64.StdEncoding.EncodeToString(tx.Signature.Data),
 		"fee", tx.Body.Fee.String(), "nonce", tx.Body.Nonce)
 
-	return c.txClient.Broadcast(ctx, tx, syncBcastFlag(txOpts.SyncBcast))
+	return c.broadcast(ctx, tx, txOpts)
+}
+
+// CreateSession authorizes a session key to sign transactions for the
+// client's signer, within the scope, expiry, and spend cap of the session.
+// Transactions are signed with the session key using WithDelegator.
+func (c *Client) CreateSession(ctx context.Context, session *types.CreateSession, opts ...clientType.TxOpt) (types.Hash, error) {
+	txOpts := clientType.GetTxOpts(opts)
+	tx, err := c.newTx(ctx, session, txOpts)
+	if err != nil {
+		return types.Hash{}, err
+	}
+
+	c.logger.Debug("create session",
+		"session_key", session.SessionKey.String(), "auth_type", session.AuthType,
+		"expiry", session.Expiry, "fee", tx.Body.Fee.String(), "nonce", tx.Body.Nonce)
+
+	return c.broadcast(ctx, tx, txOpts)
+}
+
+// RevokeSession revokes a session key of the client's signer.
+func (c *Client) RevokeSession(ctx context.Context, sessionKey []byte, authType string, opts ...clientType.TxOpt) (types.Hash, error) {
+	revoke := &types.RevokeSession{
+		SessionKey: sessionKey,
+		AuthType:   authType,
+	}
+
+	txOpts := clientType.GetTxOpts(opts)
+	tx, err := c.newTx(ctx, revoke, txOpts)
+	if err != nil {
+		return types.Hash{}, err
+	}
+
+	c.logger.Debug("revoke session",
+		"session_key", revoke.SessionKey.String(), "auth_type", authType,
+		"fee", tx.Body.Fee.String(), "nonce", tx.Body.Nonce)
+
+	return c.broadcast(ctx, tx, txOpts)
+}
+
+// RegisterMultisig registers a multisig account with its serialized definition
+// (see auth.Multisig). The account ID is the SHA-256 hash of the definition.
+func (c *Client) RegisterMultisig(ctx context.Context, definition []byte, opts ...clientType.TxOpt) (types.Hash, error) {
+	reg := &types.RegisterMultisig{Definition: definition}
+
+	txOpts := clientType.GetTxOpts(opts)
+	tx, err := c.newTx(ctx, reg, txOpts)
+	if err != nil {
+		return types.Hash{}, err
+	}
+
+	c.logger.Debug("register multisig",
+		"definition", reg.Definition.String(),
+		"fee", tx.Body.Fee.String(), "nonce", tx.Body.Nonce)
+
+	return c.broadcast(ctx, tx, txOpts)
+}
+
+// UpdateMultisig replaces the definition of the client's signer, which must be
+// a multisig account. The account keeps its ID.
+func (c *Client) UpdateMultisig(ctx context.Context, definition []byte, opts ...clientType.TxOpt) (types.Hash, error) {
+	upd := &types.UpdateMultisig{Definition: definition}
+
+	txOpts := clientType.GetTxOpts(opts)
+	tx, err := c.newTx(ctx, upd, txOpts)
+	if err != nil {
+		return types.Hash{}, err
+	}
+
+	c.logger.Debug("update multisig",
+		"definition", upd.Definition.String(),
+		"fee", tx.Body.Fee.String(), "nonce", tx.Body.Nonce)
+
+	return c.broadcast(ctx, tx, txOpts)
 }
 
 // Call calls an action. It returns the result records.
-func (c *Client) Call(ctx context.Context, namespace string, action string, inputs []any) (*types.CallResult, error) {
+func (c *Client) Call(ctx context.Context, namespace string, action string, inputs []any, opts ...clientType.CallOpt) (*types.CallResult, error) {
 	encoded, err := EncodeInputs(inputs)
 	if err != nil {
 		return nil, err
@@ -337,6 +463,7 @@ func (c *Client) Call(ctx context.Context, namespace string, action string, inpu
 	if err != nil {
 		return nil, fmt.Errorf("create signed message: %w", err)
 	}
+	msg.Trace = clientType.GetCallOpts(opts).Trace
 
 	res, err := c.txClient.Call(ctx, msg)
 	if err != nil {
@@ -418,6 +545,17 @@ func (c *Client) TxQuery(ctx context.Context, txHash types.Hash) (*types.TxQuery
 	return c.txClient.TxQuery(ctx, txHash)
 }
 
+// Schema describes the actions of a deployed namespace, including the default
+// values of their parameters.
+func (c *Client) Schema(ctx context.Context, namespace string) (*types.Schema, error) {
+	return c.txClient.Schema(ctx, namespace)
+}
+
+// ExportSchema returns a Kuneiform script that recreates a deployed namespace.
+func (c *Client) ExportSchema(ctx context.Context, namespace string) (string, error) {
+	return c.txClient.ExportSchema(ctx, namespace)
+}
+
 // WaitTx repeatedly queries at a given interval for the status of a transaction
 // until it is confirmed (is included in a block).
 func (c *Client) WaitTx(ctx context.Context, txHash types.Hash, interval time.Duration) (*types.TxQueryResponse, error) {
@@ -46,6 +46,10 @@ func (c *Client) newTx(ctx context.Context, data types.Payload, txOpts *clientTy
 	if err != nil {
 		return nil, fmt.Errorf("failed to create transaction: %w", err)
 	}
+	tx.Body.ValidAfterHeight = txOpts.ValidAfterHeight
+	tx.Body.ValidUntilHeight = txOpts.ValidUntilHeight
+	tx.Body.FeePayer = txOpts.FeePayer
+	tx.Body.Delegator = txOpts.Delegator
 
 	// estimate price
 	price := txOpts.Fee
@@ -65,5 +69,11 @@ func (c *Client) newTx(ctx context.Context, data types.Payload, txOpts *clientTy
 		return nil, fmt.Errorf("failed to sign transaction: %w", err)
 	}
 
+	if txOpts.FeePayerSigner != nil {
+		if err = tx.SignFeePayer(txOpts.FeePayerSigner); err != nil {
+			return nil, fmt.Errorf("failed to sign transaction as fee payer: %w", err)
+		}
+	}
+
 	return tx, nil
 }
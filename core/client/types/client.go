@@ -16,13 +16,20 @@ import (
 
 // Client defines methods are used to talk to a Kwil provider.
 type Client interface {
-	Call(ctx context.Context, namespace string, action string, inputs []any) (*types.CallResult, error)
+	Call(ctx context.Context, namespace string, action string, inputs []any, opts ...CallOpt) (*types.CallResult, error)
 	ChainID() string
 	ChainInfo(ctx context.Context) (*types.ChainInfo, error)
 	Execute(ctx context.Context, namespace string, action string, tuples [][]any, opts ...TxOpt) (types.Hash, error)
 	ExecuteSQL(ctx context.Context, sql string, params map[string]any, opts ...TxOpt) (types.Hash, error)
+	ExecuteMulti(ctx context.Context, ops []types.Payload, opts ...TxOpt) (types.Hash, error)
+	CreateSession(ctx context.Context, session *types.CreateSession, opts ...TxOpt) (types.Hash, error)
+	RevokeSession(ctx context.Context, sessionKey []byte, authType string, opts ...TxOpt) (types.Hash, error)
+	RegisterMultisig(ctx context.Context, definition []byte, opts ...TxOpt) (types.Hash, error)
+	UpdateMultisig(ctx context.Context, definition []byte, opts ...TxOpt) (types.Hash, error)
+	ExportSchema(ctx context.Context, namespace string) (string, error)
 	GetAccount(ctx context.Context, account *types.AccountID, status types.AccountStatus) (*types.Account, error)
 	Ping(ctx context.Context) (string, error)
+	Schema(ctx context.Context, namespace string) (*types.Schema, error)
 	Query(ctx context.Context, query string, params map[string]any, auth bool) (*types.QueryResult, error)
 	TxQuery(ctx context.Context, txHash types.Hash) (*types.TxQueryResponse, error)
 	WaitTx(ctx context.Context, txHash types.Hash, interval time.Duration) (*types.TxQueryResponse, error)
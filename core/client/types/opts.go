@@ -6,6 +6,7 @@ import (
 
 	"github.com/trufnetwork/kwil-db/core/crypto/auth"
 	"github.com/trufnetwork/kwil-db/core/log"
+	"github.com/trufnetwork/kwil-db/core/types"
 )
 
 // Options are options that can be set for the client
@@ -79,7 +80,27 @@ type TxOptions struct {
 	Nonce int64
 	Fee   *big.Int
 
+	// ValidAfterHeight and ValidUntilHeight limit the blocks that the
+	// transaction may be included in. Zero is no limit.
+	ValidAfterHeight int64
+	ValidUntilHeight int64
+
+	// FeePayer, if set, sponsors the transaction by paying its fee. The
+	// transaction must also be signed by it, either by FeePayerSigner, or by
+	// the fee payer after it is given to a TxHandler.
+	FeePayer *types.FeePayer
+	// FeePayerSigner, if set, is the fee payer that signs the transaction.
+	FeePayerSigner auth.Signer
+
+	// Delegator, if set, is the account for which the transaction is signed
+	// with a session key that it authorized.
+	Delegator *types.Delegator
+
 	SyncBcast bool // wait for mining on broadcast
+
+	// TxHandler, if set, is given the signed transaction instead of it being
+	// broadcast.
+	TxHandler func(*types.Transaction) error
 }
 
 func GetTxOpts(opts []TxOpt) *TxOptions {
@@ -108,6 +129,59 @@ func WithFee(fee *big.Int) TxOpt {
 	}
 }
 
+// WithValidAfterHeight sets the height that a block must be above for the
+// transaction to be included in it.
+func WithValidAfterHeight(height int64) TxOpt {
+	return func(o *TxOptions) {
+		o.ValidAfterHeight = height
+	}
+}
+
+// WithValidUntilHeight sets the highest block that the transaction may be
+// included in. A transaction that is not included by then expires.
+func WithValidUntilHeight(height int64) TxOpt {
+	return func(o *TxOptions) {
+		o.ValidUntilHeight = height
+	}
+}
+
+// WithFeePayer sets the account that pays the fee of the transaction instead
+// of the sender. Since the fee payer must also sign the transaction, it is
+// normally used with WithTxHandler to give the transaction to the fee payer,
+// which signs it with Transaction.SignFeePayer and broadcasts it.
+func WithFeePayer(identifier []byte, authType string) TxOpt {
+	return func(o *TxOptions) {
+		o.FeePayer = &types.FeePayer{
+			Identifier: identifier,
+			AuthType:   authType,
+		}
+	}
+}
+
+// WithFeePayerSigner sets the account that pays the fee of the transaction
+// instead of the sender, and signs the transaction as it.
+func WithFeePayerSigner(signer auth.Signer) TxOpt {
+	return func(o *TxOptions) {
+		o.FeePayer = &types.FeePayer{
+			Identifier: signer.CompactID(),
+			AuthType:   signer.AuthType(),
+		}
+		o.FeePayerSigner = signer
+	}
+}
+
+// WithDelegator sets the account for which the transaction is signed, when the
+// client's signer is a session key that the account authorized with a
+// CreateSession transaction. The transaction is executed as the delegator's.
+func WithDelegator(identifier []byte, authType string) TxOpt {
+	return func(o *TxOptions) {
+		o.Delegator = &types.Delegator{
+			Identifier: identifier,
+			AuthType:   authType,
+		}
+	}
+}
+
 // WithSyncBroadcast indicates that broadcast should wait for the transaction to
 // be included in a block, not merely accepted into mempool.
 func WithSyncBroadcast(wait bool) TxOpt {
@@ -115,3 +189,39 @@ func WithSyncBroadcast(wait bool) TxOpt {
 		o.SyncBcast = wait
 	}
 }
+
+// WithTxHandler makes the transaction methods give the signed transaction to
+// handle instead of broadcasting it, and return its hash. This is used to
+// collect the signatures of the members of a multisig account before the
+// transaction is broadcast.
+func WithTxHandler(handle func(*types.Transaction) error) TxOpt {
+	return func(o *TxOptions) {
+		o.TxHandler = handle
+	}
+}
+
+// CallOptions is the options for calling an action.
+type CallOptions struct {
+	// Trace requests an execution trace of the call.
+	Trace bool
+}
+
+// GetCallOpts returns the options set by opts.
+func GetCallOpts(opts []CallOpt) *CallOptions {
+	callOpts := &CallOptions{}
+	for _, opt := range opts {
+		opt(callOpts)
+	}
+	return callOpts
+}
+
+// CallOpt sets an option used when calling an action.
+type CallOpt func(*CallOptions)
+
+// WithTrace requests an execution trace of the call, which is returned in
+// the Trace field of the result.
+func WithTrace() CallOpt {
+	return func(o *CallOptions) {
+		o.Trace = true
+	}
+}
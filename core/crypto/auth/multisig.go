@@ -0,0 +1,397 @@
+package auth
+
+import (
+	"bytes"
+	"crypto/sha256"
+	"encoding/binary"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"io"
+	"slices"
+
+	"github.com/trufnetwork/kwil-db/core/crypto"
+)
+
+const (
+	// MultisigAuth is the authenticator name of the multisignature accounts.
+	MultisigAuth = "multisig"
+
+	// MaxMultisigMembers is the most members that a multisig account may have.
+	MaxMultisigMembers = 20
+
+	// MultisigIDSize is the size of the ID of a multisig account, which is
+	// the SHA-256 hash of the definition it was registered with.
+	MultisigIDSize = sha256.Size
+)
+
+// MultisigMember is a member of a multisig account, identified like the
+// sender of a transaction: by the AuthType and CompactID of its Signer.
+type MultisigMember struct {
+	AuthType string `json:"type"`
+	ID       []byte `json:"id"`
+}
+
+// Multisig defines a multisignature account, which authorizes transactions
+// signed by at least Threshold of its Members. An account must be registered
+// with its definition before it is used. Its ID, which is the CompactID of its
+// transactions, is the ID of the definition it was registered with, and it is
+// kept when the account changes its definition. It implements crypto.PublicKey
+// so that it can be the key of a MultisigSigner.
+type Multisig struct {
+	Threshold uint8
+	Members   []*MultisigMember
+}
+
+var _ crypto.PublicKey = (*Multisig)(nil)
+
+// NewMultisig creates a multisig account definition.
+func NewMultisig(threshold uint8, members []*MultisigMember) (*Multisig, error) {
+	m := &Multisig{Threshold: threshold, Members: members}
+	if err := m.validate(); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func (m *Multisig) validate() error {
+	if len(m.Members) == 0 || len(m.Members) > MaxMultisigMembers {
+		return fmt.Errorf("multisig must have between 1 and %d members, has %d", MaxMultisigMembers, len(m.Members))
+	}
+	if m.Threshold == 0 || int(m.Threshold) > len(m.Members) {
+		return fmt.Errorf("multisig threshold %d must be between 1 and the %d members", m.Threshold, len(m.Members))
+	}
+	for i, member := range m.Members {
+		if member.AuthType == "" || len(member.ID) == 0 {
+			return fmt.Errorf("multisig member %d has no auth type or ID", i)
+		}
+		if member.AuthType == MultisigAuth {
+			return errors.New("multisig members may not be multisig accounts")
+		}
+		if m.MemberIndex(member.AuthType, member.ID) != i {
+			return fmt.Errorf("multisig member %d is a duplicate", i)
+		}
+	}
+	return nil
+}
+
+// MemberIndex returns the index of the member with the given auth type and
+// ID, or -1 if there is none.
+func (m *Multisig) MemberIndex(authType string, id []byte) int {
+	return slices.IndexFunc(m.Members, func(member *MultisigMember) bool {
+		return member.AuthType == authType && bytes.Equal(member.ID, id)
+	})
+}
+
+// Bytes returns the serialized definition.
+func (m *Multisig) Bytes() []byte {
+	buf := new(bytes.Buffer)
+	buf.WriteByte(m.Threshold)
+	buf.WriteByte(uint8(len(m.Members)))
+	for _, member := range m.Members {
+		buf.Write(binary.AppendUvarint(nil, uint64(len(member.AuthType))))
+		buf.WriteString(member.AuthType)
+		buf.Write(binary.AppendUvarint(nil, uint64(len(member.ID))))
+		buf.Write(member.ID)
+	}
+	return buf.Bytes()
+}
+
+// ID returns the SHA-256 hash of the serialized definition, which is the ID of
+// an account registered with this definition.
+func (m *Multisig) ID() []byte {
+	hash := sha256.Sum256(m.Bytes())
+	return hash[:]
+}
+
+// Type returns crypto.KeyTypeMultisig.
+func (m *Multisig) Type() crypto.KeyType {
+	return crypto.KeyTypeMultisig
+}
+
+// Equals checks whether the key is the same multisig definition.
+func (m *Multisig) Equals(key crypto.Key) bool {
+	other, ok := key.(*Multisig)
+	return ok && bytes.Equal(m.Bytes(), other.Bytes())
+}
+
+// Verify checks that sig is a MultisigSignature of data for this definition by
+// at least the threshold of members, using the authenticators of the Kwil Go
+// SDK.
+func (m *Multisig) Verify(data []byte, sig []byte) (bool, error) {
+	ms, err := UnmarshalMultisigSignature(sig)
+	if err != nil {
+		return false, err
+	}
+	if !m.Equals(ms.Multisig) {
+		return false, errors.New("multisig signature is for another definition")
+	}
+	if err := (MultisigAuthenticator{}).verify(ms, data); err != nil {
+		return false, err
+	}
+	return true, nil
+}
+
+// UnmarshalMultisig deserializes and validates a multisig account definition.
+func UnmarshalMultisig(data []byte) (*Multisig, error) {
+	r := bytes.NewReader(data)
+
+	var header [2]byte
+	if _, err := io.ReadFull(r, header[:]); err != nil {
+		return nil, fmt.Errorf("failed to read multisig header: %w", err)
+	}
+	m := &Multisig{Threshold: header[0]}
+	for range header[1] {
+		authType, err := readMultisigBytes(r)
+		if err != nil {
+			return nil, fmt.Errorf("failed to read multisig member auth type: %w", err)
+		}
+		id, err := readMultisigBytes(r)
+		if err != nil {
+			return nil, fmt.Errorf("failed to read multisig member ID: %w", err)
+		}
+		m.Members = append(m.Members, &MultisigMember{AuthType: string(authType), ID: id})
+	}
+	if r.Len() != 0 {
+		return nil, errors.New("extra multisig data")
+	}
+
+	if err := m.validate(); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+// readMultisigBytes reads bytes with a uvarint length prefix.
+func readMultisigBytes(r *bytes.Reader) ([]byte, error) {
+	n, err := binary.ReadUvarint(r)
+	if err != nil {
+		return nil, err
+	}
+	if n > uint64(r.Len()) {
+		return nil, fmt.Errorf("impossibly long length: %d", n)
+	}
+	b := make([]byte, n)
+	_, err = io.ReadFull(r, b)
+	return b, err
+}
+
+// MultisigPartial is the signature of one member of a multisig account.
+type MultisigPartial struct {
+	// Index is the index of the member in the account's Members.
+	Index uint8
+	// Data is the signature of the member, made by the Signer of its AuthType.
+	Data []byte
+}
+
+// MultisigSignature is the Data of a multisig Signature: the definition of the
+// account that the members signed with, and the signatures of the members that
+// signed, ordered by member index.
+type MultisigSignature struct {
+	Multisig *Multisig
+	Partials []*MultisigPartial
+}
+
+// Add adds or replaces the signature of a member.
+func (s *MultisigSignature) Add(partial *MultisigPartial) {
+	i, found := slices.BinarySearchFunc(s.Partials, partial.Index, func(p *MultisigPartial, idx uint8) int {
+		return int(p.Index) - int(idx)
+	})
+	if found {
+		s.Partials[i] = partial
+		return
+	}
+	s.Partials = slices.Insert(s.Partials, i, partial)
+}
+
+// Bytes returns the serialized signature, which is the Data of a Signature.
+func (s *MultisigSignature) Bytes() []byte {
+	buf := new(bytes.Buffer)
+	def := s.Multisig.Bytes()
+	buf.Write(binary.AppendUvarint(nil, uint64(len(def))))
+	buf.Write(def)
+	buf.WriteByte(uint8(len(s.Partials)))
+	for _, p := range s.Partials {
+		buf.WriteByte(p.Index)
+		buf.Write(binary.AppendUvarint(nil, uint64(len(p.Data))))
+		buf.Write(p.Data)
+	}
+	return buf.Bytes()
+}
+
+// UnmarshalMultisigSignature deserializes a multisig signature. The partial
+// signatures must be ordered by member index without duplicates, so that a
+// signature has a single serialization.
+func UnmarshalMultisigSignature(data []byte) (*MultisigSignature, error) {
+	r := bytes.NewReader(data)
+	def, err := readMultisigBytes(r)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read multisig signature definition: %w", err)
+	}
+	m, err := UnmarshalMultisig(def)
+	if err != nil {
+		return nil, err
+	}
+	n, err := r.ReadByte()
+	if err != nil {
+		return nil, fmt.Errorf("failed to read multisig signature count: %w", err)
+	}
+
+	s := &MultisigSignature{Multisig: m}
+	for i := range n {
+		idx, err := r.ReadByte()
+		if err != nil {
+			return nil, fmt.Errorf("failed to read multisig signature index: %w", err)
+		}
+		if i > 0 && idx <= s.Partials[i-1].Index {
+			return nil, errors.New("multisig signatures are not ordered by member")
+		}
+		sig, err := readMultisigBytes(r)
+		if err != nil {
+			return nil, fmt.Errorf("failed to read multisig signature: %w", err)
+		}
+		s.Partials = append(s.Partials, &MultisigPartial{Index: idx, Data: sig})
+	}
+	if r.Len() != 0 {
+		return nil, errors.New("extra multisig signature data")
+	}
+	return s, nil
+}
+
+// MultisigAuthenticator verifies the signatures of multisig accounts, whose
+// CompactID is the account ID and signature is a serialized MultisigSignature.
+// The signature includes the definition that the members signed with, and it
+// is only verified against that definition. The account may have changed its
+// definition since it was registered, so whether the definition is the
+// current one of the account is for the node to check against its registry.
+type MultisigAuthenticator struct {
+	// Authenticators gets the Authenticator of the members' signatures. If nil,
+	// only the authenticators of the Kwil Go SDK are recognized.
+	Authenticators func(authType string) (Authenticator, error)
+}
+
+var _ Authenticator = MultisigAuthenticator{}
+
+// Identifier returns the hex-encoded account ID.
+func (a MultisigAuthenticator) Identifier(compactID []byte) (string, error) {
+	if len(compactID) != MultisigIDSize {
+		return "", fmt.Errorf("multisig account ID must be %d bytes, not %d", MultisigIDSize, len(compactID))
+	}
+	return hex.EncodeToString(compactID), nil
+}
+
+// Verify checks that the signature has valid signatures of msg by at least
+// the threshold of members of the definition in the signature.
+func (a MultisigAuthenticator) Verify(compactID, msg, signature []byte) error {
+	if len(compactID) != MultisigIDSize {
+		return fmt.Errorf("multisig account ID must be %d bytes, not %d", MultisigIDSize, len(compactID))
+	}
+	sig, err := UnmarshalMultisigSignature(signature)
+	if err != nil {
+		return err
+	}
+	return a.verify(sig, msg)
+}
+
+func (a MultisigAuthenticator) verify(sig *MultisigSignature, msg []byte) error {
+	m := sig.Multisig
+	if len(sig.Partials) < int(m.Threshold) {
+		return fmt.Errorf("%w: %d of the %d required multisig signatures",
+			crypto.ErrInvalidSignature, len(sig.Partials), m.Threshold)
+	}
+
+	for _, p := range sig.Partials {
+		if int(p.Index) >= len(m.Members) {
+			return fmt.Errorf("multisig signature of unknown member %d", p.Index)
+		}
+		member := m.Members[p.Index]
+		authn, err := a.authenticator(member.AuthType)
+		if err != nil {
+			return err
+		}
+		if err := authn.Verify(member.ID, msg, p.Data); err != nil {
+			return fmt.Errorf("multisig signature of member %d: %w", p.Index, err)
+		}
+	}
+	return nil
+}
+
+func (a MultisigAuthenticator) authenticator(authType string) (Authenticator, error) {
+	if a.Authenticators != nil {
+		return a.Authenticators(authType)
+	}
+	switch authType {
+	case Ed25519Auth:
+		return Ed25519Authenticator{}, nil
+	case EthPersonalSignAuth:
+		return EthSecp256k1Authenticator{}, nil
+	case Secp256k1Auth:
+		return Secp25k1Authenticator{}, nil
+	}
+	return nil, fmt.Errorf("unknown multisig member auth type: %s", authType)
+}
+
+func (MultisigAuthenticator) KeyType() crypto.KeyType {
+	return crypto.KeyTypeMultisig
+}
+
+// MultisigSigner signs for a multisig account with the Signers of some of its
+// members. If there are fewer than the threshold, the signature is partial,
+// and it must be combined with those of the other members before broadcast.
+type MultisigSigner struct {
+	// Multisig is the current definition of the account.
+	Multisig *Multisig
+	// ID is the account ID. If nil, it is the ID of Multisig, which is only
+	// the account ID if the account has not changed its definition since it
+	// was registered.
+	ID      []byte
+	Signers []Signer
+}
+
+var _ Signer = (*MultisigSigner)(nil)
+
+// Sign signs the message with each of the Signers.
+func (s *MultisigSigner) Sign(msg []byte) (*Signature, error) {
+	sig := &MultisigSignature{Multisig: s.Multisig}
+	for _, signer := range s.Signers {
+		partial, err := SignMultisigPartial(s.Multisig, signer, msg)
+		if err != nil {
+			return nil, err
+		}
+		sig.Add(partial)
+	}
+
+	return &Signature{
+		Data: sig.Bytes(),
+		Type: MultisigAuth,
+	}, nil
+}
+
+// SignMultisigPartial signs a message for a multisig account with the Signer
+// of one of its members.
+func SignMultisigPartial(m *Multisig, signer Signer, msg []byte) (*MultisigPartial, error) {
+	idx := m.MemberIndex(signer.AuthType(), signer.CompactID())
+	if idx < 0 {
+		return nil, fmt.Errorf("signer %x (%s) is not a member of the multisig account", signer.CompactID(), signer.AuthType())
+	}
+	sig, err := signer.Sign(msg)
+	if err != nil {
+		return nil, err
+	}
+	return &MultisigPartial{Index: uint8(idx), Data: sig.Data}, nil
+}
+
+func (s *MultisigSigner) CompactID() []byte {
+	if s.ID != nil {
+		return s.ID
+	}
+	return s.Multisig.ID()
+}
+
+func (s *MultisigSigner) PubKey() crypto.PublicKey {
+	return s.Multisig
+}
+
+func (s *MultisigSigner) AuthType() string {
+	return MultisigAuth
+}
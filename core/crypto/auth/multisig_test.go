@@ -0,0 +1,159 @@
+package auth_test
+
+import (
+	"encoding/binary"
+	"encoding/hex"
+	"slices"
+	"testing"
+
+	"github.com/trufnetwork/kwil-db/core/crypto/auth"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func testMultisig(t *testing.T, threshold uint8) (*auth.Multisig, []auth.Signer) {
+	signers := []auth.Signer{
+		secp256k1Signer(t, [32]byte{1}),
+		ed25519Signer(t, [32]byte{2}),
+		secp256k1PlainSigner(t, [32]byte{3}),
+	}
+	members := make([]*auth.MultisigMember, len(signers))
+	for i, s := range signers {
+		members[i] = &auth.MultisigMember{AuthType: s.AuthType(), ID: s.CompactID()}
+	}
+	m, err := auth.NewMultisig(threshold, members)
+	require.NoError(t, err)
+	return m, signers
+}
+
+func TestMultisigRoundTrip(t *testing.T) {
+	m, _ := testMultisig(t, 2)
+
+	m2, err := auth.UnmarshalMultisig(m.Bytes())
+	require.NoError(t, err)
+	assert.Equal(t, m, m2)
+	assert.True(t, m.Equals(m2))
+
+	_, err = auth.UnmarshalMultisig(append(m.Bytes(), 0))
+	assert.Error(t, err)
+	_, err = auth.UnmarshalMultisig(m.Bytes()[:len(m.Bytes())-1])
+	assert.Error(t, err)
+
+	assert.Len(t, m.ID(), auth.MultisigIDSize)
+	m3, _ := testMultisig(t, 3)
+	assert.NotEqual(t, m.ID(), m3.ID())
+}
+
+func TestNewMultisigInvalid(t *testing.T) {
+	member := &auth.MultisigMember{AuthType: auth.Ed25519Auth, ID: []byte{1}}
+
+	_, err := auth.NewMultisig(1, nil)
+	assert.Error(t, err, "no members")
+	_, err = auth.NewMultisig(0, []*auth.MultisigMember{member})
+	assert.Error(t, err, "zero threshold")
+	_, err = auth.NewMultisig(2, []*auth.MultisigMember{member})
+	assert.Error(t, err, "threshold above members")
+	_, err = auth.NewMultisig(1, []*auth.MultisigMember{member, member})
+	assert.Error(t, err, "duplicate member")
+	_, err = auth.NewMultisig(1, []*auth.MultisigMember{{AuthType: auth.MultisigAuth, ID: []byte{1}}})
+	assert.Error(t, err, "nested multisig")
+}
+
+func TestMultisigSignAndVerify(t *testing.T) {
+	m, signers := testMultisig(t, 2)
+	msg := []byte("message")
+	authn := auth.MultisigAuthenticator{}
+	id := m.ID()
+
+	ident, err := authn.Identifier(id)
+	require.NoError(t, err)
+	assert.Equal(t, hex.EncodeToString(id), ident)
+	_, err = authn.Identifier(m.Bytes())
+	require.Error(t, err)
+
+	// one of two required signatures
+	partial := &auth.MultisigSigner{Multisig: m, Signers: signers[2:]}
+	assert.Equal(t, id, partial.CompactID())
+	sig, err := partial.Sign(msg)
+	require.NoError(t, err)
+	assert.Equal(t, auth.MultisigAuth, sig.Type)
+	require.Error(t, authn.Verify(id, msg, sig.Data))
+
+	// combine with a second member's signature made separately
+	ms, err := auth.UnmarshalMultisigSignature(sig.Data)
+	require.NoError(t, err)
+	p, err := auth.SignMultisigPartial(m, signers[0], msg)
+	require.NoError(t, err)
+	ms.Add(p)
+	require.Len(t, ms.Partials, 2)
+	assert.Equal(t, uint8(0), ms.Partials[0].Index)
+	require.NoError(t, authn.Verify(id, msg, ms.Bytes()))
+
+	ok, err := m.Verify(msg, ms.Bytes())
+	require.NoError(t, err)
+	assert.True(t, ok)
+
+	// all members
+	full := &auth.MultisigSigner{Multisig: m, Signers: signers}
+	sig, err = full.Sign(msg)
+	require.NoError(t, err)
+	require.NoError(t, authn.Verify(id, msg, sig.Data))
+	require.Error(t, authn.Verify(id, []byte("other message"), sig.Data))
+
+	// signer that is not a member
+	_, err = auth.SignMultisigPartial(m, ed25519Signer(t, [32]byte{4}), msg)
+	require.Error(t, err)
+
+	// the signature is for the definition it includes, not another one
+	other, _ := testMultisig(t, 1)
+	ok, err = other.Verify(msg, sig.Data)
+	require.Error(t, err)
+	assert.False(t, ok)
+}
+
+func TestMultisigSignerRotatedID(t *testing.T) {
+	m, signers := testMultisig(t, 1)
+	id := m.ID()
+
+	// the account changed its definition, and keeps the ID it was registered with
+	rotated, _ := testMultisig(t, 2)
+	signer := &auth.MultisigSigner{Multisig: rotated, ID: id, Signers: signers[:2]}
+	assert.Equal(t, id, signer.CompactID())
+
+	msg := []byte("message")
+	sig, err := signer.Sign(msg)
+	require.NoError(t, err)
+	require.NoError(t, auth.MultisigAuthenticator{}.Verify(id, msg, sig.Data))
+
+	ms, err := auth.UnmarshalMultisigSignature(sig.Data)
+	require.NoError(t, err)
+	assert.True(t, rotated.Equals(ms.Multisig))
+}
+
+func TestMultisigSignatureCanonical(t *testing.T) {
+	m, _ := testMultisig(t, 2)
+	sig := &auth.MultisigSignature{Multisig: m}
+	sig.Add(&auth.MultisigPartial{Index: 2, Data: []byte{2}})
+	sig.Add(&auth.MultisigPartial{Index: 0, Data: []byte{0}})
+	sig.Add(&auth.MultisigPartial{Index: 2, Data: []byte{3}})
+
+	sig2, err := auth.UnmarshalMultisigSignature(sig.Bytes())
+	require.NoError(t, err)
+	assert.Equal(t, sig, sig2)
+
+	def := m.Bytes()
+	prefix := append(binary.AppendUvarint(nil, uint64(len(def))), def...)
+
+	unordered := append(slices.Clone(prefix), 2, 2, 1, 2, 0, 1, 0)
+	_, err = auth.UnmarshalMultisigSignature(unordered)
+	require.Error(t, err)
+
+	duplicate := append(slices.Clone(prefix), 2, 0, 1, 0, 0, 1, 0)
+	_, err = auth.UnmarshalMultisigSignature(duplicate)
+	require.Error(t, err)
+
+	ordered := append(slices.Clone(prefix), 2, 0, 1, 0, 2, 1, 0)
+	_, err = auth.UnmarshalMultisigSignature(ordered)
+	require.NoError(t, err)
+}
@@ -27,11 +27,13 @@ var (
 	keyTypes = map[KeyType]KeyDefinition{
 		KeyTypeSecp256k1: Secp256k1Definition{},
 		KeyTypeEd25519:   Ed25519Definition{},
+		KeyTypeMultisig:  MultisigDefinition{},
 	}
 
 	encodingIDs = map[uint32]KeyType{
 		Secp256k1Definition{}.EncodeFlag(): KeyTypeSecp256k1,
 		Ed25519Definition{}.EncodeFlag():   KeyTypeEd25519,
+		MultisigDefinition{}.EncodeFlag():  KeyTypeMultisig,
 	}
 )
 
@@ -105,7 +107,11 @@ func GeneratePrivateKey(kt KeyType) (PrivateKey, error) {
 	if !ok {
 		return nil, fmt.Errorf("unknown key type: %v", kt)
 	}
-	return kd.Generate(), nil
+	key := kd.Generate()
+	if key == nil {
+		return nil, fmt.Errorf("key type %v has no private key", kt)
+	}
+	return key, nil
 }
 
 func WireEncodeKeyType(kt KeyType) []byte {
@@ -16,11 +16,16 @@ type KeyType string
 const (
 	KeyTypeSecp256k1 KeyType = "secp256k1"
 	KeyTypeEd25519   KeyType = "ed25519"
+
+	// KeyTypeMultisig is the type of the multisignature accounts, which are
+	// defined by the keys of their members instead of a key of their own.
+	KeyTypeMultisig KeyType = "multisig"
 )
 
 const (
 	keyIDSecp256k1 = iota
 	keyIDEd25519
+	keyIDMultisig
 )
 
 func (kt KeyType) String() string {
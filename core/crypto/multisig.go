@@ -0,0 +1,35 @@
+package crypto
+
+import "errors"
+
+// ErrNoMultisigKey is returned when unmarshalling or generating a key of a
+// multisignature account, which has no key of its own.
+var ErrNoMultisigKey = errors.New("multisig accounts have no key of their own")
+
+// MultisigDefinition is the KeyDefinition of the multisignature accounts. The
+// accounts are defined and verified in the auth package, so this definition
+// only registers the key type.
+type MultisigDefinition struct{}
+
+var _ KeyDefinition = MultisigDefinition{}
+
+func (MultisigDefinition) Type() KeyType {
+	return KeyTypeMultisig
+}
+
+func (MultisigDefinition) EncodeFlag() uint32 {
+	return keyIDMultisig
+}
+
+func (MultisigDefinition) UnmarshalPrivateKey(b []byte) (PrivateKey, error) {
+	return nil, ErrNoMultisigKey
+}
+
+func (MultisigDefinition) UnmarshalPublicKey(b []byte) (PublicKey, error) {
+	return nil, ErrNoMultisigKey
+}
+
+// Generate returns nil, as there is no multisig private key.
+func (MultisigDefinition) Generate() PrivateKey {
+	return nil
+}
@@ -142,10 +142,10 @@ func NewClient(ctx context.Context, target string, opts *GatewayOptions) (*Gatew
 
 // Call call an action. It returns the result records.  If authentication is needed,
 // it will call the gatewaySigner to sign the authentication message.
-func (c *GatewayClient) Call(ctx context.Context, namespace string, action string, inputs []any) (*types.CallResult, error) {
+func (c *GatewayClient) Call(ctx context.Context, namespace string, action string, inputs []any, opts ...clientType.CallOpt) (*types.CallResult, error) {
 	// we will try to call with the current cookies set.  If we receive an error and it is an auth error,
 	// we will re-auth and retry.  We will only retry once.
-	res, err := c.Client.Call(ctx, namespace, action, inputs)
+	res, err := c.Client.Call(ctx, namespace, action, inputs, opts...)
 	if err == nil {
 		return res, nil
 	}
@@ -164,7 +164,7 @@ func (c *GatewayClient) Call(ctx context.Context, namespace string, action strin
 	}
 
 	// retry the call
-	return c.Client.Call(ctx, namespace, action, inputs)
+	return c.Client.Call(ctx, namespace, action, inputs, opts...)
 }
 
 // authenticate authenticates the client with the gateway.
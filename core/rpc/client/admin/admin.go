@@ -39,4 +39,7 @@ type AdminClient interface {
 	// Block Execution
 	BlockExecStatus(ctx context.Context) (*adminTypes.BlockExecutionStatus, error)
 	AbortBlockExecution(ctx context.Context, height int64, discardTxs []string) error
+	// ExecProfile gets the slowest actions and statements executed in blocks,
+	// at most limit of each, optionally only those of a namespace.
+	ExecProfile(ctx context.Context, limit int, namespace string) (*adminTypes.ExecProfile, error)
 }
@@ -317,3 +317,16 @@ func (cl *Client) AbortBlockExecution(ctx context.Context, height int64, discard
 	res := &adminjson.AbortBlockExecResponse{}
 	return cl.CallMethod(ctx, string(adminjson.MethodAbortBlockExecution), cmd, res)
 }
+
+func (cl *Client) ExecProfile(ctx context.Context, limit int, namespace string) (*adminTypes.ExecProfile, error) {
+	cmd := &adminjson.ExecProfileRequest{
+		Limit:     limit,
+		Namespace: namespace,
+	}
+	res := &adminjson.ExecProfileResponse{}
+	err := cl.CallMethod(ctx, string(adminjson.MethodExecProfile), cmd, res)
+	if err != nil {
+		return nil, err
+	}
+	return res.Profile, nil
+}
@@ -158,6 +158,32 @@ func (cl *Client) Query(ctx context.Context, query string, params map[string]*ty
 	return (*types.QueryResult)(res), nil
 }
 
+func (cl *Client) Schema(ctx context.Context, namespace string) (*types.Schema, error) {
+	cmd := &userjson.SchemaRequest{
+		Namespace: namespace,
+	}
+	res := &userjson.SchemaResponse{}
+	err := cl.CallMethod(ctx, string(userjson.MethodSchema), cmd, res)
+	if err != nil {
+		return nil, err
+	}
+
+	return res, nil
+}
+
+func (cl *Client) ExportSchema(ctx context.Context, namespace string) (string, error) {
+	cmd := &userjson.ExportSchemaRequest{
+		Namespace: namespace,
+	}
+	res := &userjson.ExportSchemaResponse{}
+	err := cl.CallMethod(ctx, string(userjson.MethodExportSchema), cmd, res)
+	if err != nil {
+		return "", err
+	}
+
+	return res.Schema, nil
+}
+
 func (cl *Client) AuthenticatedQuery(ctx context.Context, msg *types.AuthenticatedQuery) (*types.QueryResult, error) {
 	cmd := msg
 	res := &userjson.QueryResponse{}
@@ -22,6 +22,8 @@ type TxSvcClient interface {
 	Query(ctx context.Context, query string, params map[string]*types.EncodedValue) (*types.QueryResult, error)
 	AuthenticatedQuery(ctx context.Context, msg *types.AuthenticatedQuery) (*types.QueryResult, error)
 	TxQuery(ctx context.Context, txHash types.Hash) (*types.TxQueryResponse, error)
+	Schema(ctx context.Context, namespace string) (*types.Schema, error)
+	ExportSchema(ctx context.Context, namespace string) (string, error)
 
 	// Migration methods
 	ListMigrations(ctx context.Context) ([]*types.Migration, error)
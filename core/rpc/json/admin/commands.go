@@ -52,6 +52,14 @@ type ResolutionStatusRequest struct {
 
 type BlockExecStatusRequest struct{}
 
+// ExecProfileRequest requests the slowest actions and statements executed in
+// blocks. A zero Limit uses the server's default, and an empty Namespace
+// includes all namespaces.
+type ExecProfileRequest struct {
+	Limit     int    `json:"limit,omitempty"`
+	Namespace string `json:"namespace,omitempty"`
+}
+
 type AbortBlockExecRequest struct {
 	Height int64    `json:"height"`
 	Txs    []string `json:"txs"`
@@ -27,4 +27,5 @@ const (
 	// MethodDeleteResolution  jsonrpc.Method = "admin.delete_resolution"
 	MethodBlockExecStatus     jsonrpc.Method = "admin.block_exec_status"
 	MethodAbortBlockExecution jsonrpc.Method = "admin.abort_block_execution"
+	MethodExecProfile         jsonrpc.Method = "admin.exec_profile"
 )
@@ -110,4 +110,8 @@ type BlockExecStatusResponse struct {
 
 type AbortBlockExecResponse struct{}
 
+type ExecProfileResponse struct {
+	Profile *adminTypes.ExecProfile `json:"profile"`
+}
+
 type PromoteResponse struct{}
@@ -21,7 +21,12 @@ type VersionRequest struct{}
 
 // SchemaRequest contains the request parameters for MethodSchema.
 type SchemaRequest struct {
-	Namespace string `json:"namespace"`
+	Namespace string `json:"namespace" desc:"the namespace to describe"`
+}
+
+// ExportSchemaRequest contains the request parameters for MethodExportSchema.
+type ExportSchemaRequest struct {
+	Namespace string `json:"namespace" desc:"the namespace to export"`
 }
 
 // AccountRequest contains the request parameters for MethodAccount.
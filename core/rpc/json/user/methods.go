@@ -17,6 +17,7 @@ const (
 	MethodAuthenticatedQuery    jsonrpc.Method = "user.authenticated_query"
 	MethodTxQuery               jsonrpc.Method = "user.tx_query"
 	MethodSchema                jsonrpc.Method = "user.schema"
+	MethodExportSchema          jsonrpc.Method = "user.export_schema"
 	MethodUpdateProposalStatus  jsonrpc.Method = "user.update_proposal_status"
 	MethodListUpdateProposals   jsonrpc.Method = "user.list_update_proposals"
 	MethodMigrationStatus       jsonrpc.Method = "user.migration_status"
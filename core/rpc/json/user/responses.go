@@ -40,6 +40,14 @@ type QueryResponse types.QueryResult
 // CallResponse contains the response object for MethodCall.
 type CallResponse types.CallResult
 
+// ExportSchemaResponse contains the response object for MethodExportSchema.
+type ExportSchemaResponse struct {
+	Schema string `json:"schema" desc:"a Kuneiform script that recreates the namespace"`
+}
+
+// SchemaResponse contains the response object for MethodSchema.
+type SchemaResponse = types.Schema
+
 // ChainInfoResponse contains the response object for MethodChainInfo.
 type ChainInfoResponse = types.ChainInfo
 
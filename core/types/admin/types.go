@@ -66,3 +66,31 @@ type TxInfo struct {
 	ID     types.Hash `json:"id"`
 	Status bool       `json:"status"`
 }
+
+// ExecProfile is the time spent executing actions and their statements in
+// blocks, since the node started.
+type ExecProfile struct {
+	// Actions are the slowest actions, by total time.
+	Actions []*ExecProfileEntry `json:"actions"`
+	// Statements are the slowest statements of actions, by total time.
+	Statements []*ExecProfileEntry `json:"statements"`
+}
+
+// ExecProfileEntry is the execution profile of an action, or of a statement
+// of an action. The time and rows of an action or statement include those of
+// the statements and actions it runs.
+type ExecProfileEntry struct {
+	Namespace string `json:"namespace"`
+	Action    string `json:"action"`
+	// Line is the line of the statement in the action's CREATE ACTION
+	// statement. It is zero for actions.
+	Line int `json:"line,omitempty"`
+	// Statement is the first line of the statement's source.
+	Statement string `json:"statement,omitempty"`
+	// Calls is the number of times it was executed.
+	Calls     int64         `json:"calls"`
+	TotalTime time.Duration `json:"total_time_ns"`
+	MaxTime   time.Duration `json:"max_time_ns"`
+	// Rows is the number of rows returned or changed by its SQL statements.
+	Rows int64 `json:"rows"`
+}
@@ -21,6 +21,9 @@ type BlockResult struct {
 	Height    int64            `json:"height"`
 	Hash      types.Hash       `json:"hash"`
 	TxResults []types.TxResult `json:"tx_results"`
+	// ScheduleResults are the results of the actions called by schedules
+	// at the end of the block.
+	ScheduleResults []*types.ScheduleResult `json:"schedule_results,omitempty"`
 }
 
 type GenesisAlloc struct {
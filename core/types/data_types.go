@@ -91,7 +91,11 @@ func (c *DataType) String() string {
 	str := strings.Builder{}
 	aliased, ok := typeAlias[c.Name]
 	if !ok {
-		aliased = "[!invalid!]" + c.Name
+		if c.IsUserDefined() {
+			aliased = c.Name
+		} else {
+			aliased = "[!invalid!]" + c.Name
+		}
 	}
 
 	str.WriteString(aliased)
@@ -111,6 +115,23 @@ func (c *DataType) String() string {
 	return str.String()
 }
 
+// IsUserDefined reports whether the type refers to a type created with
+// CREATE TYPE, rather than to a built-in type. User-defined types are
+// resolved by name within the namespace that uses them.
+func (c *DataType) IsUserDefined() bool {
+	if c.Name == nullStr {
+		return false
+	}
+	if _, ok := typeAlias[strings.ToLower(c.Name)]; ok {
+		return false
+	}
+
+	return userTypeNameRegex.MatchString(c.Name)
+}
+
+// userTypeNameRegex matches the names that can be given to user-defined types.
+var userTypeNameRegex = regexp.MustCompile(`^[a-zA-Z][a-zA-Z0-9_]*$`)
+
 func (c *DataType) HasMetadata() bool {
 	return c.Metadata != [2]uint16{}
 }
@@ -164,7 +185,16 @@ func (c *DataType) Clean() error {
 
 	referencedType, ok := typeAlias[lName]
 	if !ok {
-		return fmt.Errorf("unknown type: %s", c.Name)
+		if !c.IsUserDefined() {
+			return fmt.Errorf("unknown type: %s", c.Name)
+		}
+
+		// user-defined types are checked to exist when they are used
+		if c.HasMetadata() {
+			return fmt.Errorf("type %s cannot have metadata", c.Name)
+		}
+		c.Name = lName
+		return nil
 	}
 
 	switch referencedType {
@@ -340,7 +370,7 @@ func ParseDataType(s string) (*DataType, error) {
 	}
 
 	// Regular expression to parse the data type
-	re := regexp.MustCompile(`^([a-z0-9]+)(\(([\d, ]+)\))?(\[\])?$`)
+	re := regexp.MustCompile(`^([a-z0-9_]+)(\(([\d, ]+)\))?(\[\])?$`)
 	matches := re.FindStringSubmatch(s)
 
 	if len(matches) == 0 {
@@ -353,7 +383,16 @@ func ParseDataType(s string) (*DataType, error) {
 
 	baseName, ok := typeAlias[baseType]
 	if !ok {
-		return nil, fmt.Errorf("unknown data type: %s", baseType)
+		// it might be a user-defined type, which cannot have metadata
+		dt := &DataType{
+			Name:    baseType,
+			IsArray: isArray,
+		}
+		if !dt.IsUserDefined() || rawMetadata != "" {
+			return nil, fmt.Errorf("unknown data type: %s", baseType)
+		}
+
+		return dt, nil
 	}
 
 	var metadata [2]uint16
@@ -175,6 +175,20 @@ func Test_ParseDataTypes(t *testing.T) {
 			in:        "decimal(10, a)",
 			wantError: true,
 		},
+		{
+			in: "my_type",
+			out: DataType{
+				Name: "my_type",
+			},
+		},
+		{
+			in:        "my_type(10, 2)",
+			wantError: true,
+		},
+		{
+			in:        "1type",
+			wantError: true,
+		},
 	}
 
 	for _, tt := range tests {
@@ -283,3 +283,96 @@ func TestDecodeArrayTypes(t *testing.T) {
 		assert.Equal(t, []*string{}, decoded)
 	})
 }
+
+func TestEncodedValue_UserDefined(t *testing.T) {
+	t.Run("enum", func(t *testing.T) {
+		ev, err := EncodeValue(&Enum{Type: "mood", Label: "happy"})
+		require.NoError(t, err)
+		assert.Equal(t, "mood", ev.Type.Name)
+
+		decoded, err := roundTrip(t, ev).Decode()
+		require.NoError(t, err)
+		assert.Equal(t, &Enum{Type: "mood", Label: "happy"}, decoded)
+	})
+
+	t.Run("composite with nested values", func(t *testing.T) {
+		c := Composite{
+			Type: "person",
+			Fields: []*CompositeField{
+				{Name: "name", Value: "alice"},
+				{Name: "age", Value: int64(30)},
+				{Name: "nickname", Value: nil},
+				{Name: "mood", Value: Enum{Type: "mood", Label: "sad"}},
+			},
+		}
+		ev, err := EncodeValue(c)
+		require.NoError(t, err)
+
+		decoded, err := roundTrip(t, ev).Decode()
+		require.NoError(t, err)
+
+		name, age := "alice", int64(30)
+		assert.Equal(t, &Composite{
+			Type: "person",
+			Fields: []*CompositeField{
+				{Name: "name", Value: &name},
+				{Name: "age", Value: &age},
+				{Name: "nickname", Value: nil},
+				{Name: "mood", Value: &Enum{Type: "mood", Label: "sad"}},
+			},
+		}, decoded)
+
+		v, ok := decoded.(*Composite).Field("age")
+		require.True(t, ok)
+		assert.Equal(t, &age, v)
+		_, ok = decoded.(*Composite).Field("missing")
+		require.False(t, ok)
+	})
+
+	t.Run("null user-defined value", func(t *testing.T) {
+		e := &EncodedValue{
+			Type: DataType{Name: "mood"},
+			Data: [][]byte{encodeNull()},
+		}
+		decoded, err := e.Decode()
+		require.NoError(t, err)
+		assert.Nil(t, decoded)
+	})
+
+	t.Run("missing type name", func(t *testing.T) {
+		_, err := EncodeValue(Enum{Label: "happy"})
+		require.Error(t, err)
+	})
+
+	t.Run("unknown kind", func(t *testing.T) {
+		e := &EncodedValue{
+			Type: DataType{Name: "mood"},
+			Data: [][]byte{encodeNotNull([]byte("xhappy"))},
+		}
+		_, err := e.Decode()
+		require.Error(t, err)
+	})
+
+	t.Run("arrays are not supported", func(t *testing.T) {
+		e := &EncodedValue{
+			Type: DataType{Name: "mood", IsArray: true},
+			Data: [][]byte{encodeNotNull([]byte("ehappy"))},
+		}
+		_, err := e.Decode()
+		require.Error(t, err)
+
+		_, err = EncodeValue([]Enum{{Type: "mood", Label: "happy"}})
+		require.ErrorContains(t, err, "not supported")
+		_, err = EncodeValue([]any{nil, &Composite{Type: "point"}})
+		require.ErrorContains(t, err, "not supported")
+	})
+}
+
+func roundTrip(t *testing.T, ev *EncodedValue) *EncodedValue {
+	bts, err := ev.MarshalBinary()
+	require.NoError(t, err)
+
+	var ev2 EncodedValue
+	require.NoError(t, ev2.UnmarshalBinary(bts))
+	return &ev2
+}
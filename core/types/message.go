@@ -44,6 +44,10 @@ type CallMessage struct {
 	// *auth.Signature struct, but it is now a []byte that represents just the
 	// signature data since the type is already in the AuthType field above.
 	SignatureData []byte `json:"signature"`
+
+	// Trace requests an execution trace of the call in the result. It is not
+	// signed, since it does not change what the call does.
+	Trace bool `json:"trace,omitempty"`
 }
 
 const callMsgToSignTmplV0 = `Kwil view call.
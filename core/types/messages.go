@@ -17,6 +17,7 @@ type BlockExecRequest struct {
 
 type BlockExecResult struct {
 	TxResults        []TxResult
+	ScheduleResults  []*ScheduleResult
 	AppHash          Hash
 	ValidatorUpdates []*Validator
 	ParamUpdates     ParamUpdates
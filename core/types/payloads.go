@@ -13,8 +13,11 @@ import (
 	"bytes"
 	"encoding"
 	"encoding/binary"
+	"encoding/json"
 	"errors"
 	"fmt"
+	"io"
+	"math"
 	"math/big"
 	"reflect"
 	"strconv"
@@ -51,6 +54,11 @@ const (
 	PayloadTypeCreateResolution    PayloadType = "create_resolution"
 	PayloadTypeApproveResolution   PayloadType = "approve_resolution"
 	PayloadTypeDeleteResolution    PayloadType = "delete_resolution"
+	PayloadTypeMultiOperation      PayloadType = "multi"
+	PayloadTypeCreateSession       PayloadType = "create_session"
+	PayloadTypeRevokeSession       PayloadType = "revoke_session"
+	PayloadTypeRegisterMultisig    PayloadType = "register_multisig"
+	PayloadTypeUpdateMultisig      PayloadType = "update_multisig"
 )
 
 // payloadConcreteTypes associates a payload type with the concrete type of
@@ -69,6 +77,11 @@ var payloadConcreteTypes = map[PayloadType]Payload{
 	PayloadTypeValidatorVoteBodies: &ValidatorVoteBodies{},
 	PayloadTypeCreateResolution:    &CreateResolution{},
 	PayloadTypeApproveResolution:   &ApproveResolution{},
+	PayloadTypeMultiOperation:      &MultiOperation{},
+	PayloadTypeCreateSession:       &CreateSession{},
+	PayloadTypeRevokeSession:       &RevokeSession{},
+	PayloadTypeRegisterMultisig:    &RegisterMultisig{},
+	PayloadTypeUpdateMultisig:      &UpdateMultisig{},
 	// PayloadTypeDeleteResolution:    &DeleteResolution{},
 }
 
@@ -111,6 +124,11 @@ var payloadTypes = map[PayloadType]bool{
 	PayloadTypeCreateResolution:    true,
 	PayloadTypeApproveResolution:   true,
 	PayloadTypeDeleteResolution:    true,
+	PayloadTypeMultiOperation:      true,
+	PayloadTypeCreateSession:       true,
+	PayloadTypeRevokeSession:       true,
+	PayloadTypeRegisterMultisig:    true,
+	PayloadTypeUpdateMultisig:      true,
 }
 
 // Valid says if the payload type is known. This does not mean that the node
@@ -128,6 +146,11 @@ func (p PayloadType) Valid() bool {
 		PayloadTypeDeleteResolution,
 		PayloadTypeRawStatement,
 		PayloadTypeExecute,
+		PayloadTypeMultiOperation,
+		PayloadTypeCreateSession,
+		PayloadTypeRevokeSession,
+		PayloadTypeRegisterMultisig,
+		PayloadTypeUpdateMultisig,
 		// These should not come in user transactions, but they are not invalid
 		// payload types in general.
 		PayloadTypeValidatorVoteIDs,
@@ -619,7 +642,19 @@ func (e *EncodedValue) Decode() (any, error) {
 
 	typeName, ok := typeAlias[e.Type.Name]
 	if !ok {
-		return nil, fmt.Errorf(`unknown type "%s"`, e.Type.Name)
+		if !e.Type.IsUserDefined() {
+			return nil, fmt.Errorf(`unknown type "%s"`, e.Type.Name)
+		}
+		// CREATE ACTION rejects parameters of these types, so no action
+		// could be called with them
+		if e.Type.IsArray {
+			return nil, fmt.Errorf(`arrays of user-defined type "%s" are not supported`, e.Type.Name)
+		}
+		if len(e.Data) != 1 {
+			return nil, fmt.Errorf("expected 1 element, got %d", len(e.Data))
+		}
+
+		return decodeUserValue(e.Data[0], e.Type.Name)
 	}
 
 	if e.Type.IsArray {
@@ -721,6 +756,8 @@ func EncodeValue(v any) (*EncodedValue, error) {
 			}
 
 			return encodeNotNull([]byte(t.String())), decTyp, nil
+		case Enum, Composite:
+			return encodeUserValue(t)
 		default:
 			return nil, nil, fmt.Errorf("cannot encode type %T", v2)
 		}
@@ -773,6 +810,11 @@ func EncodeValue(v any) (*EncodedValue, error) {
 			if err != nil {
 				return nil, err
 			}
+			if t.IsUserDefined() {
+				// actions may not have parameters of these types, so they
+				// are rejected here rather than by the node
+				return nil, fmt.Errorf(`arrays of user-defined type "%s" are not supported`, t.Name)
+			}
 			t = t.Copy() // to avoid modifying the original type
 
 			// if no first datatype, then set it.
@@ -988,6 +1030,447 @@ func (v *Transfer) UnmarshalBinary(b []byte) error {
 	return nil
 }
 
+// MultiOperation is a payload that executes an ordered list of operations
+// atomically, in one database transaction. If any operation fails, the effects
+// of all of them are rolled back. Only the operation types in
+// MultiOperationTypes may be included.
+type MultiOperation struct {
+	Operations []Payload
+}
+
+var _ Payload = (*MultiOperation)(nil)
+
+func (m MultiOperation) Type() PayloadType {
+	return PayloadTypeMultiOperation
+}
+
+// MaxMultiOperations is the maximum number of operations in a MultiOperation.
+const MaxMultiOperations = 100
+
+// MultiOperationTypes are the payload types that may be operations of a
+// MultiOperation.
+var MultiOperationTypes = map[PayloadType]bool{
+	PayloadTypeExecute:      true,
+	PayloadTypeRawStatement: true,
+	PayloadTypeTransfer:     true,
+}
+
+func checkMultiOperationCount(n int) error {
+	if n == 0 {
+		return errors.New("multi-operation payload has no operations")
+	}
+	if n > MaxMultiOperations {
+		return fmt.Errorf("multi-operation payload has %d operations, the maximum is %d", n, MaxMultiOperations)
+	}
+	return nil
+}
+
+const moVersion = 0
+
+// MultiOperation serialization is as follows:
+//
+//   - Two bytes for version (uint16), which is presently 0 (moVersion).
+//   - The number of operations is written as a uint16.
+//   - For each operation:
+//     - The payload type is written according to WriteString.
+//     - The payload is serialized according to its MarshalBinary, written
+//       according to WriteBytes.
+
+func (m MultiOperation) MarshalBinary() ([]byte, error) {
+	if err := checkMultiOperationCount(len(m.Operations)); err != nil {
+		return nil, err
+	}
+
+	buf := new(bytes.Buffer)
+	if err := binary.Write(buf, SerializationByteOrder, uint16(moVersion)); err != nil {
+		return nil, err
+	}
+	if err := binary.Write(buf, SerializationByteOrder, uint16(len(m.Operations))); err != nil {
+		return nil, err
+	}
+
+	for i, op := range m.Operations {
+		if op == nil {
+			return nil, fmt.Errorf("operation %d is nil", i)
+		}
+		if !MultiOperationTypes[op.Type()] {
+			return nil, fmt.Errorf("operation %d: payload type %s may not be in a multi-operation payload", i, op.Type())
+		}
+		if err := WriteString(buf, op.Type().String()); err != nil {
+			return nil, err
+		}
+		bts, err := op.MarshalBinary()
+		if err != nil {
+			return nil, fmt.Errorf("operation %d: %w", i, err)
+		}
+		if err := WriteBytes(buf, bts); err != nil {
+			return nil, err
+		}
+	}
+
+	return buf.Bytes(), nil
+}
+
+func (m *MultiOperation) UnmarshalBinary(b []byte) error {
+	rd := bytes.NewReader(b)
+
+	var version uint16
+	if err := binary.Read(rd, SerializationByteOrder, &version); err != nil {
+		return err
+	}
+	if version != moVersion {
+		return fmt.Errorf("unsupported multi-operation payload version %d", version)
+	}
+
+	var numOps uint16
+	if err := binary.Read(rd, SerializationByteOrder, &numOps); err != nil {
+		return err
+	}
+	if err := checkMultiOperationCount(int(numOps)); err != nil {
+		return err
+	}
+
+	ops := make([]Payload, numOps)
+	for i := range ops {
+		pt, err := ReadString(rd)
+		if err != nil {
+			return err
+		}
+		payloadType := PayloadType(pt)
+		if !MultiOperationTypes[payloadType] {
+			return fmt.Errorf("operation %d: payload type %s may not be in a multi-operation payload", i, payloadType)
+		}
+		bts, err := ReadBytes(rd)
+		if err != nil {
+			return err
+		}
+		ops[i], err = UnmarshalPayload(payloadType, bts)
+		if err != nil {
+			return fmt.Errorf("operation %d: %w", i, err)
+		}
+	}
+
+	if rd.Len() != 0 {
+		return errors.New("unexpected trailing data in multi-operation payload")
+	}
+
+	m.Operations = ops
+	return nil
+}
+
+// MarshalJSON includes the type of each operation with its payload.
+func (m MultiOperation) MarshalJSON() ([]byte, error) {
+	type operation struct {
+		Type    PayloadType `json:"type"`
+		Payload Payload     `json:"payload"`
+	}
+	ops := make([]operation, len(m.Operations))
+	for i, op := range m.Operations {
+		ops[i] = operation{Type: op.Type(), Payload: op}
+	}
+	return json.Marshal(struct {
+		Operations []operation `json:"operations"`
+	}{ops})
+}
+
+// CreateSession authorizes a session key to sign transactions for the sender,
+// which is the delegator. Transactions signed by the session key must name the
+// delegator in the transaction body, and they are executed as if the delegator
+// sent them. A session key may only execute actions, limited to those in
+// Namespaces and Actions, if they are not empty. The session expires after the
+// block height Expiry, and the total fees spent by the session may not exceed
+// SpendCap, if it is not nil. Creating a session for a key that already has
+// one replaces it.
+type CreateSession struct {
+	SessionKey HexBytes `json:"session_key"`
+	AuthType   string   `json:"auth_type"`
+	Namespaces []string `json:"namespaces"`
+	Actions    []string `json:"actions"`
+	Expiry     int64    `json:"expiry"`
+	SpendCap   *big.Int `json:"spend_cap"`
+}
+
+var _ Payload = (*CreateSession)(nil)
+
+func (c CreateSession) Type() PayloadType {
+	return PayloadTypeCreateSession
+}
+
+const csVersion = 0
+
+// CreateSession serialization is as follows:
+//
+//   - Two bytes for version (uint16), which is presently 0 (csVersion).
+//   - The session key is written according to WriteBytes.
+//   - The auth type is written according to WriteString.
+//   - The namespaces and actions are each written as a uint16 count followed
+//     by each string according to WriteString.
+//   - The expiry is written as an int64.
+//   - The spend cap is written according to WriteBigInt.
+
+func (c CreateSession) MarshalBinary() ([]byte, error) {
+	if len(c.SessionKey) == 0 || c.AuthType == "" {
+		return nil, errors.New("missing session key in create session payload")
+	}
+
+	buf := new(bytes.Buffer)
+	if err := binary.Write(buf, SerializationByteOrder, uint16(csVersion)); err != nil {
+		return nil, err
+	}
+	if err := WriteBytes(buf, c.SessionKey); err != nil {
+		return nil, err
+	}
+	if err := WriteString(buf, c.AuthType); err != nil {
+		return nil, err
+	}
+	if err := writeStrings(buf, c.Namespaces); err != nil {
+		return nil, err
+	}
+	if err := writeStrings(buf, c.Actions); err != nil {
+		return nil, err
+	}
+	if err := binary.Write(buf, SerializationByteOrder, c.Expiry); err != nil {
+		return nil, err
+	}
+	if err := WriteBigInt(buf, c.SpendCap); err != nil {
+		return nil, err
+	}
+
+	return buf.Bytes(), nil
+}
+
+func (c *CreateSession) UnmarshalBinary(b []byte) error {
+	rd := bytes.NewReader(b)
+
+	var version uint16
+	if err := binary.Read(rd, SerializationByteOrder, &version); err != nil {
+		return err
+	}
+	if version != csVersion {
+		return fmt.Errorf("unsupported create session payload version %d", version)
+	}
+
+	var err error
+	if c.SessionKey, err = ReadBytes(rd); err != nil {
+		return err
+	}
+	if c.AuthType, err = ReadString(rd); err != nil {
+		return err
+	}
+	if c.Namespaces, err = readStrings(rd); err != nil {
+		return err
+	}
+	if c.Actions, err = readStrings(rd); err != nil {
+		return err
+	}
+	if err = binary.Read(rd, SerializationByteOrder, &c.Expiry); err != nil {
+		return err
+	}
+	if c.SpendCap, err = ReadBigInt(rd); err != nil {
+		return err
+	}
+
+	if rd.Len() != 0 {
+		return errors.New("unexpected trailing data in create session payload")
+	}
+
+	return nil
+}
+
+// RevokeSession revokes a session key that the sender authorized with
+// CreateSession.
+type RevokeSession struct {
+	SessionKey HexBytes `json:"session_key"`
+	AuthType   string   `json:"auth_type"`
+}
+
+var _ Payload = (*RevokeSession)(nil)
+
+func (r RevokeSession) Type() PayloadType {
+	return PayloadTypeRevokeSession
+}
+
+const revsVersion = 0
+
+func (r RevokeSession) MarshalBinary() ([]byte, error) {
+	if len(r.SessionKey) == 0 || r.AuthType == "" {
+		return nil, errors.New("missing session key in revoke session payload")
+	}
+
+	buf := new(bytes.Buffer)
+	if err := binary.Write(buf, SerializationByteOrder, uint16(revsVersion)); err != nil {
+		return nil, err
+	}
+	if err := WriteBytes(buf, r.SessionKey); err != nil {
+		return nil, err
+	}
+	if err := WriteString(buf, r.AuthType); err != nil {
+		return nil, err
+	}
+
+	return buf.Bytes(), nil
+}
+
+func (r *RevokeSession) UnmarshalBinary(b []byte) error {
+	rd := bytes.NewReader(b)
+
+	var version uint16
+	if err := binary.Read(rd, SerializationByteOrder, &version); err != nil {
+		return err
+	}
+	if version != revsVersion {
+		return fmt.Errorf("unsupported revoke session payload version %d", version)
+	}
+
+	var err error
+	if r.SessionKey, err = ReadBytes(rd); err != nil {
+		return err
+	}
+	if r.AuthType, err = ReadString(rd); err != nil {
+		return err
+	}
+
+	if rd.Len() != 0 {
+		return errors.New("unexpected trailing data in revoke session payload")
+	}
+
+	return nil
+}
+
+// RegisterMultisig registers a multisig account with its serialized
+// definition (see auth.Multisig). The account ID is the SHA-256 hash of the
+// definition, and it may not already be registered. Any account may register a
+// multisig account.
+type RegisterMultisig struct {
+	Definition HexBytes `json:"definition"`
+}
+
+var _ Payload = (*RegisterMultisig)(nil)
+
+func (r RegisterMultisig) Type() PayloadType {
+	return PayloadTypeRegisterMultisig
+}
+
+const regmsVersion = 0
+
+func (r RegisterMultisig) MarshalBinary() ([]byte, error) {
+	return marshalMultisigDefinition(regmsVersion, r.Definition)
+}
+
+func (r *RegisterMultisig) UnmarshalBinary(b []byte) error {
+	def, err := unmarshalMultisigDefinition(regmsVersion, b)
+	if err != nil {
+		return fmt.Errorf("register multisig payload: %w", err)
+	}
+	r.Definition = def
+	return nil
+}
+
+// UpdateMultisig replaces the definition of the multisig account that sends
+// it, which keeps its ID. Transactions of the account must then be signed by
+// the members of the new definition.
+type UpdateMultisig struct {
+	Definition HexBytes `json:"definition"`
+}
+
+var _ Payload = (*UpdateMultisig)(nil)
+
+func (u UpdateMultisig) Type() PayloadType {
+	return PayloadTypeUpdateMultisig
+}
+
+const updmsVersion = 0
+
+func (u UpdateMultisig) MarshalBinary() ([]byte, error) {
+	return marshalMultisigDefinition(updmsVersion, u.Definition)
+}
+
+func (u *UpdateMultisig) UnmarshalBinary(b []byte) error {
+	def, err := unmarshalMultisigDefinition(updmsVersion, b)
+	if err != nil {
+		return fmt.Errorf("update multisig payload: %w", err)
+	}
+	u.Definition = def
+	return nil
+}
+
+// marshalMultisigDefinition serializes the multisig payloads: two bytes for
+// the version (uint16), and the definition written according to WriteBytes.
+func marshalMultisigDefinition(version uint16, definition []byte) ([]byte, error) {
+	if len(definition) == 0 {
+		return nil, errors.New("missing multisig definition")
+	}
+
+	buf := new(bytes.Buffer)
+	if err := binary.Write(buf, SerializationByteOrder, version); err != nil {
+		return nil, err
+	}
+	if err := WriteBytes(buf, definition); err != nil {
+		return nil, err
+	}
+
+	return buf.Bytes(), nil
+}
+
+func unmarshalMultisigDefinition(wantVersion uint16, b []byte) ([]byte, error) {
+	rd := bytes.NewReader(b)
+
+	var version uint16
+	if err := binary.Read(rd, SerializationByteOrder, &version); err != nil {
+		return nil, err
+	}
+	if version != wantVersion {
+		return nil, fmt.Errorf("unsupported version %d", version)
+	}
+
+	definition, err := ReadBytes(rd)
+	if err != nil {
+		return nil, err
+	}
+
+	if rd.Len() != 0 {
+		return nil, errors.New("unexpected trailing data")
+	}
+
+	return definition, nil
+}
+
+// writeStrings writes a uint16 count of strings, and each string according to
+// WriteString.
+func writeStrings(w io.Writer, strs []string) error {
+	if len(strs) > math.MaxUint16 {
+		return fmt.Errorf("too many strings: %d", len(strs))
+	}
+	if err := binary.Write(w, SerializationByteOrder, uint16(len(strs))); err != nil {
+		return err
+	}
+	for _, s := range strs {
+		if err := WriteString(w, s); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// readStrings reads strings written by writeStrings.
+func readStrings(r io.Reader) ([]string, error) {
+	var n uint16
+	if err := binary.Read(r, SerializationByteOrder, &n); err != nil {
+		return nil, err
+	}
+	if n == 0 {
+		return nil, nil
+	}
+	strs := make([]string, n)
+	for i := range strs {
+		var err error
+		if strs[i], err = ReadString(r); err != nil {
+			return nil, err
+		}
+	}
+	return strs, nil
+}
+
 // ValidatorJoin requests to join the network with
 // a certain amount of power
 type ValidatorJoin struct {
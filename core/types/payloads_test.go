@@ -4,6 +4,7 @@ import (
 	"bytes"
 	"encoding/binary"
 	"fmt"
+	"math/big"
 	"testing"
 
 	"github.com/stretchr/testify/assert"
@@ -911,6 +912,83 @@ func TestActionExecution_MarshalUnmarshal(t *testing.T) {
 	})
 }
 
+func TestMultiOperation_MarshalUnmarshal(t *testing.T) {
+	t.Run("valid operations", func(t *testing.T) {
+		original := MultiOperation{
+			Operations: []Payload{
+				&ActionExecution{
+					Namespace: "testdb",
+					Action:    "test_action",
+					Arguments: [][]*EncodedValue{
+						{{Type: DataType{Name: TextType.Name}, Data: [][]byte{[]byte("arg1")}}},
+					},
+				},
+				&RawStatement{Statement: "DELETE FROM users;"},
+				&Transfer{
+					To:     &AccountID{Identifier: []byte{1, 2, 3}, KeyType: crypto.KeyTypeSecp256k1},
+					Amount: big.NewInt(100),
+				},
+			},
+		}
+
+		data, err := original.MarshalBinary()
+		require.NoError(t, err)
+
+		var decoded MultiOperation
+		err = decoded.UnmarshalBinary(data)
+		require.NoError(t, err)
+		require.Len(t, decoded.Operations, 3)
+		for i, op := range decoded.Operations {
+			require.Equal(t, original.Operations[i].Type(), op.Type())
+		}
+		require.Equal(t, original.Operations[0], decoded.Operations[0])
+		require.Equal(t, original.Operations[2], decoded.Operations[2])
+
+		// trailing data
+		err = decoded.UnmarshalBinary(append(data, 0))
+		require.Error(t, err)
+	})
+
+	t.Run("no operations", func(t *testing.T) {
+		_, err := MultiOperation{}.MarshalBinary()
+		require.Error(t, err)
+
+		buf := &bytes.Buffer{}
+		binary.Write(buf, SerializationByteOrder, uint16(moVersion))
+		binary.Write(buf, SerializationByteOrder, uint16(0))
+
+		var decoded MultiOperation
+		err = decoded.UnmarshalBinary(buf.Bytes())
+		require.Error(t, err)
+	})
+
+	t.Run("too many operations", func(t *testing.T) {
+		ops := make([]Payload, MaxMultiOperations+1)
+		for i := range ops {
+			ops[i] = &RawStatement{Statement: "SELECT 1;"}
+		}
+		_, err := MultiOperation{Operations: ops}.MarshalBinary()
+		require.Error(t, err)
+	})
+
+	t.Run("disallowed operation type", func(t *testing.T) {
+		_, err := MultiOperation{Operations: []Payload{&ValidatorLeave{}}}.MarshalBinary()
+		require.Error(t, err)
+
+		leave, err := ValidatorLeave{}.MarshalBinary()
+		require.NoError(t, err)
+		buf := &bytes.Buffer{}
+		binary.Write(buf, SerializationByteOrder, uint16(moVersion))
+		binary.Write(buf, SerializationByteOrder, uint16(1))
+		WriteString(buf, PayloadTypeValidatorLeave.String())
+		WriteBytes(buf, leave)
+
+		var decoded MultiOperation
+		err = decoded.UnmarshalBinary(buf.Bytes())
+		require.Error(t, err)
+	})
+}
+
 func TestActionCall_MarshalUnmarshal(t *testing.T) {
 	t.Run("valid action call with multiple arguments", func(t *testing.T) {
 		original := ActionCall{
@@ -1044,3 +1122,78 @@ func TestActionCall_MarshalUnmarshal(t *testing.T) {
 		require.Error(t, err)
 	})
 }
+
+func TestSession_MarshalUnmarshal(t *testing.T) {
+	t.Run("create session", func(t *testing.T) {
+		for _, original := range []CreateSession{
+			{
+				SessionKey: []byte{1, 2, 3},
+				AuthType:   "secp256k1_ep",
+				Namespaces: []string{"main", "game"},
+				Actions:    []string{"move"},
+				Expiry:     1000,
+				SpendCap:   big.NewInt(500),
+			},
+			{ // unscoped and uncapped
+				SessionKey: []byte{4, 5, 6},
+				AuthType:   "ed25519",
+				Expiry:     10,
+			},
+		} {
+			data, err := original.MarshalBinary()
+			require.NoError(t, err)
+
+			var decoded CreateSession
+			require.NoError(t, decoded.UnmarshalBinary(data))
+			require.Equal(t, original, decoded)
+
+			require.Error(t, decoded.UnmarshalBinary(append(data, 0)))
+		}
+
+		_, err := CreateSession{AuthType: "ed25519"}.MarshalBinary()
+		require.Error(t, err)
+	})
+
+	t.Run("revoke session", func(t *testing.T) {
+		original := RevokeSession{SessionKey: []byte{1, 2, 3}, AuthType: "secp256k1_ep"}
+		data, err := original.MarshalBinary()
+		require.NoError(t, err)
+
+		var decoded RevokeSession
+		require.NoError(t, decoded.UnmarshalBinary(data))
+		require.Equal(t, original, decoded)
+
+		_, err = RevokeSession{SessionKey: []byte{1}}.MarshalBinary()
+		require.Error(t, err)
+	})
+}
+
+func TestMultisig_MarshalUnmarshal(t *testing.T) {
+	t.Run("register multisig", func(t *testing.T) {
+		original := RegisterMultisig{Definition: []byte{1, 1, 2, 3}}
+		data, err := original.MarshalBinary()
+		require.NoError(t, err)
+
+		var decoded RegisterMultisig
+		require.NoError(t, decoded.UnmarshalBinary(data))
+		require.Equal(t, original, decoded)
+		require.Error(t, decoded.UnmarshalBinary(append(data, 0)))
+
+		_, err = RegisterMultisig{}.MarshalBinary()
+		require.Error(t, err)
+	})
+
+	t.Run("update multisig", func(t *testing.T) {
+		original := UpdateMultisig{Definition: []byte{2, 1, 2, 3}}
+		data, err := original.MarshalBinary()
+		require.NoError(t, err)
+
+		payload, err := UnmarshalPayload(PayloadTypeUpdateMultisig, data)
+		require.NoError(t, err)
+		require.Equal(t, &original, payload)
+		require.True(t, PayloadTypeUpdateMultisig.Valid())
+
+		_, err = UpdateMultisig{}.MarshalBinary()
+		require.Error(t, err)
+	})
+}
@@ -25,6 +25,10 @@ const (
 	CodeInvalidSender       TxCode = 9
 	CodeTxTimeoutCommit     TxCode = 10
 	CodeMempoolFull         TxCode = 11
+	CodeTxOutOfHeightRange  TxCode = 12
+	CodeFeePayerRejected    TxCode = 13
+	CodeSessionRejected     TxCode = 14
+	CodeMultisigRejected    TxCode = 15
 
 	// engine-related error code
 	CodeInvalidSchema         TxCode = 100 // TODO: remove, as this is not applicable to the engine
@@ -57,6 +61,10 @@ var (
 	ErrTxTooLarge            = errors.New("transaction size limit exceeded")
 	ErrUnknownPayloadType    = errors.New("unknown payload type")
 	ErrDisallowedInMigration = errors.New("transaction type not allowed during migration")
+	ErrTxOutOfHeightRange    = errors.New("transaction not valid at block height")
+	ErrFeePayerRejected      = errors.New("fee payer may not pay for transaction")
+	ErrSessionRejected       = errors.New("session key may not sign transaction for delegator")
+	ErrMultisigRejected      = errors.New("multisig signature is not for the registered account definition")
 )
 
 // BroadcastErrorToCode converts an error from a broadcast method to a TxCode.
@@ -91,6 +99,18 @@ func BroadcastErrorToCode(err error) TxCode {
 	if errors.Is(err, ErrMigrationComplete) {
 		return CodeNetworkHalted
 	}
+	if errors.Is(err, ErrTxOutOfHeightRange) {
+		return CodeTxOutOfHeightRange
+	}
+	if errors.Is(err, ErrFeePayerRejected) {
+		return CodeFeePayerRejected
+	}
+	if errors.Is(err, ErrSessionRejected) {
+		return CodeSessionRejected
+	}
+	if errors.Is(err, ErrMultisigRejected) {
+		return CodeMultisigRejected
+	}
 	return CodeUnknownError
 }
 
@@ -117,6 +137,14 @@ func BroadcastCodeToError(code TxCode) error {
 		return ErrDisallowedInMigration
 	case CodeNetworkHalted:
 		return ErrMigrationComplete
+	case CodeTxOutOfHeightRange:
+		return ErrTxOutOfHeightRange
+	case CodeFeePayerRejected:
+		return ErrFeePayerRejected
+	case CodeSessionRejected:
+		return ErrSessionRejected
+	case CodeMultisigRejected:
+		return ErrMultisigRejected
 	}
 	return nil
 }
@@ -127,16 +155,36 @@ type TxResult struct {
 	Gas    int64   `json:"gas"`
 	Log    string  `json:"log,omitempty"`
 	Events []Event `json:"events,omitempty"`
+	// Operations are the results of the operations of a multi-operation
+	// transaction, in order. Operations after a failed one are not executed,
+	// and have no result.
+	Operations []OperationResult `json:"operations,omitempty"`
+}
+
+// OperationResult is the result of one operation of a multi-operation
+// transaction.
+type OperationResult struct {
+	Code uint32 `json:"code"`
+	Log  string `json:"log,omitempty"`
 }
 
 // txResultsVer is the results structure or serialization version known presently
 const txResultsVer uint16 = 0 // v0 has events with no data a future v1 will change how events are decoded
 
+// txResultsVerOperations is the serialization version of a result with
+// operation results, which follow the events. Results without them are still
+// serialized with txResultsVer.
+const txResultsVerOperations uint16 = 1
+
 func (tr TxResult) MarshalBinary() ([]byte, error) {
 	data := make([]byte, 2+4+4, 2+4+4+2+2) // put 10 bytes, append the rest
 
 	// version
-	binary.BigEndian.PutUint16(data, txResultsVer)
+	version := txResultsVer
+	if len(tr.Operations) > 0 {
+		version = txResultsVerOperations
+	}
+	binary.BigEndian.PutUint16(data, version)
 
 	// Encode code as 4 bytes
 	binary.BigEndian.PutUint32(data[2:], tr.Code)
@@ -160,6 +208,22 @@ func (tr TxResult) MarshalBinary() ([]byte, error) {
 		data = append(data, evt...)
 	}
 
+	if version == txResultsVer {
+		return data, nil
+	}
+
+	// Operations
+	numOps := len(tr.Operations)
+	if numOps > math.MaxUint16 {
+		return nil, errors.New("too many operation results")
+	}
+	data = binary.BigEndian.AppendUint16(data, uint16(numOps))
+	for _, op := range tr.Operations {
+		data = binary.BigEndian.AppendUint32(data, op.Code)
+		data = binary.BigEndian.AppendUint32(data, uint32(len(op.Log)))
+		data = append(data, op.Log...)
+	}
+
 	return data, nil
 }
 
@@ -171,7 +235,7 @@ func (tr *TxResult) UnmarshalBinary(data []byte) error {
 	var offset int
 
 	version := binary.BigEndian.Uint16(data)
-	if version != txResultsVer {
+	if version != txResultsVer && version != txResultsVerOperations {
 		return fmt.Errorf("unsupported version %d", version)
 	}
 	offset += 2
@@ -214,6 +278,34 @@ func (tr *TxResult) UnmarshalBinary(data []byte) error {
 		offset += int(eventLen)
 	}
 
+	tr.Operations = nil
+	if version == txResultsVer {
+		return nil
+	}
+
+	// Decode operation results
+	if len(data) < offset+2 {
+		return errors.New("insufficient data for operation results length")
+	}
+	numOps := binary.BigEndian.Uint16(data[offset : offset+2])
+	offset += 2
+
+	tr.Operations = make([]OperationResult, numOps)
+	for i := range tr.Operations {
+		if len(data) < offset+8 {
+			return errors.New("insufficient data for operation result")
+		}
+		tr.Operations[i].Code = binary.BigEndian.Uint32(data[offset:])
+		logLen := int(binary.BigEndian.Uint32(data[offset+4:]))
+		offset += 8
+
+		if len(data) < offset+logLen {
+			return errors.New("insufficient data for operation log")
+		}
+		tr.Operations[i].Log = string(data[offset : offset+logLen])
+		offset += logLen
+	}
+
 	return nil
 }
 
@@ -227,11 +319,116 @@ func (e *Event) UnmarshalBinary(data []byte) error {
 	return nil
 }
 
+// ScheduleResult is the result of an action that a schedule (created with
+// CREATE SCHEDULE) called at the end of a block.
+type ScheduleResult struct {
+	// Namespace is the namespace the schedule was created in.
+	Namespace string `json:"namespace"`
+	// Name is the name of the schedule.
+	Name string `json:"name"`
+	// Logs are the logs written by the action.
+	Logs string `json:"logs,omitempty"`
+	// Error is the error that the call failed with, if any. The changes made
+	// by a failed call are rolled back.
+	Error string `json:"error,omitempty"`
+}
+
+// scheduleResultVer is the serialization version of ScheduleResult.
+const scheduleResultVer uint16 = 0
+
+func (sr ScheduleResult) MarshalBinary() ([]byte, error) {
+	data := binary.BigEndian.AppendUint16(nil, scheduleResultVer)
+	for _, str := range []string{sr.Namespace, sr.Name, sr.Logs, sr.Error} {
+		data = binary.BigEndian.AppendUint32(data, uint32(len(str)))
+		data = append(data, str...)
+	}
+
+	return data, nil
+}
+
+func (sr *ScheduleResult) UnmarshalBinary(data []byte) error {
+	if len(data) < 2 {
+		return errors.New("insufficient data")
+	}
+
+	version := binary.BigEndian.Uint16(data)
+	if version != scheduleResultVer {
+		return fmt.Errorf("unsupported version %d", version)
+	}
+	data = data[2:]
+
+	for _, str := range []*string{&sr.Namespace, &sr.Name, &sr.Logs, &sr.Error} {
+		if len(data) < 4 {
+			return errors.New("insufficient data for string length")
+		}
+		strLen := binary.BigEndian.Uint32(data)
+		data = data[4:]
+
+		if uint32(len(data)) < strLen {
+			return errors.New("insufficient data for string")
+		}
+		*str = string(data[:strLen])
+		data = data[strLen:]
+	}
+
+	if len(data) != 0 {
+		return errors.New("unexpected trailing data")
+	}
+
+	return nil
+}
+
 // CallResult is the result of an action call.
 type CallResult struct {
 	QueryResult *QueryResult `json:"query_result"`
 	Logs        string       `json:"logs"`
 	Error       *string      `json:"error"`
+	// Trace is the execution trace of the call. It is only set if a trace
+	// was requested.
+	Trace *CallTrace `json:"trace,omitempty"`
+}
+
+// TraceKind is the kind of an event in an execution trace.
+type TraceKind string
+
+const (
+	// TraceKindCall is a call of an action or extension method. Its children
+	// are the events of the call.
+	TraceKindCall TraceKind = "call"
+	// TraceKindStatement is a statement of an action. Its children are the
+	// events of the statement, such as the statements of a loop body.
+	TraceKindStatement TraceKind = "statement"
+	// TraceKindAssign is the assignment of a value to a variable.
+	TraceKindAssign TraceKind = "assign"
+	// TraceKindSQL is an SQL statement.
+	TraceKindSQL TraceKind = "sql"
+)
+
+// CallTrace is an event in the execution trace of an action call. The root
+// of a trace is the called action, and each event has the events that
+// happened within it as children, in order.
+type CallTrace struct {
+	Kind TraceKind `json:"kind"`
+	// Text describes the event. It is the name of the called action or
+	// method, the text of the statement, "$var = value" for assignments, or
+	// the SQL statement.
+	Text string `json:"text"`
+	// Line is the line of a statement, starting at 1 at the first line of
+	// its CREATE ACTION statement.
+	Line int `json:"line,omitempty"`
+	// Params are the arguments of a call, or the bound parameters of an SQL
+	// statement as "$name = value".
+	Params []string `json:"params,omitempty"`
+	// RowsAffected is the number of rows returned or changed by an SQL
+	// statement.
+	RowsAffected *int64 `json:"rows_affected,omitempty"`
+	// Error is the error that the event failed with, if any.
+	Error string `json:"error,omitempty"`
+	// Truncated is set on the root if events were omitted because the
+	// trace was too large.
+	Truncated bool `json:"truncated,omitempty"`
+
+	Children []*CallTrace `json:"children,omitempty"`
 }
 
 // QueryResult is the result of a SQL query or action.
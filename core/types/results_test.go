@@ -132,6 +132,43 @@ func TestTxResultMarshalUnmarshal(t *testing.T) {
 			t.Errorf("got %d events, want 0", len(decoded.Events))
 		}
 	})
+
+	t.Run("with operations", func(t *testing.T) {
+		tr := TxResult{
+			Code: 1,
+			Log:  "operation 1: failed",
+			Operations: []OperationResult{
+				{Code: 0},
+				{Code: 1, Log: "failed"},
+			},
+		}
+
+		data, err := tr.MarshalBinary()
+		if err != nil {
+			t.Fatal(err)
+		}
+		if version := binary.BigEndian.Uint16(data); version != txResultsVerOperations {
+			t.Errorf("got version %d, want %d", version, txResultsVerOperations)
+		}
+
+		var decoded TxResult
+		err = decoded.UnmarshalBinary(data)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if !reflect.DeepEqual(decoded.Operations, tr.Operations) {
+			t.Errorf("got operations %v, want %v", decoded.Operations, tr.Operations)
+		}
+
+		_, err = decoded.MarshalBinary()
+		if err != nil {
+			t.Fatal(err)
+		}
+		err = decoded.UnmarshalBinary(data[:len(data)-1])
+		if err == nil {
+			t.Error("expected error for truncated operation log")
+		}
+	})
 }
 
 // errTestAny is a special error type used within tests if we want
@@ -139,6 +176,30 @@ func TestTxResultMarshalUnmarshal(t *testing.T) {
 // specific error type.
 var errTestAny = errors.New("any test error")
 
+func TestScheduleResultMarshalUnmarshal(t *testing.T) {
+	for _, sr := range []ScheduleResult{
+		{},
+		{Namespace: "main", Name: "expire_orders", Logs: "expired 3 orders"},
+		{Namespace: "main", Name: "distribute", Error: "insufficient balance"},
+	} {
+		data, err := sr.MarshalBinary()
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		var decoded ScheduleResult
+		err = decoded.UnmarshalBinary(data)
+		if err != nil {
+			t.Fatal(err)
+		}
+		assert.Equal(t, sr, decoded)
+
+		// truncated data is rejected
+		err = decoded.UnmarshalBinary(data[:len(data)-1])
+		assert.Error(t, err)
+	}
+}
+
 func TestQueryResultScanScalars(t *testing.T) {
 	type testcase struct {
 		name   string
@@ -771,6 +832,10 @@ func TestBroadcastErrorCodeRoundTrip(t *testing.T) {
 		{"unknown payload type", ErrUnknownPayloadType, CodeInvalidTxType},
 		{"disallowed in migration", ErrDisallowedInMigration, CodeNetworkInMigration},
 		{"migration complete", ErrMigrationComplete, CodeNetworkHalted},
+		{"out of height range", ErrTxOutOfHeightRange, CodeTxOutOfHeightRange},
+		{"fee payer rejected", ErrFeePayerRejected, CodeFeePayerRejected},
+		{"session rejected", ErrSessionRejected, CodeSessionRejected},
+		{"multisig rejected", ErrMultisigRejected, CodeMultisigRejected},
 		{"unknown error", errors.New("some unknown error"), CodeUnknownError},
 	}
 
@@ -0,0 +1,49 @@
+package types
+
+// Schema describes the actions and user-defined types of a namespace.
+type Schema struct {
+	Namespace string      `json:"namespace"`
+	Types     []*UserType `json:"types,omitempty"`
+	Actions   []*Action   `json:"actions"`
+}
+
+// UserType is a type created with CREATE TYPE. It is either a composite
+// type, which has fields, or an enum type, which has labels.
+type UserType struct {
+	Name   string           `json:"name"`
+	Fields []*UserTypeField `json:"fields,omitempty"`
+	Labels []string         `json:"labels,omitempty"`
+}
+
+// IsEnum reports whether the type is an enum type.
+func (u *UserType) IsEnum() bool {
+	return len(u.Labels) > 0
+}
+
+// UserTypeField is a field of a composite type.
+type UserTypeField struct {
+	Name string    `json:"name"`
+	Type *DataType `json:"type"`
+}
+
+// Action describes how an action is called.
+type Action struct {
+	Name       string             `json:"name"`
+	Parameters []*ActionParameter `json:"parameters"`
+	Modifiers  []string           `json:"modifiers"`
+}
+
+// ActionParameter is a parameter of an action.
+type ActionParameter struct {
+	// Name is the name of the parameter, including the leading $.
+	Name string    `json:"name"`
+	Type *DataType `json:"type"`
+	// Default is the default value of the parameter, as a Kuneiform
+	// expression. It is empty if the argument is required.
+	Default string `json:"default,omitempty"`
+}
+
+// HasDefault reports whether the argument for the parameter can be omitted.
+func (p *ActionParameter) HasDefault() bool {
+	return p.Default != ""
+}
@@ -44,6 +44,10 @@ type Transaction struct {
 	// a public key of the sender, hence bytes that encode as hexadecimal.
 	Sender HexBytes `json:"sender"`
 
+	// FeePayerSignature is the signature of the transaction by the fee payer
+	// of a sponsored transaction, which is set in the Body.
+	FeePayerSignature *auth.Signature `json:"fee_payer_signature,omitempty"`
+
 	strictUnmarshal bool
 	cachedHash      *Hash // maybe maybe maybe... this would require a mutex or careful use
 }
@@ -100,9 +104,80 @@ type TransactionBody struct {
 	// be unmarshaled with the chain ID in Kwil blockchain application.
 	ChainID string `json:"chain_id"`
 
+	// ValidAfterHeight, if not zero, is the height that a block must be above
+	// for the transaction to be included in it.
+	ValidAfterHeight int64 `json:"valid_after_height,omitempty"`
+
+	// ValidUntilHeight, if not zero, is the highest block in which the
+	// transaction may be included. This prevents a transaction stuck in a
+	// mempool from being executed arbitrarily later.
+	ValidUntilHeight int64 `json:"valid_until_height,omitempty"`
+
+	// FeePayer, if set, is the account that pays the fee of the transaction
+	// instead of the sender. The transaction must also be signed by it.
+	FeePayer *FeePayer `json:"fee_payer,omitempty"`
+
+	// Delegator, if set, is the account for which the sender acts with a
+	// session key that the delegator authorized with a CreateSession
+	// transaction. The transaction is executed as the delegator's, who also
+	// pays its fee unless it has a FeePayer.
+	Delegator *Delegator `json:"delegator,omitempty"`
+
 	strictUnmarshal bool
 }
 
+// FeePayer identifies the account that sponsors a transaction by paying its
+// fee, like the Sender and Signature type of a transaction identify its sender.
+type FeePayer struct {
+	// Identifier is the CompactID of the fee payer's Signer.
+	Identifier HexBytes `json:"identifier"`
+	// AuthType is the auth type of the fee payer's Signer.
+	AuthType string `json:"auth_type"`
+}
+
+var _ io.WriterTo = (*FeePayer)(nil)
+
+func (fp *FeePayer) WriteTo(w io.Writer) (int64, error) {
+	cw := utils.NewCountingWriter(w)
+	if err := WriteCompactBytes(cw, EmptyIfNil(fp.Identifier)); err != nil {
+		return cw.Written(), err
+	}
+	err := WriteCompactString(cw, fp.AuthType)
+	return cw.Written(), err
+}
+
+var _ io.ReaderFrom = (*FeePayer)(nil)
+
+func (fp *FeePayer) ReadFrom(r io.Reader) (int64, error) {
+	cr := utils.NewCountingReader(r)
+	id, err := ReadCompactBytes(cr)
+	if err != nil {
+		return cr.ReadCount(), err
+	}
+	authType, err := ReadCompactString(cr)
+	if err != nil {
+		return cr.ReadCount(), err
+	}
+	fp.Identifier, fp.AuthType = id, authType
+	return cr.ReadCount(), nil
+}
+
+// Delegator identifies the account for which a session key signs a
+// transaction. It is serialized like a FeePayer.
+type Delegator FeePayer
+
+var _ io.WriterTo = (*Delegator)(nil)
+
+func (d *Delegator) WriteTo(w io.Writer) (int64, error) {
+	return (*FeePayer)(d).WriteTo(w)
+}
+
+var _ io.ReaderFrom = (*Delegator)(nil)
+
+func (d *Delegator) ReadFrom(r io.Reader) (int64, error) {
+	return (*FeePayer)(d).ReadFrom(r)
+}
+
 func (tb *TransactionBody) StrictUnmarshal() {
 	tb.strictUnmarshal = true
 }
@@ -123,6 +198,10 @@ func (t TransactionBody) MarshalJSON() ([]byte, error) {
 		Fee         string      `json:"fee"`
 		Nonce       uint64      `json:"nonce"`
 		ChainID     string      `json:"chain_id"`
+		ValidAfter  int64       `json:"valid_after_height,omitempty"`
+		ValidUntil  int64       `json:"valid_until_height,omitempty"`
+		FeePayer    *FeePayer   `json:"fee_payer,omitempty"`
+		Delegator   *Delegator  `json:"delegator,omitempty"`
 	}{
 		Description: t.Description,
 		Payload:     t.Payload,
@@ -130,6 +209,10 @@ func (t TransactionBody) MarshalJSON() ([]byte, error) {
 		Fee:         feeStr, // *big.Int => string
 		Nonce:       t.Nonce,
 		ChainID:     t.ChainID,
+		ValidAfter:  t.ValidAfterHeight,
+		ValidUntil:  t.ValidUntilHeight,
+		FeePayer:    t.FeePayer,
+		Delegator:   t.Delegator,
 	})
 }
 
@@ -161,13 +244,27 @@ func (t *TransactionBody) UnmarshalJSON(data []byte) error {
 	return nil
 }
 
+// ValidAt checks that the transaction may be included in a block at the given
+// height. It returns an error wrapping ErrTxOutOfHeightRange if it may not.
+func (t *TransactionBody) ValidAt(height int64) error {
+	if t.ValidAfterHeight != 0 && height <= t.ValidAfterHeight {
+		return fmt.Errorf("%w: valid after height %d, block height %d",
+			ErrTxOutOfHeightRange, t.ValidAfterHeight, height)
+	}
+	if t.ValidUntilHeight != 0 && height > t.ValidUntilHeight {
+		return fmt.Errorf("%w: valid until height %d, block height %d",
+			ErrTxOutOfHeightRange, t.ValidUntilHeight, height)
+	}
+	return nil
+}
+
 const txMsgToSignTmplV0 = `%s
 
 PayloadType: %s
 PayloadDigest: %x
 Fee: %s
 Nonce: %d
-
+%s
 Kwil Chain ID: %s
 `
 
@@ -238,15 +335,21 @@ func createTransaction(contents Payload, chainID string, nonce uint64, sert Sign
 }
 
 // SerializeMsg produces the serialization of the transaction that is to be used
-// in both signing and verification of transaction.
+// in both signing and verification of transaction. The message of a sponsored
+// transaction includes the Sender and the type of its Signature, so the fee
+// payer's signature cannot be reused for a different sender.
 func (t *Transaction) SerializeMsg() ([]byte, error) {
-	return t.Body.SerializeMsg(t.Serialization)
+	var senderAuth string
+	if t.Signature != nil {
+		senderAuth = t.Signature.Type
+	}
+	return t.Body.serializeMsg(t.Serialization, t.Sender, senderAuth)
 }
 
 // Sign signs transaction body with given signer.
 // It will serialize the transaction body first and sign it.
 func (t *Transaction) Sign(signer auth.Signer) error {
-	msg, err := t.SerializeMsg()
+	msg, err := t.Body.serializeMsg(t.Serialization, signer.CompactID(), signer.AuthType())
 	if err != nil {
 		return err
 	}
@@ -265,12 +368,51 @@ func (t *Transaction) Sign(signer auth.Signer) error {
 	return nil
 }
 
+// SignFeePayer signs a sponsored transaction as its fee payer, which must be
+// the Body's FeePayer. The fee payer signs the same message as the sender, so
+// the transaction must be signed by the sender first.
+func (t *Transaction) SignFeePayer(signer auth.Signer) error {
+	if t.Body.FeePayer == nil {
+		return errors.New("transaction has no fee payer")
+	}
+	if len(t.Sender) == 0 || t.Signature == nil {
+		return errors.New("transaction is not signed by the sender")
+	}
+	if !bytes.Equal(t.Body.FeePayer.Identifier, signer.CompactID()) || t.Body.FeePayer.AuthType != signer.AuthType() {
+		return errors.New("signer is not the fee payer of the transaction")
+	}
+
+	msg, err := t.SerializeMsg()
+	if err != nil {
+		return err
+	}
+
+	signature, err := signer.Sign(msg)
+	if err != nil {
+		return err
+	}
+
+	t.FeePayerSignature = signature
+
+	return nil
+}
+
 // SerializeMsg prepares a message for signing or verification using a certain
 // message construction format. This is done since a Kwil transaction is foreign
 // to wallets, and it is signed as a message, not a transaction that is native
 // to the wallet. As such we define conventions for constructing user-friendly
 // messages. The Kwil frontend SDKs must implement these serialization schemes.
+//
+// The message of a body with a FeePayer must also include the sender, so it
+// must be produced with Transaction.SerializeMsg instead.
 func (t *TransactionBody) SerializeMsg(mst SignedMsgSerializationType) ([]byte, error) {
+	return t.serializeMsg(mst, nil, "")
+}
+
+// serializeMsg prepares the message of a transaction by the given sender. The
+// sender is only included if the body has a FeePayer, so the message of an
+// unsponsored transaction does not depend on it.
+func (t *TransactionBody) serializeMsg(mst SignedMsgSerializationType, sender []byte, senderAuth string) ([]byte, error) {
 	if len(t.Description) > MsgDescriptionMaxLength {
 		return nil, errors.New("description is too long")
 	}
@@ -278,6 +420,12 @@ func (t *TransactionBody) SerializeMsg(mst SignedMsgSerializationType) ([]byte,
 	switch mst {
 	case SignedMsgDirect:
 		msg := t.Bytes()
+		if t.FeePayer != nil {
+			buf := bytes.NewBuffer(msg)
+			WriteCompactBytes(buf, sender) // writes to a bytes.Buffer do not fail
+			WriteCompactString(buf, senderAuth)
+			msg = buf.Bytes()
+		}
 		sigHash := HashBytes(msg) // could just be msg
 		return sigHash[:], nil
 	case SignedMsgConcat:
@@ -288,12 +436,29 @@ func (t *TransactionBody) SerializeMsg(mst SignedMsgSerializationType) ([]byte,
 		// we present its hash in the result message.
 		payloadHash := HashBytes(t.Payload)
 		payloadDigest := payloadHash[:20]
+		// The optional fields are only displayed if set, so the message of a
+		// transaction without them is unchanged.
+		var optional string
+		if t.ValidAfterHeight != 0 {
+			optional += fmt.Sprintf("Valid After Height: %d\n", t.ValidAfterHeight)
+		}
+		if t.ValidUntilHeight != 0 {
+			optional += fmt.Sprintf("Valid Until Height: %d\n", t.ValidUntilHeight)
+		}
+		if t.FeePayer != nil {
+			optional += fmt.Sprintf("Sender: %x (%s)\n", sender, senderAuth)
+			optional += fmt.Sprintf("Fee Payer: %x (%s)\n", t.FeePayer.Identifier, t.FeePayer.AuthType)
+		}
+		if t.Delegator != nil {
+			optional += fmt.Sprintf("Delegator: %x (%s)\n", t.Delegator.Identifier, t.Delegator.AuthType)
+		}
 		msgStr := fmt.Sprintf(txMsgToSignTmplV0,
 			t.Description,
 			t.PayloadType.String(),
 			payloadDigest,
 			t.Fee.String(),
 			t.Nonce,
+			optional,
 			t.ChainID)
 		return []byte(msgStr), nil
 	}
@@ -304,9 +469,6 @@ var smallestTxSize = 6 // (&Transaction{}).SerializeSize()
 
 // SerializeSize gives the size of the serialized transaction.
 func (t *Transaction) SerializeSize() int64 {
-	totalLen := func(l int) int {
-		return l + uvarintLen(uint64(l))
-	}
 	// NOTE: unit tests must have SerializeSize verified against MarshalBinary
 	// and/or WriteTo to ensure this method does not become stale!
 	var sigSize int64
@@ -317,11 +479,15 @@ func (t *Transaction) SerializeSize() int64 {
 	if t.Body != nil {
 		bodySize = t.Body.SerializeSize()
 	}
-	return int64(2 +
-		totalLen(int(sigSize)) +
-		totalLen(int(bodySize)) +
-		totalLen(len(t.Serialization)) +
-		totalLen(len(t.Sender)))
+	size := int64(2 +
+		compactBytesLen(int(sigSize)) +
+		compactBytesLen(int(bodySize)) +
+		compactStringLen(len(t.Serialization)) +
+		compactBytesLen(len(t.Sender)))
+	if t.FeePayerSignature != nil {
+		size += int64(compactBytesLen(int(t.FeePayerSignature.SerializeSize())))
+	}
+	return size
 }
 
 var _ io.WriterTo = (*Transaction)(nil)
@@ -425,9 +591,56 @@ func (tb TransactionBody) WriteTo(w io.Writer) (int64, error) {
 	if err := WriteCompactString(cw, tb.ChainID); err != nil {
 		return cw.Written(), fmt.Errorf("failed to write transaction body chain ID: %w", err)
 	}
+
+	// Optional fields, which are only written if set so that the
+	// serialization of a body without them is unchanged.
+	if tb.ValidAfterHeight != 0 {
+		if err := writeBodyField(cw, bodyFieldValidAfterHeight, tb.ValidAfterHeight); err != nil {
+			return cw.Written(), fmt.Errorf("failed to write transaction body valid after height: %w", err)
+		}
+	}
+	if tb.ValidUntilHeight != 0 {
+		if err := writeBodyField(cw, bodyFieldValidUntilHeight, tb.ValidUntilHeight); err != nil {
+			return cw.Written(), fmt.Errorf("failed to write transaction body valid until height: %w", err)
+		}
+	}
+	if tb.FeePayer != nil {
+		if err := writeBodyField(cw, bodyFieldFeePayer, tb.FeePayer); err != nil {
+			return cw.Written(), fmt.Errorf("failed to write transaction body fee payer: %w", err)
+		}
+	}
+	if tb.Delegator != nil {
+		if err := writeBodyField(cw, bodyFieldDelegator, tb.Delegator); err != nil {
+			return cw.Written(), fmt.Errorf("failed to write transaction body delegator: %w", err)
+		}
+	}
+
 	return cw.Written(), nil
 }
 
+// bodyField identifies an optional field of a serialized transaction body.
+// Optional fields follow the required fields, each as its identifier followed
+// by its value, in increasing order of identifier.
+type bodyField uint8
+
+const (
+	bodyFieldValidAfterHeight bodyField = iota + 1
+	bodyFieldValidUntilHeight
+	bodyFieldFeePayer
+	bodyFieldDelegator
+)
+
+func writeBodyField(w io.Writer, field bodyField, value any) error {
+	if _, err := w.Write([]byte{byte(field)}); err != nil {
+		return err
+	}
+	if wt, ok := value.(io.WriterTo); ok {
+		_, err := wt.WriteTo(w)
+		return err
+	}
+	return binary.Write(w, SerializationByteOrder, value)
+}
+
 // uvarintLen returns the number of bytes required to encode x as an unsigned
 // varint. This is equivalent to len(binary.AppendUvarint(nil, x)), but computed
 // without any allocations.
@@ -451,21 +664,42 @@ func varintLen(x int64) int {
 	return uvarintLen(ux)
 }
 
+// compactBytesLen returns the serialized size of l bytes written with
+// WriteCompactBytes, which prefixes them with a signed varint length.
+func compactBytesLen(l int) int {
+	return l + varintLen(int64(l))
+}
+
+// compactStringLen returns the serialized size of a string of length l written
+// with WriteCompactString, which prefixes it with an unsigned varint length.
+func compactStringLen(l int) int {
+	return l + uvarintLen(uint64(l))
+}
+
 // SerializeSize gives the size of the serialized transaction body.
 func (tb TransactionBody) SerializeSize() int64 {
-	totalLen := func(l int) int {
-		return l + uvarintLen(uint64(l))
-	}
 	// NOTE: unit tests must have SerializeSize verified against MarshalBinary!
 	fw := utils.NewCountingWriter(io.Discard)
 	WriteBigInt(fw, tb.Fee) // fee serialization involves the Fee strings, so this is not so trivial
 
-	sz := totalLen(len(tb.Description)) +
-		totalLen(len(tb.Payload)) +
-		totalLen(len(tb.PayloadType)) +
+	sz := compactStringLen(len(tb.Description)) +
+		compactBytesLen(len(tb.Payload)) +
+		compactStringLen(len(tb.PayloadType)) +
 		int(fw.Written()) +
 		8 + // nonce
-		totalLen(len(tb.ChainID))
+		compactStringLen(len(tb.ChainID))
+	if tb.ValidAfterHeight != 0 {
+		sz += 1 + 8
+	}
+	if tb.ValidUntilHeight != 0 {
+		sz += 1 + 8
+	}
+	if tb.FeePayer != nil {
+		sz += 1 + compactBytesLen(len(tb.FeePayer.Identifier)) + compactStringLen(len(tb.FeePayer.AuthType))
+	}
+	if tb.Delegator != nil {
+		sz += 1 + compactBytesLen(len(tb.Delegator.Identifier)) + compactStringLen(len(tb.Delegator.AuthType))
+	}
 
 	return int64(sz)
 }
@@ -515,6 +749,58 @@ func (tb *TransactionBody) ReadFrom(r io.Reader) (int64, error) {
 	}
 	tb.ChainID = chainID
 
+	// Optional fields, until the end of the body.
+	tb.ValidAfterHeight, tb.ValidUntilHeight, tb.FeePayer, tb.Delegator = 0, 0, nil, nil
+	var last bodyField
+	for {
+		var field [1]byte
+		if _, err := io.ReadFull(cr, field[:]); err != nil {
+			if errors.Is(err, io.EOF) {
+				break
+			}
+			return cr.ReadCount(), fmt.Errorf("failed to read transaction body field: %w", err)
+		}
+		// fields in order and only once, so a body has a single serialization
+		if bodyField(field[0]) <= last {
+			return cr.ReadCount(), fmt.Errorf("transaction body field %d out of order", field[0])
+		}
+		last = bodyField(field[0])
+
+		switch last {
+		case bodyFieldValidAfterHeight, bodyFieldValidUntilHeight:
+			dst := &tb.ValidAfterHeight
+			if last == bodyFieldValidUntilHeight {
+				dst = &tb.ValidUntilHeight
+			}
+			if err := binary.Read(cr, SerializationByteOrder, dst); err != nil {
+				return cr.ReadCount(), fmt.Errorf("failed to read transaction body field %d: %w", last, err)
+			}
+			if *dst == 0 { // unset fields are not written
+				return cr.ReadCount(), fmt.Errorf("transaction body field %d is zero", last)
+			}
+		case bodyFieldFeePayer:
+			fp := &FeePayer{}
+			if _, err := fp.ReadFrom(cr); err != nil {
+				return cr.ReadCount(), fmt.Errorf("failed to read transaction body fee payer: %w", err)
+			}
+			if len(fp.Identifier) == 0 || fp.AuthType == "" {
+				return cr.ReadCount(), errors.New("transaction body fee payer is empty")
+			}
+			tb.FeePayer = fp
+		case bodyFieldDelegator:
+			d := &Delegator{}
+			if _, err := d.ReadFrom(cr); err != nil {
+				return cr.ReadCount(), fmt.Errorf("failed to read transaction body delegator: %w", err)
+			}
+			if len(d.Identifier) == 0 || d.AuthType == "" {
+				return cr.ReadCount(), errors.New("transaction body delegator is empty")
+			}
+			tb.Delegator = d
+		default:
+			return cr.ReadCount(), fmt.Errorf("unknown transaction body field %d", last)
+		}
+	}
+
 	return cr.ReadCount(), nil
 }
 
@@ -541,11 +827,21 @@ func (tb *TransactionBody) UnmarshalBinary(data []byte) error {
 	return nil
 }
 
-const txVersion uint16 = 0
+const (
+	txVersion uint16 = 0
+	// txVersionFeePayer is the version of a transaction with a
+	// FeePayerSignature, which follows the Sender. Transactions without one
+	// are serialized as txVersion, so their serialization is unchanged.
+	txVersionFeePayer uint16 = 1
+)
 
 func (t *Transaction) serialize(w io.Writer) (err error) {
 	// version
-	if err := binary.Write(w, SerializationByteOrder, txVersion); err != nil {
+	ver := txVersion
+	if t.FeePayerSignature != nil {
+		ver = txVersionFeePayer
+	}
+	if err := binary.Write(w, SerializationByteOrder, ver); err != nil {
 		return fmt.Errorf("failed to write transaction version: %w", err)
 	}
 
@@ -577,6 +873,13 @@ func (t *Transaction) serialize(w io.Writer) (err error) {
 		return fmt.Errorf("failed to write transaction sender: %w", err)
 	}
 
+	// Fee payer signature
+	if t.FeePayerSignature != nil {
+		if err := WriteCompactBytes(w, t.FeePayerSignature.Bytes()); err != nil {
+			return fmt.Errorf("failed to write transaction fee payer signature: %w", err)
+		}
+	}
+
 	return nil
 }
 
@@ -589,7 +892,7 @@ func (t *Transaction) deserialize(r io.Reader) (int64, error) {
 	if err != nil {
 		return cr.ReadCount(), fmt.Errorf("failed to read transaction version: %w", err)
 	}
-	if ver != txVersion { // in the future we can have different transaction (sub)structs, switch to different handling, etc.
+	if ver != txVersion && ver != txVersionFeePayer { // in the future we can have different transaction (sub)structs, switch to different handling, etc.
 		return cr.ReadCount(), fmt.Errorf("unsupported transaction version %d", ver)
 	}
 
@@ -637,6 +940,20 @@ func (t *Transaction) deserialize(r io.Reader) (int64, error) {
 	}
 	t.Sender = senderBytes
 
+	// Fee payer signature
+	t.FeePayerSignature = nil
+	if ver == txVersionFeePayer {
+		sigBytes, err := ReadCompactBytes(cr)
+		if err != nil {
+			return cr.ReadCount(), fmt.Errorf("failed to read transaction fee payer signature: %w", err)
+		}
+		var signature auth.Signature
+		if err = signature.UnmarshalBinary(sigBytes); err != nil {
+			return cr.ReadCount(), fmt.Errorf("failed to unmarshal transaction fee payer signature: %w", err)
+		}
+		t.FeePayerSignature = &signature
+	}
+
 	return cr.ReadCount(), nil
 }
 
@@ -2,11 +2,13 @@ package types
 
 import (
 	"bytes"
+	"encoding/binary"
 	"encoding/json"
 	"errors"
 	"fmt"
 	"io"
 	"math/big"
+	"slices"
 	"strings"
 	"testing"
 
@@ -960,6 +962,26 @@ func TestTransactionBody_SerializeSize(t *testing.T) {
 			},
 			expected: 19, // 1 + 0 + 1 + 0 + 1 + 0 + (1 + 2 + 5) + 8 + 1 + 0
 		},
+		{
+			name: "validity heights",
+			body: TransactionBody{
+				Fee:              big.NewInt(0), // 1 + 1 + 1
+				ValidAfterHeight: 10,            // 1 + 8
+				ValidUntilHeight: 20,            // 1 + 8
+			},
+			expected: 33, // 1 + 0 + 1 + 0 + 1 + 0 + (1 + 1 + 1) + 8 + 1 + 0 + 9 + 9
+		},
+		{
+			// a length of 64 takes two bytes as the signed varint prefix of
+			// compact bytes, but only one as the unsigned prefix of a string
+			name: "lengths of 64",
+			body: TransactionBody{
+				Description: string(make([]byte, 64)), // 1 + 64
+				Payload:     make([]byte, 64),         // 2 + 64
+				Fee:         big.NewInt(0),            // 1 + 1 + 1
+			},
+			expected: 144, // 1 + 64 + 2 + 64 + 1 + 0 + (1 + 1 + 1) + 8 + 1 + 0
+		},
 	}
 
 	for _, tc := range testCases {
@@ -1043,6 +1065,18 @@ func TestTransaction_SerializeSize(t *testing.T) {
 			},
 			expected: 1032, // 2 + 1 + (13) + 2 + (2 + 1000 + 1 + 4) + 1 + 1 + 1 + 4
 		},
+		{
+			// a serialized secp256k1 signature is longer than 63 bytes, so it
+			// has a two byte signed varint length
+			name: "secp256k1 signature data",
+			tx: Transaction{
+				Body:          &TransactionBody{},
+				Signature:     &auth.Signature{Data: make([]byte, 65), Type: "test"},
+				Sender:        []byte{1},
+				Serialization: "test",
+			},
+			expected: 96, // 2 + 1 + (13) + 2 + (71) + 1 + 1 + 1 + 4
+		},
 	}
 
 	for _, tc := range testCases {
@@ -1302,3 +1336,243 @@ func TestReadCompactString(t *testing.T) {
 	require.Error(t, err)
 	require.ErrorContains(t, err, "longer than data length")
 }
+
+func TestTransactionBodyValidityHeights(t *testing.T) {
+	t.Parallel()
+
+	newBody := func(after, until int64) *TransactionBody {
+		return &TransactionBody{
+			Description: "test",
+			Payload:     []byte("payload"),
+			PayloadType: PayloadTypeExecute,
+			Fee:         big.NewInt(100),
+			Nonce:       1,
+			ChainID:     "test-chain",
+
+			ValidAfterHeight: after,
+			ValidUntilHeight: until,
+		}
+	}
+
+	t.Run("unset heights do not change the serialization", func(t *testing.T) {
+		body := newBody(0, 0)
+		data := body.Bytes()
+
+		withHeights := newBody(5, 10).Bytes()
+		require.Equal(t, data, withHeights[:len(data)])
+		require.Len(t, withHeights, len(data)+18)
+
+		msg, err := body.SerializeMsg(SignedMsgConcat)
+		require.NoError(t, err)
+		require.NotContains(t, string(msg), "Height")
+		require.Contains(t, string(msg), "Nonce: 1\n\nKwil Chain ID: test-chain\n")
+	})
+
+	t.Run("round trip", func(t *testing.T) {
+		for _, heights := range [][2]int64{{0, 0}, {5, 0}, {0, 10}, {5, 10}} {
+			body := newBody(heights[0], heights[1])
+
+			var body2 TransactionBody
+			body2.StrictUnmarshal()
+			require.NoError(t, body2.UnmarshalBinary(body.Bytes()))
+			require.Equal(t, heights[0], body2.ValidAfterHeight)
+			require.Equal(t, heights[1], body2.ValidUntilHeight)
+			require.Equal(t, body.Bytes(), body2.Bytes())
+
+			jsonData, err := json.Marshal(body)
+			require.NoError(t, err)
+			var body3 TransactionBody
+			require.NoError(t, json.Unmarshal(jsonData, &body3))
+			require.Equal(t, heights[0], body3.ValidAfterHeight)
+			require.Equal(t, heights[1], body3.ValidUntilHeight)
+		}
+	})
+
+	t.Run("signed message", func(t *testing.T) {
+		msg, err := newBody(5, 10).SerializeMsg(SignedMsgConcat)
+		require.NoError(t, err)
+		require.Contains(t, string(msg), "Nonce: 1\nValid After Height: 5\nValid Until Height: 10\n\nKwil Chain ID: test-chain\n")
+
+		direct, err := newBody(0, 10).SerializeMsg(SignedMsgDirect)
+		require.NoError(t, err)
+		direct2, err := newBody(0, 11).SerializeMsg(SignedMsgDirect)
+		require.NoError(t, err)
+		require.NotEqual(t, direct, direct2)
+	})
+
+	t.Run("non-canonical fields", func(t *testing.T) {
+		base := newBody(0, 0).Bytes()
+		field := func(id byte, height int64) []byte {
+			return binary.LittleEndian.AppendUint64([]byte{id}, uint64(height))
+		}
+
+		for name, extra := range map[string][]byte{
+			"out of order": append(field(2, 10), field(1, 5)...),
+			"duplicate":    append(field(1, 5), field(1, 6)...),
+			"zero":         field(2, 0),
+			"unknown":      field(9, 1),
+			"truncated":    field(1, 5)[:4],
+		} {
+			var body TransactionBody
+			err := body.UnmarshalBinary(append(slices.Clone(base), extra...))
+			require.Error(t, err, name)
+		}
+	})
+
+	t.Run("valid at", func(t *testing.T) {
+		body := newBody(5, 10)
+		for height, valid := range map[int64]bool{4: false, 5: false, 6: true, 10: true, 11: false} {
+			err := body.ValidAt(height)
+			if valid {
+				require.NoError(t, err, height)
+			} else {
+				require.ErrorIs(t, err, ErrTxOutOfHeightRange, height)
+			}
+		}
+
+		require.NoError(t, newBody(0, 0).ValidAt(1_000_000))
+	})
+}
+
+func TestTransactionFeePayer(t *testing.T) {
+	sender, payer := secp256k1Signer(t), ed25519Signer(t)
+	newTx := func() *Transaction {
+		return &Transaction{
+			Body: &TransactionBody{
+				Payload:     []byte("payload"),
+				PayloadType: PayloadTypeExecute,
+				Fee:         big.NewInt(100),
+				Nonce:       1,
+				ChainID:     "test-chain",
+				FeePayer: &FeePayer{
+					Identifier: payer.CompactID(),
+					AuthType:   payer.AuthType(),
+				},
+			},
+			Serialization: SignedMsgConcat,
+		}
+	}
+
+	t.Run("sign and verify", func(t *testing.T) {
+		tx := newTx()
+		require.NoError(t, tx.Sign(sender))
+		require.NoError(t, tx.SignFeePayer(payer))
+
+		msg, err := tx.SerializeMsg()
+		require.NoError(t, err)
+		require.Contains(t, string(msg), fmt.Sprintf("Sender: %x (%s)\nFee Payer: %x (%s)\n",
+			sender.CompactID(), sender.AuthType(), payer.CompactID(), payer.AuthType()))
+		require.NoError(t, auth.EthSecp256k1Authenticator{}.Verify(tx.Sender, msg, tx.Signature.Data))
+		require.NoError(t, auth.Ed25519Authenticator{}.Verify(tx.Body.FeePayer.Identifier, msg, tx.FeePayerSignature.Data))
+
+		require.Error(t, newTx().SignFeePayer(sender), "not the fee payer")
+		require.Error(t, newTx().SignFeePayer(payer), "not signed by the sender")
+	})
+
+	t.Run("bound to the sender", func(t *testing.T) {
+		for _, ser := range []SignedMsgSerializationType{SignedMsgConcat, SignedMsgDirect} {
+			tx := newTx()
+			tx.Serialization = ser
+			require.NoError(t, tx.Sign(sender))
+			require.NoError(t, tx.SignFeePayer(payer))
+
+			// another sender reusing the fee payer's signature
+			other := newTx()
+			other.Serialization = ser
+			require.NoError(t, other.Sign(secp256k1Signer(t)))
+			other.FeePayerSignature = tx.FeePayerSignature
+
+			msg, err := other.SerializeMsg()
+			require.NoError(t, err)
+			require.NoError(t, auth.EthSecp256k1Authenticator{}.Verify(other.Sender, msg, other.Signature.Data))
+			require.Error(t, auth.Ed25519Authenticator{}.Verify(other.Body.FeePayer.Identifier, msg, other.FeePayerSignature.Data), ser)
+		}
+	})
+
+	t.Run("round trip", func(t *testing.T) {
+		tx := newTx()
+		require.NoError(t, tx.Sign(sender))
+		unsponsored := tx.Bytes()
+		require.Equal(t, txVersion, binary.LittleEndian.Uint16(unsponsored))
+
+		require.NoError(t, tx.SignFeePayer(payer))
+		data := tx.Bytes()
+		require.Equal(t, txVersionFeePayer, binary.LittleEndian.Uint16(data))
+		require.Equal(t, int64(len(data)), tx.SerializeSize())
+		require.Equal(t, int64(len(tx.Body.Bytes())), tx.Body.SerializeSize())
+
+		var tx2 Transaction
+		tx2.StrictUnmarshal()
+		require.NoError(t, tx2.UnmarshalBinary(data))
+		require.Equal(t, tx.Body.FeePayer, tx2.Body.FeePayer)
+		require.Equal(t, tx.FeePayerSignature, tx2.FeePayerSignature)
+		require.Equal(t, data, tx2.Bytes())
+
+		// reusing the Transaction clears the fee payer signature
+		require.NoError(t, tx2.UnmarshalBinary(unsponsored))
+		require.Nil(t, tx2.FeePayerSignature)
+
+		jsonData, err := json.Marshal(tx)
+		require.NoError(t, err)
+		var tx3 Transaction
+		require.NoError(t, json.Unmarshal(jsonData, &tx3))
+		require.Equal(t, data, tx3.Bytes())
+	})
+
+	t.Run("empty fee payer", func(t *testing.T) {
+		body := newTx().Body
+		body.FeePayer = &FeePayer{AuthType: payer.AuthType()}
+		var body2 TransactionBody
+		require.Error(t, body2.UnmarshalBinary(body.Bytes()))
+	})
+}
+
+func TestTransactionDelegator(t *testing.T) {
+	session, delegator := secp256k1Signer(t), ed25519Signer(t)
+	tx := &Transaction{
+		Body: &TransactionBody{
+			Payload:     []byte("payload"),
+			PayloadType: PayloadTypeExecute,
+			Fee:         big.NewInt(100),
+			Nonce:       1,
+			ChainID:     "test-chain",
+			Delegator: &Delegator{
+				Identifier: delegator.CompactID(),
+				AuthType:   delegator.AuthType(),
+			},
+		},
+		Serialization: SignedMsgConcat,
+	}
+	require.NoError(t, tx.Sign(session))
+
+	msg, err := tx.SerializeMsg()
+	require.NoError(t, err)
+	require.Contains(t, string(msg), fmt.Sprintf("Delegator: %x (%s)\n", delegator.CompactID(), delegator.AuthType()))
+	require.NoError(t, auth.EthSecp256k1Authenticator{}.Verify(tx.Sender, msg, tx.Signature.Data))
+
+	data := tx.Bytes()
+	require.Equal(t, int64(len(data)), tx.SerializeSize())
+	require.Equal(t, int64(len(tx.Body.Bytes())), tx.Body.SerializeSize())
+
+	var tx2 Transaction
+	tx2.StrictUnmarshal()
+	require.NoError(t, tx2.UnmarshalBinary(data))
+	require.Equal(t, tx.Body.Delegator, tx2.Body.Delegator)
+	require.Equal(t, data, tx2.Bytes())
+
+	jsonData, err := json.Marshal(tx)
+	require.NoError(t, err)
+	var tx3 Transaction
+	require.NoError(t, json.Unmarshal(jsonData, &tx3))
+	require.Equal(t, data, tx3.Bytes())
+
+	// the delegator field follows the fee payer field
+	tx.Body.FeePayer = &FeePayer{Identifier: session.CompactID(), AuthType: session.AuthType()}
+	var body TransactionBody
+	require.NoError(t, body.UnmarshalBinary(tx.Body.Bytes()))
+	require.Equal(t, tx.Body.FeePayer, body.FeePayer)
+	require.Equal(t, tx.Body.Delegator, body.Delegator)
+
+	tx.Body.Delegator = &Delegator{AuthType: delegator.AuthType()}
+	require.Error(t, body.UnmarshalBinary(tx.Body.Bytes()), "empty delegator")
+}
@@ -0,0 +1,168 @@
+package types
+
+import (
+	"bytes"
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"slices"
+)
+
+// Enum is a value of a user-defined enum type, created with
+// CREATE TYPE name AS ENUM (...).
+type Enum struct {
+	// Type is the name of the enum type.
+	Type string `json:"type"`
+	// Label is the label of the value.
+	Label string `json:"label"`
+}
+
+// Composite is a value of a user-defined composite type, created with
+// CREATE TYPE name AS (field type, ...).
+type Composite struct {
+	// Type is the name of the composite type.
+	Type string `json:"type"`
+	// Fields are the values of the fields of the type. Fields that are
+	// omitted are null.
+	Fields []*CompositeField `json:"fields"`
+}
+
+// CompositeField is a named field of a composite value.
+type CompositeField struct {
+	Name string `json:"name"`
+	// Value is the value of the field. It can be any value that can be
+	// encoded with EncodeValue, including other user-defined values.
+	Value any `json:"value"`
+}
+
+// Field returns the value of the field with the given name, and whether the
+// field was set.
+func (c *Composite) Field(name string) (any, bool) {
+	i := slices.IndexFunc(c.Fields, func(f *CompositeField) bool { return f.Name == name })
+	if i == -1 {
+		return nil, false
+	}
+
+	return c.Fields[i].Value, true
+}
+
+// the first byte of the encoding of a non-null user-defined value identifies
+// its kind, since the type name alone does not say whether it is an enum or
+// a composite.
+const (
+	userValueEnum      byte = 'e'
+	userValueComposite byte = 'c'
+)
+
+// User-defined values are encoded as follows (after the not-null byte):
+//
+//   - A byte for the kind of the value, 'e' for enums and 'c' for composites.
+//   - For enums, the bytes of the label.
+//   - For composites, the number of fields as a uint16, and for each field
+//     its name and its EncodedValue serialized according to MarshalBinary,
+//     each written according to WriteBytes.
+
+// encodeUserValue encodes an enum or composite value.
+func encodeUserValue(v any) ([]byte, *DataType, error) {
+	switch v := v.(type) {
+	case Enum:
+		if v.Type == "" {
+			return nil, nil, errors.New("enum value must have a type")
+		}
+
+		return encodeNotNull(append([]byte{userValueEnum}, v.Label...)), &DataType{Name: v.Type}, nil
+	case Composite:
+		if v.Type == "" {
+			return nil, nil, errors.New("composite value must have a type")
+		}
+		if len(v.Fields) > int(^uint16(0)) {
+			return nil, nil, fmt.Errorf("too many fields in composite value: %d", len(v.Fields))
+		}
+
+		buf := &bytes.Buffer{}
+		buf.WriteByte(userValueComposite)
+		if err := binary.Write(buf, SerializationByteOrder, uint16(len(v.Fields))); err != nil {
+			return nil, nil, err
+		}
+		for _, f := range v.Fields {
+			ev, err := EncodeValue(f.Value)
+			if err != nil {
+				return nil, nil, fmt.Errorf("field %s: %w", f.Name, err)
+			}
+			bts, err := ev.MarshalBinary()
+			if err != nil {
+				return nil, nil, err
+			}
+
+			if err := WriteBytes(buf, []byte(f.Name)); err != nil {
+				return nil, nil, err
+			}
+			if err := WriteBytes(buf, bts); err != nil {
+				return nil, nil, err
+			}
+		}
+
+		return encodeNotNull(buf.Bytes()), &DataType{Name: v.Type}, nil
+	default:
+		return nil, nil, fmt.Errorf("cannot encode type %T", v)
+	}
+}
+
+// decodeUserValue decodes an enum or composite value of the given type. It
+// returns a *Enum or a *Composite.
+func decodeUserValue(data []byte, typename string) (any, error) {
+	data, null, err := decodeNullable(data)
+	if err != nil {
+		return nil, err
+	}
+	if null {
+		return nil, nil
+	}
+	if len(data) == 0 {
+		return nil, fmt.Errorf("missing kind of user-defined value of type %s", typename)
+	}
+
+	switch data[0] {
+	case userValueEnum:
+		return &Enum{Type: typename, Label: string(data[1:])}, nil
+	case userValueComposite:
+		buf := bytes.NewBuffer(data[1:])
+		var numFields uint16
+		if err := binary.Read(buf, SerializationByteOrder, &numFields); err != nil {
+			return nil, err
+		}
+
+		c := &Composite{
+			Type:   typename,
+			Fields: make([]*CompositeField, numFields),
+		}
+		for i := range c.Fields {
+			name, err := ReadBytes(buf)
+			if err != nil {
+				return nil, err
+			}
+			bts, err := ReadBytes(buf)
+			if err != nil {
+				return nil, err
+			}
+
+			var ev EncodedValue
+			if err := ev.UnmarshalBinary(bts); err != nil {
+				return nil, fmt.Errorf("field %s: %w", name, err)
+			}
+			val, err := ev.Decode()
+			if err != nil {
+				return nil, fmt.Errorf("field %s: %w", name, err)
+			}
+
+			c.Fields[i] = &CompositeField{Name: string(name), Value: val}
+		}
+		if buf.Len() != 0 {
+			return nil, fmt.Errorf("unexpected trailing bytes in composite value of type %s", typename)
+		}
+
+		return c, nil
+	default:
+		return nil, fmt.Errorf("unknown kind %d of user-defined value of type %s", data[0], typename)
+	}
+}
@@ -90,6 +90,12 @@ func init() {
 	if err != nil {
 		panic(err)
 	}
+
+	// the members of multisig accounts may use any registered authenticator
+	err = RegisterAuthenticator(ModAdd, auth.MultisigAuth, auth.MultisigAuthenticator{Authenticators: GetAuthenticator})
+	if err != nil {
+		panic(err)
+	}
 }
 
 func IsAuthTypeValid(authType string) bool {
@@ -3,8 +3,11 @@ package hooks
 import (
 	"context"
 	"fmt"
+	"slices"
+	"strings"
 
 	"github.com/trufnetwork/kwil-db/common"
+	"github.com/trufnetwork/kwil-db/core/types"
 	"github.com/trufnetwork/kwil-db/core/utils/order"
 )
 
@@ -126,8 +129,67 @@ func ListEngineReadyHooks() []EngineReadyHook {
 	return hooks
 }
 
+// SponsorPolicy is a function that decides whether the fee payer of a
+// sponsored transaction may pay for it, for example to restrict the
+// namespaces that a fee payer sponsors. It returns an error if the fee payer
+// may not pay. Policies are run when a transaction enters the mempool and when
+// it is executed, so they must be deterministic, as all nodes will run the same
+// SponsorPolicies in the same order.
+type SponsorPolicy func(ctx context.Context, payer *types.AccountID, tx *types.Transaction) error
+
+var sponsorPolicies map[string]SponsorPolicy
+
+// RegisterSponsorPolicy registers a SponsorPolicy that every sponsored
+// transaction must satisfy. The name can be anything, as long as it is unique.
+// It is used to deterministically order the policies.
+func RegisterSponsorPolicy(name string, policy SponsorPolicy) error {
+	_, ok := sponsorPolicies[name]
+	if ok {
+		return fmt.Errorf("sponsor policy with name %s already exists", name)
+	}
+
+	sponsorPolicies[name] = policy
+	return nil
+}
+
+// ListSponsorPolicies deterministically returns a list of all registered
+// SponsorPolicies.
+func ListSponsorPolicies() []SponsorPolicy {
+	var policies []SponsorPolicy
+	for _, policy := range order.OrderMap(sponsorPolicies) {
+		policies = append(policies, policy.Value)
+	}
+
+	return policies
+}
+
+// SponsorNamespaces returns a SponsorPolicy that restricts the transactions
+// sponsored by a fee payer to the execution of actions in the given
+// namespaces. If payer is nil, it restricts every fee payer.
+func SponsorNamespaces(payer *types.AccountID, namespaces ...string) SponsorPolicy {
+	return func(ctx context.Context, txPayer *types.AccountID, tx *types.Transaction) error {
+		if payer != nil && (payer.KeyType != txPayer.KeyType || !slices.Equal(payer.Identifier, txPayer.Identifier)) {
+			return nil
+		}
+
+		if tx.Body.PayloadType != types.PayloadTypeExecute {
+			return fmt.Errorf("%w: sponsored transactions must execute actions, not %s",
+				types.ErrFeePayerRejected, tx.Body.PayloadType)
+		}
+		exec := &types.ActionExecution{}
+		if err := exec.UnmarshalBinary(tx.Body.Payload); err != nil {
+			return err
+		}
+		if !slices.ContainsFunc(namespaces, func(ns string) bool { return strings.EqualFold(ns, exec.Namespace) }) {
+			return fmt.Errorf("%w: namespace %s is not sponsored", types.ErrFeePayerRejected, exec.Namespace)
+		}
+		return nil
+	}
+}
+
 func init() {
 	genesisHooks = make(map[string]GenesisHook)
 	endBlockHooks = make(map[string]EndBlockHook)
 	engineReadyHooks = make(map[string]EngineReadyHook)
+	sponsorPolicies = make(map[string]SponsorPolicy)
 }
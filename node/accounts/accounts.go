@@ -6,6 +6,7 @@ import (
 	"encoding/binary"
 	"errors"
 	"fmt"
+	"maps"
 	"math/big"
 	"sync"
 
@@ -109,6 +110,8 @@ func (s *Spend) UnmarshalBinary(data []byte) (err error) {
 func InitializeAccountStore(ctx context.Context, db sql.DB, logger log.Logger) (*Accounts, error) {
 	upgradeFns := map[int64]versioning.UpgradeFunc{
 		0: initTables,
+		1: initSessionTables,
+		2: initMultisigTables,
 	}
 
 	err := versioning.Upgrade(ctx, db, schemaName, upgradeFns, accountStoreVersion)
@@ -223,8 +226,47 @@ func (a *Accounts) Credit(ctx context.Context, tx sql.Executor, account *types.A
 
 // Spend spends an amount from an account and records nonces. It blocks until the spend is written to the database.
 // The nonce passed must be exactly one greater than the account's nonce. If the nonce is not valid, the spend will fail.
+// If payer is not nil, the amount is spent from the payer's account instead, which sponsors the spend, while
+// the nonce is still that of the account.
 // If the account does not have enough funds to spend the amount, an ErrInsufficientFunds error will be returned.
-func (a *Accounts) Spend(ctx context.Context, tx sql.Executor, account *types.AccountID, amount *big.Int, nonce int64) error {
+func (a *Accounts) Spend(ctx context.Context, tx sql.Executor, account, payer *types.AccountID, amount *big.Int, nonce int64) error {
+	if payer != nil && acctMapKey(payer) == acctMapKey(account) {
+		payer = nil // an account paying for itself is not sponsored
+	}
+	if payer == nil {
+		return a.spend(ctx, tx, account, amount, nonce)
+	}
+
+	// The payer is checked before the account's nonce is updated, so a failed
+	// sponsored spend changes neither account.
+	payerAcct, err := a.getAccount(ctx, tx, payer, true)
+	if err != nil {
+		if !errors.Is(err, ErrAccountNotFound) || amount.Sign() != 0 {
+			return err
+		}
+		payerAcct = &types.Account{Balance: big.NewInt(0)}
+	}
+	newBal := new(big.Int).Sub(payerAcct.Balance, amount)
+	if newBal.Sign() < 0 {
+		return errInsufficientFunds(payer, amount, payerAcct.Balance)
+	}
+
+	if err := a.spend(ctx, tx, account, big.NewInt(0), nonce); err != nil {
+		return err
+	}
+	if amount.Sign() == 0 {
+		return nil
+	}
+
+	// track the sponsored spend for migration as a spend of the payer, whose
+	// nonce is not used by ApplySpend
+	a.recordSpend(payer, amount, nonce)
+
+	return a.updateAccount(ctx, tx, payer, newBal, payerAcct.Nonce)
+}
+
+// spend spends an amount from an account that pays for itself.
+func (a *Accounts) spend(ctx context.Context, tx sql.Executor, account *types.AccountID, amount *big.Int, nonce int64) error {
 	acct, err := a.getAccount(ctx, tx, account, true)
 	if err != nil {
 		// If amount is 0 and account does not exist, create the account
@@ -398,6 +440,25 @@ func (a *Accounts) Rollback() {
 	a.spends = nil
 }
 
+// Checkpoint returns a function that restores the account updates and spends
+// of the block to what they are at the time of the call. It is used to discard
+// the updates made in a database transaction that is then rolled back.
+func (a *Accounts) Checkpoint() (restore func()) {
+	a.mtx.RLock()
+	defer a.mtx.RUnlock()
+
+	updates := maps.Clone(a.updates)
+	numSpends := len(a.spends)
+
+	return func() {
+		a.mtx.Lock()
+		defer a.mtx.Unlock()
+
+		a.updates = updates
+		a.spends = a.spends[:numSpends]
+	}
+}
+
 func acctMapKey(account *types.AccountID) string {
 	return string(account.Identifier) + "#" + account.KeyType.String()
 }
@@ -97,3 +97,96 @@ func TestGetAccount(t *testing.T) {
 	require.NoError(t, err)
 	require.Equal(t, big.NewInt(100), account.Balance)
 }
+
+func TestSessionsLive(t *testing.T) {
+	ctx := context.Background()
+	db, err := pg.NewDB(ctx, testConfig)
+	require.NoError(t, err)
+	defer cleanupDB(ctx, db)
+
+	tx, err := db.BeginTx(ctx)
+	require.NoError(t, err)
+	defer tx.Rollback(ctx)
+
+	_, err = InitializeAccountStore(ctx, tx, log.DiscardLogger)
+	require.NoError(t, err)
+
+	session := &Session{
+		Delegator:     []byte("delegator"),
+		DelegatorAuth: "secp256k1_ep",
+		SessionKey:    []byte("session"),
+		SessionAuth:   "ed25519",
+		Namespaces:    []string{"main"},
+		Actions:       []string{"move", "attack"},
+		Expiry:        100,
+		SpendCap:      big.NewInt(1000),
+		Spent:         big.NewInt(0),
+	}
+	require.NoError(t, CreateSession(ctx, tx, session))
+
+	got, err := GetSession(ctx, tx, session.Delegator, session.DelegatorAuth, session.SessionKey, session.SessionAuth)
+	require.NoError(t, err)
+	require.Equal(t, session, got)
+
+	got.Spent.SetInt64(300)
+	require.NoError(t, UpdateSessionSpent(ctx, tx, got))
+	got, err = GetSession(ctx, tx, session.Delegator, session.DelegatorAuth, session.SessionKey, session.SessionAuth)
+	require.NoError(t, err)
+	require.Equal(t, int64(300), got.Spent.Int64())
+
+	// replacing the session resets what it spent
+	unscoped := &Session{
+		Delegator:     session.Delegator,
+		DelegatorAuth: session.DelegatorAuth,
+		SessionKey:    session.SessionKey,
+		SessionAuth:   session.SessionAuth,
+		Expiry:        200,
+		Spent:         big.NewInt(0),
+	}
+	require.NoError(t, CreateSession(ctx, tx, unscoped))
+	got, err = GetSession(ctx, tx, session.Delegator, session.DelegatorAuth, session.SessionKey, session.SessionAuth)
+	require.NoError(t, err)
+	require.Equal(t, unscoped, got)
+
+	// a session is specific to the delegator's authenticator
+	_, err = GetSession(ctx, tx, session.Delegator, "ed25519", session.SessionKey, session.SessionAuth)
+	require.ErrorIs(t, err, ErrSessionNotFound)
+
+	require.NoError(t, RevokeSession(ctx, tx, session.Delegator, session.DelegatorAuth, session.SessionKey, session.SessionAuth))
+	_, err = GetSession(ctx, tx, session.Delegator, session.DelegatorAuth, session.SessionKey, session.SessionAuth)
+	require.ErrorIs(t, err, ErrSessionNotFound)
+	err = RevokeSession(ctx, tx, session.Delegator, session.DelegatorAuth, session.SessionKey, session.SessionAuth)
+	require.ErrorIs(t, err, ErrSessionNotFound)
+}
+
+func TestMultisigsLive(t *testing.T) {
+	ctx := context.Background()
+	db, err := pg.NewDB(ctx, testConfig)
+	require.NoError(t, err)
+	defer cleanupDB(ctx, db)
+
+	tx, err := db.BeginTx(ctx)
+	require.NoError(t, err)
+	defer tx.Rollback(ctx)
+
+	_, err = InitializeAccountStore(ctx, tx, log.DiscardLogger)
+	require.NoError(t, err)
+
+	id := []byte("id")
+	_, err = GetMultisig(ctx, tx, id)
+	require.ErrorIs(t, err, ErrMultisigNotFound)
+	require.ErrorIs(t, UpdateMultisig(ctx, tx, id, []byte("definition")), ErrMultisigNotFound)
+
+	require.NoError(t, RegisterMultisig(ctx, tx, id, []byte("definition")))
+	require.ErrorIs(t, RegisterMultisig(ctx, tx, id, []byte("other")), ErrMultisigExists)
+
+	got, err := GetMultisig(ctx, tx, id)
+	require.NoError(t, err)
+	require.Equal(t, []byte("definition"), got)
+
+	// the account keeps its ID when its definition changes
+	require.NoError(t, UpdateMultisig(ctx, tx, id, []byte("rotated")))
+	got, err = GetMultisig(ctx, tx, id)
+	require.NoError(t, err)
+	require.Equal(t, []byte("rotated"), got)
+}
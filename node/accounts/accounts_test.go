@@ -326,7 +326,7 @@ var acctsTestCases = []acctsTestCase{
 		fn: func(t *testing.T, db sql.DB, a *Accounts, c counter, skip bool) {
 			ctx := context.Background()
 
-			err := a.Spend(ctx, db, account1, big.NewInt(100), 1)
+			err := a.Spend(ctx, db, account1, nil, big.NewInt(100), 1)
 			require.ErrorIs(t, err, ErrAccountNotFound)
 			verifyDBAccessCount(t, c, 1, skip)
 		},
@@ -340,7 +340,7 @@ var acctsTestCases = []acctsTestCase{
 			require.NoError(t, err)
 			verifyDBAccessCount(t, c, 1, skip)
 
-			err = a.Spend(ctx, db, account1, big.NewInt(101), 1)
+			err = a.Spend(ctx, db, account1, nil, big.NewInt(101), 1)
 			require.ErrorIs(t, err, ErrInsufficientFunds)
 
 			acc, err := a.GetAccount(ctx, db, account1)
@@ -359,7 +359,7 @@ var acctsTestCases = []acctsTestCase{
 			require.NoError(t, err)
 			verifyDBAccessCount(t, c, 1, skip)
 
-			err = a.Spend(ctx, db, account1, big.NewInt(50), 2)
+			err = a.Spend(ctx, db, account1, nil, big.NewInt(50), 2)
 			require.ErrorIs(t, err, ErrInvalidNonce)
 
 			acc, err := a.GetAccount(ctx, db, account1)
@@ -378,7 +378,7 @@ var acctsTestCases = []acctsTestCase{
 			require.NoError(t, err)
 			verifyDBAccessCount(t, c, 1, skip)
 
-			err = a.Spend(ctx, db, account1, big.NewInt(50), 1)
+			err = a.Spend(ctx, db, account1, nil, big.NewInt(50), 1)
 			require.NoError(t, err)
 
 			acc, err := a.GetAccount(ctx, db, account1)
@@ -392,7 +392,7 @@ var acctsTestCases = []acctsTestCase{
 		fn: func(t *testing.T, db sql.DB, a *Accounts, c counter, skip bool) {
 			ctx := context.Background()
 
-			err := a.Spend(ctx, db, account1, big.NewInt(0), 1)
+			err := a.Spend(ctx, db, account1, nil, big.NewInt(0), 1)
 			require.NoError(t, err)
 			verifyDBAccessCount(t, c, 1, skip)
 
@@ -406,6 +406,65 @@ var acctsTestCases = []acctsTestCase{
 			verifyDBAccessCount(t, c, 1, skip)
 		},
 	},
+	{
+		name: "sponsored spend",
+		fn: func(t *testing.T, db sql.DB, a *Accounts, c counter, skip bool) {
+			ctx := context.Background()
+
+			err := a.Credit(ctx, db, account2, big.NewInt(100))
+			require.NoError(t, err)
+
+			// the sender has no account, and pays nothing
+			err = a.Spend(ctx, db, account1, account2, big.NewInt(40), 1)
+			require.NoError(t, err)
+			verifyDBAccessCount(t, c, 2, skip)
+
+			acc, err := a.GetAccount(ctx, db, account1)
+			require.NoError(t, err)
+			require.Equal(t, big.NewInt(0), acc.Balance)
+			require.Equal(t, int64(1), acc.Nonce)
+
+			payer, err := a.GetAccount(ctx, db, account2)
+			require.NoError(t, err)
+			require.Equal(t, big.NewInt(60), payer.Balance)
+			require.Equal(t, int64(0), payer.Nonce)
+
+			// neither account changes if the payer cannot pay
+			err = a.Spend(ctx, db, account1, account2, big.NewInt(61), 2)
+			require.ErrorIs(t, err, ErrInsufficientFunds)
+
+			acc, err = a.GetAccount(ctx, db, account1)
+			require.NoError(t, err)
+			require.Equal(t, int64(1), acc.Nonce)
+		},
+	},
+	{
+		name: "checkpoint restore",
+		fn: func(t *testing.T, db sql.DB, a *Accounts, c counter, skip bool) {
+			ctx := context.Background()
+
+			err := a.Credit(ctx, db, account1, big.NewInt(100))
+			require.NoError(t, err)
+
+			restore := a.Checkpoint()
+
+			err = a.Transfer(ctx, db, account1, account2, big.NewInt(40))
+			require.NoError(t, err)
+			err = a.Spend(ctx, db, account1, nil, big.NewInt(10), 1)
+			require.NoError(t, err)
+			require.Len(t, a.spends, 1)
+
+			restore()
+
+			require.Empty(t, a.spends)
+			_, ok := a.updates[acctMapKey(account2)]
+			require.False(t, ok)
+			acct, ok := a.updates[acctMapKey(account1)]
+			require.True(t, ok)
+			require.Equal(t, big.NewInt(100), acct.Balance)
+			require.Equal(t, int64(0), acct.Nonce)
+		},
+	},
 	{
 		name: "Account Cache test",
 		fn: func(t *testing.T, db sql.DB, a *Accounts, c counter, skip bool) {
@@ -415,7 +474,7 @@ var acctsTestCases = []acctsTestCase{
 			_, ok := a.records.Get(mapKey)
 			require.False(t, ok)
 
-			err := a.Spend(ctx, db, account1, big.NewInt(0), 1)
+			err := a.Spend(ctx, db, account1, nil, big.NewInt(0), 1)
 			require.NoError(t, err)
 			verifyDBAccessCount(t, c, 1, skip)
 
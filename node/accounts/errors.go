@@ -15,6 +15,9 @@ var (
 	ErrAccountNotFound   = errors.New("account not found")
 	ErrNegativeBalance   = errors.New("negative balance not permitted")
 	ErrNegativeTransfer  = errors.New("negative transfer not permitted")
+	ErrSessionNotFound   = errors.New("session not found")
+	ErrMultisigExists    = errors.New("multisig account already registered")
+	ErrMultisigNotFound  = errors.New("multisig account not found")
 )
 
 // errInsufficientFunds formats an error message for insufficient funds
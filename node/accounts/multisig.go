@@ -0,0 +1,56 @@
+package accounts
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/trufnetwork/kwil-db/node/types/sql"
+)
+
+// RegisterMultisig registers a multisig account with its serialized
+// definition. If an account with the ID is already registered, it returns
+// ErrMultisigExists.
+func RegisterMultisig(ctx context.Context, db sql.Executor, id, definition []byte) error {
+	res, err := db.Execute(ctx, sqlCreateMultisig, id, definition)
+	if err != nil {
+		return err
+	}
+	if res.Status.RowsAffected == 0 {
+		return ErrMultisigExists
+	}
+	return nil
+}
+
+// GetMultisig retrieves the current serialized definition of a multisig
+// account. If the account is not registered, it returns ErrMultisigNotFound.
+func GetMultisig(ctx context.Context, db sql.Executor, id []byte) ([]byte, error) {
+	results, err := db.Execute(ctx, sqlGetMultisig, id)
+	if err != nil {
+		return nil, err
+	}
+	if len(results.Rows) == 0 {
+		return nil, ErrMultisigNotFound
+	}
+	if len(results.Rows) > 1 {
+		return nil, fmt.Errorf("expected 1 row, got %d", len(results.Rows))
+	}
+	definition, ok := results.Rows[0][0].([]byte)
+	if !ok {
+		return nil, errors.New("failed to convert stored multisig definition to bytes")
+	}
+	return definition, nil
+}
+
+// UpdateMultisig replaces the definition of a multisig account, which keeps its
+// ID. If the account is not registered, it returns ErrMultisigNotFound.
+func UpdateMultisig(ctx context.Context, db sql.Executor, id, definition []byte) error {
+	res, err := db.Execute(ctx, sqlUpdateMultisig, id, definition)
+	if err != nil {
+		return err
+	}
+	if res.Status.RowsAffected == 0 {
+		return ErrMultisigNotFound
+	}
+	return nil
+}
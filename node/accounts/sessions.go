@@ -0,0 +1,159 @@
+package accounts
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"math/big"
+
+	"github.com/trufnetwork/kwil-db/node/types/sql"
+)
+
+// Session is a session key that a delegator authorized to sign transactions
+// for it. The delegator and session key are each identified by their
+// identifier and authenticator type.
+type Session struct {
+	Delegator     []byte
+	DelegatorAuth string
+	SessionKey    []byte
+	SessionAuth   string
+	// Namespaces and Actions limit the actions that the session key may
+	// execute. An empty list does not limit them.
+	Namespaces []string
+	Actions    []string
+	// Expiry is the last block height at which the session is valid.
+	Expiry int64
+	// SpendCap is the maximum total amount the session may spend, or nil if
+	// there is no cap.
+	SpendCap *big.Int
+	// Spent is the total amount spent by the session.
+	Spent *big.Int
+}
+
+// CreateSession stores a session, with nothing spent. An existing session of
+// the same delegator and session key is replaced.
+func CreateSession(ctx context.Context, db sql.Executor, s *Session) error {
+	namespaces, actions := s.Namespaces, s.Actions
+	if namespaces == nil {
+		namespaces = []string{}
+	}
+	if actions == nil {
+		actions = []string{}
+	}
+	var spendCap *string
+	if s.SpendCap != nil {
+		str := s.SpendCap.String()
+		spendCap = &str
+	}
+
+	_, err := db.Execute(ctx, sqlCreateSession, s.Delegator, s.DelegatorAuth, s.SessionKey, s.SessionAuth,
+		namespaces, actions, s.Expiry, spendCap)
+	return err
+}
+
+// GetSession retrieves the session of a delegator and session key. If there is
+// no such session, it returns ErrSessionNotFound.
+func GetSession(ctx context.Context, db sql.Executor, delegator []byte, delegatorAuth string,
+	sessionKey []byte, sessionAuth string) (*Session, error) {
+	results, err := db.Execute(ctx, sqlGetSession, delegator, delegatorAuth, sessionKey, sessionAuth)
+	if err != nil {
+		return nil, err
+	}
+	if len(results.Rows) == 0 {
+		return nil, ErrSessionNotFound
+	}
+	if len(results.Rows) > 1 {
+		return nil, fmt.Errorf("expected 1 row, got %d", len(results.Rows))
+	}
+	row := results.Rows[0]
+
+	s := &Session{
+		Delegator:     delegator,
+		DelegatorAuth: delegatorAuth,
+		SessionKey:    sessionKey,
+		SessionAuth:   sessionAuth,
+	}
+
+	if s.Namespaces, err = stringArray(row[0]); err != nil {
+		return nil, fmt.Errorf("failed to convert stored session namespaces: %w", err)
+	}
+	if s.Actions, err = stringArray(row[1]); err != nil {
+		return nil, fmt.Errorf("failed to convert stored session actions: %w", err)
+	}
+
+	var ok bool
+	s.Expiry, ok = row[2].(int64)
+	if !ok {
+		return nil, errors.New("failed to convert stored session expiry to int64")
+	}
+
+	switch spendCap := row[3].(type) {
+	case nil:
+	case string:
+		s.SpendCap, ok = new(big.Int).SetString(spendCap, 10)
+		if !ok {
+			return nil, ErrConvertToBigInt
+		}
+	default:
+		return nil, errors.New("failed to convert stored session spend cap to big int")
+	}
+
+	spent, ok := row[4].(string)
+	if !ok {
+		return nil, errors.New("failed to convert stored session spent amount to big int")
+	}
+	s.Spent, ok = new(big.Int).SetString(spent, 10)
+	if !ok {
+		return nil, ErrConvertToBigInt
+	}
+
+	return s, nil
+}
+
+// UpdateSessionSpent stores the Spent amount of a session.
+func UpdateSessionSpent(ctx context.Context, db sql.Executor, s *Session) error {
+	_, err := db.Execute(ctx, sqlUpdateSessionSpent, s.Delegator, s.DelegatorAuth, s.SessionKey, s.SessionAuth,
+		s.Spent.String())
+	return err
+}
+
+// RevokeSession deletes the session of a delegator and session key. If there
+// is no such session, it returns ErrSessionNotFound.
+func RevokeSession(ctx context.Context, db sql.Executor, delegator []byte, delegatorAuth string,
+	sessionKey []byte, sessionAuth string) error {
+	res, err := db.Execute(ctx, sqlDeleteSession, delegator, delegatorAuth, sessionKey, sessionAuth)
+	if err != nil {
+		return err
+	}
+	if res.Status.RowsAffected == 0 {
+		return ErrSessionNotFound
+	}
+	return nil
+}
+
+// stringArray converts a stored TEXT[] value.
+func stringArray(v any) ([]string, error) {
+	switch arr := v.(type) {
+	case nil:
+		return nil, nil
+	case []string:
+		if len(arr) == 0 {
+			return nil, nil
+		}
+		return arr, nil
+	case []*string:
+		if len(arr) == 0 {
+			return nil, nil
+		}
+		strs := make([]string, len(arr))
+		for i, s := range arr {
+			if s == nil {
+				return nil, errors.New("unexpected NULL element")
+			}
+			strs[i] = *s
+		}
+		return strs, nil
+	default:
+		return nil, fmt.Errorf("unexpected type %T", v)
+	}
+}
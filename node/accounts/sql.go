@@ -14,7 +14,7 @@ import (
 const (
 	schemaName = `kwild_accts`
 
-	accountStoreVersion = 0
+	accountStoreVersion = 2
 
 	sqlInitTables = `CREATE TABLE IF NOT EXISTS ` + schemaName + `.accounts (
 		identifier BYTEA NOT NULL,
@@ -32,6 +32,45 @@ const (
 	sqlGetAccount = `SELECT balance, nonce FROM ` + schemaName + `.accounts WHERE identifier = $1 AND id_type = $2`
 
 	sqlNumAccounts = `SELECT COUNT(1) FROM ` + schemaName + `.accounts`
+
+	sqlInitSessionTables = `CREATE TABLE IF NOT EXISTS ` + schemaName + `.sessions (
+		delegator BYTEA NOT NULL,
+		delegator_auth TEXT NOT NULL,
+		session_key BYTEA NOT NULL,
+		session_auth TEXT NOT NULL,
+		namespaces TEXT[] NOT NULL, -- empty for any namespace
+		actions TEXT[] NOT NULL, -- empty for any action
+		expiry INT8 NOT NULL, -- last valid block height
+		spend_cap TEXT, -- NULL for no cap
+		spent TEXT NOT NULL,
+		PRIMARY KEY(delegator, delegator_auth, session_key, session_auth)
+	);`
+
+	sqlCreateSession = `INSERT INTO ` + schemaName + `.sessions (delegator, delegator_auth, session_key, session_auth,
+		namespaces, actions, expiry, spend_cap, spent) VALUES ($1, $2, $3, $4, $5, $6, $7, $8, '0')
+		ON CONFLICT (delegator, delegator_auth, session_key, session_auth) DO UPDATE
+		SET namespaces = $5, actions = $6, expiry = $7, spend_cap = $8, spent = '0'`
+
+	sqlGetSession = `SELECT namespaces, actions, expiry, spend_cap, spent FROM ` + schemaName + `.sessions
+		WHERE delegator = $1 AND delegator_auth = $2 AND session_key = $3 AND session_auth = $4`
+
+	sqlUpdateSessionSpent = `UPDATE ` + schemaName + `.sessions SET spent = $5
+		WHERE delegator = $1 AND delegator_auth = $2 AND session_key = $3 AND session_auth = $4`
+
+	sqlDeleteSession = `DELETE FROM ` + schemaName + `.sessions
+		WHERE delegator = $1 AND delegator_auth = $2 AND session_key = $3 AND session_auth = $4`
+
+	sqlInitMultisigTables = `CREATE TABLE IF NOT EXISTS ` + schemaName + `.multisigs (
+		id BYTEA PRIMARY KEY, -- hash of the definition it was registered with
+		definition BYTEA NOT NULL -- current definition
+	);`
+
+	sqlCreateMultisig = `INSERT INTO ` + schemaName + `.multisigs (id, definition) VALUES ($1, $2)
+		ON CONFLICT (id) DO NOTHING`
+
+	sqlGetMultisig = `SELECT definition FROM ` + schemaName + `.multisigs WHERE id = $1`
+
+	sqlUpdateMultisig = `UPDATE ` + schemaName + `.multisigs SET definition = $2 WHERE id = $1`
 )
 
 func initTables(ctx context.Context, tx sql.DB) error {
@@ -43,6 +82,28 @@ func initTables(ctx context.Context, tx sql.DB) error {
 	return nil
 }
 
+// initSessionTables creates the session key registry, upgrading the store
+// from version 0.
+func initSessionTables(ctx context.Context, tx sql.DB) error {
+	_, err := tx.Execute(ctx, sqlInitSessionTables)
+	if err != nil {
+		return fmt.Errorf("failed to initialize session tables: %w", err)
+	}
+
+	return nil
+}
+
+// initMultisigTables creates the multisig account registry, upgrading the
+// store from version 1.
+func initMultisigTables(ctx context.Context, tx sql.DB) error {
+	_, err := tx.Execute(ctx, sqlInitMultisigTables)
+	if err != nil {
+		return fmt.Errorf("failed to initialize multisig tables: %w", err)
+	}
+
+	return nil
+}
+
 // updateAccount updates the balance and nonce of an account.
 func updateAccount(ctx context.Context, db sql.Executor, acctID []byte, acctType uint32, amount *big.Int, nonce int64) error {
 	_, err := db.Execute(ctx, sqlUpdateAccount, amount.String(), nonce, acctID, acctType)
@@ -703,6 +703,12 @@ func (n *Node) BlockResultByHash(hash types.Hash) ([]ktypes.TxResult, error) {
 	return n.bki.Results(hash)
 }
 
+// BlockScheduleResultsByHash returns the results of the actions called by
+// schedules at the end of a block.
+func (n *Node) BlockScheduleResultsByHash(hash types.Hash) ([]*ktypes.ScheduleResult, error) {
+	return n.bki.ScheduleResults(hash)
+}
+
 func gcPeerCache() {
 	// gcPeerCache runs from a ticker started in node.Start.
 	// It trims the peerBest map by removing entries that are older than
@@ -40,6 +40,7 @@ type ValidatorModule interface {
 
 type Mempool interface {
 	RecheckTxs(ctx context.Context, checkFn mempool.CheckFn)
+	SetEvictFn(fn mempool.EvictFn)
 }
 
 type TxApp interface {
@@ -50,6 +51,7 @@ type TxApp interface {
 	Rollback()
 	GenesisInit(ctx context.Context, db sql.DB, genesisConfig *config.GenesisConfig, chain *common.ChainContext) error
 	ApplyMempool(ctx *common.TxContext, db sql.DB, tx *ktypes.Transaction) error
+	EvictMempool(tx *ktypes.Transaction) error
 
 	Price(ctx context.Context, dbTx sql.DB, tx *ktypes.Transaction, chainContext *common.ChainContext) (*big.Int, error)
 	AccountInfo(ctx context.Context, dbTx sql.DB, identifier *ktypes.AccountID, pending bool) (balance *big.Int, nonce int64, err error)
@@ -63,7 +63,7 @@ type BlockProcessor struct {
 	snapshotter SnapshotModule
 	events      EventStore
 	migrator    MigratorModule
-	mempool     Mempool // only for rechecks
+	mempool     Mempool // only for rechecks and evictions
 	log         log.Logger
 
 	// broadcast function to send transactions to the network
@@ -98,6 +98,14 @@ func NewBlockProcessor(ctx context.Context, db DB, txapp TxApp, accounts Account
 
 	bp.genesisParams = genesisCfg
 
+	// The txapp's pending nonces and balances must not count the transactions
+	// that are evicted from the mempool.
+	mp.SetEvictFn(func(tx *types.Tx) {
+		if err := txapp.EvictMempool(tx.Transaction); err != nil {
+			logger.Warn("failed to revert evicted transaction", "tx", tx.Hash(), "error", err)
+		}
+	})
+
 	tx, err := db.BeginTx(ctx)
 	if err != nil {
 		return nil, fmt.Errorf("failed to begin outer tx: %w", err)
@@ -422,9 +430,10 @@ func (bp *BlockProcessor) ExecuteBlock(ctx context.Context, req *ktypes.BlockExe
 		default:
 			res := bp.txapp.Execute(txCtx, bp.consensusTx, tx)
 			txResult := ktypes.TxResult{
-				Code: uint32(res.ResponseCode),
-				Gas:  res.Spend,
-				Log:  res.Log,
+				Code:       uint32(res.ResponseCode),
+				Gas:        res.Spend,
+				Log:        res.Log,
+				Operations: res.Operations,
 			}
 
 			// bookkeeping for the block execution status
@@ -588,6 +597,7 @@ func (bp *BlockProcessor) ExecuteBlock(ctx context.Context, req *ktypes.BlockExe
 		AppHash:          nextHash,
 		ValidatorUpdates: valUpdatesList,
 		ParamUpdates:     maps.Clone(bp.chainCtx.NetworkUpdates),
+		ScheduleResults:  blockCtx.ScheduleResults,
 	}, nil
 
 }
@@ -4,6 +4,7 @@ import (
 	"bytes"
 	"context"
 	"encoding/hex"
+	"errors"
 	"fmt"
 	"sort"
 
@@ -439,13 +440,34 @@ func (bp *BlockProcessor) PrepareValidatorVoteIDTx(ctx context.Context, db sql.D
 	return tx, ids, nil
 }
 
-// verifyTransaction verifies a transaction's signature using the Authenticator
-// registry in this package.
+// verifyTransaction verifies a transaction's signature, and that of its fee
+// payer if it is sponsored, using the Authenticator registry in this package.
 func verifyTransaction(tx *types.Transaction) error {
 	msg, err := tx.SerializeMsg()
 	if err != nil {
 		return err
 	}
 
-	return authExt.VerifySignature(tx.Sender, msg, tx.Signature)
+	if err := authExt.VerifySignature(tx.Sender, msg, tx.Signature); err != nil {
+		return err
+	}
+
+	feePayer := tx.Body.FeePayer
+	if feePayer == nil {
+		if tx.FeePayerSignature != nil {
+			return errors.New("transaction has a fee payer signature but no fee payer")
+		}
+		return nil
+	}
+	if tx.FeePayerSignature == nil {
+		return errors.New("transaction is not signed by its fee payer")
+	}
+	if tx.FeePayerSignature.Type != feePayer.AuthType {
+		return fmt.Errorf("fee payer signature type %s does not match fee payer auth type %s",
+			tx.FeePayerSignature.Type, feePayer.AuthType)
+	}
+	if err := authExt.VerifySignature(feePayer.Identifier, msg, tx.FeePayerSignature); err != nil {
+		return fmt.Errorf("invalid fee payer signature: %w", err)
+	}
+	return nil
 }
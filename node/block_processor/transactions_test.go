@@ -4,8 +4,10 @@ import (
 	"bytes"
 	"context"
 	"crypto/sha256"
+	"encoding/hex"
 	"math/big"
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/require"
 
@@ -289,6 +291,101 @@ func genNodeKeyAndSigner(t *testing.T) (crypto.PrivateKey, auth.Signer) {
 	return nodePrivKey, nodeSigner
 }
 
+func TestVerifyTransactionFeePayer(t *testing.T) {
+	_, sender := genNodeKeyAndSigner(t)
+	payer := auth.GetNodeSigner(genEd25519Key([]byte("payer")))
+	_, other := genNodeKeyAndSigner(t)
+
+	newTx := func() *types.Transaction {
+		tx, err := types.CreateTransaction(&types.ActionExecution{Namespace: "ns", Action: "act"}, "chain", 1)
+		require.NoError(t, err)
+		tx.Body.FeePayer = &types.FeePayer{
+			Identifier: payer.CompactID(),
+			AuthType:   payer.AuthType(),
+		}
+		require.NoError(t, tx.Sign(sender))
+		return tx
+	}
+
+	tx := newTx()
+	require.Error(t, verifyTransaction(tx), "not signed by fee payer")
+
+	require.NoError(t, tx.SignFeePayer(payer))
+	require.NoError(t, verifyTransaction(tx))
+
+	// a signature by another account
+	sig, err := other.Sign([]byte("msg"))
+	require.NoError(t, err)
+	tx.FeePayerSignature = sig
+	require.Error(t, verifyTransaction(tx))
+
+	// the fee payer signature reused by another sender
+	tx = newTx()
+	require.NoError(t, tx.SignFeePayer(payer))
+	feePayerSig := tx.FeePayerSignature
+	require.NoError(t, tx.Sign(other))
+	tx.FeePayerSignature = feePayerSig
+	require.Error(t, verifyTransaction(tx))
+
+	// a fee payer signature without a fee payer
+	tx = newTx()
+	require.NoError(t, tx.SignFeePayer(payer))
+	tx.Body.FeePayer = nil
+	require.NoError(t, tx.Sign(sender))
+	require.Error(t, verifyTransaction(tx))
+}
+
+// checkTxApp records the context and sender account of the transaction that
+// passed CheckTx.
+type checkTxApp struct {
+	mockTxApp
+	txCtx *common.TxContext
+	acct  *types.AccountID
+}
+
+func (m *checkTxApp) ApplyMempool(ctx *common.TxContext, db sql.DB, tx *types.Transaction) (err error) {
+	m.txCtx = ctx
+	m.acct, err = txapp.TxSenderAcctID(tx)
+	return err
+}
+
+// TestCheckTxMultisig checks that a transaction of a multisig account is
+// verified and identified with the registered multisig authenticator.
+func TestCheckTxMultisig(t *testing.T) {
+	var members []*auth.MultisigMember
+	var signers []auth.Signer
+	for _, seed := range []string{"a", "b", "c"} {
+		signer := auth.GetNodeSigner(genEd25519Key([]byte(seed)))
+		signers = append(signers, signer)
+		members = append(members, &auth.MultisigMember{AuthType: signer.AuthType(), ID: signer.CompactID()})
+	}
+	multisig, err := auth.NewMultisig(2, members)
+	require.NoError(t, err)
+
+	app := &checkTxApp{}
+	bp := &BlockProcessor{
+		db:            &mockDB{},
+		log:           log.DiscardLogger,
+		chainCtx:      &common.ChainContext{NetworkParameters: &types.NetworkParameters{}},
+		genesisParams: &config.GenesisConfig{ChainID: "chain"},
+		txapp:         app,
+	}
+	checkTx := func(signers ...auth.Signer) error {
+		tx, err := types.CreateTransaction(&types.ActionExecution{Namespace: "ns", Action: "act"}, "chain", 1)
+		require.NoError(t, err)
+		require.NoError(t, tx.Sign(&auth.MultisigSigner{Multisig: multisig, Signers: signers}))
+		return bp.CheckTx(context.Background(), nodetypes.NewTx(tx), 1, time.Now(), false)
+	}
+
+	require.NoError(t, checkTx(signers[0], signers[2]))
+	require.Equal(t, hex.EncodeToString(multisig.ID()), app.txCtx.Caller)
+	require.Equal(t, auth.MultisigAuth, app.txCtx.Authenticator)
+	require.Equal(t, &types.AccountID{Identifier: multisig.ID(), KeyType: crypto.KeyTypeMultisig}, app.acct)
+
+	// below the threshold
+	require.Error(t, checkTx(signers[1]))
+}
+
 func TestPrepareVoteIDTx(t *testing.T) {
 	leaderPrivKey, leaderSigner := genNodeKeyAndSigner(t)
 	leaderPubKey := leaderPrivKey.Public()
@@ -689,6 +786,10 @@ func (m *mockTxApp) ApplyMempool(ctx *common.TxContext, db sql.DB, tx *types.Tra
 	return nil
 }
 
+func (m *mockTxApp) EvictMempool(tx *types.Transaction) error {
+	return nil
+}
+
 func (m *mockTxApp) Begin(ctx context.Context, height int64) error {
 	return nil
 }
@@ -115,7 +115,10 @@ func (ce *ConsensusEngine) lastBlock() (int64, types.Hash, time.Time) {
 
 // QueueTx attempts to add a transaction to the mempool.
 // It is an error if the transaction is already in the mempool.
-// It is an error if the transaction fails CheckTx.
+// It is an error if the transaction fails CheckTx, except for a nonce gap, in
+// which case it is queued in the mempool until the gap is filled.
+// It is an error if the transaction has the same sender and nonce as one in
+// the mempool, unless it has a higher fee, in which case it replaces it.
 // This method holds the mempool lock for the duration of the call.
 func (ce *ConsensusEngine) QueueTx(ctx context.Context, tx *types.Tx) error {
 	height, _, timestamp := ce.lastBlock()
@@ -129,18 +132,25 @@ func (ce *ConsensusEngine) QueueTx(ctx context.Context, tx *types.Tx) error {
 	ce.mempoolMtx.Lock()
 	defer ce.mempoolMtx.Unlock()
 
-	err := ce.mempool.Store(tx)
-	if err != nil {
-		return err
-	}
-
+	// The transaction is checked before it replaces the one in the mempool with
+	// the same sender and nonce, or evicts others, so a transaction that fails
+	// CheckTx leaves the mempool unchanged. A transaction with a nonce gap, which
+	// CheckTx only reports once it is verified, is queued with the future-nonce
+	// transactions until the gap is filled, and never evicts pending ones.
 	const recheck = false
-	err = ce.blockProcessor.CheckTx(ctx, tx, height, timestamp, recheck)
+	err := ce.mempool.CheckAndStore(ctx, tx, func(ctx context.Context, tx *types.Tx) error {
+		return ce.blockProcessor.CheckTx(ctx, tx, height, timestamp, recheck)
+	})
 	if err != nil {
-		ce.mempool.Remove(tx.Hash())
 		return err
 	}
 
+	// The transaction may fill the nonce gap of the sender's future-nonce
+	// transactions, which are already verified.
+	ce.mempool.PromoteFuture(ctx, tx.Sender, func(ctx context.Context, tx *types.Tx) error {
+		return ce.blockProcessor.CheckTx(ctx, tx, height, timestamp, true)
+	})
+
 	// if the node is a leader, see if mempool has enough txs to fill the block
 	// and send a trigger to the CE if it's in the waiting state to start the new round.
 	if ce.role.Load() == types.RoleLeader {
@@ -254,9 +264,10 @@ func (ce *ConsensusEngine) executeBlock(ctx context.Context, blkProp *blockPropo
 	mets.RecordExecuted(ctx, ce.state.tExecuted.Sub(t0), blkProp.blk.Header.Height, int64(blkProp.blk.Header.NumTxns))
 
 	ce.state.blockRes = &blockResult{
-		ack:       true,
-		appHash:   results.AppHash,
-		txResults: results.TxResults,
+		ack:          true,
+		appHash:      results.AppHash,
+		txResults:    results.TxResults,
+		schedResults: results.ScheduleResults,
 		// vote is set in processBlockProposal
 		paramUpdates: results.ParamUpdates,
 	}
@@ -291,6 +302,12 @@ func (ce *ConsensusEngine) commit(ctx context.Context, syncing bool) error {
 		return err
 	}
 
+	if len(ce.state.blockRes.schedResults) > 0 {
+		if err := ce.blockStore.StoreScheduleResults(blkProp.blkHash, ce.state.blockRes.schedResults); err != nil {
+			return err
+		}
+	}
+
 	req := &ktypes.CommitRequest{
 		Height:  height,
 		AppHash: appHash,
@@ -299,6 +299,7 @@ type blockResult struct {
 	ack          bool
 	appHash      ktypes.Hash
 	txResults    []ktypes.TxResult
+	schedResults []*ktypes.ScheduleResult
 	vote         *vote
 	paramUpdates ktypes.ParamUpdates
 	valUpdates   []*ktypes.Validator
@@ -428,6 +429,18 @@ func (ce *ConsensusEngine) Start(ctx context.Context, fns BroadcastFns, peerFns
 		return fmt.Errorf("error catching up: %w", err)
 	}
 
+	// Restore the transactions from the mempool journal, if any, once they pass
+	// CheckTx against the caught up state, like newly received transactions.
+	lh, t := ce.lastBlockInternal()
+	ce.mempoolMtx.Lock()
+	restored := ce.mempool.RestoreJournal(ctx, func(ctx context.Context, tx *types.Tx) error {
+		return ce.blockProcessor.CheckTx(ctx, tx, lh, t, false)
+	})
+	ce.mempoolMtx.Unlock()
+	if restored > 0 {
+		ce.log.Info("Restored mempool transactions from journal", "count", restored)
+	}
+
 	// apply leader updates if any before starting the consensus event loop
 	ce.applyLeaderUpdates()
 
@@ -1087,6 +1087,10 @@ func (d *dummyTxApp) ApplyMempool(ctx *common.TxContext, db sql.DB, tx *ktypes.T
 	return nil
 }
 
+func (d *dummyTxApp) EvictMempool(tx *ktypes.Transaction) error {
+	return nil
+}
+
 type validatorStore struct {
 	valSet []*ktypes.Validator
 }
@@ -26,7 +26,9 @@ type Mempool interface {
 	PeekN(maxTxns, totalSizeLimit int) []*types.Tx
 	Remove(txid types.Hash)
 	RecheckTxs(ctx context.Context, checkFn mempool.CheckFn)
-	Store(*types.Tx) error
+	CheckAndStore(ctx context.Context, tx *types.Tx, checkFn mempool.CheckFn) error
+	PromoteFuture(ctx context.Context, sender []byte, checkFn mempool.CheckFn)
+	RestoreJournal(ctx context.Context, checkFn mempool.CheckFn) int
 	TxsAvailable() bool
 	Size() (totalBytes, numTxns int)
 	CapMaxTxSize(maxBytes int64)
@@ -40,6 +42,7 @@ type BlockStore interface {
 	GetByHeight(height int64) (types.Hash, *ktypes.Block, *ktypes.CommitInfo, error)
 	StoreResults(hash types.Hash, results []ktypes.TxResult) error
 	Results(hash types.Hash) ([]ktypes.TxResult, error)
+	StoreScheduleResults(hash types.Hash, results []*ktypes.ScheduleResult) error
 }
 
 type BlockProcessor interface {
@@ -0,0 +1,433 @@
+package diff
+
+import (
+	"fmt"
+	"slices"
+	"strings"
+
+	"github.com/trufnetwork/kwil-db/node/engine"
+	"github.com/trufnetwork/kwil-db/node/engine/format"
+	"github.com/trufnetwork/kwil-db/node/engine/parse"
+)
+
+// Plan is an ordered list of statements that migrates a namespace to a
+// desired schema.
+type Plan struct {
+	Namespace string  `json:"namespace"`
+	Steps     []*Step `json:"steps"`
+	// Unsupported are changes that cannot be made with a migration, and
+	// must be made by hand.
+	Unsupported []string `json:"unsupported,omitempty"`
+}
+
+// Step is a single statement of a migration.
+type Step struct {
+	SQL string `json:"sql"`
+	// Destructive is true if the step drops data, or an action.
+	Destructive bool `json:"destructive"`
+	// Comment explains why the step is destructive, or how it can fail.
+	Comment string `json:"comment,omitempty"`
+}
+
+// Destructive returns true if any step in the plan is destructive.
+func (p *Plan) Destructive() bool {
+	return slices.ContainsFunc(p.Steps, func(s *Step) bool { return s.Destructive })
+}
+
+// SQL returns the statements of the plan, without comments.
+func (p *Plan) SQL() string {
+	stmts := make([]string, len(p.Steps))
+	for i, s := range p.Steps {
+		stmts[i] = s.SQL
+	}
+	return strings.Join(stmts, "\n")
+}
+
+// Script returns the plan as a commented Kuneiform script.
+func (p *Plan) Script() string {
+	var sb strings.Builder
+	for _, u := range p.Unsupported {
+		fmt.Fprintf(&sb, "-- UNSUPPORTED: %s\n", u)
+	}
+
+	for i, s := range p.Steps {
+		multiline := strings.Contains(s.SQL, "\n")
+		if i > 0 && (multiline || strings.Contains(p.Steps[i-1].SQL, "\n")) {
+			sb.WriteString("\n")
+		}
+
+		if s.Destructive {
+			fmt.Fprintf(&sb, "-- DESTRUCTIVE: %s\n", s.Comment)
+		} else if s.Comment != "" {
+			fmt.Fprintf(&sb, "-- %s\n", s.Comment)
+		}
+		sb.WriteString(s.SQL)
+		sb.WriteString("\n")
+	}
+
+	return sb.String()
+}
+
+// Compare plans the migration of a namespace from its live schema to the
+// desired schema. If live is nil, the namespace does not exist, and is
+// created first.
+//
+// Steps are ordered so that they can be executed as a single script:
+// actions, indexes, constraints, and tables are dropped first, then tables
+// are created and altered, and finally constraints, indexes, and actions are
+// created. Primary keys cannot be changed, and are reported as unsupported.
+func Compare(namespace string, live, desired *Schema) (*Plan, error) {
+	p := &planner{
+		plan:      &Plan{Namespace: namespace},
+		prefix:    "{" + namespace + "}",
+		recreated: make(map[string]bool),
+	}
+	if namespace == engine.DefaultNamespace {
+		p.prefix = ""
+	}
+
+	if live == nil {
+		p.add(&Step{SQL: "CREATE NAMESPACE " + namespace + ";"})
+		live = &Schema{}
+	}
+
+	// 1. drop removed actions
+	for _, act := range live.Actions {
+		if _, ok := desired.Action(act.Name); !ok {
+			p.add(&Step{
+				SQL:         p.prefix + "DROP ACTION " + act.Name + ";",
+				Destructive: true,
+				Comment:     fmt.Sprintf(`drops action "%s"`, act.Name),
+			})
+		}
+	}
+
+	// tables that exist in both schemas
+	var kept [][2]*Table
+	for _, tbl := range desired.Tables {
+		if old, ok := live.Table(tbl.Name); ok {
+			kept = append(kept, [2]*Table{old, tbl})
+		}
+	}
+
+	// Columns whose type changes are dropped and added again, which also
+	// drops their indexes and constraints, so those are recreated as well.
+	for _, pair := range kept {
+		old, tbl := pair[0], pair[1]
+		for _, col := range tbl.Columns {
+			if current, ok := old.Column(col.Name); ok && !current.Type.EqualsStrict(col.Type) && !slices.Contains(old.PrimaryKey, col.Name) {
+				p.recreated[tbl.Name+"."+col.Name] = true
+			}
+		}
+	}
+
+	// 2. drop removed or changed indexes, and constraints, from kept tables.
+	// Foreign keys are dropped first, since they can depend on unique
+	// constraints.
+	for _, pair := range kept {
+		old, tbl := pair[0], pair[1]
+		for _, idx := range old.Indexes {
+			if !p.keepIndex(tbl.Name, idx, tbl.Indexes) {
+				p.add(&Step{SQL: p.prefix + "DROP INDEX " + idx.Name + ";"})
+			}
+		}
+	}
+	for _, fks := range []bool{true, false} {
+		for _, pair := range kept {
+			old, tbl := pair[0], pair[1]
+			for _, con := range old.Constraints {
+				if (con.Type == engine.ConstraintFK) != fks || p.keepConstraint(tbl.Name, con, tbl.Constraints) {
+					continue
+				}
+				p.add(&Step{SQL: fmt.Sprintf("%sALTER TABLE %s DROP CONSTRAINT %s;", p.prefix, tbl.Name, con.Name)})
+			}
+		}
+	}
+
+	// 3. drop removed tables, referencing tables first
+	var dropped []*Table
+	for _, tbl := range live.Tables {
+		if _, ok := desired.Table(tbl.Name); !ok {
+			dropped = append(dropped, tbl)
+		}
+	}
+	dropped = sortByReferences(dropped)
+	slices.Reverse(dropped)
+	for _, tbl := range dropped {
+		p.add(&Step{
+			SQL:         p.prefix + "DROP TABLE " + tbl.Name + ";",
+			Destructive: true,
+			Comment:     fmt.Sprintf(`drops table "%s" and all of its data`, tbl.Name),
+		})
+	}
+
+	// 4. create new tables, referenced tables first
+	var created []*Table
+	for _, tbl := range desired.Tables {
+		if _, ok := live.Table(tbl.Name); !ok {
+			created = append(created, tbl)
+		}
+	}
+	for _, tbl := range sortByReferences(created) {
+		stmt, err := format.Statement(tbl.create)
+		if err != nil {
+			return nil, err
+		}
+		p.add(&Step{SQL: p.prefix + stmt})
+	}
+
+	// 5. alter the columns of kept tables
+	for _, pair := range kept {
+		p.alterColumns(pair[0], pair[1])
+	}
+
+	// 6. add constraints and indexes to kept tables, and indexes to new
+	// tables. Foreign keys are added last, after the unique constraints
+	// that they may reference.
+	for _, fks := range []bool{false, true} {
+		for _, pair := range kept {
+			old, tbl := pair[0], pair[1]
+			for _, con := range tbl.Constraints {
+				if (con.Type == engine.ConstraintFK) != fks || p.keepConstraint(tbl.Name, con, old.Constraints) {
+					continue
+				}
+				p.add(&Step{SQL: fmt.Sprintf("%sALTER TABLE %s ADD %s;", p.prefix, tbl.Name, con.definition())})
+			}
+		}
+	}
+	for _, tbl := range desired.Tables {
+		old, ok := live.Table(tbl.Name)
+		if !ok {
+			old = &Table{}
+		}
+		for _, idx := range tbl.Indexes {
+			if p.keepIndex(tbl.Name, idx, old.Indexes) {
+				continue
+			}
+			stmt, err := format.Statement(&parse.CreateIndexStatement{
+				Name:    idx.Name,
+				On:      tbl.Name,
+				Columns: idx.Columns,
+				Type:    idx.indexType(),
+			})
+			if err != nil {
+				return nil, err
+			}
+			p.add(&Step{SQL: p.prefix + stmt})
+		}
+	}
+
+	// 7. create new and changed actions
+	for _, act := range desired.Actions {
+		old, ok := live.Action(act.Name)
+		if ok && old.canonical == act.canonical {
+			continue
+		}
+
+		c := *act.stmt
+		c.OrReplace = ok
+		stmt, err := format.Statement(&c)
+		if err != nil {
+			return nil, err
+		}
+		p.add(&Step{SQL: p.prefix + stmt})
+	}
+
+	return p.plan, nil
+}
+
+type planner struct {
+	plan *Plan
+	// prefix is the namespace prefix of statements, which is empty for the
+	// default namespace.
+	prefix string
+	// recreated are the columns, as table.column, that are dropped and
+	// added again because their type changes.
+	recreated map[string]bool
+}
+
+func (p *planner) add(s *Step) {
+	p.plan.Steps = append(p.plan.Steps, s)
+}
+
+// touches returns true if any of the columns of a table are recreated.
+func (p *planner) touches(table string, columns []string) bool {
+	return slices.ContainsFunc(columns, func(col string) bool { return p.recreated[table+"."+col] })
+}
+
+// keepIndex returns true if an index is unchanged. idx is an index from one
+// schema, and others are the indexes of the same table in the other schema.
+func (p *planner) keepIndex(table string, idx *Index, others []*Index) bool {
+	return slices.ContainsFunc(others, idx.equals) && !p.touches(table, idx.Columns)
+}
+
+// keepConstraint returns true if a constraint is unchanged. con is a
+// constraint from one schema, and others are the constraints of the same
+// table in the other schema. Only live check constraints know their
+// columns, so both are checked.
+func (p *planner) keepConstraint(table string, con *Constraint, others []*Constraint) bool {
+	i := slices.IndexFunc(others, con.equals)
+	return i >= 0 && !p.touches(table, con.Columns) && !p.touches(table, others[i].Columns)
+}
+
+// alterColumns plans the column changes of a table that exists in both
+// schemas.
+func (p *planner) alterColumns(old, tbl *Table) {
+	alter := fmt.Sprintf("%sALTER TABLE %s ", p.prefix, tbl.Name)
+
+	if !slices.Equal(old.PrimaryKey, tbl.PrimaryKey) {
+		p.plan.Unsupported = append(p.plan.Unsupported, fmt.Sprintf(`the primary key of table "%s" changes from (%s) to (%s)`,
+			tbl.Name, strings.Join(old.PrimaryKey, ", "), strings.Join(tbl.PrimaryKey, ", ")))
+	}
+
+	for _, col := range old.Columns {
+		if _, ok := tbl.Column(col.Name); !ok {
+			p.add(&Step{
+				SQL:         alter + "DROP COLUMN " + col.Name + ";",
+				Destructive: true,
+				Comment:     fmt.Sprintf(`drops column "%s" of table "%s" and all of its data`, col.Name, tbl.Name),
+			})
+		}
+	}
+
+	for _, col := range tbl.Columns {
+		current, ok := old.Column(col.Name)
+		if ok && !current.Type.EqualsStrict(col.Type) {
+			if slices.Contains(old.PrimaryKey, col.Name) {
+				p.plan.Unsupported = append(p.plan.Unsupported, fmt.Sprintf(`the type of primary key column "%s" of table "%s" changes from %s to %s`,
+					col.Name, tbl.Name, current.Type, col.Type))
+				continue
+			}
+
+			// there is no ALTER COLUMN ... TYPE, so the column is recreated
+			p.add(&Step{
+				SQL:         alter + "DROP COLUMN " + col.Name + ";",
+				Destructive: true,
+				Comment: fmt.Sprintf(`changes the type of column "%s" of table "%s" from %s to %s, which drops all of its data`,
+					col.Name, tbl.Name, current.Type, col.Type),
+			})
+			ok = false
+		}
+
+		if !ok {
+			p.add(&Step{SQL: alter + "ADD COLUMN " + col.Name + " " + format.TypeString(col.Type) + ";"})
+			current = &Column{}
+		}
+
+		if normalize(current.Default) != normalize(col.Default) {
+			if col.Default == "" {
+				p.add(&Step{SQL: alter + "ALTER COLUMN " + col.Name + " DROP DEFAULT;"})
+			} else {
+				p.add(&Step{SQL: alter + "ALTER COLUMN " + col.Name + " SET DEFAULT " + col.Default + ";"})
+			}
+		}
+
+		// primary key columns are always NOT NULL
+		if current.NotNull == col.NotNull || slices.Contains(tbl.PrimaryKey, col.Name) {
+			continue
+		}
+		if !col.NotNull {
+			p.add(&Step{SQL: alter + "ALTER COLUMN " + col.Name + " DROP NOT NULL;"})
+			continue
+		}
+
+		step := &Step{SQL: alter + "ALTER COLUMN " + col.Name + " SET NOT NULL;"}
+		if ok {
+			step.Comment = fmt.Sprintf(`fails if column "%s" contains NULL values`, col.Name)
+		} else {
+			step.Comment = fmt.Sprintf(`fails if table "%s" contains rows, since the new column is NULL for all of them`, tbl.Name)
+		}
+		p.add(step)
+	}
+}
+
+func (i *Index) equals(o *Index) bool {
+	return i.Unique == o.Unique && slices.Equal(i.Columns, o.Columns)
+}
+
+func (i *Index) indexType() parse.IndexType {
+	if i.Unique {
+		return parse.IndexTypeUnique
+	}
+	return parse.IndexTypeBTree
+}
+
+func (c *Constraint) equals(o *Constraint) bool {
+	if c.Type != o.Type {
+		return false
+	}
+
+	switch c.Type {
+	case engine.ConstraintUnique:
+		return sameColumns(c.Columns, o.Columns)
+	case engine.ConstraintCheck:
+		return normalize(c.Check) == normalize(o.Check)
+	case engine.ConstraintFK:
+		// the referenced namespace is not reported by the info views
+		return slices.Equal(c.Columns, o.Columns) && c.RefTable == o.RefTable &&
+			slices.Equal(c.RefColumns, o.RefColumns) && c.OnUpdate == o.OnUpdate && c.OnDelete == o.OnDelete
+	}
+	return false
+}
+
+// definition returns the constraint as it is written in ALTER TABLE ... ADD.
+func (c *Constraint) definition() string {
+	var sb strings.Builder
+	if c.Name != "" {
+		sb.WriteString("CONSTRAINT " + c.Name + " ")
+	}
+
+	switch c.Type {
+	case engine.ConstraintUnique:
+		sb.WriteString("UNIQUE (" + strings.Join(c.Columns, ", ") + ")")
+	case engine.ConstraintCheck:
+		sb.WriteString("CHECK (" + c.Check + ")")
+	case engine.ConstraintFK:
+		sb.WriteString("FOREIGN KEY (" + strings.Join(c.Columns, ", ") + ") REFERENCES ")
+		if c.RefNamespace != "" {
+			sb.WriteString(c.RefNamespace + ".")
+		}
+		sb.WriteString(c.RefTable + "(" + strings.Join(c.RefColumns, ", ") + ")")
+		if c.OnUpdate != string(parse.DO_NO_ACTION) {
+			sb.WriteString(" ON UPDATE " + c.OnUpdate)
+		}
+		if c.OnDelete != string(parse.DO_NO_ACTION) {
+			sb.WriteString(" ON DELETE " + c.OnDelete)
+		}
+	}
+	return sb.String()
+}
+
+// sortByReferences sorts tables so that tables come after the tables that
+// they reference with foreign keys. References to tables outside of the
+// list, and cycles, are ignored. Otherwise, the order of the list is kept.
+func sortByReferences(tables []*Table) []*Table {
+	names := make(map[string]bool, len(tables))
+	for _, tbl := range tables {
+		names[tbl.Name] = true
+	}
+
+	var sorted []*Table
+	visited := make(map[string]bool, len(tables))
+	var visit func(tbl *Table)
+	visit = func(tbl *Table) {
+		if visited[tbl.Name] {
+			return
+		}
+		visited[tbl.Name] = true
+
+		for _, con := range tbl.Constraints {
+			if con.Type != engine.ConstraintFK || con.RefTable == tbl.Name || !names[con.RefTable] {
+				continue
+			}
+			i := slices.IndexFunc(tables, func(t *Table) bool { return t.Name == con.RefTable })
+			visit(tables[i])
+		}
+		sorted = append(sorted, tbl)
+	}
+
+	for _, tbl := range tables {
+		visit(tbl)
+	}
+	return sorted
+}
@@ -0,0 +1,246 @@
+package diff
+
+import (
+	"context"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"github.com/trufnetwork/kwil-db/core/types"
+	"github.com/trufnetwork/kwil-db/node/engine"
+)
+
+const desiredSchema = `CREATE NAMESPACE IF NOT EXISTS app;
+{app}CREATE TABLE users (
+	id INT PRIMARY KEY,
+	name TEXT NOT NULL DEFAULT 'anon',
+	age INT CHECK (age >= 0),
+	email TEXT NOT NULL
+);
+{app}CREATE INDEX users_name ON users (name);
+{app}CREATE TABLE posts (
+	id INT PRIMARY KEY,
+	author INT REFERENCES users(id) ON DELETE CASCADE,
+	body TEXT
+);
+-- comments and formatting do not matter
+{app}CREATE ACTION get_user($id int) public view {
+	SELECT * FROM users WHERE id = $id;
+};
+{app}CREATE ACTION add_user($id INT, $name TEXT) public {
+	INSERT INTO users (id, name, email) VALUES ($id, $name, '');
+};`
+
+func liveSchema(t *testing.T) *Schema {
+	getUser, err := ParseAction(`CREATE OR REPLACE ACTION get_user($id INT8) PUBLIC VIEW { select * from users where id = $id; }`)
+	require.NoError(t, err)
+	oldAction, err := ParseAction(`CREATE ACTION old_action() public {}`)
+	require.NoError(t, err)
+
+	return &Schema{
+		Tables: []*Table{
+			{
+				Name: "users",
+				Columns: []*Column{
+					{Name: "id", Type: types.IntType, NotNull: true},
+					{Name: "name", Type: types.TextType, NotNull: true, Default: "'anon'::text"},
+					{Name: "age", Type: types.TextType},
+					{Name: "legacy", Type: types.TextType},
+				},
+				PrimaryKey: []string{"id"},
+				Indexes: []*Index{
+					{Name: "users_legacy_idx", Columns: []string{"legacy"}},
+				},
+				Constraints: []*Constraint{
+					{Name: "users_age_check", Type: engine.ConstraintCheck, Columns: []string{"age"}, Check: "CHECK ((age >= 0))"},
+				},
+			},
+			{
+				Name: "followers",
+				Columns: []*Column{
+					{Name: "id", Type: types.IntType, NotNull: true},
+					{Name: "user_id", Type: types.IntType},
+				},
+				PrimaryKey: []string{"id"},
+				Constraints: []*Constraint{
+					{Name: "followers_user_id_fkey", Type: engine.ConstraintFK, Columns: []string{"user_id"}, RefTable: "users",
+						RefColumns: []string{"id"}, OnUpdate: "NO ACTION", OnDelete: "NO ACTION"},
+				},
+			},
+		},
+		Actions: []*Action{getUser, oldAction},
+	}
+}
+
+func Test_Compare(t *testing.T) {
+	desired, err := FromSource(desiredSchema, "app")
+	require.NoError(t, err)
+
+	plan, err := Compare("app", liveSchema(t), desired)
+	require.NoError(t, err)
+
+	var sqls []string
+	var destructive []string
+	for _, s := range plan.Steps {
+		sqls = append(sqls, s.SQL)
+		if s.Destructive {
+			destructive = append(destructive, s.SQL)
+		}
+	}
+
+	require.Equal(t, []string{
+		"{app}DROP ACTION old_action;",
+		"{app}DROP INDEX users_legacy_idx;",
+		"{app}ALTER TABLE users DROP CONSTRAINT users_age_check;",
+		"{app}DROP TABLE followers;",
+		"{app}CREATE TABLE posts (\n    id INT8 PRIMARY KEY,\n    author INT8 REFERENCES users(id) ON DELETE CASCADE,\n    body TEXT\n);",
+		"{app}ALTER TABLE users DROP COLUMN legacy;",
+		"{app}ALTER TABLE users DROP COLUMN age;",
+		"{app}ALTER TABLE users ADD COLUMN age INT8;",
+		"{app}ALTER TABLE users ADD COLUMN email TEXT;",
+		"{app}ALTER TABLE users ALTER COLUMN email SET NOT NULL;",
+		"{app}ALTER TABLE users ADD CHECK (age >= 0);",
+		"{app}CREATE INDEX users_name ON users (name);",
+		"{app}CREATE ACTION add_user($id INT8, $name TEXT) public {\n    INSERT INTO users (id, name, email) VALUES ($id, $name, '');\n};",
+	}, sqls)
+	require.Equal(t, []string{
+		"{app}DROP ACTION old_action;",
+		"{app}DROP TABLE followers;",
+		"{app}ALTER TABLE users DROP COLUMN legacy;",
+		"{app}ALTER TABLE users DROP COLUMN age;",
+	}, destructive)
+	require.Empty(t, plan.Unsupported)
+
+	// applying the desired schema to itself is a no-op
+	plan, err = Compare("app", desired, desired)
+	require.NoError(t, err)
+	require.Empty(t, plan.Steps)
+}
+
+func Test_CompareNewNamespace(t *testing.T) {
+	desired, err := FromSource(`CREATE TABLE a (id INT PRIMARY KEY, b_id INT REFERENCES b(id));
+CREATE TABLE b (id INT PRIMARY KEY);`, engine.DefaultNamespace)
+	require.NoError(t, err)
+
+	plan, err := Compare(engine.DefaultNamespace, nil, desired)
+	require.NoError(t, err)
+
+	// referenced tables are created first, and the default namespace has
+	// no prefix
+	require.Equal(t, `CREATE NAMESPACE main;
+
+CREATE TABLE b (
+    id INT8 PRIMARY KEY
+);
+
+CREATE TABLE a (
+    id INT8 PRIMARY KEY,
+    b_id INT8 REFERENCES b(id)
+);
+`, plan.Script())
+}
+
+func Test_CompareUnsupported(t *testing.T) {
+	desired, err := FromSource(`CREATE TABLE t (id TEXT PRIMARY KEY, n INT NOT NULL);`, "ns")
+	require.NoError(t, err)
+
+	live := &Schema{Tables: []*Table{{
+		Name:       "t",
+		Columns:    []*Column{{Name: "id", Type: types.IntType, NotNull: true}, {Name: "n", Type: types.IntType}},
+		PrimaryKey: []string{"id"},
+	}}}
+
+	plan, err := Compare("ns", live, desired)
+	require.NoError(t, err)
+	require.Equal(t, `-- UNSUPPORTED: the type of primary key column "id" of table "t" changes from int8 to text
+-- fails if column "n" contains NULL values
+{ns}ALTER TABLE t ALTER COLUMN n SET NOT NULL;
+`, plan.Script())
+}
+
+func Test_FromSourceErrors(t *testing.T) {
+	for name, src := range map[string]string{
+		"other namespace": `{other}CREATE TABLE t (id INT PRIMARY KEY);`,
+		"dml":             `INSERT INTO t VALUES (1);`,
+		"unknown table":   `CREATE INDEX i ON t (id);`,
+		"duplicate table": `CREATE TABLE t (id INT PRIMARY KEY); CREATE TABLE t (id INT PRIMARY KEY);`,
+		"syntax error":    `CREATE TABLE t (`,
+	} {
+		t.Run(name, func(t *testing.T) {
+			_, err := FromSource(src, "ns")
+			require.Error(t, err)
+		})
+	}
+}
+
+func Test_Normalize(t *testing.T) {
+	for kuneiform, pg := range map[string]string{
+		"'anon'":                            "'anon'::text",
+		"'a'":                               "'a'::character varying(10)",
+		"age >= 0 AND age < 150":            "CHECK (((age >= 0) AND (age < 150)))",
+		"length(name) > 0":                  "CHECK ((length(name) > 0))",
+		"ARRAY[1, 2]":                       "ARRAY[(1)::bigint, (2)::bigint]",
+		"status IN ('a', 'b') OR x IS NULL": "CHECK (((status IN ('a'::text, 'b'::text)) OR (x IS NULL)))",
+	} {
+		require.Equal(t, normalize(kuneiform), normalize(pg), kuneiform)
+	}
+
+	require.NotEqual(t, normalize("a > 0"), normalize("CHECK ((a >= 0))"))
+	require.Empty(t, normalize("NULL"))
+}
+
+func Test_LoadSchema(t *testing.T) {
+	results := map[string]*types.QueryResult{
+		"info.namespaces": {Values: [][]any{{"USER"}}},
+		"info.columns": {Values: [][]any{
+			{"t", "id", "bigint", false, nil, true},
+			{"t", "name", "text", true, "'x'::text", false},
+			{"t", "tags", "text[]", true, nil, false},
+		}},
+		"info.constraints": {Values: [][]any{
+			{"t", "t_name_key", "UNIQUE", []any{"name"}, "UNIQUE (name)"},
+		}},
+		"info.foreign_keys": {},
+		"info.indexes": {Values: [][]any{
+			{"t", "t_pkey", true, true, []any{"id"}},
+			{"t", "t_name_key", false, true, []any{"name"}},
+			{"t", "t_tags_idx", false, false, []any{"tags"}},
+		}},
+		"info.actions": {Values: [][]any{
+			{"a", "CREATE ACTION a() public view {}", false},
+			{"builtin", "", true},
+		}},
+	}
+
+	query := func(_ context.Context, query string, params map[string]any) (*types.QueryResult, error) {
+		require.Equal(t, "app", params["namespace"])
+		for view, res := range results {
+			if strings.Contains(query, view) {
+				return res, nil
+			}
+		}
+		t.Fatalf("unexpected query: %s", query)
+		return nil, nil
+	}
+
+	s, err := LoadSchema(context.Background(), query, "app")
+	require.NoError(t, err)
+
+	require.Len(t, s.Tables, 1)
+	tbl := s.Tables[0]
+	require.Equal(t, []string{"id"}, tbl.PrimaryKey)
+	require.Equal(t, []*Column{
+		{Name: "id", Type: types.IntType, NotNull: true},
+		{Name: "name", Type: types.TextType, Default: "'x'::text"},
+		{Name: "tags", Type: types.TextArrayType},
+	}, tbl.Columns)
+	require.Equal(t, []*Index{{Name: "t_tags_idx", Columns: []string{"tags"}}}, tbl.Indexes)
+	require.Len(t, tbl.Constraints, 1)
+
+	require.Len(t, s.Actions, 1)
+	require.Equal(t, "a", s.Actions[0].Name)
+
+	results["info.namespaces"] = &types.QueryResult{}
+	_, err = LoadSchema(context.Background(), query, "app")
+	require.ErrorIs(t, err, ErrNamespaceNotFound)
+}
@@ -0,0 +1,569 @@
+// Package diff compares the schema of a live namespace with a desired schema
+// that is written in Kuneiform, and plans the statements needed to migrate
+// the namespace to the desired schema.
+//
+// The live schema is read from the info views (info.columns,
+// info.constraints, etc.), which report expressions (column defaults and check constraints) as
+// Postgres renders them. Expressions are therefore compared after removing
+// casts, parentheses, whitespace, and case. Expressions that are written
+// differently than Postgres renders them may be planned as changed even if
+// they are equivalent; such steps are redundant, but not harmful.
+package diff
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"regexp"
+	"slices"
+	"strings"
+
+	"github.com/trufnetwork/kwil-db/core/types"
+	"github.com/trufnetwork/kwil-db/node/engine"
+	"github.com/trufnetwork/kwil-db/node/engine/format"
+	"github.com/trufnetwork/kwil-db/node/engine/lint"
+	"github.com/trufnetwork/kwil-db/node/engine/parse"
+)
+
+// Schema is the schema of a single namespace.
+type Schema struct {
+	Tables  []*Table
+	Actions []*Action
+}
+
+// Table returns the table with the given name.
+func (s *Schema) Table(name string) (*Table, bool) {
+	for _, t := range s.Tables {
+		if t.Name == name {
+			return t, true
+		}
+	}
+	return nil, false
+}
+
+// Action returns the action with the given name.
+func (s *Schema) Action(name string) (*Action, bool) {
+	for _, a := range s.Actions {
+		if a.Name == name {
+			return a, true
+		}
+	}
+	return nil, false
+}
+
+// Table is a table, along with its indexes and constraints.
+type Table struct {
+	Name        string
+	Columns     []*Column
+	PrimaryKey  []string
+	Indexes     []*Index
+	Constraints []*Constraint
+
+	// create is the statement that creates the table. It is only set for
+	// tables in the desired schema.
+	create *parse.CreateTableStatement
+}
+
+// Column returns the column with the given name.
+func (t *Table) Column(name string) (*Column, bool) {
+	for _, c := range t.Columns {
+		if c.Name == name {
+			return c, true
+		}
+	}
+	return nil, false
+}
+
+// Column is a table column.
+type Column struct {
+	Name    string
+	Type    *types.DataType
+	NotNull bool
+	// Default is the default expression of the column. It is empty if the
+	// column does not have a default.
+	Default string
+}
+
+// Index is an index that was created with CREATE INDEX. It does not include
+// the indexes that back primary keys and unique constraints.
+type Index struct {
+	// Name can be empty in the desired schema, in which case Postgres
+	// generates one.
+	Name    string
+	Columns []string
+	Unique  bool
+}
+
+// Constraint is a unique, check, or foreign key constraint.
+type Constraint struct {
+	// Name can be empty in the desired schema, in which case Postgres
+	// generates one.
+	Name string
+	Type engine.ConstraintType
+	// Columns are the local columns of the constraint. They are not set for
+	// check constraints in the desired schema.
+	Columns []string
+	// Check is the expression of a check constraint.
+	Check string
+	// RefTable, RefColumns, OnUpdate, and OnDelete are only set for foreign
+	// keys. OnUpdate and OnDelete are NO ACTION if not specified.
+	RefNamespace string
+	RefTable     string
+	RefColumns   []string
+	OnUpdate     string
+	OnDelete     string
+}
+
+// Action is an action. Actions are compared by their formatted source, so
+// that formatting and comments do not cause an action to be replaced.
+type Action struct {
+	Name string
+
+	stmt *parse.CreateActionStatement
+	// canonical is the formatted source of the action, without blank lines,
+	// and without OR REPLACE, IF NOT EXISTS, or a namespace prefix.
+	canonical string
+}
+
+func newAction(stmt *parse.CreateActionStatement) (*Action, error) {
+	c := *stmt
+	c.OrReplace = false
+	c.IfNotExists = false
+	c.SetNamespacePrefix("")
+
+	src, err := format.Statement(&c)
+	if err != nil {
+		return nil, fmt.Errorf(`action "%s": %w`, stmt.Name, err)
+	}
+
+	var lines []string
+	for _, line := range strings.Split(src, "\n") {
+		if strings.TrimSpace(line) != "" {
+			lines = append(lines, line)
+		}
+	}
+
+	return &Action{
+		Name:      stmt.Name,
+		stmt:      &c,
+		canonical: strings.Join(lines, "\n"),
+	}, nil
+}
+
+// ParseAction parses the raw CREATE ACTION statement of an action, as
+// stored in info.actions.
+func ParseAction(raw string) (*Action, error) {
+	stmts, err := parse.Parse(raw)
+	if err != nil {
+		return nil, err
+	}
+	if len(stmts) != 1 {
+		return nil, fmt.Errorf("expected a single CREATE ACTION statement, got %d statements", len(stmts))
+	}
+
+	stmt, ok := stmts[0].(*parse.CreateActionStatement)
+	if !ok {
+		return nil, fmt.Errorf("expected a CREATE ACTION statement, got %T", stmts[0])
+	}
+	return newAction(stmt)
+}
+
+// FromSource builds the desired schema of a namespace from Kuneiform source.
+// The source may only contain CREATE TABLE, CREATE INDEX, and CREATE ACTION
+// statements, as well as CREATE NAMESPACE and SET CURRENT NAMESPACE
+// statements for the namespace itself. Statements may not target any other
+// namespace.
+func FromSource(src, namespace string) (*Schema, error) {
+	stmts, err := parse.Parse(src)
+	if err != nil {
+		return nil, err
+	}
+
+	s := &Schema{}
+	for _, stmt := range stmts {
+		if ns, ok := stmt.(parse.Namespaceable); ok && ns.GetNamespacePrefix() != "" && ns.GetNamespacePrefix() != namespace {
+			return nil, fmt.Errorf(`statement targets namespace "%s", expected "%s"`, ns.GetNamespacePrefix(), namespace)
+		}
+
+		switch stmt := stmt.(type) {
+		case *parse.CreateNamespaceStatement:
+			if stmt.Namespace != namespace {
+				return nil, fmt.Errorf(`schema creates namespace "%s", expected "%s"`, stmt.Namespace, namespace)
+			}
+		case *parse.SetCurrentNamespaceStatement:
+			if stmt.Namespace != namespace {
+				return nil, fmt.Errorf(`schema sets the current namespace to "%s", expected "%s"`, stmt.Namespace, namespace)
+			}
+		case *parse.CreateTableStatement:
+			if _, ok := s.Table(stmt.Name); ok {
+				return nil, fmt.Errorf(`table "%s" is defined more than once`, stmt.Name)
+			}
+
+			tbl, err := tableFromAST(stmt)
+			if err != nil {
+				return nil, fmt.Errorf(`table "%s": %w`, stmt.Name, err)
+			}
+			s.Tables = append(s.Tables, tbl)
+		case *parse.CreateIndexStatement:
+			tbl, ok := s.Table(stmt.On)
+			if !ok {
+				return nil, fmt.Errorf(`index on unknown table "%s"`, stmt.On)
+			}
+			for _, col := range stmt.Columns {
+				if _, ok := tbl.Column(col); !ok {
+					return nil, fmt.Errorf(`index on unknown column "%s" of table "%s"`, col, stmt.On)
+				}
+			}
+
+			tbl.Indexes = append(tbl.Indexes, &Index{
+				Name:    stmt.Name,
+				Columns: stmt.Columns,
+				Unique:  stmt.Type == parse.IndexTypeUnique,
+			})
+		case *parse.CreateActionStatement:
+			if _, ok := s.Action(stmt.Name); ok {
+				return nil, fmt.Errorf(`action "%s" is defined more than once`, stmt.Name)
+			}
+
+			act, err := newAction(stmt)
+			if err != nil {
+				return nil, err
+			}
+			s.Actions = append(s.Actions, act)
+		default:
+			return nil, fmt.Errorf("unsupported statement in schema: only CREATE TABLE, CREATE INDEX, and CREATE ACTION are supported, got %T", stmt)
+		}
+	}
+
+	return s, nil
+}
+
+// tableFromAST converts a CREATE TABLE statement to a table. The columns and
+// primary key are taken from the linter's catalog; the defaults and
+// constraints are read from the statement, since the catalog does not keep
+// their expressions, references, or declared names.
+func tableFromAST(stmt *parse.CreateTableStatement) (*Table, error) {
+	c := *stmt
+	c.IfNotExists = false
+	c.SetNamespacePrefix("")
+
+	tbl := &Table{
+		Name:   stmt.Name,
+		create: &c,
+	}
+
+	cat := lint.TableFromAST(stmt)
+	for _, col := range cat.Columns {
+		tbl.Columns = append(tbl.Columns, &Column{
+			Name:    col.Name,
+			Type:    col.DataType,
+			NotNull: !col.Nullable,
+		})
+	}
+	for _, idx := range cat.Indexes {
+		if idx.Type == engine.PRIMARY {
+			tbl.PrimaryKey = idx.Columns
+		}
+	}
+
+	for i, col := range stmt.Columns {
+		for _, con := range col.Constraints {
+			switch con := con.(type) {
+			case *parse.DefaultConstraint:
+				def, err := format.Expression(con.Value)
+				if err != nil {
+					return nil, err
+				}
+				tbl.Columns[i].Default = def
+			case *parse.UniqueInlineConstraint:
+				tbl.Constraints = append(tbl.Constraints, &Constraint{
+					Type:    engine.ConstraintUnique,
+					Columns: []string{col.Name},
+				})
+			case *parse.CheckConstraint:
+				check, err := format.Expression(con.Expression)
+				if err != nil {
+					return nil, err
+				}
+				tbl.Constraints = append(tbl.Constraints, &Constraint{
+					Type:  engine.ConstraintCheck,
+					Check: check,
+				})
+			case *parse.ForeignKeyReferences:
+				tbl.Constraints = append(tbl.Constraints, foreignKey("", []string{col.Name}, con))
+			}
+		}
+	}
+
+	for _, con := range stmt.Constraints {
+		switch c := con.Constraint.(type) {
+		case *parse.UniqueOutOfLineConstraint:
+			tbl.Constraints = append(tbl.Constraints, &Constraint{
+				Name:    con.Name,
+				Type:    engine.ConstraintUnique,
+				Columns: c.Columns,
+			})
+		case *parse.CheckConstraint:
+			check, err := format.Expression(c.Expression)
+			if err != nil {
+				return nil, err
+			}
+			tbl.Constraints = append(tbl.Constraints, &Constraint{
+				Name:  con.Name,
+				Type:  engine.ConstraintCheck,
+				Check: check,
+			})
+		case *parse.ForeignKeyOutOfLineConstraint:
+			tbl.Constraints = append(tbl.Constraints, foreignKey(con.Name, c.Columns, c.References))
+		}
+	}
+
+	return tbl, nil
+}
+
+func foreignKey(name string, columns []string, ref *parse.ForeignKeyReferences) *Constraint {
+	fk := &Constraint{
+		Name:         name,
+		Type:         engine.ConstraintFK,
+		Columns:      columns,
+		RefNamespace: ref.RefTableNamespace,
+		RefTable:     ref.RefTable,
+		RefColumns:   ref.RefColumns,
+		OnUpdate:     string(parse.DO_NO_ACTION),
+		OnDelete:     string(parse.DO_NO_ACTION),
+	}
+	for _, action := range ref.Actions {
+		switch action.On {
+		case parse.ON_UPDATE:
+			fk.OnUpdate = string(action.Do)
+		case parse.ON_DELETE:
+			fk.OnDelete = string(action.Do)
+		}
+	}
+	return fk
+}
+
+// QueryFunc executes a read-only query with named parameters.
+type QueryFunc func(ctx context.Context, query string, params map[string]any) (*types.QueryResult, error)
+
+// ErrNamespaceNotFound is returned by LoadSchema if the namespace does not
+// exist.
+var ErrNamespaceNotFound = errors.New("namespace not found")
+
+// LoadSchema reads the live schema of a user namespace from the info views.
+func LoadSchema(ctx context.Context, query QueryFunc, namespace string) (*Schema, error) {
+	params := map[string]any{"namespace": namespace}
+
+	res, err := query(ctx, `SELECT type FROM info.namespaces WHERE name = $namespace`, params)
+	if err != nil {
+		return nil, err
+	}
+	if len(res.Values) == 0 {
+		return nil, fmt.Errorf(`%w: "%s"`, ErrNamespaceNotFound, namespace)
+	}
+	if typ, _ := res.Values[0][0].(string); typ != "USER" {
+		return nil, fmt.Errorf(`namespace "%s" is a %s namespace, and cannot be migrated`, namespace, strings.ToLower(typ))
+	}
+
+	s := &Schema{}
+	tables := make(map[string]*Table)
+	// table looks up a table by name, since all rows are returned in one
+	// result set per view.
+	table := func(name string) (*Table, error) {
+		tbl, ok := tables[name]
+		if !ok {
+			return nil, fmt.Errorf(`info views reference unknown table "%s"`, name)
+		}
+		return tbl, nil
+	}
+
+	var tblName, name, dataType, def string
+	var nullable, isPK bool
+	res, err = query(ctx, `SELECT table_name, name, data_type, is_nullable, default_value, is_primary_key
+		FROM info.columns WHERE namespace = $namespace ORDER BY table_name, ordinal_position`, params)
+	if err != nil {
+		return nil, err
+	}
+	err = res.Scan(func() error {
+		// NULL values are not scanned, so the defaults are reset after each row
+		defer func() { def = "" }()
+
+		tbl, ok := tables[tblName]
+		if !ok {
+			tbl = &Table{Name: tblName}
+			tables[tblName] = tbl
+			s.Tables = append(s.Tables, tbl)
+		}
+
+		dt, err := types.ParseDataType(dataType)
+		if err != nil {
+			return fmt.Errorf(`column "%s" of table "%s": %w`, name, tblName, err)
+		}
+		tbl.Columns = append(tbl.Columns, &Column{
+			Name:    name,
+			Type:    dt,
+			NotNull: !nullable,
+			Default: def,
+		})
+		return nil
+	}, &tblName, &name, &dataType, &nullable, &def, &isPK)
+	if err != nil {
+		return nil, err
+	}
+
+	// constraint names, which are excluded from the indexes below since
+	// unique constraints are backed by an index of the same name
+	constraintNames := make(map[string]bool)
+
+	var conType, expr string
+	var cols []string
+	res, err = query(ctx, `SELECT table_name, name, constraint_type, columns, expression
+		FROM info.constraints WHERE namespace = $namespace`, params)
+	if err != nil {
+		return nil, err
+	}
+	err = res.Scan(func() error {
+		defer func() { cols, expr = nil, "" }()
+
+		tbl, err := table(tblName)
+		if err != nil {
+			return err
+		}
+		constraintNames[tblName+"."+name] = true
+
+		con := &Constraint{Name: name}
+		switch conType {
+		case "UNIQUE":
+			con.Type = engine.ConstraintUnique
+			con.Columns = cols
+		case "CHECK":
+			con.Type = engine.ConstraintCheck
+			con.Columns = cols
+			con.Check = expr
+		default:
+			return fmt.Errorf(`unknown type "%s" of constraint "%s"`, conType, name)
+		}
+		tbl.Constraints = append(tbl.Constraints, con)
+		return nil
+	}, &tblName, &name, &conType, &cols, &expr)
+	if err != nil {
+		return nil, err
+	}
+
+	var refTable, onUpdate, onDelete string
+	var refCols []string
+	res, err = query(ctx, `SELECT table_name, name, columns, ref_table, ref_columns, on_update, on_delete
+		FROM info.foreign_keys WHERE namespace = $namespace`, params)
+	if err != nil {
+		return nil, err
+	}
+	err = res.Scan(func() error {
+		defer func() { cols, refCols = nil, nil }()
+
+		tbl, err := table(tblName)
+		if err != nil {
+			return err
+		}
+		tbl.Constraints = append(tbl.Constraints, &Constraint{
+			Name:       name,
+			Type:       engine.ConstraintFK,
+			Columns:    cols,
+			RefTable:   refTable,
+			RefColumns: refCols,
+			OnUpdate:   onUpdate,
+			OnDelete:   onDelete,
+		})
+		return nil
+	}, &tblName, &name, &cols, &refTable, &refCols, &onUpdate, &onDelete)
+	if err != nil {
+		return nil, err
+	}
+
+	var isUnique bool
+	res, err = query(ctx, `SELECT table_name, name, is_primary_key, is_unique, columns
+		FROM info.indexes WHERE namespace = $namespace`, params)
+	if err != nil {
+		return nil, err
+	}
+	err = res.Scan(func() error {
+		defer func() { cols = nil }()
+
+		tbl, err := table(tblName)
+		if err != nil {
+			return err
+		}
+		if isPK {
+			tbl.PrimaryKey = cols
+			return nil
+		}
+		if constraintNames[tblName+"."+name] {
+			return nil
+		}
+
+		tbl.Indexes = append(tbl.Indexes, &Index{
+			Name:    name,
+			Columns: cols,
+			Unique:  isUnique,
+		})
+		return nil
+	}, &tblName, &name, &isPK, &isUnique, &cols)
+	if err != nil {
+		return nil, err
+	}
+
+	var raw string
+	var builtIn bool
+	res, err = query(ctx, `SELECT name, raw_statement, built_in
+		FROM info.actions WHERE namespace = $namespace`, params)
+	if err != nil {
+		return nil, err
+	}
+	err = res.Scan(func() error {
+		if builtIn {
+			return nil
+		}
+
+		act, err := ParseAction(raw)
+		if err != nil {
+			return fmt.Errorf(`action "%s": %w`, name, err)
+		}
+		s.Actions = append(s.Actions, act)
+		return nil
+	}, &name, &raw, &builtIn)
+	if err != nil {
+		return nil, err
+	}
+
+	return s, nil
+}
+
+var (
+	// casts matches Postgres type casts, such as ::text or
+	// ::character varying(10)[].
+	casts = regexp.MustCompile(`::(character varying|double precision|[a-z_0-9]+)(\([0-9, ]+\))?(\[\])*`)
+	// punctuation matches the characters that are removed when comparing
+	// expressions.
+	punctuation = regexp.MustCompile(`[\s()]`)
+)
+
+// normalize normalizes an expression so that the Kuneiform and Postgres
+// representations of the same expression are usually equal.
+func normalize(expr string) string {
+	expr = strings.ToLower(strings.TrimSpace(expr))
+	expr = strings.TrimPrefix(expr, "check ")
+	expr = casts.ReplaceAllString(expr, "")
+	expr = punctuation.ReplaceAllString(expr, "")
+	if expr == "null" {
+		return ""
+	}
+	return expr
+}
+
+// sameColumns returns true if both lists contain the same columns,
+// regardless of order.
+func sameColumns(a, b []string) bool {
+	a, b = slices.Clone(a), slices.Clone(b)
+	slices.Sort(a)
+	slices.Sort(b)
+	return slices.Equal(a, b)
+}
@@ -27,12 +27,15 @@ var (
 	ErrArrayDimensionality     = errors.New("array dimensionality error")
 	ErrInvalidNull             = errors.New("invalid null value")
 	ErrArrayTooSmall           = errors.New("array too small")
+	ErrInvalidArgument         = errors.New("invalid function argument")
 	ErrExtensionImplementation = errors.New("extension implementation error")
 	ErrActionInvocation        = errors.New("action invocation error")
 
 	// Errors that signal the existence or non-existence of an object.
 	ErrUnknownAction     = errors.New("unknown action")
 	ErrUnknownTable      = errors.New("unknown table")
+	ErrUnknownType       = errors.New("unknown type")
+	ErrUnknownSchedule   = errors.New("unknown schedule")
 	ErrNamespaceNotFound = errors.New("namespace not found")
 	ErrNamespaceExists   = errors.New("namespace already exists")
 
@@ -50,6 +53,7 @@ var (
 	ErrInvalidTxCtx               = errors.New("invalid transaction context")
 	ErrReservedNamespacePrefix    = errors.New("namespace prefix is reserved")
 	ErrCannotAlterPrimaryKey      = errors.New("cannot drop or alter a table's primary key")
+	ErrScheduleWorkLimit          = errors.New("scheduled calls exceeded the work limit of the block")
 
 	// Errors that are the result of not having proper permissions or failing to meet a condition
 	// that was programmed by the user.
@@ -0,0 +1,521 @@
+// Package format formats Kuneiform source code. The source is parsed, and
+// then regenerated from the AST in a canonical style:
+//
+//   - SQL and DDL keywords and data types are uppercase
+//   - action control flow, modifiers, and keywords in action expressions
+//     are lowercase
+//   - blocks are indented with four spaces
+//   - SQL statements that do not fit on a single line are broken before
+//     each clause
+//   - assignments use :=
+//
+// Comments are preserved. Comments that are on their own line are kept
+// before the statement that follows them, and comments at the end of a line
+// stay at the end of that line. Comments inside of a statement, such as
+// between two expressions, are moved before the statement.
+package format
+
+import (
+	"cmp"
+	"fmt"
+	"slices"
+	"strings"
+
+	"github.com/antlr4-go/antlr/v4"
+	"github.com/trufnetwork/kwil-db/node/engine/parse"
+	"github.com/trufnetwork/kwil-db/node/engine/parse/gen"
+)
+
+const (
+	// indent is the indentation of a single block level.
+	indent = "    "
+	// maxWidth is the width after which SQL statements are broken across
+	// multiple lines.
+	maxWidth = 100
+)
+
+// Format formats Kuneiform source. It returns an error if the source does
+// not parse.
+func Format(src string) (res string, err error) {
+	// the parser trims the source, so we do the same to get matching positions
+	src = strings.TrimSpace(src)
+	comments, code := lex(src)
+
+	p := &printer{
+		gen:      newGenerator(),
+		comments: comments,
+	}
+
+	// The parser appends a semicolon if the source does not end with one,
+	// which fails if the source ends with a comment. We therefore only parse
+	// up to the last token that is not a comment.
+	var stmts []parse.TopLevelStatement
+	if code != "" {
+		parsed, err := parse.ParseWithErrListener(code)
+		if err != nil {
+			return "", err
+		}
+		if err := parsed.ParseErrs.Err(); err != nil {
+			return "", err
+		}
+		stmts = parsed.Statements
+	}
+
+	defer func() {
+		if r := recover(); r != nil {
+			err = fmt.Errorf("failed to format: %v", r)
+		}
+	}()
+
+	p.file(stmts)
+	return p.buf.String(), nil
+}
+
+// Statement formats a single statement, without a trailing newline. Unlike
+// Format, it does not need the source of the statement, so it can be used to
+// print statements that were built or modified programmatically.
+func Statement(stmt parse.TopLevelStatement) (res string, err error) {
+	defer func() {
+		if r := recover(); r != nil {
+			err = fmt.Errorf("failed to format: %v", r)
+		}
+	}()
+
+	p := &printer{gen: newGenerator()}
+	p.file([]parse.TopLevelStatement{stmt})
+	return strings.TrimSuffix(p.buf.String(), "\n"), nil
+}
+
+// Expression formats an expression as it would appear in a SQL statement.
+func Expression(e parse.Expression) (res string, err error) {
+	defer func() {
+		if r := recover(); r != nil {
+			err = fmt.Errorf("failed to format: %v", r)
+		}
+	}()
+
+	return newGenerator().expr(e), nil
+}
+
+// pos is a position in the (trimmed) source. Lines are 1-indexed, and
+// columns are 0-indexed.
+type pos struct {
+	line, col int
+}
+
+func (p pos) before(o pos) bool {
+	return p.line < o.line || (p.line == o.line && p.col < o.col)
+}
+
+// eof is a position after all source.
+var eof = pos{line: int(^uint(0) >> 1)}
+
+func startOf(n parse.GetPositioner) pos {
+	p := n.GetPosition()
+	if p.StartLine == nil || p.StartCol == nil {
+		return pos{}
+	}
+	return pos{line: *p.StartLine, col: *p.StartCol}
+}
+
+// endOf returns the position of the last token of a node.
+func endOf(n parse.GetPositioner) pos {
+	p := n.GetPosition()
+	if p.EndLine == nil || p.EndCol == nil {
+		return pos{}
+	}
+	return pos{line: *p.EndLine, col: *p.EndCol}
+}
+
+type comment struct {
+	text    string
+	start   pos
+	endLine int
+}
+
+// lex returns all comments in the source, in order, as well as the source
+// up to and including the last token that is not a comment.
+func lex(src string) (comments []*comment, code string) {
+	lexer := gen.NewKuneiformLexer(antlr.NewInputStream(src))
+	lexer.RemoveErrorListeners()
+
+	// antlr indexes the input by rune
+	runes := []rune(src)
+	for {
+		tok := lexer.NextToken()
+		switch tok.GetTokenType() {
+		case antlr.TokenEOF:
+			return comments, code
+		case gen.KuneiformLexerBLOCK_COMMENT, gen.KuneiformLexerLINE_COMMENT, gen.KuneiformLexerSQL_COMMENT:
+			comments = append(comments, &comment{
+				text:    tok.GetText(),
+				start:   pos{line: tok.GetLine(), col: tok.GetColumn()},
+				endLine: tok.GetLine() + strings.Count(tok.GetText(), "\n"),
+			})
+		case gen.KuneiformLexerWS:
+		default:
+			code = string(runes[:tok.GetStop()+1])
+		}
+	}
+}
+
+// printer lays out statements and interleaves them with comments.
+type printer struct {
+	buf      strings.Builder
+	gen      *generator
+	comments []*comment
+	// depth is the current block depth.
+	depth int
+	// last is the source line of the last written statement or comment.
+	// It is 0 at the start of a block.
+	last int
+}
+
+// write writes text at the current indentation, without a trailing newline.
+func (p *printer) write(text string) {
+	p.buf.WriteString(strings.Repeat(indent, p.depth))
+	p.buf.WriteString(text)
+}
+
+// gap writes a blank line if there was one in the source before line.
+func (p *printer) gap(line int) {
+	if p.last > 0 && line > p.last+1 {
+		p.buf.WriteString("\n")
+	}
+}
+
+// leading writes the comments before the given position on their own lines.
+func (p *printer) leading(before pos) {
+	for len(p.comments) > 0 && p.comments[0].start.before(before) {
+		c := p.comments[0]
+		p.comments = p.comments[1:]
+
+		p.gap(c.start.line)
+		p.write(c.text)
+		p.buf.WriteString("\n")
+		p.last = c.endLine
+	}
+}
+
+// trailing appends the comments that start on the given source line, and
+// before the given position, to the current line, and ends the line.
+func (p *printer) trailing(line int, before pos) {
+	for len(p.comments) > 0 && p.comments[0].start.line == line && p.comments[0].start.before(before) {
+		c := p.comments[0]
+		p.comments = p.comments[1:]
+
+		p.buf.WriteString(" ")
+		p.buf.WriteString(c.text)
+		p.last = max(p.last, c.endLine)
+	}
+	p.buf.WriteString("\n")
+}
+
+// item writes a single-line statement or definition, along with its
+// comments. next is the start of whatever follows it.
+func (p *printer) item(n parse.GetPositioner, text string, next pos) {
+	start, end := startOf(n), endOf(n)
+	p.leading(end)
+	p.gap(start.line)
+	p.write(text)
+	p.last = end.line
+	p.trailing(end.line, next)
+}
+
+// sql generates a SQL statement that is preceded by prefix and followed
+// by suffix. If it does not fit on a line, each clause is put on its own
+// line, with continuation lines indented by extra levels.
+func (p *printer) sql(prefix string, stmt *parse.SQLStatement, suffix string, extra int) string {
+	text := prefix + p.gen.nested(stmt) + suffix
+	if len(indent)*p.depth+len(text) <= maxWidth {
+		return text
+	}
+
+	sep := p.gen.sep
+	p.gen.sep = "\n" + strings.Repeat(indent, p.depth+extra)
+	defer func() { p.gen.sep = sep }()
+	return prefix + stmt.Accept(p.gen).(string) + suffix
+}
+
+func (p *printer) file(stmts []parse.TopLevelStatement) {
+	for i, stmt := range stmts {
+		next := eof
+		if i+1 < len(stmts) {
+			next = startOf(stmts[i+1])
+		}
+
+		// blocks are always separated from other statements by a blank line
+		if i > 0 && (isBlock(stmt) || isBlock(stmts[i-1])) {
+			p.buf.WriteString("\n")
+			p.last = 0
+		}
+
+		var prefix string
+		if ns, ok := stmt.(parse.Namespaceable); ok && ns.GetNamespacePrefix() != "" {
+			prefix = "{" + ns.GetNamespacePrefix() + "}"
+		}
+
+		switch s := stmt.(type) {
+		case *parse.CreateTableStatement:
+			p.createTable(prefix, s, next)
+		case *parse.CreateActionStatement:
+			p.createAction(prefix, s, next)
+		case *parse.SQLStatement:
+			p.item(s, p.sql(prefix, s, ";", 0), next)
+		default:
+			p.item(s, prefix+s.Accept(p.gen).(string)+";", next)
+		}
+	}
+
+	p.leading(eof)
+}
+
+func isBlock(stmt parse.TopLevelStatement) bool {
+	switch stmt.(type) {
+	case *parse.CreateTableStatement, *parse.CreateActionStatement:
+		return true
+	}
+	return false
+}
+
+// closeBlock writes the comments and the closing line at the end of a block.
+func (p *printer) closeBlock(closing string, end pos, next pos) {
+	p.depth++
+	p.leading(end)
+	p.depth--
+
+	p.write(closing)
+	p.last = end.line
+	p.trailing(end.line, next)
+}
+
+func (p *printer) createTable(prefix string, s *parse.CreateTableStatement, next pos) {
+	// columns and constraints are printed in the order they were defined
+	var items []parse.GetPositioner
+	for _, col := range s.Columns {
+		items = append(items, col)
+	}
+	for _, c := range s.Constraints {
+		items = append(items, c)
+	}
+	slices.SortStableFunc(items, func(a, b parse.GetPositioner) int {
+		return cmp.Or(
+			cmp.Compare(startOf(a).line, startOf(b).line),
+			cmp.Compare(startOf(a).col, startOf(b).col),
+		)
+	})
+
+	header := prefix + "CREATE TABLE "
+	if s.IfNotExists {
+		header += "IF NOT EXISTS "
+	}
+	header += s.Name + " ("
+
+	start, end := startOf(s), endOf(s)
+	p.leading(start)
+	p.gap(start.line)
+	p.write(header)
+	p.trailing(start.line, startOf(items[0]))
+
+	p.depth++
+	p.last = 0
+	for i, item := range items {
+		var text string
+		switch item := item.(type) {
+		case *parse.Column:
+			text = item.Accept(p.gen).(string)
+		case *parse.OutOfLineConstraint:
+			text = p.gen.outOfLineConstraint(item)
+		}
+
+		itemNext := end
+		if i+1 < len(items) {
+			text += ","
+			itemNext = startOf(items[i+1])
+		}
+		p.item(item, text, itemNext)
+	}
+	p.depth--
+
+	p.closeBlock(");", end, next)
+}
+
+func (p *printer) createAction(prefix string, s *parse.CreateActionStatement, next pos) {
+	str := strings.Builder{}
+	str.WriteString(prefix)
+	str.WriteString("CREATE ")
+	if s.OrReplace {
+		str.WriteString("OR REPLACE ")
+	}
+	str.WriteString("ACTION ")
+	if s.IfNotExists {
+		str.WriteString("IF NOT EXISTS ")
+	}
+	str.WriteString(s.Name)
+	str.WriteString("(")
+	for i, param := range s.Parameters {
+		if i > 0 {
+			str.WriteString(", ")
+		}
+		str.WriteString(param.Name)
+		str.WriteString(" ")
+		str.WriteString(TypeString(param.Type))
+		if i < len(s.Defaults) && s.Defaults[i] != nil {
+			str.WriteString(" DEFAULT ")
+			str.WriteString(p.gen.expr(s.Defaults[i]))
+		}
+	}
+	str.WriteString(")")
+	for _, mod := range s.Modifiers {
+		str.WriteString(" ")
+		str.WriteString(strings.ToLower(mod))
+	}
+	if s.Returns != nil {
+		str.WriteString(" returns ")
+		if s.Returns.IsTable {
+			str.WriteString("table ")
+		}
+		str.WriteString("(")
+		for i, field := range s.Returns.Fields {
+			if i > 0 {
+				str.WriteString(", ")
+			}
+			// unnamed return types have an empty name
+			if field.Name != "" {
+				str.WriteString(field.Name)
+				str.WriteString(" ")
+			}
+			str.WriteString(TypeString(field.Type))
+		}
+		str.WriteString(")")
+	}
+	str.WriteString(" {")
+
+	start, end := startOf(s), endOf(s)
+	p.leading(start)
+	p.gap(start.line)
+	p.write(str.String())
+	p.trailing(start.line, firstStart(s.Statements, end))
+
+	p.actionBody(s.Statements, end)
+	p.closeBlock("};", end, next)
+}
+
+// firstStart returns the start of the first statement, or end if there
+// are no statements.
+func firstStart(stmts []parse.ActionStmt, end pos) pos {
+	if len(stmts) == 0 {
+		return end
+	}
+	return startOf(stmts[0])
+}
+
+// actionBody writes the statements of a block. end is the end of the block.
+func (p *printer) actionBody(stmts []parse.ActionStmt, end pos) {
+	action := p.gen.action
+	p.gen.action = true
+	defer func() { p.gen.action = action }()
+
+	p.depth++
+	p.last = 0
+	for i, stmt := range stmts {
+		next := end
+		if i+1 < len(stmts) {
+			next = startOf(stmts[i+1])
+		}
+		p.actionStmt(stmt, next)
+	}
+	p.depth--
+}
+
+func (p *printer) actionStmt(stmt parse.ActionStmt, next pos) {
+	g := p.gen
+
+	switch s := stmt.(type) {
+	case *parse.ActionStmtDeclaration:
+		p.item(s, g.expr(s.Variable)+" "+TypeString(s.Type)+";", next)
+	case *parse.ActionStmtAssign:
+		str := g.expr(s.Variable)
+		if s.Type != nil {
+			str += " " + TypeString(s.Type)
+		}
+		p.item(s, str+" := "+g.expr(s.Value)+";", next)
+	case *parse.ActionStmtCall:
+		var str string
+		if len(s.Receivers) > 0 {
+			recvs := make([]string, len(s.Receivers))
+			for i, r := range s.Receivers {
+				if r == nil {
+					recvs[i] = "_"
+				} else {
+					recvs[i] = g.expr(r)
+				}
+			}
+			str = strings.Join(recvs, ", ") + " := "
+		}
+		p.item(s, str+g.expr(s.Call)+";", next)
+	case *parse.ActionStmtSQL:
+		p.item(s, p.sql("", s.SQL, ";", 0), next)
+	case *parse.ActionStmtLoopControl:
+		p.item(s, strings.ToLower(string(s.Type))+";", next)
+	case *parse.ActionStmtReturn:
+		switch {
+		case s.SQL != nil:
+			p.item(s, p.sql("return ", s.SQL, ";", 1), next)
+		case len(s.Values) > 0:
+			p.item(s, "return "+g.exprs(s.Values)+";", next)
+		default:
+			p.item(s, "return;", next)
+		}
+	case *parse.ActionStmtReturnNext:
+		p.item(s, "return next "+g.exprs(s.Values)+";", next)
+	case *parse.ActionStmtForLoop:
+		var header string
+		switch t := s.LoopTerm.(type) {
+		case *parse.LoopTermRange:
+			header = "for " + g.expr(s.Receiver) + " in " + g.expr(t.Start) + ".." + g.expr(t.End) + " {"
+		case *parse.LoopTermSQL:
+			header = p.sql("for "+g.expr(s.Receiver)+" in ", t.Statement, " {", 1)
+		case *parse.LoopTermExpression:
+			// the optional ARRAY keyword does not change the meaning of the loop
+			header = "for " + g.expr(s.Receiver) + " in " + g.expr(t.Expression) + " {"
+		default:
+			panic(fmt.Sprintf("unknown loop term %T", t))
+		}
+
+		start, end := startOf(s), endOf(s)
+		p.leading(start)
+		p.gap(start.line)
+		p.write(header)
+		p.trailing(start.line, firstStart(s.Body, end))
+
+		p.actionBody(s.Body, end)
+		p.closeBlock("}", end, next)
+	case *parse.ActionStmtIf:
+		start, end := startOf(s), endOf(s)
+		p.leading(start)
+		p.gap(start.line)
+
+		for i, it := range s.IfThens {
+			header := "if "
+			if i > 0 {
+				header = "} else if "
+			}
+			p.write(header + g.expr(it.If) + " {")
+			p.trailing(startOf(it).line, firstStart(it.Then, endOf(it)))
+			p.actionBody(it.Then, endOf(it))
+
+			p.depth++
+			p.leading(endOf(it))
+			p.depth--
+		}
+
+		if len(s.Else) > 0 {
+			p.write("} else {\n")
+			p.actionBody(s.Else, end)
+		}
+		p.closeBlock("}", end, next)
+	default:
+		panic(fmt.Sprintf("unknown action statement %T", s))
+	}
+}
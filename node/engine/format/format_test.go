@@ -0,0 +1,258 @@
+package format_test
+
+import (
+	"encoding/json"
+	"regexp"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"github.com/trufnetwork/kwil-db/node/engine/format"
+	"github.com/trufnetwork/kwil-db/node/engine/parse"
+)
+
+func Test_Format(t *testing.T) {
+	type testcase struct {
+		name string
+		in   string
+		want string
+		err  bool
+	}
+
+	tests := []testcase{
+		{
+			name: "create table",
+			in: `create table if not exists users (id int primary key, -- the id
+  name text not null default 'x', constraint u unique(name),
+  amt numeric(10,2) check(amt > -1.5),
+  account_id int references other.accounts(id) on delete set null
+  -- end of table
+);`,
+			want: `CREATE TABLE IF NOT EXISTS users (
+    id INT8 PRIMARY KEY, -- the id
+    name TEXT NOT NULL DEFAULT 'x',
+    CONSTRAINT u UNIQUE (name),
+    amt NUMERIC(10,2) CHECK (amt > -1.5),
+    account_id INT8 REFERENCES other.accounts(id) ON DELETE SET NULL
+    -- end of table
+);
+`,
+		},
+		{
+			name: "ddl",
+			in: `{ns}create unique index if not exists idx on t(name, id);
+alter table t add column if not exists c int, drop constraint if exists u;
+grant if not granted select, insert on ns to r;
+revoke if granted r from '0xabc';
+use erc20 {chain: 'sepolia', n: 5} as tok; unuse tok if exists;`,
+			want: `{ns}CREATE UNIQUE INDEX IF NOT EXISTS idx ON t (name, id);
+ALTER TABLE t ADD COLUMN IF NOT EXISTS c INT8, DROP CONSTRAINT IF EXISTS u;
+GRANT IF NOT GRANTED SELECT, INSERT ON ns TO r;
+REVOKE IF GRANTED r FROM '0xabc';
+USE erc20 {chain: 'sepolia', n: 5} AS tok;
+UNUSE tok IF EXISTS;
+`,
+		},
+		{
+			name: "schedules",
+			in: `create schedule if not exists tick every 10 blocks call ns.record(@height,'x');
+drop schedule if exists tick`,
+			want: `CREATE SCHEDULE IF NOT EXISTS tick EVERY 10 BLOCKS CALL ns.record(@height, 'x');
+DROP SCHEDULE IF EXISTS tick;
+`,
+		},
+		{
+			name: "short sql",
+			in:   `select  a.id, count(*)  from users as a where a.name not like 'a%' group by a.id`,
+			want: "SELECT a.id, count(*) FROM users AS a WHERE a.name NOT LIKE 'a%' GROUP BY a.id;\n",
+		},
+		{
+			name: "long sql",
+			in:   `select p.id, p.content, coalesce(l.likes, 0) as likes from posts p left join likes l on p.id = l.post_id where p.author = $author order by p.created_at desc limit 10`,
+			want: `SELECT p.id, p.content, coalesce(l.likes, 0) AS likes
+FROM posts AS p
+LEFT JOIN likes AS l ON p.id = l.post_id
+WHERE p.author = $author
+ORDER BY p.created_at DESC
+LIMIT 10;
+`,
+		},
+		{
+			name: "action",
+			in: `create action get_user($id int) public view returns (name text, age int) {
+// leading comment
+$x int := 1; -- trailing comment
+$y = $x + 1;
+
+
+if !is_valid($id) and not $x = 1 { error('invalid'); } elseif $id is null { return null, null; } else { $x := 2; }
+for $row in select name, age from users where id = $id { return $row.name, $row.age; }
+for $i in 1..10 { continue; }
+}`,
+			want: `CREATE ACTION get_user($id INT8) public view returns (name TEXT, age INT8) {
+    // leading comment
+    $x INT8 := 1; -- trailing comment
+    $y := $x + 1;
+
+    if !is_valid($id) and not $x = 1 {
+        error('invalid');
+    } else if $id is null {
+        return null, null;
+    } else {
+        $x := 2;
+    }
+    for $row in SELECT name, age FROM users WHERE id = $id {
+        return $row.name, $row.age;
+    }
+    for $i in 1..10 {
+        continue;
+    }
+};
+`,
+		},
+		{
+			name: "action defaults and named arguments",
+			in: `create action list_posts($owner text, $limit int default 10, $desc bool default false) public view {
+$n := count_posts(owner=>$owner); for $row in ns.page($owner, limit =>$limit) { return next $row.id; }
+}`,
+			want: `CREATE ACTION list_posts($owner TEXT, $limit INT8 DEFAULT 10, $desc BOOL DEFAULT FALSE) public view {
+    $n := count_posts(owner => $owner);
+    for $row in ns.page($owner, limit => $limit) {
+        return next $row.id;
+    }
+};
+`,
+		},
+		{
+			name: "statement spacing",
+			in: `/* header */
+create table a (id int primary key);
+create role r; create role s;
+
+create role t;
+create action b() private {}; -- after
+-- end of file`,
+			want: `/* header */
+CREATE TABLE a (
+    id INT8 PRIMARY KEY
+);
+
+CREATE ROLE r;
+CREATE ROLE s;
+
+CREATE ROLE t;
+
+CREATE ACTION b() private {
+}; -- after
+-- end of file
+`,
+		},
+		{
+			name: "only comments",
+			in:   "\n-- a\n\n/* b */\n",
+			want: "-- a\n\n/* b */\n",
+		},
+		{
+			name: "syntax error",
+			in:   "create table (",
+			err:  true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := format.Format(tt.in)
+			if tt.err {
+				require.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, tt.want, got)
+
+			// formatting must be idempotent
+			again, err := format.Format(got)
+			require.NoError(t, err)
+			require.Equal(t, got, again)
+		})
+	}
+}
+
+// positions are removed when comparing ASTs, since they change when formatting.
+// The ARRAY keyword in a for loop is also removed, since it is optional.
+var ignoredFields = regexp.MustCompile(`"(start_line|start_col|end_line|end_col)":\d+,?|"(Raw|raw)":"(\\.|[^"\\])*",?|"Array":(true|false),?`)
+
+// Test_FormatPreservesAST checks that the formatted source parses to the same
+// AST as the original source.
+func Test_FormatPreservesAST(t *testing.T) {
+	src := `CREATE TABLE t (id INT PRIMARY KEY, arr INT[], d NUMERIC(10, 2) DEFAULT 1.50, b BYTEA DEFAULT 0x0102);
+INSERT INTO t VALUES (1, ARRAY[1, 2], -1.5, NULL), (2, NULL, 2, 0xff) ON CONFLICT (id) DO UPDATE SET d = excluded.d WHERE t.id > 0;
+INSERT INTO t (id) VALUES (3) ON CONFLICT DO NOTHING;
+UPDATE t SET d = d * 2 FROM t AS t2 JOIN t AS t3 ON t2.id = t3.id WHERE t.id = t2.id;
+DELETE FROM t WHERE id IN (SELECT id FROM t WHERE d BETWEEN 1 AND 2);
+WITH RECURSIVE r (n) AS (SELECT 1 UNION ALL SELECT n + 1 FROM r WHERE n < 10) SELECT DISTINCT * FROM r;
+SELECT t.*, count(*) FILTER (WHERE id > 1) OVER (PARTITION BY d ORDER BY id DESC NULLS LAST) AS c, row_number() OVER w
+FROM t LEFT JOIN (SELECT 1 AS x) AS s ON true
+WHERE NOT EXISTS (SELECT 1) AND id NOT IN (1, 2) AND d IS NOT DISTINCT FROM 1 AND b IS NOT NULL
+GROUP BY 1 HAVING count(*) > 1 WINDOW w AS (ORDER BY id) ORDER BY 1 LIMIT 10 OFFSET 2;
+SELECT CASE WHEN 1 = 1 THEN 'a' ELSE 'b' END, CASE id WHEN 1 THEN 2 END, arr[1:2], arr[:], arr[1], (1 + 2) * 3, -(-1), - -1, 'a' || 'b', 'a' COLLATE nocase, $a::INT[], NULL::TEXT FROM t;
+CREATE OR REPLACE ACTION a($x INT, $y TEXT[] DEFAULT ['a'], $n INT DEFAULT -1) public view returns table (id INT) {
+	$z INT;
+	$arr INT[] := [1, 2];
+	$arr[1] := 2;
+	$p, _ := other.fn($x, limit => $n);
+	fn();
+	for $j in ARRAY $y { break; }
+	for $k in $arr[1:] { }
+	if !$z AND NOT $z = 1 OR -$z > 2 { return next 1; } else if $x IS NULL { return; }
+	UPDATE t SET d = 1 WHERE id = $x;
+	return SELECT id FROM t WHERE id = $x;
+};
+GRANT r TO $x;
+TRANSFER OWNERSHIP TO '0xabc';
+DROP TABLE IF EXISTS t, t2 CASCADE;
+DROP ACTION IF EXISTS a;
+DROP INDEX idx;
+CREATE NAMESPACE IF NOT EXISTS ns;
+SET CURRENT NAMESPACE TO ns;
+DROP NAMESPACE ns;
+ALTER TABLE t ALTER COLUMN d SET NOT NULL, ALTER COLUMN d DROP DEFAULT, RENAME COLUMN d TO e, DROP COLUMN IF EXISTS b;
+ALTER TABLE t RENAME TO u;
+ALTER TABLE u ADD CONSTRAINT fk_v FOREIGN KEY (id) REFERENCES v(id) ON UPDATE CASCADE ON DELETE RESTRICT;
+`
+
+	formatted, err := format.Format(src)
+	require.NoError(t, err)
+
+	astJSON := func(src string) string {
+		stmts, err := parse.Parse(src)
+		require.NoError(t, err)
+		bts, err := json.Marshal(stmts)
+		require.NoError(t, err)
+		return ignoredFields.ReplaceAllString(string(bts), "")
+	}
+
+	require.Equal(t, astJSON(src), astJSON(formatted))
+}
+
+func Test_Statement(t *testing.T) {
+	stmts, err := parse.Parse(`create table t (id int primary key, n int check (n > 0)); -- comment`)
+	require.NoError(t, err)
+
+	got, err := format.Statement(stmts[0])
+	require.NoError(t, err)
+	require.Equal(t, "CREATE TABLE t (\n    id INT8 PRIMARY KEY,\n    n INT8 CHECK (n > 0)\n);", got)
+
+	// statements built without source have no positions
+	got, err = format.Statement(&parse.CreateIndexStatement{
+		Name:    "idx",
+		On:      "t",
+		Columns: []string{"n", "id"},
+		Type:    parse.IndexTypeUnique,
+	})
+	require.NoError(t, err)
+	require.Equal(t, "CREATE UNIQUE INDEX idx ON t (n, id);", got)
+
+	check := stmts[0].(*parse.CreateTableStatement).Columns[1].Constraints[0].(*parse.CheckConstraint)
+	got, err = format.Expression(check.Expression)
+	require.NoError(t, err)
+	require.Equal(t, "n > 0", got)
+}
@@ -0,0 +1,1032 @@
+package format
+
+import (
+	"encoding/hex"
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/trufnetwork/kwil-db/core/types"
+	"github.com/trufnetwork/kwil-db/node/engine/parse"
+)
+
+// generator generates Kuneiform source for expressions, SQL statements,
+// and DDL statements that fit on a single line. Statements that contain
+// blocks (CREATE TABLE, CREATE ACTION, IF, FOR) are laid out by the printer.
+type generator struct {
+	parse.UnimplementedActionVisitor
+	parse.UnimplementedDDLVisitor
+
+	// action is true when generating expressions in an action body. Keywords
+	// in action expressions are lowercase, and NOT can be written as !.
+	action bool
+	// sep separates the clauses of the outermost SQL statement. It is a
+	// space, unless a statement is too long to fit on a single line.
+	sep string
+}
+
+func newGenerator() *generator {
+	return &generator{sep: " "}
+}
+
+var _ parse.Visitor = (*generator)(nil)
+
+func (g *generator) expr(e parse.Expression) string {
+	return e.Accept(g).(string)
+}
+
+func (g *generator) exprs(es []parse.Expression) string {
+	strs := make([]string, len(es))
+	for i, e := range es {
+		strs[i] = g.expr(e)
+	}
+	return strings.Join(strs, ", ")
+}
+
+// kw formats an expression keyword, which is lowercase in action bodies.
+func (g *generator) kw(s string) string {
+	if g.action {
+		return strings.ToLower(s)
+	}
+	return s
+}
+
+// nested generates a subquery, which is always on a single line.
+func (g *generator) nested(n parse.Node) string {
+	sep := g.sep
+	g.sep = " "
+	defer func() { g.sep = sep }()
+	return n.Accept(g).(string)
+}
+
+func (g *generator) cast(s string, t parse.Typecasted) string {
+	if tc := t.GetTypeCast(); tc != nil {
+		return s + "::" + TypeString(tc)
+	}
+	return s
+}
+
+// TypeString formats a data type in uppercase, as it is written in Kuneiform.
+func TypeString(t *types.DataType) string {
+	// numeric types without a precision are printed as numeric(0,0)
+	s := strings.Replace(t.String(), "numeric(0,0)", "numeric", 1)
+	return strings.ToUpper(s)
+}
+
+func (g *generator) VisitExpressionLiteral(p0 *parse.ExpressionLiteral) any {
+	var s string
+	switch v := p0.Value.(type) {
+	case string:
+		// the parser keeps the escapes in string literals
+		s = "'" + v + "'"
+	case int64:
+		s = strconv.FormatInt(v, 10)
+	case *types.Decimal:
+		s = v.String()
+	case bool:
+		s = g.kw(strings.ToUpper(strconv.FormatBool(v)))
+	case []byte:
+		s = "0x" + hex.EncodeToString(v)
+	case nil:
+		s = g.kw("NULL")
+	default:
+		panic(fmt.Sprintf("unsupported literal type: %T", v))
+	}
+
+	return g.cast(s, p0)
+}
+
+func (g *generator) VisitExpressionFunctionCall(p0 *parse.ExpressionFunctionCall) any {
+	return g.cast(g.functionCall(p0), p0)
+}
+
+func (g *generator) functionCall(p0 *parse.ExpressionFunctionCall) string {
+	str := strings.Builder{}
+	if p0.Namespace != "" {
+		str.WriteString(p0.Namespace)
+		str.WriteString(".")
+	}
+	str.WriteString(p0.Name)
+	str.WriteString("(")
+	if p0.Star {
+		str.WriteString("*")
+	} else {
+		if p0.Distinct {
+			str.WriteString("DISTINCT ")
+		}
+		str.WriteString(g.args(p0))
+	}
+	str.WriteString(")")
+	return str.String()
+}
+
+// args formats the arguments of a function call, including the names of
+// named action arguments.
+func (g *generator) args(p0 *parse.ExpressionFunctionCall) string {
+	if p0.ArgNames == nil {
+		return g.exprs(p0.Args)
+	}
+
+	strs := make([]string, len(p0.Args))
+	for i, e := range p0.Args {
+		strs[i] = g.expr(e)
+		if p0.ArgNames[i] != "" {
+			strs[i] = p0.ArgNames[i] + " => " + strs[i]
+		}
+	}
+	return strings.Join(strs, ", ")
+}
+
+func (g *generator) VisitExpressionWindowFunctionCall(p0 *parse.ExpressionWindowFunctionCall) any {
+	str := strings.Builder{}
+	str.WriteString(g.functionCall(p0.FunctionCall))
+	if p0.Filter != nil {
+		str.WriteString(" FILTER (WHERE ")
+		str.WriteString(g.expr(p0.Filter))
+		str.WriteString(")")
+	}
+	str.WriteString(" OVER ")
+	str.WriteString(p0.Window.Accept(g).(string))
+	return str.String()
+}
+
+func (g *generator) VisitWindowImpl(p0 *parse.WindowImpl) any {
+	var parts []string
+	if len(p0.PartitionBy) > 0 {
+		parts = append(parts, "PARTITION BY "+g.exprs(p0.PartitionBy))
+	}
+	if len(p0.OrderBy) > 0 {
+		parts = append(parts, "ORDER BY "+g.orderingTerms(p0.OrderBy))
+	}
+	return "(" + strings.Join(parts, " ") + ")"
+}
+
+func (g *generator) VisitWindowReference(p0 *parse.WindowReference) any {
+	return p0.Name
+}
+
+func (g *generator) VisitExpressionVariable(p0 *parse.ExpressionVariable) any {
+	return g.cast(p0.Name, p0)
+}
+
+func (g *generator) VisitExpressionArrayAccess(p0 *parse.ExpressionArrayAccess) any {
+	str := strings.Builder{}
+	str.WriteString(g.expr(p0.Array))
+	str.WriteString("[")
+	if p0.Index != nil {
+		str.WriteString(g.expr(p0.Index))
+	} else {
+		if p0.FromTo[0] != nil {
+			str.WriteString(g.expr(p0.FromTo[0]))
+		}
+		str.WriteString(":")
+		if p0.FromTo[1] != nil {
+			str.WriteString(g.expr(p0.FromTo[1]))
+		}
+	}
+	str.WriteString("]")
+	return g.cast(str.String(), p0)
+}
+
+func (g *generator) VisitExpressionMakeArray(p0 *parse.ExpressionMakeArray) any {
+	return g.cast("ARRAY["+g.exprs(p0.Values)+"]", p0)
+}
+
+func (g *generator) VisitExpressionFieldAccess(p0 *parse.ExpressionFieldAccess) any {
+	return g.cast(g.expr(p0.Record)+"."+p0.Field, p0)
+}
+
+func (g *generator) VisitExpressionParenthesized(p0 *parse.ExpressionParenthesized) any {
+	return g.cast("("+g.expr(p0.Inner)+")", p0)
+}
+
+func (g *generator) VisitExpressionComparison(p0 *parse.ExpressionComparison) any {
+	return g.expr(p0.Left) + " " + string(p0.Operator) + " " + g.expr(p0.Right)
+}
+
+func (g *generator) VisitExpressionLogical(p0 *parse.ExpressionLogical) any {
+	return g.expr(p0.Left) + " " + g.kw(string(p0.Operator)) + " " + g.expr(p0.Right)
+}
+
+func (g *generator) VisitExpressionArithmetic(p0 *parse.ExpressionArithmetic) any {
+	return g.expr(p0.Left) + " " + string(p0.Operator) + " " + g.expr(p0.Right)
+}
+
+func (g *generator) VisitExpressionUnary(p0 *parse.ExpressionUnary) any {
+	operand := g.expr(p0.Expression)
+
+	switch p0.Operator {
+	case parse.UnaryOperatorNot:
+		// In actions, ! binds tighter than NOT, so it can only be used
+		// if the operand is not a binary expression.
+		if g.action && tight(p0.Expression) {
+			return "!" + operand
+		}
+		return g.kw("NOT") + " " + operand
+	default:
+		// a space is needed to avoid starting a -- comment
+		if strings.HasPrefix(operand, "-") {
+			return string(p0.Operator) + " " + operand
+		}
+		return string(p0.Operator) + operand
+	}
+}
+
+// tight returns true if the expression binds at least as tightly as a
+// unary operator.
+func tight(e parse.Expression) bool {
+	switch e := e.(type) {
+	case *parse.ExpressionUnary:
+		return e.Operator != parse.UnaryOperatorNot
+	case *parse.ExpressionComparison, *parse.ExpressionLogical, *parse.ExpressionArithmetic,
+		*parse.ExpressionIs, *parse.ExpressionIn, *parse.ExpressionBetween,
+		*parse.ExpressionStringComparison, *parse.ExpressionCollate:
+		return false
+	default:
+		return true
+	}
+}
+
+func (g *generator) VisitExpressionColumn(p0 *parse.ExpressionColumn) any {
+	if p0.Table != "" {
+		return g.cast(p0.Table+"."+p0.Column, p0)
+	}
+	return g.cast(p0.Column, p0)
+}
+
+func (g *generator) VisitExpressionCollate(p0 *parse.ExpressionCollate) any {
+	return g.expr(p0.Expression) + " COLLATE " + p0.Collation
+}
+
+func (g *generator) VisitExpressionStringComparison(p0 *parse.ExpressionStringComparison) any {
+	str := strings.Builder{}
+	str.WriteString(g.expr(p0.Left))
+	if p0.Not {
+		str.WriteString(" NOT")
+	}
+	str.WriteString(" ")
+	str.WriteString(string(p0.Operator))
+	str.WriteString(" ")
+	str.WriteString(g.expr(p0.Right))
+	return str.String()
+}
+
+func (g *generator) VisitExpressionIs(p0 *parse.ExpressionIs) any {
+	str := strings.Builder{}
+	str.WriteString(g.expr(p0.Left))
+	str.WriteString(" ")
+	str.WriteString(g.kw("IS"))
+	if p0.Not {
+		str.WriteString(" ")
+		str.WriteString(g.kw("NOT"))
+	}
+	if p0.Distinct {
+		str.WriteString(" ")
+		str.WriteString(g.kw("DISTINCT FROM"))
+	}
+	str.WriteString(" ")
+	str.WriteString(g.expr(p0.Right))
+	return str.String()
+}
+
+func (g *generator) VisitExpressionIn(p0 *parse.ExpressionIn) any {
+	str := strings.Builder{}
+	str.WriteString(g.expr(p0.Expression))
+	if p0.Not {
+		str.WriteString(" NOT")
+	}
+	str.WriteString(" IN (")
+	if p0.Subquery != nil {
+		str.WriteString(g.nested(p0.Subquery))
+	} else {
+		str.WriteString(g.exprs(p0.List))
+	}
+	str.WriteString(")")
+	return str.String()
+}
+
+func (g *generator) VisitExpressionBetween(p0 *parse.ExpressionBetween) any {
+	str := strings.Builder{}
+	str.WriteString(g.expr(p0.Expression))
+	if p0.Not {
+		str.WriteString(" NOT")
+	}
+	str.WriteString(" BETWEEN ")
+	str.WriteString(g.expr(p0.Lower))
+	str.WriteString(" AND ")
+	str.WriteString(g.expr(p0.Upper))
+	return str.String()
+}
+
+func (g *generator) VisitExpressionSubquery(p0 *parse.ExpressionSubquery) any {
+	str := strings.Builder{}
+	if p0.Not {
+		str.WriteString("NOT ")
+	}
+	if p0.Exists {
+		str.WriteString("EXISTS ")
+	}
+	str.WriteString("(")
+	str.WriteString(g.nested(p0.Subquery))
+	str.WriteString(")")
+	return g.cast(str.String(), p0)
+}
+
+func (g *generator) VisitExpressionCase(p0 *parse.ExpressionCase) any {
+	str := strings.Builder{}
+	str.WriteString("CASE")
+	if p0.Case != nil {
+		str.WriteString(" ")
+		str.WriteString(g.expr(p0.Case))
+	}
+	for _, wt := range p0.WhenThen {
+		str.WriteString(" WHEN ")
+		str.WriteString(g.expr(wt[0]))
+		str.WriteString(" THEN ")
+		str.WriteString(g.expr(wt[1]))
+	}
+	if p0.Else != nil {
+		str.WriteString(" ELSE ")
+		str.WriteString(g.expr(p0.Else))
+	}
+	str.WriteString(" END")
+	return str.String()
+}
+
+func (g *generator) VisitCommonTableExpression(p0 *parse.CommonTableExpression) any {
+	str := strings.Builder{}
+	str.WriteString(p0.Name)
+	if len(p0.Columns) > 0 {
+		str.WriteString(" (")
+		str.WriteString(strings.Join(p0.Columns, ", "))
+		str.WriteString(")")
+	}
+	str.WriteString(" AS (")
+	if g.sep == " " {
+		str.WriteString(g.nested(p0.Query))
+		str.WriteString(")")
+		return str.String()
+	}
+
+	// when the statement is broken across lines, the query of the CTE
+	// is indented inside of the parentheses
+	sep := g.sep
+	g.sep = sep + indent
+	defer func() { g.sep = sep }()
+
+	str.WriteString(g.sep)
+	str.WriteString(p0.Query.Accept(g).(string))
+	str.WriteString(sep)
+	str.WriteString(")")
+	return str.String()
+}
+
+func (g *generator) VisitSQLStatement(p0 *parse.SQLStatement) any {
+	// SQL statements can be used in actions, but are never action expressions
+	action := g.action
+	g.action = false
+	defer func() { g.action = action }()
+
+	str := strings.Builder{}
+	if len(p0.CTEs) > 0 {
+		str.WriteString("WITH ")
+		if p0.Recursive {
+			str.WriteString("RECURSIVE ")
+		}
+		for i, cte := range p0.CTEs {
+			if i > 0 {
+				str.WriteString(", ")
+			}
+			str.WriteString(cte.Accept(g).(string))
+		}
+		str.WriteString(g.sep)
+	}
+	str.WriteString(p0.SQL.Accept(g).(string))
+	return str.String()
+}
+
+func (g *generator) VisitSelectStatement(p0 *parse.SelectStatement) any {
+	str := strings.Builder{}
+	for i, core := range p0.SelectCores {
+		if i > 0 {
+			str.WriteString(g.sep)
+			str.WriteString(string(p0.CompoundOperators[i-1]))
+			str.WriteString(g.sep)
+		}
+		str.WriteString(core.Accept(g).(string))
+	}
+
+	if len(p0.Ordering) > 0 {
+		str.WriteString(g.sep)
+		str.WriteString("ORDER BY ")
+		str.WriteString(g.orderingTerms(p0.Ordering))
+	}
+	if p0.Limit != nil {
+		str.WriteString(g.sep)
+		str.WriteString("LIMIT ")
+		str.WriteString(g.expr(p0.Limit))
+	}
+	if p0.Offset != nil {
+		str.WriteString(g.sep)
+		str.WriteString("OFFSET ")
+		str.WriteString(g.expr(p0.Offset))
+	}
+	return str.String()
+}
+
+func (g *generator) orderingTerms(terms []*parse.OrderingTerm) string {
+	strs := make([]string, len(terms))
+	for i, t := range terms {
+		strs[i] = t.Accept(g).(string)
+	}
+	return strings.Join(strs, ", ")
+}
+
+func (g *generator) VisitSelectCore(p0 *parse.SelectCore) any {
+	str := strings.Builder{}
+	str.WriteString("SELECT ")
+	if p0.Distinct {
+		str.WriteString("DISTINCT ")
+	}
+	for i, col := range p0.Columns {
+		if i > 0 {
+			str.WriteString(", ")
+		}
+		str.WriteString(col.Accept(g).(string))
+	}
+
+	if p0.From != nil {
+		str.WriteString(g.sep)
+		str.WriteString("FROM ")
+		str.WriteString(p0.From.Accept(g).(string))
+		for _, join := range p0.Joins {
+			str.WriteString(g.sep)
+			str.WriteString(join.Accept(g).(string))
+		}
+	}
+	if p0.Where != nil {
+		str.WriteString(g.sep)
+		str.WriteString("WHERE ")
+		str.WriteString(g.expr(p0.Where))
+	}
+	if len(p0.GroupBy) > 0 {
+		str.WriteString(g.sep)
+		str.WriteString("GROUP BY ")
+		str.WriteString(g.exprs(p0.GroupBy))
+		if p0.Having != nil {
+			str.WriteString(g.sep)
+			str.WriteString("HAVING ")
+			str.WriteString(g.expr(p0.Having))
+		}
+	}
+	if len(p0.Windows) > 0 {
+		str.WriteString(g.sep)
+		str.WriteString("WINDOW ")
+		for i, w := range p0.Windows {
+			if i > 0 {
+				str.WriteString(", ")
+			}
+			str.WriteString(w.Name)
+			str.WriteString(" AS ")
+			str.WriteString(w.Window.Accept(g).(string))
+		}
+	}
+	return str.String()
+}
+
+func (g *generator) VisitResultColumnExpression(p0 *parse.ResultColumnExpression) any {
+	if p0.Alias != "" {
+		return g.expr(p0.Expression) + " AS " + p0.Alias
+	}
+	return g.expr(p0.Expression)
+}
+
+func (g *generator) VisitResultColumnWildcard(p0 *parse.ResultColumnWildcard) any {
+	if p0.Table != "" {
+		return p0.Table + ".*"
+	}
+	return "*"
+}
+
+func (g *generator) VisitRelationTable(p0 *parse.RelationTable) any {
+	str := strings.Builder{}
+	if p0.Namespace != "" {
+		str.WriteString(p0.Namespace)
+		str.WriteString(".")
+	}
+	str.WriteString(p0.Table)
+	if p0.Alias != "" {
+		str.WriteString(" AS ")
+		str.WriteString(p0.Alias)
+	}
+	return str.String()
+}
+
+func (g *generator) VisitRelationSubquery(p0 *parse.RelationSubquery) any {
+	str := "(" + g.nested(p0.Subquery) + ")"
+	if p0.Alias != "" {
+		str += " AS " + p0.Alias
+	}
+	return str
+}
+
+func (g *generator) VisitJoin(p0 *parse.Join) any {
+	str := strings.Builder{}
+	if p0.Type != parse.JoinTypeInner {
+		str.WriteString(string(p0.Type))
+		str.WriteString(" ")
+	}
+	str.WriteString("JOIN ")
+	str.WriteString(p0.Relation.Accept(g).(string))
+	str.WriteString(" ON ")
+	str.WriteString(g.expr(p0.On))
+	return str.String()
+}
+
+func (g *generator) VisitUpdateStatement(p0 *parse.UpdateStatement) any {
+	str := strings.Builder{}
+	str.WriteString("UPDATE ")
+	str.WriteString(p0.Table)
+	if p0.Alias != "" {
+		str.WriteString(" AS ")
+		str.WriteString(p0.Alias)
+	}
+	str.WriteString(g.sep)
+	str.WriteString("SET ")
+	str.WriteString(g.setClauses(p0.SetClause))
+
+	if p0.From != nil {
+		str.WriteString(g.sep)
+		str.WriteString("FROM ")
+		str.WriteString(p0.From.Accept(g).(string))
+		for _, join := range p0.Joins {
+			str.WriteString(g.sep)
+			str.WriteString(join.Accept(g).(string))
+		}
+	}
+	if p0.Where != nil {
+		str.WriteString(g.sep)
+		str.WriteString("WHERE ")
+		str.WriteString(g.expr(p0.Where))
+	}
+	return str.String()
+}
+
+func (g *generator) setClauses(clauses []*parse.UpdateSetClause) string {
+	strs := make([]string, len(clauses))
+	for i, c := range clauses {
+		strs[i] = c.Accept(g).(string)
+	}
+	return strings.Join(strs, ", ")
+}
+
+func (g *generator) VisitUpdateSetClause(p0 *parse.UpdateSetClause) any {
+	return p0.Column + " = " + g.expr(p0.Value)
+}
+
+func (g *generator) VisitDeleteStatement(p0 *parse.DeleteStatement) any {
+	str := strings.Builder{}
+	str.WriteString("DELETE FROM ")
+	str.WriteString(p0.Table)
+	if p0.Alias != "" {
+		str.WriteString(" AS ")
+		str.WriteString(p0.Alias)
+	}
+	if p0.Where != nil {
+		str.WriteString(g.sep)
+		str.WriteString("WHERE ")
+		str.WriteString(g.expr(p0.Where))
+	}
+	return str.String()
+}
+
+func (g *generator) VisitInsertStatement(p0 *parse.InsertStatement) any {
+	str := strings.Builder{}
+	str.WriteString("INSERT INTO ")
+	str.WriteString(p0.Table)
+	if p0.Alias != "" {
+		str.WriteString(" AS ")
+		str.WriteString(p0.Alias)
+	}
+	if len(p0.Columns) > 0 {
+		str.WriteString(" (")
+		str.WriteString(strings.Join(p0.Columns, ", "))
+		str.WriteString(")")
+	}
+
+	str.WriteString(g.sep)
+	if p0.Select != nil {
+		str.WriteString(p0.Select.Accept(g).(string))
+	} else {
+		str.WriteString("VALUES ")
+		for i, row := range p0.Values {
+			if i > 0 {
+				str.WriteString(",")
+				// when broken across lines, each row is on its own line
+				if g.sep == " " {
+					str.WriteString(" ")
+				} else {
+					str.WriteString(g.sep + indent)
+				}
+			}
+			str.WriteString("(")
+			str.WriteString(g.exprs(row))
+			str.WriteString(")")
+		}
+	}
+
+	if p0.OnConflict != nil {
+		str.WriteString(g.sep)
+		str.WriteString(p0.OnConflict.Accept(g).(string))
+	}
+	return str.String()
+}
+
+func (g *generator) VisitUpsertClause(p0 *parse.OnConflict) any {
+	str := strings.Builder{}
+	str.WriteString("ON CONFLICT")
+	if len(p0.ConflictColumns) > 0 {
+		str.WriteString(" (")
+		str.WriteString(strings.Join(p0.ConflictColumns, ", "))
+		str.WriteString(")")
+		if p0.ConflictWhere != nil {
+			str.WriteString(" WHERE ")
+			str.WriteString(g.expr(p0.ConflictWhere))
+		}
+	}
+
+	if len(p0.DoUpdate) == 0 {
+		str.WriteString(" DO NOTHING")
+		return str.String()
+	}
+
+	str.WriteString(" DO UPDATE SET ")
+	str.WriteString(g.setClauses(p0.DoUpdate))
+	if p0.UpdateWhere != nil {
+		str.WriteString(" WHERE ")
+		str.WriteString(g.expr(p0.UpdateWhere))
+	}
+	return str.String()
+}
+
+func (g *generator) VisitOrderingTerm(p0 *parse.OrderingTerm) any {
+	str := strings.Builder{}
+	str.WriteString(g.expr(p0.Expression))
+	if p0.Order != "" {
+		str.WriteString(" ")
+		str.WriteString(string(p0.Order))
+	}
+	if p0.Nulls != "" {
+		str.WriteString(" NULLS ")
+		str.WriteString(string(p0.Nulls))
+	}
+	return str.String()
+}
+
+// DDL
+
+func (g *generator) VisitAlterTableStatement(p0 *parse.AlterTableStatement) any {
+	actions := make([]string, len(p0.Actions))
+	for i, a := range p0.Actions {
+		actions[i] = a.Accept(g).(string)
+	}
+	return "ALTER TABLE " + p0.Table + " " + strings.Join(actions, ", ")
+}
+
+func (g *generator) VisitDropTableStatement(p0 *parse.DropTableStatement) any {
+	str := strings.Builder{}
+	str.WriteString("DROP TABLE ")
+	if p0.IfExists {
+		str.WriteString("IF EXISTS ")
+	}
+	str.WriteString(strings.Join(p0.Tables, ", "))
+	if p0.Behavior != parse.DropBehaviorDefault {
+		str.WriteString(" ")
+		str.WriteString(string(p0.Behavior))
+	}
+	return str.String()
+}
+
+func (g *generator) VisitCreateIndexStatement(p0 *parse.CreateIndexStatement) any {
+	str := strings.Builder{}
+	str.WriteString("CREATE ")
+	if p0.Type == parse.IndexTypeUnique {
+		str.WriteString("UNIQUE ")
+	}
+	str.WriteString("INDEX ")
+	if p0.IfNotExists {
+		str.WriteString("IF NOT EXISTS ")
+	}
+	if p0.Name != "" {
+		str.WriteString(p0.Name)
+		str.WriteString(" ")
+	}
+	str.WriteString("ON ")
+	str.WriteString(p0.On)
+	str.WriteString(" (")
+	str.WriteString(strings.Join(p0.Columns, ", "))
+	str.WriteString(")")
+	return str.String()
+}
+
+func (g *generator) VisitDropIndexStatement(p0 *parse.DropIndexStatement) any {
+	if p0.CheckExist {
+		return "DROP INDEX IF EXISTS " + p0.Name
+	}
+	return "DROP INDEX " + p0.Name
+}
+
+func (g *generator) VisitGrantOrRevokeStatement(p0 *parse.GrantOrRevokeStatement) any {
+	str := strings.Builder{}
+	if p0.IsGrant {
+		str.WriteString("GRANT ")
+		if p0.If {
+			str.WriteString("IF NOT GRANTED ")
+		}
+	} else {
+		str.WriteString("REVOKE ")
+		if p0.If {
+			str.WriteString("IF GRANTED ")
+		}
+	}
+
+	if len(p0.Privileges) > 0 {
+		privs := make([]string, len(p0.Privileges))
+		for i, p := range p0.Privileges {
+			privs[i] = strings.ToUpper(p)
+		}
+		str.WriteString(strings.Join(privs, ", "))
+	} else {
+		str.WriteString(p0.GrantRole)
+	}
+
+	if p0.Namespace != nil {
+		str.WriteString(" ON ")
+		str.WriteString(*p0.Namespace)
+	}
+
+	if p0.IsGrant {
+		str.WriteString(" TO ")
+	} else {
+		str.WriteString(" FROM ")
+	}
+	switch {
+	case p0.ToRole != "":
+		str.WriteString(p0.ToRole)
+	case p0.ToVariable != nil:
+		str.WriteString(g.expr(p0.ToVariable))
+	default:
+		str.WriteString("'" + p0.ToUser + "'")
+	}
+	return str.String()
+}
+
+func (g *generator) VisitTransferOwnershipStatement(p0 *parse.TransferOwnershipStatement) any {
+	if p0.ToVariable != nil {
+		return "TRANSFER OWNERSHIP TO " + g.expr(p0.ToVariable)
+	}
+	return "TRANSFER OWNERSHIP TO '" + p0.ToUser + "'"
+}
+
+func (g *generator) VisitAlterColumnSet(p0 *parse.AlterColumnSet) any {
+	if p0.Type == parse.ConstraintTypeDefault {
+		return "ALTER COLUMN " + p0.Column + " SET DEFAULT " + g.expr(p0.Value)
+	}
+	return "ALTER COLUMN " + p0.Column + " SET " + p0.Type.String()
+}
+
+func (g *generator) VisitAlterColumnDrop(p0 *parse.AlterColumnDrop) any {
+	return "ALTER COLUMN " + p0.Column + " DROP " + p0.Type.String()
+}
+
+func (g *generator) VisitAddColumn(p0 *parse.AddColumn) any {
+	if p0.IfNotExists {
+		return "ADD COLUMN IF NOT EXISTS " + p0.Name + " " + TypeString(p0.Type)
+	}
+	return "ADD COLUMN " + p0.Name + " " + TypeString(p0.Type)
+}
+
+func (g *generator) VisitDropColumn(p0 *parse.DropColumn) any {
+	if p0.IfExists {
+		return "DROP COLUMN IF EXISTS " + p0.Name
+	}
+	return "DROP COLUMN " + p0.Name
+}
+
+func (g *generator) VisitRenameColumn(p0 *parse.RenameColumn) any {
+	return "RENAME COLUMN " + p0.OldName + " TO " + p0.NewName
+}
+
+func (g *generator) VisitRenameTable(p0 *parse.RenameTable) any {
+	return "RENAME TO " + p0.Name
+}
+
+func (g *generator) VisitAddTableConstraint(p0 *parse.AddTableConstraint) any {
+	return "ADD " + g.outOfLineConstraint(p0.Constraint)
+}
+
+func (g *generator) VisitDropTableConstraint(p0 *parse.DropTableConstraint) any {
+	if p0.IfExists {
+		return "DROP CONSTRAINT IF EXISTS " + p0.Name
+	}
+	return "DROP CONSTRAINT " + p0.Name
+}
+
+func (g *generator) VisitColumn(p0 *parse.Column) any {
+	str := strings.Builder{}
+	str.WriteString(p0.Name)
+	str.WriteString(" ")
+	str.WriteString(TypeString(p0.Type))
+	for _, c := range p0.Constraints {
+		str.WriteString(" ")
+		str.WriteString(c.Accept(g).(string))
+	}
+	return str.String()
+}
+
+func (g *generator) outOfLineConstraint(c *parse.OutOfLineConstraint) string {
+	if c.Name != "" {
+		return "CONSTRAINT " + c.Name + " " + c.Constraint.Accept(g).(string)
+	}
+	return c.Constraint.Accept(g).(string)
+}
+
+func (g *generator) VisitCreateRoleStatement(p0 *parse.CreateRoleStatement) any {
+	if p0.IfNotExists {
+		return "CREATE ROLE IF NOT EXISTS " + p0.Role
+	}
+	return "CREATE ROLE " + p0.Role
+}
+
+func (g *generator) VisitDropRoleStatement(p0 *parse.DropRoleStatement) any {
+	if p0.IfExists {
+		return "DROP ROLE IF EXISTS " + p0.Role
+	}
+	return "DROP ROLE " + p0.Role
+}
+
+func (g *generator) VisitUseExtensionStatement(p0 *parse.UseExtensionStatement) any {
+	str := strings.Builder{}
+	str.WriteString("USE ")
+	if p0.IfNotExists {
+		str.WriteString("IF NOT EXISTS ")
+	}
+	str.WriteString(p0.ExtName)
+	if len(p0.Config) > 0 {
+		str.WriteString(" {")
+		for i, kv := range p0.Config {
+			if i > 0 {
+				str.WriteString(", ")
+			}
+			str.WriteString(kv.Key)
+			str.WriteString(": ")
+			str.WriteString(g.expr(kv.Value))
+		}
+		str.WriteString("}")
+	}
+	str.WriteString(" AS ")
+	str.WriteString(p0.Alias)
+	return str.String()
+}
+
+func (g *generator) VisitUnuseExtensionStatement(p0 *parse.UnuseExtensionStatement) any {
+	if p0.IfExists {
+		return "UNUSE " + p0.Alias + " IF EXISTS"
+	}
+	return "UNUSE " + p0.Alias
+}
+
+func (g *generator) VisitCreateNamespaceStatement(p0 *parse.CreateNamespaceStatement) any {
+	if p0.IfNotExists {
+		return "CREATE NAMESPACE IF NOT EXISTS " + p0.Namespace
+	}
+	return "CREATE NAMESPACE " + p0.Namespace
+}
+
+func (g *generator) VisitDropNamespaceStatement(p0 *parse.DropNamespaceStatement) any {
+	if p0.IfExists {
+		return "DROP NAMESPACE IF EXISTS " + p0.Namespace
+	}
+	return "DROP NAMESPACE " + p0.Namespace
+}
+
+func (g *generator) VisitSetCurrentNamespaceStatement(p0 *parse.SetCurrentNamespaceStatement) any {
+	return "SET CURRENT NAMESPACE TO " + p0.Namespace
+}
+
+func (g *generator) VisitDropActionStatement(p0 *parse.DropActionStatement) any {
+	if p0.IfExists {
+		return "DROP ACTION IF EXISTS " + p0.Name
+	}
+	return "DROP ACTION " + p0.Name
+}
+
+func (g *generator) VisitCreateTypeStatement(p0 *parse.CreateTypeStatement) any {
+	str := strings.Builder{}
+	str.WriteString("CREATE TYPE ")
+	if p0.IfNotExists {
+		str.WriteString("IF NOT EXISTS ")
+	}
+	str.WriteString(p0.Name)
+
+	if p0.IsEnum() {
+		labels := make([]string, len(p0.Labels))
+		for i, l := range p0.Labels {
+			// the parser keeps the escapes in string literals
+			labels[i] = "'" + l + "'"
+		}
+		str.WriteString(" AS ENUM (")
+		str.WriteString(strings.Join(labels, ", "))
+		str.WriteString(")")
+		return str.String()
+	}
+
+	fields := make([]string, len(p0.Fields))
+	for i, f := range p0.Fields {
+		fields[i] = f.Name + " " + TypeString(f.Type)
+	}
+	str.WriteString(" AS (")
+	str.WriteString(strings.Join(fields, ", "))
+	str.WriteString(")")
+	return str.String()
+}
+
+func (g *generator) VisitDropTypeStatement(p0 *parse.DropTypeStatement) any {
+	if p0.IfExists {
+		return "DROP TYPE IF EXISTS " + p0.Name
+	}
+	return "DROP TYPE " + p0.Name
+}
+
+func (g *generator) VisitCreateScheduleStatement(p0 *parse.CreateScheduleStatement) any {
+	str := strings.Builder{}
+	str.WriteString("CREATE SCHEDULE ")
+	if p0.IfNotExists {
+		str.WriteString("IF NOT EXISTS ")
+	}
+	str.WriteString(p0.Name)
+	str.WriteString(" EVERY ")
+	str.WriteString(strconv.FormatInt(p0.Interval, 10))
+	str.WriteString(" BLOCKS CALL ")
+	str.WriteString(g.expr(p0.Call))
+	return str.String()
+}
+
+func (g *generator) VisitDropScheduleStatement(p0 *parse.DropScheduleStatement) any {
+	if p0.IfExists {
+		return "DROP SCHEDULE IF EXISTS " + p0.Name
+	}
+	return "DROP SCHEDULE " + p0.Name
+}
+
+func (g *generator) VisitPrimaryKeyInlineConstraint(p0 *parse.PrimaryKeyInlineConstraint) any {
+	return "PRIMARY KEY"
+}
+
+func (g *generator) VisitPrimaryKeyOutOfLineConstraint(p0 *parse.PrimaryKeyOutOfLineConstraint) any {
+	return "PRIMARY KEY (" + strings.Join(p0.Columns, ", ") + ")"
+}
+
+func (g *generator) VisitUniqueInlineConstraint(p0 *parse.UniqueInlineConstraint) any {
+	return "UNIQUE"
+}
+
+func (g *generator) VisitUniqueOutOfLineConstraint(p0 *parse.UniqueOutOfLineConstraint) any {
+	return "UNIQUE (" + strings.Join(p0.Columns, ", ") + ")"
+}
+
+func (g *generator) VisitDefaultConstraint(p0 *parse.DefaultConstraint) any {
+	return "DEFAULT " + g.expr(p0.Value)
+}
+
+func (g *generator) VisitNotNullConstraint(p0 *parse.NotNullConstraint) any {
+	return "NOT NULL"
+}
+
+func (g *generator) VisitCheckConstraint(p0 *parse.CheckConstraint) any {
+	return "CHECK (" + g.expr(p0.Expression) + ")"
+}
+
+func (g *generator) VisitForeignKeyReferences(p0 *parse.ForeignKeyReferences) any {
+	str := strings.Builder{}
+	str.WriteString("REFERENCES ")
+	if p0.RefTableNamespace != "" {
+		str.WriteString(p0.RefTableNamespace)
+		str.WriteString(".")
+	}
+	str.WriteString(p0.RefTable)
+	str.WriteString("(")
+	str.WriteString(strings.Join(p0.RefColumns, ", "))
+	str.WriteString(")")
+	for _, a := range p0.Actions {
+		str.WriteString(" ON ")
+		str.WriteString(string(a.On))
+		str.WriteString(" ")
+		str.WriteString(string(a.Do))
+	}
+	return str.String()
+}
+
+func (g *generator) VisitForeignKeyOutOfLineConstraint(p0 *parse.ForeignKeyOutOfLineConstraint) any {
+	return "FOREIGN KEY (" + strings.Join(p0.Columns, ", ") + ") " + p0.References.Accept(g).(string)
+}
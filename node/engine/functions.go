@@ -139,6 +139,58 @@ var (
 				return "uuid_generate_v5('a247cac1-d817-4949-bac7-dc4b1dc41d09'::uuid," + inputs[0] + ")", nil
 			},
 		},
+		// block_random_bytes returns n pseudo-random bytes. The bytes are derived from
+		// the hash of the block, the ID of the transaction, and a counter that is
+		// incremented each time a random function is called in the transaction, so
+		// every node computes the same bytes. A scheduled call has no transaction,
+		// so its schedule's namespace and name are used instead of the ID, and each
+		// schedule of a block draws different bytes. They are not secret or unpredictable:
+		// anyone can compute them once the block is known, and the block proposer
+		// can influence them by choosing the transactions, their order, and the
+		// timestamp of the block. They should not be used where the proposer or a
+		// user who can see the block hash profits from predicting the outcome.
+		// It can only be used in actions, since Postgres does not know the block.
+		"block_random_bytes": &ScalarFunctionDefinition{
+			ValidateArgsFunc: func(args []*types.DataType) (*types.DataType, error) {
+				if len(args) != 1 {
+					return nil, wrapErrArgumentNumber(1, len(args))
+				}
+
+				if !args[0].Equals(types.IntType) {
+					return nil, wrapErrArgumentType(types.IntType, args[0])
+				}
+
+				return types.ByteaType, nil
+			},
+			PGFormatFunc: func(inputs []string) (string, error) {
+				return "", fmt.Errorf(`%w: "block_random_bytes" cannot be used in SQL statements`, ErrIllegalFunctionUsage)
+			},
+		},
+		// random_int returns a pseudo-random integer between min and max, inclusive.
+		// It draws from the same source as block_random_bytes, and has the same
+		// caveats: the result is predictable once the block is known, and can be
+		// influenced by the block proposer. Values that would bias the result
+		// toward the low end of the range are rejected and redrawn, so every
+		// value in the range is equally likely.
+		// It can only be used in actions, since Postgres does not know the block.
+		"random_int": &ScalarFunctionDefinition{
+			ValidateArgsFunc: func(args []*types.DataType) (*types.DataType, error) {
+				if len(args) != 2 {
+					return nil, wrapErrArgumentNumber(2, len(args))
+				}
+
+				for _, arg := range args {
+					if !arg.Equals(types.IntType) {
+						return nil, wrapErrArgumentType(types.IntType, arg)
+					}
+				}
+
+				return types.IntType, nil
+			},
+			PGFormatFunc: func(inputs []string) (string, error) {
+				return "", fmt.Errorf(`%w: "random_int" cannot be used in SQL statements`, ErrIllegalFunctionUsage)
+			},
+		},
 		"encode": &ScalarFunctionDefinition{
 			ValidateArgsFunc: func(args []*types.DataType) (*types.DataType, error) {
 				// first must be blob, second must be text
@@ -196,6 +248,157 @@ var (
 			},
 			PGFormatFunc: defaultFormat("digest"),
 		},
+		// keccak256 returns the legacy Keccak-256 hash used by Ethereum, which differs
+		// from the standardized SHA3-256.
+		// The crypto functions are implemented by the interpreter, and can only be used in actions.
+		"keccak256": &ScalarFunctionDefinition{
+			ValidateArgsFunc: func(args []*types.DataType) (*types.DataType, error) {
+				if len(args) != 1 {
+					return nil, wrapErrArgumentNumber(1, len(args))
+				}
+
+				for _, arg := range args {
+					if !arg.Equals(types.ByteaType) {
+						return nil, wrapErrArgumentType(types.ByteaType, arg)
+					}
+				}
+
+				return types.ByteaType, nil
+			},
+			PGFormatFunc: func(inputs []string) (string, error) {
+				return "", fmt.Errorf(`%w: "keccak256" cannot be used in SQL statements`, ErrIllegalFunctionUsage)
+			},
+		},
+		// ecrecover recovers the Ethereum address that signed a 32 byte message hash.
+		// The signature is 65 bytes, [R || S || V], where V can be 0, 1, 27, or 28.
+		// The address is formatted the same way as the caller of a transaction signed
+		// with an Ethereum wallet, so it can be compared with @caller. It returns null
+		// if no address can be recovered.
+		"ecrecover": &ScalarFunctionDefinition{
+			ValidateArgsFunc: func(args []*types.DataType) (*types.DataType, error) {
+				if len(args) != 2 {
+					return nil, wrapErrArgumentNumber(2, len(args))
+				}
+
+				for _, arg := range args {
+					if !arg.Equals(types.ByteaType) {
+						return nil, wrapErrArgumentType(types.ByteaType, arg)
+					}
+				}
+
+				return types.TextType, nil
+			},
+			PGFormatFunc: func(inputs []string) (string, error) {
+				return "", fmt.Errorf(`%w: "ecrecover" cannot be used in SQL statements`, ErrIllegalFunctionUsage)
+			},
+		},
+		// ed25519_verify verifies an ed25519 signature of a message by a public key.
+		// It returns false if the public key or signature is malformed.
+		"ed25519_verify": &ScalarFunctionDefinition{
+			ValidateArgsFunc: func(args []*types.DataType) (*types.DataType, error) {
+				if len(args) != 3 {
+					return nil, wrapErrArgumentNumber(3, len(args))
+				}
+
+				for _, arg := range args {
+					if !arg.Equals(types.ByteaType) {
+						return nil, wrapErrArgumentType(types.ByteaType, arg)
+					}
+				}
+
+				return types.BoolType, nil
+			},
+			PGFormatFunc: func(inputs []string) (string, error) {
+				return "", fmt.Errorf(`%w: "ed25519_verify" cannot be used in SQL statements`, ErrIllegalFunctionUsage)
+			},
+		},
+		// secp256k1_verify verifies a 65 byte secp256k1 signature of the sha256 hash of
+		// a message by a compressed or uncompressed public key. It returns false if the
+		// public key or signature is malformed.
+		"secp256k1_verify": &ScalarFunctionDefinition{
+			ValidateArgsFunc: func(args []*types.DataType) (*types.DataType, error) {
+				if len(args) != 3 {
+					return nil, wrapErrArgumentNumber(3, len(args))
+				}
+
+				for _, arg := range args {
+					if !arg.Equals(types.ByteaType) {
+						return nil, wrapErrArgumentType(types.ByteaType, arg)
+					}
+				}
+
+				return types.BoolType, nil
+			},
+			PGFormatFunc: func(inputs []string) (string, error) {
+				return "", fmt.Errorf(`%w: "secp256k1_verify" cannot be used in SQL statements`, ErrIllegalFunctionUsage)
+			},
+		},
+		// eth_address returns the checksummed Ethereum address of a compressed or
+		// uncompressed secp256k1 public key.
+		"eth_address": &ScalarFunctionDefinition{
+			ValidateArgsFunc: func(args []*types.DataType) (*types.DataType, error) {
+				if len(args) != 1 {
+					return nil, wrapErrArgumentNumber(1, len(args))
+				}
+
+				for _, arg := range args {
+					if !arg.Equals(types.ByteaType) {
+						return nil, wrapErrArgumentType(types.ByteaType, arg)
+					}
+				}
+
+				return types.TextType, nil
+			},
+			PGFormatFunc: func(inputs []string) (string, error) {
+				return "", fmt.Errorf(`%w: "eth_address" cannot be used in SQL statements`, ErrIllegalFunctionUsage)
+			},
+		},
+		// merkle_root returns the root of the OpenZeppelin standard Merkle tree of 32 byte
+		// leaf hashes, as built by the reward distributor of the ERC20 bridge. The leaves
+		// are sorted before the tree is built, so their order does not matter. Postgres
+		// cannot compute Keccak-256, so there is no aggregate form; instead, the leaves
+		// of a query can be collected with array_agg and passed to merkle_root.
+		"merkle_root": &ScalarFunctionDefinition{
+			ValidateArgsFunc: func(args []*types.DataType) (*types.DataType, error) {
+				if len(args) != 1 {
+					return nil, wrapErrArgumentNumber(1, len(args))
+				}
+
+				if !args[0].Equals(types.ByteaArrayType) {
+					return nil, wrapErrArgumentType(types.ByteaArrayType, args[0])
+				}
+
+				return types.ByteaType, nil
+			},
+			PGFormatFunc: func(inputs []string) (string, error) {
+				return "", fmt.Errorf(`%w: "merkle_root" cannot be used in SQL statements`, ErrIllegalFunctionUsage)
+			},
+		},
+		// merkle_verify verifies an OpenZeppelin MerkleProof of a 32 byte leaf hash against
+		// a root. Pairs of nodes are sorted before they are hashed, so the proof does not
+		// need the index of the leaf.
+		"merkle_verify": &ScalarFunctionDefinition{
+			ValidateArgsFunc: func(args []*types.DataType) (*types.DataType, error) {
+				if len(args) != 3 {
+					return nil, wrapErrArgumentNumber(3, len(args))
+				}
+
+				for _, arg := range args[:2] {
+					if !arg.Equals(types.ByteaType) {
+						return nil, wrapErrArgumentType(types.ByteaType, arg)
+					}
+				}
+
+				if !args[2].Equals(types.ByteaArrayType) {
+					return nil, wrapErrArgumentType(types.ByteaArrayType, args[2])
+				}
+
+				return types.BoolType, nil
+			},
+			PGFormatFunc: func(inputs []string) (string, error) {
+				return "", fmt.Errorf(`%w: "merkle_verify" cannot be used in SQL statements`, ErrIllegalFunctionUsage)
+			},
+		},
 		// array functions
 		"array_append": &ScalarFunctionDefinition{
 			ValidateArgsFunc: func(args []*types.DataType) (*types.DataType, error) {
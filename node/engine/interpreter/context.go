@@ -2,7 +2,9 @@ package interpreter
 
 import (
 	"fmt"
+	"slices"
 	"strings"
+	"sync/atomic"
 
 	"github.com/decred/dcrd/container/lru"
 	"github.com/trufnetwork/kwil-db/common"
@@ -12,6 +14,7 @@ import (
 	"github.com/trufnetwork/kwil-db/node/engine/parse"
 	pggenerate "github.com/trufnetwork/kwil-db/node/engine/pg_generate"
 	"github.com/trufnetwork/kwil-db/node/engine/planner/logical"
+	"github.com/trufnetwork/kwil-db/node/pg"
 	"github.com/trufnetwork/kwil-db/node/types/sql"
 )
 
@@ -38,6 +41,17 @@ type executionContext struct {
 	queryActive bool
 	// inAction is true if the execution is currently in an action.
 	inAction bool
+	// action is the name of the action currently being executed.
+	// It is empty if the execution is not within an action.
+	action string
+	// trace records the execution, if it is being traced.
+	trace *tracer
+	// profileRows counts the rows touched by the action or statement being
+	// profiled, if the execution is being profiled.
+	profileRows *int64
+	// meter limits the work of the execution. It is nil if the execution is
+	// not metered.
+	meter *workMeter
 }
 
 // subscope creates a new subscope execution context.
@@ -54,6 +68,9 @@ func (e *executionContext) subscope(namespace string) *executionContext {
 		interpreter:    e.interpreter,
 		logs:           e.logs,
 		inAction:       true,
+		trace:          e.trace,
+		profileRows:    e.profileRows,
+		meter:          e.meter,
 	}
 }
 
@@ -153,41 +170,83 @@ func (e *executionContext) getVariableType(name string) (*types.DataType, error)
 
 // query executes a query.
 // It will parse the SQL, create a logical plan, and execute the query.
-func (e *executionContext) query(sql string, fn func(*row) error) error {
+func (e *executionContext) query(sql string, fn func(*row) error) (err error) {
 	if e.queryActive {
 		return engine.ErrQueryActive
 	}
 	e.queryActive = true
 	defer func() { e.queryActive = false }()
 
-	generatedSQL, analyzed, args, err := e.prepareQuery(sql)
+	planned, args, err := e.prepareQuery(sql)
 	if err != nil {
 		return err
 	}
 
 	// get the scan values as well:
-	var scanValues []any
-	for _, field := range analyzed.Plan.Relation().Fields {
-		scalar, err := field.Scalar()
+	scanValues := make([]any, len(planned.scanTypes))
+	for i, scalar := range planned.scanTypes {
+		zVal, err := e.newScanValue(scalar)
 		if err != nil {
 			return err
 		}
 
-		zVal, err := newZeroValue(scalar)
-		if err != nil {
-			return err
-		}
+		scanValues[i] = zVal
+	}
 
-		scanValues = append(scanValues, zVal)
+	queryFn := query
+	if planned.prepare && canPrepare(args) {
+		queryFn = queryPrepared
 	}
 
-	cols := make([]string, len(analyzed.Plan.Relation().Fields))
-	for i, field := range analyzed.Plan.Relation().Fields {
-		cols[i] = field.Name
+	ctx := e.engineCtx.TxContext.Ctx
+	var rowsAffected *int64
+	if e.trace != nil {
+		event := &types.CallTrace{
+			Kind:         types.TraceKindSQL,
+			Text:         sql,
+			RowsAffected: new(int64),
+		}
+		for i, param := range planned.params {
+			event.Params = append(event.Params, param+" = "+traceValue(args[i]))
+		}
+		if e.trace.add(event) {
+			rowsAffected = event.RowsAffected
+			defer func() {
+				if err != nil {
+					event.RowsAffected = nil
+					setTraceErr(event, err)
+				}
+			}()
+		}
+	}
+	if profileRows := e.profileRows; profileRows != nil {
+		if rowsAffected == nil {
+			rowsAffected = new(int64)
+		}
+		defer func() {
+			if err == nil {
+				*profileRows += *rowsAffected
+			}
+		}()
+	}
+	// The rows are charged once the statement completes, so the meter cannot
+	// stop a statement that touches more rows than it has left.
+	if meter := e.meter; meter != nil {
+		if rowsAffected == nil {
+			rowsAffected = new(int64)
+		}
+		defer func() {
+			if err == nil {
+				err = meter.use(*rowsAffected)
+			}
+		}()
+	}
+	if rowsAffected != nil {
+		ctx = pg.WithRowsAffected(ctx, rowsAffected)
 	}
 
-	return query(e.engineCtx.TxContext.Ctx, e.db, generatedSQL, scanValues, func() error {
-		if len(scanValues) != len(cols) {
+	return queryFn(ctx, e.db, planned.sql, scanValues, func() error {
+		if len(scanValues) != len(planned.columns) {
 			// should never happen, but just in case
 			return fmt.Errorf("node bug: scan values and columns are not the same length")
 		}
@@ -200,7 +259,7 @@ func (e *executionContext) query(sql string, fn func(*row) error) error {
 		// fn will Cast each of Values, modifying each element in place, so this
 		// should not be scanValues used by queryRowFunc.
 		return fn(&row{
-			columns: cols,
+			columns: planned.columns,
 			Values:  vals,
 		})
 	}, args)
@@ -234,76 +293,143 @@ func (e *executionContext) getValues(names []string) ([]value, error) {
 // prepareQuery prepares a query for execution.
 // It will check the cache for a prepared statement, and if it does not exist,
 // it will parse the SQL, create a logical plan, and cache the statement.
-func (e *executionContext) prepareQuery(sql string) (pgSql string, plan *logical.AnalyzedPlan, args []value, err error) {
-	cached, ok := statementCache.get(e.scope.namespace, sql)
+// Statements are cached per namespace and action, and a cached statement
+// is only used if the variables it binds still have the types they were
+// planned with.
+func (e *executionContext) prepareQuery(sql string) (planned *plannedQuery, args []value, err error) {
+	cached, ok := statementCache.get(e.scope.namespace, e.action, sql)
 	if ok {
 		// if it is mutating state it must be deterministic
+		planned = cached.nonDeterministic
 		if e.canMutateState {
-			values, err := e.getValues(cached.deterministicParams)
-			if err != nil {
-				return "", nil, nil, err
-			}
-
-			return cached.deterministicSQL, cached.deterministicPlan, values, nil
+			planned = cached.deterministic
 		}
-		values, err := e.getValues(cached.nonDeterministicParams)
+
+		values, err := e.getValues(planned.params)
 		if err != nil {
-			return "", nil, nil, err
+			return nil, nil, err
+		}
+
+		if planned.matches(values) {
+			return planned, values, nil
 		}
-		return cached.nonDeterministicSQL, cached.nonDeterministicPlan, values, nil
+		// otherwise, a variable has changed type since the statement
+		// was planned, so we need to re-plan it.
 	}
 
 	deterministicAST, err := getAST(sql)
 	if err != nil {
-		return "", nil, nil, err
+		return nil, nil, err
 	}
 	nondeterministicAST, err := getAST(sql)
 	if err != nil {
-		return "", nil, nil, err
+		return nil, nil, err
 	}
 
-	deterministicPlan, err := makePlan(e, deterministicAST)
+	deterministic, err := e.planQuery(deterministicAST)
 	if err != nil {
-		return "", nil, nil, fmt.Errorf("%w: %w", engine.ErrQueryPlanner, err)
+		return nil, nil, err
 	}
 
-	nonDeterministicPlan, err := makePlan(e, nondeterministicAST)
+	nonDeterministic, err := e.planQuery(nondeterministicAST)
 	if err != nil {
-		return "", nil, nil, fmt.Errorf("%w: %w", engine.ErrQueryPlanner, err)
+		return nil, nil, err
 	}
+	// only non-deterministic queries are ever executed outside of consensus,
+	// so they are the only ones that can use Postgres prepared statements.
+	nonDeterministic.prepare = !hasWildcardResult(nondeterministicAST)
 
-	deterministicSQL, deterministicParams, err := pggenerate.GenerateSQL(deterministicAST, e.scope.namespace, e.getVariableType)
+	statementCache.set(e.scope.namespace, e.action, sql, &preparedStatement{
+		deterministic:    deterministic,
+		nonDeterministic: nonDeterministic,
+	})
+
+	planned = nonDeterministic
+	if e.canMutateState {
+		planned = deterministic
+	}
+
+	values, err := e.getValues(planned.params)
 	if err != nil {
-		return "", nil, nil, fmt.Errorf("%w: %w", engine.ErrPGGen, err)
+		return nil, nil, err
 	}
 
-	nonDeterministicSQL, nonDeterministicParams, err := pggenerate.GenerateSQL(nondeterministicAST, e.scope.namespace, e.getVariableType)
+	return planned, values, nil
+}
+
+// planQuery creates the logical plan and generates the Postgres SQL for a query.
+func (e *executionContext) planQuery(ast *parse.SQLStatement) (*plannedQuery, error) {
+	plan, err := makePlan(e, ast)
 	if err != nil {
-		return "", nil, nil, fmt.Errorf("%w: %w", engine.ErrPGGen, err)
+		return nil, fmt.Errorf("%w: %w", engine.ErrQueryPlanner, err)
 	}
 
-	statementCache.set(e.scope.namespace, sql, &preparedStatement{
-		deterministicPlan:      deterministicPlan,
-		deterministicSQL:       deterministicSQL,
-		deterministicParams:    deterministicParams,
-		nonDeterministicPlan:   nonDeterministicPlan,
-		nonDeterministicSQL:    nonDeterministicSQL,
-		nonDeterministicParams: nonDeterministicParams,
-	})
+	pgSQL, params, err := pggenerate.GenerateSQL(ast, e.scope.namespace, e.getVariableType)
+	if err != nil {
+		return nil, fmt.Errorf("%w: %w", engine.ErrPGGen, err)
+	}
 
-	if e.canMutateState {
-		values, err := e.getValues(deterministicParams)
+	paramTypes := make([]*types.DataType, len(params))
+	for i, param := range params {
+		paramTypes[i], err = e.getVariableType(param)
 		if err != nil {
-			return "", nil, nil, err
+			return nil, err
 		}
+	}
 
-		return deterministicSQL, deterministicPlan, values, nil
+	fields := plan.Plan.Relation().Fields
+	planned := &plannedQuery{
+		plan:       plan,
+		sql:        pgSQL,
+		params:     params,
+		paramTypes: paramTypes,
+		columns:    make([]string, len(fields)),
+		scanTypes:  make([]*types.DataType, len(fields)),
 	}
-	values, err := e.getValues(nonDeterministicParams)
-	if err != nil {
-		return "", nil, nil, err
+	for i, field := range fields {
+		planned.columns[i] = field.Name
+		planned.scanTypes[i], err = field.Scalar()
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	return planned, nil
+}
+
+// hasWildcardResult returns true if the top-level result of the statement
+// is (partially) defined by a wildcard. The shape of such a result depends
+// on the current table definitions, which makes it unsafe for Postgres
+// prepared statements.
+func hasWildcardResult(ast *parse.SQLStatement) bool {
+	sel, ok := ast.SQL.(*parse.SelectStatement)
+	if !ok {
+		return true
+	}
+
+	for _, core := range sel.SelectCores {
+		for _, col := range core.Columns {
+			if _, ok := col.(*parse.ResultColumnWildcard); ok {
+				return true
+			}
+		}
 	}
-	return nonDeterministicSQL, nonDeterministicPlan, values, nil
+
+	return false
+}
+
+// canPrepare returns true if the arguments can be bound to a Postgres
+// prepared statement. Prepared statements bind arguments using the types
+// that Postgres describes, so arguments that do not have a concrete
+// type (e.g. an array of only nulls) cannot be used.
+func canPrepare(args []value) bool {
+	for _, arg := range args {
+		if _, ok := arg.(*arrayOfNulls); ok {
+			return false
+		}
+	}
+
+	return true
 }
 
 // getAST gets the AST of a SQL statement.
@@ -374,40 +500,122 @@ func makePlan(e *executionContext, ast *parse.SQLStatement) (*logical.AnalyzedPl
 // This is necessary because we use the AST to generate Postgres SQL
 // queries, so we actually modify the AST to make it deterministic.
 type preparedStatement struct {
-	deterministicPlan *logical.AnalyzedPlan
-	deterministicSQL  string
-	// the params for deterministic and non-deterministic
-	// queries _should_ be the same, but I am keeping them separate
-	// because it might change based on the implementation of the planner
-	deterministicParams    []string
-	nonDeterministicPlan   *logical.AnalyzedPlan
-	nonDeterministicSQL    string
-	nonDeterministicParams []string
-}
-
-// statementCache caches parsed statements.
-// It is reloaded when schema changes are made to the namespace
+	deterministic    *plannedQuery
+	nonDeterministic *plannedQuery
+}
+
+// plannedQuery is a single planned form of a SQL statement.
+type plannedQuery struct {
+	plan *logical.AnalyzedPlan
+	// sql is the generated Postgres SQL.
+	sql string
+	// params are the names of the variables bound to the generated SQL.
+	// The params for deterministic and non-deterministic queries _should_
+	// be the same, but I am keeping them separate because it might change
+	// based on the implementation of the planner.
+	params []string
+	// paramTypes are the types that params had when the statement was planned.
+	// The generated SQL casts params to these types, so the statement cannot be
+	// re-used if any of them change.
+	paramTypes []*types.DataType
+	// columns and scanTypes are the names and types of the returned columns.
+	// They are derived from the plan once, so that they do not need to be
+	// re-computed on every execution.
+	columns   []string
+	scanTypes []*types.DataType
+	// prepare is true if the statement can be executed as a Postgres
+	// prepared statement.
+	prepare bool
+}
+
+// matches returns true if the values have the types that the
+// query was planned with.
+func (p *plannedQuery) matches(values []value) bool {
+	if len(values) != len(p.paramTypes) {
+		return false
+	}
+
+	for i, v := range values {
+		if !v.Type().EqualsStrict(p.paramTypes[i]) {
+			return false
+		}
+	}
+
+	return true
+}
+
+// statementCacheKey identifies a cached statement.
+type statementCacheKey struct {
+	namespace string
+	// action is the action that the statement is executed in.
+	// It is empty for ad-hoc statements.
+	action string
+	query  string
+}
+
+// preparedStatements caches planned statements.
+// It is invalidated when schema changes are made that could affect the plans.
 type preparedStatements struct {
-	cache *lru.Map[[2]string, *preparedStatement]
+	cache *lru.Map[statementCacheKey, *preparedStatement]
+	// invalidations counts the number of times the cache has been invalidated.
+	// It allows callers to detect if DDL was executed during a call.
+	invalidations atomic.Uint64
+	// disabled is true if the cache should not be used.
+	disabled atomic.Bool
 }
 
 // get gets a prepared statement from the cache.
-func (p *preparedStatements) get(namespace, query string) (*preparedStatement, bool) {
-	return p.cache.Get([2]string{namespace, query})
+func (p *preparedStatements) get(namespace, action, query string) (*preparedStatement, bool) {
+	if p.disabled.Load() {
+		return nil, false
+	}
+	return p.cache.Get(statementCacheKey{namespace: namespace, action: action, query: query})
 }
 
 // set sets a prepared statement in the cache.
-func (p *preparedStatements) set(namespace, query string, stmt *preparedStatement) {
-	p.cache.Put([2]string{namespace, query}, stmt)
+func (p *preparedStatements) set(namespace, action, query string, stmt *preparedStatement) {
+	if p.disabled.Load() {
+		return
+	}
+	p.cache.Put(statementCacheKey{namespace: namespace, action: action, query: query}, stmt)
 }
 
-// clear clears the cache namespace.
+// clear clears the entire cache.
+// It should be called when tables change, since statements
+// can reference tables in other namespaces.
 func (p *preparedStatements) clear() {
+	p.invalidations.Add(1)
 	p.cache.Clear()
 }
 
+// invalidateNamespace removes all statements that were planned in a namespace.
+// It should be called when the actions in a namespace change, since plans depend
+// on which functions are actions.
+func (p *preparedStatements) invalidateNamespace(namespace string) {
+	p.invalidations.Add(1)
+	for _, key := range p.cache.Keys() {
+		if key.namespace == namespace {
+			p.cache.Delete(key)
+		}
+	}
+}
+
+// invalidationCount returns the number of times the cache has been invalidated.
+func (p *preparedStatements) invalidationCount() uint64 {
+	return p.invalidations.Load()
+}
+
 var statementCache = &preparedStatements{
-	cache: lru.NewMap[[2]string, *preparedStatement](1000),
+	cache: lru.NewMap[statementCacheKey, *preparedStatement](1000),
+}
+
+// SetStatementCacheEnabled enables or disables the cache of planned statements.
+// The cache is enabled by default. Disabling it forces every statement to be
+// re-planned each time it is executed, and is only useful for benchmarking and
+// debugging.
+func SetStatementCacheEnabled(enabled bool) {
+	statementCache.disabled.Store(!enabled)
+	statementCache.clear()
 }
 
 // executable is the interface and function to call a built-in Postgres function,
@@ -423,6 +631,51 @@ type executable struct {
 	// It is a pointer to a slice because it may be nil;
 	// it is only set if the function is a precompile or action.
 	ExpectedArgs *[]*types.DataType
+	// Parameters is the names of the parameters, in order.
+	// It is only set if the function is an action, and is used to
+	// bind named arguments.
+	Parameters []string
+	// Defaults is the number of trailing parameters that have a default
+	// value. Arguments for these parameters can be omitted.
+	Defaults int
+	// source identifies the statements of an action, to record coverage.
+	// It is nil if the executable is not an action.
+	source *actionSource
+}
+
+// bindArgs orders the arguments of a call by the parameters of the executable.
+// Names are the argument names by position, with empty names for positional
+// arguments. Omitted parameters are left nil, so that the executable can use
+// their default values.
+func bindArgs(e *executable, names []string, vals []value) ([]value, error) {
+	if len(names) == 0 {
+		return vals, nil
+	}
+	if e.Type != executableTypeAction {
+		return nil, fmt.Errorf(`%w: named arguments can only be passed to actions, but "%s" is a %s`, engine.ErrActionInvocation, e.Name, e.Type)
+	}
+	if len(vals) > len(e.Parameters) {
+		return nil, fmt.Errorf(`%w: action "%s" expected at most %d arguments, but got %d`, engine.ErrActionInvocation, e.Name, len(e.Parameters), len(vals))
+	}
+
+	bound := make([]value, len(e.Parameters))
+	for j, val := range vals {
+		if names[j] == "" {
+			bound[j] = val
+			continue
+		}
+
+		idx := slices.Index(e.Parameters, "$"+names[j])
+		if idx == -1 {
+			return nil, fmt.Errorf(`%w: action "%s" has no parameter named $%s`, engine.ErrActionInvocation, e.Name, names[j])
+		}
+		if bound[idx] != nil {
+			return nil, fmt.Errorf(`%w: parameter $%s of action "%s" was passed more than once`, engine.ErrActionInvocation, names[j], e.Name)
+		}
+		bound[idx] = val
+	}
+
+	return bound, nil
 }
 
 type executableType string
@@ -463,6 +716,7 @@ func (e *executionContext) setVariable(name string, value value) error {
 			return err
 		}
 		foundScope.variables[name] = newVal
+		e.traceAssign(name, newVal)
 		return nil
 	}
 
@@ -471,6 +725,7 @@ func (e *executionContext) setVariable(name string, value value) error {
 	}
 
 	foundScope.variables[name] = value
+	e.traceAssign(name, value)
 	return nil
 }
 
@@ -482,6 +737,7 @@ func (e *executionContext) allocateVariable(name string, value value) error {
 	}
 
 	e.scope.variables[name] = value
+	e.traceAssign(name, value)
 	return nil
 }
 
@@ -572,6 +828,16 @@ func (e *executionContext) reloadNamespaceCache() error {
 		ns.tables[table.Name] = table
 	}
 
+	userTypes, err := listTypesInNamespace(e.engineCtx.TxContext.Ctx, e.db, e.scope.namespace)
+	if err != nil {
+		return err
+	}
+
+	ns.userTypes = make(map[string]*types.UserType)
+	for _, ut := range userTypes {
+		ns.userTypes[ut.Name] = ut
+	}
+
 	statementCache.clear()
 
 	return nil
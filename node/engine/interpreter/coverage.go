@@ -0,0 +1,141 @@
+package interpreter
+
+import (
+	"maps"
+	"slices"
+	"strings"
+	"sync"
+)
+
+// Coverage records the statements of actions that are executed. It is used
+// by tests to find the logic of actions that they do not exercise. It is safe
+// for concurrent use, and can be shared by many interpreters.
+type Coverage struct {
+	mu      sync.Mutex
+	actions map[string]*ActionCoverage
+}
+
+// NewCoverage creates an empty coverage record.
+func NewCoverage() *Coverage {
+	return &Coverage{actions: make(map[string]*ActionCoverage)}
+}
+
+// ActionCoverage is the coverage of the statements of an action.
+type ActionCoverage struct {
+	// Namespace is the namespace of the action.
+	Namespace string
+	// Name is the name of the action.
+	Name string
+	// Source is the CREATE ACTION statement of the action.
+	Source string
+	// Hits maps the line of each statement of the action to the number of
+	// times a statement on the line was executed. Lines start at 1, at the
+	// first line of Source.
+	Hits map[int]int
+}
+
+// Actions returns the coverage of every action that was created or called
+// while the coverage was recorded, sorted by namespace and name.
+func (c *Coverage) Actions() []*ActionCoverage {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	res := make([]*ActionCoverage, 0, len(c.actions))
+	for _, act := range c.actions {
+		cp := *act
+		cp.Hits = maps.Clone(act.Hits)
+		res = append(res, &cp)
+	}
+
+	slices.SortFunc(res, func(a, b *ActionCoverage) int {
+		if n := strings.Compare(a.Namespace, b.Namespace); n != 0 {
+			return n
+		}
+		if n := strings.Compare(a.Name, b.Name); n != 0 {
+			return n
+		}
+		return strings.Compare(a.Source, b.Source)
+	})
+
+	return res
+}
+
+// register adds the statements of an action, so that statements that are
+// never executed are reported.
+func (c *Coverage) register(src *actionSource) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	c.get(src)
+}
+
+// hit records that a statement of an action was executed.
+func (c *Coverage) hit(src *actionSource, line int) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	c.get(src).Hits[line]++
+}
+
+// get returns the coverage of an action, adding it if it is new. The caller
+// must hold the lock.
+func (c *Coverage) get(src *actionSource) *ActionCoverage {
+	// an action that is replaced is a different action
+	key := src.namespace + "." + src.name + "\x00" + src.raw
+	act, ok := c.actions[key]
+	if !ok {
+		act = &ActionCoverage{
+			Namespace: src.namespace,
+			Name:      src.name,
+			Source:    src.raw,
+			Hits:      make(map[int]int, len(src.lines)),
+		}
+		for _, line := range src.lines {
+			act.Hits[line] = 0
+		}
+		c.actions[key] = act
+	}
+	return act
+}
+
+// actionSource identifies the statements of an action in its source.
+type actionSource struct {
+	namespace string
+	name      string
+	// raw is the CREATE ACTION statement.
+	raw string
+	// startLine is the line of the CREATE ACTION statement in the parsed
+	// input, which can hold many statements.
+	startLine int
+	// lines are the lines of the statements of the action, relative to raw.
+	lines []int
+}
+
+// relativeLine returns the line of a statement relative to the raw statement
+// of the action, or 0 if the statement has no position.
+func (a *actionSource) relativeLine(line *int) int {
+	if line == nil {
+		return 0
+	}
+	return *line - a.startLine + 1
+}
+
+// SetCoverage records the statements of actions that are executed in
+// coverage. A nil coverage stops recording.
+func (t *ThreadSafeInterpreter) SetCoverage(coverage *Coverage) {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+
+	t.i.coverage = coverage
+	if coverage == nil {
+		return
+	}
+
+	for _, ns := range t.i.namespaces {
+		for _, exec := range ns.availableFunctions {
+			if exec.source != nil {
+				coverage.register(exec.source)
+			}
+		}
+	}
+}
@@ -0,0 +1,49 @@
+package interpreter
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/trufnetwork/kwil-db/node/engine/parse"
+)
+
+func Test_Coverage(t *testing.T) {
+	// the action is the second statement, so its positions are offset
+	stmts, err := parse.Parse(`CREATE TABLE t (id INT PRIMARY KEY);
+
+CREATE ACTION act($a INT) public {
+	$b := $a;
+	if $a > 1 {
+		$b := 1;
+	} else {
+		$b := 2;
+	}
+	for $i in 1..$a { $b := $i; }
+};`)
+	require.NoError(t, err)
+
+	var act action
+	require.NoError(t, act.FromAST(stmts[1].(*parse.CreateActionStatement)))
+
+	exec := makeActionToExecutable("main", &act)
+	assert.ElementsMatch(t, []int{2, 3, 4, 6, 8}, exec.source.lines)
+
+	cov := NewCoverage()
+	cov.register(exec.source)
+	cov.hit(exec.source, 2)
+	cov.hit(exec.source, 8)
+	cov.hit(exec.source, 8)
+
+	actions := cov.Actions()
+	require.Len(t, actions, 1)
+	assert.Equal(t, "main", actions[0].Namespace)
+	assert.Equal(t, "act", actions[0].Name)
+	assert.Equal(t, act.RawStatement, actions[0].Source)
+	assert.Equal(t, map[int]int{2: 1, 3: 0, 4: 0, 6: 0, 8: 2}, actions[0].Hits)
+
+	// the returned coverage is a copy
+	actions[0].Hits[2] = 100
+	assert.Equal(t, 1, cov.Actions()[0].Hits[2])
+}
@@ -0,0 +1,112 @@
+package interpreter
+
+import (
+	"fmt"
+	"slices"
+
+	"github.com/trufnetwork/kwil-db/core/crypto"
+	"github.com/trufnetwork/kwil-db/core/crypto/auth"
+	"github.com/trufnetwork/kwil-db/core/types"
+	"github.com/trufnetwork/kwil-db/node/engine"
+	"golang.org/x/crypto/sha3"
+)
+
+// The crypto functions use the same verifiers as the transaction
+// authenticators, so a signature is valid in an action exactly when it would
+// be valid on a transaction.
+
+// bytesArgs returns the raw values of bytea arguments, and whether any of
+// them is null.
+func bytesArgs(args []value) ([][]byte, bool) {
+	res := make([][]byte, len(args))
+	for i, arg := range args {
+		if arg.Null() {
+			return nil, true
+		}
+		res[i] = arg.RawValue().([]byte)
+	}
+	return res, false
+}
+
+func keccak256Func(args []value) (value, error) {
+	b, null := bytesArgs(args)
+	if null {
+		return makeNull(types.ByteaType)
+	}
+
+	h := sha3.NewLegacyKeccak256()
+	h.Write(b[0])
+	return makeBlob(h.Sum(nil)), nil
+}
+
+func ecrecoverFunc(args []value) (value, error) {
+	b, null := bytesArgs(args)
+	if null {
+		return makeNull(types.TextType)
+	}
+
+	hash, sig := b[0], b[1]
+	if len(hash) != 32 {
+		return nil, fmt.Errorf("%w: ecrecover expects a 32 byte hash, got %d bytes", engine.ErrInvalidArgument, len(hash))
+	}
+	if len(sig) != crypto.Secp256k1SignatureLength {
+		return nil, fmt.Errorf("%w: ecrecover expects a %d byte signature, got %d bytes", engine.ErrInvalidArgument, crypto.Secp256k1SignatureLength, len(sig))
+	}
+
+	if sig[crypto.RecoveryIDOffset] == 27 || sig[crypto.RecoveryIDOffset] == 28 {
+		// Transform yellow paper V from 27/28 to 0/1
+		sig = slices.Clone(sig)
+		sig[crypto.RecoveryIDOffset] -= 27
+	}
+
+	pub, err := crypto.RecoverSecp256k1KeyFromSigHash(hash, sig)
+	if err != nil {
+		return makeNull(types.TextType)
+	}
+
+	addr, err := auth.EthSecp256k1Authenticator{}.Identifier(crypto.EthereumAddressFromPubKey(pub))
+	if err != nil {
+		return nil, err
+	}
+
+	return makeText(addr), nil
+}
+
+func ed25519VerifyFunc(args []value) (value, error) {
+	b, null := bytesArgs(args)
+	if null {
+		return makeNull(types.BoolType)
+	}
+
+	err := auth.Ed25519Authenticator{}.Verify(b[0], b[1], b[2])
+	return makeBool(err == nil), nil
+}
+
+func secp256k1VerifyFunc(args []value) (value, error) {
+	b, null := bytesArgs(args)
+	if null {
+		return makeNull(types.BoolType)
+	}
+
+	err := auth.Secp25k1Authenticator{}.Verify(b[0], b[1], b[2])
+	return makeBool(err == nil), nil
+}
+
+func ethAddressFunc(args []value) (value, error) {
+	b, null := bytesArgs(args)
+	if null {
+		return makeNull(types.TextType)
+	}
+
+	pub, err := crypto.UnmarshalSecp256k1PublicKey(b[0])
+	if err != nil {
+		return nil, fmt.Errorf("%w: invalid secp256k1 public key: %w", engine.ErrInvalidArgument, err)
+	}
+
+	addr, err := auth.EthSecp256k1Authenticator{}.Identifier(crypto.EthereumAddressFromPubKey(pub))
+	if err != nil {
+		return nil, err
+	}
+
+	return makeText(addr), nil
+}
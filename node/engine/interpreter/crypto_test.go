@@ -0,0 +1,88 @@
+package interpreter
+
+import (
+	"encoding/hex"
+	"fmt"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/trufnetwork/kwil-db/core/crypto"
+	"github.com/trufnetwork/kwil-db/core/crypto/auth"
+	"github.com/trufnetwork/kwil-db/node/engine"
+)
+
+func Test_CryptoFuncs(t *testing.T) {
+	call := func(name string, args ...any) (any, error) {
+		vals := make([]value, len(args))
+		for i, arg := range args {
+			v, err := newValue(arg)
+			require.NoError(t, err)
+			vals[i] = v
+		}
+
+		res, err := builtInScalarFuncs[name](vals)
+		if err != nil {
+			return nil, err
+		}
+		return res.RawValue(), nil
+	}
+	mustCall := func(name string, args ...any) any {
+		res, err := call(name, args...)
+		require.NoError(t, err)
+		return res
+	}
+
+	// keccak256 is the legacy Keccak used by Ethereum, not SHA3
+	assert.Equal(t, "c5d2460186f7233c927e7db2dcc703c0e500b653ca82273b7bfad8045d85a470",
+		hex.EncodeToString(mustCall("keccak256", []byte{}).([]byte)))
+
+	secpKey, _, err := crypto.GenerateSecp256k1Key(nil)
+	require.NoError(t, err)
+	secpPub := secpKey.Public().(*crypto.Secp256k1PublicKey)
+	msg := []byte("voucher")
+
+	// ecrecover recovers the caller of transactions signed by Ethereum wallets
+	ethSigner := &auth.EthPersonalSigner{Key: *secpKey.(*crypto.Secp256k1PrivateKey)}
+	sig, err := ethSigner.Sign(msg)
+	require.NoError(t, err)
+	caller, err := auth.EthSecp256k1Authenticator{}.Identifier(ethSigner.CompactID())
+	require.NoError(t, err)
+
+	hash := mustCall("keccak256", []byte(fmt.Sprintf("\x19Ethereum Signed Message:\n%d%s", len(msg), msg)))
+	assert.Equal(t, caller, mustCall("ecrecover", hash, sig.Data))
+	assert.Equal(t, caller, mustCall("eth_address", secpPub.Bytes()))
+	assert.Equal(t, caller, mustCall("eth_address", secpPub.BytesUncompressed()))
+
+	// yellow paper recovery ids are accepted
+	sig27 := append([]byte{}, sig.Data...)
+	sig27[crypto.RecoveryIDOffset] += 27
+	assert.Equal(t, caller, mustCall("ecrecover", hash, sig27))
+
+	// a different hash recovers a different address
+	assert.NotEqual(t, caller, mustCall("ecrecover", mustCall("keccak256", msg), sig.Data))
+
+	_, err = call("ecrecover", []byte("short"), sig.Data)
+	assert.ErrorIs(t, err, engine.ErrInvalidArgument)
+	_, err = call("eth_address", []byte("not a key"))
+	assert.ErrorIs(t, err, engine.ErrInvalidArgument)
+
+	// secp256k1_verify matches the secp256k1 authenticator
+	secpSig, err := secpKey.Sign(msg)
+	require.NoError(t, err)
+	assert.Equal(t, true, mustCall("secp256k1_verify", secpPub.Bytes(), msg, secpSig))
+	assert.Equal(t, false, mustCall("secp256k1_verify", secpPub.Bytes(), []byte("other"), secpSig))
+	assert.Equal(t, false, mustCall("secp256k1_verify", []byte("not a key"), msg, secpSig))
+
+	// ed25519_verify matches the ed25519 authenticator
+	edKey, edPub, err := crypto.GenerateEd25519Key(nil)
+	require.NoError(t, err)
+	edSig, err := edKey.Sign(msg)
+	require.NoError(t, err)
+	assert.Equal(t, true, mustCall("ed25519_verify", edPub.Bytes(), msg, edSig))
+	assert.Equal(t, false, mustCall("ed25519_verify", edPub.Bytes(), []byte("other"), edSig))
+	assert.Equal(t, false, mustCall("ed25519_verify", edPub.Bytes(), msg, edSig[:10]))
+
+	// nulls give nulls
+	assert.Nil(t, mustCall("ed25519_verify", nil, msg, edSig))
+}
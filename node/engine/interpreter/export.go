@@ -0,0 +1,803 @@
+package interpreter
+
+import (
+	"context"
+	"encoding/hex"
+	"fmt"
+	"regexp"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/trufnetwork/kwil-db/core/types"
+	"github.com/trufnetwork/kwil-db/node/engine"
+	"github.com/trufnetwork/kwil-db/node/engine/format"
+	"github.com/trufnetwork/kwil-db/node/engine/parse"
+	"github.com/trufnetwork/kwil-db/node/types/sql"
+)
+
+// ExportSchema returns a Kuneiform script that recreates a namespace. The script contains
+// the namespace's types, tables, indexes, actions, and schedules, the extensions used by its actions, and
+// the privileges granted or revoked on the namespace, along with the roles that hold them
+// and their members. Data is not exported.
+// The script is canonically formatted, so exporting the same schema always gives the same
+// result, and it can be deployed as-is to the same or another network.
+func (t *ThreadSafeInterpreter) ExportSchema(ctx context.Context, db sql.DB, namespace string) (string, error) {
+	unlock, err := t.lock(db)
+	if err != nil {
+		return "", err
+	}
+	defer unlock()
+
+	return t.i.exportSchema(ctx, db, strings.ToLower(namespace))
+}
+
+func (i *baseInterpreter) exportSchema(ctx context.Context, db sql.DB, namespace string) (string, error) {
+	ns, ok := i.namespaces[namespace]
+	if !ok {
+		return "", fmt.Errorf(`%w: "%s"`, engine.ErrNamespaceNotFound, namespace)
+	}
+	// the default namespace is a system namespace, but it holds user tables and actions
+	if ns.namespaceType != namespaceTypeUser && namespace != engine.DefaultNamespace {
+		return "", fmt.Errorf(`cannot export %s namespace "%s"`, strings.ToLower(string(ns.namespaceType)), namespace)
+	}
+
+	exp := &schemaExport{namespace: namespace}
+
+	var err error
+	exp.userTypes, err = listTypesInNamespace(ctx, db, namespace)
+	if err != nil {
+		return "", err
+	}
+
+	exp.tables, err = exportTables(ctx, db, namespace)
+	if err != nil {
+		return "", err
+	}
+
+	exp.actions, err = exportActions(ctx, db, namespace)
+	if err != nil {
+		return "", err
+	}
+
+	exp.schedules, err = exportSchedules(ctx, db, namespace)
+	if err != nil {
+		return "", err
+	}
+
+	exts, err := getExtensionInitializationMetadata(ctx, db)
+	if err != nil {
+		return "", err
+	}
+	exp.extensions = usedExtensions(exts, exp.actions)
+
+	exp.roles, err = exportRoles(ctx, db, namespace)
+	if err != nil {
+		return "", err
+	}
+
+	return exp.script()
+}
+
+// Schema describes the user-defined types and actions of a namespace, including the
+// default values of action parameters, so that clients know which arguments can be
+// omitted and how to encode values of user-defined types.
+func (t *ThreadSafeInterpreter) Schema(ctx context.Context, db sql.DB, namespace string) (*types.Schema, error) {
+	unlock, err := t.lock(db)
+	if err != nil {
+		return nil, err
+	}
+	defer unlock()
+
+	if namespace == "" {
+		namespace = engine.DefaultNamespace
+	}
+	namespace = strings.ToLower(namespace)
+
+	if _, ok := t.i.namespaces[namespace]; !ok {
+		return nil, fmt.Errorf(`%w: "%s"`, engine.ErrNamespaceNotFound, namespace)
+	}
+
+	userTypes, err := listTypesInNamespace(ctx, db, namespace)
+	if err != nil {
+		return nil, err
+	}
+
+	actions, err := exportActions(ctx, db, namespace)
+	if err != nil {
+		return nil, err
+	}
+
+	schema := &types.Schema{
+		Namespace: namespace,
+		Actions:   make([]*types.Action, len(actions)),
+	}
+	if len(userTypes) > 0 {
+		schema.Types = userTypes
+	}
+	for j, act := range actions {
+		desc := &types.Action{
+			Name:       act.Name,
+			Parameters: make([]*types.ActionParameter, len(act.Parameters)),
+			Modifiers:  act.Modifiers,
+		}
+		for k, param := range act.Parameters {
+			desc.Parameters[k] = &types.ActionParameter{
+				Name: param.Name,
+				Type: param.Type,
+			}
+			if k < len(act.Defaults) && act.Defaults[k] != nil {
+				desc.Parameters[k].Default, err = format.Expression(act.Defaults[k])
+				if err != nil {
+					return nil, err
+				}
+			}
+		}
+		schema.Actions[j] = desc
+	}
+
+	return schema, nil
+}
+
+// schemaExport is everything needed to recreate a namespace.
+type schemaExport struct {
+	namespace  string
+	extensions []*storedExtension
+	userTypes  []*types.UserType
+	tables     []*exportedTable
+	actions    []*parse.CreateActionStatement
+	schedules  []*parse.CreateScheduleStatement
+	roles      []*exportedRole
+}
+
+type exportedTable struct {
+	name    string
+	columns []*exportedColumn
+	// constraints are the table's primary key, unique, check, and foreign key constraints,
+	// in that order.
+	constraints []*exportedConstraint
+	// indexes are the indexes that do not back a constraint.
+	indexes []*engine.Index
+}
+
+type exportedColumn struct {
+	name         string
+	dataType     *types.DataType
+	notNull      bool
+	defaultValue *string
+}
+
+type exportedConstraint struct {
+	name string
+	// definition is the Postgres definition of the constraint, e.g. "UNIQUE (a, b)".
+	definition string
+	// primaryKey is true if the constraint is the table's primary key.
+	primaryKey bool
+	// refTable is the table referenced by a foreign key in the same namespace.
+	refTable string
+}
+
+type exportedRole struct {
+	name    string
+	granted []string
+	revoked []string
+	members []string
+}
+
+// exportTables reads all tables in a namespace, with their columns, constraints, and
+// indexes.
+func exportTables(ctx context.Context, db sql.DB, namespace string) ([]*exportedTable, error) {
+	var tables []*exportedTable
+	byName := make(map[string]*exportedTable)
+
+	var tblName, colName, dataType string
+	var nullable, isPK bool
+	var defaultValue *string
+	err := queryRowFunc(ctx, db, `SELECT table_name, name, data_type, is_nullable, default_value, is_primary_key
+	FROM info.columns
+	WHERE namespace = $1
+	ORDER BY table_name, ordinal_position`,
+		[]any{&tblName, &colName, &dataType, &nullable, &defaultValue, &isPK},
+		func() error {
+			tbl, ok := byName[tblName]
+			if !ok {
+				tbl = &exportedTable{name: tblName}
+				byName[tblName] = tbl
+				tables = append(tables, tbl)
+			}
+
+			dt, err := types.ParseDataType(dataType)
+			if err != nil {
+				return err
+			}
+
+			tbl.columns = append(tbl.columns, &exportedColumn{
+				name:         colName,
+				dataType:     dt,
+				notNull:      !nullable && !isPK, // NOT NULL is implied by the primary key
+				defaultValue: defaultValue,
+			})
+			return nil
+		}, namespace)
+	if err != nil {
+		return nil, err
+	}
+
+	// constraint definitions are read from the catalog, since the info views
+	// do not include the definition of foreign keys and primary keys.
+	var conName, conType, definition string
+	var refNamespace, refTable *string
+	err = queryRowFunc(ctx, db, `SELECT t.relname::TEXT, c.conname::TEXT, c.contype::TEXT, pg_get_constraintdef(c.oid),
+		rn.nspname::TEXT, rt.relname::TEXT
+	FROM pg_constraint c
+	JOIN pg_class t ON t.oid = c.conrelid
+	JOIN pg_namespace n ON n.oid = t.relnamespace
+	LEFT JOIN pg_class rt ON rt.oid = c.confrelid
+	LEFT JOIN pg_namespace rn ON rn.oid = rt.relnamespace
+	WHERE n.nspname = $1 AND c.contype IN ('p', 'u', 'c', 'f')
+	ORDER BY 1, array_position(ARRAY['p', 'u', 'c', 'f'], c.contype::TEXT), 2`,
+		[]any{&tblName, &conName, &conType, &definition, &refNamespace, &refTable},
+		func() error {
+			tbl, ok := byName[tblName]
+			if !ok {
+				return fmt.Errorf(`constraint "%s" is on unknown table "%s"`, conName, tblName)
+			}
+
+			con := &exportedConstraint{
+				name:       conName,
+				definition: definition,
+				primaryKey: conType == "p",
+			}
+			if conType == "f" && refNamespace != nil && refTable != nil && *refNamespace == namespace {
+				con.refTable = *refTable
+				// Postgres qualifies the referenced table when its schema is not on the
+				// search path. The namespace is implied by the script.
+				con.definition = strings.Replace(con.definition, "REFERENCES "+namespace+".", "REFERENCES ", 1)
+			}
+
+			tbl.constraints = append(tbl.constraints, con)
+			return nil
+		}, namespace)
+	if err != nil {
+		return nil, err
+	}
+
+	var idxName string
+	var isUnique bool
+	var columns []string
+	err = queryRowFunc(ctx, db, `SELECT table_name, name, is_primary_key, is_unique, columns
+	FROM info.indexes
+	WHERE namespace = $1
+	ORDER BY table_name, name`,
+		[]any{&tblName, &idxName, &isPK, &isUnique, &columns},
+		func() error {
+			tbl, ok := byName[tblName]
+			if !ok {
+				return fmt.Errorf(`index "%s" is on unknown table "%s"`, idxName, tblName)
+			}
+
+			// primary key and unique constraints are backed by an index of the same name
+			for _, con := range tbl.constraints {
+				if con.name == idxName {
+					return nil
+				}
+			}
+			if isPK {
+				return nil
+			}
+
+			idxType := engine.BTREE
+			if isUnique {
+				idxType = engine.UNIQUE_BTREE
+			}
+
+			tbl.indexes = append(tbl.indexes, &engine.Index{
+				Name:    idxName,
+				Columns: append([]string{}, columns...),
+				Type:    idxType,
+			})
+			return nil
+		}, namespace)
+	if err != nil {
+		return nil, err
+	}
+
+	return tables, nil
+}
+
+// exportActions reads all actions in a namespace, ordered by name.
+func exportActions(ctx context.Context, db sql.DB, namespace string) ([]*parse.CreateActionStatement, error) {
+	var actions []*parse.CreateActionStatement
+
+	var rawStmt string
+	err := queryRowFunc(ctx, db, `SELECT raw_statement
+	FROM kwild_engine.actions
+	WHERE namespace = $1 AND built_in = false
+	ORDER BY name`,
+		[]any{&rawStmt},
+		func() error {
+			res, err := parse.Parse(rawStmt)
+			if err != nil {
+				return fmt.Errorf("%w: %w", engine.ErrParse, err)
+			}
+
+			if len(res) != 1 {
+				return fmt.Errorf("expected exactly 1 statement, got %d", len(res))
+			}
+
+			act, ok := res[0].(*parse.CreateActionStatement)
+			if !ok {
+				return fmt.Errorf("expected CreateActionStatement, got %T", res[0])
+			}
+
+			// the action is recreated in the namespace of the script
+			act.OrReplace = false
+			act.IfNotExists = false
+			act.SetNamespacePrefix("")
+
+			actions = append(actions, act)
+			return nil
+		}, namespace)
+	if err != nil {
+		return nil, err
+	}
+
+	return actions, nil
+}
+
+// exportSchedules reads all schedules in a namespace, ordered by name.
+func exportSchedules(ctx context.Context, db sql.DB, namespace string) ([]*parse.CreateScheduleStatement, error) {
+	var schedules []*parse.CreateScheduleStatement
+
+	var rawStmt string
+	err := queryRowFunc(ctx, db, `SELECT raw_statement
+	FROM kwild_engine.schedules
+	WHERE namespace = $1
+	ORDER BY name`,
+		[]any{&rawStmt},
+		func() error {
+			res, err := parse.Parse(rawStmt)
+			if err != nil {
+				return fmt.Errorf("%w: %w", engine.ErrParse, err)
+			}
+
+			if len(res) != 1 {
+				return fmt.Errorf("expected exactly 1 statement, got %d", len(res))
+			}
+
+			sched, ok := res[0].(*parse.CreateScheduleStatement)
+			if !ok {
+				return fmt.Errorf("expected CreateScheduleStatement, got %T", res[0])
+			}
+
+			// the schedule is recreated in the namespace of the script
+			sched.IfNotExists = false
+			sched.SetNamespacePrefix("")
+
+			schedules = append(schedules, sched)
+			return nil
+		}, namespace)
+	if err != nil {
+		return nil, err
+	}
+
+	return schedules, nil
+}
+
+// exportRoles reads the privileges that are granted or revoked on a namespace, and the
+// members of the roles that hold them. The owner role is not exported, since it holds all
+// privileges.
+func exportRoles(ctx context.Context, db sql.DB, namespace string) ([]*exportedRole, error) {
+	var roles []*exportedRole
+	byName := make(map[string]*exportedRole)
+
+	var roleName, priv string
+	var granted bool
+	err := queryRowFunc(ctx, db, `SELECT r.name, rp.privilege_type::TEXT, rp.granted
+	FROM kwild_engine.role_privileges rp
+	JOIN kwild_engine.roles r ON r.id = rp.role_id
+	JOIN kwild_engine.namespaces n ON n.id = rp.namespace_id
+	WHERE n.name = $1 AND r.name != $2
+	ORDER BY 1, 2`,
+		[]any{&roleName, &priv, &granted},
+		func() error {
+			role, ok := byName[roleName]
+			if !ok {
+				role = &exportedRole{name: roleName}
+				byName[roleName] = role
+				roles = append(roles, role)
+			}
+
+			if granted {
+				role.granted = append(role.granted, priv)
+			} else {
+				role.revoked = append(role.revoked, priv)
+			}
+			return nil
+		}, namespace, ownerRole)
+	if err != nil {
+		return nil, err
+	}
+
+	var user string
+	err = queryRowFunc(ctx, db, `SELECT r.name, u.user_identifier
+	FROM kwild_engine.user_roles u
+	JOIN kwild_engine.roles r ON r.id = u.role_id
+	ORDER BY 1, 2`,
+		[]any{&roleName, &user},
+		func() error {
+			role, ok := byName[roleName]
+			if !ok || isBuiltInRole(roleName) {
+				return nil
+			}
+
+			role.members = append(role.members, user)
+			return nil
+		})
+	if err != nil {
+		return nil, err
+	}
+
+	return roles, nil
+}
+
+// namespaceCallRegex matches calls to methods in other namespaces, e.g. "ext.method(".
+var namespaceCallRegex = regexp.MustCompile(`\b([a-z_][a-z0-9_]*)\.[a-z_][a-z0-9_]*\(`)
+
+// usedExtensions returns the extensions that are called by any of the actions, ordered
+// by alias.
+func usedExtensions(exts []*storedExtension, actions []*parse.CreateActionStatement) []*storedExtension {
+	called := make(map[string]struct{})
+	for _, act := range actions {
+		src, err := format.Statement(act)
+		if err != nil {
+			continue // the action is validated when the script is formatted
+		}
+
+		for _, match := range namespaceCallRegex.FindAllStringSubmatch(strings.ToLower(src), -1) {
+			called[match[1]] = struct{}{}
+		}
+	}
+
+	var used []*storedExtension
+	for _, ext := range exts {
+		if _, ok := called[ext.Alias]; ok {
+			used = append(used, ext)
+		}
+	}
+
+	sort.Slice(used, func(i, j int) bool {
+		return used[i].Alias < used[j].Alias
+	})
+
+	return used
+}
+
+// script renders the export as a formatted Kuneiform script.
+func (e *schemaExport) script() (string, error) {
+	prefix := ""
+	if e.namespace != engine.DefaultNamespace {
+		prefix = "{" + e.namespace + "}"
+	}
+
+	// statements are grouped, and the groups are separated by a blank line
+	var groups [][]string
+	if e.namespace != engine.DefaultNamespace {
+		groups = append(groups, []string{"CREATE NAMESPACE IF NOT EXISTS " + e.namespace + ";"})
+	}
+
+	var uses []string
+	for _, ext := range e.extensions {
+		stmt, err := useExtensionStatement(ext)
+		if err != nil {
+			return "", fmt.Errorf(`extension "%s": %w`, ext.Alias, err)
+		}
+		uses = append(uses, stmt)
+	}
+	groups = append(groups, uses)
+
+	var typeStmts []string
+	for _, ut := range sortTypesByReferences(e.userTypes) {
+		typeStmts = append(typeStmts, prefix+createTypeStatement(ut))
+	}
+	groups = append(groups, typeStmts)
+
+	for _, tbl := range sortTablesByReferences(e.tables) {
+		groups = append(groups, []string{prefix + tbl.createStatement()})
+
+		var indexes []string
+		for _, idx := range tbl.indexes {
+			unique := ""
+			if idx.Type == engine.UNIQUE_BTREE {
+				unique = "UNIQUE "
+			}
+			indexes = append(indexes, fmt.Sprintf("%sCREATE %sINDEX %s ON %s (%s);", prefix, unique, idx.Name, tbl.name, strings.Join(idx.Columns, ", ")))
+		}
+		groups = append(groups, indexes)
+	}
+
+	for _, act := range e.actions {
+		stmt, err := format.Statement(act)
+		if err != nil {
+			return "", fmt.Errorf(`action "%s": %w`, act.Name, err)
+		}
+		groups = append(groups, []string{prefix + stmt})
+	}
+
+	var scheduleStmts []string
+	for _, sched := range e.schedules {
+		stmt, err := format.Statement(sched)
+		if err != nil {
+			return "", fmt.Errorf(`schedule "%s": %w`, sched.Name, err)
+		}
+		scheduleStmts = append(scheduleStmts, prefix+stmt)
+	}
+	groups = append(groups, scheduleStmts)
+
+	for _, role := range e.roles {
+		var stmts []string
+		if !isBuiltInRole(role.name) {
+			stmts = append(stmts, "CREATE ROLE IF NOT EXISTS "+role.name+";")
+		}
+		if len(role.granted) > 0 {
+			stmts = append(stmts, fmt.Sprintf("GRANT IF NOT GRANTED %s ON %s TO %s;", strings.Join(role.granted, ", "), e.namespace, role.name))
+		}
+		if len(role.revoked) > 0 {
+			stmts = append(stmts, fmt.Sprintf("REVOKE IF GRANTED %s ON %s FROM %s;", strings.Join(role.revoked, ", "), e.namespace, role.name))
+		}
+		for _, member := range role.members {
+			stmts = append(stmts, fmt.Sprintf("GRANT IF NOT GRANTED %s TO '%s';", role.name, member))
+		}
+		groups = append(groups, stmts)
+	}
+
+	var sb strings.Builder
+	for _, group := range groups {
+		if len(group) == 0 {
+			continue
+		}
+		if sb.Len() > 0 {
+			sb.WriteString("\n")
+		}
+		for _, stmt := range group {
+			sb.WriteString(stmt)
+			sb.WriteString("\n")
+		}
+	}
+
+	// formatting the script both canonicalizes it and ensures it can be parsed
+	res, err := format.Format(sb.String())
+	if err != nil {
+		return "", fmt.Errorf("exported schema is not valid Kuneiform: %w", err)
+	}
+
+	return res, nil
+}
+
+// createStatement renders the CREATE TABLE statement of the table, without a namespace prefix.
+func (t *exportedTable) createStatement() string {
+	var defs []string
+	for _, col := range t.columns {
+		def := col.name + " " + strings.ToUpper(col.dataType.String())
+		if col.notNull {
+			def += " NOT NULL"
+		}
+		if col.defaultValue != nil {
+			def += " DEFAULT " + fromPostgresExpression(*col.defaultValue)
+		}
+		defs = append(defs, def)
+	}
+
+	for _, con := range t.constraints {
+		def := fromPostgresExpression(con.definition)
+		// primary keys are always named after the table, so their name is not kept
+		if !con.primaryKey {
+			def = "CONSTRAINT " + con.name + " " + def
+		}
+		defs = append(defs, def)
+	}
+
+	return "CREATE TABLE " + t.name + " (\n" + strings.Join(defs, ",\n") + "\n);"
+}
+
+// sortTablesByReferences orders tables so that tables referenced by foreign keys are
+// created before the tables that reference them. Otherwise, the order is kept.
+func sortTablesByReferences(tables []*exportedTable) []*exportedTable {
+	byName := make(map[string]*exportedTable, len(tables))
+	for _, tbl := range tables {
+		byName[tbl.name] = tbl
+	}
+
+	var sorted []*exportedTable
+	visited := make(map[string]bool)
+	var visit func(tbl *exportedTable)
+	visit = func(tbl *exportedTable) {
+		if visited[tbl.name] {
+			return
+		}
+		visited[tbl.name] = true
+
+		for _, con := range tbl.constraints {
+			if ref, ok := byName[con.refTable]; ok {
+				visit(ref)
+			}
+		}
+
+		sorted = append(sorted, tbl)
+	}
+
+	for _, tbl := range tables {
+		visit(tbl)
+	}
+
+	return sorted
+}
+
+// sortTypesByReferences orders types so that types used by the fields of composite types
+// are created before the types that use them. Otherwise, the order is kept.
+func sortTypesByReferences(userTypes []*types.UserType) []*types.UserType {
+	byName := make(map[string]*types.UserType, len(userTypes))
+	for _, ut := range userTypes {
+		byName[ut.Name] = ut
+	}
+
+	var sorted []*types.UserType
+	visited := make(map[string]bool)
+	var visit func(ut *types.UserType)
+	visit = func(ut *types.UserType) {
+		if visited[ut.Name] {
+			return
+		}
+		visited[ut.Name] = true
+
+		for _, field := range ut.Fields {
+			if ref, ok := byName[field.Type.Name]; ok {
+				visit(ref)
+			}
+		}
+
+		sorted = append(sorted, ut)
+	}
+
+	for _, ut := range userTypes {
+		visit(ut)
+	}
+
+	return sorted
+}
+
+// createTypeStatement renders the CREATE TYPE statement of a user-defined type.
+func createTypeStatement(ut *types.UserType) string {
+	if ut.IsEnum() {
+		labels := make([]string, len(ut.Labels))
+		for i, l := range ut.Labels {
+			// stored labels keep the escapes of the literal they were parsed from
+			labels[i] = "'" + l + "'"
+		}
+		return fmt.Sprintf("CREATE TYPE IF NOT EXISTS %s AS ENUM (%s);", ut.Name, strings.Join(labels, ", "))
+	}
+
+	fields := make([]string, len(ut.Fields))
+	for i, f := range ut.Fields {
+		fields[i] = f.Name + " " + strings.ToUpper(f.Type.String())
+	}
+	return fmt.Sprintf("CREATE TYPE IF NOT EXISTS %s AS (%s);", ut.Name, strings.Join(fields, ", "))
+}
+
+var (
+	// anyArrayRegex matches "= ANY (ARRAY[...])" and "<> ALL (ARRAY[...])", which is how
+	// Postgres deparses IN and NOT IN lists.
+	anyArrayRegex = regexp.MustCompile(`(=\s*ANY|<>\s*ALL)\s*\(ARRAY\[([^\[\]]*)\]\)`)
+	// likeOperators are the operators Postgres deparses LIKE and ILIKE to, longest first.
+	likeOperators = strings.NewReplacer(
+		" !~~* ", " NOT ILIKE ",
+		" ~~* ", " ILIKE ",
+		" !~~ ", " NOT LIKE ",
+		" ~~ ", " LIKE ",
+	)
+)
+
+// fromPostgresExpression rewrites the parts of an expression deparsed by Postgres that are
+// not valid Kuneiform into their Kuneiform equivalents.
+func fromPostgresExpression(expr string) string {
+	expr = anyArrayRegex.ReplaceAllStringFunc(expr, func(match string) string {
+		parts := anyArrayRegex.FindStringSubmatch(match)
+		if strings.HasPrefix(parts[1], "<>") {
+			return "NOT IN (" + parts[2] + ")"
+		}
+		return "IN (" + parts[2] + ")"
+	})
+
+	return likeOperators.Replace(expr)
+}
+
+// useExtensionStatement renders the USE statement that initializes an extension.
+func useExtensionStatement(ext *storedExtension) (string, error) {
+	keys := make([]string, 0, len(ext.Metadata))
+	for k := range ext.Metadata {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+
+	var config []string
+	for _, k := range keys {
+		lit, err := valueLiteral(ext.Metadata[k])
+		if err != nil {
+			return "", fmt.Errorf(`parameter "%s": %w`, k, err)
+		}
+		config = append(config, k+": "+lit)
+	}
+
+	stmt := "USE IF NOT EXISTS " + ext.ExtName
+	if len(config) > 0 {
+		stmt += " {" + strings.Join(config, ", ") + "}"
+	}
+
+	return stmt + " AS " + ext.Alias + ";", nil
+}
+
+// valueLiteral renders a value as a Kuneiform literal of the same type.
+// Values whose type cannot be inferred from the literal are cast.
+func valueLiteral(v value) (string, error) {
+	typeStr := strings.ToUpper(v.Type().String())
+	if v.Null() {
+		return "NULL::" + typeStr, nil
+	}
+
+	if arr, ok := v.(arrayValue); ok {
+		elems := make([]string, arr.Len())
+		for i := int32(1); i <= arr.Len(); i++ {
+			el, err := arr.Get(i)
+			if err != nil {
+				return "", err
+			}
+
+			elems[i-1], err = scalarLiteral(el)
+			if err != nil {
+				return "", err
+			}
+		}
+
+		return "ARRAY[" + strings.Join(elems, ", ") + "]::" + typeStr, nil
+	}
+
+	lit, err := scalarLiteral(v)
+	if err != nil {
+		return "", err
+	}
+
+	switch v.(type) {
+	case *decimalValue, *uuidValue:
+		return lit + "::" + typeStr, nil
+	default:
+		return lit, nil
+	}
+}
+
+func scalarLiteral(v value) (string, error) {
+	if v.Null() {
+		return "NULL", nil
+	}
+
+	switch val := v.(type) {
+	case *textValue:
+		// stored values keep the escapes of the literal they were parsed from
+		return "'" + val.Text.String + "'", nil
+	case *int8Value:
+		return strconv.FormatInt(val.Int64, 10), nil
+	case *boolValue:
+		return strings.ToUpper(strconv.FormatBool(val.Bool.Bool)), nil
+	case *uuidValue:
+		return "'" + types.UUID(val.UUID.Bytes).String() + "'", nil
+	case *decimalValue:
+		dec, err := val.dec()
+		if err != nil {
+			return "", err
+		}
+		return dec.String(), nil
+	case *blobValue:
+		return "0x" + hex.EncodeToString(val.bts), nil
+	default:
+		return "", fmt.Errorf("cannot export value of type %s", v.Type())
+	}
+}
@@ -0,0 +1,145 @@
+package interpreter
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"github.com/trufnetwork/kwil-db/core/types"
+	"github.com/trufnetwork/kwil-db/node/engine"
+	"github.com/trufnetwork/kwil-db/node/engine/parse"
+)
+
+func Test_ExportScript(t *testing.T) {
+	res, err := parse.Parse(`CREATE OR REPLACE ACTION get_post($id int) public view returns (body text) {
+		for $row in SELECT body FROM posts WHERE id = $id { return $row.body; }
+	}; CREATE ACTION hash_post($id INT) public { $h := crypto.sha256('x'::bytea); }`)
+	require.NoError(t, err)
+
+	getPost := res[0].(*parse.CreateActionStatement)
+	getPost.OrReplace = false
+	hashPost := res[1].(*parse.CreateActionStatement)
+
+	dec, err := types.ParseDecimalExplicit("1.50", 10, 2)
+	require.NoError(t, err)
+
+	exts := []*storedExtension{
+		{ExtName: "hashes", Alias: "crypto", Metadata: map[string]value{
+			"rounds": makeInt8(3),
+			"salt":   makeText("abc"),
+			"fee":    makeDecimal(dec),
+		}},
+		{ExtName: "other", Alias: "unused"},
+	}
+
+	defaultValue := "'anon'::text"
+	exp := &schemaExport{
+		namespace: "app",
+		tables: []*exportedTable{
+			{
+				name: "posts",
+				columns: []*exportedColumn{
+					{name: "id", dataType: types.IntType},
+					{name: "author", dataType: types.TextType, notNull: true},
+					{name: "status", dataType: types.TextType},
+				},
+				constraints: []*exportedConstraint{
+					{name: "posts_pkey", definition: "PRIMARY KEY (id)", primaryKey: true},
+					{name: "posts_status_check", definition: "CHECK ((status = ANY (ARRAY['draft'::text, 'published'::text])))"},
+					{name: "posts_author_fkey", definition: "FOREIGN KEY (author) REFERENCES users(name) ON DELETE CASCADE", refTable: "users"},
+				},
+				indexes: []*engine.Index{{Name: "posts_status_idx", Columns: []string{"status", "id"}, Type: engine.BTREE}},
+			},
+			{
+				name: "users",
+				columns: []*exportedColumn{
+					{name: "name", dataType: types.TextType, defaultValue: &defaultValue},
+				},
+				constraints: []*exportedConstraint{
+					{name: "users_pkey", definition: "PRIMARY KEY (name)", primaryKey: true},
+					{name: "users_name_check", definition: "CHECK ((name !~~ '%admin%'::text))"},
+				},
+			},
+		},
+		actions:    []*parse.CreateActionStatement{getPost, hashPost},
+		extensions: usedExtensions(exts, []*parse.CreateActionStatement{getPost, hashPost}),
+		roles: []*exportedRole{
+			{name: "default", revoked: []string{"insert"}},
+			{name: "writer", granted: []string{"insert", "update"}, members: []string{"0xabc"}},
+		},
+	}
+
+	script, err := exp.script()
+	require.NoError(t, err)
+
+	require.Equal(t, `CREATE NAMESPACE IF NOT EXISTS app;
+
+USE IF NOT EXISTS hashes {fee: 1.50::NUMERIC(10,2), rounds: 3, salt: 'abc'} AS crypto;
+
+{app}CREATE TABLE users (
+    name TEXT DEFAULT 'anon'::TEXT,
+    PRIMARY KEY (name),
+    CONSTRAINT users_name_check CHECK ((name NOT LIKE '%admin%'::TEXT))
+);
+
+{app}CREATE TABLE posts (
+    id INT8,
+    author TEXT NOT NULL,
+    status TEXT,
+    PRIMARY KEY (id),
+    CONSTRAINT posts_status_check CHECK ((status IN ('draft'::TEXT, 'published'::TEXT))),
+    CONSTRAINT posts_author_fkey FOREIGN KEY (author) REFERENCES users(name) ON DELETE CASCADE
+);
+
+{app}CREATE INDEX posts_status_idx ON posts (status, id);
+
+{app}CREATE ACTION get_post($id INT8) public view returns (body TEXT) {
+    for $row in SELECT body FROM posts WHERE id = $id {
+        return $row.body;
+    }
+};
+
+{app}CREATE ACTION hash_post($id INT8) public {
+    $h := crypto.sha256('x'::BYTEA);
+};
+
+REVOKE IF GRANTED INSERT ON app FROM default;
+
+CREATE ROLE IF NOT EXISTS writer;
+GRANT IF NOT GRANTED INSERT, UPDATE ON app TO writer;
+GRANT IF NOT GRANTED writer TO '0xabc';
+`, script)
+}
+
+func Test_FromPostgresExpression(t *testing.T) {
+	for pg, kuneiform := range map[string]string{
+		"CHECK ((age >= 0))":                               "CHECK ((age >= 0))",
+		"CHECK ((s = ANY (ARRAY['a'::text, 'b'::text])))":  "CHECK ((s IN ('a'::text, 'b'::text)))",
+		"CHECK ((s <> ALL (ARRAY['a'::text, 'b'::text])))": "CHECK ((s NOT IN ('a'::text, 'b'::text)))",
+		"CHECK ((s ~~* 'a%'::text))":                       "CHECK ((s ILIKE 'a%'::text))",
+		"CHECK ((s !~~ 'a%'::text))":                       "CHECK ((s NOT LIKE 'a%'::text))",
+	} {
+		require.Equal(t, kuneiform, fromPostgresExpression(pg))
+	}
+}
+
+func Test_ValueLiteral(t *testing.T) {
+	uuid := types.NewUUIDV5([]byte("a"))
+	arr, err := makeArray([]scalarValue{makeInt8(1), makeInt8(2)}, types.IntArrayType)
+	require.NoError(t, err)
+	null, err := makeNull(types.TextType)
+	require.NoError(t, err)
+
+	for want, v := range map[string]value{
+		"'a'":                           makeText("a"),
+		"-5":                            makeInt8(-5),
+		"TRUE":                          makeBool(true),
+		"0x0102":                        makeBlob([]byte{1, 2}),
+		"'" + uuid.String() + "'::UUID": makeUUID(uuid),
+		"ARRAY[1, 2]::INT8[]":           arr,
+		"NULL::TEXT":                    null,
+	} {
+		got, err := valueLiteral(v)
+		require.NoError(t, err)
+		require.Equal(t, want, got)
+	}
+}
@@ -120,6 +120,7 @@ func initializeExtension(ctx context.Context, svc *common.Service, db sql.DB, i
 	return &namespace{
 		availableFunctions: executables,
 		tables:             make(map[string]*engine.Table),
+		userTypes:          make(map[string]*types.UserType),
 		onDeploy: func(ctx *executionContext) error {
 			return inst.OnUse(ctx.engineCtx, ctx.app())
 		},
@@ -23,6 +23,7 @@ import (
 	"github.com/trufnetwork/kwil-db/node/engine/parse"
 	"github.com/trufnetwork/kwil-db/node/pg"
 	"github.com/trufnetwork/kwil-db/node/types/sql"
+	"github.com/trufnetwork/kwil-db/node/versioning"
 )
 
 // ThreadSafeInterpreter is a thread-safe interpreter.
@@ -78,6 +79,26 @@ func (t *ThreadSafeInterpreter) ExecuteWithoutEngineCtx(ctx context.Context, db
 	return t.Execute(newInvalidEngineCtx(ctx), db, statement, params, fn)
 }
 
+// Checkpoint records the in-memory state of the interpreter, which is kept
+// alongside the database, and returns a function that restores it. It is used
+// when statements that have succeeded are rolled back with an enclosing
+// database transaction, such as the operations of a multi-operation transaction.
+func (t *ThreadSafeInterpreter) Checkpoint() (restore func()) {
+	t.mu.Lock()
+	copied := t.i.copy()
+	invalidations := statementCache.invalidationCount()
+	t.mu.Unlock()
+
+	return func() {
+		t.mu.Lock()
+		defer t.mu.Unlock()
+
+		t.i.apply(copied)
+		t.i.rollbackStatementCache(invalidations)
+		t.i.syncNamespaceManager()
+	}
+}
+
 // recursiveInterpreter is an interpreter that can call itself.
 // It is used for extensions that need to call back into the interpreter.
 type recursiveInterpreter struct {
@@ -141,6 +162,8 @@ type namespace struct {
 	// availableFunctions maps local action names to their execution func.
 	availableFunctions map[string]*executable
 	tables             map[string]*engine.Table
+	// userTypes are the enum and composite types created in the namespace.
+	userTypes map[string]*types.UserType
 
 	// onDeploy is called exactly once when the namespace is deployed.
 	// It is used to set up the namespace.
@@ -164,6 +187,7 @@ func (n *namespace) copy() *namespace {
 	n2 := &namespace{
 		availableFunctions: maps.Clone(n.availableFunctions),
 		tables:             make(map[string]*engine.Table), // we need to copy the tables as well, so shallow copy is not enough
+		userTypes:          maps.Clone(n.userTypes),        // user types are never mutated in place, so a shallow copy is enough
 		onDeploy:           n.onDeploy,
 		onUndeploy:         n.onUndeploy,
 		namespaceType:      n.namespaceType,
@@ -190,6 +214,7 @@ func (n *namespace) copy() *namespace {
 func (n *namespace) apply(n2 *namespace) {
 	n.availableFunctions = n2.availableFunctions
 	n.tables = n2.tables
+	n.userTypes = n2.userTypes
 	n.onDeploy = n2.onDeploy
 	n.onUndeploy = n2.onUndeploy
 	n.namespaceType = n2.namespaceType
@@ -239,6 +264,16 @@ func NewInterpreter(ctx context.Context, db sql.DB, service *common.Service, acc
 		return nil, err
 	}
 
+	err = upgradeSchema(ctx, db)
+	if err != nil {
+		return nil, err
+	}
+
+	err = registerSchedulesHook()
+	if err != nil {
+		return nil, err
+	}
+
 	interpreter := &baseInterpreter{
 		namespaces:        make(map[string]*namespace),
 		service:           service,
@@ -263,6 +298,16 @@ func NewInterpreter(ctx context.Context, db sql.DB, service *common.Service, acc
 			tblMap[tbl.Name] = tbl
 		}
 
+		userTypes, err := listTypesInNamespace(ctx, db, ns.Name)
+		if err != nil {
+			return nil, err
+		}
+
+		typeMap := make(map[string]*types.UserType)
+		for _, ut := range userTypes {
+			typeMap[ut.Name] = ut
+		}
+
 		actions, err := listActionsInBuiltInNamespace(ctx, db, ns.Name)
 		if err != nil {
 			return nil, err
@@ -277,6 +322,7 @@ func NewInterpreter(ctx context.Context, db sql.DB, service *common.Service, acc
 
 		interpreter.namespaces[ns.Name] = &namespace{
 			tables:             tblMap,
+			userTypes:          typeMap,
 			availableFunctions: namespaceFunctions,
 			namespaceType:      ns.Type,
 			onDeploy:           func(ctx *executionContext) error { return nil },
@@ -322,6 +368,7 @@ func NewInterpreter(ctx context.Context, db sql.DB, service *common.Service, acc
 			}
 
 			namespace.tables = existing.tables
+			namespace.userTypes = existing.userTypes
 		}
 
 		interpreter.namespaces[ext.Alias] = namespace
@@ -391,6 +438,22 @@ func initSQLIfNotInitialized(ctx context.Context, db sql.DB) error {
 	return nil
 }
 
+// engineSchemaVersion is the version of the engine schema, which is upgraded
+// from the schema initialized by schemaInitSQL.
+const engineSchemaVersion = 1
+
+// upgradeSchema upgrades the engine schema to engineSchemaVersion. It must be
+// run after initSQLIfNotInitialized, since the upgrades alter the initial
+// schema, whether it was just initialized or created by an earlier version.
+func upgradeSchema(ctx context.Context, db sql.DB) error {
+	upgradeFns := map[int64]versioning.UpgradeFunc{
+		0: func(ctx context.Context, db sql.DB) error { return nil }, // schemaInitSQL
+		1: func(ctx context.Context, db sql.DB) error { return pg.Exec(ctx, db, schemaV1SQL) },
+	}
+
+	return versioning.Upgrade(ctx, db, engine.InternalEnginePGSchema, upgradeFns, engineSchemaVersion)
+}
+
 // newUserDefinedErr makes an error that was returned from user-defined code using the ERROR function.
 func newUserDefinedErr(e error) error {
 	return &userDefinedErr{err: e}
@@ -444,6 +507,12 @@ type baseInterpreter struct {
 	accounts common.Accounts
 	// namespaceRegister is used to register and unregister namespaces
 	namespaceRegister engine.NamespaceRegister
+	// coverage records the statements of actions that are executed.
+	// It is nil unless coverage is being recorded.
+	coverage *Coverage
+	// profile records the time spent executing actions in blocks.
+	// It is nil unless execution profiling is enabled.
+	profile *Profile
 }
 
 // copy deep copies the state of the interpreter.
@@ -462,6 +531,8 @@ func (i *baseInterpreter) copy() *baseInterpreter {
 		service:    i.service,
 		validators: i.validators,
 		accounts:   i.accounts,
+		coverage:   i.coverage,
+		profile:    i.profile,
 	}
 }
 
@@ -514,6 +585,7 @@ func parseAdhoc(statement string) ([]parse.TopLevelStatement, error) {
 // Execute executes a statement against the database.
 func (i *baseInterpreter) execute(ctx *common.EngineContext, db sql.DB, statement string, params map[string]any, fn func(*common.Row) error, toplevel bool) (err error) {
 	copied := i.copy()
+	invalidations := statementCache.invalidationCount()
 	defer func() {
 		noErrOrPanic := true
 		if err != nil {
@@ -530,6 +602,7 @@ func (i *baseInterpreter) execute(ctx *common.EngineContext, db sql.DB, statemen
 		} else {
 			// rollback
 			i.apply(copied)
+			i.rollbackStatementCache(invalidations)
 		}
 	}()
 
@@ -621,6 +694,7 @@ func isValidVarName(s string) error {
 // The resultFn is called with the result of the action, if any.
 func (i *baseInterpreter) call(ctx *common.EngineContext, db sql.DB, namespace, action string, args []any, resultFn func(*common.Row) error, toplevel bool) (callRes *common.CallResult, err error) {
 	copied := i.copy()
+	invalidations := statementCache.invalidationCount()
 	defer func() {
 		// if there is either an error or a panic, then we need
 		// to rollback the interpreter to its previous state.
@@ -644,6 +718,7 @@ func (i *baseInterpreter) call(ctx *common.EngineContext, db sql.DB, namespace,
 		} else {
 			// rollback
 			i.apply(copied)
+			i.rollbackStatementCache(invalidations)
 		}
 	}()
 
@@ -691,7 +766,10 @@ func (i *baseInterpreter) call(ctx *common.EngineContext, db sql.DB, namespace,
 
 	if exec.ExpectedArgs != nil {
 		expect := *exec.ExpectedArgs
-		if len(expect) != len(args) {
+		if len(args) > len(expect) || len(args) < len(expect)-exec.Defaults {
+			if exec.Defaults > 0 {
+				return nil, fmt.Errorf(`%w: action "%s" expected %d to %d arguments, but got %d`, engine.ErrActionInvocation, action, len(expect)-exec.Defaults, len(expect), len(args))
+			}
 			return nil, fmt.Errorf(`%w: action "%s" expected %d arguments, but got %d`, engine.ErrActionInvocation, action, len(expect), len(args))
 		}
 
@@ -717,9 +795,24 @@ func (i *baseInterpreter) call(ctx *common.EngineContext, db sql.DB, namespace,
 		}
 	}
 
+	var trace *types.CallTrace
+	if ctx.Trace {
+		trace = &types.CallTrace{
+			Kind: types.TraceKindCall,
+			Text: namespace + "." + action,
+		}
+		for _, arg := range argVals {
+			trace.Params = append(trace.Params, traceValue(arg))
+		}
+		execCtx.trace = newTracer(trace)
+	}
+
 	err = exec.Func(execCtx, argVals, func(row *row) error {
 		return resultFn(rowToCommonRow(row))
 	})
+	if trace != nil {
+		setTraceErr(trace, err)
+	}
 
 	// if the error is an execution error,
 	// then it should be part of the CallResult,
@@ -729,11 +822,13 @@ func (i *baseInterpreter) call(ctx *common.EngineContext, db sql.DB, namespace,
 		return &common.CallResult{
 			Logs:  *execCtx.logs,
 			Error: err,
+			Trace: trace,
 		}, nil
 	}
 
 	return &common.CallResult{
-		Logs: *execCtx.logs,
+		Logs:  *execCtx.logs,
+		Trace: trace,
 	}, err
 }
 
@@ -776,6 +871,15 @@ func (i *baseInterpreter) newExecCtx(txCtx *common.EngineContext, db sql.DB, nam
 	return e, nil
 }
 
+// rollbackStatementCache clears the statement cache if it was invalidated
+// since the given invalidation count. Statements planned after DDL that is
+// being rolled back would reference objects that no longer exist.
+func (i *baseInterpreter) rollbackStatementCache(invalidations uint64) {
+	if statementCache.invalidationCount() != invalidations {
+		statementCache.clear()
+	}
+}
+
 // syncNamespaceManager syncs all current namespaces with the namespace manager.
 func (i *baseInterpreter) syncNamespaceManager() {
 	i.namespaceRegister.Lock()
@@ -857,6 +961,18 @@ func funcDefToExecutable(funcName string, funcDef *engine.ScalarFunctionDefiniti
 				return newUserDefinedErr(errors.New(msg))
 			}
 
+			if txFunc, ok := txScalarFuncs[funcName]; ok {
+				res, err := txFunc(e, args)
+				if err != nil {
+					return err
+				}
+
+				return fn(&row{
+					columns: []string{funcName},
+					Values:  []value{res},
+				})
+			}
+
 			builtIn, ok := builtInScalarFuncs[funcName]
 			if ok {
 				res, err := builtIn(args)
@@ -1152,6 +1268,15 @@ var builtInScalarFuncs = map[string]scalarFuncImpl{
 
 		return arrVal, nil
 	},
+	// crypto functions do not have Postgres equivalents, so they can only be
+	// used in actions.
+	"keccak256":        keccak256Func,
+	"ecrecover":        ecrecoverFunc,
+	"ed25519_verify":   ed25519VerifyFunc,
+	"secp256k1_verify": secp256k1VerifyFunc,
+	"eth_address":      ethAddressFunc,
+	"merkle_root":      merkleRootFunc,
+	"merkle_verify":    merkleVerifyFunc,
 }
 
 // oneLengthArray makes an array with one element.
@@ -15,6 +15,7 @@ import (
 	"github.com/stretchr/testify/require"
 	"github.com/trufnetwork/kwil-db/common"
 	"github.com/trufnetwork/kwil-db/core/types"
+	"github.com/trufnetwork/kwil-db/extensions/hooks"
 	"github.com/trufnetwork/kwil-db/extensions/precompiles"
 	"github.com/trufnetwork/kwil-db/node/engine"
 	"github.com/trufnetwork/kwil-db/node/engine/interpreter"
@@ -1124,6 +1125,18 @@ func Test_CreateAndDelete(t *testing.T) {
 			drop:   "DROP ACTION act;",
 			verify: "SELECT * FROM info.actions where name = 'act' AND namespace = 'main';",
 		},
+		{
+			name:   "create and drop enum type",
+			create: "CREATE TYPE mood AS ENUM ('sad', 'happy');",
+			drop:   "DROP TYPE mood;",
+			verify: "SELECT * FROM info.types where name = 'mood' AND namespace = 'main';",
+		},
+		{
+			name:   "create and drop composite type",
+			create: "CREATE TYPE point AS (x int, y int);",
+			drop:   "DROP TYPE point;",
+			verify: "SELECT * FROM info.types where name = 'point' AND namespace = 'main';",
+		},
 	}
 
 	db := newTestDB(t, nil, nil)
@@ -2218,6 +2231,112 @@ func Test_Actions(t *testing.T) {
 				{[]*types.Decimal{mustExplicitDecimal("1.00000", 10, 5)}},
 			},
 		},
+		{
+			name: "omitted trailing arguments use defaults",
+			stmt: []string{
+				`CREATE ACTION with_defaults($a int, $b int DEFAULT 2, $c text DEFAULT 'c') public view returns (a int, b int, c text) {
+					RETURN $a, $b, $c;
+				}`,
+			},
+			action: "with_defaults",
+			values: []any{int64(1)},
+			results: [][]any{
+				{int64(1), int64(2), "c"},
+			},
+		},
+		{
+			name: "missing required argument",
+			stmt: []string{
+				`CREATE ACTION with_defaults($a int, $b int DEFAULT 2) public view returns (a int, b int) {
+					RETURN $a, $b;
+				}`,
+			},
+			action: "with_defaults",
+			values: []any{},
+			err:    engine.ErrActionInvocation,
+		},
+		{
+			name: "named arguments between actions",
+			stmt: []string{
+				`CREATE ACTION callee($a int, $b int DEFAULT 2, $c int DEFAULT 3) private view returns (a int, b int, c int) {
+					RETURN $a, $b, $c;
+				}`,
+				`CREATE ACTION caller() public view returns (a int, b int, c int) {
+					$a, $b, $c := callee(1, c => 30);
+					RETURN $a, $b, $c;
+				}`,
+			},
+			action: "caller",
+			results: [][]any{
+				{int64(1), int64(2), int64(30)},
+			},
+		},
+		rawTest("named arguments to a built-in function", `
+		$a := abs(x => 1);
+		`, engine.ErrActionInvocation),
+		{
+			name: "enum column, parameter, and return",
+			stmt: []string{
+				`CREATE TYPE mood AS ENUM ('sad', 'happy');`,
+				`CREATE TABLE diary (id int PRIMARY KEY, feeling mood NOT NULL);`,
+				`CREATE ACTION log_mood($id int, $feeling mood) public returns (feeling mood) {
+					INSERT INTO diary (id, feeling) VALUES ($id, $feeling);
+					for $row in SELECT feeling FROM diary WHERE feeling = 'happy'::mood {
+						RETURN $row.feeling;
+					};
+				}`,
+			},
+			action: "log_mood",
+			values: []any{int64(1), types.Enum{Type: "mood", Label: "happy"}},
+			results: [][]any{
+				{&types.Enum{Type: "mood", Label: "happy"}},
+			},
+		},
+		{
+			name: "invalid enum label",
+			stmt: []string{
+				`CREATE TYPE mood AS ENUM ('sad', 'happy');`,
+				`CREATE ACTION get_mood($feeling mood) public view returns (feeling mood) {
+					RETURN $feeling;
+				}`,
+			},
+			action: "get_mood",
+			values: []any{types.Enum{Type: "mood", Label: "angry"}},
+			err:    engine.ErrCast,
+		},
+		{
+			name: "composite parameter and return",
+			stmt: []string{
+				`CREATE TYPE mood AS ENUM ('sad', 'happy');`,
+				`CREATE TYPE entry AS (id int, note text, feeling mood);`,
+				`CREATE TABLE entries (id int PRIMARY KEY, e entry);`,
+				`CREATE ACTION add_entry($e entry) public returns (e entry) {
+					INSERT INTO entries (id, e) VALUES (1, $e);
+					for $row in SELECT e FROM entries {
+						RETURN $row.e;
+					};
+				}`,
+			},
+			action: "add_entry",
+			values: []any{&types.Composite{
+				Type: "entry",
+				Fields: []*types.CompositeField{
+					{Name: "feeling", Value: types.Enum{Type: "mood", Label: "sad"}},
+					{Name: "note", Value: "rainy (again)"},
+					{Name: "id", Value: int64(7)},
+				},
+			}},
+			results: [][]any{
+				{&types.Composite{
+					Type: "entry",
+					Fields: []*types.CompositeField{
+						{Name: "id", Value: int64(7)},
+						{Name: "note", Value: "rainy (again)"},
+						{Name: "feeling", Value: &types.Enum{Type: "mood", Label: "sad"}},
+					},
+				}},
+			},
+		},
 	}
 
 	db := newTestDB(t, nil, nil)
@@ -3321,6 +3440,127 @@ func Test_NamespaceDropsCache(t *testing.T) {
 	require.NoError(t, err)
 }
 
+// This tests that cached statements are scoped to the action they are executed in.
+// Both actions execute the same SQL, but bind a variable of a different type, so
+// re-using the other action's plan would cast the text argument to an integer.
+func Test_StatementCachePerAction(t *testing.T) {
+	db := newTestDB(t, nil, nil)
+
+	ctx := context.Background()
+	tx, err := db.BeginTx(ctx)
+	require.NoError(t, err)
+	defer tx.Rollback(ctx) // always rollback
+
+	interp := newTestInterp(t, tx, []string{
+		`CREATE ACTION as_int($v int) public view returns (v text) { for $row in SELECT $v::TEXT AS v { return $row.v; } }`,
+		`CREATE ACTION as_text($v text) public view returns (v text) { for $row in SELECT $v::TEXT AS v { return $row.v; } }`,
+	}, false)
+
+	var res []any
+	_, err = interp.CallWithoutEngineCtx(ctx, tx, "", "as_int", []any{1}, func(r *common.Row) error {
+		res = append(res, r.Values...)
+		return nil
+	})
+	require.NoError(t, err)
+
+	_, err = interp.CallWithoutEngineCtx(ctx, tx, "", "as_text", []any{"hello"}, func(r *common.Row) error {
+		res = append(res, r.Values...)
+		return nil
+	})
+	require.NoError(t, err)
+
+	require.Equal(t, []any{"1", "hello"}, res)
+}
+
+// This tests that replacing an action invalidates the statements cached for its namespace.
+// After abs is overwritten by an action, it can no longer be used in SQL.
+func Test_ReplaceActionInvalidatesCache(t *testing.T) {
+	db := newTestDB(t, nil, nil)
+
+	ctx := context.Background()
+	tx, err := db.BeginTx(ctx)
+	require.NoError(t, err)
+	defer tx.Rollback(ctx) // always rollback
+
+	interp := newTestInterp(t, tx, []string{
+		`CREATE ACTION get_abs($v int) public view returns (v int) { for $row in SELECT abs($v) AS v { return $row.v; } }`,
+	}, false)
+
+	res, err := interp.CallWithoutEngineCtx(ctx, tx, "", "get_abs", []any{-1}, nil)
+	require.NoError(t, err)
+	require.NoError(t, res.Error)
+
+	err = interp.ExecuteWithoutEngineCtx(ctx, tx, `CREATE OR REPLACE ACTION abs($v int) public view returns (v int) { return $v; }`, nil, nil)
+	require.NoError(t, err)
+
+	_, err = interp.CallWithoutEngineCtx(ctx, tx, "", "get_abs", []any{-1}, nil)
+	require.Error(t, err)
+}
+
+// This tests that restoring a checkpoint discards the namespaces and actions
+// created by DDL that is rolled back with its database transaction.
+func Test_Checkpoint(t *testing.T) {
+	db := newTestDB(t, nil, nil)
+
+	ctx := context.Background()
+	tx, err := db.BeginTx(ctx)
+	require.NoError(t, err)
+	defer tx.Rollback(ctx) // always rollback
+
+	interp := newTestInterp(t, tx, nil, true)
+
+	restore := interp.Checkpoint()
+
+	tx2, err := tx.BeginTx(ctx)
+	require.NoError(t, err)
+
+	err = interp.ExecuteWithoutEngineCtx(ctx, tx2, `CREATE NAMESPACE test_ns;`, nil, nil)
+	require.NoError(t, err)
+
+	err = interp.ExecuteWithoutEngineCtx(ctx, tx2, `CREATE ACTION smthn() public view returns (v int) { return 1; }`, nil, nil)
+	require.NoError(t, err)
+
+	require.NoError(t, tx2.Rollback(ctx))
+	restore()
+
+	_, err = interp.CallWithoutEngineCtx(ctx, tx, "", "smthn", nil, nil)
+	require.Error(t, err)
+
+	// the namespace can be created again, since it no longer exists in memory
+	err = interp.ExecuteWithoutEngineCtx(ctx, tx, `CREATE NAMESPACE test_ns;`, nil, nil)
+	require.NoError(t, err)
+}
+
+// This tests that actions may not have parameters or returns of arrays of
+// user-defined types, which cannot be passed in action calls.
+func Test_UserTypeArrays(t *testing.T) {
+	db := newTestDB(t, nil, nil)
+
+	ctx := context.Background()
+	tx, err := db.BeginTx(ctx)
+	require.NoError(t, err)
+	defer tx.Rollback(ctx) // always rollback
+
+	interp := newTestInterp(t, tx, nil, true)
+
+	err = interp.ExecuteWithoutEngineCtx(ctx, tx, `CREATE TYPE mood AS ENUM ('sad', 'happy');`, nil, nil)
+	require.NoError(t, err)
+
+	for _, stmt := range []string{
+		`CREATE ACTION log_moods($moods mood[]) public {};`,
+		`CREATE ACTION get_moods() public view returns (moods mood[]) {};`,
+	} {
+		tx2, err := tx.BeginTx(ctx)
+		require.NoError(t, err)
+
+		err = interp.ExecuteWithoutEngineCtx(ctx, tx2, stmt, nil, nil)
+		require.ErrorIs(t, err, engine.ErrType)
+		require.ErrorContains(t, err, `arrays of user-defined type "mood" are not supported`)
+
+		require.NoError(t, tx2.Rollback(ctx))
+	}
+}
+
 // There is a bug where an in-line select (a select used within an action that is not a standalone statement,
 // but rather part of a larger statement) does not work if the SELECT privileges are revoked. This is unexpected,
 // since privileges should not apply within actions.
@@ -3343,3 +3583,327 @@ func Test_RevokedInlineSelectInAction(t *testing.T) {
 	_, err = interp.Call(newEngineCtx("some_caller"), tx, "", "m", nil, func(r *common.Row) error { return nil })
 	require.NoError(t, err)
 }
+
+// This tests that an exported schema can be redeployed, and that exporting
+// the redeployed namespace gives the same script.
+func Test_ExportSchema(t *testing.T) {
+	db := newTestDB(t, nil, nil)
+
+	ctx := context.Background()
+	tx, err := db.BeginTx(ctx)
+	require.NoError(t, err)
+	defer tx.Rollback(ctx) // always rollback
+
+	interp := newTestInterp(t, tx, nil, false)
+
+	err = interp.ExecuteWithoutEngineCtx(ctx, tx, `CREATE NAMESPACE app;
+	{app}CREATE TABLE users (
+		id INT PRIMARY KEY,
+		name TEXT NOT NULL DEFAULT 'anon' CHECK (name NOT LIKE '%admin%'),
+		status TEXT CHECK (status IN ('active', 'banned')),
+		UNIQUE (name)
+	);
+	{app}CREATE TABLE posts (
+		id INT PRIMARY KEY,
+		author INT REFERENCES users(id) ON DELETE CASCADE,
+		body TEXT
+	);
+	{app}CREATE INDEX posts_author_idx ON posts (author);
+	{app}CREATE ACTION get_post($id INT) public view returns (body TEXT) {
+		for $row in SELECT body FROM posts WHERE id = $id {
+			return $row.body;
+		}
+	};
+	CREATE ROLE writer;
+	GRANT INSERT, UPDATE ON app TO writer;
+	GRANT writer TO '0xabc';`, nil, nil)
+	require.NoError(t, err)
+
+	exported, err := interp.ExportSchema(ctx, tx, "app")
+	require.NoError(t, err)
+
+	require.Contains(t, exported, "{app}CREATE TABLE users")
+	require.Contains(t, exported, "{app}CREATE INDEX posts_author_idx ON posts (author);")
+	require.Contains(t, exported, "GRANT IF NOT GRANTED INSERT, UPDATE ON app TO writer;")
+	require.Contains(t, exported, "GRANT IF NOT GRANTED writer TO '0xabc';")
+
+	err = interp.ExecuteWithoutEngineCtx(ctx, tx, `DROP NAMESPACE app;`, nil, nil)
+	require.NoError(t, err)
+
+	err = interp.ExecuteWithoutEngineCtx(ctx, tx, exported, nil, nil)
+	require.NoError(t, err)
+
+	reexported, err := interp.ExportSchema(ctx, tx, "app")
+	require.NoError(t, err)
+	require.Equal(t, exported, reexported)
+
+	_, err = interp.ExportSchema(ctx, tx, "info")
+	require.Error(t, err)
+
+	_, err = interp.ExportSchema(ctx, tx, "missing")
+	require.ErrorIs(t, err, engine.ErrNamespaceNotFound)
+}
+
+// This tests that schedules call their action as their creator every n blocks,
+// that failed calls are rolled back without affecting other schedules, and
+// that only the owner can manage schedules.
+func Test_Schedules(t *testing.T) {
+	db := newTestDB(t, nil, nil)
+
+	ctx := context.Background()
+	tx, err := db.BeginTx(ctx)
+	require.NoError(t, err)
+	defer tx.Rollback(ctx) // always rollback
+
+	interp := newTestInterp(t, tx, []string{
+		`CREATE TABLE ticks (height INT PRIMARY KEY, caller TEXT NOT NULL);`,
+		`CREATE ACTION record($h int) owner public {
+			INSERT INTO ticks (height, caller) VALUES ($h, @caller);
+			notice('tick ' || $h::TEXT);
+		};`,
+		`CREATE ACTION fail() public {
+			INSERT INTO ticks (height, caller) VALUES (-1, @caller);
+			error('boom');
+		};`,
+	}, false)
+
+	// only the owner can create schedules
+	err = interp.Execute(newEngineCtx("user2"), tx, `CREATE SCHEDULE rec EVERY 2 BLOCKS CALL record(@height);`, nil, nil)
+	require.ErrorIs(t, err, engine.ErrDoesNotHavePrivilege)
+
+	err = interp.Execute(newEngineCtx(defaultCaller), tx, `CREATE SCHEDULE rec EVERY 2 BLOCKS CALL record(@height);`, nil, nil)
+	require.NoError(t, err)
+
+	err = interp.Execute(newEngineCtx(defaultCaller), tx, `CREATE SCHEDULE failing EVERY 3 BLOCKS CALL fail();`, nil, nil)
+	require.NoError(t, err)
+
+	err = interp.Execute(newEngineCtx(defaultCaller), tx, `CREATE SCHEDULE IF NOT EXISTS rec EVERY 5 BLOCKS CALL record(1);`, nil, nil)
+	require.NoError(t, err)
+
+	err = interp.Execute(newEngineCtx(defaultCaller), tx, `CREATE SCHEDULE unknown EVERY 5 BLOCKS CALL does_not_exist();`, nil, nil)
+	require.ErrorIs(t, err, engine.ErrUnknownAction)
+
+	var hook hooks.EndBlockHook
+	for _, h := range hooks.ListEndBlockHooks() {
+		if h.Name == "engine_schedules" {
+			hook = h.Hook
+		}
+	}
+	require.NotNil(t, hook)
+
+	// the schedules were created at height 1
+	var results []*types.ScheduleResult
+	for height := int64(2); height <= 6; height++ {
+		block := newEngineCtx(defaultCaller).TxContext.BlockContext
+		block.Height = height
+
+		err = hook(ctx, &common.App{DB: tx, Engine: interp}, block)
+		require.NoError(t, err)
+		results = append(results, block.ScheduleResults...)
+	}
+
+	require.Len(t, results, 3)
+	assert.Equal(t, &types.ScheduleResult{Namespace: "main", Name: "rec", Logs: "tick 3"}, results[0])
+	assert.Equal(t, "failing", results[1].Name)
+	assert.Contains(t, results[1].Error, "boom")
+	assert.Equal(t, &types.ScheduleResult{Namespace: "main", Name: "rec", Logs: "tick 5"}, results[2])
+
+	// the failed call was rolled back
+	var ticks [][]any
+	err = interp.Execute(newEngineCtx(defaultCaller), tx, `SELECT height, caller FROM ticks ORDER BY height;`, nil, func(r *common.Row) error {
+		ticks = append(ticks, r.Values)
+		return nil
+	})
+	require.NoError(t, err)
+	assert.Equal(t, [][]any{{int64(3), defaultCaller}, {int64(5), defaultCaller}}, ticks)
+
+	// only the owner can drop schedules
+	err = interp.Execute(newEngineCtx("user2"), tx, `DROP SCHEDULE rec;`, nil, nil)
+	require.ErrorIs(t, err, engine.ErrDoesNotHavePrivilege)
+
+	err = interp.Execute(newEngineCtx(defaultCaller), tx, `DROP SCHEDULE rec;`, nil, nil)
+	require.NoError(t, err)
+
+	err = interp.Execute(newEngineCtx(defaultCaller), tx, `DROP SCHEDULE IF EXISTS rec;`, nil, nil)
+	require.NoError(t, err)
+
+	err = interp.Execute(newEngineCtx(defaultCaller), tx, `DROP SCHEDULE rec;`, nil, nil)
+	require.ErrorIs(t, err, engine.ErrUnknownSchedule)
+}
+
+// This tests that the scheduled calls of a block are limited in the work they
+// can do together, and that a call that exceeds the limit is rolled back.
+func Test_ScheduleWorkLimit(t *testing.T) {
+	db := newTestDB(t, nil, nil)
+
+	ctx := context.Background()
+	tx, err := db.BeginTx(ctx)
+	require.NoError(t, err)
+	defer tx.Rollback(ctx) // always rollback
+
+	interp := newTestInterp(t, tx, []string{
+		`CREATE TABLE ticks (height INT PRIMARY KEY);`,
+		`CREATE ACTION spin($h int) public {
+			INSERT INTO ticks (height) VALUES ($h);
+			for $i in 1..2000000 { }
+		};`,
+		`CREATE ACTION record($h int) public {
+			INSERT INTO ticks (height) VALUES ($h + 1000);
+		};`,
+	}, false)
+
+	err = interp.Execute(newEngineCtx(defaultCaller), tx, `CREATE SCHEDULE a_spin EVERY 1 BLOCKS CALL spin(@height);`, nil, nil)
+	require.NoError(t, err)
+	err = interp.Execute(newEngineCtx(defaultCaller), tx, `CREATE SCHEDULE b_record EVERY 1 BLOCKS CALL record(@height);`, nil, nil)
+	require.NoError(t, err)
+
+	var hook hooks.EndBlockHook
+	for _, h := range hooks.ListEndBlockHooks() {
+		if h.Name == "engine_schedules" {
+			hook = h.Hook
+		}
+	}
+	require.NotNil(t, hook)
+
+	block := newEngineCtx(defaultCaller).TxContext.BlockContext
+	block.Height = 2
+	err = hook(ctx, &common.App{DB: tx, Engine: interp}, block)
+	require.NoError(t, err)
+
+	// the spinning call used up the work of the block, so the call after it
+	// also failed
+	require.Len(t, block.ScheduleResults, 2)
+	assert.Contains(t, block.ScheduleResults[0].Error, engine.ErrScheduleWorkLimit.Error())
+	assert.Contains(t, block.ScheduleResults[1].Error, engine.ErrScheduleWorkLimit.Error())
+
+	var count int64
+	err = interp.Execute(newEngineCtx(defaultCaller), tx, `SELECT count(*) FROM ticks;`, nil, func(r *common.Row) error {
+		count = r.Values[0].(int64)
+		return nil
+	})
+	require.NoError(t, err)
+	assert.Zero(t, count)
+}
+
+// This tests that a traced call records the statements, assignments, SQL and
+// nested action calls that it executes.
+func Test_Trace(t *testing.T) {
+	db := newTestDB(t, nil, nil)
+
+	ctx := context.Background()
+	tx, err := db.BeginTx(ctx)
+	require.NoError(t, err)
+	defer tx.Rollback(ctx) // always rollback
+
+	interp := newTestInterp(t, tx, []string{
+		`CREATE TABLE items (id INT PRIMARY KEY);`,
+		`CREATE ACTION add_item($id int) private {
+			INSERT INTO items (id) VALUES ($id);
+		}`,
+		`CREATE ACTION act($a int) public {
+			$b := $a + 1;
+			add_item($b);
+		}`,
+	}, false)
+
+	engCtx := newEngineCtx(defaultCaller)
+	engCtx.Trace = true
+	res, err := interp.Call(engCtx, tx, "", "act", []any{1}, nil)
+	require.NoError(t, err)
+	require.NoError(t, res.Error)
+	require.NotNil(t, res.Trace)
+
+	root := res.Trace
+	assert.Equal(t, types.TraceKindCall, root.Kind)
+	assert.Equal(t, "main.act", root.Text)
+	assert.Equal(t, []string{"1"}, root.Params)
+
+	var stmts []*types.CallTrace
+	for _, child := range root.Children {
+		if child.Kind == types.TraceKindStatement {
+			stmts = append(stmts, child)
+		}
+	}
+	require.Len(t, stmts, 2)
+
+	assert.Equal(t, 2, stmts[0].Line)
+	assert.Equal(t, "$b := $a + 1;", stmts[0].Text)
+	require.Len(t, stmts[0].Children, 1)
+	assert.Equal(t, "$b = 2", stmts[0].Children[0].Text)
+
+	assert.Equal(t, 3, stmts[1].Line)
+	require.Len(t, stmts[1].Children, 1)
+	call := stmts[1].Children[0]
+	assert.Equal(t, types.TraceKindCall, call.Kind)
+	assert.Equal(t, "main.add_item", call.Text)
+	assert.Equal(t, []string{"2"}, call.Params)
+
+	var sqlEvent *types.CallTrace
+	for _, stmt := range call.Children {
+		for _, child := range stmt.Children {
+			if child.Kind == types.TraceKindSQL {
+				sqlEvent = child
+			}
+		}
+	}
+	require.NotNil(t, sqlEvent)
+	assert.Equal(t, []string{"$id = 2"}, sqlEvent.Params)
+	require.NotNil(t, sqlEvent.RowsAffected)
+	assert.EqualValues(t, 1, *sqlEvent.RowsAffected)
+
+	// calls are not traced unless requested
+	res, err = interp.Call(newEngineCtx(defaultCaller), tx, "", "act", []any{5}, nil)
+	require.NoError(t, err)
+	assert.Nil(t, res.Trace)
+}
+
+// This tests that the actions and statements executed in a read-write
+// transaction are profiled, and that read-only calls are not.
+func Test_ExecProfile(t *testing.T) {
+	db := newTestDB(t, nil, nil)
+
+	ctx := context.Background()
+	tx, err := db.BeginTx(ctx)
+	require.NoError(t, err)
+	defer tx.Rollback(ctx) // always rollback
+
+	interp := newTestInterp(t, tx, []string{
+		`CREATE TABLE items (id INT PRIMARY KEY);`,
+		`CREATE ACTION add_items($n int) public {
+			for $i in 1..$n {
+				INSERT INTO items (id) VALUES ($i);
+			}
+		}`,
+		`CREATE ACTION get_one() public view returns (n int) {
+			return 1;
+		}`,
+	}, false)
+
+	profile := interpreter.NewProfile()
+	interp.SetProfile(profile)
+
+	_, err = interp.Call(newEngineCtx(defaultCaller), tx, "", "add_items", []any{3}, nil)
+	require.NoError(t, err)
+
+	readTx, err := db.BeginReadTx(ctx)
+	require.NoError(t, err)
+	defer readTx.Rollback(ctx)
+	_, err = interp.Call(newEngineCtx(defaultCaller), readTx, "", "get_one", nil, nil)
+	require.NoError(t, err)
+
+	top := profile.Top(0, "")
+	require.Len(t, top.Actions, 1)
+	assert.Equal(t, "add_items", top.Actions[0].Action)
+	assert.EqualValues(t, 1, top.Actions[0].Calls)
+	assert.EqualValues(t, 3, top.Actions[0].Rows)
+
+	require.Len(t, top.Statements, 2)
+	// the loop includes the statement it runs, so it is the slowest
+	assert.Equal(t, 2, top.Statements[0].Line)
+	assert.EqualValues(t, 1, top.Statements[0].Calls)
+	assert.EqualValues(t, 3, top.Statements[0].Rows)
+	assert.Equal(t, 3, top.Statements[1].Line)
+	assert.Equal(t, "INSERT INTO items (id) VALUES ($i);", top.Statements[1].Statement)
+	assert.EqualValues(t, 3, top.Statements[1].Calls)
+	assert.EqualValues(t, 3, top.Statements[1].Rows)
+}
@@ -0,0 +1,100 @@
+package interpreter
+
+import (
+	"bytes"
+	"fmt"
+	"slices"
+
+	"github.com/trufnetwork/kwil-db/core/types"
+	"github.com/trufnetwork/kwil-db/node/engine"
+	"golang.org/x/crypto/sha3"
+)
+
+// The Merkle functions follow the OpenZeppelin standard Merkle tree, which is
+// what the ERC20 bridge uses to distribute rewards, so roots and proofs can be
+// checked against the MerkleProof library on chain.
+
+// merkleNodeLen is the length of a leaf hash or node of a Merkle tree.
+const merkleNodeLen = 32
+
+// merkleNodes returns the nodes in a bytea array. It returns an error if any
+// node is null or is not a 32 byte hash.
+func merkleNodes(fn string, arr arrayValue) ([][]byte, error) {
+	nodes := make([][]byte, arr.Len())
+	for i := range arr.Len() {
+		v, err := arr.Get(i + 1)
+		if err != nil {
+			return nil, err
+		}
+		if v.Null() {
+			return nil, fmt.Errorf("%w: %s does not accept null nodes", engine.ErrInvalidArgument, fn)
+		}
+
+		node := v.RawValue().([]byte)
+		if len(node) != merkleNodeLen {
+			return nil, fmt.Errorf("%w: %s expects %d byte nodes, got %d bytes", engine.ErrInvalidArgument, fn, merkleNodeLen, len(node))
+		}
+		nodes[i] = node
+	}
+
+	return nodes, nil
+}
+
+// hashMerklePair hashes two nodes in sorted order.
+func hashMerklePair(a, b []byte) []byte {
+	if bytes.Compare(a, b) > 0 {
+		a, b = b, a
+	}
+
+	h := sha3.NewLegacyKeccak256()
+	h.Write(a)
+	h.Write(b)
+	return h.Sum(nil)
+}
+
+func merkleRootFunc(args []value) (value, error) {
+	if args[0].Null() {
+		return makeNull(types.ByteaType)
+	}
+
+	leaves, err := merkleNodes("merkle_root", args[0].(arrayValue))
+	if err != nil {
+		return nil, err
+	}
+	if len(leaves) == 0 {
+		return nil, fmt.Errorf("%w: merkle_root expects at least one leaf", engine.ErrInvalidArgument)
+	}
+
+	slices.SortFunc(leaves, bytes.Compare)
+
+	// the tree is stored as an array, where the children of node i are nodes
+	// 2i+1 and 2i+2, and the leaves fill the end of the array in reverse order
+	tree := make([][]byte, 2*len(leaves)-1)
+	for i, leaf := range leaves {
+		tree[len(tree)-1-i] = leaf
+	}
+	for i := len(tree) - 1 - len(leaves); i >= 0; i-- {
+		tree[i] = hashMerklePair(tree[2*i+1], tree[2*i+2])
+	}
+
+	return makeBlob(tree[0]), nil
+}
+
+func merkleVerifyFunc(args []value) (value, error) {
+	if args[0].Null() || args[1].Null() || args[2].Null() {
+		return makeNull(types.BoolType)
+	}
+
+	root, leaf := args[0].RawValue().([]byte), args[1].RawValue().([]byte)
+	proof, err := merkleNodes("merkle_verify", args[2].(arrayValue))
+	if err != nil {
+		return nil, err
+	}
+
+	node := leaf
+	for _, sibling := range proof {
+		node = hashMerklePair(node, sibling)
+	}
+
+	return makeBool(bytes.Equal(node, root)), nil
+}
@@ -0,0 +1,77 @@
+package interpreter
+
+import (
+	"math/big"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/trufnetwork/kwil-db/node/engine"
+	"github.com/trufnetwork/kwil-db/node/exts/erc20-bridge/utils"
+)
+
+func Test_MerkleFuncs(t *testing.T) {
+	call := func(name string, args ...value) (any, error) {
+		res, err := builtInScalarFuncs[name](args)
+		if err != nil {
+			return nil, err
+		}
+		return res.RawValue(), nil
+	}
+
+	// the functions must agree with the trees of the reward distributor
+	users := []string{
+		"0x1111111111111111111111111111111111111111",
+		"0x2222222222222222222222222222222222222222",
+		"0x3333333333333333333333333333333333333333",
+		"0x4444444444444444444444444444444444444444",
+		"0x5555555555555555555555555555555555555555",
+	}
+	amounts := []*big.Int{big.NewInt(1), big.NewInt(2), big.NewInt(3), big.NewInt(4), big.NewInt(5)}
+	contract := "0x6666666666666666666666666666666666666666"
+	dump, root, err := utils.GenRewardMerkleTree(users, amounts, contract, [32]byte{7})
+	require.NoError(t, err)
+
+	leaves := make([][]byte, len(users))
+	proofs := make([][][]byte, len(users))
+	for i, user := range users {
+		_, proofs[i], leaves[i], _, _, err = utils.GetMTreeProof(dump, user)
+		require.NoError(t, err)
+	}
+
+	res, err := call("merkle_root", newBlobArrayValue(leaves))
+	require.NoError(t, err)
+	assert.Equal(t, root, res)
+
+	// the order of the leaves does not matter
+	reversed := [][]byte{leaves[4], leaves[3], leaves[2], leaves[1], leaves[0]}
+	res, err = call("merkle_root", newBlobArrayValue(reversed))
+	require.NoError(t, err)
+	assert.Equal(t, root, res)
+
+	// a single leaf is its own root
+	res, err = call("merkle_root", newBlobArrayValue(leaves[:1]))
+	require.NoError(t, err)
+	assert.Equal(t, leaves[0], res)
+
+	for i := range users {
+		res, err = call("merkle_verify", makeBlob(root), makeBlob(leaves[i]), newBlobArrayValue(proofs[i]))
+		require.NoError(t, err)
+		assert.Equal(t, true, res)
+
+		// a proof only proves its own leaf
+		other := leaves[(i+1)%len(leaves)]
+		res, err = call("merkle_verify", makeBlob(root), makeBlob(other), newBlobArrayValue(proofs[i]))
+		require.NoError(t, err)
+		assert.Equal(t, false, res)
+	}
+
+	_, err = call("merkle_root", newBlobArrayValue(nil))
+	assert.ErrorIs(t, err, engine.ErrInvalidArgument)
+	_, err = call("merkle_root", newBlobArrayValue([][]byte{[]byte("short")}))
+	assert.ErrorIs(t, err, engine.ErrInvalidArgument)
+	_, err = call("merkle_root", newBlobArrayValue([][]byte{leaves[0], nil}))
+	assert.ErrorIs(t, err, engine.ErrInvalidArgument)
+	_, err = call("merkle_verify", makeBlob(root), makeBlob(leaves[0]), newBlobArrayValue([][]byte{[]byte("short")}))
+	assert.ErrorIs(t, err, engine.ErrInvalidArgument)
+}
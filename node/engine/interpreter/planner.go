@@ -6,6 +6,7 @@ package interpreter
 import (
 	"errors"
 	"fmt"
+	"slices"
 	"strings"
 
 	"github.com/trufnetwork/kwil-db/core/types"
@@ -17,31 +18,76 @@ import (
 
 // makeActionToExecutable creates an executable from an action
 func makeActionToExecutable(namespace string, act *action) *executable {
-	planner := &interpreterPlanner{}
+	planner := &interpreterPlanner{
+		source: &actionSource{
+			namespace: namespace,
+			name:      act.Name,
+			raw:       act.RawStatement,
+			startLine: act.startLine,
+		},
+	}
 	stmtFns := make([]stmtFunc, len(act.Body))
 	for j, stmt := range act.Body {
-		stmtFns[j] = stmt.Accept(planner).(stmtFunc)
+		stmtFns[j] = planner.planActionStmt(stmt)
 	}
 
 	var expectedArgs []*types.DataType
+	var paramNames []string
 	for _, p := range act.Parameters {
 		expectedArgs = append(expectedArgs, p.Type)
+		paramNames = append(paramNames, p.Name)
 	}
 
-	validateArgs := func(v []value) ([]value, error) {
-		newVal := make([]value, len(v))
-		if len(v) != len(act.Parameters) {
-			return nil, fmt.Errorf("expected %d arguments, got %d", len(act.Parameters), len(v))
+	defaults := make([]exprFunc, len(act.Parameters))
+	numDefaults := 0
+	for j, d := range act.Defaults {
+		if d != nil {
+			defaults[j] = d.Accept(planner).(exprFunc)
+			numDefaults++
 		}
+	}
 
-		for i, arg := range v {
-			if !act.Parameters[i].Type.Equals(arg.Type()) {
-				return nil, fmt.Errorf("%w: expected argument %d to be %s, got %s", engine.ErrType, i+1, act.Parameters[i].Type, arg.Type())
+	// validateArgs checks the arguments against the parameters. Omitted
+	// arguments, either trailing or left nil by named arguments, are
+	// replaced by the parameter's default value.
+	validateArgs := func(exec *executionContext, v []value) ([]value, error) {
+		if len(v) > len(act.Parameters) {
+			return nil, fmt.Errorf("expected at most %d arguments, got %d", len(act.Parameters), len(v))
+		}
+
+		newVal := make([]value, len(act.Parameters))
+		for i, param := range act.Parameters {
+			var arg value
+			if i < len(v) {
+				arg = v[i]
 			}
 
 			var err error
+			if arg == nil {
+				if defaults[i] == nil {
+					return nil, fmt.Errorf("%w: missing argument for parameter %s", engine.ErrActionInvocation, param.Name)
+				}
+
+				arg, err = defaults[i](exec)
+				if err != nil {
+					return nil, err
+				}
+
+				// defaults are constants, so they are cast to the parameter
+				// type rather than requiring an exact type match
+				arg, err = exec.castValue(namespace, arg, param.Type)
+				if err != nil {
+					return nil, fmt.Errorf("%w: default value of parameter %s: %w", engine.ErrType, param.Name, err)
+				}
+			}
+
+			// user-defined types can also be passed as their text representation
+			if !param.Type.Equals(arg.Type()) && !(param.Type.IsUserDefined() && arg.Type().Equals(types.TextType)) {
+				return nil, fmt.Errorf("%w: expected argument %d to be %s, got %s", engine.ErrType, i+1, param.Type, arg.Type())
+			}
+
 			// type cast, in case of precision and scale or nulls
-			newVal[i], err = arg.Cast(act.Parameters[i].Type)
+			newVal[i], err = exec.castValue(namespace, arg, param.Type)
 			if err != nil {
 				return nil, err
 			}
@@ -53,13 +99,16 @@ func makeActionToExecutable(namespace string, act *action) *executable {
 	return &executable{
 		Name:         act.Name,
 		ExpectedArgs: &expectedArgs,
+		Parameters:   paramNames,
+		Defaults:     numDefaults,
+		source:       planner.source,
 		Func: func(exec *executionContext, args []value, fn resultFunc) error {
 			if err := exec.canExecute(namespace, act.Name, act.Modifiers); err != nil {
 				return err
 			}
 
 			// validate the args
-			args, err := validateArgs(args)
+			args, err := validateArgs(exec, args)
 			if err != nil {
 				return err
 			}
@@ -79,66 +128,133 @@ func makeActionToExecutable(namespace string, act *action) *executable {
 			}
 
 			exec2 := exec.subscope(namespace)
+			exec2.action = act.Name
 
-			for j, param := range act.Parameters {
-				err = exec2.allocateVariable(param.Name, args[j])
-				if err != nil {
-					return err
+			run := func() error {
+				for j, param := range act.Parameters {
+					err = exec2.allocateVariable(param.Name, args[j])
+					if err != nil {
+						return err
+					}
 				}
-			}
 
-			// execute the statements
-			for _, stmt := range stmtFns {
-				err := stmt(exec2, func(row *row) error {
-					row.columns = returnColNames
+				// execute the statements
+				for _, stmt := range stmtFns {
+					err := stmt(exec2, func(row *row) error {
+						row.columns = returnColNames
 
-					// we will ensure that the return values match the expected return types
-					if len(row.Values) != len(expectedReturnTypes) {
-						return fmt.Errorf("%w: expected %d return values, got %d", engine.ErrReturnShape, len(expectedReturnTypes), len(row.Values))
-					}
+						// we will ensure that the return values match the expected return types
+						if len(row.Values) != len(expectedReturnTypes) {
+							return fmt.Errorf("%w: expected %d return values, got %d", engine.ErrReturnShape, len(expectedReturnTypes), len(row.Values))
+						}
 
-					// we will iterate over and check it is of the correct type.
-					// We will also type cast it to the correct type, to ensure we maintain precision and scale,
-					// and account for any nulls
-					for i, val := range row.Values {
-						// only equals, not equals strict, because we want to accept
-						// nulls.
-						if !val.Type().Equals(expectedReturnTypes[i]) {
-							return fmt.Errorf("%w: expected return value %d to be %s, got %s", engine.ErrType, i+1, expectedReturnTypes[i], val.Type())
+						// we will iterate over and check it is of the correct type.
+						// We will also type cast it to the correct type, to ensure we maintain precision and scale,
+						// and account for any nulls
+						for i, val := range row.Values {
+							// only equals, not equals strict, because we want to accept
+							// nulls.
+							if !val.Type().Equals(expectedReturnTypes[i]) {
+								return fmt.Errorf("%w: expected return value %d to be %s, got %s", engine.ErrType, i+1, expectedReturnTypes[i], val.Type())
+							}
+
+							row.Values[i], err = exec2.castValue(namespace, val, expectedReturnTypes[i])
+							if err != nil {
+								return err
+							}
 						}
 
-						row.Values[i], err = val.Cast(expectedReturnTypes[i])
+						err := fn(row)
 						if err != nil {
 							return err
 						}
-					}
 
-					err := fn(row)
-					if err != nil {
+						return nil
+					})
+					switch err {
+					case nil:
+						// do nothing
+					case errReturn:
+						// the procedure is done, exit early
+						return nil
+					default:
 						return err
 					}
-
-					return nil
-				})
-				switch err {
-				case nil:
-					// do nothing
-				case errReturn:
-					// the procedure is done, exit early
-					return nil
-				default:
-					return err
 				}
+
+				return nil
+			}
+			if !exec2.profiling() {
+				return run()
 			}
 
-			return nil
+			dur, rows, err := exec2.profile(run)
+			exec2.interpreter.profile.recordAction(exec2.engineCtx.TxContext.Ctx, namespace, act.Name, dur, rows)
+			return err
 		},
 		Type: executableTypeAction,
 	}
 }
 
 // interpreterPlanner creates functions for running Kuneiform logic.
-type interpreterPlanner struct{}
+type interpreterPlanner struct {
+	// source is set when planning the body of an action. It is used to
+	// record which of the action's statements are executed.
+	source *actionSource
+}
+
+// planActionStmt plans a statement of the body of an action. If the execution
+// is metered, the statement is charged to its meter. If coverage is being
+// recorded, the statement records its line when it is executed. If the
+// execution is being traced or profiled, the statement is recorded in the
+// trace or profile.
+func (i *interpreterPlanner) planActionStmt(stmt parse.ActionStmt) stmtFunc {
+	stmtFn := meteredStmt(stmt.Accept(i).(stmtFunc))
+	if i.source == nil {
+		return stmtFn
+	}
+
+	line := i.source.relativeLine(stmt.GetPosition().StartLine)
+	if line <= 0 {
+		return stmtFn
+	}
+	if !slices.Contains(i.source.lines, line) {
+		i.source.lines = append(i.source.lines, line)
+	}
+
+	source := i.source
+	var text string
+	if lines := strings.Split(source.raw, "\n"); line <= len(lines) {
+		text = strings.TrimSpace(lines[line-1])
+	}
+	traced := func(exec *executionContext, fn resultFunc) error {
+		if exec.trace == nil {
+			return stmtFn(exec, fn)
+		}
+
+		end := exec.trace.begin(&types.CallTrace{
+			Kind: types.TraceKindStatement,
+			Text: text,
+			Line: line,
+		})
+		err := stmtFn(exec, fn)
+		end(err)
+		return err
+	}
+
+	return func(exec *executionContext, fn resultFunc) error {
+		if exec.interpreter != nil && exec.interpreter.coverage != nil {
+			exec.interpreter.coverage.hit(source, line)
+		}
+		if !exec.profiling() {
+			return traced(exec, fn)
+		}
+
+		dur, rows, err := exec.profile(func() error { return traced(exec, fn) })
+		exec.interpreter.profile.recordStatement(exec.engineCtx.TxContext.Ctx, source, line, text, dur, rows)
+		return err
+	}
+}
 
 var (
 
@@ -460,7 +576,13 @@ func (i *interpreterPlanner) VisitActionStmtCall(p0 *parse.ActionStmtCall) any {
 			vals[j] = val
 		}
 
+		vals, err = bindArgs(funcDef, p0.Call.ArgNames, vals)
+		if err != nil {
+			return err
+		}
+
 		iter := 0
+		endTrace := exec.traceCall(p0.Call.Namespace, funcDef, vals)
 		err = funcDef.Func(exec, vals, func(row *row) error {
 			// if there are receivers and this returns more than 1 value, we should return an error.
 			if iter > 0 && len(receivers) > 0 {
@@ -486,6 +608,7 @@ func (i *interpreterPlanner) VisitActionStmtCall(p0 *parse.ActionStmtCall) any {
 
 			return nil
 		})
+		endTrace(err)
 		if err != nil {
 			return err
 		}
@@ -519,13 +642,18 @@ func executeBlock(exec *executionContext, fn resultFunc,
 func (i *interpreterPlanner) VisitActionStmtForLoop(p0 *parse.ActionStmtForLoop) any {
 	stmtFns := make([]stmtFunc, len(p0.Body))
 	for j, stmt := range p0.Body {
-		stmtFns[j] = stmt.Accept(i).(stmtFunc)
+		stmtFns[j] = i.planActionStmt(stmt)
 	}
 
 	loopFn := p0.LoopTerm.Accept(i).(loopTermFunc)
 
 	return stmtFunc(func(exec *executionContext, fn resultFunc) error {
 		err := loopFn(exec, func(term value) error {
+			// an iteration is charged even if the body is empty
+			if err := exec.meter.use(1); err != nil {
+				return err
+			}
+
 			exec.scope.child()
 			defer exec.scope.popScope()
 			err := exec.allocateVariable(p0.Receiver.Name, term)
@@ -635,6 +763,7 @@ func (i *interpreterPlanner) VisitLoopTermExpression(p0 *parse.LoopTermExpressio
 				vals[j] = val
 			}
 
+			endTrace := exec.traceCall(functionCall.Namespace, funcDef, vals)
 			err = funcDef.Func(exec, vals, func(row *row) error {
 				rec, err := row.record()
 				if err != nil {
@@ -643,6 +772,7 @@ func (i *interpreterPlanner) VisitLoopTermExpression(p0 *parse.LoopTermExpressio
 
 				return handleLoopTermErr(fn(rec))
 			})
+			endTrace(err)
 			if err != nil {
 				return err
 			}
@@ -715,7 +845,7 @@ func (i *interpreterPlanner) VisitActionStmtIf(p0 *parse.ActionStmtIf) any {
 		ifFn := ifThen.If.Accept(i).(exprFunc)
 		var thenFns []stmtFunc
 		for _, stmt := range ifThen.Then {
-			thenFns = append(thenFns, stmt.Accept(i).(stmtFunc))
+			thenFns = append(thenFns, i.planActionStmt(stmt))
 		}
 
 		ifThenFns = append(ifThenFns, struct {
@@ -730,7 +860,7 @@ func (i *interpreterPlanner) VisitActionStmtIf(p0 *parse.ActionStmtIf) any {
 	var elseFns []stmtFunc
 	if p0.Else != nil {
 		for _, stmt := range p0.Else {
-			elseFns = append(elseFns, stmt.Accept(i).(stmtFunc))
+			elseFns = append(elseFns, i.planActionStmt(stmt))
 		}
 	}
 
@@ -905,7 +1035,7 @@ func cast(t parse.Typecasted, s exprFunc) exprFunc {
 			return nil, err
 		}
 
-		return val.Cast(t.GetTypeCast())
+		return exec.castValue(exec.scope.namespace, val, t.GetTypeCast())
 	})
 }
 
@@ -947,8 +1077,14 @@ func (i *interpreterPlanner) VisitExpressionFunctionCall(p0 *parse.ExpressionFun
 			vals[j] = val
 		}
 
+		vals, err = bindArgs(execute, p0.ArgNames, vals)
+		if err != nil {
+			return nil, err
+		}
+
 		var val value
 		iters := 0
+		endTrace := exec.traceCall(p0.Namespace, execute, vals)
 		err = execute.Func(exec, vals, func(received *row) error {
 			iters++
 			if len(received.Values) != 1 {
@@ -959,6 +1095,7 @@ func (i *interpreterPlanner) VisitExpressionFunctionCall(p0 *parse.ExpressionFun
 
 			return nil
 		})
+		endTrace(err)
 		if err != nil {
 			return nil, err
 		}
@@ -1628,6 +1765,12 @@ func (i *interpreterPlanner) VisitCreateTableStatement(p0 *parse.CreateTableStat
 			return err
 		}
 
+		for _, col := range p0.Columns {
+			if err := exec.checkType(col.Type); err != nil {
+				return err
+			}
+		}
+
 		err = genAndExec(exec, p0)
 		if err != nil {
 			return err
@@ -1855,6 +1998,8 @@ func (i *interpreterPlanner) VisitUnuseExtensionStatement(p0 *parse.UnuseExtensi
 		delete(exec.interpreter.namespaces, p0.Alias)
 		exec.interpreter.accessController.unregisterNamespace(p0.Alias)
 
+		statementCache.clear()
+
 		return nil
 	})
 }
@@ -1902,6 +2047,19 @@ func (i *interpreterPlanner) VisitCreateActionStatement(p0 *parse.CreateActionSt
 			return err
 		}
 
+		for _, param := range act.Parameters {
+			if err := exec.checkType(param.Type); err != nil {
+				return err
+			}
+		}
+		if act.Returns != nil {
+			for _, field := range act.Returns.Fields {
+				if err := exec.checkType(field.Type); err != nil {
+					return err
+				}
+			}
+		}
+
 		err = storeAction(exec.engineCtx.TxContext.Ctx, exec.db, exec.scope.namespace, &act, false)
 		if err != nil {
 			return err
@@ -1909,6 +2067,13 @@ func (i *interpreterPlanner) VisitCreateActionStatement(p0 *parse.CreateActionSt
 
 		execute := makeActionToExecutable(exec.scope.namespace, &act)
 		namespace.availableFunctions[p0.Name] = execute
+		if exec.interpreter.coverage != nil {
+			exec.interpreter.coverage.register(execute.source)
+		}
+
+		// statements planned in this namespace might have referenced a function
+		// that this action now replaces, so they need to be re-planned.
+		statementCache.invalidateNamespace(exec.scope.namespace)
 
 		return nil
 	})
@@ -1976,10 +2141,179 @@ func (i *interpreterPlanner) VisitDropActionStatement(p0 *parse.DropActionStatem
 			}
 		}
 
+		statementCache.invalidateNamespace(exec.scope.namespace)
+
 		return nil
 	})
 }
 
+func (i *interpreterPlanner) VisitCreateTypeStatement(p0 *parse.CreateTypeStatement) any {
+	return stmtFunc(func(exec *executionContext, fn resultFunc) error {
+		reset, err := handleNamespaced(exec, p0)
+		if err != nil {
+			return err
+		}
+		defer reset()
+
+		if err := exec.checkNamespaceMutatbility(); err != nil {
+			return err
+		}
+
+		if err := exec.checkPrivilege(_CREATE_PRIVILEGE); err != nil {
+			return err
+		}
+
+		namespace := exec.interpreter.namespaces[exec.scope.namespace]
+		if _, exists := namespace.userTypes[p0.Name]; exists {
+			if p0.IfNotExists {
+				return nil
+			}
+
+			return fmt.Errorf(`type "%s" already exists`, p0.Name)
+		}
+
+		for _, field := range p0.Fields {
+			if field.Type.IsArray {
+				return fmt.Errorf(`%w: field "%s" of type "%s" cannot be an array`, engine.ErrType, field.Name, p0.Name)
+			}
+			if err := exec.checkType(field.Type); err != nil {
+				return err
+			}
+		}
+
+		if err := genAndExec(exec, p0); err != nil {
+			return err
+		}
+
+		return exec.reloadNamespaceCache()
+	})
+}
+
+func (i *interpreterPlanner) VisitDropTypeStatement(p0 *parse.DropTypeStatement) any {
+	return stmtFunc(func(exec *executionContext, fn resultFunc) error {
+		reset, err := handleNamespaced(exec, p0)
+		if err != nil {
+			return err
+		}
+		defer reset()
+
+		if err := exec.checkNamespaceMutatbility(); err != nil {
+			return err
+		}
+
+		if err := exec.checkPrivilege(_DROP_PRIVILEGE); err != nil {
+			return err
+		}
+
+		namespace := exec.interpreter.namespaces[exec.scope.namespace]
+		if _, exists := namespace.userTypes[p0.Name]; !exists {
+			if p0.IfExists {
+				return nil
+			}
+
+			return fmt.Errorf(`%w: "%s"`, engine.ErrUnknownType, p0.Name)
+		}
+
+		// Postgres refuses to drop types used by tables and other types,
+		// but it does not know about actions.
+		actions, err := actionsUsingType(exec.engineCtx.TxContext.Ctx, exec.db, exec.scope.namespace, p0.Name)
+		if err != nil {
+			return err
+		}
+		if len(actions) > 0 {
+			return fmt.Errorf(`cannot drop type "%s" because it is used by actions: %s`, p0.Name, strings.Join(actions, ", "))
+		}
+
+		if err := genAndExec(exec, p0); err != nil {
+			return err
+		}
+
+		return exec.reloadNamespaceCache()
+	})
+}
+
+func (i *interpreterPlanner) VisitCreateScheduleStatement(p0 *parse.CreateScheduleStatement) any {
+	return stmtFunc(func(exec *executionContext, fn resultFunc) error {
+		reset, err := handleNamespaced(exec, p0)
+		if err != nil {
+			return err
+		}
+		defer reset()
+
+		if err := exec.checkNamespaceMutatbility(); err != nil {
+			return err
+		}
+
+		// schedules call actions on behalf of their creator every n blocks,
+		// so they need a transaction and block to start from.
+		if exec.engineCtx.InvalidTxCtx {
+			return engine.ErrInvalidTxCtx
+		}
+
+		if !exec.engineCtx.OverrideAuthz && !exec.isOwner() {
+			return fmt.Errorf("%w: only the db owner can create schedules", engine.ErrDoesNotHavePrivilege)
+		}
+
+		ctx := exec.engineCtx.TxContext
+		exists, err := scheduleExists(ctx.Ctx, exec.db, exec.scope.namespace, p0.Name)
+		if err != nil {
+			return err
+		}
+		if exists {
+			if p0.IfNotExists {
+				return nil
+			}
+
+			return fmt.Errorf(`schedule "%s" already exists`, p0.Name)
+		}
+
+		// the scheduled action must exist when the schedule is created.
+		// If it is dropped later, the runs of the schedule will fail.
+		ns, err := exec.getNamespace(p0.Call.Namespace)
+		if err != nil {
+			return err
+		}
+		executable, ok := ns.availableFunctions[p0.Call.Name]
+		if !ok || (executable.Type != executableTypeAction && executable.Type != executableTypePrecompile) {
+			return fmt.Errorf(`%w: "%s"`, engine.ErrUnknownAction, p0.Call.Name)
+		}
+
+		return storeSchedule(ctx.Ctx, exec.db, exec.scope.namespace, p0.Name, p0.Interval, ctx.BlockContext.Height, p0.Raw, ctx)
+	})
+}
+
+func (i *interpreterPlanner) VisitDropScheduleStatement(p0 *parse.DropScheduleStatement) any {
+	return stmtFunc(func(exec *executionContext, fn resultFunc) error {
+		reset, err := handleNamespaced(exec, p0)
+		if err != nil {
+			return err
+		}
+		defer reset()
+
+		if err := exec.checkNamespaceMutatbility(); err != nil {
+			return err
+		}
+
+		if !exec.engineCtx.OverrideAuthz && !exec.isOwner() {
+			return fmt.Errorf("%w: only the db owner can drop schedules", engine.ErrDoesNotHavePrivilege)
+		}
+
+		exists, err := scheduleExists(exec.engineCtx.TxContext.Ctx, exec.db, exec.scope.namespace, p0.Name)
+		if err != nil {
+			return err
+		}
+		if !exists {
+			if p0.IfExists {
+				return nil
+			}
+
+			return fmt.Errorf(`%w: "%s"`, engine.ErrUnknownSchedule, p0.Name)
+		}
+
+		return deleteSchedule(exec.engineCtx.TxContext.Ctx, exec.db, exec.scope.namespace, p0.Name)
+	})
+}
+
 func (i *interpreterPlanner) VisitCreateNamespaceStatement(p0 *parse.CreateNamespaceStatement) any {
 	return stmtFunc(func(exec *executionContext, fn resultFunc) error {
 		if err := exec.checkPrivilege(_CREATE_PRIVILEGE); err != nil {
@@ -2019,6 +2353,7 @@ func (i *interpreterPlanner) VisitCreateNamespaceStatement(p0 *parse.CreateNames
 		exec.interpreter.namespaces[p0.Namespace] = &namespace{
 			availableFunctions: copyBuiltinExecutables(),
 			tables:             make(map[string]*engine.Table),
+			userTypes:          make(map[string]*types.UserType),
 			onDeploy:           func(*executionContext) error { return nil },
 			onUndeploy:         func(*executionContext) error { return nil },
 		}
@@ -2057,6 +2392,9 @@ func (i *interpreterPlanner) VisitDropNamespaceStatement(p0 *parse.DropNamespace
 		delete(exec.interpreter.namespaces, p0.Namespace)
 		exec.interpreter.accessController.unregisterNamespace(p0.Namespace)
 
+		// the namespace's tables might have been referenced from other namespaces
+		statementCache.clear()
+
 		return nil
 	})
 }
@@ -2142,7 +2480,7 @@ func (i *interpreterPlanner) VisitAddColumn(p0 *parse.AddColumn) any {
 			return fmt.Errorf(`column "%s" already exists`, p0.Name)
 		}
 
-		return nil
+		return exec.checkType(p0.Type)
 	})
 }
 
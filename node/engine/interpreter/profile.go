@@ -0,0 +1,149 @@
+package interpreter
+
+import (
+	"cmp"
+	"context"
+	"slices"
+	"strings"
+	"sync"
+	"time"
+
+	adminTypes "github.com/trufnetwork/kwil-db/core/types/admin"
+	"github.com/trufnetwork/kwil-db/node/metrics"
+)
+
+// Profile records the wall time and rows of the actions and statements that
+// are executed in blocks, and exports them as metrics. Only executions that
+// can mutate state are recorded, so read-only calls do not skew it. It is safe
+// for concurrent use.
+type Profile struct {
+	mu         sync.Mutex
+	actions    map[profileKey]*adminTypes.ExecProfileEntry
+	statements map[profileKey]*adminTypes.ExecProfileEntry
+}
+
+// NewProfile creates an empty execution profile.
+func NewProfile() *Profile {
+	return &Profile{
+		actions:    make(map[profileKey]*adminTypes.ExecProfileEntry),
+		statements: make(map[profileKey]*adminTypes.ExecProfileEntry),
+	}
+}
+
+type profileKey struct {
+	namespace string
+	action    string
+	line      int
+}
+
+// Top returns the limit actions and statements with the most total time,
+// sorted from the slowest. If limit is not positive, all are returned. If
+// namespace is not empty, only the actions of the namespace are returned.
+func (p *Profile) Top(limit int, namespace string) *adminTypes.ExecProfile {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	return &adminTypes.ExecProfile{
+		Actions:    topEntries(p.actions, limit, namespace),
+		Statements: topEntries(p.statements, limit, namespace),
+	}
+}
+
+func topEntries(entries map[profileKey]*adminTypes.ExecProfileEntry, limit int, namespace string) []*adminTypes.ExecProfileEntry {
+	res := make([]*adminTypes.ExecProfileEntry, 0, len(entries))
+	for key, entry := range entries {
+		if namespace != "" && key.namespace != namespace {
+			continue
+		}
+		cp := *entry
+		res = append(res, &cp)
+	}
+
+	slices.SortFunc(res, func(a, b *adminTypes.ExecProfileEntry) int {
+		return cmp.Or(
+			cmp.Compare(b.TotalTime, a.TotalTime),
+			strings.Compare(a.Namespace, b.Namespace),
+			strings.Compare(a.Action, b.Action),
+			cmp.Compare(a.Line, b.Line),
+		)
+	})
+
+	if limit > 0 && len(res) > limit {
+		res = res[:limit]
+	}
+	return res
+}
+
+// recordAction records an execution of an action.
+func (p *Profile) recordAction(ctx context.Context, namespace, action string, dur time.Duration, rows int64) {
+	metrics.Engine.RecordAction(ctx, namespace, action, dur, rows)
+
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	key := profileKey{namespace: namespace, action: action}
+	p.record(p.actions, key, "", dur, rows)
+}
+
+// recordStatement records an execution of a statement of an action.
+func (p *Profile) recordStatement(ctx context.Context, src *actionSource, line int, text string, dur time.Duration, rows int64) {
+	metrics.Engine.RecordStatement(ctx, src.namespace, src.name, line, dur, rows)
+
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	key := profileKey{namespace: src.namespace, action: src.name, line: line}
+	p.record(p.statements, key, text, dur, rows)
+}
+
+// record adds an execution to an entry. The caller must hold the lock.
+func (p *Profile) record(entries map[profileKey]*adminTypes.ExecProfileEntry, key profileKey, text string, dur time.Duration, rows int64) {
+	entry, ok := entries[key]
+	if !ok {
+		entry = &adminTypes.ExecProfileEntry{
+			Namespace: key.namespace,
+			Action:    key.action,
+			Line:      key.line,
+		}
+		entries[key] = entry
+	}
+
+	// an action that is replaced can have a different statement on the line
+	entry.Statement = text
+	entry.Calls++
+	entry.TotalTime += dur
+	entry.MaxTime = max(entry.MaxTime, dur)
+	entry.Rows += rows
+}
+
+// SetProfile records the actions and statements executed in blocks in
+// profile. A nil profile stops recording.
+func (t *ThreadSafeInterpreter) SetProfile(profile *Profile) {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+
+	t.i.profile = profile
+}
+
+// profiling returns true if the execution is being profiled.
+func (e *executionContext) profiling() bool {
+	return e.canMutateState && e.interpreter != nil && e.interpreter.profile != nil
+}
+
+// profile runs fn, which executes an action or statement, and returns the time
+// it took and the rows touched by the SQL statements it executed. The rows
+// are also added to those of the action or statement that runs it.
+func (e *executionContext) profile(fn func() error) (dur time.Duration, rows int64, err error) {
+	parentRows := e.profileRows
+	e.profileRows = &rows
+	defer func() {
+		e.profileRows = parentRows
+		if parentRows != nil {
+			*parentRows += rows
+		}
+	}()
+
+	start := time.Now()
+	err = fn()
+	return time.Since(start), rows, err
+}
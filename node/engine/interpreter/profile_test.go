@@ -0,0 +1,68 @@
+package interpreter
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func Test_Profile(t *testing.T) {
+	ctx := context.Background()
+	p := NewProfile()
+
+	p.recordAction(ctx, "main", "fast", time.Millisecond, 1)
+	p.recordAction(ctx, "main", "slow", 3*time.Millisecond, 2)
+	p.recordAction(ctx, "main", "slow", 5*time.Millisecond, 4)
+	p.recordAction(ctx, "other", "slowest", 10*time.Millisecond, 0)
+
+	src := &actionSource{namespace: "main", name: "slow"}
+	p.recordStatement(ctx, src, 2, "$a := 1;", time.Millisecond, 0)
+	p.recordStatement(ctx, src, 3, "INSERT INTO t VALUES ($a);", 4*time.Millisecond, 3)
+
+	top := p.Top(2, "")
+	require.Len(t, top.Actions, 2)
+	assert.Equal(t, "slowest", top.Actions[0].Action)
+	slow := top.Actions[1]
+	assert.Equal(t, "slow", slow.Action)
+	assert.EqualValues(t, 2, slow.Calls)
+	assert.Equal(t, 8*time.Millisecond, slow.TotalTime)
+	assert.Equal(t, 5*time.Millisecond, slow.MaxTime)
+	assert.EqualValues(t, 6, slow.Rows)
+
+	require.Len(t, top.Statements, 2)
+	assert.Equal(t, 3, top.Statements[0].Line)
+	assert.Equal(t, "INSERT INTO t VALUES ($a);", top.Statements[0].Statement)
+	assert.EqualValues(t, 3, top.Statements[0].Rows)
+
+	// filtered by namespace, without a limit
+	top = p.Top(0, "main")
+	require.Len(t, top.Actions, 2)
+	assert.Equal(t, "slow", top.Actions[0].Action)
+	assert.Equal(t, "fast", top.Actions[1].Action)
+
+	// the returned entries are copies
+	top.Actions[0].Calls = 100
+	assert.EqualValues(t, 2, p.Top(1, "main").Actions[0].Calls)
+}
+
+func Test_ProfileRows(t *testing.T) {
+	e := &executionContext{}
+
+	_, outer, err := e.profile(func() error {
+		*e.profileRows += 1
+		_, inner, err := e.profile(func() error {
+			*e.profileRows += 2
+			return nil
+		})
+		assert.EqualValues(t, 2, inner)
+		return err
+	})
+	require.NoError(t, err)
+
+	// rows of nested statements are included
+	assert.EqualValues(t, 3, outer)
+	assert.Nil(t, e.profileRows)
+}
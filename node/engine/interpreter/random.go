@@ -0,0 +1,125 @@
+package interpreter
+
+import (
+	"crypto/sha256"
+	"encoding/binary"
+	"fmt"
+	"math"
+	"slices"
+
+	"github.com/trufnetwork/kwil-db/core/types"
+	"github.com/trufnetwork/kwil-db/node/engine"
+)
+
+const (
+	// randomCounterKey is the key of the transaction context value that counts
+	// the random draws in a transaction.
+	randomCounterKey = "engine_random_counter"
+	// randomSourceKey is the key of the transaction context value that
+	// identifies a call without a transaction, such as a scheduled call, so
+	// that the calls of a block draw different bytes.
+	randomSourceKey = "engine_random_source"
+	// randomDomain separates the hashes used for randomness from other hashes
+	// of the same data.
+	randomDomain = "kwil_block_random"
+	// maxRandomBytes is the most bytes that can be drawn at once.
+	maxRandomBytes = 1024
+)
+
+// txScalarFuncs are built-in scalar functions that depend on the transaction
+// context. They are implemented in Go, and cannot be used in SQL statements.
+var txScalarFuncs = map[string]func(e *executionContext, args []value) (value, error){
+	"block_random_bytes": func(e *executionContext, args []value) (value, error) {
+		if args[0].Null() {
+			return makeNull(types.ByteaType)
+		}
+
+		n := args[0].RawValue().(int64)
+		if n < 0 || n > maxRandomBytes {
+			return nil, fmt.Errorf("%w: block_random_bytes can return between 0 and %d bytes, got %d", engine.ErrInvalidArgument, maxRandomBytes, n)
+		}
+
+		b, err := e.randomBytes(int(n))
+		if err != nil {
+			return nil, err
+		}
+
+		return makeBlob(b), nil
+	},
+	"random_int": func(e *executionContext, args []value) (value, error) {
+		if args[0].Null() || args[1].Null() {
+			return makeNull(types.IntType)
+		}
+
+		lo, hi := args[0].RawValue().(int64), args[1].RawValue().(int64)
+		if lo > hi {
+			return nil, fmt.Errorf("%w: random_int min %d is greater than max %d", engine.ErrInvalidArgument, lo, hi)
+		}
+
+		// the size of the range wraps to 0 if it is every int64
+		size := uint64(hi-lo) + 1
+		// values at or above limit would make the low end of the range more likely
+		limit := uint64(math.MaxUint64)
+		if size != 0 {
+			limit -= math.MaxUint64 % size
+		}
+
+		for {
+			b, err := e.randomBytes(8)
+			if err != nil {
+				return nil, err
+			}
+
+			r := binary.BigEndian.Uint64(b)
+			if r >= limit {
+				continue
+			}
+			if size != 0 {
+				r %= size
+			}
+
+			return makeInt8(lo + int64(r)), nil
+		}
+	},
+}
+
+// randomBytes returns n bytes derived from the hash of the block, the ID of the
+// transaction, the source of a call without a transaction, if any, and a
+// counter of the draws in the transaction. Each call increments the counter, so
+// successive calls return different bytes.
+func (e *executionContext) randomBytes(n int) ([]byte, error) {
+	txCtx := e.engineCtx.TxContext
+	if e.engineCtx.InvalidTxCtx || txCtx.BlockContext == nil {
+		return nil, engine.ErrInvalidTxCtx
+	}
+
+	var counter uint64
+	if v, ok := txCtx.Value(randomCounterKey); ok {
+		counter = v.(uint64)
+	}
+	txCtx.SetValue(randomCounterKey, counter+1)
+
+	var source string
+	if v, ok := txCtx.Value(randomSourceKey); ok {
+		source = v.(string)
+	}
+
+	h := sha256.New()
+	h.Write([]byte(randomDomain))
+	h.Write(txCtx.BlockContext.Hash[:])
+	h.Write(binary.BigEndian.AppendUint32(nil, uint32(len(txCtx.TxID))))
+	h.Write([]byte(txCtx.TxID))
+	h.Write(binary.BigEndian.AppendUint32(nil, uint32(len(source))))
+	h.Write([]byte(source))
+	h.Write(binary.BigEndian.AppendUint64(nil, counter))
+	seed := h.Sum(nil)
+
+	// bytes beyond the first hash are drawn by hashing the seed with an index
+	res := make([]byte, 0, n+sha256.Size)
+	for i := uint64(0); len(res) < n; i++ {
+		block := sha256.Sum256(binary.BigEndian.AppendUint64(slices.Clip(seed), i))
+		res = append(res, block[:]...)
+	}
+
+	return res[:n], nil
+}
@@ -0,0 +1,100 @@
+package interpreter
+
+import (
+	"context"
+	"math"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/trufnetwork/kwil-db/common"
+	"github.com/trufnetwork/kwil-db/core/types"
+	"github.com/trufnetwork/kwil-db/node/engine"
+)
+
+func Test_BlockRandom(t *testing.T) {
+	newCtx := func(blockHash types.Hash, txID string) *executionContext {
+		return &executionContext{
+			engineCtx: &common.EngineContext{
+				TxContext: &common.TxContext{
+					Ctx:          context.Background(),
+					BlockContext: &common.BlockContext{Height: 1, Hash: blockHash},
+					TxID:         txID,
+				},
+			},
+		}
+	}
+	randomBytes := func(e *executionContext, n int64) []byte {
+		v, err := txScalarFuncs["block_random_bytes"](e, []value{makeInt8(n)})
+		require.NoError(t, err)
+		return v.RawValue().([]byte)
+	}
+	randomInt := func(e *executionContext, lo, hi int64) (int64, error) {
+		v, err := txScalarFuncs["random_int"](e, []value{makeInt8(lo), makeInt8(hi)})
+		if err != nil {
+			return 0, err
+		}
+		return v.RawValue().(int64), nil
+	}
+
+	// the same block and transaction give the same sequence
+	e1, e2 := newCtx(types.Hash{1}, "tx1"), newCtx(types.Hash{1}, "tx1")
+	first := randomBytes(e1, 100)
+	assert.Len(t, first, 100)
+	assert.Equal(t, first, randomBytes(e2, 100))
+
+	// successive draws differ
+	second := randomBytes(e1, 100)
+	assert.NotEqual(t, first, second)
+	assert.Equal(t, second, randomBytes(e2, 100))
+
+	// other transactions and blocks draw other bytes
+	assert.NotEqual(t, first, randomBytes(newCtx(types.Hash{1}, "tx2"), 100))
+	assert.NotEqual(t, first, randomBytes(newCtx(types.Hash{2}, "tx1"), 100))
+
+	// scheduled calls have no transaction ID, and draw different bytes for
+	// each schedule
+	newScheduleCtx := func(name string) *executionContext {
+		e := newCtx(types.Hash{1}, "")
+		e.engineCtx.TxContext.SetValue(randomSourceKey, scheduleRandomSource("main", name))
+		return e
+	}
+	scheduled := randomBytes(newScheduleCtx("a"), 100)
+	assert.Equal(t, scheduled, randomBytes(newScheduleCtx("a"), 100))
+	assert.NotEqual(t, scheduled, randomBytes(newScheduleCtx("b"), 100))
+	assert.NotEqual(t, scheduled, randomBytes(newCtx(types.Hash{1}, ""), 100))
+
+	// shorter draws are prefixes of longer ones at the same counter
+	assert.Equal(t, first[:10], randomBytes(newCtx(types.Hash{1}, "tx1"), 10))
+	assert.Empty(t, randomBytes(e1, 0))
+
+	_, err := txScalarFuncs["block_random_bytes"](e1, []value{makeInt8(maxRandomBytes + 1)})
+	assert.ErrorIs(t, err, engine.ErrInvalidArgument)
+
+	// integers are within the inclusive range
+	seen := make(map[int64]bool)
+	for range 200 {
+		n, err := randomInt(e1, -2, 2)
+		require.NoError(t, err)
+		require.GreaterOrEqual(t, n, int64(-2))
+		require.LessOrEqual(t, n, int64(2))
+		seen[n] = true
+	}
+	assert.Len(t, seen, 5)
+
+	n, err := randomInt(e1, 7, 7)
+	require.NoError(t, err)
+	assert.Equal(t, int64(7), n)
+
+	_, err = randomInt(e1, math.MinInt64, math.MaxInt64)
+	require.NoError(t, err)
+
+	_, err = randomInt(e1, 2, 1)
+	assert.ErrorIs(t, err, engine.ErrInvalidArgument)
+
+	// randomness needs a block
+	invalid := newCtx(types.Hash{1}, "tx1")
+	invalid.engineCtx.InvalidTxCtx = true
+	_, err = randomInt(invalid, 1, 2)
+	assert.ErrorIs(t, err, engine.ErrInvalidTxCtx)
+}
@@ -0,0 +1,184 @@
+package interpreter
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"sync"
+
+	"github.com/trufnetwork/kwil-db/common"
+	"github.com/trufnetwork/kwil-db/core/types"
+	"github.com/trufnetwork/kwil-db/extensions/hooks"
+	"github.com/trufnetwork/kwil-db/node/engine"
+	"github.com/trufnetwork/kwil-db/node/engine/parse"
+	"github.com/trufnetwork/kwil-db/node/types/sql"
+)
+
+const schedulesEndBlockHook = "engine_schedules"
+
+// maxScheduleWork is the work that the scheduled calls of a block can do in
+// total, since they are not paid for by a transaction. A unit of work is an
+// action statement, a loop iteration, or a row touched by a SQL statement.
+// Once it is used up, the remaining calls of the block fail.
+//
+// The rows of a SQL statement are only charged once the statement completes,
+// so the meter does not bound the work of a single statement, such as an
+// INSERT ... SELECT over a large table. Such a statement uses up the meter,
+// which stops the calls after it, but its own cost is only limited by the
+// data it touches.
+var maxScheduleWork int64 = 1_000_000
+
+// workMeter counts down the work that an execution can still do. Methods on a
+// nil meter do not limit the execution.
+type workMeter struct {
+	remaining int64
+}
+
+// use charges units of work to the meter. It returns ErrScheduleWorkLimit if
+// the meter is used up.
+func (m *workMeter) use(units int64) error {
+	if m == nil {
+		return nil
+	}
+	m.remaining -= units
+	if m.remaining < 0 {
+		return engine.ErrScheduleWorkLimit
+	}
+	return nil
+}
+
+// meteredStmt charges a statement to the meter of the execution before
+// executing it.
+func meteredStmt(stmtFn stmtFunc) stmtFunc {
+	return func(exec *executionContext, fn resultFunc) error {
+		if err := exec.meter.use(1); err != nil {
+			return err
+		}
+		return stmtFn(exec, fn)
+	}
+}
+
+// registerSchedulesHook registers the end block hook that runs the schedules.
+// It is only registered once the engine schema has been upgraded to have the
+// schedules table, so no block queries the table before it exists.
+var registerSchedulesHook = sync.OnceValue(func() error {
+	return hooks.RegisterEndBlockHook(schedulesEndBlockHook, func(ctx context.Context, app *common.App, block *common.BlockContext) error {
+		interp, ok := app.Engine.(*ThreadSafeInterpreter)
+		if !ok {
+			// the engine can be swapped for another implementation in tests
+			return nil
+		}
+
+		return interp.runSchedules(ctx, app.DB, block)
+	})
+})
+
+// runSchedules calls the actions of all schedules that are due at the height
+// of the block. Each call runs in its own savepoint, so a failed call is
+// rolled back without affecting the others. The calls share a meter of
+// maxScheduleWork, which stops them once it is used up, but does not bound
+// the work of a single SQL statement. The result of each call is appended to
+// the block context. Only errors that are
+// not caused by the scheduled calls themselves are returned.
+func (t *ThreadSafeInterpreter) runSchedules(ctx context.Context, db sql.DB, block *common.BlockContext) error {
+	unlock, err := t.lock(db)
+	if err != nil {
+		return err
+	}
+	defer unlock()
+
+	schedules, err := dueSchedules(ctx, db, block.Height)
+	if err != nil {
+		return err
+	}
+
+	meter := &workMeter{remaining: maxScheduleWork}
+	for _, sched := range schedules {
+		tx, err := db.BeginTx(ctx)
+		if err != nil {
+			return err
+		}
+
+		logs, runErr := t.i.runSchedule(ctx, tx, block, sched, meter)
+		if runErr != nil {
+			err = tx.Rollback(ctx)
+		} else {
+			err = tx.Commit(ctx)
+		}
+		if err != nil {
+			return err
+		}
+
+		res := &types.ScheduleResult{
+			Namespace: sched.Namespace,
+			Name:      sched.Name,
+			Logs:      strings.Join(logs, "\n"),
+		}
+		if runErr != nil {
+			res.Error = runErr.Error()
+		}
+		block.ScheduleResults = append(block.ScheduleResults, res)
+	}
+
+	return nil
+}
+
+// runSchedule calls the action of a schedule as the creator of the schedule,
+// charging its work to the meter. It returns the logs of the call. If the call fails, the in-memory state of
+// the interpreter is rolled back, and the caller must roll back the database.
+func (i *baseInterpreter) runSchedule(ctx context.Context, db sql.DB, block *common.BlockContext, sched *storedSchedule, meter *workMeter) (logs []string, err error) {
+	copied := i.copy()
+	invalidations := statementCache.invalidationCount()
+	defer func() {
+		if r := recover(); r != nil {
+			err = fmt.Errorf("panic: %v", r)
+		}
+
+		if err != nil {
+			i.apply(copied)
+			i.rollbackStatementCache(invalidations)
+		} else {
+			i.syncNamespaceManager()
+		}
+	}()
+
+	// the statement was valid when the schedule was created
+	ast, err := parseAdhoc(sched.RawStatement)
+	if err != nil {
+		return nil, err
+	}
+	if len(ast) != 1 {
+		return nil, fmt.Errorf("expected a single statement for schedule %s, got %d", sched.Name, len(ast))
+	}
+	stmt, ok := ast[0].(*parse.CreateScheduleStatement)
+	if !ok {
+		return nil, fmt.Errorf("expected schedule %s to be stored as CREATE SCHEDULE, got %T", sched.Name, ast[0])
+	}
+
+	txCtx := &common.TxContext{
+		Ctx:           ctx,
+		BlockContext:  block,
+		Signer:        sched.Signer,
+		Caller:        sched.Caller,
+		Authenticator: sched.Authenticator,
+	}
+	// the call has no transaction ID, so the schedule is the source of its
+	// randomness, which differs from the other calls of the block
+	txCtx.SetValue(randomSourceKey, scheduleRandomSource(sched.Namespace, sched.Name))
+
+	execCtx, err := i.newExecCtx(&common.EngineContext{TxContext: txCtx}, db, sched.Namespace, true)
+	if err != nil {
+		return nil, err
+	}
+	execCtx.meter = meter
+
+	call := (&parse.ActionStmtCall{Call: stmt.Call}).Accept(&interpreterPlanner{}).(stmtFunc)
+	err = call(execCtx, func(*row) error { return nil })
+	return *execCtx.logs, err
+}
+
+// scheduleRandomSource identifies a schedule as the source of the randomness of
+// its calls.
+func scheduleRandomSource(namespace, name string) string {
+	return "schedule:" + namespace + "." + name
+}
@@ -0,0 +1,46 @@
+package interpreter
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/trufnetwork/kwil-db/node/engine"
+	"github.com/trufnetwork/kwil-db/node/engine/parse"
+)
+
+func Test_WorkMeter(t *testing.T) {
+	res, err := parse.Parse(`CREATE ACTION spin() public {
+		for $i in 1..100 { }
+		$a := 1;
+		for $j in 1..10 { $a := $a + $j; }
+	};`)
+	require.NoError(t, err)
+	body := res[0].(*parse.CreateActionStatement).Statements
+
+	planner := &interpreterPlanner{}
+	run := func(meter *workMeter) error {
+		exec := &executionContext{scope: newScope("main"), meter: meter}
+		for _, stmt := range body {
+			if err := planner.planActionStmt(stmt)(exec, func(*row) error { return nil }); err != nil {
+				return err
+			}
+		}
+		return nil
+	}
+
+	// 3 statements, 110 iterations, and 10 statements in the second loop
+	meter := &workMeter{remaining: 123}
+	require.NoError(t, run(meter))
+	assert.EqualValues(t, 0, meter.remaining)
+
+	// an empty loop is charged for its iterations
+	meter = &workMeter{remaining: 50}
+	require.ErrorIs(t, run(meter), engine.ErrScheduleWorkLimit)
+
+	// the meter stays used up
+	require.ErrorIs(t, run(meter), engine.ErrScheduleWorkLimit)
+
+	// a nil meter does not limit the execution
+	require.NoError(t, run(nil))
+}
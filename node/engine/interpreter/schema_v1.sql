@@ -0,0 +1,159 @@
+/*
+    Version 1 of the engine schema adds user-defined types, which the parameters
+    and return fields of actions may have instead of a scalar type, and the
+    schedules of actions that are called every n blocks.
+
+    Upgrades are applied on top of schema.sql, both to new databases and to
+    databases created at an earlier version.
+*/
+
+ALTER TABLE kwild_engine.parameters
+    ALTER COLUMN scalar_type DROP NOT NULL, -- null if the type is user-defined
+    ADD COLUMN user_type TEXT DEFAULT NULL, -- the name of the user-defined type, if any
+    ADD CHECK ((scalar_type IS NULL) != (user_type IS NULL));
+
+ALTER TABLE kwild_engine.return_fields
+    ALTER COLUMN scalar_type DROP NOT NULL, -- null if the type is user-defined
+    ADD COLUMN user_type TEXT DEFAULT NULL, -- the name of the user-defined type, if any
+    ADD CHECK ((scalar_type IS NULL) != (user_type IS NULL));
+
+-- format_param_type formats the type of an action parameter or return field for display.
+-- It is either a scalar type or a user-defined type.
+CREATE OR REPLACE FUNCTION kwild_engine.format_param_type(scal kwild_engine.scalar_data_type, user_type TEXT, is_arr BOOLEAN, meta BYTEA)
+RETURNS TEXT AS $$
+BEGIN
+    IF user_type IS NULL THEN
+        RETURN kwild_engine.format_type(scal, is_arr, meta);
+    END IF;
+
+    IF is_arr THEN
+        RETURN user_type || '[]';
+    END IF;
+
+    RETURN user_type;
+END;
+$$ LANGUAGE plpgsql;
+
+-- format_pg_type formats a function read from postgres's information_schema.columns
+CREATE OR REPLACE FUNCTION kwild_engine.format_pg_type (type oid, typemod integer)
+RETURNS TEXT AS $$
+DECLARE
+    result TEXT;
+BEGIN
+    result := pg_catalog.format_type(type, typemod);
+    -- we can usually just return this, however there are a few times that we need to format it
+    -- to Kwil's native type
+    if result = 'character varying' THEN
+        result := 'text';
+    END IF;
+    if result = 'bigint' THEN
+        result := 'int8';
+    END IF;
+    if result = 'character' THEN
+        result := 'text';
+    END IF;
+    if result = 'decimal' THEN
+        result := 'numeric';
+    END IF;
+    -- user-defined types are qualified with their schema if it is not in the search path,
+    -- but Kwil always refers to them by their name within the namespace
+    if (SELECT typtype FROM pg_catalog.pg_type WHERE oid = type) IN ('e', 'c') THEN
+        result := (SELECT typname::TEXT FROM pg_catalog.pg_type WHERE oid = type);
+    END IF;
+
+    RETURN result;
+END;
+$$ LANGUAGE plpgsql;
+
+-- info.types is a public view that provides a list of all user-defined types in the database.
+-- Enums have labels, and composite types have fields.
+CREATE VIEW info.types AS
+SELECT
+    n.nspname::TEXT AS namespace,
+    t.typname::TEXT AS name,
+    CASE WHEN t.typtype = 'e' THEN 'ENUM' ELSE 'COMPOSITE' END AS kind,
+    COALESCE((
+        SELECT array_agg(e.enumlabel::TEXT ORDER BY e.enumsortorder)
+        FROM pg_enum e
+        WHERE e.enumtypid = t.oid
+    ), ARRAY[]::TEXT[]) AS labels,
+    COALESCE((
+        SELECT array_agg(a.attname::TEXT ORDER BY a.attnum)
+        FROM pg_attribute a
+        WHERE a.attrelid = t.typrelid AND a.attnum > 0 AND NOT a.attisdropped
+    ), ARRAY[]::TEXT[]) AS field_names,
+    COALESCE((
+        SELECT array_agg(kwild_engine.format_pg_type(a.atttypid, a.atttypmod) ORDER BY a.attnum)
+        FROM pg_attribute a
+        WHERE a.attrelid = t.typrelid AND a.attnum > 0 AND NOT a.attisdropped
+    ), ARRAY[]::TEXT[]) AS field_types
+FROM pg_type t
+JOIN pg_namespace n ON t.typnamespace = n.oid
+JOIN kwild_engine.namespaces us ON n.nspname::TEXT = us.name
+LEFT JOIN pg_class c ON c.oid = t.typrelid
+WHERE t.typtype = 'e' OR (t.typtype = 'c' AND c.relkind = 'c') -- only enums and standalone composite types
+ORDER BY namespace, name;
+
+-- actions is a public view that provides a list of all actions in the database
+CREATE OR REPLACE VIEW info.actions AS
+WITH parameters AS (
+    SELECT 
+        action_id,
+        array_agg(p.name ORDER BY p.position, p.name, kwild_engine.format_param_type(p.scalar_type, p.user_type, p.is_array, p.metadata)) AS parameter_names,
+        array_agg(kwild_engine.format_param_type(p.scalar_type, p.user_type, p.is_array, p.metadata) ORDER BY p.position, p.name, kwild_engine.format_param_type(p.scalar_type, p.user_type, p.is_array, p.metadata)) AS parameter_types
+    FROM kwild_engine.parameters p
+    GROUP BY action_id
+), return_fields AS (
+    SELECT 
+        action_id,
+        array_agg(r.name ORDER BY r.position, r.name, kwild_engine.format_param_type(r.scalar_type, r.user_type, r.is_array, r.metadata)) AS return_names,
+        array_agg(kwild_engine.format_param_type(r.scalar_type, r.user_type, r.is_array, r.metadata) ORDER BY r.position, r.name, kwild_engine.format_param_type(r.scalar_type, r.user_type, r.is_array, r.metadata)) AS return_types
+    FROM kwild_engine.return_fields r
+    GROUP BY action_id
+)
+SELECT 
+    a.namespace AS namespace,
+    a.name::TEXT AS name,
+    a.raw_statement AS raw_statement,
+    a.modifiers::TEXT[] AS access_modifiers,
+    COALESCE(p.parameter_names, ARRAY[]::TEXT[]) AS parameter_names,
+    COALESCE(p.parameter_types, ARRAY[]::TEXT[]) AS parameter_types,
+    COALESCE(r.return_names, ARRAY[]::TEXT[]) AS return_names,
+    COALESCE(r.return_types, ARRAY[]::TEXT[]) AS return_types,
+    a.returns_table AS returns_table,
+    a.built_in AS built_in
+FROM kwild_engine.actions a
+LEFT JOIN parameters p
+    ON a.id = p.action_id
+LEFT JOIN return_fields r
+    ON a.id = r.action_id
+ORDER BY a.namespace, a.name,
+    1, 2, 3, 4, 5, 6, 7, 8, 9;
+
+-- schedules is a table that stores all actions that are called every n blocks.
+-- The caller, signer and authenticator are those of the transaction that created
+-- the schedule, and are used as the context of each scheduled call.
+CREATE TABLE IF NOT EXISTS kwild_engine.schedules (
+    id BIGSERIAL PRIMARY KEY,
+    namespace TEXT NOT NULL REFERENCES kwild_engine.namespaces(name) ON UPDATE CASCADE ON DELETE CASCADE,
+    name TEXT NOT NULL CHECK (name = lower(name)),
+    interval INT8 NOT NULL CHECK (interval > 0),
+    start_height INT8 NOT NULL,
+    raw_statement TEXT NOT NULL,
+    caller TEXT NOT NULL,
+    signer BYTEA,
+    authenticator TEXT NOT NULL,
+    UNIQUE (namespace, name)
+);
+
+-- schedules is a public view that provides a list of all schedules in the database
+CREATE VIEW info.schedules AS
+SELECT
+    namespace,
+    name,
+    interval,
+    start_height,
+    raw_statement,
+    caller AS owner
+FROM kwild_engine.schedules
+ORDER BY 1, 2;
@@ -9,6 +9,7 @@ import (
 	"strconv"
 	"strings"
 
+	"github.com/trufnetwork/kwil-db/common"
 	"github.com/trufnetwork/kwil-db/core/types"
 	"github.com/trufnetwork/kwil-db/core/utils/order"
 	"github.com/trufnetwork/kwil-db/extensions/precompiles"
@@ -21,6 +22,9 @@ import (
 var (
 	//go:embed schema.sql
 	schemaInitSQL string
+
+	//go:embed schema_v1.sql
+	schemaV1SQL string
 )
 
 // queryOneInt64 queries for a single int64 value.
@@ -78,9 +82,10 @@ func storeAction(ctx context.Context, db sql.DB, namespace string, action *actio
 	}
 
 	for i, param := range action.Parameters {
-		err = execute(ctx, db, `INSERT INTO kwild_engine.parameters (action_id, name, scalar_type, is_array, metadata, position)
-			VALUES ($1, $2, $3, $4, $5, $6)`,
-			actionID, param.Name, strings.ToUpper(param.Type.Name), param.Type.IsArray, getTypeMetadata(param.Type), i+1)
+		scalarType, userType := splitTypeName(param.Type)
+		err = execute(ctx, db, `INSERT INTO kwild_engine.parameters (action_id, name, scalar_type, user_type, is_array, metadata, position)
+			VALUES ($1, $2, $3, $4, $5, $6, $7)`,
+			actionID, param.Name, scalarType, userType, param.Type.IsArray, getTypeMetadata(param.Type), i+1)
 		if err != nil {
 			return err
 		}
@@ -88,9 +93,10 @@ func storeAction(ctx context.Context, db sql.DB, namespace string, action *actio
 
 	if action.Returns != nil {
 		for i, field := range action.Returns.Fields {
-			err = execute(ctx, db, `INSERT INTO kwild_engine.return_fields (action_id, name, scalar_type, is_array, metadata, position)
-			VALUES ($1, $2, $3, $4, $5, $6)`,
-				actionID, field.Name, strings.ToUpper(field.Type.Name), field.Type.IsArray, getTypeMetadata(field.Type), i+1)
+			scalarType, userType := splitTypeName(field.Type)
+			err = execute(ctx, db, `INSERT INTO kwild_engine.return_fields (action_id, name, scalar_type, user_type, is_array, metadata, position)
+			VALUES ($1, $2, $3, $4, $5, $6, $7)`,
+				actionID, field.Name, scalarType, userType, field.Type.IsArray, getTypeMetadata(field.Type), i+1)
 			if err != nil {
 				return err
 			}
@@ -100,11 +106,101 @@ func storeAction(ctx context.Context, db sql.DB, namespace string, action *actio
 	return nil
 }
 
+// splitTypeName returns the values of the scalar_type and user_type columns
+// for a parameter or return field. Exactly one of them is non-nil.
+func splitTypeName(t *types.DataType) (scalarType, userType *string) {
+	name := t.Name
+	if t.IsUserDefined() {
+		return nil, &name
+	}
+
+	name = strings.ToUpper(name)
+	return &name, nil
+}
+
+// actionsUsingType lists the actions in a namespace that have a parameter or
+// return field of the given user-defined type.
+func actionsUsingType(ctx context.Context, db sql.DB, namespace, typeName string) ([]string, error) {
+	var actions []string
+	var name string
+	err := queryRowFunc(ctx, db, `SELECT DISTINCT a.name FROM kwild_engine.actions a
+		WHERE a.namespace = $1 AND (
+			EXISTS (SELECT 1 FROM kwild_engine.parameters p WHERE p.action_id = a.id AND p.user_type = $2)
+			OR EXISTS (SELECT 1 FROM kwild_engine.return_fields r WHERE r.action_id = a.id AND r.user_type = $2)
+		) ORDER BY a.name`, []any{&name},
+		func() error {
+			actions = append(actions, name)
+			return nil
+		}, namespace, typeName)
+	if err != nil {
+		return nil, err
+	}
+
+	return actions, nil
+}
+
 // deleteAction deletes an action from the database.
 func deleteAction(ctx context.Context, db sql.DB, namespace, actionName string) error {
 	return execute(ctx, db, `DELETE FROM kwild_engine.actions WHERE namespace = $1 AND name = $2`, namespace, actionName)
 }
 
+// storedSchedule is a schedule that is stored in the database.
+type storedSchedule struct {
+	Namespace     string
+	Name          string
+	RawStatement  string
+	Caller        string
+	Signer        []byte
+	Authenticator string
+}
+
+// storeSchedule stores a schedule in the database. The schedule first runs
+// interval blocks after the start height.
+func storeSchedule(ctx context.Context, db sql.DB, namespace, name string, interval, startHeight int64, rawStatement string, txCtx *common.TxContext) error {
+	return execute(ctx, db, `INSERT INTO kwild_engine.schedules (namespace, name, interval, start_height, raw_statement, caller, signer, authenticator)
+		VALUES ($1, $2, $3, $4, $5, $6, $7, $8)`,
+		namespace, name, interval, startHeight, rawStatement, txCtx.Caller, txCtx.Signer, txCtx.Authenticator)
+}
+
+// scheduleExists checks if a schedule exists in a namespace.
+func scheduleExists(ctx context.Context, db sql.DB, namespace, name string) (bool, error) {
+	var exists bool
+	err := queryRowFunc(ctx, db, `SELECT EXISTS (SELECT 1 FROM kwild_engine.schedules WHERE namespace = $1 AND name = $2)`,
+		[]any{&exists}, func() error { return nil }, namespace, name)
+	if err != nil {
+		return false, err
+	}
+
+	return exists, nil
+}
+
+// deleteSchedule deletes a schedule from the database.
+func deleteSchedule(ctx context.Context, db sql.DB, namespace, name string) error {
+	return execute(ctx, db, `DELETE FROM kwild_engine.schedules WHERE namespace = $1 AND name = $2`, namespace, name)
+}
+
+// dueSchedules lists the schedules that are due to run at a block height,
+// ordered by namespace and name.
+func dueSchedules(ctx context.Context, db sql.DB, height int64) ([]*storedSchedule, error) {
+	var schedules []*storedSchedule
+	sched := &storedSchedule{}
+	err := queryRowFunc(ctx, db, `SELECT namespace, name, raw_statement, caller, signer, authenticator
+		FROM kwild_engine.schedules
+		WHERE $1 > start_height AND ($1 - start_height) % interval = 0
+		ORDER BY namespace, name`,
+		[]any{&sched.Namespace, &sched.Name, &sched.RawStatement, &sched.Caller, &sched.Signer, &sched.Authenticator},
+		func() error {
+			cp := *sched
+			schedules = append(schedules, &cp)
+			return nil
+		}, height)
+	if err != nil {
+		return nil, err
+	}
+
+	return schedules, nil
+}
+
 // deleteActionIfBuiltin deletes an action from the database if it is a built-in action.
 // It returns true if an action was found but not deleted
 func deleteActionIfBuiltin(ctx context.Context, db sql.DB, namespace, actionName string) (bool, error) {
@@ -319,6 +415,56 @@ func listTablesInNamespace(ctx context.Context, db sql.DB, namespace string) ([]
 	return tables, nil
 }
 
+// listTypesInNamespace lists all user-defined types in a namespace.
+func listTypesInNamespace(ctx context.Context, db sql.DB, namespace string) ([]*types.UserType, error) {
+	userTypes := make([]*types.UserType, 0)
+	var name, kind string
+	var labels, fieldNames, fieldTypes []string
+	scans := []any{
+		&name,
+		&kind,
+		&labels,
+		&fieldNames,
+		&fieldTypes,
+	}
+	err := queryRowFunc(ctx, db, `SELECT name, kind, labels, field_names, field_types
+	FROM info.types
+	WHERE namespace = $1`, scans,
+		func() error {
+			ut := &types.UserType{
+				Name: name,
+			}
+
+			switch kind {
+			case "ENUM":
+				ut.Labels = append([]string{}, labels...)
+			case "COMPOSITE":
+				for i, fieldName := range fieldNames {
+					dt, err := types.ParseDataType(fieldTypes[i])
+					if err != nil {
+						return err
+					}
+
+					ut.Fields = append(ut.Fields, &types.UserTypeField{
+						Name: fieldName,
+						Type: dt,
+					})
+				}
+			default:
+				return fmt.Errorf("unknown kind %s of type %s", kind, name)
+			}
+
+			userTypes = append(userTypes, ut)
+			return nil
+		}, namespace,
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	return userTypes, nil
+}
+
 // listActionsInBuiltInNamespace lists all actions in a namespace.
 // If the namespace is an extension, it wont return any actions.
 func listActionsInBuiltInNamespace(ctx context.Context, db sql.DB, namespace string) ([]*action, error) {
@@ -565,6 +711,20 @@ func query(ctx context.Context, db sql.DB, query string, scanVals []any, fn func
 	return queryRowFunc(ctx, db, query, scanVals, fn, argVals...)
 }
 
+// queryPrepared is like query, except that the statement is executed as a
+// named Postgres prepared statement, which is cached on the connection.
+// Cached statements hold connection-local state that can fail differently
+// after DDL, so it must never be used for queries executed in consensus.
+func queryPrepared(ctx context.Context, db sql.DB, query string, scanVals []any, fn func() error, args []value) error {
+	argVals := make([]any, len(args)+1)
+	argVals[0] = pg.QueryModeDefault
+	for i, v := range args {
+		argVals[i+1] = v
+	}
+
+	return pg.QueryRowFunc(ctx, db, query, scanVals, fn, argVals...)
+}
+
 // queryRowFunc executes a SQL query with the given values.
 func queryRowFunc(ctx context.Context, tx sql.Executor, stmt string,
 	scans []any, fn func() error, args ...any) error {
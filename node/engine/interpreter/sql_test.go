@@ -1317,3 +1317,87 @@ func (t *transactionalCache) Copy() precompiles.Cache {
 func (t *transactionalCache) Apply(cache precompiles.Cache) {
 	t.counter = cache.(*transactionalCache).counter
 }
+
+// Test_UpgradeSchema tests that the engine starts on a database that was
+// initialized before the schema upgrades.
+func Test_UpgradeSchema(t *testing.T) {
+	ctx := context.Background()
+
+	db, err := pg.NewDB(ctx, &pg.DBConfig{
+		PoolConfig: pg.PoolConfig{
+			ConnConfig: pg.ConnConfig{
+				Host:   "127.0.0.1",
+				Port:   "5432",
+				User:   "kwild",
+				Pass:   "kwild", // would be ignored if pg_hba.conf set with trust
+				DBName: "kwil_test_db",
+			},
+			MaxConns: 11,
+		},
+	})
+	require.NoError(t, err)
+	defer db.Close()
+	tx, err := db.BeginTx(ctx)
+	require.NoError(t, err)
+	defer tx.Rollback(ctx) // always rollback to avoid cleanup
+
+	// a database created at the initial schema, without a version
+	for _, schema := range []string{"main", "info", "kwild_engine"} {
+		_, err = tx.Execute(ctx, fmt.Sprintf(`DROP SCHEMA IF EXISTS %s CASCADE;`, schema))
+		require.NoError(t, err)
+	}
+	require.NoError(t, pg.Exec(ctx, tx, schemaInitSQL))
+
+	interp, err := NewInterpreter(ctx, tx, &common.Service{}, nil, nil, nil)
+	require.NoError(t, err)
+
+	err = interp.ExecuteWithoutEngineCtx(ctx, tx, `TRANSFER OWNERSHIP TO '0xUser';`, nil, nil)
+	require.NoError(t, err)
+
+	for _, stmt := range []string{
+		`CREATE TYPE mood AS ENUM ('sad', 'happy');`,
+		`CREATE ACTION feel($m mood) public view returns (m mood) { RETURN $m; }`,
+	} {
+		err = interp.ExecuteWithoutEngineCtx(ctx, tx, stmt, nil, nil)
+		require.NoError(t, err)
+	}
+
+	var kinds []string
+	err = interp.ExecuteWithoutEngineCtx(ctx, tx, `SELECT kind FROM info.types WHERE namespace = 'main';`, nil, func(r *common.Row) error {
+		kinds = append(kinds, r.Values[0].(string))
+		return nil
+	})
+	require.NoError(t, err)
+	assert.Equal(t, []string{"ENUM"}, kinds)
+
+	var paramTypes []string
+	err = interp.ExecuteWithoutEngineCtx(ctx, tx, `SELECT parameter_types FROM info.actions WHERE namespace = 'main' AND name = 'feel';`, nil, func(r *common.Row) error {
+		for _, v := range r.Values[0].([]*string) {
+			paramTypes = append(paramTypes, *v)
+		}
+		return nil
+	})
+	require.NoError(t, err)
+	assert.Equal(t, []string{"mood"}, paramTypes)
+
+	// the schedules of the upgraded database are run at the end of each block
+	err = interp.ExecuteWithoutEngineCtx(ctx, tx, `CREATE SCHEDULE tick EVERY 1 BLOCKS CALL feel('happy'::mood);`, nil, nil)
+	require.NoError(t, err)
+
+	var names []string
+	err = interp.ExecuteWithoutEngineCtx(ctx, tx, `SELECT name FROM info.schedules;`, nil, func(r *common.Row) error {
+		names = append(names, r.Values[0].(string))
+		return nil
+	})
+	require.NoError(t, err)
+	assert.Equal(t, []string{"tick"}, names)
+
+	block := &common.BlockContext{Height: 2}
+	require.NoError(t, interp.runSchedules(ctx, tx, block))
+	require.Len(t, block.ScheduleResults, 1)
+	assert.Empty(t, block.ScheduleResults[0].Error)
+
+	// the engine restarts on the upgraded database
+	_, err = NewInterpreter(ctx, tx, &common.Service{}, nil, nil, nil)
+	require.NoError(t, err)
+}
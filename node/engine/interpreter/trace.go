@@ -0,0 +1,134 @@
+package interpreter
+
+import (
+	"errors"
+	"strings"
+
+	"github.com/trufnetwork/kwil-db/core/types"
+)
+
+// maxTraceEvents is the most events that a trace records. Traces of long
+// loops would otherwise grow without bound.
+const maxTraceEvents = 10_000
+
+// tracer records the events of a call as a tree.
+type tracer struct {
+	root *types.CallTrace
+	// open are the events that new events are nested in. The last is the
+	// innermost.
+	open   []*types.CallTrace
+	events int
+}
+
+func newTracer(root *types.CallTrace) *tracer {
+	return &tracer{root: root, open: []*types.CallTrace{root}}
+}
+
+// add records an event in the innermost open event. It returns false if the
+// trace is full.
+func (t *tracer) add(event *types.CallTrace) bool {
+	if t.events >= maxTraceEvents {
+		t.root.Truncated = true
+		return false
+	}
+	t.events++
+
+	parent := t.open[len(t.open)-1]
+	parent.Children = append(parent.Children, event)
+	return true
+}
+
+// begin records an event that the following events are nested in, until the
+// returned function is called with the event's error.
+func (t *tracer) begin(event *types.CallTrace) (end func(error)) {
+	if !t.add(event) {
+		return func(error) {}
+	}
+
+	t.open = append(t.open, event)
+	return func(err error) {
+		t.open = t.open[:len(t.open)-1]
+		setTraceErr(event, err)
+	}
+}
+
+// setTraceErr sets the error of an event. Errors used for control flow are
+// not failures.
+func setTraceErr(event *types.CallTrace, err error) {
+	if err == nil || errors.Is(err, errBreak) || errors.Is(err, errContinue) || errors.Is(err, errReturn) {
+		return
+	}
+	event.Error = err.Error()
+}
+
+// traceCall records a call of an action or extension method, if the
+// execution is being traced. Calls of built-in functions are not recorded.
+func (e *executionContext) traceCall(namespace string, exe *executable, args []value) (end func(error)) {
+	if e.trace == nil || exe.Type == executableTypeFunction {
+		return func(error) {}
+	}
+
+	if namespace == "" {
+		namespace = e.scope.namespace
+	}
+
+	params := make([]string, len(args))
+	for i, arg := range args {
+		params[i] = traceValue(arg)
+	}
+
+	return e.trace.begin(&types.CallTrace{
+		Kind:   types.TraceKindCall,
+		Text:   namespace + "." + exe.Name,
+		Params: params,
+	})
+}
+
+// traceAssign records the assignment of a variable, if the execution is being
+// traced.
+func (e *executionContext) traceAssign(name string, val value) {
+	if e.trace == nil {
+		return
+	}
+
+	e.trace.add(&types.CallTrace{
+		Kind: types.TraceKindAssign,
+		Text: name + " = " + traceValue(val),
+	})
+}
+
+// traceValue formats a value for a trace.
+func traceValue(v value) string {
+	if v == nil || v.Null() {
+		return "NULL"
+	}
+
+	if arr, ok := v.(arrayValue); ok {
+		elems := make([]string, arr.Len())
+		for i := range elems {
+			elem, err := arr.Get(int32(i + 1))
+			if err != nil {
+				return "<" + err.Error() + ">"
+			}
+			elems[i] = traceValue(elem)
+		}
+		return "[" + strings.Join(elems, ", ") + "]"
+	}
+
+	if rec, ok := v.(*recordValue); ok {
+		fields := make([]string, len(rec.Order))
+		for i, name := range rec.Order {
+			fields[i] = name + ": " + traceValue(rec.Fields[name])
+		}
+		return "{" + strings.Join(fields, ", ") + "}"
+	}
+
+	s, err := stringifyValue(v)
+	if err != nil {
+		return "<" + err.Error() + ">"
+	}
+	if _, ok := v.(*textValue); ok {
+		return "'" + strings.ReplaceAll(s, "'", "''") + "'"
+	}
+	return s
+}
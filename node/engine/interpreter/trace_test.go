@@ -0,0 +1,59 @@
+package interpreter
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/trufnetwork/kwil-db/core/types"
+)
+
+func Test_Tracer(t *testing.T) {
+	root := &types.CallTrace{Kind: types.TraceKindCall, Text: "main.act"}
+	tr := newTracer(root)
+
+	end := tr.begin(&types.CallTrace{Kind: types.TraceKindStatement, Line: 2})
+	tr.add(&types.CallTrace{Kind: types.TraceKindAssign, Text: "$a = 1"})
+	end(errBreak)
+
+	end = tr.begin(&types.CallTrace{Kind: types.TraceKindStatement, Line: 3})
+	end(errors.New("boom"))
+
+	require.Len(t, root.Children, 2)
+	require.Len(t, root.Children[0].Children, 1)
+	assert.Equal(t, "$a = 1", root.Children[0].Children[0].Text)
+	// control flow is not an error
+	assert.Empty(t, root.Children[0].Error)
+	assert.Equal(t, "boom", root.Children[1].Error)
+	assert.False(t, root.Truncated)
+
+	for range maxTraceEvents {
+		tr.add(&types.CallTrace{Kind: types.TraceKindAssign})
+	}
+	assert.True(t, root.Truncated)
+	// the three events above count towards the limit
+	assert.Len(t, root.Children, maxTraceEvents-1)
+}
+
+func Test_TraceValue(t *testing.T) {
+	tests := []struct {
+		name string
+		val  any
+		want string
+	}{
+		{"int", int64(1), "1"},
+		{"text", "it's", "'it''s'"},
+		{"bool", true, "true"},
+		{"null", nil, "NULL"},
+		{"array", []*int64{ptr[int64](1), nil}, "[1, NULL]"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			v, err := newValue(tt.val)
+			require.NoError(t, err)
+			assert.Equal(t, tt.want, traceValue(v))
+		})
+	}
+}
@@ -16,6 +16,9 @@ type action struct {
 
 	// Parameters are the input parameters of the action.
 	Parameters []*engine.NamedType `json:"parameters"`
+	// Defaults are the default values of the parameters, by position.
+	// A nil entry means the parameter has no default.
+	Defaults []parse.Expression
 	// Modifiers modify the access to the action.
 	Modifiers []precompiles.Modifier `json:"modifiers"`
 
@@ -24,6 +27,10 @@ type action struct {
 
 	// RawStatement is the unparsed CREATE ACTION statement.
 	RawStatement string `json:"raw_statement"`
+	// startLine is the line of the CREATE ACTION statement in the input it
+	// was parsed from. The positions of the statements in Body are relative
+	// to that input.
+	startLine int
 
 	// Returns specifies the return types of the action.
 	Returns *actionReturn `json:"return_types"`
@@ -38,8 +45,12 @@ func (a *action) FromAST(ast *parse.CreateActionStatement) error {
 	a.Name = ast.Name
 	a.RawStatement = ast.Raw
 	a.Body = ast.Statements
+	if pos := ast.GetPosition(); pos.StartLine != nil {
+		a.startLine = *pos.StartLine
+	}
 
 	a.Parameters = ast.Parameters
+	a.Defaults = ast.Defaults
 
 	if ast.Returns != nil {
 		a.Returns = &actionReturn{
@@ -0,0 +1,398 @@
+package interpreter
+
+import (
+	"encoding/hex"
+	"fmt"
+	"slices"
+	"strings"
+
+	"github.com/jackc/pgx/v5/pgtype"
+	"github.com/trufnetwork/kwil-db/core/types"
+	"github.com/trufnetwork/kwil-db/node/engine"
+)
+
+// userTypeDef is the definition of a user-defined type, with the definitions
+// of any user-defined types of its fields resolved.
+type userTypeDef struct {
+	*types.UserType
+	// fieldDefs are the definitions of the types of the fields of a
+	// composite type. They are nil for fields of built-in types.
+	fieldDefs []*userTypeDef
+}
+
+// getUserType gets the definition of a user-defined type in a namespace.
+func (e *executionContext) getUserType(namespace, name string) (*userTypeDef, error) {
+	ns, ok := e.interpreter.namespaces[namespace]
+	if !ok {
+		return nil, fmt.Errorf(`%w: "%s"`, engine.ErrNamespaceNotFound, namespace)
+	}
+
+	ut, ok := ns.userTypes[name]
+	if !ok {
+		return nil, fmt.Errorf(`%w: "%s" in namespace "%s"`, engine.ErrUnknownType, name, namespace)
+	}
+
+	def := &userTypeDef{
+		UserType:  ut,
+		fieldDefs: make([]*userTypeDef, len(ut.Fields)),
+	}
+	for i, field := range ut.Fields {
+		if !field.Type.IsUserDefined() {
+			continue
+		}
+
+		fieldDef, err := e.getUserType(namespace, field.Type.Name)
+		if err != nil {
+			return nil, err
+		}
+		def.fieldDefs[i] = fieldDef
+	}
+
+	return def, nil
+}
+
+// checkType checks that a type used in a namespace exists. Built-in types
+// always exist, and user-defined types must have been created in the
+// namespace. Arrays of user-defined types are not supported.
+func (e *executionContext) checkType(t *types.DataType) error {
+	if !t.IsUserDefined() {
+		return nil
+	}
+
+	if t.IsArray {
+		return fmt.Errorf(`%w: arrays of user-defined type "%s" are not supported`, engine.ErrType, t.Name)
+	}
+
+	_, err := e.getUserType(e.scope.namespace, t.Name)
+	return err
+}
+
+// castValue casts a value to a type. Unlike value.Cast, it can cast to the
+// user-defined types of the given namespace.
+func (e *executionContext) castValue(namespace string, v value, t *types.DataType) (value, error) {
+	if !t.IsUserDefined() || t.IsArray {
+		return v.Cast(t)
+	}
+
+	def, err := e.getUserType(namespace, t.Name)
+	if err != nil {
+		return nil, err
+	}
+
+	return def.cast(v)
+}
+
+// newScanValue creates a value that a query result column of the given type
+// can be scanned into.
+func (e *executionContext) newScanValue(t *types.DataType) (value, error) {
+	if !t.IsUserDefined() || t.IsArray {
+		return newZeroValue(t)
+	}
+
+	def, err := e.getUserType(e.scope.namespace, t.Name)
+	if err != nil {
+		return nil, err
+	}
+
+	return def.null(), nil
+}
+
+// null returns a null value of the type.
+func (d *userTypeDef) null() *userValue {
+	return &userValue{typeName: d.Name, def: d}
+}
+
+// valid returns a non-null value of the type from its text representation.
+func (d *userTypeDef) valid(text string) *userValue {
+	return &userValue{
+		Text:     pgtype.Text{String: text, Valid: true},
+		typeName: d.Name,
+		def:      d,
+	}
+}
+
+// cast casts a value to the type. Text is parsed as the type's text
+// representation, and composites passed by a caller are checked against
+// the fields of the type.
+func (d *userTypeDef) cast(v value) (*userValue, error) {
+	if v.Null() {
+		return d.null(), nil
+	}
+
+	switch v := v.(type) {
+	case *userValue:
+		if v.typeName != d.Name {
+			return nil, castErr(fmt.Errorf("cannot cast %s to %s", v.typeName, d.Name))
+		}
+		if v.fields != nil {
+			return d.fromFields(v.fields)
+		}
+
+		return d.fromText(v.String)
+	case *textValue:
+		return d.fromText(v.String)
+	default:
+		return nil, castErr(fmt.Errorf("cannot cast %s to %s", v.Type(), d.Name))
+	}
+}
+
+// fromText creates a value of the type from its text representation.
+func (d *userTypeDef) fromText(text string) (*userValue, error) {
+	if d.IsEnum() {
+		if !slices.Contains(d.Labels, text) {
+			return nil, castErr(fmt.Errorf(`invalid label "%s" for enum %s`, text, d.Name))
+		}
+
+		return d.valid(text), nil
+	}
+
+	fields, err := d.parseFields(text)
+	if err != nil {
+		return nil, err
+	}
+
+	return d.fromValues(fields)
+}
+
+// fromFields creates a composite value from its fields by name. Omitted fields
+// are null.
+func (d *userTypeDef) fromFields(fields map[string]value) (*userValue, error) {
+	if d.IsEnum() {
+		return nil, castErr(fmt.Errorf("cannot cast a composite value to enum %s", d.Name))
+	}
+
+	for name := range fields {
+		if !slices.ContainsFunc(d.Fields, func(f *types.UserTypeField) bool { return f.Name == name }) {
+			return nil, castErr(fmt.Errorf(`type %s has no field "%s"`, d.Name, name))
+		}
+	}
+
+	vals := make([]value, len(d.Fields))
+	for i, field := range d.Fields {
+		v, ok := fields[field.Name]
+		if !ok || v.Null() {
+			vals[i] = d.nullField(i)
+			continue
+		}
+
+		if !field.Type.Equals(v.Type()) {
+			return nil, fmt.Errorf("%w: expected field %s of type %s to be %s, got %s", engine.ErrType, field.Name, d.Name, field.Type, v.Type())
+		}
+
+		var err error
+		if d.fieldDefs[i] != nil {
+			vals[i], err = d.fieldDefs[i].cast(v)
+		} else {
+			// type cast, in case of precision and scale
+			vals[i], err = v.Cast(field.Type)
+		}
+		if err != nil {
+			return nil, fmt.Errorf("field %s: %w", field.Name, err)
+		}
+	}
+
+	return d.fromValues(vals)
+}
+
+// fromValues creates a composite value from the values of all of its fields,
+// in the order they are defined.
+func (d *userTypeDef) fromValues(vals []value) (*userValue, error) {
+	texts := make([]*string, len(vals))
+	for i, v := range vals {
+		var err error
+		texts[i], err = pgText(v)
+		if err != nil {
+			return nil, fmt.Errorf("field %s: %w", d.Fields[i].Name, err)
+		}
+	}
+
+	return d.valid(formatRecord(texts)), nil
+}
+
+// nullField returns a null value of the type of the i-th field.
+func (d *userTypeDef) nullField(i int) value {
+	if d.fieldDefs[i] != nil {
+		return d.fieldDefs[i].null()
+	}
+
+	v, err := makeNull(d.Fields[i].Type)
+	if err != nil {
+		// the field types are checked when the type is created
+		panic(err)
+	}
+	return v
+}
+
+// parseFields parses the text representation of a composite value into the
+// values of its fields.
+func (d *userTypeDef) parseFields(text string) ([]value, error) {
+	texts, err := parseRecord(text)
+	if err != nil {
+		return nil, castErr(err)
+	}
+	if len(texts) != len(d.Fields) {
+		return nil, castErr(fmt.Errorf("expected %d fields for type %s, got %d", len(d.Fields), d.Name, len(texts)))
+	}
+
+	vals := make([]value, len(texts))
+	for i, t := range texts {
+		if t == nil {
+			vals[i] = d.nullField(i)
+			continue
+		}
+
+		field := d.Fields[i]
+		switch {
+		case d.fieldDefs[i] != nil:
+			vals[i], err = d.fieldDefs[i].fromText(*t)
+		case *field.Type == *types.ByteaType:
+			var b []byte
+			b, err = hex.DecodeString(strings.TrimPrefix(*t, `\x`))
+			vals[i] = makeBlob(b)
+		default:
+			vals[i], err = makeText(*t).Cast(field.Type)
+		}
+		if err != nil {
+			return nil, fmt.Errorf("field %s: %w", field.Name, err)
+		}
+	}
+
+	return vals, nil
+}
+
+// decodeComposite decodes the text representation of a composite value.
+func (d *userTypeDef) decodeComposite(text string) (*types.Composite, error) {
+	vals, err := d.parseFields(text)
+	if err != nil {
+		return nil, err
+	}
+
+	c := &types.Composite{
+		Type:   d.Name,
+		Fields: make([]*types.CompositeField, len(vals)),
+	}
+	for i, v := range vals {
+		c.Fields[i] = &types.CompositeField{
+			Name:  d.Fields[i].Name,
+			Value: v.RawValue(),
+		}
+	}
+
+	return c, nil
+}
+
+// pgText returns the Postgres text representation of a scalar value, as it
+// appears in a record literal. It returns nil for nulls.
+func pgText(v value) (*string, error) {
+	if v.Null() {
+		return nil, nil
+	}
+
+	var s string
+	switch v := v.(type) {
+	case *userValue:
+		s = v.String
+	case *blobValue:
+		s = `\x` + hex.EncodeToString(v.bts)
+	case *boolValue:
+		// Postgres outputs booleans as t and f
+		s = "f"
+		if v.Bool.Bool {
+			s = "t"
+		}
+	case scalarValue:
+		txt, err := v.Cast(types.TextType)
+		if err != nil {
+			return nil, err
+		}
+		s = txt.RawValue().(string)
+	default:
+		return nil, fmt.Errorf("%w: cannot use %s in a composite value", engine.ErrType, v.Type())
+	}
+
+	return &s, nil
+}
+
+// formatRecord formats a Postgres record literal. It quotes fields the same
+// way Postgres does, so that equal values have equal text representations.
+func formatRecord(fields []*string) string {
+	var str strings.Builder
+	str.WriteByte('(')
+	for i, f := range fields {
+		if i > 0 {
+			str.WriteByte(',')
+		}
+		if f == nil {
+			continue
+		}
+
+		if *f != "" && !strings.ContainsAny(*f, "\"\\(), \t\n\r\v\f") {
+			str.WriteString(*f)
+			continue
+		}
+
+		str.WriteByte('"')
+		for _, c := range *f {
+			if c == '"' || c == '\\' {
+				str.WriteRune(c)
+			}
+			str.WriteRune(c)
+		}
+		str.WriteByte('"')
+	}
+	str.WriteByte(')')
+
+	return str.String()
+}
+
+// parseRecord parses a Postgres record literal into the text of its fields.
+// Fields that are null are nil.
+func parseRecord(s string) ([]*string, error) {
+	if len(s) < 2 || s[0] != '(' || s[len(s)-1] != ')' {
+		return nil, fmt.Errorf("invalid record literal %s", s)
+	}
+	s = s[1 : len(s)-1]
+
+	var fields []*string
+	for i := 0; ; {
+		// an unquoted empty field is null
+		if i == len(s) || s[i] == ',' {
+			fields = append(fields, nil)
+		} else {
+			var field strings.Builder
+			inQuotes := false
+		loop:
+			for ; i < len(s); i++ {
+				c := s[i]
+				switch {
+				case c == '\\':
+					i++
+					if i == len(s) {
+						return nil, fmt.Errorf("unexpected end of record literal %s", s)
+					}
+					field.WriteByte(s[i])
+				case c == '"' && inQuotes && i+1 < len(s) && s[i+1] == '"':
+					i++
+					field.WriteByte('"')
+				case c == '"':
+					inQuotes = !inQuotes
+				case c == ',' && !inQuotes:
+					break loop
+				default:
+					field.WriteByte(c)
+				}
+			}
+			if inQuotes {
+				return nil, fmt.Errorf("unterminated quote in record literal %s", s)
+			}
+
+			f := field.String()
+			fields = append(fields, &f)
+		}
+
+		if i == len(s) {
+			return fields, nil
+		}
+		i++ // skip the comma
+	}
+}
@@ -0,0 +1,121 @@
+package interpreter
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/trufnetwork/kwil-db/core/types"
+	"github.com/trufnetwork/kwil-db/node/engine"
+)
+
+func Test_RecordLiteral(t *testing.T) {
+	str := func(s string) *string { return &s }
+
+	tests := []struct {
+		name    string
+		literal string
+		fields  []*string
+	}{
+		{"simple", `(1,abc)`, []*string{str("1"), str("abc")}},
+		{"nulls", `(,1,)`, []*string{nil, str("1"), nil}},
+		{"empty string", `("",1)`, []*string{str(""), str("1")}},
+		{"quoted", `("a b","a,b","(x)")`, []*string{str("a b"), str("a,b"), str("(x)")}},
+		{"escapes", `("say ""hi""","back\\slash")`, []*string{str(`say "hi"`), str(`back\slash`)}},
+		{"nested", `(1,"(2,""a b"")")`, []*string{str("1"), str(`(2,"a b")`)}},
+		{"single null", `()`, []*string{nil}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			fields, err := parseRecord(tt.literal)
+			require.NoError(t, err)
+			assert.Equal(t, tt.fields, fields)
+
+			// formatting quotes fields the same way Postgres does
+			assert.Equal(t, tt.literal, formatRecord(tt.fields))
+		})
+	}
+
+	for _, invalid := range []string{``, `1,2`, `("a)`, `(a\)`} {
+		_, err := parseRecord(invalid)
+		assert.Error(t, err, invalid)
+	}
+}
+
+func Test_UserTypeCast(t *testing.T) {
+	mood := &userTypeDef{
+		UserType: &types.UserType{Name: "mood", Labels: []string{"sad", "happy"}},
+	}
+	entry := &userTypeDef{
+		UserType: &types.UserType{
+			Name: "entry",
+			Fields: []*types.UserTypeField{
+				{Name: "id", Type: types.IntType},
+				{Name: "note", Type: types.TextType},
+				{Name: "data", Type: types.ByteaType},
+				{Name: "ok", Type: types.BoolType},
+				{Name: "mood", Type: &types.DataType{Name: "mood"}},
+			},
+		},
+		fieldDefs: []*userTypeDef{nil, nil, nil, nil, mood},
+	}
+
+	// enums are checked against their labels
+	v, err := mood.cast(makeText("happy"))
+	require.NoError(t, err)
+	assert.Equal(t, &types.Enum{Type: "mood", Label: "happy"}, v.RawValue())
+
+	_, err = mood.cast(makeText("angry"))
+	assert.ErrorIs(t, err, engine.ErrCast)
+
+	// composites passed by callers are ordered by their definition,
+	// and omitted fields are null
+	arg, err := newValue(&types.Composite{
+		Type: "entry",
+		Fields: []*types.CompositeField{
+			{Name: "mood", Value: types.Enum{Type: "mood", Label: "sad"}},
+			{Name: "note", Value: "a, b"},
+			{Name: "id", Value: int64(1)},
+			{Name: "ok", Value: true},
+		},
+	})
+	require.NoError(t, err)
+
+	v, err = entry.cast(arg)
+	require.NoError(t, err)
+	assert.Equal(t, `(1,"a, b",,t,sad)`, v.String)
+
+	// the same value can be read from its text representation
+	v2, err := entry.cast(makeText(`(1,"a, b",,t,sad)`))
+	require.NoError(t, err)
+	eq, err := v.Compare(v2, _EQUAL)
+	require.NoError(t, err)
+	assert.True(t, eq.Bool.Bool)
+
+	assert.Equal(t, &types.Composite{
+		Type: "entry",
+		Fields: []*types.CompositeField{
+			{Name: "id", Value: int64(1)},
+			{Name: "note", Value: "a, b"},
+			{Name: "data", Value: nil},
+			{Name: "ok", Value: true},
+			{Name: "mood", Value: &types.Enum{Type: "mood", Label: "sad"}},
+		},
+	}, v.RawValue())
+
+	// fields must exist and have the declared type
+	arg, err = newValue(types.Composite{Type: "entry", Fields: []*types.CompositeField{{Name: "missing", Value: int64(1)}}})
+	require.NoError(t, err)
+	_, err = entry.cast(arg)
+	assert.ErrorIs(t, err, engine.ErrCast)
+
+	arg, err = newValue(types.Composite{Type: "entry", Fields: []*types.CompositeField{{Name: "id", Value: "1"}}})
+	require.NoError(t, err)
+	_, err = entry.cast(arg)
+	assert.ErrorIs(t, err, engine.ErrType)
+
+	// invalid labels of nested enums are rejected
+	_, err = entry.cast(makeText(`(1,,,,angry)`))
+	assert.ErrorIs(t, err, engine.ErrCast)
+}
@@ -11,6 +11,7 @@ import (
 
 	"github.com/jackc/pgx/v5/pgtype"
 	"github.com/trufnetwork/kwil-db/core/types"
+	"github.com/trufnetwork/kwil-db/core/utils/order"
 	"github.com/trufnetwork/kwil-db/node/engine"
 )
 
@@ -590,6 +591,31 @@ func newValue(v any) (value, error) {
 		return &uuidArrayValue{
 			singleDimArray: newValidArr(pgUUIDs),
 		}, nil
+	case types.Enum:
+		return &userValue{
+			Text:     pgtype.Text{String: v.Label, Valid: true},
+			typeName: strings.ToLower(v.Type),
+		}, nil
+	case types.Composite:
+		fields := make(map[string]value, len(v.Fields))
+		for _, f := range v.Fields {
+			name := strings.ToLower(f.Name)
+			if _, ok := fields[name]; ok {
+				return nil, fmt.Errorf("duplicate field %s in value of type %s", f.Name, v.Type)
+			}
+
+			fv, err := newValue(f.Value)
+			if err != nil {
+				return nil, fmt.Errorf("field %s: %w", f.Name, err)
+			}
+			fields[name] = fv
+		}
+
+		return &userValue{
+			Text:     pgtype.Text{Valid: true},
+			typeName: strings.ToLower(v.Type),
+			fields:   fields,
+		}, nil
 	case nil:
 		return &nullValue{}, nil
 	case []any:
@@ -829,6 +855,10 @@ func (i *int8Value) Cast(t *types.DataType) (value, error) {
 
 // makeNull creates a new null value of the given type.
 func makeNull(t *types.DataType) (value, error) {
+	if t.IsUserDefined() && !t.IsArray {
+		return &userValue{typeName: t.Name}, nil
+	}
+
 	m, ok := kwilTypeToValue[struct {
 		name    string
 		isArray bool
@@ -2489,6 +2519,103 @@ func (a *uuidArrayValue) Cast(t *types.DataType) (value, error) {
 	}
 }
 
+// userValue is a value of a user-defined enum or composite type. It is held
+// in its Postgres text representation, which is the label for enums and a
+// record literal (e.g. (1,"some text")) for composites.
+type userValue struct {
+	pgtype.Text
+	// typeName is the name of the user-defined type.
+	typeName string
+	// def is the definition of the type. It is nil for values that were
+	// passed by a caller and have not yet been cast to a namespace's type.
+	def *userTypeDef
+	// fields are the fields of a composite value passed by a caller. They
+	// are checked against the definition of the type when the value is cast.
+	fields map[string]value
+}
+
+func (u *userValue) Null() bool {
+	return !u.Valid
+}
+
+func (u *userValue) Compare(v value, op comparisonOp) (*boolValue, error) {
+	if res, early := nullCmp(u, v, op); early {
+		return res, nil
+	}
+
+	val2, ok := v.(*userValue)
+	if !ok || u.typeName != val2.typeName {
+		return nil, makeTypeErr(u, v)
+	}
+	if u.fields != nil || val2.fields != nil {
+		return nil, fmt.Errorf("%w: cannot compare values of type %s before they are cast", engine.ErrComparison, u.typeName)
+	}
+
+	switch op {
+	case _EQUAL:
+		return makeBool(u.String == val2.String), nil
+	case _IS_DISTINCT_FROM:
+		return makeBool(u.String != val2.String), nil
+	default:
+		return nil, fmt.Errorf("%w: cannot use comparison operator %s with type %s", engine.ErrComparison, op, u.typeName)
+	}
+}
+
+func (u *userValue) Arithmetic(v scalarValue, op arithmeticOp) (scalarValue, error) {
+	return nil, fmt.Errorf("%w: cannot perform arithmetic operation %s on type %s", engine.ErrArithmetic, op, u.typeName)
+}
+
+func (u *userValue) Unary(op unaryOp) (scalarValue, error) {
+	return nil, fmt.Errorf("%w: cannot perform unary operation on type %s", engine.ErrUnary, u.typeName)
+}
+
+func (u *userValue) Type() *types.DataType {
+	return &types.DataType{Name: u.typeName}
+}
+
+// RawValue returns a *types.Enum or a *types.Composite.
+func (u *userValue) RawValue() any {
+	if !u.Valid {
+		return nil
+	}
+
+	if u.fields != nil {
+		c := &types.Composite{Type: u.typeName}
+		for _, kv := range order.OrderMap(u.fields) {
+			c.Fields = append(c.Fields, &types.CompositeField{Name: kv.Key, Value: kv.Value.RawValue()})
+		}
+		return c
+	}
+
+	if u.def == nil || u.def.IsEnum() {
+		return &types.Enum{Type: u.typeName, Label: u.String}
+	}
+
+	c, err := u.def.decodeComposite(u.String)
+	if err != nil {
+		// values are only resolved after they have been checked, so this
+		// should never happen
+		panic(err)
+	}
+	return c
+}
+
+func (u *userValue) Cast(t *types.DataType) (value, error) {
+	if u.Null() {
+		return makeNull(t)
+	}
+
+	if t.Name == u.typeName && !t.IsArray {
+		return u, nil
+	}
+
+	if *t == *types.TextType && u.fields == nil {
+		return makeText(u.String), nil
+	}
+
+	return nil, castErr(fmt.Errorf("cannot cast %s to %s", u.typeName, t))
+}
+
 // emptyRecordValue creates a new empty record value.
 func emptyRecordValue() *recordValue {
 	return &recordValue{
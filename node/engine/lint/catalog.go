@@ -0,0 +1,470 @@
+package lint
+
+import (
+	"fmt"
+	"maps"
+	"slices"
+	"strings"
+
+	"github.com/trufnetwork/kwil-db/core/types"
+	"github.com/trufnetwork/kwil-db/node/engine"
+	"github.com/trufnetwork/kwil-db/node/engine/parse"
+)
+
+// Catalog is a virtual catalog of namespaces, tables, and actions. It is
+// built purely from DDL, and is used in place of a database when linting.
+type Catalog struct {
+	namespaces map[string]*namespace
+}
+
+type namespace struct {
+	tables    map[string]*engine.Table
+	actions   map[string]*parse.CreateActionStatement
+	userTypes map[string]*parse.CreateTypeStatement
+	schedules map[string]*parse.CreateScheduleStatement
+}
+
+// NewCatalog creates a new catalog containing only the default namespace.
+func NewCatalog() *Catalog {
+	c := &Catalog{
+		namespaces: make(map[string]*namespace),
+	}
+	c.namespace(engine.DefaultNamespace)
+	return c
+}
+
+// namespace gets a namespace, creating it if it does not exist.
+func (c *Catalog) namespace(name string) *namespace {
+	ns, ok := c.namespaces[name]
+	if !ok {
+		ns = &namespace{
+			tables:    make(map[string]*engine.Table),
+			actions:   make(map[string]*parse.CreateActionStatement),
+			userTypes: make(map[string]*parse.CreateTypeStatement),
+			schedules: make(map[string]*parse.CreateScheduleStatement),
+		}
+		c.namespaces[name] = ns
+	}
+	return ns
+}
+
+// Table gets a table from the catalog.
+func (c *Catalog) Table(ns, name string) (*engine.Table, bool) {
+	n, ok := c.namespaces[ns]
+	if !ok {
+		return nil, false
+	}
+	tbl, ok := n.tables[name]
+	return tbl, ok
+}
+
+// Action gets an action from the catalog.
+func (c *Catalog) Action(ns, name string) (*parse.CreateActionStatement, bool) {
+	n, ok := c.namespaces[ns]
+	if !ok {
+		return nil, false
+	}
+	act, ok := n.actions[name]
+	return act, ok
+}
+
+// Actions returns all actions in a namespace.
+func (c *Catalog) Actions(ns string) []*parse.CreateActionStatement {
+	n, ok := c.namespaces[ns]
+	if !ok {
+		return nil
+	}
+
+	acts := make([]*parse.CreateActionStatement, 0, len(n.actions))
+	for _, act := range n.actions {
+		acts = append(acts, act)
+	}
+	return acts
+}
+
+// Apply applies a top-level statement to the catalog. currentNamespace is the
+// namespace that the statement is executed in if it is not prefixed with one.
+// Statements that do not affect the catalog are ignored. It returns the
+// namespace that subsequent statements should be executed in.
+func (c *Catalog) Apply(stmt parse.TopLevelStatement, currentNamespace string) (string, error) {
+	nsName := statementNamespace(stmt, currentNamespace)
+
+	switch s := stmt.(type) {
+	case *parse.SetCurrentNamespaceStatement:
+		c.namespace(s.Namespace)
+		return s.Namespace, nil
+	case *parse.CreateNamespaceStatement:
+		c.namespace(s.Namespace)
+	case *parse.DropNamespaceStatement:
+		delete(c.namespaces, s.Namespace)
+	case *parse.CreateTableStatement:
+		ns := c.namespace(nsName)
+		if _, ok := ns.tables[s.Name]; ok {
+			if s.IfNotExists {
+				return currentNamespace, nil
+			}
+			return currentNamespace, fmt.Errorf(`table "%s" already exists`, s.Name)
+		}
+
+		for _, col := range s.Columns {
+			if err := ns.checkType(col.Type); err != nil {
+				return currentNamespace, err
+			}
+		}
+
+		ns.tables[s.Name] = TableFromAST(s)
+	case *parse.DropTableStatement:
+		ns := c.namespace(nsName)
+		for _, name := range s.Tables {
+			if _, ok := ns.tables[name]; !ok && !s.IfExists {
+				return currentNamespace, fmt.Errorf(`table "%s" does not exist`, name)
+			}
+			delete(ns.tables, name)
+		}
+	case *parse.AlterTableStatement:
+		ns := c.namespace(nsName)
+		tbl, ok := ns.tables[s.Table]
+		if !ok {
+			return currentNamespace, fmt.Errorf(`table "%s" does not exist`, s.Table)
+		}
+
+		for _, act := range s.Actions {
+			if add, ok := act.(*parse.AddColumn); ok {
+				if err := ns.checkType(add.Type); err != nil {
+					return currentNamespace, err
+				}
+			}
+		}
+
+		newName, err := alterTable(tbl, s)
+		if err != nil {
+			return currentNamespace, err
+		}
+		if newName != s.Table {
+			delete(ns.tables, s.Table)
+			ns.tables[newName] = tbl
+		}
+	case *parse.CreateIndexStatement:
+		ns := c.namespace(nsName)
+		tbl, ok := ns.tables[s.On]
+		if !ok {
+			return currentNamespace, fmt.Errorf(`table "%s" does not exist`, s.On)
+		}
+
+		for _, col := range s.Columns {
+			if _, ok := tbl.Column(col); !ok {
+				return currentNamespace, fmt.Errorf(`column "%s" does not exist on table "%s"`, col, s.On)
+			}
+		}
+
+		typ := engine.BTREE
+		if s.Type == parse.IndexTypeUnique {
+			typ = engine.UNIQUE_BTREE
+		}
+		tbl.Indexes = append(tbl.Indexes, &engine.Index{
+			Name:    s.Name,
+			Columns: s.Columns,
+			Type:    typ,
+		})
+	case *parse.CreateActionStatement:
+		ns := c.namespace(nsName)
+		if _, ok := ns.actions[s.Name]; ok && !s.OrReplace {
+			if s.IfNotExists {
+				return currentNamespace, nil
+			}
+			return currentNamespace, fmt.Errorf(`action "%s" already exists`, s.Name)
+		}
+
+		fields := s.Parameters
+		if s.Returns != nil {
+			fields = append(slices.Clip(fields), s.Returns.Fields...)
+		}
+		for _, f := range fields {
+			if err := ns.checkType(f.Type); err != nil {
+				return currentNamespace, err
+			}
+		}
+		ns.actions[s.Name] = s
+	case *parse.DropActionStatement:
+		ns := c.namespace(nsName)
+		if _, ok := ns.actions[s.Name]; !ok && !s.IfExists {
+			return currentNamespace, fmt.Errorf(`action "%s" does not exist`, s.Name)
+		}
+		delete(ns.actions, s.Name)
+	case *parse.CreateTypeStatement:
+		ns := c.namespace(nsName)
+		if _, ok := ns.userTypes[s.Name]; ok {
+			if s.IfNotExists {
+				return currentNamespace, nil
+			}
+			return currentNamespace, fmt.Errorf(`type "%s" already exists`, s.Name)
+		}
+
+		for _, f := range s.Fields {
+			if f.Type.IsArray {
+				return currentNamespace, fmt.Errorf(`field "%s" of type "%s" cannot be an array`, f.Name, s.Name)
+			}
+			if err := ns.checkType(f.Type); err != nil {
+				return currentNamespace, err
+			}
+		}
+		ns.userTypes[s.Name] = s
+	case *parse.DropTypeStatement:
+		ns := c.namespace(nsName)
+		if _, ok := ns.userTypes[s.Name]; !ok && !s.IfExists {
+			return currentNamespace, fmt.Errorf(`type "%s" does not exist`, s.Name)
+		}
+		delete(ns.userTypes, s.Name)
+	case *parse.CreateScheduleStatement:
+		ns := c.namespace(nsName)
+		if _, ok := ns.schedules[s.Name]; ok {
+			if s.IfNotExists {
+				return currentNamespace, nil
+			}
+			return currentNamespace, fmt.Errorf(`schedule "%s" already exists`, s.Name)
+		}
+
+		// actions of namespaces that are not in the catalog, such as
+		// extensions, cannot be checked
+		callNs := nsName
+		if s.Call.Namespace != "" {
+			callNs = s.Call.Namespace
+		}
+		if target, ok := c.namespaces[callNs]; ok {
+			if _, ok := target.actions[s.Call.Name]; !ok {
+				return currentNamespace, fmt.Errorf(`action "%s" does not exist`, s.Call.Name)
+			}
+		}
+		ns.schedules[s.Name] = s
+	case *parse.DropScheduleStatement:
+		ns := c.namespace(nsName)
+		if _, ok := ns.schedules[s.Name]; !ok && !s.IfExists {
+			return currentNamespace, fmt.Errorf(`schedule "%s" does not exist`, s.Name)
+		}
+		delete(ns.schedules, s.Name)
+	}
+
+	return currentNamespace, nil
+}
+
+// checkType checks that a user-defined type exists in the namespace.
+func (n *namespace) checkType(t *types.DataType) error {
+	if !t.IsUserDefined() {
+		return nil
+	}
+	if t.IsArray {
+		return fmt.Errorf(`arrays of user-defined type "%s" are not supported`, t.Name)
+	}
+	if _, ok := n.userTypes[t.Name]; !ok {
+		return fmt.Errorf(`type "%s" does not exist`, t.Name)
+	}
+	return nil
+}
+
+// statementNamespace returns the namespace that a statement targets.
+func statementNamespace(stmt parse.TopLevelStatement, currentNamespace string) string {
+	if n, ok := stmt.(parse.Namespaceable); ok && n.GetNamespacePrefix() != "" {
+		return n.GetNamespacePrefix()
+	}
+	return currentNamespace
+}
+
+// TableFromAST converts a CREATE TABLE statement to a table.
+// The parser has already validated the table definition, so
+// it does not check for duplicate columns or missing primary keys.
+func TableFromAST(stmt *parse.CreateTableStatement) *engine.Table {
+	tbl := &engine.Table{
+		Name:        stmt.Name,
+		Constraints: make(map[string]*engine.Constraint),
+	}
+
+	var pk []string
+	for _, col := range stmt.Columns {
+		c := &engine.Column{
+			Name:     col.Name,
+			DataType: col.Type,
+			Nullable: true,
+		}
+
+		for _, con := range col.Constraints {
+			switch con.(type) {
+			case *parse.PrimaryKeyInlineConstraint:
+				pk = append(pk, col.Name)
+			case *parse.NotNullConstraint:
+				c.Nullable = false
+			case *parse.UniqueInlineConstraint:
+				tbl.Constraints[stmt.Name+"_"+col.Name+"_key"] = &engine.Constraint{
+					Type:    engine.ConstraintUnique,
+					Columns: []string{col.Name},
+				}
+			}
+		}
+
+		tbl.Columns = append(tbl.Columns, c)
+	}
+
+	for _, con := range stmt.Constraints {
+		name := con.Name
+		if name == "" {
+			name = stmt.Name + "_" + strings.Join(con.Constraint.LocalColumns(), "_")
+		}
+
+		switch c := con.Constraint.(type) {
+		case *parse.PrimaryKeyOutOfLineConstraint:
+			pk = append(pk, c.Columns...)
+		case *parse.UniqueOutOfLineConstraint:
+			tbl.Constraints[name+"_key"] = &engine.Constraint{
+				Type:    engine.ConstraintUnique,
+				Columns: c.Columns,
+			}
+		case *parse.ForeignKeyOutOfLineConstraint:
+			tbl.Constraints[name+"_fkey"] = &engine.Constraint{
+				Type:    engine.ConstraintFK,
+				Columns: c.Columns,
+			}
+		case *parse.CheckConstraint:
+			tbl.Constraints[name+"_check"] = &engine.Constraint{
+				Type: engine.ConstraintCheck,
+			}
+		}
+	}
+
+	if len(pk) == 0 {
+		return tbl
+	}
+
+	for _, name := range pk {
+		if col, ok := tbl.Column(name); ok {
+			col.IsPrimaryKey = true
+			col.Nullable = false
+		}
+	}
+	tbl.Indexes = append(tbl.Indexes, &engine.Index{
+		Name:    stmt.Name + "_pkey",
+		Columns: pk,
+		Type:    engine.PRIMARY,
+	})
+
+	return tbl
+}
+
+// alterTable applies an ALTER TABLE statement to a table.
+// It returns the name of the table after the statement is applied.
+func alterTable(tbl *engine.Table, stmt *parse.AlterTableStatement) (string, error) {
+	name := stmt.Table
+	for _, action := range stmt.Actions {
+		switch a := action.(type) {
+		case *parse.AddColumn:
+			if _, ok := tbl.Column(a.Name); ok {
+				if a.IfNotExists {
+					continue
+				}
+				return "", fmt.Errorf(`column "%s" already exists`, a.Name)
+			}
+			tbl.Columns = append(tbl.Columns, &engine.Column{
+				Name:     a.Name,
+				DataType: a.Type,
+				Nullable: true,
+			})
+		case *parse.DropColumn:
+			col, ok := tbl.Column(a.Name)
+			if !ok {
+				if a.IfExists {
+					continue
+				}
+				return "", fmt.Errorf(`column "%s" does not exist`, a.Name)
+			}
+			if col.IsPrimaryKey {
+				return "", engine.ErrCannotAlterPrimaryKey
+			}
+			for i, c := range tbl.Columns {
+				if c == col {
+					tbl.Columns = append(tbl.Columns[:i], tbl.Columns[i+1:]...)
+					break
+				}
+			}
+		case *parse.RenameColumn:
+			col, ok := tbl.Column(a.OldName)
+			if !ok {
+				return "", fmt.Errorf(`column "%s" does not exist`, a.OldName)
+			}
+			col.Name = a.NewName
+			for _, idx := range tbl.Indexes {
+				renameColumn(idx.Columns, a.OldName, a.NewName)
+			}
+			for _, con := range tbl.Constraints {
+				renameColumn(con.Columns, a.OldName, a.NewName)
+			}
+		case *parse.RenameTable:
+			name = a.Name
+			tbl.Name = a.Name
+		case *parse.AlterColumnSet:
+			col, ok := tbl.Column(a.Column)
+			if !ok {
+				return "", fmt.Errorf(`column "%s" does not exist`, a.Column)
+			}
+			if a.Type == parse.ConstraintTypeNotNull {
+				col.Nullable = false
+			}
+		case *parse.AlterColumnDrop:
+			col, ok := tbl.Column(a.Column)
+			if !ok {
+				return "", fmt.Errorf(`column "%s" does not exist`, a.Column)
+			}
+			if a.Type == parse.ConstraintTypeNotNull {
+				if col.IsPrimaryKey {
+					return "", engine.ErrCannotAlterPrimaryKey
+				}
+				col.Nullable = true
+			}
+		case *parse.AddTableConstraint:
+			for _, col := range a.Constraint.Constraint.LocalColumns() {
+				if _, ok := tbl.Column(col); !ok {
+					return "", fmt.Errorf(`constraint references unknown column "%s"`, col)
+				}
+			}
+			if u, ok := a.Constraint.Constraint.(*parse.UniqueOutOfLineConstraint); ok {
+				conName := a.Constraint.Name
+				if conName == "" {
+					conName = tbl.Name + "_" + strings.Join(u.Columns, "_") + "_key"
+				}
+				tbl.Constraints[conName] = &engine.Constraint{
+					Type:    engine.ConstraintUnique,
+					Columns: u.Columns,
+				}
+			}
+		case *parse.DropTableConstraint:
+			if _, ok := tbl.Constraints[a.Name]; !ok && !a.IfExists {
+				return "", fmt.Errorf(`constraint "%s" does not exist`, a.Name)
+			}
+			delete(tbl.Constraints, a.Name)
+		}
+	}
+
+	return name, nil
+}
+
+func renameColumn(cols []string, oldName, newName string) {
+	for i, c := range cols {
+		if c == oldName {
+			cols[i] = newName
+		}
+	}
+}
+
+// contextualVariables are the types of the @ variables available in actions.
+var contextualVariables = map[string]*types.DataType{
+	"@caller":          types.TextType,
+	"@txid":            types.TextType,
+	"@signer":          types.ByteaType,
+	"@height":          types.IntType,
+	"@foreign_caller":  types.TextType,
+	"@block_timestamp": types.IntType,
+	"@authenticator":   types.TextType,
+}
+
+// ContextualVariables returns the @ variables available in actions,
+// mapped to their types.
+func ContextualVariables() map[string]*types.DataType {
+	return maps.Clone(contextualVariables)
+}
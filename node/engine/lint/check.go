@@ -0,0 +1,686 @@
+package lint
+
+import (
+	"errors"
+	"fmt"
+	"slices"
+	"strings"
+
+	"github.com/trufnetwork/kwil-db/core/types"
+	"github.com/trufnetwork/kwil-db/node/engine"
+	"github.com/trufnetwork/kwil-db/node/engine/parse"
+	"github.com/trufnetwork/kwil-db/node/engine/planner/logical"
+)
+
+// checkAction checks the signature and body of an action.
+func (c *checker) checkAction(ns string, act *parse.CreateActionStatement) {
+	hasAccessModifier := false
+	for _, mod := range act.Modifiers {
+		switch strings.ToLower(mod) {
+		case "public", "private", "system":
+			hasAccessModifier = true
+		}
+	}
+	if !hasAccessModifier {
+		c.report(act, SeverityError, RuleMissingAccessModifier, `action "%s" must have one of the PUBLIC, PRIVATE, or SYSTEM access modifiers`, act.Name)
+	}
+
+	a := newActionChecker(c, ns, act)
+	for i, param := range act.Parameters {
+		if i < len(act.Defaults) && act.Defaults[i] != nil {
+			if typ := a.typeOf(act.Defaults[i]); typ != nil && !compatible(param.Type, typ) {
+				a.report(act.Defaults[i], SeverityError, RuleTypeMismatch, `default value of parameter %s must be %s, got %s`, param.Name, param.Type, typ)
+			}
+		}
+
+		a.scope.vars[param.Name] = &variable{
+			dataType: param.Type,
+			// parameters are part of the action's signature, so we
+			// do not complain if they are unused.
+			used: true,
+		}
+	}
+
+	a.block(act.Statements)
+	a.popScope()
+}
+
+// variable is a variable in an action.
+type variable struct {
+	// node is where the variable was declared.
+	node parse.GetPositioner
+	// dataType is the type of the variable.
+	// It is nil if it is a record, or if the type cannot be determined.
+	dataType *types.DataType
+	// record is true if the variable is a record.
+	record bool
+	// fields are the fields of a record.
+	// It is nil if the variable is not a record, or if the fields are unknown.
+	fields map[string]*types.DataType
+	// used is true if the variable has been read.
+	used bool
+}
+
+// scope is a block scope in an action.
+type scope struct {
+	parent *scope
+	vars   map[string]*variable
+}
+
+func (s *scope) lookup(name string) *variable {
+	for ; s != nil; s = s.parent {
+		if v, ok := s.vars[name]; ok {
+			return v
+		}
+	}
+	return nil
+}
+
+// actionChecker checks the statements of a single action.
+type actionChecker struct {
+	*checker
+	namespace string
+	// action is nil if top-level SQL is being checked.
+	action *parse.CreateActionStatement
+	scope  *scope
+}
+
+func newActionChecker(c *checker, ns string, act *parse.CreateActionStatement) *actionChecker {
+	return &actionChecker{
+		checker:   c,
+		namespace: ns,
+		action:    act,
+		scope:     &scope{vars: make(map[string]*variable)},
+	}
+}
+
+func (a *actionChecker) pushScope() {
+	a.scope = &scope{parent: a.scope, vars: make(map[string]*variable)}
+}
+
+// popScope pops the current scope, reporting any variables that were
+// never read.
+func (a *actionChecker) popScope() {
+	for name, v := range a.scope.vars {
+		if !v.used {
+			a.report(v.node, SeverityWarning, RuleUnusedVariable, `variable "%s" is declared but never used`, name)
+		}
+	}
+	a.scope = a.scope.parent
+}
+
+// block checks a list of statements. It returns true if the block
+// always exits early (via RETURN, BREAK, CONTINUE, or error()).
+func (a *actionChecker) block(stmts []parse.ActionStmt) (exits bool) {
+	reported := false
+	for _, stmt := range stmts {
+		// unreachable statements are still checked, but only the
+		// first one is reported.
+		if exits && !reported {
+			a.report(stmt, SeverityWarning, RuleUnreachableCode, "unreachable code")
+			reported = true
+		}
+		if a.stmt(stmt) {
+			exits = true
+		}
+	}
+	return exits
+}
+
+// stmt checks a single statement. It returns true if the statement
+// always exits the block it is in.
+func (a *actionChecker) stmt(stmt parse.ActionStmt) (exits bool) {
+	switch s := stmt.(type) {
+	case *parse.ActionStmtDeclaration:
+		a.declare(s.Variable, s.Type)
+	case *parse.ActionStmtAssign:
+		valType := a.expr(s.Value)
+
+		switch v := s.Variable.(type) {
+		case *parse.ExpressionVariable:
+			existing := a.scope.lookup(v.Name)
+			if existing == nil {
+				typ := s.Type
+				if typ == nil {
+					typ = valType
+				}
+				a.declare(v, typ)
+				a.checkAssignable(s.Value, typ, valType, v.Name)
+				break
+			}
+
+			if s.Type != nil && existing.dataType != nil && !existing.dataType.EqualsStrict(s.Type) {
+				a.report(s, SeverityError, RuleTypeMismatch, `cannot assign new type "%s" to variable "%s" of type "%s"`, s.Type, v.Name, existing.dataType)
+				break
+			}
+			a.checkAssignable(s.Value, existing.dataType, valType, v.Name)
+		case *parse.ExpressionArrayAccess:
+			arrType := a.expr(v.Array)
+			if v.Index != nil {
+				a.expr(v.Index)
+			}
+			if v.FromTo != nil {
+				for _, e := range v.FromTo {
+					if e != nil {
+						a.expr(e)
+					}
+				}
+			}
+
+			if arrType != nil && arrType.IsArray && v.Index != nil {
+				elem := arrType.Copy()
+				elem.IsArray = false
+				name := "array element"
+				if arr, ok := v.Array.(*parse.ExpressionVariable); ok {
+					name = arr.Name + "[]"
+				}
+				a.checkAssignable(s.Value, elem, valType, name)
+			}
+		}
+	case *parse.ActionStmtCall:
+		returns := a.call(s.Call)
+		if returns != nil && len(s.Receivers) > len(returns) {
+			a.report(s, SeverityError, RuleTypeMismatch, `"%s" returns %d values, but %d receivers were given`, s.Call.Name, len(returns), len(s.Receivers))
+			returns = nil
+		}
+
+		for i, r := range s.Receivers {
+			if r == nil {
+				continue
+			}
+
+			var typ *types.DataType
+			if returns != nil {
+				typ = returns[i].Type
+			}
+
+			if existing := a.scope.lookup(r.Name); existing != nil {
+				a.checkAssignable(r, existing.dataType, typ, r.Name)
+			} else {
+				a.declare(r, typ)
+			}
+		}
+
+		if s.Call.Namespace == "" && strings.EqualFold(s.Call.Name, "error") {
+			return true
+		}
+	case *parse.ActionStmtForLoop:
+		receiver := &variable{
+			node: s.Receiver,
+			// loops are commonly used to repeat a block of code, so we do not
+			// report unused receivers.
+			used: true,
+		}
+
+		switch term := s.LoopTerm.(type) {
+		case *parse.LoopTermRange:
+			for _, e := range []parse.Expression{term.Start, term.End} {
+				if typ := a.expr(e); typ != nil && !typ.Equals(types.IntType) {
+					a.report(e, SeverityError, RuleTypeMismatch, "range bounds must be of type int, got %s", typ)
+				}
+			}
+			receiver.dataType = types.IntType
+		case *parse.LoopTermSQL:
+			a.checkViewOrdering(term.Statement)
+			receiver.record = true
+			if fields := a.sql(term.Statement); fields != nil {
+				receiver.fields = make(map[string]*types.DataType, len(fields))
+				for _, f := range fields {
+					receiver.fields[f.Name] = f.Type
+				}
+			}
+		case *parse.LoopTermExpression:
+			if term.Array {
+				typ := a.expr(term.Expression)
+				if typ != nil {
+					if !typ.IsArray {
+						a.report(term.Expression, SeverityError, RuleTypeMismatch, "cannot loop over non-array type %s", typ)
+					} else {
+						receiver.dataType = typ.Copy()
+						receiver.dataType.IsArray = false
+					}
+				}
+				break
+			}
+
+			receiver.record = true
+			if call, ok := term.Expression.(*parse.ExpressionFunctionCall); ok && !isBuiltin(call) {
+				a.useVariables(call)
+				if fields := a.call(call); fields != nil {
+					receiver.fields = make(map[string]*types.DataType, len(fields))
+					for _, f := range fields {
+						receiver.fields[f.Name] = f.Type
+					}
+				}
+			} else {
+				a.expr(term.Expression)
+			}
+		}
+
+		a.pushScope()
+		a.scope.vars[s.Receiver.Name] = receiver
+		a.block(s.Body)
+		a.popScope()
+	case *parse.ActionStmtIf:
+		allExit := true
+		for _, ifThen := range s.IfThens {
+			if typ := a.expr(ifThen.If); typ != nil && !typ.Equals(types.BoolType) {
+				a.report(ifThen.If, SeverityError, RuleTypeMismatch, "IF condition must be of type bool, got %s", typ)
+			}
+
+			a.pushScope()
+			if !a.block(ifThen.Then) {
+				allExit = false
+			}
+			a.popScope()
+		}
+
+		if s.Else == nil {
+			return false
+		}
+
+		a.pushScope()
+		if !a.block(s.Else) {
+			allExit = false
+		}
+		a.popScope()
+
+		return allExit
+	case *parse.ActionStmtSQL:
+		a.sql(s.SQL)
+	case *parse.ActionStmtLoopControl:
+		return true
+	case *parse.ActionStmtReturn:
+		if s.SQL != nil {
+			a.checkViewOrdering(s.SQL)
+			fields := a.sql(s.SQL)
+			if fields != nil {
+				a.checkReturn(s, fields)
+			}
+			return true
+		}
+
+		fields := make([]*engine.NamedType, len(s.Values))
+		for i, v := range s.Values {
+			fields[i] = &engine.NamedType{Type: a.expr(v)}
+		}
+		a.checkReturn(s, fields)
+
+		return true
+	case *parse.ActionStmtReturnNext:
+		fields := make([]*engine.NamedType, len(s.Values))
+		for i, v := range s.Values {
+			fields[i] = &engine.NamedType{Type: a.expr(v)}
+		}
+		a.checkReturn(s, fields)
+	}
+
+	return false
+}
+
+// declare declares a new variable in the current scope.
+func (a *actionChecker) declare(v *parse.ExpressionVariable, typ *types.DataType) {
+	if _, ok := a.scope.vars[v.Name]; ok {
+		a.report(v, SeverityError, RuleTypeMismatch, `variable "%s" already exists`, v.Name)
+		return
+	}
+
+	a.scope.vars[v.Name] = &variable{
+		node:     v,
+		dataType: typ,
+	}
+}
+
+// checkAssignable reports an error if a value of type got cannot be
+// assigned to a variable of type want. If either type is unknown,
+// nothing is reported.
+func (a *actionChecker) checkAssignable(node parse.GetPositioner, want, got *types.DataType, name string) {
+	if want == nil || got == nil || compatible(want, got) {
+		return
+	}
+
+	a.report(node, SeverityError, RuleTypeMismatch, `cannot assign value of type %s to variable "%s" of type %s`, got, name, want)
+}
+
+// checkReturn checks the values returned from an action against its
+// declared return types.
+func (a *actionChecker) checkReturn(node parse.ActionStmt, fields []*engine.NamedType) {
+	if a.action.Returns == nil {
+		if len(fields) > 0 {
+			a.report(node, SeverityError, RuleTypeMismatch, `action "%s" does not declare a return type`, a.action.Name)
+		}
+		return
+	}
+
+	expected := a.action.Returns.Fields
+	if len(fields) != len(expected) {
+		a.report(node, SeverityError, RuleTypeMismatch, "expected %d return values, got %d", len(expected), len(fields))
+		return
+	}
+
+	for i, f := range fields {
+		if f.Type != nil && !compatible(expected[i].Type, f.Type) {
+			a.report(node, SeverityError, RuleTypeMismatch, "expected return value %d to be %s, got %s", i+1, expected[i].Type, f.Type)
+		}
+	}
+}
+
+// compatible returns true if a value of type got can be used where type want
+// is expected. Decimal precision and scale are not compared, since they
+// cannot always be inferred without evaluating the expression.
+func compatible(want, got *types.DataType) bool {
+	if want.Equals(got) {
+		return true
+	}
+
+	return want.IsArray == got.IsArray &&
+		strings.EqualFold(want.Name, types.NumericStr) &&
+		strings.EqualFold(got.Name, types.NumericStr)
+}
+
+// isBuiltin returns true if the function call is to a built-in function.
+func isBuiltin(call *parse.ExpressionFunctionCall) bool {
+	if call.Namespace != "" {
+		return false
+	}
+	_, ok := engine.Functions[call.Name]
+	return ok
+}
+
+// call checks a call to an action or function, returning its return types.
+// It returns nil if the return types cannot be determined.
+func (a *actionChecker) call(call *parse.ExpressionFunctionCall) []*engine.NamedType {
+	argTypes := make([]*types.DataType, len(call.Args))
+	for i, arg := range call.Args {
+		argTypes[i] = a.typeOf(arg)
+	}
+
+	if isBuiltin(call) {
+		typ := a.typeOf(call)
+		if typ == nil {
+			return nil
+		}
+		return []*engine.NamedType{{Name: call.Name, Type: typ}}
+	}
+
+	ns := call.Namespace
+	if ns == "" {
+		ns = a.namespace
+	}
+
+	act, ok := a.catalog.Action(ns, call.Name)
+	if !ok {
+		// calls to other namespaces can be to extensions, which we do not know about.
+		if call.Namespace == "" {
+			a.report(call, SeverityError, RuleUnknownFunction, `unknown action or function "%s"`, call.Name)
+		}
+		return nil
+	}
+
+	if len(call.Args) > len(act.Parameters) {
+		a.report(call, SeverityError, RuleTypeMismatch, `action "%s" expects at most %d arguments, got %d`, act.Name, len(act.Parameters), len(call.Args))
+	} else {
+		a.arguments(call, act, argTypes)
+	}
+
+	if act.Returns == nil {
+		return []*engine.NamedType{}
+	}
+	return act.Returns.Fields
+}
+
+// arguments checks that the arguments of an action call match the action's
+// parameters. Arguments are matched by position, or by name if they are named,
+// and parameters with a default value can be omitted.
+func (a *actionChecker) arguments(call *parse.ExpressionFunctionCall, act *parse.CreateActionStatement, argTypes []*types.DataType) {
+	given := make([]bool, len(act.Parameters))
+	for i, typ := range argTypes {
+		idx := i
+		if i < len(call.ArgNames) && call.ArgNames[i] != "" {
+			idx = slices.IndexFunc(act.Parameters, func(p *engine.NamedType) bool {
+				return p.Name == "$"+call.ArgNames[i]
+			})
+			if idx < 0 {
+				a.report(call.Args[i], SeverityError, RuleTypeMismatch, `action "%s" has no parameter named "%s"`, act.Name, call.ArgNames[i])
+				continue
+			}
+			if given[idx] {
+				a.report(call.Args[i], SeverityError, RuleTypeMismatch, `parameter %s of action "%s" is given more than once`, act.Parameters[idx].Name, act.Name)
+				continue
+			}
+		}
+		given[idx] = true
+
+		if typ != nil && !compatible(act.Parameters[idx].Type, typ) {
+			a.report(call.Args[i], SeverityError, RuleTypeMismatch, `argument %d of action "%s" must be %s, got %s`, i+1, act.Name, act.Parameters[idx].Type, typ)
+		}
+	}
+
+	for i, ok := range given {
+		if !ok && (i >= len(act.Defaults) || act.Defaults[i] == nil) {
+			a.report(call, SeverityError, RuleTypeMismatch, `missing argument for parameter %s of action "%s"`, act.Parameters[i].Name, act.Name)
+		}
+	}
+}
+
+// expr checks an expression, and returns its type.
+// It returns nil if the type cannot be determined.
+func (a *actionChecker) expr(e parse.Expression) *types.DataType {
+	if !a.useVariables(e) {
+		return nil
+	}
+	return a.typeOf(e)
+}
+
+// typeOf returns the type of an expression whose variables have already been
+// checked by useVariables.
+func (a *actionChecker) typeOf(e parse.Expression) *types.DataType {
+	if call, ok := e.(*parse.ExpressionFunctionCall); ok && !isBuiltin(call) {
+		returns := a.call(call)
+		if len(returns) != 1 {
+			if returns != nil {
+				a.report(call, SeverityError, RuleTypeMismatch, `"%s" must return exactly one value to be used in an expression`, call.Name)
+			}
+			return nil
+		}
+		return returns[0].Type
+	}
+
+	// expressions that call actions cannot be planned as SQL, so we
+	// cannot determine their type.
+	callsAction := false
+	visitNodes(e, func(gp parse.GetPositioner) {
+		if call, ok := gp.(*parse.ExpressionFunctionCall); ok && !isBuiltin(call) {
+			callsAction = true
+		}
+	})
+	if callsAction {
+		return nil
+	}
+
+	// we plan the expression as SELECT <expr> to get its type.
+	stmt := &parse.SQLStatement{
+		SQL: &parse.SelectStatement{
+			SelectCores: []*parse.SelectCore{
+				{
+					Columns: []parse.ResultColumn{
+						&parse.ResultColumnExpression{Expression: e},
+					},
+				},
+			},
+		},
+	}
+
+	plan, err := logical.CreateLogicalPlan(stmt, a.getTable, a.varType, a.objectType, a.isAction, false, a.namespace)
+	if err != nil {
+		a.reportPlanErr(e, err)
+		return nil
+	}
+
+	fields := plan.Plan.Relation().Fields
+	if len(fields) != 1 {
+		return nil
+	}
+	typ, err := fields[0].Scalar()
+	if err != nil {
+		return nil
+	}
+	return typ
+}
+
+// sql checks a SQL statement, returning the fields that it returns.
+// It returns nil if the statement could not be planned.
+func (a *actionChecker) sql(stmt *parse.SQLStatement) []*engine.NamedType {
+	a.checkDeterminism(stmt)
+
+	if !a.useVariables(stmt) {
+		return nil
+	}
+
+	ns := a.namespace
+	if stmt.NamespacePrefix != "" {
+		ns = stmt.NamespacePrefix
+	}
+
+	plan, err := logical.CreateLogicalPlan(stmt, a.getTable, a.varType, a.objectType, a.isAction, false, ns)
+	if err != nil {
+		a.reportPlanErr(stmt, err)
+		return nil
+	}
+
+	fields := plan.Plan.Relation().Fields
+	res := make([]*engine.NamedType, len(fields))
+	for i, f := range fields {
+		typ, err := f.Scalar()
+		if err != nil {
+			return nil
+		}
+		res[i] = &engine.NamedType{Name: f.Name, Type: typ}
+	}
+	return res
+}
+
+// useVariables marks all variables referenced in the node as used, reporting
+// any that do not exist. It returns false if the node references variables
+// whose types are unknown, in which case it cannot be planned.
+func (a *actionChecker) useVariables(node any) (ok bool) {
+	ok = true
+	visitNodes(node, func(gp parse.GetPositioner) {
+		v, isVar := gp.(*parse.ExpressionVariable)
+		if !isVar {
+			return
+		}
+
+		if v.Prefix == parse.VariablePrefixAt {
+			if _, known := contextualVariables[v.Name]; !known {
+				a.report(v, SeverityError, RuleUnknownVariable, `unknown contextual variable "%s"`, v.Name)
+				ok = false
+			}
+			return
+		}
+
+		// top-level SQL can be given parameters when executed,
+		// so we cannot check its variables.
+		if a.action == nil {
+			ok = false
+			return
+		}
+
+		found := a.scope.lookup(v.Name)
+		if found == nil {
+			a.report(v, SeverityError, RuleUnknownVariable, `unknown variable "%s"`, v.Name)
+			ok = false
+			return
+		}
+
+		found.used = true
+		if found.record && found.fields == nil {
+			ok = false
+		}
+	})
+	return ok
+}
+
+// visitNodes calls fn exactly once for every node in the tree.
+// parse.RecursivelyVisitPositions can visit nodes that are referenced
+// through interfaces more than once.
+func visitNodes(node any, fn func(parse.GetPositioner)) {
+	seen := make(map[parse.GetPositioner]struct{})
+	parse.RecursivelyVisitPositions(node, func(gp parse.GetPositioner) {
+		if _, ok := seen[gp]; ok {
+			return
+		}
+		seen[gp] = struct{}{}
+		fn(gp)
+	})
+}
+
+// reportPlanErr reports an error returned from the logical planner.
+func (a *actionChecker) reportPlanErr(node parse.GetPositioner, err error) {
+	rule := RuleInvalidQuery
+	switch {
+	case errors.Is(err, logical.ErrColumnNotFound):
+		rule = RuleUnknownColumn
+	case errors.Is(err, logical.ErrUnknownTable), errors.Is(err, engine.ErrUnknownTable):
+		rule = RuleUnknownTable
+	case errors.Is(err, logical.ErrFunctionDoesNotExist):
+		rule = RuleUnknownFunction
+	case errors.Is(err, engine.ErrUnknownVariable):
+		rule = RuleUnknownVariable
+	case errors.Is(err, engine.ErrType):
+		rule = RuleTypeMismatch
+	default:
+		// most type errors from the planner are not wrapped with a
+		// sentinel error, so we detect them by their message.
+		msg := strings.ToLower(err.Error())
+		if strings.Contains(msg, "type") || strings.Contains(msg, "must be a boolean") {
+			rule = RuleTypeMismatch
+		}
+	}
+
+	a.report(node, SeverityError, rule, "%s", err.Error())
+}
+
+func (a *actionChecker) getTable(ns, name string) (*engine.Table, error) {
+	if ns == "" {
+		ns = a.namespace
+	}
+	tbl, ok := a.catalog.Table(ns, name)
+	if !ok {
+		return nil, fmt.Errorf(`%w: "%s"`, engine.ErrUnknownTable, name)
+	}
+	return tbl, nil
+}
+
+func (a *actionChecker) varType(name string) (*types.DataType, error) {
+	if typ, ok := contextualVariables[name]; ok {
+		return typ, nil
+	}
+
+	v := a.scope.lookup(name)
+	if v == nil || v.record {
+		return nil, fmt.Errorf("%w: %s", engine.ErrUnknownVariable, name)
+	}
+	if v.dataType == nil {
+		// the type could not be inferred, so we treat it as null,
+		// which is compatible with every type.
+		return types.NullType, nil
+	}
+	return v.dataType, nil
+}
+
+func (a *actionChecker) objectType(name string) (map[string]*types.DataType, error) {
+	v := a.scope.lookup(name)
+	if v == nil || !v.record || v.fields == nil {
+		return nil, fmt.Errorf("%w: %s", engine.ErrUnknownVariable, name)
+	}
+	return v.fields, nil
+}
+
+func (a *actionChecker) isAction(name string) bool {
+	if _, ok := engine.Functions[name]; ok {
+		return false
+	}
+	_, ok := a.catalog.Action(a.namespace, name)
+	return ok
+}
@@ -0,0 +1,105 @@
+package lint
+
+import (
+	"strings"
+
+	"github.com/trufnetwork/kwil-db/node/engine"
+	"github.com/trufnetwork/kwil-db/node/engine/parse"
+)
+
+// orderDependentWindowFuncs are window functions whose results depend on the
+// order of the rows in the window.
+var orderDependentWindowFuncs = map[string]struct{}{
+	"row_number":  {},
+	"lag":         {},
+	"lead":        {},
+	"first_value": {},
+	"last_value":  {},
+	"nth_value":   {},
+}
+
+// checkDeterminism reports patterns in a SQL statement whose results depend
+// on row order that the author did not specify. When executed in consensus,
+// the engine orders these by every column, which is deterministic but rarely
+// what was intended. Outside of consensus, no ordering is applied at all.
+func (c *checker) checkDeterminism(stmt *parse.SQLStatement) {
+	windows := make(map[string]*parse.WindowImpl)
+	visitNodes(stmt, func(gp parse.GetPositioner) {
+		if core, ok := gp.(*parse.SelectCore); ok {
+			for _, w := range core.Windows {
+				windows[w.Name] = w.Window
+			}
+		}
+	})
+
+	visitNodes(stmt, func(gp parse.GetPositioner) {
+		switch n := gp.(type) {
+		case *parse.SelectStatement:
+			if (n.Limit != nil || n.Offset != nil) && len(n.Ordering) == 0 {
+				c.report(n, SeverityWarning, RuleNonDeterministic, "LIMIT or OFFSET without ORDER BY; the rows selected depend on an implicit ordering")
+			}
+		case *parse.ExpressionWindowFunctionCall:
+			if _, ok := orderDependentWindowFuncs[strings.ToLower(n.FunctionCall.Name)]; !ok {
+				return
+			}
+
+			var window *parse.WindowImpl
+			switch w := n.Window.(type) {
+			case *parse.WindowImpl:
+				window = w
+			case *parse.WindowReference:
+				window = windows[w.Name]
+			}
+
+			if window != nil && len(window.OrderBy) == 0 {
+				c.report(n, SeverityWarning, RuleNonDeterministic, `window function "%s" is used without ORDER BY in its window`, n.FunctionCall.Name)
+			}
+		}
+	})
+}
+
+// checkViewOrdering reports SELECT statements that return rows from a VIEW
+// action without an ORDER BY. VIEW actions called outside of a transaction
+// are not given a default ordering, so different nodes can return rows in
+// different orders.
+func (a *actionChecker) checkViewOrdering(stmt *parse.SQLStatement) {
+	if a.action == nil {
+		return
+	}
+
+	isView := false
+	for _, mod := range a.action.Modifiers {
+		if strings.EqualFold(mod, "view") {
+			isView = true
+		}
+	}
+
+	sel, ok := stmt.SQL.(*parse.SelectStatement)
+	if !isView || !ok || len(sel.Ordering) > 0 || returnsSingleRow(sel) {
+		return
+	}
+
+	a.report(stmt, SeverityWarning, RuleNonDeterministic, "SELECT in a VIEW action without ORDER BY; rows may be returned in a different order by different nodes")
+}
+
+// returnsSingleRow returns true if the SELECT aggregates all of its rows
+// into one, e.g. SELECT COUNT(*) FROM t.
+func returnsSingleRow(sel *parse.SelectStatement) bool {
+	if len(sel.SelectCores) != 1 || len(sel.SelectCores[0].GroupBy) > 0 {
+		return false
+	}
+
+	aggregates := false
+	for _, col := range sel.SelectCores[0].Columns {
+		visitNodes(col, func(gp parse.GetPositioner) {
+			call, ok := gp.(*parse.ExpressionFunctionCall)
+			if !ok || call.Namespace != "" {
+				return
+			}
+			if _, ok := engine.Functions[call.Name].(*engine.AggregateFunctionDefinition); ok {
+				aggregates = true
+			}
+		})
+	}
+	return aggregates
+}
@@ -0,0 +1,214 @@
+// Package lint statically analyzes Kuneiform schemas and actions without
+// a database. It builds a virtual catalog from the DDL in a set of files,
+// and then plans every SQL statement and action body against it to detect
+// errors that would otherwise only surface when the action is executed.
+package lint
+
+import (
+	"cmp"
+	"fmt"
+	"slices"
+
+	"github.com/trufnetwork/kwil-db/node/engine"
+	"github.com/trufnetwork/kwil-db/node/engine/parse"
+)
+
+// Severity is the severity of a diagnostic.
+type Severity string
+
+const (
+	// SeverityError is used for problems that will cause a statement
+	// or action to fail.
+	SeverityError Severity = "error"
+	// SeverityWarning is used for problems that will not fail, but are
+	// likely to be bugs.
+	SeverityWarning Severity = "warning"
+)
+
+// Rule identifies the check that produced a diagnostic.
+type Rule string
+
+const (
+	RuleSyntax                Rule = "syntax"
+	RuleSchema                Rule = "schema"
+	RuleUnknownTable          Rule = "unknown-table"
+	RuleUnknownColumn         Rule = "unknown-column"
+	RuleUnknownVariable       Rule = "unknown-variable"
+	RuleUnknownFunction       Rule = "unknown-function"
+	RuleTypeMismatch          Rule = "type-mismatch"
+	RuleInvalidQuery          Rule = "invalid-query"
+	RuleUnusedVariable        Rule = "unused-variable"
+	RuleUnreachableCode       Rule = "unreachable-code"
+	RuleMissingAccessModifier Rule = "missing-access-modifier"
+	RuleNonDeterministic      Rule = "non-deterministic"
+)
+
+// Diagnostic is a single problem found by the linter.
+// Lines and columns are 1-indexed, and are 0 if unknown.
+type Diagnostic struct {
+	File      string   `json:"file"`
+	Line      int      `json:"line"`
+	Column    int      `json:"column"`
+	EndLine   int      `json:"end_line,omitempty"`
+	EndColumn int      `json:"end_column,omitempty"`
+	Severity  Severity `json:"severity"`
+	Rule      Rule     `json:"rule"`
+	Message   string   `json:"message"`
+}
+
+// String formats the diagnostic as file:line:col: severity: message (rule).
+func (d *Diagnostic) String() string {
+	return fmt.Sprintf("%s:%d:%d: %s: %s (%s)", d.File, d.Line, d.Column, d.Severity, d.Message, d.Rule)
+}
+
+// File is a source file to lint.
+type File struct {
+	// Name is used to identify the file in diagnostics.
+	Name string
+	// Source is the Kuneiform source of the file.
+	Source string
+}
+
+// Lint checks the given files. The files are treated as a single deployment,
+// in the order given: tables and actions declared in one file are visible
+// to every other file. Diagnostics are sorted by file and position.
+func Lint(files ...*File) []*Diagnostic {
+	catalog := NewCatalog()
+	c := &checker{catalog: catalog}
+
+	// first, we parse all files and build the catalog. Action bodies are
+	// only checked once the entire catalog is known, since they are planned
+	// when called, and not when they are created.
+	type located struct {
+		file      string
+		namespace string
+		stmt      parse.TopLevelStatement
+	}
+	var stmts []*located
+	offsets := make(map[string][2]int, len(files))
+	for _, f := range files {
+		c.file = f.Name
+
+		lines, cols := parse.TrimmedOffset(f.Source)
+		offsets[f.Name] = [2]int{lines, cols}
+
+		res, err := parse.ParseWithErrListener(f.Source)
+		if err != nil {
+			c.diags = append(c.diags, &Diagnostic{
+				File:     f.Name,
+				Severity: SeverityError,
+				Rule:     RuleSyntax,
+				Message:  err.Error(),
+			})
+			continue
+		}
+
+		if res.ParseErrs.Err() != nil {
+			// the AST of a file that does not parse can be incomplete,
+			// so we do not analyze it further.
+			for _, perr := range res.ParseErrs.Errors() {
+				msg := perr.Err.Error()
+				if perr.Message != "" {
+					msg += ": " + perr.Message
+				}
+				c.reportAt(perr.Position, SeverityError, RuleSyntax, "%s", msg)
+			}
+			continue
+		}
+
+		ns := engine.DefaultNamespace
+		for _, stmt := range res.Statements {
+			stmts = append(stmts, &located{
+				file:      f.Name,
+				namespace: statementNamespace(stmt, ns),
+				stmt:      stmt,
+			})
+
+			ns, err = catalog.Apply(stmt, ns)
+			if err != nil {
+				c.report(stmt, SeverityError, RuleSchema, "%s", err.Error())
+			}
+		}
+	}
+
+	for _, l := range stmts {
+		c.file = l.file
+		switch s := l.stmt.(type) {
+		case *parse.CreateActionStatement:
+			c.checkAction(l.namespace, s)
+		case *parse.SQLStatement:
+			newActionChecker(c, l.namespace, nil).sql(s)
+		}
+	}
+
+	// the parser trims leading whitespace, so positions are shifted
+	// back to be relative to the original source.
+	for _, d := range c.diags {
+		off := offsets[d.File]
+		if d.Line == 1 {
+			d.Column += off[1]
+		}
+		if d.EndLine == 1 {
+			d.EndColumn += off[1]
+		}
+		if d.Line > 0 {
+			d.Line += off[0]
+		}
+		if d.EndLine > 0 {
+			d.EndLine += off[0]
+		}
+	}
+
+	slices.SortStableFunc(c.diags, func(a, b *Diagnostic) int {
+		return cmp.Or(
+			cmp.Compare(a.File, b.File),
+			cmp.Compare(a.Line, b.Line),
+			cmp.Compare(a.Column, b.Column),
+		)
+	})
+
+	return c.diags
+}
+
+// HasErrors returns true if any of the diagnostics are errors.
+func HasErrors(diags []*Diagnostic) bool {
+	return slices.ContainsFunc(diags, func(d *Diagnostic) bool {
+		return d.Severity == SeverityError
+	})
+}
+
+// checker accumulates diagnostics for a set of files.
+type checker struct {
+	catalog *Catalog
+	// file is the name of the file currently being checked.
+	file  string
+	diags []*Diagnostic
+}
+
+// report adds a diagnostic at the position of the given node.
+func (c *checker) report(node parse.GetPositioner, sev Severity, rule Rule, format string, args ...any) {
+	c.reportAt(node.GetPosition(), sev, rule, format, args...)
+}
+
+func (c *checker) reportAt(pos *parse.Position, sev Severity, rule Rule, format string, args ...any) {
+	d := &Diagnostic{
+		File:     c.file,
+		Severity: sev,
+		Rule:     rule,
+		Message:  fmt.Sprintf(format, args...),
+	}
+
+	// antlr columns are 0-indexed, while lines are 1-indexed.
+	if pos != nil {
+		if pos.StartLine != nil && pos.StartCol != nil {
+			d.Line = *pos.StartLine
+			d.Column = *pos.StartCol + 1
+		}
+		if pos.EndLine != nil && pos.EndCol != nil {
+			d.EndLine = *pos.EndLine
+			d.EndColumn = *pos.EndCol + 1
+		}
+	}
+
+	c.diags = append(c.diags, d)
+}
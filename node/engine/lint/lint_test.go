@@ -0,0 +1,230 @@
+package lint_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"github.com/trufnetwork/kwil-db/node/engine/lint"
+)
+
+const testSchema = `
+CREATE TABLE users (
+	id INT PRIMARY KEY,
+	name TEXT NOT NULL UNIQUE,
+	age INT
+);
+
+CREATE TABLE posts (
+	id INT PRIMARY KEY,
+	author_id INT REFERENCES users(id),
+	content TEXT
+);
+`
+
+// expected is a diagnostic expected from the linter.
+type expected struct {
+	rule lint.Rule
+	line int
+}
+
+func Test_Lint(t *testing.T) {
+	tests := []struct {
+		name string
+		sql  string
+		want []expected
+	}{
+		{
+			name: "valid action",
+			sql: `CREATE ACTION get_user($id int) public view returns (name text, age int) {
+				for $row in SELECT name, age FROM users WHERE id = $id ORDER BY name {
+					return $row.name, $row.age;
+				}
+				error('not found');
+			};`,
+		},
+		{
+			name: "unknown column",
+			sql: `CREATE ACTION get_user($id int) public {
+				SELECT email FROM users WHERE id = $id;
+			};`,
+			want: []expected{{lint.RuleUnknownColumn, 2}},
+		},
+		{
+			name: "unknown table",
+			sql:  `INSERT INTO accounts (id) VALUES (1);`,
+			want: []expected{{lint.RuleUnknownTable, 1}},
+		},
+		{
+			name: "comparison type mismatch",
+			sql: `CREATE ACTION get_user($name text) public {
+				SELECT * FROM users WHERE id = $name;
+			};`,
+			want: []expected{{lint.RuleTypeMismatch, 2}},
+		},
+		{
+			name: "assignment type mismatch",
+			sql: `CREATE ACTION set_age() public {
+				$age int := 'old';
+				INSERT INTO users (id, name, age) VALUES (1, 'a', $age);
+			};`,
+			want: []expected{{lint.RuleTypeMismatch, 2}},
+		},
+		{
+			name: "return type mismatch",
+			sql: `CREATE ACTION get_age() public returns (age int) {
+				return 'old';
+			};`,
+			want: []expected{{lint.RuleTypeMismatch, 2}},
+		},
+		{
+			name: "argument type mismatch",
+			sql: `CREATE ACTION add_user($id int, $name text) private {
+				INSERT INTO users (id, name) VALUES ($id, $name);
+			};
+			CREATE ACTION add_default() public {
+				add_user('1', 'default');
+			};`,
+			want: []expected{{lint.RuleTypeMismatch, 5}},
+		},
+		{
+			name: "omitted and named arguments",
+			sql: `CREATE ACTION add_user($id int, $name text DEFAULT 'anon', $age int DEFAULT 0) private {
+				INSERT INTO users (id, name, age) VALUES ($id, $name, $age);
+			};
+			CREATE ACTION add_users() public {
+				add_user(1);
+				add_user(2, age => 30);
+				add_user(age => 30);
+				add_user(3, years => 30);
+				add_user(4, name => 5);
+			};
+			CREATE ACTION bad_default($n int DEFAULT 'none') public {};`,
+			want: []expected{{lint.RuleTypeMismatch, 7}, {lint.RuleTypeMismatch, 8}, {lint.RuleTypeMismatch, 9}, {lint.RuleTypeMismatch, 11}},
+		},
+		{
+			name: "unknown variable",
+			sql: `CREATE ACTION del() public {
+				DELETE FROM users WHERE id = $id;
+			};`,
+			want: []expected{{lint.RuleUnknownVariable, 2}},
+		},
+		{
+			name: "unused variable",
+			sql: `CREATE ACTION noop() public {
+				$unused int := 1;
+				if true {
+					$also_unused := 'a';
+				}
+			};`,
+			want: []expected{{lint.RuleUnusedVariable, 2}, {lint.RuleUnusedVariable, 4}},
+		},
+		{
+			name: "unreachable code",
+			sql: `CREATE ACTION early() public returns (n int) {
+				if true {
+					return 1;
+				} else {
+					error('no');
+				}
+				return 2;
+			};`,
+			want: []expected{{lint.RuleUnreachableCode, 7}},
+		},
+		{
+			name: "missing access modifier",
+			sql: `CREATE ACTION hidden() view {
+				SELECT 1;
+			};`,
+			want: []expected{{lint.RuleMissingAccessModifier, 1}},
+		},
+		{
+			name: "unknown action",
+			sql: `CREATE ACTION caller() public {
+				does_not_exist();
+			};`,
+			want: []expected{{lint.RuleUnknownFunction, 2}},
+		},
+		{
+			name: "limit without order by",
+			sql: `CREATE ACTION first_user() public {
+				for $row in SELECT id FROM users LIMIT 1 {
+					INSERT INTO posts (id, author_id) VALUES (1, $row.id);
+				}
+			};`,
+			want: []expected{{lint.RuleNonDeterministic, 2}},
+		},
+		{
+			name: "unordered select in view action",
+			sql: `CREATE ACTION list_users() public view returns table(id int) {
+				return SELECT id FROM users;
+			};`,
+			want: []expected{{lint.RuleNonDeterministic, 2}},
+		},
+		{
+			name: "schedules",
+			sql: `CREATE ACTION tick() private {};
+			CREATE SCHEDULE ticker EVERY 10 BLOCKS CALL tick();
+			CREATE SCHEDULE ticker EVERY 20 BLOCKS CALL tick();
+			CREATE SCHEDULE tocker EVERY 10 BLOCKS CALL tock();
+			DROP SCHEDULE ticker;
+			DROP SCHEDULE ticker;`,
+			want: []expected{{lint.RuleSchema, 3}, {lint.RuleSchema, 4}, {lint.RuleSchema, 6}},
+		},
+		{
+			name: "arrays of user-defined types",
+			sql: `CREATE TYPE mood AS ENUM ('sad', 'happy');
+			CREATE ACTION log_moods($moods mood[]) public {};
+			CREATE ACTION get_moods() public view returns (moods mood[]) {};
+			CREATE TABLE diary (id INT PRIMARY KEY, moods mood[]);`,
+			want: []expected{{lint.RuleSchema, 2}, {lint.RuleSchema, 3}, {lint.RuleSchema, 4}},
+		},
+		{
+			name: "syntax error",
+			sql:  `CREATE ACTION broken( public {};`,
+			want: []expected{{lint.RuleSyntax, 1}},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			diags := lint.Lint(
+				&lint.File{Name: "schema.sql", Source: testSchema},
+				&lint.File{Name: "test.sql", Source: tt.sql},
+			)
+
+			var got []expected
+			for _, d := range diags {
+				require.Equal(t, "test.sql", d.File, "unexpected diagnostic: %s", d)
+				got = append(got, expected{d.Rule, d.Line})
+			}
+
+			require.Equal(t, tt.want, got, "diagnostics: %v", diags)
+		})
+	}
+}
+
+func Test_LintSchemaChanges(t *testing.T) {
+	diags := lint.Lint(&lint.File{Name: "test.sql", Source: testSchema + `
+		ALTER TABLE users RENAME COLUMN age TO years;
+		ALTER TABLE users ADD COLUMN email TEXT;
+		CREATE ACTION get_years($email text) public view returns (years int) {
+			for $row in SELECT years FROM users WHERE email = $email ORDER BY years {
+				return $row.years;
+			}
+			return SELECT age FROM users ORDER BY age;
+		};
+	`})
+
+	require.Len(t, diags, 1, "diagnostics: %v", diags)
+	require.Equal(t, lint.RuleUnknownColumn, diags[0].Rule)
+	require.Equal(t, 20, diags[0].Line)
+	require.True(t, lint.HasErrors(diags))
+}
+
+func Test_LintLeadingWhitespace(t *testing.T) {
+	diags := lint.Lint(&lint.File{Name: "test.sql", Source: "\n\n  INSERT INTO accounts (id) VALUES (1);"})
+
+	require.Len(t, diags, 1, "diagnostics: %v", diags)
+	require.Equal(t, 3, diags[0].Line)
+	require.Equal(t, 3, diags[0].Column)
+}
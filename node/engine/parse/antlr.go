@@ -5,6 +5,8 @@ import (
 	"fmt"
 	"math"
 	"math/big"
+	"regexp"
+	"slices"
 	"strconv"
 	"strings"
 
@@ -100,6 +102,14 @@ func (s *schemaVisitor) VisitStatement(ctx *gen.StatementContext) any {
 		s2 = ctx.Unuse_extension_statement().Accept(s).(TopLevelStatement)
 	case ctx.Set_current_namespace_statement() != nil:
 		s2 = ctx.Set_current_namespace_statement().Accept(s).(TopLevelStatement)
+	case ctx.Create_type_statement() != nil:
+		s2 = ctx.Create_type_statement().Accept(s).(TopLevelStatement)
+	case ctx.Drop_object_statement() != nil:
+		s2 = ctx.Drop_object_statement().Accept(s).(TopLevelStatement)
+	case ctx.Create_schedule_statement() != nil:
+		s3 := ctx.Create_schedule_statement().Accept(s).(*CreateScheduleStatement)
+		s3.Raw = s.getTextFromStream(ctx.GetStart().GetStart(), ctx.GetStop().GetStop()) + ";"
+		s2 = s3
 	default:
 		panic(fmt.Sprintf("unknown parser entry: %s", ctx.GetText()))
 	}
@@ -121,7 +131,7 @@ func (s *schemaVisitor) VisitCreate_action_statement(ctx *gen.Create_action_stat
 		IfNotExists: ctx.EXISTS() != nil,
 		OrReplace:   ctx.REPLACE() != nil,
 		Name:        s.getIdent(ctx.Identifier(0)),
-		Parameters:  arr[*engine.NamedType](len(ctx.AllType_())),
+		Parameters:  arr[*engine.NamedType](len(ctx.AllAction_parameter())),
 		Statements:  arr[ActionStmt](len(ctx.AllAction_statement())),
 		Raw:         s.getTextFromStream(ctx.GetStart().GetStart(), ctx.GetStop().GetStop()),
 	}
@@ -144,25 +154,35 @@ func (s *schemaVisitor) VisitCreate_action_statement(ctx *gen.Create_action_stat
 	}
 
 	paramSet := make(map[string]struct{})
-	for i, t := range ctx.AllType_() {
-		name := s.cleanStringIdent(ctx, ctx.VARIABLE(i).GetText())
+	for i, p := range ctx.AllAction_parameter() {
+		param := p.Accept(s).(*engine.NamedType)
 
 		// check for duplicate parameters
-		if _, ok := paramSet[name]; ok {
-			s.errs.RuleErr(ctx, ErrDuplicateParameterName, "parameter %s redeclared", name)
+		if _, ok := paramSet[param.Name]; ok {
+			s.errs.RuleErr(p, ErrDuplicateParameterName, "parameter %s redeclared", param.Name)
 		}
-		paramSet[name] = struct{}{}
+		paramSet[param.Name] = struct{}{}
+
+		cas.Parameters[i] = param
 
-		// parameters must start with $
-		if !strings.HasPrefix(name, "$") {
-			s.errs.RuleErr(ctx, ErrSyntax, "parameter name must start with $")
+		if p.DEFAULT() == nil {
+			// like in Postgres, once a parameter has a default, all following
+			// parameters must have one too, so that callers can omit them.
+			if cas.Defaults != nil {
+				s.errs.RuleErr(p, ErrParameterDefault, "parameter %s must have a default value, since it follows a parameter with a default value", param.Name)
+			}
+			continue
 		}
 
-		typ := t.Accept(s).(*types.DataType)
-		cas.Parameters[i] = &engine.NamedType{
-			Name: name,
-			Type: typ,
+		def := p.Action_expr().Accept(s).(Expression)
+		if !isConstant(def) {
+			s.errs.RuleErr(p.Action_expr(), ErrParameterDefault, "default value of parameter %s must be a constant", param.Name)
 		}
+
+		if cas.Defaults == nil {
+			cas.Defaults = make([]Expression, len(cas.Parameters))
+		}
+		cas.Defaults[i] = def
 	}
 
 	if ctx.Action_return() != nil {
@@ -177,6 +197,44 @@ func (s *schemaVisitor) VisitCreate_action_statement(ctx *gen.Create_action_stat
 	return cas
 }
 
+func (s *schemaVisitor) VisitAction_parameter(ctx *gen.Action_parameterContext) any {
+	name := s.cleanStringIdent(ctx, ctx.VARIABLE().GetText())
+
+	// parameters must start with $
+	if !strings.HasPrefix(name, "$") {
+		s.errs.RuleErr(ctx, ErrSyntax, "parameter name must start with $")
+	}
+
+	return &engine.NamedType{
+		Name: name,
+		Type: ctx.Type_().Accept(s).(*types.DataType),
+	}
+}
+
+// isConstant reports whether an action expression can be evaluated without
+// variables, function calls, or queries. Parameter defaults must be constant.
+func isConstant(e Expression) bool {
+	switch e := e.(type) {
+	case *ExpressionLiteral:
+		return true
+	case *ExpressionMakeArray:
+		for _, v := range e.Values {
+			if !isConstant(v) {
+				return false
+			}
+		}
+		return true
+	case *ExpressionParenthesized:
+		return isConstant(e.Inner)
+	case *ExpressionUnary:
+		return isConstant(e.Expression)
+	case *ExpressionArithmetic:
+		return isConstant(e.Left) && isConstant(e.Right)
+	default:
+		return false
+	}
+}
+
 func (s *schemaVisitor) VisitDrop_action_statement(ctx *gen.Drop_action_statementContext) any {
 	das := &DropActionStatement{
 		IfExists: ctx.EXISTS() != nil,
@@ -215,6 +273,125 @@ func (s *schemaVisitor) VisitSet_current_namespace_statement(ctx *gen.Set_curren
 	return sns
 }
 
+// TYPE and ENUM are not reserved keywords, so that they can still be used as
+// identifiers. The grammar matches them as identifiers, and they are checked here.
+func (s *schemaVisitor) expectKeyword(ctx antlr.ParserRuleContext, tok antlr.TerminalNode, keyword string) {
+	if !strings.EqualFold(tok.GetText(), keyword) {
+		s.errs.RuleErr(ctx, ErrSyntax, `expected %s, found "%s"`, keyword, tok.GetText())
+	}
+}
+
+func (s *schemaVisitor) VisitCreate_type_statement(ctx *gen.Create_type_statementContext) any {
+	cts := &CreateTypeStatement{
+		IfNotExists: ctx.EXISTS() != nil,
+		Name:        s.getIdent(ctx.Identifier()),
+	}
+	s.expectKeyword(ctx, ctx.IDENTIFIER(0), "TYPE")
+
+	if !(&types.DataType{Name: cts.Name}).IsUserDefined() {
+		s.errs.RuleErr(ctx.Identifier(), ErrIdentifier, `cannot create type "%s", the name is reserved`, cts.Name)
+	}
+
+	if len(ctx.AllType_field()) > 0 {
+		fieldSet := make(map[string]struct{})
+		for _, f := range ctx.AllType_field() {
+			field := f.Accept(s).(*engine.NamedType)
+			if _, ok := fieldSet[field.Name]; ok {
+				s.errs.RuleErr(f, ErrSyntax, "field %s redeclared", field.Name)
+			}
+			fieldSet[field.Name] = struct{}{}
+
+			cts.Fields = append(cts.Fields, field)
+		}
+	} else {
+		s.expectKeyword(ctx, ctx.IDENTIFIER(1), "ENUM")
+
+		labelSet := make(map[string]struct{})
+		cts.Labels = []string{}
+		for _, l := range ctx.AllSTRING_() {
+			label := parseStringLiteral(l.GetText())
+			if _, ok := labelSet[label]; ok {
+				s.errs.TokenErr(l.GetSymbol(), ErrSyntax, "enum label %s redeclared", label)
+			}
+			labelSet[label] = struct{}{}
+
+			cts.Labels = append(cts.Labels, label)
+		}
+	}
+
+	cts.Set(ctx)
+	return cts
+}
+
+func (s *schemaVisitor) VisitType_field(ctx *gen.Type_fieldContext) any {
+	return &engine.NamedType{
+		Name: s.getIdent(ctx.Identifier()),
+		Type: ctx.Type_().Accept(s).(*types.DataType),
+	}
+}
+
+func (s *schemaVisitor) VisitDrop_object_statement(ctx *gen.Drop_object_statementContext) any {
+	ifExists := ctx.EXISTS() != nil
+	name := s.getIdent(ctx.Identifier())
+
+	if strings.EqualFold(ctx.IDENTIFIER().GetText(), "SCHEDULE") {
+		dss := &DropScheduleStatement{
+			IfExists: ifExists,
+			Name:     name,
+		}
+
+		dss.Set(ctx)
+		return dss
+	}
+
+	dts := &DropTypeStatement{
+		IfExists: ifExists,
+		Name:     name,
+	}
+	s.expectKeyword(ctx, ctx.IDENTIFIER(), "TYPE")
+
+	dts.Set(ctx)
+	return dts
+}
+
+func (s *schemaVisitor) VisitCreate_schedule_statement(ctx *gen.Create_schedule_statementContext) any {
+	css := &CreateScheduleStatement{
+		IfNotExists: ctx.EXISTS() != nil,
+		Name:        s.getIdent(ctx.Identifier()),
+		Call:        ctx.Action_function_call().Accept(s).(*ExpressionFunctionCall),
+	}
+	s.expectKeyword(ctx, ctx.IDENTIFIER(0), "SCHEDULE")
+	s.expectKeyword(ctx, ctx.IDENTIFIER(1), "EVERY")
+	s.expectKeyword(ctx, ctx.IDENTIFIER(2), "BLOCKS")
+
+	interval, err := strconv.ParseInt(ctx.DIGITS_().GetText(), 10, 64)
+	if err != nil || interval <= 0 {
+		s.errs.TokenErr(ctx.DIGITS_().GetSymbol(), ErrSyntax, "schedule interval must be a positive number of blocks")
+	}
+	css.Interval = interval
+
+	for i, arg := range css.Call.Args {
+		if !isScheduleArgument(arg) {
+			s.errs.RuleErr(ctx.Action_function_call(), ErrSyntax, "argument %d of a scheduled call must be a constant or a contextual variable", i+1)
+		}
+	}
+
+	css.Set(ctx)
+	return css
+}
+
+// isScheduleArgument reports whether an expression can be an argument to a
+// scheduled action call. Scheduled calls are evaluated at the end of a block,
+// outside of any action, so only constants and contextual variables can be
+// used.
+func isScheduleArgument(e Expression) bool {
+	if v, ok := e.(*ExpressionVariable); ok {
+		return v.Prefix == VariablePrefixAt
+	}
+
+	return isConstant(e)
+}
+
 // unknownExpression creates a new literal with an unknown type and null value.
 // It should be used when we have to return early from a visitor method that
 // returns an expression.
@@ -2376,14 +2553,57 @@ func (s *schemaVisitor) VisitNormal_call_action(ctx *gen.Normal_call_actionConte
 	}
 
 	// distinct and * cannot be used in action function calls
-	if ctx.Action_expr_list() != nil {
-		call.Args = ctx.Action_expr_list().Accept(s).([]Expression)
+	args := ctx.AllAction_argument()
+	for i, arg := range args {
+		call.Args = append(call.Args, arg.Accept(s).(Expression))
+
+		if arg.GetName() == nil {
+			if call.ArgNames != nil {
+				s.errs.RuleErr(arg, ErrNamedArgument, "positional arguments cannot follow named arguments")
+			}
+			continue
+		}
+
+		if call.ArgNames == nil {
+			call.ArgNames = make([]string, len(args))
+		}
+
+		name := s.argumentName(arg)
+		if slices.Contains(call.ArgNames, name) {
+			s.errs.RuleErr(arg, ErrNamedArgument, `argument "%s" specified more than once`, name)
+		}
+		call.ArgNames[i] = name
 	}
 
 	call.Set(ctx)
 	return call
 }
 
+func (s *schemaVisitor) VisitAction_argument(ctx *gen.Action_argumentContext) any {
+	return ctx.Action_expr().Accept(s)
+}
+
+// argumentName returns the name of a named action argument. The grammar
+// matches any token as the name, so that keywords such as "limit" can be used
+// to name parameters. It can optionally be prefixed with a $, like the
+// parameter itself.
+func (s *schemaVisitor) argumentName(ctx gen.IAction_argumentContext) string {
+	// "=>" is lexed as "=" followed by ">", so we check that they are adjacent
+	if ctx.EQUALS().GetSymbol().GetStop()+1 != ctx.GT().GetSymbol().GetStart() {
+		s.errs.RuleErr(ctx, ErrSyntax, `expected "=>" after argument name`)
+	}
+
+	name := strings.ToLower(strings.TrimPrefix(ctx.GetName().GetText(), "$"))
+	if !argumentNameRegexp.MatchString(name) {
+		s.errs.RuleErr(ctx, ErrNamedArgument, `invalid argument name "%s"`, ctx.GetName().GetText())
+	}
+	s.validateVariableIdentifier(ctx, name)
+
+	return name
+}
+
+var argumentNameRegexp = regexp.MustCompile(`^[a-z_][a-z0-9_]*$`)
+
 func (s *schemaVisitor) VisitRange(ctx *gen.RangeContext) any {
 	r := &LoopTermRange{
 		Start: ctx.Action_expr(0).Accept(s).(Expression),
@@ -125,6 +125,10 @@ type ExpressionFunctionCall struct {
 	// Args are the arguments to the function call.
 	// They are passed using ()
 	Args []Expression
+	// ArgNames are the names of the arguments, by position in Args.
+	// Positional arguments have an empty name. It is nil unless the
+	// function call is an action call that uses named arguments.
+	ArgNames []string
 	// Distinct is true if the function call is a DISTINCT function call.
 	Distinct bool
 	// Star is true if the function call is a * function call.
@@ -593,6 +597,10 @@ type CreateActionStatement struct {
 
 	// Parameters are the parameters of the action.
 	Parameters []*engine.NamedType
+	// Defaults are the default values of the parameters, by position
+	// in Parameters. Parameters without a default have a nil entry.
+	// It is nil if no parameter has a default.
+	Defaults []Expression
 	// Public is true if the action is public.
 	// Public bool
 
@@ -628,6 +636,88 @@ func (d *DropActionStatement) Accept(v Visitor) any {
 	return v.VisitDropActionStatement(d)
 }
 
+// CreateTypeStatement is a CREATE TYPE statement, which creates either a
+// composite type or an enum type.
+type CreateTypeStatement struct {
+	Position
+	Namespacing
+	// IfNotExists is true if the IF NOT EXISTS clause is present.
+	IfNotExists bool
+	// Name is the name of the type.
+	Name string
+	// Fields are the fields of a composite type.
+	// Either Fields or Labels is set, but not both.
+	Fields []*engine.NamedType
+	// Labels are the labels of an enum type, in order.
+	// Either Fields or Labels is set, but not both.
+	Labels []string
+}
+
+func (c *CreateTypeStatement) topLevelStatement() {}
+
+func (c *CreateTypeStatement) Accept(v Visitor) any {
+	return v.VisitCreateTypeStatement(c)
+}
+
+// IsEnum reports whether the statement creates an enum type.
+func (c *CreateTypeStatement) IsEnum() bool {
+	return c.Labels != nil
+}
+
+type DropTypeStatement struct {
+	Position
+	Namespacing
+	// IfExists is true if the IF EXISTS clause is present.
+	IfExists bool
+	// Name is the name of the type.
+	Name string
+}
+
+func (d *DropTypeStatement) topLevelStatement() {}
+
+func (d *DropTypeStatement) Accept(v Visitor) any {
+	return v.VisitDropTypeStatement(d)
+}
+
+// CreateScheduleStatement is a CREATE SCHEDULE statement, which makes the
+// engine call an action every Interval blocks.
+type CreateScheduleStatement struct {
+	Position
+	Namespacing
+	// IfNotExists is true if the IF NOT EXISTS clause is present.
+	IfNotExists bool
+	// Name is the name of the schedule.
+	Name string
+	// Interval is the number of blocks between calls.
+	Interval int64
+	// Call is the action call. Its arguments are constants or contextual
+	// variables, which are evaluated each time the action is called.
+	Call *ExpressionFunctionCall
+	// Raw is the raw CREATE SCHEDULE statement.
+	Raw string
+}
+
+func (c *CreateScheduleStatement) topLevelStatement() {}
+
+func (c *CreateScheduleStatement) Accept(v Visitor) any {
+	return v.VisitCreateScheduleStatement(c)
+}
+
+type DropScheduleStatement struct {
+	Position
+	Namespacing
+	// IfExists is true if the IF EXISTS clause is present.
+	IfExists bool
+	// Name is the name of the schedule.
+	Name string
+}
+
+func (d *DropScheduleStatement) topLevelStatement() {}
+
+func (d *DropScheduleStatement) Accept(v Visitor) any {
+	return v.VisitDropScheduleStatement(d)
+}
+
 // ActionReturn is the return struct of the action.
 type ActionReturn struct {
 	Position
@@ -1711,6 +1801,10 @@ type DDLVisitor interface {
 	VisitSetCurrentNamespaceStatement(*SetCurrentNamespaceStatement) any
 	VisitCreateActionStatement(*CreateActionStatement) any
 	VisitDropActionStatement(*DropActionStatement) any
+	VisitCreateTypeStatement(*CreateTypeStatement) any
+	VisitDropTypeStatement(*DropTypeStatement) any
+	VisitCreateScheduleStatement(*CreateScheduleStatement) any
+	VisitDropScheduleStatement(*DropScheduleStatement) any
 	// Constraints
 	VisitPrimaryKeyInlineConstraint(*PrimaryKeyInlineConstraint) any
 	VisitPrimaryKeyOutOfLineConstraint(*PrimaryKeyOutOfLineConstraint) any
@@ -1825,7 +1919,11 @@ func (s *UnimplementedActionVisitor) VisitActionStmtSQL(p0 *ActionStmtSQL) any {
 	panic(fmt.Sprintf("api misuse: cannot visit %T in constrained visitor", s))
 }
 
-func (s *UnimplementedActionVisitor) VisitActionStmtBreak(p0 *ActionStmtLoopControl) any {
+func (s *UnimplementedActionVisitor) VisitLoopTermExpression(p0 *LoopTermExpression) any {
+	panic(fmt.Sprintf("api misuse: cannot visit %T in constrained visitor", s))
+}
+
+func (s *UnimplementedActionVisitor) VisitActionStmtLoopControl(p0 *ActionStmtLoopControl) any {
 	panic(fmt.Sprintf("api misuse: cannot visit %T in constrained visitor", s))
 }
 
@@ -1951,6 +2049,22 @@ func (u *UnimplementedDDLVisitor) VisitDropActionStatement(p0 *DropActionStateme
 	panic(fmt.Sprintf("api misuse: cannot visit %T in constrained visitor", u))
 }
 
+func (u *UnimplementedDDLVisitor) VisitCreateTypeStatement(p0 *CreateTypeStatement) any {
+	panic(fmt.Sprintf("api misuse: cannot visit %T in constrained visitor", u))
+}
+
+func (u *UnimplementedDDLVisitor) VisitDropTypeStatement(p0 *DropTypeStatement) any {
+	panic(fmt.Sprintf("api misuse: cannot visit %T in constrained visitor", u))
+}
+
+func (u *UnimplementedDDLVisitor) VisitCreateScheduleStatement(p0 *CreateScheduleStatement) any {
+	panic(fmt.Sprintf("api misuse: cannot visit %T in constrained visitor", u))
+}
+
+func (u *UnimplementedDDLVisitor) VisitDropScheduleStatement(p0 *DropScheduleStatement) any {
+	panic(fmt.Sprintf("api misuse: cannot visit %T in constrained visitor", u))
+}
+
 func (u *UnimplementedDDLVisitor) VisitUseExtensionStatement(p0 *UseExtensionStatement) any {
 	panic(fmt.Sprintf("api misuse: cannot visit %T in constrained visitor", u))
 }
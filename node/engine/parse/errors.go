@@ -240,6 +240,8 @@ var (
 	ErrTableDefinition           = errors.New("table definition error")
 	ErrUnknownColumn             = errors.New("unknown column reference")
 	ErrDuplicateParameterName    = errors.New("duplicate parameter name")
+	ErrParameterDefault          = errors.New("invalid parameter default")
+	ErrNamedArgument             = errors.New("invalid named argument")
 	ErrDuplicateResultColumnName = errors.New("duplicate result column name")
 	ErrIdentifier                = errors.New("identifier error")
 	ErrCollation                 = errors.New("collation error")
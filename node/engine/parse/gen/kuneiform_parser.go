@@ -95,11 +95,12 @@ func kuneiformparserParserInit() {
 		"upsert_clause", "delete_statement", "sql_expr", "window", "when_then_clause",
 		"sql_expr_list", "sql_function_call", "action_expr", "action_expr_list",
 		"action_statement", "variable_or_underscore", "action_function_call",
-		"if_then_block", "range",
+		"if_then_block", "range", "action_parameter", "action_argument", "create_type_statement",
+		"drop_object_statement", "type_field", "create_schedule_statement",
 	}
 	staticData.PredictionContextCache = antlr.NewPredictionContextCache()
 	staticData.serializedATN = []int32{
-		4, 1, 155, 1387, 2, 0, 7, 0, 2, 1, 7, 1, 2, 2, 7, 2, 2, 3, 7, 3, 2, 4,
+		4, 1, 155, 1478, 2, 0, 7, 0, 2, 1, 7, 1, 2, 2, 7, 2, 2, 3, 7, 3, 2, 4,
 		7, 4, 2, 5, 7, 5, 2, 6, 7, 6, 2, 7, 7, 7, 2, 8, 7, 8, 2, 9, 7, 9, 2, 10,
 		7, 10, 2, 11, 7, 11, 2, 12, 7, 12, 2, 13, 7, 13, 2, 14, 7, 14, 2, 15, 7,
 		15, 2, 16, 7, 16, 2, 17, 7, 17, 2, 18, 7, 18, 2, 19, 7, 19, 2, 20, 7, 20,
@@ -111,681 +112,725 @@ func kuneiformparserParserInit() {
 		47, 7, 47, 2, 48, 7, 48, 2, 49, 7, 49, 2, 50, 7, 50, 2, 51, 7, 51, 2, 52,
 		7, 52, 2, 53, 7, 53, 2, 54, 7, 54, 2, 55, 7, 55, 2, 56, 7, 56, 2, 57, 7,
 		57, 2, 58, 7, 58, 2, 59, 7, 59, 2, 60, 7, 60, 2, 61, 7, 61, 2, 62, 7, 62,
-		2, 63, 7, 63, 1, 0, 1, 0, 1, 0, 5, 0, 132, 8, 0, 10, 0, 12, 0, 135, 9,
-		0, 1, 0, 3, 0, 138, 8, 0, 1, 0, 1, 0, 1, 1, 1, 1, 1, 1, 1, 1, 3, 1, 146,
-		8, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1,
-		1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 3, 1, 166, 8, 1, 1, 2, 1, 2,
-		3, 2, 170, 8, 2, 1, 2, 1, 2, 3, 2, 174, 8, 2, 1, 2, 1, 2, 1, 2, 1, 2, 1,
-		2, 1, 2, 3, 2, 182, 8, 2, 1, 3, 1, 3, 1, 3, 1, 3, 1, 3, 3, 3, 189, 8, 3,
-		1, 4, 1, 4, 1, 5, 1, 5, 1, 5, 5, 5, 196, 8, 5, 10, 5, 12, 5, 199, 9, 5,
-		1, 6, 1, 6, 1, 6, 1, 6, 1, 6, 3, 6, 206, 8, 6, 1, 6, 3, 6, 209, 8, 6, 1,
-		6, 1, 6, 3, 6, 213, 8, 6, 1, 7, 1, 7, 1, 7, 1, 8, 1, 8, 1, 9, 1, 9, 1,
-		9, 5, 9, 223, 8, 9, 10, 9, 12, 9, 226, 9, 9, 1, 10, 1, 10, 1, 10, 5, 10,
-		231, 8, 10, 10, 10, 12, 10, 234, 9, 10, 1, 11, 1, 11, 1, 11, 1, 11, 1,
-		11, 1, 11, 5, 11, 242, 8, 11, 10, 11, 12, 11, 245, 9, 11, 1, 12, 1, 12,
-		1, 12, 1, 12, 1, 12, 1, 12, 1, 12, 1, 12, 1, 12, 1, 12, 1, 12, 1, 12, 1,
-		12, 3, 12, 260, 8, 12, 1, 13, 1, 13, 1, 13, 1, 13, 1, 13, 1, 13, 1, 13,
-		1, 13, 1, 13, 1, 13, 3, 13, 272, 8, 13, 1, 14, 1, 14, 1, 14, 1, 14, 3,
-		14, 278, 8, 14, 1, 14, 1, 14, 1, 14, 1, 14, 1, 14, 1, 14, 3, 14, 286, 8,
-		14, 3, 14, 288, 8, 14, 1, 15, 1, 15, 3, 15, 292, 8, 15, 1, 15, 1, 15, 1,
-		15, 1, 15, 1, 15, 1, 15, 1, 15, 1, 15, 3, 15, 302, 8, 15, 1, 16, 1, 16,
-		3, 16, 306, 8, 16, 1, 16, 1, 16, 1, 16, 5, 16, 311, 8, 16, 10, 16, 12,
-		16, 314, 9, 16, 3, 16, 316, 8, 16, 1, 16, 1, 16, 1, 16, 1, 16, 3, 16, 322,
-		8, 16, 1, 17, 1, 17, 1, 17, 1, 17, 1, 17, 5, 17, 329, 8, 17, 10, 17, 12,
-		17, 332, 9, 17, 3, 17, 334, 8, 17, 1, 17, 3, 17, 337, 8, 17, 1, 17, 1,
-		17, 1, 17, 1, 17, 1, 17, 1, 18, 1, 18, 1, 18, 1, 18, 1, 18, 3, 18, 349,
-		8, 18, 1, 18, 1, 18, 1, 18, 1, 18, 3, 18, 355, 8, 18, 1, 18, 1, 18, 1,
-		18, 3, 18, 360, 8, 18, 5, 18, 362, 8, 18, 10, 18, 12, 18, 365, 9, 18, 1,
-		18, 1, 18, 1, 19, 1, 19, 3, 19, 371, 8, 19, 1, 19, 1, 19, 1, 19, 1, 19,
-		1, 19, 1, 19, 1, 19, 1, 19, 1, 19, 1, 19, 1, 19, 1, 19, 1, 19, 1, 19, 1,
-		19, 1, 19, 1, 19, 1, 19, 1, 19, 1, 19, 1, 19, 1, 19, 1, 19, 3, 19, 396,
-		8, 19, 1, 20, 1, 20, 1, 21, 1, 21, 1, 21, 1, 21, 3, 21, 404, 8, 21, 1,
-		21, 1, 21, 3, 21, 408, 8, 21, 1, 22, 1, 22, 1, 22, 1, 22, 1, 22, 1, 22,
-		5, 22, 416, 8, 22, 10, 22, 12, 22, 419, 9, 22, 1, 23, 1, 23, 1, 23, 1,
-		23, 1, 23, 1, 23, 1, 23, 1, 23, 3, 23, 429, 8, 23, 1, 23, 1, 23, 1, 23,
-		1, 23, 1, 23, 1, 23, 1, 23, 3, 23, 438, 8, 23, 1, 23, 1, 23, 1, 23, 1,
-		23, 1, 23, 3, 23, 445, 8, 23, 1, 23, 1, 23, 1, 23, 1, 23, 1, 23, 1, 23,
-		1, 23, 3, 23, 454, 8, 23, 1, 23, 1, 23, 1, 23, 1, 23, 1, 23, 1, 23, 1,
-		23, 1, 23, 1, 23, 1, 23, 1, 23, 1, 23, 1, 23, 1, 23, 1, 23, 1, 23, 3, 23,
-		472, 8, 23, 1, 23, 3, 23, 475, 8, 23, 1, 24, 1, 24, 3, 24, 479, 8, 24,
-		1, 24, 1, 24, 1, 24, 1, 24, 3, 24, 485, 8, 24, 1, 24, 3, 24, 488, 8, 24,
-		1, 24, 1, 24, 1, 24, 1, 24, 1, 24, 1, 24, 1, 25, 1, 25, 1, 25, 1, 25, 3,
-		25, 500, 8, 25, 1, 25, 1, 25, 1, 26, 1, 26, 1, 26, 1, 26, 1, 26, 3, 26,
-		509, 8, 26, 1, 26, 1, 26, 1, 27, 1, 27, 1, 27, 1, 27, 3, 27, 517, 8, 27,
-		1, 27, 1, 27, 1, 28, 1, 28, 1, 28, 1, 28, 3, 28, 525, 8, 28, 1, 28, 1,
-		28, 3, 28, 529, 8, 28, 1, 28, 1, 28, 3, 28, 533, 8, 28, 1, 28, 1, 28, 1,
-		28, 1, 28, 3, 28, 539, 8, 28, 1, 29, 1, 29, 1, 29, 3, 29, 544, 8, 29, 1,
-		29, 1, 29, 3, 29, 548, 8, 29, 1, 29, 1, 29, 3, 29, 552, 8, 29, 1, 29, 1,
-		29, 1, 29, 1, 29, 3, 29, 558, 8, 29, 1, 30, 1, 30, 1, 30, 1, 30, 1, 30,
-		3, 30, 565, 8, 30, 1, 31, 1, 31, 1, 31, 5, 31, 570, 8, 31, 10, 31, 12,
-		31, 573, 9, 31, 1, 32, 1, 32, 1, 33, 1, 33, 1, 33, 3, 33, 580, 8, 33, 1,
-		33, 1, 33, 1, 33, 1, 33, 3, 33, 586, 8, 33, 1, 33, 1, 33, 1, 33, 1, 33,
-		1, 33, 1, 33, 1, 33, 5, 33, 595, 8, 33, 10, 33, 12, 33, 598, 9, 33, 3,
-		33, 600, 8, 33, 1, 33, 1, 33, 5, 33, 604, 8, 33, 10, 33, 12, 33, 607, 9,
-		33, 1, 33, 3, 33, 610, 8, 33, 1, 33, 1, 33, 5, 33, 614, 8, 33, 10, 33,
-		12, 33, 617, 9, 33, 1, 33, 1, 33, 1, 34, 1, 34, 1, 34, 1, 34, 3, 34, 625,
-		8, 34, 1, 34, 1, 34, 1, 35, 1, 35, 1, 35, 1, 35, 3, 35, 633, 8, 35, 1,
-		35, 1, 35, 1, 35, 1, 35, 1, 35, 1, 35, 1, 35, 1, 35, 1, 35, 1, 35, 5, 35,
-		645, 8, 35, 10, 35, 12, 35, 648, 9, 35, 3, 35, 650, 8, 35, 1, 35, 3, 35,
-		653, 8, 35, 1, 35, 1, 35, 1, 35, 1, 36, 1, 36, 1, 36, 1, 36, 3, 36, 662,
-		8, 36, 1, 37, 1, 37, 1, 37, 1, 37, 1, 37, 3, 37, 669, 8, 37, 1, 37, 1,
-		37, 1, 38, 1, 38, 1, 38, 1, 38, 3, 38, 677, 8, 38, 1, 38, 1, 38, 1, 39,
-		1, 39, 1, 39, 1, 39, 1, 39, 1, 39, 1, 40, 1, 40, 1, 40, 1, 40, 5, 40, 691,
-		8, 40, 10, 40, 12, 40, 694, 9, 40, 1, 40, 1, 40, 1, 40, 1, 40, 1, 40, 5,
-		40, 701, 8, 40, 10, 40, 12, 40, 704, 9, 40, 3, 40, 706, 8, 40, 1, 40, 1,
-		40, 3, 40, 710, 8, 40, 1, 40, 1, 40, 3, 40, 714, 8, 40, 1, 41, 1, 41, 3,
-		41, 718, 8, 41, 1, 41, 1, 41, 3, 41, 722, 8, 41, 1, 42, 1, 42, 3, 42, 726,
-		8, 42, 1, 42, 1, 42, 3, 42, 730, 8, 42, 1, 43, 1, 43, 3, 43, 734, 8, 43,
-		1, 43, 1, 43, 1, 43, 5, 43, 739, 8, 43, 10, 43, 12, 43, 742, 9, 43, 1,
-		43, 1, 43, 1, 43, 5, 43, 747, 8, 43, 10, 43, 12, 43, 750, 9, 43, 3, 43,
-		752, 8, 43, 1, 43, 1, 43, 3, 43, 756, 8, 43, 1, 43, 1, 43, 1, 43, 1, 43,
-		1, 43, 3, 43, 763, 8, 43, 3, 43, 765, 8, 43, 1, 43, 1, 43, 1, 43, 1, 43,
-		1, 43, 1, 43, 1, 43, 1, 43, 1, 43, 5, 43, 776, 8, 43, 10, 43, 12, 43, 779,
-		9, 43, 3, 43, 781, 8, 43, 1, 44, 1, 44, 1, 44, 3, 44, 786, 8, 44, 1, 44,
-		1, 44, 3, 44, 790, 8, 44, 1, 44, 3, 44, 793, 8, 44, 1, 44, 1, 44, 1, 44,
-		1, 44, 3, 44, 799, 8, 44, 1, 44, 3, 44, 802, 8, 44, 3, 44, 804, 8, 44,
-		1, 45, 3, 45, 807, 8, 45, 1, 45, 1, 45, 1, 45, 1, 45, 1, 45, 1, 46, 1,
-		46, 3, 46, 816, 8, 46, 1, 46, 3, 46, 819, 8, 46, 1, 46, 1, 46, 1, 46, 3,
-		46, 824, 8, 46, 1, 46, 3, 46, 827, 8, 46, 1, 47, 1, 47, 1, 47, 3, 47, 832,
-		8, 47, 1, 47, 3, 47, 835, 8, 47, 1, 47, 1, 47, 1, 47, 1, 47, 5, 47, 841,
-		8, 47, 10, 47, 12, 47, 844, 9, 47, 1, 47, 1, 47, 1, 47, 5, 47, 849, 8,
-		47, 10, 47, 12, 47, 852, 9, 47, 3, 47, 854, 8, 47, 1, 47, 1, 47, 3, 47,
-		858, 8, 47, 1, 48, 1, 48, 1, 48, 1, 48, 1, 49, 1, 49, 1, 49, 1, 49, 3,
-		49, 868, 8, 49, 1, 49, 3, 49, 871, 8, 49, 1, 49, 1, 49, 1, 49, 1, 49, 3,
-		49, 877, 8, 49, 1, 49, 1, 49, 1, 49, 1, 49, 1, 49, 1, 49, 1, 49, 1, 49,
-		1, 49, 5, 49, 888, 8, 49, 10, 49, 12, 49, 891, 9, 49, 1, 49, 3, 49, 894,
-		8, 49, 1, 49, 3, 49, 897, 8, 49, 1, 50, 1, 50, 1, 50, 1, 50, 1, 50, 1,
-		50, 1, 50, 3, 50, 906, 8, 50, 3, 50, 908, 8, 50, 1, 50, 1, 50, 1, 50, 1,
-		50, 1, 50, 1, 50, 1, 50, 5, 50, 917, 8, 50, 10, 50, 12, 50, 920, 9, 50,
-		1, 50, 1, 50, 3, 50, 924, 8, 50, 3, 50, 926, 8, 50, 1, 51, 1, 51, 1, 51,
-		1, 51, 3, 51, 932, 8, 51, 1, 51, 3, 51, 935, 8, 51, 1, 51, 1, 51, 3, 51,
-		939, 8, 51, 1, 52, 1, 52, 1, 52, 1, 52, 1, 52, 3, 52, 946, 8, 52, 1, 52,
-		1, 52, 1, 52, 1, 52, 3, 52, 952, 8, 52, 1, 52, 1, 52, 1, 52, 1, 52, 1,
-		52, 1, 52, 1, 52, 3, 52, 961, 8, 52, 1, 52, 1, 52, 1, 52, 3, 52, 966, 8,
-		52, 1, 52, 1, 52, 3, 52, 970, 8, 52, 1, 52, 1, 52, 3, 52, 974, 8, 52, 1,
-		52, 1, 52, 1, 52, 3, 52, 979, 8, 52, 1, 52, 1, 52, 3, 52, 983, 8, 52, 1,
-		52, 1, 52, 1, 52, 3, 52, 988, 8, 52, 1, 52, 1, 52, 3, 52, 992, 8, 52, 1,
-		52, 1, 52, 3, 52, 996, 8, 52, 1, 52, 4, 52, 999, 8, 52, 11, 52, 12, 52,
-		1000, 1, 52, 1, 52, 3, 52, 1005, 8, 52, 1, 52, 1, 52, 1, 52, 3, 52, 1010,
-		8, 52, 1, 52, 3, 52, 1013, 8, 52, 1, 52, 1, 52, 1, 52, 1, 52, 3, 52, 1019,
-		8, 52, 1, 52, 1, 52, 3, 52, 1023, 8, 52, 1, 52, 1, 52, 1, 52, 1, 52, 1,
-		52, 1, 52, 1, 52, 1, 52, 1, 52, 1, 52, 1, 52, 1, 52, 1, 52, 1, 52, 3, 52,
-		1039, 8, 52, 1, 52, 1, 52, 1, 52, 1, 52, 3, 52, 1045, 8, 52, 1, 52, 1,
+		2, 63, 7, 63, 2, 64, 7, 64, 2, 65, 7, 65, 2, 66, 7, 66, 2, 67, 7, 67, 2,
+		68, 7, 68, 2, 69, 7, 69, 1, 0, 1, 0, 1, 0, 5, 0, 144, 8, 0, 10, 0, 12,
+		0, 147, 9, 0, 1, 0, 3, 0, 150, 8, 0, 1, 0, 1, 0, 1, 1, 1, 1, 1, 1, 1, 1,
+		3, 1, 158, 8, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1,
+		1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1,
+		3, 1, 181, 8, 1, 1, 2, 1, 2, 3, 2, 185, 8, 2, 1, 2, 1, 2, 3, 2, 189, 8,
+		2, 1, 2, 1, 2, 1, 2, 1, 2, 1, 2, 1, 2, 3, 2, 197, 8, 2, 1, 3, 1, 3, 1,
+		3, 1, 3, 1, 3, 3, 3, 204, 8, 3, 1, 4, 1, 4, 1, 5, 1, 5, 1, 5, 5, 5, 211,
+		8, 5, 10, 5, 12, 5, 214, 9, 5, 1, 6, 1, 6, 1, 6, 1, 6, 1, 6, 3, 6, 221,
+		8, 6, 1, 6, 3, 6, 224, 8, 6, 1, 6, 1, 6, 3, 6, 228, 8, 6, 1, 7, 1, 7, 1,
+		7, 1, 8, 1, 8, 1, 9, 1, 9, 1, 9, 5, 9, 238, 8, 9, 10, 9, 12, 9, 241, 9,
+		9, 1, 10, 1, 10, 1, 10, 5, 10, 246, 8, 10, 10, 10, 12, 10, 249, 9, 10,
+		1, 11, 1, 11, 1, 11, 1, 11, 1, 11, 1, 11, 5, 11, 257, 8, 11, 10, 11, 12,
+		11, 260, 9, 11, 1, 12, 1, 12, 1, 12, 1, 12, 1, 12, 1, 12, 1, 12, 1, 12,
+		1, 12, 1, 12, 1, 12, 1, 12, 1, 12, 3, 12, 275, 8, 12, 1, 13, 1, 13, 1,
+		13, 1, 13, 1, 13, 1, 13, 1, 13, 1, 13, 1, 13, 1, 13, 3, 13, 287, 8, 13,
+		1, 14, 1, 14, 1, 14, 1, 14, 3, 14, 293, 8, 14, 1, 14, 1, 14, 1, 14, 1,
+		14, 1, 14, 1, 14, 3, 14, 301, 8, 14, 3, 14, 303, 8, 14, 1, 15, 1, 15, 3,
+		15, 307, 8, 15, 1, 15, 1, 15, 1, 15, 1, 15, 1, 15, 1, 15, 1, 15, 1, 15,
+		3, 15, 317, 8, 15, 1, 16, 1, 16, 3, 16, 321, 8, 16, 1, 16, 1, 16, 1, 16,
+		5, 16, 326, 8, 16, 10, 16, 12, 16, 329, 9, 16, 3, 16, 331, 8, 16, 1, 16,
+		1, 16, 1, 16, 1, 16, 3, 16, 337, 8, 16, 1, 17, 1, 17, 1, 17, 1, 17, 1,
+		17, 5, 17, 344, 8, 17, 10, 17, 12, 17, 347, 9, 17, 3, 17, 349, 8, 17, 1,
+		17, 3, 17, 352, 8, 17, 1, 17, 1, 17, 1, 17, 1, 17, 1, 17, 1, 18, 1, 18,
+		1, 18, 1, 18, 1, 18, 3, 18, 364, 8, 18, 1, 18, 1, 18, 1, 18, 1, 18, 3,
+		18, 370, 8, 18, 1, 18, 1, 18, 1, 18, 3, 18, 375, 8, 18, 5, 18, 377, 8,
+		18, 10, 18, 12, 18, 380, 9, 18, 1, 18, 1, 18, 1, 19, 1, 19, 3, 19, 386,
+		8, 19, 1, 19, 1, 19, 1, 19, 1, 19, 1, 19, 1, 19, 1, 19, 1, 19, 1, 19, 1,
+		19, 1, 19, 1, 19, 1, 19, 1, 19, 1, 19, 1, 19, 1, 19, 1, 19, 1, 19, 1, 19,
+		1, 19, 1, 19, 1, 19, 3, 19, 411, 8, 19, 1, 20, 1, 20, 1, 21, 1, 21, 1,
+		21, 1, 21, 3, 21, 419, 8, 21, 1, 21, 1, 21, 3, 21, 423, 8, 21, 1, 22, 1,
+		22, 1, 22, 1, 22, 1, 22, 1, 22, 5, 22, 431, 8, 22, 10, 22, 12, 22, 434,
+		9, 22, 1, 23, 1, 23, 1, 23, 1, 23, 1, 23, 1, 23, 1, 23, 1, 23, 3, 23, 444,
+		8, 23, 1, 23, 1, 23, 1, 23, 1, 23, 1, 23, 1, 23, 1, 23, 3, 23, 453, 8,
+		23, 1, 23, 1, 23, 1, 23, 1, 23, 1, 23, 3, 23, 460, 8, 23, 1, 23, 1, 23,
+		1, 23, 1, 23, 1, 23, 1, 23, 1, 23, 3, 23, 469, 8, 23, 1, 23, 1, 23, 1,
+		23, 1, 23, 1, 23, 1, 23, 1, 23, 1, 23, 1, 23, 1, 23, 1, 23, 1, 23, 1, 23,
+		1, 23, 1, 23, 1, 23, 3, 23, 487, 8, 23, 1, 23, 3, 23, 490, 8, 23, 1, 24,
+		1, 24, 3, 24, 494, 8, 24, 1, 24, 1, 24, 1, 24, 1, 24, 3, 24, 500, 8, 24,
+		1, 24, 3, 24, 503, 8, 24, 1, 24, 1, 24, 1, 24, 1, 24, 1, 24, 1, 24, 1,
+		25, 1, 25, 1, 25, 1, 25, 3, 25, 515, 8, 25, 1, 25, 1, 25, 1, 26, 1, 26,
+		1, 26, 1, 26, 1, 26, 3, 26, 524, 8, 26, 1, 26, 1, 26, 1, 27, 1, 27, 1,
+		27, 1, 27, 3, 27, 532, 8, 27, 1, 27, 1, 27, 1, 28, 1, 28, 1, 28, 1, 28,
+		3, 28, 540, 8, 28, 1, 28, 1, 28, 3, 28, 544, 8, 28, 1, 28, 1, 28, 3, 28,
+		548, 8, 28, 1, 28, 1, 28, 1, 28, 1, 28, 3, 28, 554, 8, 28, 1, 29, 1, 29,
+		1, 29, 3, 29, 559, 8, 29, 1, 29, 1, 29, 3, 29, 563, 8, 29, 1, 29, 1, 29,
+		3, 29, 567, 8, 29, 1, 29, 1, 29, 1, 29, 1, 29, 3, 29, 573, 8, 29, 1, 30,
+		1, 30, 1, 30, 1, 30, 1, 30, 3, 30, 580, 8, 30, 1, 31, 1, 31, 1, 31, 5,
+		31, 585, 8, 31, 10, 31, 12, 31, 588, 9, 31, 1, 32, 1, 32, 1, 33, 1, 33,
+		1, 33, 3, 33, 595, 8, 33, 1, 33, 1, 33, 1, 33, 1, 33, 3, 33, 601, 8, 33,
+		1, 33, 1, 33, 1, 33, 1, 33, 1, 33, 5, 33, 608, 8, 33, 10, 33, 12, 33, 611,
+		9, 33, 3, 33, 613, 8, 33, 1, 33, 1, 33, 5, 33, 617, 8, 33, 10, 33, 12,
+		33, 620, 9, 33, 1, 33, 3, 33, 623, 8, 33, 1, 33, 1, 33, 5, 33, 627, 8,
+		33, 10, 33, 12, 33, 630, 9, 33, 1, 33, 1, 33, 1, 34, 1, 34, 1, 34, 1, 34,
+		3, 34, 638, 8, 34, 1, 34, 1, 34, 1, 35, 1, 35, 1, 35, 1, 35, 3, 35, 646,
+		8, 35, 1, 35, 1, 35, 1, 35, 1, 35, 1, 35, 1, 35, 1, 35, 1, 35, 1, 35, 1,
+		35, 5, 35, 658, 8, 35, 10, 35, 12, 35, 661, 9, 35, 3, 35, 663, 8, 35, 1,
+		35, 3, 35, 666, 8, 35, 1, 35, 1, 35, 1, 35, 1, 36, 1, 36, 1, 36, 1, 36,
+		3, 36, 675, 8, 36, 1, 37, 1, 37, 1, 37, 1, 37, 1, 37, 3, 37, 682, 8, 37,
+		1, 37, 1, 37, 1, 38, 1, 38, 1, 38, 1, 38, 3, 38, 690, 8, 38, 1, 38, 1,
+		38, 1, 39, 1, 39, 1, 39, 1, 39, 1, 39, 1, 39, 1, 40, 1, 40, 1, 40, 1, 40,
+		5, 40, 704, 8, 40, 10, 40, 12, 40, 707, 9, 40, 1, 40, 1, 40, 1, 40, 1,
+		40, 1, 40, 5, 40, 714, 8, 40, 10, 40, 12, 40, 717, 9, 40, 3, 40, 719, 8,
+		40, 1, 40, 1, 40, 3, 40, 723, 8, 40, 1, 40, 1, 40, 3, 40, 727, 8, 40, 1,
+		41, 1, 41, 3, 41, 731, 8, 41, 1, 41, 1, 41, 3, 41, 735, 8, 41, 1, 42, 1,
+		42, 3, 42, 739, 8, 42, 1, 42, 1, 42, 3, 42, 743, 8, 42, 1, 43, 1, 43, 3,
+		43, 747, 8, 43, 1, 43, 1, 43, 1, 43, 5, 43, 752, 8, 43, 10, 43, 12, 43,
+		755, 9, 43, 1, 43, 1, 43, 1, 43, 5, 43, 760, 8, 43, 10, 43, 12, 43, 763,
+		9, 43, 3, 43, 765, 8, 43, 1, 43, 1, 43, 3, 43, 769, 8, 43, 1, 43, 1, 43,
+		1, 43, 1, 43, 1, 43, 3, 43, 776, 8, 43, 3, 43, 778, 8, 43, 1, 43, 1, 43,
+		1, 43, 1, 43, 1, 43, 1, 43, 1, 43, 1, 43, 1, 43, 5, 43, 789, 8, 43, 10,
+		43, 12, 43, 792, 9, 43, 3, 43, 794, 8, 43, 1, 44, 1, 44, 1, 44, 3, 44,
+		799, 8, 44, 1, 44, 1, 44, 3, 44, 803, 8, 44, 1, 44, 3, 44, 806, 8, 44,
+		1, 44, 1, 44, 1, 44, 1, 44, 3, 44, 812, 8, 44, 1, 44, 3, 44, 815, 8, 44,
+		3, 44, 817, 8, 44, 1, 45, 3, 45, 820, 8, 45, 1, 45, 1, 45, 1, 45, 1, 45,
+		1, 45, 1, 46, 1, 46, 3, 46, 829, 8, 46, 1, 46, 3, 46, 832, 8, 46, 1, 46,
+		1, 46, 1, 46, 3, 46, 837, 8, 46, 1, 46, 3, 46, 840, 8, 46, 1, 47, 1, 47,
+		1, 47, 3, 47, 845, 8, 47, 1, 47, 3, 47, 848, 8, 47, 1, 47, 1, 47, 1, 47,
+		1, 47, 5, 47, 854, 8, 47, 10, 47, 12, 47, 857, 9, 47, 1, 47, 1, 47, 1,
+		47, 5, 47, 862, 8, 47, 10, 47, 12, 47, 865, 9, 47, 3, 47, 867, 8, 47, 1,
+		47, 1, 47, 3, 47, 871, 8, 47, 1, 48, 1, 48, 1, 48, 1, 48, 1, 49, 1, 49,
+		1, 49, 1, 49, 3, 49, 881, 8, 49, 1, 49, 3, 49, 884, 8, 49, 1, 49, 1, 49,
+		1, 49, 1, 49, 3, 49, 890, 8, 49, 1, 49, 1, 49, 1, 49, 1, 49, 1, 49, 1,
+		49, 1, 49, 1, 49, 1, 49, 5, 49, 901, 8, 49, 10, 49, 12, 49, 904, 9, 49,
+		1, 49, 3, 49, 907, 8, 49, 1, 49, 3, 49, 910, 8, 49, 1, 50, 1, 50, 1, 50,
+		1, 50, 1, 50, 1, 50, 1, 50, 3, 50, 919, 8, 50, 3, 50, 921, 8, 50, 1, 50,
+		1, 50, 1, 50, 1, 50, 1, 50, 1, 50, 1, 50, 5, 50, 930, 8, 50, 10, 50, 12,
+		50, 933, 9, 50, 1, 50, 1, 50, 3, 50, 937, 8, 50, 3, 50, 939, 8, 50, 1,
+		51, 1, 51, 1, 51, 1, 51, 3, 51, 945, 8, 51, 1, 51, 3, 51, 948, 8, 51, 1,
+		51, 1, 51, 3, 51, 952, 8, 51, 1, 52, 1, 52, 1, 52, 1, 52, 1, 52, 3, 52,
+		959, 8, 52, 1, 52, 1, 52, 1, 52, 1, 52, 3, 52, 965, 8, 52, 1, 52, 1, 52,
+		1, 52, 1, 52, 1, 52, 1, 52, 1, 52, 3, 52, 974, 8, 52, 1, 52, 1, 52, 1,
+		52, 3, 52, 979, 8, 52, 1, 52, 1, 52, 3, 52, 983, 8, 52, 1, 52, 1, 52, 3,
+		52, 987, 8, 52, 1, 52, 1, 52, 1, 52, 3, 52, 992, 8, 52, 1, 52, 1, 52, 3,
+		52, 996, 8, 52, 1, 52, 1, 52, 1, 52, 3, 52, 1001, 8, 52, 1, 52, 1, 52,
+		3, 52, 1005, 8, 52, 1, 52, 1, 52, 3, 52, 1009, 8, 52, 1, 52, 4, 52, 1012,
+		8, 52, 11, 52, 12, 52, 1013, 1, 52, 1, 52, 3, 52, 1018, 8, 52, 1, 52, 1,
+		52, 1, 52, 3, 52, 1023, 8, 52, 1, 52, 3, 52, 1026, 8, 52, 1, 52, 1, 52,
+		1, 52, 1, 52, 3, 52, 1032, 8, 52, 1, 52, 1, 52, 3, 52, 1036, 8, 52, 1,
 		52, 1, 52, 1, 52, 1, 52, 1, 52, 1, 52, 1, 52, 1, 52, 1, 52, 1, 52, 1, 52,
-		1, 52, 1, 52, 1, 52, 1, 52, 1, 52, 1, 52, 3, 52, 1065, 8, 52, 1, 52, 1,
-		52, 1, 52, 1, 52, 3, 52, 1071, 8, 52, 1, 52, 1, 52, 3, 52, 1075, 8, 52,
-		3, 52, 1077, 8, 52, 1, 52, 1, 52, 3, 52, 1081, 8, 52, 1, 52, 1, 52, 1,
-		52, 1, 52, 1, 52, 3, 52, 1088, 8, 52, 1, 52, 1, 52, 1, 52, 1, 52, 3, 52,
-		1094, 8, 52, 1, 52, 1, 52, 1, 52, 1, 52, 1, 52, 3, 52, 1101, 8, 52, 1,
-		52, 1, 52, 1, 52, 1, 52, 1, 52, 1, 52, 3, 52, 1109, 8, 52, 5, 52, 1111,
-		8, 52, 10, 52, 12, 52, 1114, 9, 52, 1, 53, 1, 53, 1, 53, 1, 53, 3, 53,
-		1120, 8, 53, 1, 53, 1, 53, 1, 53, 1, 53, 1, 53, 5, 53, 1127, 8, 53, 10,
-		53, 12, 53, 1130, 9, 53, 3, 53, 1132, 8, 53, 1, 53, 1, 53, 1, 54, 1, 54,
-		1, 54, 1, 54, 1, 54, 1, 55, 1, 55, 1, 55, 5, 55, 1144, 8, 55, 10, 55, 12,
-		55, 1147, 9, 55, 1, 56, 1, 56, 1, 56, 3, 56, 1152, 8, 56, 1, 56, 1, 56,
-		3, 56, 1156, 8, 56, 1, 56, 1, 56, 1, 57, 1, 57, 1, 57, 1, 57, 1, 57, 3,
-		57, 1165, 8, 57, 1, 57, 1, 57, 1, 57, 1, 57, 3, 57, 1171, 8, 57, 1, 57,
-		1, 57, 3, 57, 1175, 8, 57, 1, 57, 1, 57, 3, 57, 1179, 8, 57, 1, 57, 3,
-		57, 1182, 8, 57, 1, 57, 1, 57, 3, 57, 1186, 8, 57, 1, 57, 1, 57, 3, 57,
-		1190, 8, 57, 1, 57, 1, 57, 3, 57, 1194, 8, 57, 1, 57, 1, 57, 1, 57, 1,
+		1, 52, 1, 52, 1, 52, 3, 52, 1052, 8, 52, 1, 52, 1, 52, 1, 52, 1, 52, 3,
+		52, 1058, 8, 52, 1, 52, 1, 52, 1, 52, 1, 52, 1, 52, 1, 52, 1, 52, 1, 52,
+		1, 52, 1, 52, 1, 52, 1, 52, 1, 52, 1, 52, 1, 52, 1, 52, 1, 52, 1, 52, 3,
+		52, 1078, 8, 52, 1, 52, 1, 52, 1, 52, 1, 52, 3, 52, 1084, 8, 52, 1, 52,
+		1, 52, 3, 52, 1088, 8, 52, 3, 52, 1090, 8, 52, 1, 52, 1, 52, 3, 52, 1094,
+		8, 52, 1, 52, 1, 52, 1, 52, 1, 52, 1, 52, 3, 52, 1101, 8, 52, 1, 52, 1,
+		52, 1, 52, 1, 52, 3, 52, 1107, 8, 52, 1, 52, 1, 52, 1, 52, 1, 52, 1, 52,
+		3, 52, 1114, 8, 52, 1, 52, 1, 52, 1, 52, 1, 52, 1, 52, 1, 52, 3, 52, 1122,
+		8, 52, 5, 52, 1124, 8, 52, 10, 52, 12, 52, 1127, 9, 52, 1, 53, 1, 53, 1,
+		53, 1, 53, 3, 53, 1133, 8, 53, 1, 53, 1, 53, 1, 53, 1, 53, 1, 53, 5, 53,
+		1140, 8, 53, 10, 53, 12, 53, 1143, 9, 53, 3, 53, 1145, 8, 53, 1, 53, 1,
+		53, 1, 54, 1, 54, 1, 54, 1, 54, 1, 54, 1, 55, 1, 55, 1, 55, 5, 55, 1157,
+		8, 55, 10, 55, 12, 55, 1160, 9, 55, 1, 56, 1, 56, 1, 56, 3, 56, 1165, 8,
+		56, 1, 56, 1, 56, 3, 56, 1169, 8, 56, 1, 56, 1, 56, 1, 57, 1, 57, 1, 57,
+		1, 57, 1, 57, 3, 57, 1178, 8, 57, 1, 57, 1, 57, 1, 57, 1, 57, 3, 57, 1184,
+		8, 57, 1, 57, 1, 57, 3, 57, 1188, 8, 57, 1, 57, 1, 57, 3, 57, 1192, 8,
+		57, 1, 57, 3, 57, 1195, 8, 57, 1, 57, 1, 57, 3, 57, 1199, 8, 57, 1, 57,
+		1, 57, 3, 57, 1203, 8, 57, 1, 57, 1, 57, 3, 57, 1207, 8, 57, 1, 57, 1,
 		57, 1, 57, 1, 57, 1, 57, 1, 57, 1, 57, 1, 57, 1, 57, 1, 57, 1, 57, 1, 57,
 		1, 57, 1, 57, 1, 57, 1, 57, 1, 57, 1, 57, 1, 57, 1, 57, 1, 57, 1, 57, 1,
-		57, 3, 57, 1221, 8, 57, 1, 57, 1, 57, 1, 57, 1, 57, 3, 57, 1227, 8, 57,
-		1, 57, 1, 57, 3, 57, 1231, 8, 57, 3, 57, 1233, 8, 57, 1, 57, 1, 57, 3,
-		57, 1237, 8, 57, 1, 57, 1, 57, 1, 57, 3, 57, 1242, 8, 57, 1, 57, 1, 57,
-		1, 57, 1, 57, 1, 57, 1, 57, 3, 57, 1250, 8, 57, 5, 57, 1252, 8, 57, 10,
-		57, 12, 57, 1255, 9, 57, 1, 58, 1, 58, 1, 58, 5, 58, 1260, 8, 58, 10, 58,
-		12, 58, 1263, 9, 58, 1, 59, 1, 59, 1, 59, 1, 59, 1, 59, 1, 59, 1, 59, 5,
-		59, 1272, 8, 59, 10, 59, 12, 59, 1275, 9, 59, 1, 59, 1, 59, 3, 59, 1279,
-		8, 59, 1, 59, 1, 59, 1, 59, 1, 59, 1, 59, 3, 59, 1286, 8, 59, 1, 59, 1,
-		59, 1, 59, 1, 59, 1, 59, 1, 59, 1, 59, 1, 59, 1, 59, 1, 59, 3, 59, 1298,
-		8, 59, 1, 59, 3, 59, 1301, 8, 59, 1, 59, 1, 59, 5, 59, 1305, 8, 59, 10,
-		59, 12, 59, 1308, 9, 59, 1, 59, 1, 59, 3, 59, 1312, 8, 59, 1, 59, 1, 59,
-		1, 59, 1, 59, 1, 59, 3, 59, 1319, 8, 59, 1, 59, 5, 59, 1322, 8, 59, 10,
-		59, 12, 59, 1325, 9, 59, 1, 59, 1, 59, 1, 59, 5, 59, 1330, 8, 59, 10, 59,
-		12, 59, 1333, 9, 59, 1, 59, 3, 59, 1336, 8, 59, 1, 59, 3, 59, 1339, 8,
-		59, 1, 59, 1, 59, 1, 59, 1, 59, 1, 59, 1, 59, 1, 59, 1, 59, 3, 59, 1349,
-		8, 59, 1, 59, 1, 59, 1, 59, 1, 59, 1, 59, 1, 59, 3, 59, 1357, 8, 59, 1,
-		60, 1, 60, 1, 61, 1, 61, 1, 61, 3, 61, 1364, 8, 61, 1, 61, 1, 61, 1, 61,
-		3, 61, 1369, 8, 61, 1, 61, 1, 61, 1, 62, 1, 62, 1, 62, 5, 62, 1376, 8,
-		62, 10, 62, 12, 62, 1379, 9, 62, 1, 62, 1, 62, 1, 63, 1, 63, 1, 63, 1,
-		63, 1, 63, 0, 2, 104, 114, 64, 0, 2, 4, 6, 8, 10, 12, 14, 16, 18, 20, 22,
-		24, 26, 28, 30, 32, 34, 36, 38, 40, 42, 44, 46, 48, 50, 52, 54, 56, 58,
-		60, 62, 64, 66, 68, 70, 72, 74, 76, 78, 80, 82, 84, 86, 88, 90, 92, 94,
-		96, 98, 100, 102, 104, 106, 108, 110, 112, 114, 116, 118, 120, 122, 124,
-		126, 0, 17, 1, 0, 20, 21, 1, 0, 138, 139, 13, 0, 34, 35, 37, 39, 41, 43,
-		46, 49, 52, 52, 54, 54, 56, 56, 63, 63, 87, 87, 112, 118, 125, 129, 131,
-		136, 148, 148, 1, 0, 149, 150, 1, 0, 58, 59, 1, 0, 53, 54, 6, 0, 34, 34,
-		38, 39, 42, 42, 58, 59, 98, 99, 135, 136, 1, 0, 79, 80, 1, 0, 106, 107,
-		2, 0, 75, 77, 101, 101, 3, 0, 14, 14, 19, 19, 22, 22, 1, 0, 66, 67, 2,
-		0, 15, 16, 24, 28, 2, 0, 11, 11, 20, 21, 2, 0, 15, 15, 31, 31, 1, 0, 116,
-		117, 2, 0, 30, 30, 149, 149, 1605, 0, 128, 1, 0, 0, 0, 2, 145, 1, 0, 0,
-		0, 4, 181, 1, 0, 0, 0, 6, 188, 1, 0, 0, 0, 8, 190, 1, 0, 0, 0, 10, 192,
-		1, 0, 0, 0, 12, 200, 1, 0, 0, 0, 14, 214, 1, 0, 0, 0, 16, 217, 1, 0, 0,
-		0, 18, 219, 1, 0, 0, 0, 20, 227, 1, 0, 0, 0, 22, 235, 1, 0, 0, 0, 24, 259,
-		1, 0, 0, 0, 26, 261, 1, 0, 0, 0, 28, 273, 1, 0, 0, 0, 30, 289, 1, 0, 0,
-		0, 32, 315, 1, 0, 0, 0, 34, 323, 1, 0, 0, 0, 36, 343, 1, 0, 0, 0, 38, 370,
-		1, 0, 0, 0, 40, 397, 1, 0, 0, 0, 42, 399, 1, 0, 0, 0, 44, 409, 1, 0, 0,
-		0, 46, 474, 1, 0, 0, 0, 48, 476, 1, 0, 0, 0, 50, 495, 1, 0, 0, 0, 52, 503,
-		1, 0, 0, 0, 54, 512, 1, 0, 0, 0, 56, 520, 1, 0, 0, 0, 58, 540, 1, 0, 0,
-		0, 60, 559, 1, 0, 0, 0, 62, 566, 1, 0, 0, 0, 64, 574, 1, 0, 0, 0, 66, 576,
-		1, 0, 0, 0, 68, 620, 1, 0, 0, 0, 70, 628, 1, 0, 0, 0, 72, 657, 1, 0, 0,
-		0, 74, 663, 1, 0, 0, 0, 76, 672, 1, 0, 0, 0, 78, 680, 1, 0, 0, 0, 80, 686,
-		1, 0, 0, 0, 82, 721, 1, 0, 0, 0, 84, 723, 1, 0, 0, 0, 86, 731, 1, 0, 0,
-		0, 88, 803, 1, 0, 0, 0, 90, 806, 1, 0, 0, 0, 92, 826, 1, 0, 0, 0, 94, 828,
-		1, 0, 0, 0, 96, 859, 1, 0, 0, 0, 98, 863, 1, 0, 0, 0, 100, 898, 1, 0, 0,
-		0, 102, 927, 1, 0, 0, 0, 104, 1022, 1, 0, 0, 0, 106, 1115, 1, 0, 0, 0,
-		108, 1135, 1, 0, 0, 0, 110, 1140, 1, 0, 0, 0, 112, 1148, 1, 0, 0, 0, 114,
-		1193, 1, 0, 0, 0, 116, 1256, 1, 0, 0, 0, 118, 1356, 1, 0, 0, 0, 120, 1358,
-		1, 0, 0, 0, 122, 1363, 1, 0, 0, 0, 124, 1372, 1, 0, 0, 0, 126, 1382, 1,
-		0, 0, 0, 128, 133, 3, 2, 1, 0, 129, 130, 5, 6, 0, 0, 130, 132, 3, 2, 1,
-		0, 131, 129, 1, 0, 0, 0, 132, 135, 1, 0, 0, 0, 133, 131, 1, 0, 0, 0, 133,
-		134, 1, 0, 0, 0, 134, 137, 1, 0, 0, 0, 135, 133, 1, 0, 0, 0, 136, 138,
-		5, 6, 0, 0, 137, 136, 1, 0, 0, 0, 137, 138, 1, 0, 0, 0, 138, 139, 1, 0,
-		0, 0, 139, 140, 5, 0, 0, 1, 140, 1, 1, 0, 0, 0, 141, 142, 5, 1, 0, 0, 142,
-		143, 3, 6, 3, 0, 143, 144, 5, 2, 0, 0, 144, 146, 1, 0, 0, 0, 145, 141,
-		1, 0, 0, 0, 145, 146, 1, 0, 0, 0, 146, 165, 1, 0, 0, 0, 147, 166, 3, 32,
-		16, 0, 148, 166, 3, 36, 18, 0, 149, 166, 3, 44, 22, 0, 150, 166, 3, 42,
-		21, 0, 151, 166, 3, 48, 24, 0, 152, 166, 3, 50, 25, 0, 153, 166, 3, 52,
-		26, 0, 154, 166, 3, 54, 27, 0, 155, 166, 3, 56, 28, 0, 156, 166, 3, 58,
-		29, 0, 157, 166, 3, 60, 30, 0, 158, 166, 3, 66, 33, 0, 159, 166, 3, 68,
-		34, 0, 160, 166, 3, 70, 35, 0, 161, 166, 3, 72, 36, 0, 162, 166, 3, 74,
-		37, 0, 163, 166, 3, 76, 38, 0, 164, 166, 3, 78, 39, 0, 165, 147, 1, 0,
-		0, 0, 165, 148, 1, 0, 0, 0, 165, 149, 1, 0, 0, 0, 165, 150, 1, 0, 0, 0,
-		165, 151, 1, 0, 0, 0, 165, 152, 1, 0, 0, 0, 165, 153, 1, 0, 0, 0, 165,
-		154, 1, 0, 0, 0, 165, 155, 1, 0, 0, 0, 165, 156, 1, 0, 0, 0, 165, 157,
-		1, 0, 0, 0, 165, 158, 1, 0, 0, 0, 165, 159, 1, 0, 0, 0, 165, 160, 1, 0,
-		0, 0, 165, 161, 1, 0, 0, 0, 165, 162, 1, 0, 0, 0, 165, 163, 1, 0, 0, 0,
-		165, 164, 1, 0, 0, 0, 166, 3, 1, 0, 0, 0, 167, 182, 5, 137, 0, 0, 168,
-		170, 7, 0, 0, 0, 169, 168, 1, 0, 0, 0, 169, 170, 1, 0, 0, 0, 170, 171,
-		1, 0, 0, 0, 171, 182, 5, 140, 0, 0, 172, 174, 7, 0, 0, 0, 173, 172, 1,
-		0, 0, 0, 173, 174, 1, 0, 0, 0, 174, 175, 1, 0, 0, 0, 175, 176, 5, 140,
-		0, 0, 176, 177, 5, 12, 0, 0, 177, 182, 5, 140, 0, 0, 178, 182, 7, 1, 0,
-		0, 179, 182, 5, 57, 0, 0, 180, 182, 5, 141, 0, 0, 181, 167, 1, 0, 0, 0,
-		181, 169, 1, 0, 0, 0, 181, 173, 1, 0, 0, 0, 181, 178, 1, 0, 0, 0, 181,
-		179, 1, 0, 0, 0, 181, 180, 1, 0, 0, 0, 182, 5, 1, 0, 0, 0, 183, 184, 5,
-		33, 0, 0, 184, 185, 3, 8, 4, 0, 185, 186, 5, 33, 0, 0, 186, 189, 1, 0,
-		0, 0, 187, 189, 3, 8, 4, 0, 188, 183, 1, 0, 0, 0, 188, 187, 1, 0, 0, 0,
-		189, 7, 1, 0, 0, 0, 190, 191, 7, 2, 0, 0, 191, 9, 1, 0, 0, 0, 192, 197,
-		3, 6, 3, 0, 193, 194, 5, 9, 0, 0, 194, 196, 3, 6, 3, 0, 195, 193, 1, 0,
-		0, 0, 196, 199, 1, 0, 0, 0, 197, 195, 1, 0, 0, 0, 197, 198, 1, 0, 0, 0,
-		198, 11, 1, 0, 0, 0, 199, 197, 1, 0, 0, 0, 200, 208, 3, 6, 3, 0, 201, 202,
-		5, 7, 0, 0, 202, 205, 5, 140, 0, 0, 203, 204, 5, 9, 0, 0, 204, 206, 5,
-		140, 0, 0, 205, 203, 1, 0, 0, 0, 205, 206, 1, 0, 0, 0, 206, 207, 1, 0,
-		0, 0, 207, 209, 5, 8, 0, 0, 208, 201, 1, 0, 0, 0, 208, 209, 1, 0, 0, 0,
-		209, 212, 1, 0, 0, 0, 210, 211, 5, 3, 0, 0, 211, 213, 5, 4, 0, 0, 212,
-		210, 1, 0, 0, 0, 212, 213, 1, 0, 0, 0, 213, 13, 1, 0, 0, 0, 214, 215, 5,
-		29, 0, 0, 215, 216, 3, 12, 6, 0, 216, 15, 1, 0, 0, 0, 217, 218, 7, 3, 0,
-		0, 218, 17, 1, 0, 0, 0, 219, 220, 3, 6, 3, 0, 220, 224, 3, 12, 6, 0, 221,
-		223, 3, 24, 12, 0, 222, 221, 1, 0, 0, 0, 223, 226, 1, 0, 0, 0, 224, 222,
-		1, 0, 0, 0, 224, 225, 1, 0, 0, 0, 225, 19, 1, 0, 0, 0, 226, 224, 1, 0,
-		0, 0, 227, 232, 3, 12, 6, 0, 228, 229, 5, 9, 0, 0, 229, 231, 3, 12, 6,
-		0, 230, 228, 1, 0, 0, 0, 231, 234, 1, 0, 0, 0, 232, 230, 1, 0, 0, 0, 232,
-		233, 1, 0, 0, 0, 233, 21, 1, 0, 0, 0, 234, 232, 1, 0, 0, 0, 235, 236, 3,
-		6, 3, 0, 236, 243, 3, 12, 6, 0, 237, 238, 5, 9, 0, 0, 238, 239, 3, 6, 3,
-		0, 239, 240, 3, 12, 6, 0, 240, 242, 1, 0, 0, 0, 241, 237, 1, 0, 0, 0, 242,
-		245, 1, 0, 0, 0, 243, 241, 1, 0, 0, 0, 243, 244, 1, 0, 0, 0, 244, 23, 1,
-		0, 0, 0, 245, 243, 1, 0, 0, 0, 246, 247, 5, 48, 0, 0, 247, 260, 5, 49,
-		0, 0, 248, 260, 5, 52, 0, 0, 249, 250, 5, 62, 0, 0, 250, 260, 5, 57, 0,
-		0, 251, 252, 5, 56, 0, 0, 252, 260, 3, 114, 57, 0, 253, 260, 3, 28, 14,
-		0, 254, 255, 5, 46, 0, 0, 255, 256, 5, 7, 0, 0, 256, 257, 3, 104, 52, 0,
-		257, 258, 5, 8, 0, 0, 258, 260, 1, 0, 0, 0, 259, 246, 1, 0, 0, 0, 259,
-		248, 1, 0, 0, 0, 259, 249, 1, 0, 0, 0, 259, 251, 1, 0, 0, 0, 259, 253,
-		1, 0, 0, 0, 259, 254, 1, 0, 0, 0, 260, 25, 1, 0, 0, 0, 261, 262, 5, 50,
-		0, 0, 262, 271, 7, 4, 0, 0, 263, 264, 5, 55, 0, 0, 264, 272, 5, 57, 0,
-		0, 265, 266, 5, 55, 0, 0, 266, 272, 5, 56, 0, 0, 267, 272, 5, 54, 0, 0,
-		268, 269, 5, 88, 0, 0, 269, 272, 5, 37, 0, 0, 270, 272, 5, 53, 0, 0, 271,
-		263, 1, 0, 0, 0, 271, 265, 1, 0, 0, 0, 271, 267, 1, 0, 0, 0, 271, 268,
-		1, 0, 0, 0, 271, 270, 1, 0, 0, 0, 272, 27, 1, 0, 0, 0, 273, 277, 5, 60,
-		0, 0, 274, 275, 3, 6, 3, 0, 275, 276, 5, 12, 0, 0, 276, 278, 1, 0, 0, 0,
-		277, 274, 1, 0, 0, 0, 277, 278, 1, 0, 0, 0, 278, 279, 1, 0, 0, 0, 279,
-		280, 3, 6, 3, 0, 280, 281, 5, 7, 0, 0, 281, 282, 3, 10, 5, 0, 282, 287,
-		5, 8, 0, 0, 283, 285, 3, 26, 13, 0, 284, 286, 3, 26, 13, 0, 285, 284, 1,
-		0, 0, 0, 285, 286, 1, 0, 0, 0, 286, 288, 1, 0, 0, 0, 287, 283, 1, 0, 0,
-		0, 287, 288, 1, 0, 0, 0, 288, 29, 1, 0, 0, 0, 289, 301, 5, 87, 0, 0, 290,
-		292, 5, 36, 0, 0, 291, 290, 1, 0, 0, 0, 291, 292, 1, 0, 0, 0, 292, 293,
-		1, 0, 0, 0, 293, 294, 5, 7, 0, 0, 294, 295, 3, 22, 11, 0, 295, 296, 5,
-		8, 0, 0, 296, 302, 1, 0, 0, 0, 297, 298, 5, 7, 0, 0, 298, 299, 3, 20, 10,
-		0, 299, 300, 5, 8, 0, 0, 300, 302, 1, 0, 0, 0, 301, 291, 1, 0, 0, 0, 301,
-		297, 1, 0, 0, 0, 302, 31, 1, 0, 0, 0, 303, 305, 5, 89, 0, 0, 304, 306,
-		5, 124, 0, 0, 305, 304, 1, 0, 0, 0, 305, 306, 1, 0, 0, 0, 306, 307, 1,
-		0, 0, 0, 307, 312, 3, 34, 17, 0, 308, 309, 5, 9, 0, 0, 309, 311, 3, 34,
-		17, 0, 310, 308, 1, 0, 0, 0, 311, 314, 1, 0, 0, 0, 312, 310, 1, 0, 0, 0,
-		312, 313, 1, 0, 0, 0, 313, 316, 1, 0, 0, 0, 314, 312, 1, 0, 0, 0, 315,
-		303, 1, 0, 0, 0, 315, 316, 1, 0, 0, 0, 316, 321, 1, 0, 0, 0, 317, 322,
-		3, 80, 40, 0, 318, 322, 3, 94, 47, 0, 319, 322, 3, 98, 49, 0, 320, 322,
-		3, 102, 51, 0, 321, 317, 1, 0, 0, 0, 321, 318, 1, 0, 0, 0, 321, 319, 1,
-		0, 0, 0, 321, 320, 1, 0, 0, 0, 322, 33, 1, 0, 0, 0, 323, 336, 3, 6, 3,
-		0, 324, 333, 5, 7, 0, 0, 325, 330, 3, 6, 3, 0, 326, 327, 5, 9, 0, 0, 327,
-		329, 3, 6, 3, 0, 328, 326, 1, 0, 0, 0, 329, 332, 1, 0, 0, 0, 330, 328,
-		1, 0, 0, 0, 330, 331, 1, 0, 0, 0, 331, 334, 1, 0, 0, 0, 332, 330, 1, 0,
-		0, 0, 333, 325, 1, 0, 0, 0, 333, 334, 1, 0, 0, 0, 334, 335, 1, 0, 0, 0,
-		335, 337, 5, 8, 0, 0, 336, 324, 1, 0, 0, 0, 336, 337, 1, 0, 0, 0, 337,
-		338, 1, 0, 0, 0, 338, 339, 5, 78, 0, 0, 339, 340, 5, 7, 0, 0, 340, 341,
-		3, 80, 40, 0, 341, 342, 5, 8, 0, 0, 342, 35, 1, 0, 0, 0, 343, 344, 5, 38,
-		0, 0, 344, 348, 5, 36, 0, 0, 345, 346, 5, 113, 0, 0, 346, 347, 5, 62, 0,
-		0, 347, 349, 5, 71, 0, 0, 348, 345, 1, 0, 0, 0, 348, 349, 1, 0, 0, 0, 349,
-		350, 1, 0, 0, 0, 350, 351, 3, 6, 3, 0, 351, 354, 5, 7, 0, 0, 352, 355,
-		3, 18, 9, 0, 353, 355, 3, 38, 19, 0, 354, 352, 1, 0, 0, 0, 354, 353, 1,
-		0, 0, 0, 355, 363, 1, 0, 0, 0, 356, 359, 5, 9, 0, 0, 357, 360, 3, 18, 9,
-		0, 358, 360, 3, 38, 19, 0, 359, 357, 1, 0, 0, 0, 359, 358, 1, 0, 0, 0,
-		360, 362, 1, 0, 0, 0, 361, 356, 1, 0, 0, 0, 362, 365, 1, 0, 0, 0, 363,
-		361, 1, 0, 0, 0, 363, 364, 1, 0, 0, 0, 364, 366, 1, 0, 0, 0, 365, 363,
-		1, 0, 0, 0, 366, 367, 5, 8, 0, 0, 367, 37, 1, 0, 0, 0, 368, 369, 5, 45,
-		0, 0, 369, 371, 3, 6, 3, 0, 370, 368, 1, 0, 0, 0, 370, 371, 1, 0, 0, 0,
-		371, 395, 1, 0, 0, 0, 372, 373, 5, 52, 0, 0, 373, 374, 5, 7, 0, 0, 374,
-		375, 3, 10, 5, 0, 375, 376, 5, 8, 0, 0, 376, 396, 1, 0, 0, 0, 377, 378,
-		5, 46, 0, 0, 378, 379, 5, 7, 0, 0, 379, 380, 3, 104, 52, 0, 380, 381, 5,
-		8, 0, 0, 381, 396, 1, 0, 0, 0, 382, 383, 5, 47, 0, 0, 383, 384, 5, 49,
-		0, 0, 384, 385, 5, 7, 0, 0, 385, 386, 3, 10, 5, 0, 386, 387, 5, 8, 0, 0,
-		387, 388, 3, 28, 14, 0, 388, 396, 1, 0, 0, 0, 389, 390, 5, 48, 0, 0, 390,
-		391, 5, 49, 0, 0, 391, 392, 5, 7, 0, 0, 392, 393, 3, 10, 5, 0, 393, 394,
-		5, 8, 0, 0, 394, 396, 1, 0, 0, 0, 395, 372, 1, 0, 0, 0, 395, 377, 1, 0,
-		0, 0, 395, 382, 1, 0, 0, 0, 395, 389, 1, 0, 0, 0, 396, 39, 1, 0, 0, 0,
-		397, 398, 7, 5, 0, 0, 398, 41, 1, 0, 0, 0, 399, 400, 5, 42, 0, 0, 400,
-		403, 5, 36, 0, 0, 401, 402, 5, 113, 0, 0, 402, 404, 5, 71, 0, 0, 403, 401,
-		1, 0, 0, 0, 403, 404, 1, 0, 0, 0, 404, 405, 1, 0, 0, 0, 405, 407, 3, 10,
-		5, 0, 406, 408, 3, 40, 20, 0, 407, 406, 1, 0, 0, 0, 407, 408, 1, 0, 0,
-		0, 408, 43, 1, 0, 0, 0, 409, 410, 5, 39, 0, 0, 410, 411, 5, 36, 0, 0, 411,
-		412, 3, 6, 3, 0, 412, 417, 3, 46, 23, 0, 413, 414, 5, 9, 0, 0, 414, 416,
-		3, 46, 23, 0, 415, 413, 1, 0, 0, 0, 416, 419, 1, 0, 0, 0, 417, 415, 1,
-		0, 0, 0, 417, 418, 1, 0, 0, 0, 418, 45, 1, 0, 0, 0, 419, 417, 1, 0, 0,
-		0, 420, 421, 5, 39, 0, 0, 421, 422, 5, 40, 0, 0, 422, 423, 3, 6, 3, 0,
-		423, 428, 5, 55, 0, 0, 424, 425, 5, 62, 0, 0, 425, 429, 5, 57, 0, 0, 426,
-		427, 5, 56, 0, 0, 427, 429, 3, 114, 57, 0, 428, 424, 1, 0, 0, 0, 428, 426,
-		1, 0, 0, 0, 429, 475, 1, 0, 0, 0, 430, 431, 5, 39, 0, 0, 431, 432, 5, 40,
-		0, 0, 432, 433, 3, 6, 3, 0, 433, 437, 5, 42, 0, 0, 434, 435, 5, 62, 0,
-		0, 435, 438, 5, 57, 0, 0, 436, 438, 5, 56, 0, 0, 437, 434, 1, 0, 0, 0,
-		437, 436, 1, 0, 0, 0, 438, 475, 1, 0, 0, 0, 439, 440, 5, 41, 0, 0, 440,
-		444, 5, 40, 0, 0, 441, 442, 5, 113, 0, 0, 442, 443, 5, 62, 0, 0, 443, 445,
-		5, 71, 0, 0, 444, 441, 1, 0, 0, 0, 444, 445, 1, 0, 0, 0, 445, 446, 1, 0,
-		0, 0, 446, 447, 3, 6, 3, 0, 447, 448, 3, 12, 6, 0, 448, 475, 1, 0, 0, 0,
-		449, 450, 5, 42, 0, 0, 450, 453, 5, 40, 0, 0, 451, 452, 5, 113, 0, 0, 452,
-		454, 5, 71, 0, 0, 453, 451, 1, 0, 0, 0, 453, 454, 1, 0, 0, 0, 454, 455,
-		1, 0, 0, 0, 455, 475, 3, 6, 3, 0, 456, 457, 5, 43, 0, 0, 457, 458, 5, 40,
-		0, 0, 458, 459, 3, 6, 3, 0, 459, 460, 5, 44, 0, 0, 460, 461, 3, 6, 3, 0,
-		461, 475, 1, 0, 0, 0, 462, 463, 5, 43, 0, 0, 463, 464, 5, 44, 0, 0, 464,
-		475, 3, 6, 3, 0, 465, 466, 5, 41, 0, 0, 466, 475, 3, 38, 19, 0, 467, 468,
-		5, 42, 0, 0, 468, 471, 5, 45, 0, 0, 469, 470, 5, 113, 0, 0, 470, 472, 5,
-		71, 0, 0, 471, 469, 1, 0, 0, 0, 471, 472, 1, 0, 0, 0, 472, 473, 1, 0, 0,
-		0, 473, 475, 3, 6, 3, 0, 474, 420, 1, 0, 0, 0, 474, 430, 1, 0, 0, 0, 474,
-		439, 1, 0, 0, 0, 474, 449, 1, 0, 0, 0, 474, 456, 1, 0, 0, 0, 474, 462,
-		1, 0, 0, 0, 474, 465, 1, 0, 0, 0, 474, 467, 1, 0, 0, 0, 475, 47, 1, 0,
-		0, 0, 476, 478, 5, 38, 0, 0, 477, 479, 5, 52, 0, 0, 478, 477, 1, 0, 0,
-		0, 478, 479, 1, 0, 0, 0, 479, 480, 1, 0, 0, 0, 480, 484, 5, 63, 0, 0, 481,
-		482, 5, 113, 0, 0, 482, 483, 5, 62, 0, 0, 483, 485, 5, 71, 0, 0, 484, 481,
-		1, 0, 0, 0, 484, 485, 1, 0, 0, 0, 485, 487, 1, 0, 0, 0, 486, 488, 3, 6,
-		3, 0, 487, 486, 1, 0, 0, 0, 487, 488, 1, 0, 0, 0, 488, 489, 1, 0, 0, 0,
-		489, 490, 5, 50, 0, 0, 490, 491, 3, 6, 3, 0, 491, 492, 5, 7, 0, 0, 492,
-		493, 3, 10, 5, 0, 493, 494, 5, 8, 0, 0, 494, 49, 1, 0, 0, 0, 495, 496,
-		5, 42, 0, 0, 496, 499, 5, 63, 0, 0, 497, 498, 5, 113, 0, 0, 498, 500, 5,
-		71, 0, 0, 499, 497, 1, 0, 0, 0, 499, 500, 1, 0, 0, 0, 500, 501, 1, 0, 0,
-		0, 501, 502, 3, 6, 3, 0, 502, 51, 1, 0, 0, 0, 503, 504, 5, 38, 0, 0, 504,
-		508, 5, 128, 0, 0, 505, 506, 5, 113, 0, 0, 506, 507, 5, 62, 0, 0, 507,
-		509, 5, 71, 0, 0, 508, 505, 1, 0, 0, 0, 508, 509, 1, 0, 0, 0, 509, 510,
-		1, 0, 0, 0, 510, 511, 3, 6, 3, 0, 511, 53, 1, 0, 0, 0, 512, 513, 5, 42,
-		0, 0, 513, 516, 5, 128, 0, 0, 514, 515, 5, 113, 0, 0, 515, 517, 5, 71,
-		0, 0, 516, 514, 1, 0, 0, 0, 516, 517, 1, 0, 0, 0, 517, 518, 1, 0, 0, 0,
-		518, 519, 3, 6, 3, 0, 519, 55, 1, 0, 0, 0, 520, 524, 5, 125, 0, 0, 521,
-		522, 5, 113, 0, 0, 522, 523, 5, 62, 0, 0, 523, 525, 5, 126, 0, 0, 524,
-		521, 1, 0, 0, 0, 524, 525, 1, 0, 0, 0, 525, 528, 1, 0, 0, 0, 526, 529,
-		3, 62, 31, 0, 527, 529, 3, 6, 3, 0, 528, 526, 1, 0, 0, 0, 528, 527, 1,
-		0, 0, 0, 529, 532, 1, 0, 0, 0, 530, 531, 5, 50, 0, 0, 531, 533, 3, 6, 3,
-		0, 532, 530, 1, 0, 0, 0, 532, 533, 1, 0, 0, 0, 533, 534, 1, 0, 0, 0, 534,
-		538, 5, 44, 0, 0, 535, 539, 3, 6, 3, 0, 536, 539, 5, 137, 0, 0, 537, 539,
-		3, 114, 57, 0, 538, 535, 1, 0, 0, 0, 538, 536, 1, 0, 0, 0, 538, 537, 1,
-		0, 0, 0, 539, 57, 1, 0, 0, 0, 540, 543, 5, 127, 0, 0, 541, 542, 5, 113,
-		0, 0, 542, 544, 5, 126, 0, 0, 543, 541, 1, 0, 0, 0, 543, 544, 1, 0, 0,
-		0, 544, 547, 1, 0, 0, 0, 545, 548, 3, 62, 31, 0, 546, 548, 3, 6, 3, 0,
-		547, 545, 1, 0, 0, 0, 547, 546, 1, 0, 0, 0, 548, 551, 1, 0, 0, 0, 549,
-		550, 5, 50, 0, 0, 550, 552, 3, 6, 3, 0, 551, 549, 1, 0, 0, 0, 551, 552,
-		1, 0, 0, 0, 552, 553, 1, 0, 0, 0, 553, 557, 5, 95, 0, 0, 554, 558, 3, 6,
-		3, 0, 555, 558, 5, 137, 0, 0, 556, 558, 3, 114, 57, 0, 557, 554, 1, 0,
-		0, 0, 557, 555, 1, 0, 0, 0, 557, 556, 1, 0, 0, 0, 558, 59, 1, 0, 0, 0,
-		559, 560, 5, 133, 0, 0, 560, 561, 5, 134, 0, 0, 561, 564, 5, 44, 0, 0,
-		562, 565, 5, 137, 0, 0, 563, 565, 3, 114, 57, 0, 564, 562, 1, 0, 0, 0,
-		564, 563, 1, 0, 0, 0, 565, 61, 1, 0, 0, 0, 566, 571, 3, 64, 32, 0, 567,
-		568, 5, 9, 0, 0, 568, 570, 3, 64, 32, 0, 569, 567, 1, 0, 0, 0, 570, 573,
-		1, 0, 0, 0, 571, 569, 1, 0, 0, 0, 571, 572, 1, 0, 0, 0, 572, 63, 1, 0,
-		0, 0, 573, 571, 1, 0, 0, 0, 574, 575, 7, 6, 0, 0, 575, 65, 1, 0, 0, 0,
-		576, 579, 5, 38, 0, 0, 577, 578, 5, 65, 0, 0, 578, 580, 5, 129, 0, 0, 579,
-		577, 1, 0, 0, 0, 579, 580, 1, 0, 0, 0, 580, 581, 1, 0, 0, 0, 581, 585,
-		5, 37, 0, 0, 582, 583, 5, 113, 0, 0, 583, 584, 5, 62, 0, 0, 584, 586, 5,
-		71, 0, 0, 585, 582, 1, 0, 0, 0, 585, 586, 1, 0, 0, 0, 586, 587, 1, 0, 0,
-		0, 587, 588, 3, 6, 3, 0, 588, 599, 5, 7, 0, 0, 589, 590, 5, 149, 0, 0,
-		590, 596, 3, 12, 6, 0, 591, 592, 5, 9, 0, 0, 592, 593, 5, 149, 0, 0, 593,
-		595, 3, 12, 6, 0, 594, 591, 1, 0, 0, 0, 595, 598, 1, 0, 0, 0, 596, 594,
-		1, 0, 0, 0, 596, 597, 1, 0, 0, 0, 597, 600, 1, 0, 0, 0, 598, 596, 1, 0,
-		0, 0, 599, 589, 1, 0, 0, 0, 599, 600, 1, 0, 0, 0, 600, 601, 1, 0, 0, 0,
-		601, 605, 5, 8, 0, 0, 602, 604, 3, 6, 3, 0, 603, 602, 1, 0, 0, 0, 604,
-		607, 1, 0, 0, 0, 605, 603, 1, 0, 0, 0, 605, 606, 1, 0, 0, 0, 606, 609,
-		1, 0, 0, 0, 607, 605, 1, 0, 0, 0, 608, 610, 3, 30, 15, 0, 609, 608, 1,
-		0, 0, 0, 609, 610, 1, 0, 0, 0, 610, 611, 1, 0, 0, 0, 611, 615, 5, 1, 0,
-		0, 612, 614, 3, 118, 59, 0, 613, 612, 1, 0, 0, 0, 614, 617, 1, 0, 0, 0,
-		615, 613, 1, 0, 0, 0, 615, 616, 1, 0, 0, 0, 616, 618, 1, 0, 0, 0, 617,
-		615, 1, 0, 0, 0, 618, 619, 5, 2, 0, 0, 619, 67, 1, 0, 0, 0, 620, 621, 5,
-		42, 0, 0, 621, 624, 5, 37, 0, 0, 622, 623, 5, 113, 0, 0, 623, 625, 5, 71,
-		0, 0, 624, 622, 1, 0, 0, 0, 624, 625, 1, 0, 0, 0, 625, 626, 1, 0, 0, 0,
-		626, 627, 3, 6, 3, 0, 627, 69, 1, 0, 0, 0, 628, 632, 5, 34, 0, 0, 629,
-		630, 5, 113, 0, 0, 630, 631, 5, 62, 0, 0, 631, 633, 5, 71, 0, 0, 632, 629,
-		1, 0, 0, 0, 632, 633, 1, 0, 0, 0, 633, 634, 1, 0, 0, 0, 634, 652, 3, 6,
-		3, 0, 635, 649, 5, 1, 0, 0, 636, 637, 3, 6, 3, 0, 637, 638, 5, 5, 0, 0,
-		638, 646, 3, 114, 57, 0, 639, 640, 5, 9, 0, 0, 640, 641, 3, 6, 3, 0, 641,
-		642, 5, 5, 0, 0, 642, 643, 3, 114, 57, 0, 643, 645, 1, 0, 0, 0, 644, 639,
-		1, 0, 0, 0, 645, 648, 1, 0, 0, 0, 646, 644, 1, 0, 0, 0, 646, 647, 1, 0,
-		0, 0, 647, 650, 1, 0, 0, 0, 648, 646, 1, 0, 0, 0, 649, 636, 1, 0, 0, 0,
-		649, 650, 1, 0, 0, 0, 650, 651, 1, 0, 0, 0, 651, 653, 5, 2, 0, 0, 652,
-		635, 1, 0, 0, 0, 652, 653, 1, 0, 0, 0, 653, 654, 1, 0, 0, 0, 654, 655,
-		5, 78, 0, 0, 655, 656, 3, 6, 3, 0, 656, 71, 1, 0, 0, 0, 657, 658, 5, 35,
-		0, 0, 658, 661, 3, 6, 3, 0, 659, 660, 5, 113, 0, 0, 660, 662, 5, 71, 0,
-		0, 661, 659, 1, 0, 0, 0, 661, 662, 1, 0, 0, 0, 662, 73, 1, 0, 0, 0, 663,
-		664, 5, 38, 0, 0, 664, 668, 5, 132, 0, 0, 665, 666, 5, 113, 0, 0, 666,
-		667, 5, 62, 0, 0, 667, 669, 5, 71, 0, 0, 668, 665, 1, 0, 0, 0, 668, 669,
-		1, 0, 0, 0, 669, 670, 1, 0, 0, 0, 670, 671, 3, 6, 3, 0, 671, 75, 1, 0,
-		0, 0, 672, 673, 5, 42, 0, 0, 673, 676, 5, 132, 0, 0, 674, 675, 5, 113,
-		0, 0, 675, 677, 5, 71, 0, 0, 676, 674, 1, 0, 0, 0, 676, 677, 1, 0, 0, 0,
-		677, 678, 1, 0, 0, 0, 678, 679, 3, 6, 3, 0, 679, 77, 1, 0, 0, 0, 680, 681,
-		5, 55, 0, 0, 681, 682, 5, 131, 0, 0, 682, 683, 5, 132, 0, 0, 683, 684,
-		5, 44, 0, 0, 684, 685, 3, 6, 3, 0, 685, 79, 1, 0, 0, 0, 686, 692, 3, 86,
-		43, 0, 687, 688, 3, 82, 41, 0, 688, 689, 3, 86, 43, 0, 689, 691, 1, 0,
-		0, 0, 690, 687, 1, 0, 0, 0, 691, 694, 1, 0, 0, 0, 692, 690, 1, 0, 0, 0,
-		692, 693, 1, 0, 0, 0, 693, 705, 1, 0, 0, 0, 694, 692, 1, 0, 0, 0, 695,
-		696, 5, 83, 0, 0, 696, 697, 5, 84, 0, 0, 697, 702, 3, 84, 42, 0, 698, 699,
-		5, 9, 0, 0, 699, 701, 3, 84, 42, 0, 700, 698, 1, 0, 0, 0, 701, 704, 1,
-		0, 0, 0, 702, 700, 1, 0, 0, 0, 702, 703, 1, 0, 0, 0, 703, 706, 1, 0, 0,
-		0, 704, 702, 1, 0, 0, 0, 705, 695, 1, 0, 0, 0, 705, 706, 1, 0, 0, 0, 706,
-		709, 1, 0, 0, 0, 707, 708, 5, 81, 0, 0, 708, 710, 3, 104, 52, 0, 709, 707,
-		1, 0, 0, 0, 709, 710, 1, 0, 0, 0, 710, 713, 1, 0, 0, 0, 711, 712, 5, 82,
-		0, 0, 712, 714, 3, 104, 52, 0, 713, 711, 1, 0, 0, 0, 713, 714, 1, 0, 0,
-		0, 714, 81, 1, 0, 0, 0, 715, 717, 5, 102, 0, 0, 716, 718, 5, 72, 0, 0,
-		717, 716, 1, 0, 0, 0, 717, 718, 1, 0, 0, 0, 718, 722, 1, 0, 0, 0, 719,
-		722, 5, 103, 0, 0, 720, 722, 5, 104, 0, 0, 721, 715, 1, 0, 0, 0, 721, 719,
-		1, 0, 0, 0, 721, 720, 1, 0, 0, 0, 722, 83, 1, 0, 0, 0, 723, 725, 3, 104,
-		52, 0, 724, 726, 7, 7, 0, 0, 725, 724, 1, 0, 0, 0, 725, 726, 1, 0, 0, 0,
-		726, 729, 1, 0, 0, 0, 727, 728, 5, 105, 0, 0, 728, 730, 7, 8, 0, 0, 729,
-		727, 1, 0, 0, 0, 729, 730, 1, 0, 0, 0, 730, 85, 1, 0, 0, 0, 731, 733, 5,
-		98, 0, 0, 732, 734, 5, 94, 0, 0, 733, 732, 1, 0, 0, 0, 733, 734, 1, 0,
-		0, 0, 734, 735, 1, 0, 0, 0, 735, 740, 3, 92, 46, 0, 736, 737, 5, 9, 0,
-		0, 737, 739, 3, 92, 46, 0, 738, 736, 1, 0, 0, 0, 739, 742, 1, 0, 0, 0,
-		740, 738, 1, 0, 0, 0, 740, 741, 1, 0, 0, 0, 741, 751, 1, 0, 0, 0, 742,
-		740, 1, 0, 0, 0, 743, 744, 5, 95, 0, 0, 744, 748, 3, 88, 44, 0, 745, 747,
-		3, 90, 45, 0, 746, 745, 1, 0, 0, 0, 747, 750, 1, 0, 0, 0, 748, 746, 1,
-		0, 0, 0, 748, 749, 1, 0, 0, 0, 749, 752, 1, 0, 0, 0, 750, 748, 1, 0, 0,
-		0, 751, 743, 1, 0, 0, 0, 751, 752, 1, 0, 0, 0, 752, 755, 1, 0, 0, 0, 753,
-		754, 5, 96, 0, 0, 754, 756, 3, 104, 52, 0, 755, 753, 1, 0, 0, 0, 755, 756,
-		1, 0, 0, 0, 756, 764, 1, 0, 0, 0, 757, 758, 5, 85, 0, 0, 758, 759, 5, 84,
-		0, 0, 759, 762, 3, 110, 55, 0, 760, 761, 5, 86, 0, 0, 761, 763, 3, 104,
-		52, 0, 762, 760, 1, 0, 0, 0, 762, 763, 1, 0, 0, 0, 763, 765, 1, 0, 0, 0,
-		764, 757, 1, 0, 0, 0, 764, 765, 1, 0, 0, 0, 765, 780, 1, 0, 0, 0, 766,
-		767, 5, 122, 0, 0, 767, 768, 3, 6, 3, 0, 768, 769, 5, 78, 0, 0, 769, 777,
-		3, 106, 53, 0, 770, 771, 5, 9, 0, 0, 771, 772, 3, 6, 3, 0, 772, 773, 5,
-		78, 0, 0, 773, 774, 3, 106, 53, 0, 774, 776, 1, 0, 0, 0, 775, 770, 1, 0,
-		0, 0, 776, 779, 1, 0, 0, 0, 777, 775, 1, 0, 0, 0, 777, 778, 1, 0, 0, 0,
-		778, 781, 1, 0, 0, 0, 779, 777, 1, 0, 0, 0, 780, 766, 1, 0, 0, 0, 780,
-		781, 1, 0, 0, 0, 781, 87, 1, 0, 0, 0, 782, 783, 3, 6, 3, 0, 783, 784, 5,
-		12, 0, 0, 784, 786, 1, 0, 0, 0, 785, 782, 1, 0, 0, 0, 785, 786, 1, 0, 0,
-		0, 786, 787, 1, 0, 0, 0, 787, 792, 3, 6, 3, 0, 788, 790, 5, 78, 0, 0, 789,
-		788, 1, 0, 0, 0, 789, 790, 1, 0, 0, 0, 790, 791, 1, 0, 0, 0, 791, 793,
-		3, 6, 3, 0, 792, 789, 1, 0, 0, 0, 792, 793, 1, 0, 0, 0, 793, 804, 1, 0,
-		0, 0, 794, 795, 5, 7, 0, 0, 795, 796, 3, 80, 40, 0, 796, 801, 5, 8, 0,
-		0, 797, 799, 5, 78, 0, 0, 798, 797, 1, 0, 0, 0, 798, 799, 1, 0, 0, 0, 799,
-		800, 1, 0, 0, 0, 800, 802, 3, 6, 3, 0, 801, 798, 1, 0, 0, 0, 801, 802,
-		1, 0, 0, 0, 802, 804, 1, 0, 0, 0, 803, 785, 1, 0, 0, 0, 803, 794, 1, 0,
-		0, 0, 804, 89, 1, 0, 0, 0, 805, 807, 7, 9, 0, 0, 806, 805, 1, 0, 0, 0,
-		806, 807, 1, 0, 0, 0, 807, 808, 1, 0, 0, 0, 808, 809, 5, 74, 0, 0, 809,
-		810, 3, 88, 44, 0, 810, 811, 5, 50, 0, 0, 811, 812, 3, 104, 52, 0, 812,
-		91, 1, 0, 0, 0, 813, 818, 3, 104, 52, 0, 814, 816, 5, 78, 0, 0, 815, 814,
-		1, 0, 0, 0, 815, 816, 1, 0, 0, 0, 816, 817, 1, 0, 0, 0, 817, 819, 3, 6,
-		3, 0, 818, 815, 1, 0, 0, 0, 818, 819, 1, 0, 0, 0, 819, 827, 1, 0, 0, 0,
-		820, 821, 3, 6, 3, 0, 821, 822, 5, 12, 0, 0, 822, 824, 1, 0, 0, 0, 823,
-		820, 1, 0, 0, 0, 823, 824, 1, 0, 0, 0, 824, 825, 1, 0, 0, 0, 825, 827,
-		5, 14, 0, 0, 826, 813, 1, 0, 0, 0, 826, 823, 1, 0, 0, 0, 827, 93, 1, 0,
-		0, 0, 828, 829, 5, 59, 0, 0, 829, 834, 3, 6, 3, 0, 830, 832, 5, 78, 0,
-		0, 831, 830, 1, 0, 0, 0, 831, 832, 1, 0, 0, 0, 832, 833, 1, 0, 0, 0, 833,
-		835, 3, 6, 3, 0, 834, 831, 1, 0, 0, 0, 834, 835, 1, 0, 0, 0, 835, 836,
-		1, 0, 0, 0, 836, 837, 5, 55, 0, 0, 837, 842, 3, 96, 48, 0, 838, 839, 5,
-		9, 0, 0, 839, 841, 3, 96, 48, 0, 840, 838, 1, 0, 0, 0, 841, 844, 1, 0,
-		0, 0, 842, 840, 1, 0, 0, 0, 842, 843, 1, 0, 0, 0, 843, 853, 1, 0, 0, 0,
-		844, 842, 1, 0, 0, 0, 845, 846, 5, 95, 0, 0, 846, 850, 3, 88, 44, 0, 847,
-		849, 3, 90, 45, 0, 848, 847, 1, 0, 0, 0, 849, 852, 1, 0, 0, 0, 850, 848,
-		1, 0, 0, 0, 850, 851, 1, 0, 0, 0, 851, 854, 1, 0, 0, 0, 852, 850, 1, 0,
-		0, 0, 853, 845, 1, 0, 0, 0, 853, 854, 1, 0, 0, 0, 854, 857, 1, 0, 0, 0,
-		855, 856, 5, 96, 0, 0, 856, 858, 3, 104, 52, 0, 857, 855, 1, 0, 0, 0, 857,
-		858, 1, 0, 0, 0, 858, 95, 1, 0, 0, 0, 859, 860, 3, 6, 3, 0, 860, 861, 5,
-		15, 0, 0, 861, 862, 3, 104, 52, 0, 862, 97, 1, 0, 0, 0, 863, 864, 5, 99,
-		0, 0, 864, 865, 5, 109, 0, 0, 865, 870, 3, 6, 3, 0, 866, 868, 5, 78, 0,
-		0, 867, 866, 1, 0, 0, 0, 867, 868, 1, 0, 0, 0, 868, 869, 1, 0, 0, 0, 869,
-		871, 3, 6, 3, 0, 870, 867, 1, 0, 0, 0, 870, 871, 1, 0, 0, 0, 871, 876,
-		1, 0, 0, 0, 872, 873, 5, 7, 0, 0, 873, 874, 3, 10, 5, 0, 874, 875, 5, 8,
-		0, 0, 875, 877, 1, 0, 0, 0, 876, 872, 1, 0, 0, 0, 876, 877, 1, 0, 0, 0,
-		877, 893, 1, 0, 0, 0, 878, 879, 5, 100, 0, 0, 879, 880, 5, 7, 0, 0, 880,
-		881, 3, 110, 55, 0, 881, 889, 5, 8, 0, 0, 882, 883, 5, 9, 0, 0, 883, 884,
-		5, 7, 0, 0, 884, 885, 3, 110, 55, 0, 885, 886, 5, 8, 0, 0, 886, 888, 1,
-		0, 0, 0, 887, 882, 1, 0, 0, 0, 888, 891, 1, 0, 0, 0, 889, 887, 1, 0, 0,
-		0, 889, 890, 1, 0, 0, 0, 890, 894, 1, 0, 0, 0, 891, 889, 1, 0, 0, 0, 892,
-		894, 3, 80, 40, 0, 893, 878, 1, 0, 0, 0, 893, 892, 1, 0, 0, 0, 894, 896,
-		1, 0, 0, 0, 895, 897, 3, 100, 50, 0, 896, 895, 1, 0, 0, 0, 896, 897, 1,
-		0, 0, 0, 897, 99, 1, 0, 0, 0, 898, 899, 5, 50, 0, 0, 899, 907, 5, 110,
-		0, 0, 900, 901, 5, 7, 0, 0, 901, 902, 3, 10, 5, 0, 902, 905, 5, 8, 0, 0,
-		903, 904, 5, 96, 0, 0, 904, 906, 3, 104, 52, 0, 905, 903, 1, 0, 0, 0, 905,
-		906, 1, 0, 0, 0, 906, 908, 1, 0, 0, 0, 907, 900, 1, 0, 0, 0, 907, 908,
-		1, 0, 0, 0, 908, 909, 1, 0, 0, 0, 909, 925, 5, 51, 0, 0, 910, 926, 5, 111,
-		0, 0, 911, 912, 5, 59, 0, 0, 912, 913, 5, 55, 0, 0, 913, 918, 3, 96, 48,
-		0, 914, 915, 5, 9, 0, 0, 915, 917, 3, 96, 48, 0, 916, 914, 1, 0, 0, 0,
-		917, 920, 1, 0, 0, 0, 918, 916, 1, 0, 0, 0, 918, 919, 1, 0, 0, 0, 919,
-		923, 1, 0, 0, 0, 920, 918, 1, 0, 0, 0, 921, 922, 5, 96, 0, 0, 922, 924,
-		3, 104, 52, 0, 923, 921, 1, 0, 0, 0, 923, 924, 1, 0, 0, 0, 924, 926, 1,
-		0, 0, 0, 925, 910, 1, 0, 0, 0, 925, 911, 1, 0, 0, 0, 926, 101, 1, 0, 0,
-		0, 927, 928, 5, 58, 0, 0, 928, 929, 5, 95, 0, 0, 929, 934, 3, 6, 3, 0,
-		930, 932, 5, 78, 0, 0, 931, 930, 1, 0, 0, 0, 931, 932, 1, 0, 0, 0, 932,
-		933, 1, 0, 0, 0, 933, 935, 3, 6, 3, 0, 934, 931, 1, 0, 0, 0, 934, 935,
-		1, 0, 0, 0, 935, 938, 1, 0, 0, 0, 936, 937, 5, 96, 0, 0, 937, 939, 3, 104,
-		52, 0, 938, 936, 1, 0, 0, 0, 938, 939, 1, 0, 0, 0, 939, 103, 1, 0, 0, 0,
-		940, 941, 6, 52, -1, 0, 941, 942, 5, 7, 0, 0, 942, 943, 3, 104, 52, 0,
-		943, 945, 5, 8, 0, 0, 944, 946, 3, 14, 7, 0, 945, 944, 1, 0, 0, 0, 945,
-		946, 1, 0, 0, 0, 946, 1023, 1, 0, 0, 0, 947, 948, 7, 0, 0, 0, 948, 1023,
-		3, 104, 52, 22, 949, 951, 3, 4, 2, 0, 950, 952, 3, 14, 7, 0, 951, 950,
-		1, 0, 0, 0, 951, 952, 1, 0, 0, 0, 952, 1023, 1, 0, 0, 0, 953, 960, 3, 112,
-		56, 0, 954, 955, 5, 123, 0, 0, 955, 956, 5, 7, 0, 0, 956, 957, 5, 96, 0,
-		0, 957, 958, 3, 104, 52, 0, 958, 959, 5, 8, 0, 0, 959, 961, 1, 0, 0, 0,
-		960, 954, 1, 0, 0, 0, 960, 961, 1, 0, 0, 0, 961, 962, 1, 0, 0, 0, 962,
-		965, 5, 120, 0, 0, 963, 966, 3, 106, 53, 0, 964, 966, 3, 6, 3, 0, 965,
-		963, 1, 0, 0, 0, 965, 964, 1, 0, 0, 0, 966, 1023, 1, 0, 0, 0, 967, 969,
-		3, 112, 56, 0, 968, 970, 3, 14, 7, 0, 969, 968, 1, 0, 0, 0, 969, 970, 1,
-		0, 0, 0, 970, 1023, 1, 0, 0, 0, 971, 973, 3, 16, 8, 0, 972, 974, 3, 14,
-		7, 0, 973, 972, 1, 0, 0, 0, 973, 974, 1, 0, 0, 0, 974, 1023, 1, 0, 0, 0,
-		975, 976, 5, 130, 0, 0, 976, 978, 5, 3, 0, 0, 977, 979, 3, 110, 55, 0,
-		978, 977, 1, 0, 0, 0, 978, 979, 1, 0, 0, 0, 979, 980, 1, 0, 0, 0, 980,
-		982, 5, 4, 0, 0, 981, 983, 3, 14, 7, 0, 982, 981, 1, 0, 0, 0, 982, 983,
-		1, 0, 0, 0, 983, 1023, 1, 0, 0, 0, 984, 985, 3, 6, 3, 0, 985, 986, 5, 12,
-		0, 0, 986, 988, 1, 0, 0, 0, 987, 984, 1, 0, 0, 0, 987, 988, 1, 0, 0, 0,
-		988, 989, 1, 0, 0, 0, 989, 991, 3, 6, 3, 0, 990, 992, 3, 14, 7, 0, 991,
-		990, 1, 0, 0, 0, 991, 992, 1, 0, 0, 0, 992, 1023, 1, 0, 0, 0, 993, 995,
-		5, 90, 0, 0, 994, 996, 3, 104, 52, 0, 995, 994, 1, 0, 0, 0, 995, 996, 1,
-		0, 0, 0, 996, 998, 1, 0, 0, 0, 997, 999, 3, 108, 54, 0, 998, 997, 1, 0,
-		0, 0, 999, 1000, 1, 0, 0, 0, 1000, 998, 1, 0, 0, 0, 1000, 1001, 1, 0, 0,
-		0, 1001, 1004, 1, 0, 0, 0, 1002, 1003, 5, 115, 0, 0, 1003, 1005, 3, 104,
-		52, 0, 1004, 1002, 1, 0, 0, 0, 1004, 1005, 1, 0, 0, 0, 1005, 1006, 1, 0,
-		0, 0, 1006, 1007, 5, 93, 0, 0, 1007, 1023, 1, 0, 0, 0, 1008, 1010, 5, 62,
-		0, 0, 1009, 1008, 1, 0, 0, 0, 1009, 1010, 1, 0, 0, 0, 1010, 1011, 1, 0,
-		0, 0, 1011, 1013, 5, 71, 0, 0, 1012, 1009, 1, 0, 0, 0, 1012, 1013, 1, 0,
-		0, 0, 1013, 1014, 1, 0, 0, 0, 1014, 1015, 5, 7, 0, 0, 1015, 1016, 3, 80,
-		40, 0, 1016, 1018, 5, 8, 0, 0, 1017, 1019, 3, 14, 7, 0, 1018, 1017, 1,
-		0, 0, 0, 1018, 1019, 1, 0, 0, 0, 1019, 1023, 1, 0, 0, 0, 1020, 1021, 5,
-		62, 0, 0, 1021, 1023, 3, 104, 52, 3, 1022, 940, 1, 0, 0, 0, 1022, 947,
-		1, 0, 0, 0, 1022, 949, 1, 0, 0, 0, 1022, 953, 1, 0, 0, 0, 1022, 967, 1,
-		0, 0, 0, 1022, 971, 1, 0, 0, 0, 1022, 975, 1, 0, 0, 0, 1022, 987, 1, 0,
-		0, 0, 1022, 993, 1, 0, 0, 0, 1022, 1012, 1, 0, 0, 0, 1022, 1020, 1, 0,
-		0, 0, 1023, 1112, 1, 0, 0, 0, 1024, 1025, 10, 20, 0, 0, 1025, 1026, 5,
-		23, 0, 0, 1026, 1111, 3, 104, 52, 21, 1027, 1028, 10, 19, 0, 0, 1028, 1029,
-		7, 10, 0, 0, 1029, 1111, 3, 104, 52, 20, 1030, 1031, 10, 18, 0, 0, 1031,
-		1032, 7, 0, 0, 0, 1032, 1111, 3, 104, 52, 19, 1033, 1034, 10, 9, 0, 0,
-		1034, 1035, 5, 13, 0, 0, 1035, 1111, 3, 104, 52, 10, 1036, 1038, 10, 7,
-		0, 0, 1037, 1039, 5, 62, 0, 0, 1038, 1037, 1, 0, 0, 0, 1038, 1039, 1, 0,
-		0, 0, 1039, 1040, 1, 0, 0, 0, 1040, 1041, 7, 11, 0, 0, 1041, 1111, 3, 104,
-		52, 8, 1042, 1044, 10, 6, 0, 0, 1043, 1045, 5, 62, 0, 0, 1044, 1043, 1,
-		0, 0, 0, 1044, 1045, 1, 0, 0, 0, 1045, 1046, 1, 0, 0, 0, 1046, 1047, 5,
-		69, 0, 0, 1047, 1048, 3, 104, 52, 0, 1048, 1049, 5, 64, 0, 0, 1049, 1050,
-		3, 104, 52, 7, 1050, 1111, 1, 0, 0, 0, 1051, 1052, 10, 5, 0, 0, 1052, 1053,
-		7, 12, 0, 0, 1053, 1111, 3, 104, 52, 6, 1054, 1055, 10, 2, 0, 0, 1055,
-		1056, 5, 64, 0, 0, 1056, 1111, 3, 104, 52, 3, 1057, 1058, 10, 1, 0, 0,
-		1058, 1059, 5, 65, 0, 0, 1059, 1111, 3, 104, 52, 2, 1060, 1061, 10, 24,
-		0, 0, 1061, 1062, 5, 12, 0, 0, 1062, 1064, 3, 6, 3, 0, 1063, 1065, 3, 14,
-		7, 0, 1064, 1063, 1, 0, 0, 0, 1064, 1065, 1, 0, 0, 0, 1065, 1111, 1, 0,
-		0, 0, 1066, 1067, 10, 23, 0, 0, 1067, 1076, 5, 3, 0, 0, 1068, 1077, 3,
-		104, 52, 0, 1069, 1071, 3, 104, 52, 0, 1070, 1069, 1, 0, 0, 0, 1070, 1071,
-		1, 0, 0, 0, 1071, 1072, 1, 0, 0, 0, 1072, 1074, 5, 5, 0, 0, 1073, 1075,
-		3, 104, 52, 0, 1074, 1073, 1, 0, 0, 0, 1074, 1075, 1, 0, 0, 0, 1075, 1077,
-		1, 0, 0, 0, 1076, 1068, 1, 0, 0, 0, 1076, 1070, 1, 0, 0, 0, 1077, 1078,
-		1, 0, 0, 0, 1078, 1080, 5, 4, 0, 0, 1079, 1081, 3, 14, 7, 0, 1080, 1079,
-		1, 0, 0, 0, 1080, 1081, 1, 0, 0, 0, 1081, 1111, 1, 0, 0, 0, 1082, 1083,
-		10, 21, 0, 0, 1083, 1084, 5, 97, 0, 0, 1084, 1111, 3, 6, 3, 0, 1085, 1087,
-		10, 8, 0, 0, 1086, 1088, 5, 62, 0, 0, 1087, 1086, 1, 0, 0, 0, 1087, 1088,
-		1, 0, 0, 0, 1088, 1089, 1, 0, 0, 0, 1089, 1090, 5, 68, 0, 0, 1090, 1093,
-		5, 7, 0, 0, 1091, 1094, 3, 110, 55, 0, 1092, 1094, 3, 80, 40, 0, 1093,
-		1091, 1, 0, 0, 0, 1093, 1092, 1, 0, 0, 0, 1094, 1095, 1, 0, 0, 0, 1095,
-		1096, 5, 8, 0, 0, 1096, 1111, 1, 0, 0, 0, 1097, 1098, 10, 4, 0, 0, 1098,
-		1100, 5, 70, 0, 0, 1099, 1101, 5, 62, 0, 0, 1100, 1099, 1, 0, 0, 0, 1100,
-		1101, 1, 0, 0, 0, 1101, 1108, 1, 0, 0, 0, 1102, 1103, 5, 94, 0, 0, 1103,
-		1104, 5, 95, 0, 0, 1104, 1109, 3, 104, 52, 0, 1105, 1109, 5, 57, 0, 0,
-		1106, 1109, 5, 138, 0, 0, 1107, 1109, 5, 139, 0, 0, 1108, 1102, 1, 0, 0,
-		0, 1108, 1105, 1, 0, 0, 0, 1108, 1106, 1, 0, 0, 0, 1108, 1107, 1, 0, 0,
-		0, 1109, 1111, 1, 0, 0, 0, 1110, 1024, 1, 0, 0, 0, 1110, 1027, 1, 0, 0,
-		0, 1110, 1030, 1, 0, 0, 0, 1110, 1033, 1, 0, 0, 0, 1110, 1036, 1, 0, 0,
-		0, 1110, 1042, 1, 0, 0, 0, 1110, 1051, 1, 0, 0, 0, 1110, 1054, 1, 0, 0,
-		0, 1110, 1057, 1, 0, 0, 0, 1110, 1060, 1, 0, 0, 0, 1110, 1066, 1, 0, 0,
-		0, 1110, 1082, 1, 0, 0, 0, 1110, 1085, 1, 0, 0, 0, 1110, 1097, 1, 0, 0,
-		0, 1111, 1114, 1, 0, 0, 0, 1112, 1110, 1, 0, 0, 0, 1112, 1113, 1, 0, 0,
-		0, 1113, 105, 1, 0, 0, 0, 1114, 1112, 1, 0, 0, 0, 1115, 1119, 5, 7, 0,
-		0, 1116, 1117, 5, 121, 0, 0, 1117, 1118, 5, 84, 0, 0, 1118, 1120, 3, 110,
-		55, 0, 1119, 1116, 1, 0, 0, 0, 1119, 1120, 1, 0, 0, 0, 1120, 1131, 1, 0,
-		0, 0, 1121, 1122, 5, 83, 0, 0, 1122, 1123, 5, 84, 0, 0, 1123, 1128, 3,
-		84, 42, 0, 1124, 1125, 5, 9, 0, 0, 1125, 1127, 3, 84, 42, 0, 1126, 1124,
-		1, 0, 0, 0, 1127, 1130, 1, 0, 0, 0, 1128, 1126, 1, 0, 0, 0, 1128, 1129,
-		1, 0, 0, 0, 1129, 1132, 1, 0, 0, 0, 1130, 1128, 1, 0, 0, 0, 1131, 1121,
-		1, 0, 0, 0, 1131, 1132, 1, 0, 0, 0, 1132, 1133, 1, 0, 0, 0, 1133, 1134,
-		5, 8, 0, 0, 1134, 107, 1, 0, 0, 0, 1135, 1136, 5, 91, 0, 0, 1136, 1137,
-		3, 104, 52, 0, 1137, 1138, 5, 92, 0, 0, 1138, 1139, 3, 104, 52, 0, 1139,
-		109, 1, 0, 0, 0, 1140, 1145, 3, 104, 52, 0, 1141, 1142, 5, 9, 0, 0, 1142,
-		1144, 3, 104, 52, 0, 1143, 1141, 1, 0, 0, 0, 1144, 1147, 1, 0, 0, 0, 1145,
-		1143, 1, 0, 0, 0, 1145, 1146, 1, 0, 0, 0, 1146, 111, 1, 0, 0, 0, 1147,
-		1145, 1, 0, 0, 0, 1148, 1149, 3, 6, 3, 0, 1149, 1155, 5, 7, 0, 0, 1150,
-		1152, 5, 94, 0, 0, 1151, 1150, 1, 0, 0, 0, 1151, 1152, 1, 0, 0, 0, 1152,
-		1153, 1, 0, 0, 0, 1153, 1156, 3, 110, 55, 0, 1154, 1156, 5, 14, 0, 0, 1155,
-		1151, 1, 0, 0, 0, 1155, 1154, 1, 0, 0, 0, 1155, 1156, 1, 0, 0, 0, 1156,
-		1157, 1, 0, 0, 0, 1157, 1158, 5, 8, 0, 0, 1158, 113, 1, 0, 0, 0, 1159,
-		1160, 6, 57, -1, 0, 1160, 1161, 5, 7, 0, 0, 1161, 1162, 3, 114, 57, 0,
-		1162, 1164, 5, 8, 0, 0, 1163, 1165, 3, 14, 7, 0, 1164, 1163, 1, 0, 0, 0,
-		1164, 1165, 1, 0, 0, 0, 1165, 1194, 1, 0, 0, 0, 1166, 1167, 7, 13, 0, 0,
-		1167, 1194, 3, 114, 57, 14, 1168, 1170, 3, 4, 2, 0, 1169, 1171, 3, 14,
-		7, 0, 1170, 1169, 1, 0, 0, 0, 1170, 1171, 1, 0, 0, 0, 1171, 1194, 1, 0,
-		0, 0, 1172, 1174, 3, 122, 61, 0, 1173, 1175, 3, 14, 7, 0, 1174, 1173, 1,
-		0, 0, 0, 1174, 1175, 1, 0, 0, 0, 1175, 1194, 1, 0, 0, 0, 1176, 1178, 3,
-		16, 8, 0, 1177, 1179, 3, 14, 7, 0, 1178, 1177, 1, 0, 0, 0, 1178, 1179,
-		1, 0, 0, 0, 1179, 1194, 1, 0, 0, 0, 1180, 1182, 5, 130, 0, 0, 1181, 1180,
-		1, 0, 0, 0, 1181, 1182, 1, 0, 0, 0, 1182, 1183, 1, 0, 0, 0, 1183, 1185,
-		5, 3, 0, 0, 1184, 1186, 3, 116, 58, 0, 1185, 1184, 1, 0, 0, 0, 1185, 1186,
-		1, 0, 0, 0, 1186, 1187, 1, 0, 0, 0, 1187, 1189, 5, 4, 0, 0, 1188, 1190,
-		3, 14, 7, 0, 1189, 1188, 1, 0, 0, 0, 1189, 1190, 1, 0, 0, 0, 1190, 1194,
-		1, 0, 0, 0, 1191, 1192, 5, 62, 0, 0, 1192, 1194, 3, 114, 57, 3, 1193, 1159,
-		1, 0, 0, 0, 1193, 1166, 1, 0, 0, 0, 1193, 1168, 1, 0, 0, 0, 1193, 1172,
-		1, 0, 0, 0, 1193, 1176, 1, 0, 0, 0, 1193, 1181, 1, 0, 0, 0, 1193, 1191,
-		1, 0, 0, 0, 1194, 1253, 1, 0, 0, 0, 1195, 1196, 10, 13, 0, 0, 1196, 1197,
-		5, 23, 0, 0, 1197, 1252, 3, 114, 57, 14, 1198, 1199, 10, 12, 0, 0, 1199,
-		1200, 7, 10, 0, 0, 1200, 1252, 3, 114, 57, 13, 1201, 1202, 10, 11, 0, 0,
-		1202, 1203, 7, 0, 0, 0, 1203, 1252, 3, 114, 57, 12, 1204, 1205, 10, 6,
-		0, 0, 1205, 1206, 5, 13, 0, 0, 1206, 1252, 3, 114, 57, 7, 1207, 1208, 10,
-		5, 0, 0, 1208, 1209, 7, 12, 0, 0, 1209, 1252, 3, 114, 57, 6, 1210, 1211,
-		10, 2, 0, 0, 1211, 1212, 5, 64, 0, 0, 1212, 1252, 3, 114, 57, 3, 1213,
-		1214, 10, 1, 0, 0, 1214, 1215, 5, 65, 0, 0, 1215, 1252, 3, 114, 57, 2,
-		1216, 1217, 10, 16, 0, 0, 1217, 1218, 5, 12, 0, 0, 1218, 1220, 3, 6, 3,
-		0, 1219, 1221, 3, 14, 7, 0, 1220, 1219, 1, 0, 0, 0, 1220, 1221, 1, 0, 0,
-		0, 1221, 1252, 1, 0, 0, 0, 1222, 1223, 10, 15, 0, 0, 1223, 1232, 5, 3,
-		0, 0, 1224, 1233, 3, 114, 57, 0, 1225, 1227, 3, 114, 57, 0, 1226, 1225,
-		1, 0, 0, 0, 1226, 1227, 1, 0, 0, 0, 1227, 1228, 1, 0, 0, 0, 1228, 1230,
-		5, 5, 0, 0, 1229, 1231, 3, 114, 57, 0, 1230, 1229, 1, 0, 0, 0, 1230, 1231,
-		1, 0, 0, 0, 1231, 1233, 1, 0, 0, 0, 1232, 1224, 1, 0, 0, 0, 1232, 1226,
-		1, 0, 0, 0, 1233, 1234, 1, 0, 0, 0, 1234, 1236, 5, 4, 0, 0, 1235, 1237,
-		3, 14, 7, 0, 1236, 1235, 1, 0, 0, 0, 1236, 1237, 1, 0, 0, 0, 1237, 1252,
-		1, 0, 0, 0, 1238, 1239, 10, 4, 0, 0, 1239, 1241, 5, 70, 0, 0, 1240, 1242,
-		5, 62, 0, 0, 1241, 1240, 1, 0, 0, 0, 1241, 1242, 1, 0, 0, 0, 1242, 1249,
-		1, 0, 0, 0, 1243, 1244, 5, 94, 0, 0, 1244, 1245, 5, 95, 0, 0, 1245, 1250,
-		3, 114, 57, 0, 1246, 1250, 5, 57, 0, 0, 1247, 1250, 5, 138, 0, 0, 1248,
-		1250, 5, 139, 0, 0, 1249, 1243, 1, 0, 0, 0, 1249, 1246, 1, 0, 0, 0, 1249,
-		1247, 1, 0, 0, 0, 1249, 1248, 1, 0, 0, 0, 1250, 1252, 1, 0, 0, 0, 1251,
-		1195, 1, 0, 0, 0, 1251, 1198, 1, 0, 0, 0, 1251, 1201, 1, 0, 0, 0, 1251,
-		1204, 1, 0, 0, 0, 1251, 1207, 1, 0, 0, 0, 1251, 1210, 1, 0, 0, 0, 1251,
-		1213, 1, 0, 0, 0, 1251, 1216, 1, 0, 0, 0, 1251, 1222, 1, 0, 0, 0, 1251,
-		1238, 1, 0, 0, 0, 1252, 1255, 1, 0, 0, 0, 1253, 1251, 1, 0, 0, 0, 1253,
-		1254, 1, 0, 0, 0, 1254, 115, 1, 0, 0, 0, 1255, 1253, 1, 0, 0, 0, 1256,
-		1261, 3, 114, 57, 0, 1257, 1258, 5, 9, 0, 0, 1258, 1260, 3, 114, 57, 0,
-		1259, 1257, 1, 0, 0, 0, 1260, 1263, 1, 0, 0, 0, 1261, 1259, 1, 0, 0, 0,
-		1261, 1262, 1, 0, 0, 0, 1262, 117, 1, 0, 0, 0, 1263, 1261, 1, 0, 0, 0,
-		1264, 1265, 5, 149, 0, 0, 1265, 1266, 3, 12, 6, 0, 1266, 1267, 5, 6, 0,
-		0, 1267, 1357, 1, 0, 0, 0, 1268, 1273, 3, 120, 60, 0, 1269, 1270, 5, 9,
-		0, 0, 1270, 1272, 3, 120, 60, 0, 1271, 1269, 1, 0, 0, 0, 1272, 1275, 1,
-		0, 0, 0, 1273, 1271, 1, 0, 0, 0, 1273, 1274, 1, 0, 0, 0, 1274, 1276, 1,
-		0, 0, 0, 1275, 1273, 1, 0, 0, 0, 1276, 1277, 7, 14, 0, 0, 1277, 1279, 1,
-		0, 0, 0, 1278, 1268, 1, 0, 0, 0, 1278, 1279, 1, 0, 0, 0, 1279, 1280, 1,
-		0, 0, 0, 1280, 1281, 3, 122, 61, 0, 1281, 1282, 5, 6, 0, 0, 1282, 1357,
-		1, 0, 0, 0, 1283, 1285, 3, 114, 57, 0, 1284, 1286, 3, 12, 6, 0, 1285, 1284,
-		1, 0, 0, 0, 1285, 1286, 1, 0, 0, 0, 1286, 1287, 1, 0, 0, 0, 1287, 1288,
-		7, 14, 0, 0, 1288, 1289, 3, 114, 57, 0, 1289, 1290, 5, 6, 0, 0, 1290, 1357,
-		1, 0, 0, 0, 1291, 1292, 5, 112, 0, 0, 1292, 1293, 5, 149, 0, 0, 1293, 1300,
-		5, 68, 0, 0, 1294, 1301, 3, 126, 63, 0, 1295, 1301, 3, 32, 16, 0, 1296,
-		1298, 5, 130, 0, 0, 1297, 1296, 1, 0, 0, 0, 1297, 1298, 1, 0, 0, 0, 1298,
-		1299, 1, 0, 0, 0, 1299, 1301, 3, 114, 57, 0, 1300, 1294, 1, 0, 0, 0, 1300,
-		1295, 1, 0, 0, 0, 1300, 1297, 1, 0, 0, 0, 1301, 1302, 1, 0, 0, 0, 1302,
-		1306, 5, 1, 0, 0, 1303, 1305, 3, 118, 59, 0, 1304, 1303, 1, 0, 0, 0, 1305,
-		1308, 1, 0, 0, 0, 1306, 1304, 1, 0, 0, 0, 1306, 1307, 1, 0, 0, 0, 1307,
-		1309, 1, 0, 0, 0, 1308, 1306, 1, 0, 0, 0, 1309, 1311, 5, 2, 0, 0, 1310,
-		1312, 5, 6, 0, 0, 1311, 1310, 1, 0, 0, 0, 1311, 1312, 1, 0, 0, 0, 1312,
-		1357, 1, 0, 0, 0, 1313, 1314, 5, 113, 0, 0, 1314, 1323, 3, 124, 62, 0,
-		1315, 1319, 5, 114, 0, 0, 1316, 1317, 5, 115, 0, 0, 1317, 1319, 5, 113,
-		0, 0, 1318, 1315, 1, 0, 0, 0, 1318, 1316, 1, 0, 0, 0, 1319, 1320, 1, 0,
-		0, 0, 1320, 1322, 3, 124, 62, 0, 1321, 1318, 1, 0, 0, 0, 1322, 1325, 1,
-		0, 0, 0, 1323, 1321, 1, 0, 0, 0, 1323, 1324, 1, 0, 0, 0, 1324, 1335, 1,
-		0, 0, 0, 1325, 1323, 1, 0, 0, 0, 1326, 1327, 5, 115, 0, 0, 1327, 1331,
-		5, 1, 0, 0, 1328, 1330, 3, 118, 59, 0, 1329, 1328, 1, 0, 0, 0, 1330, 1333,
-		1, 0, 0, 0, 1331, 1329, 1, 0, 0, 0, 1331, 1332, 1, 0, 0, 0, 1332, 1334,
-		1, 0, 0, 0, 1333, 1331, 1, 0, 0, 0, 1334, 1336, 5, 2, 0, 0, 1335, 1326,
-		1, 0, 0, 0, 1335, 1336, 1, 0, 0, 0, 1336, 1338, 1, 0, 0, 0, 1337, 1339,
-		5, 6, 0, 0, 1338, 1337, 1, 0, 0, 0, 1338, 1339, 1, 0, 0, 0, 1339, 1357,
-		1, 0, 0, 0, 1340, 1341, 3, 32, 16, 0, 1341, 1342, 5, 6, 0, 0, 1342, 1357,
-		1, 0, 0, 0, 1343, 1344, 7, 15, 0, 0, 1344, 1357, 5, 6, 0, 0, 1345, 1348,
-		5, 118, 0, 0, 1346, 1349, 3, 116, 58, 0, 1347, 1349, 3, 32, 16, 0, 1348,
-		1346, 1, 0, 0, 0, 1348, 1347, 1, 0, 0, 0, 1348, 1349, 1, 0, 0, 0, 1349,
-		1350, 1, 0, 0, 0, 1350, 1357, 5, 6, 0, 0, 1351, 1352, 5, 118, 0, 0, 1352,
-		1353, 5, 119, 0, 0, 1353, 1354, 3, 116, 58, 0, 1354, 1355, 5, 6, 0, 0,
-		1355, 1357, 1, 0, 0, 0, 1356, 1264, 1, 0, 0, 0, 1356, 1278, 1, 0, 0, 0,
-		1356, 1283, 1, 0, 0, 0, 1356, 1291, 1, 0, 0, 0, 1356, 1313, 1, 0, 0, 0,
-		1356, 1340, 1, 0, 0, 0, 1356, 1343, 1, 0, 0, 0, 1356, 1345, 1, 0, 0, 0,
-		1356, 1351, 1, 0, 0, 0, 1357, 119, 1, 0, 0, 0, 1358, 1359, 7, 16, 0, 0,
-		1359, 121, 1, 0, 0, 0, 1360, 1361, 3, 6, 3, 0, 1361, 1362, 5, 12, 0, 0,
-		1362, 1364, 1, 0, 0, 0, 1363, 1360, 1, 0, 0, 0, 1363, 1364, 1, 0, 0, 0,
-		1364, 1365, 1, 0, 0, 0, 1365, 1366, 3, 6, 3, 0, 1366, 1368, 5, 7, 0, 0,
-		1367, 1369, 3, 116, 58, 0, 1368, 1367, 1, 0, 0, 0, 1368, 1369, 1, 0, 0,
-		0, 1369, 1370, 1, 0, 0, 0, 1370, 1371, 5, 8, 0, 0, 1371, 123, 1, 0, 0,
-		0, 1372, 1373, 3, 114, 57, 0, 1373, 1377, 5, 1, 0, 0, 1374, 1376, 3, 118,
-		59, 0, 1375, 1374, 1, 0, 0, 0, 1376, 1379, 1, 0, 0, 0, 1377, 1375, 1, 0,
-		0, 0, 1377, 1378, 1, 0, 0, 0, 1378, 1380, 1, 0, 0, 0, 1379, 1377, 1, 0,
-		0, 0, 1380, 1381, 5, 2, 0, 0, 1381, 125, 1, 0, 0, 0, 1382, 1383, 3, 114,
-		57, 0, 1383, 1384, 5, 32, 0, 0, 1384, 1385, 3, 114, 57, 0, 1385, 127, 1,
-		0, 0, 0, 195, 133, 137, 145, 165, 169, 173, 181, 188, 197, 205, 208, 212,
-		224, 232, 243, 259, 271, 277, 285, 287, 291, 301, 305, 312, 315, 321, 330,
-		333, 336, 348, 354, 359, 363, 370, 395, 403, 407, 417, 428, 437, 444, 453,
-		471, 474, 478, 484, 487, 499, 508, 516, 524, 528, 532, 538, 543, 547, 551,
-		557, 564, 571, 579, 585, 596, 599, 605, 609, 615, 624, 632, 646, 649, 652,
-		661, 668, 676, 692, 702, 705, 709, 713, 717, 721, 725, 729, 733, 740, 748,
-		751, 755, 762, 764, 777, 780, 785, 789, 792, 798, 801, 803, 806, 815, 818,
-		823, 826, 831, 834, 842, 850, 853, 857, 867, 870, 876, 889, 893, 896, 905,
-		907, 918, 923, 925, 931, 934, 938, 945, 951, 960, 965, 969, 973, 978, 982,
-		987, 991, 995, 1000, 1004, 1009, 1012, 1018, 1022, 1038, 1044, 1064, 1070,
-		1074, 1076, 1080, 1087, 1093, 1100, 1108, 1110, 1112, 1119, 1128, 1131,
-		1145, 1151, 1155, 1164, 1170, 1174, 1178, 1181, 1185, 1189, 1193, 1220,
-		1226, 1230, 1232, 1236, 1241, 1249, 1251, 1253, 1261, 1273, 1278, 1285,
-		1297, 1300, 1306, 1311, 1318, 1323, 1331, 1335, 1338, 1348, 1356, 1363,
-		1368, 1377,
+		57, 1, 57, 1, 57, 3, 57, 1234, 8, 57, 1, 57, 1, 57, 1, 57, 1, 57, 3, 57,
+		1240, 8, 57, 1, 57, 1, 57, 3, 57, 1244, 8, 57, 3, 57, 1246, 8, 57, 1, 57,
+		1, 57, 3, 57, 1250, 8, 57, 1, 57, 1, 57, 1, 57, 3, 57, 1255, 8, 57, 1,
+		57, 1, 57, 1, 57, 1, 57, 1, 57, 1, 57, 3, 57, 1263, 8, 57, 5, 57, 1265,
+		8, 57, 10, 57, 12, 57, 1268, 9, 57, 1, 58, 1, 58, 1, 58, 5, 58, 1273, 8,
+		58, 10, 58, 12, 58, 1276, 9, 58, 1, 59, 1, 59, 1, 59, 1, 59, 1, 59, 1,
+		59, 1, 59, 5, 59, 1285, 8, 59, 10, 59, 12, 59, 1288, 9, 59, 1, 59, 1, 59,
+		3, 59, 1292, 8, 59, 1, 59, 1, 59, 1, 59, 1, 59, 1, 59, 3, 59, 1299, 8,
+		59, 1, 59, 1, 59, 1, 59, 1, 59, 1, 59, 1, 59, 1, 59, 1, 59, 1, 59, 1, 59,
+		3, 59, 1311, 8, 59, 1, 59, 3, 59, 1314, 8, 59, 1, 59, 1, 59, 5, 59, 1318,
+		8, 59, 10, 59, 12, 59, 1321, 9, 59, 1, 59, 1, 59, 3, 59, 1325, 8, 59, 1,
+		59, 1, 59, 1, 59, 1, 59, 1, 59, 3, 59, 1332, 8, 59, 1, 59, 5, 59, 1335,
+		8, 59, 10, 59, 12, 59, 1338, 9, 59, 1, 59, 1, 59, 1, 59, 5, 59, 1343, 8,
+		59, 10, 59, 12, 59, 1346, 9, 59, 1, 59, 3, 59, 1349, 8, 59, 1, 59, 3, 59,
+		1352, 8, 59, 1, 59, 1, 59, 1, 59, 1, 59, 1, 59, 1, 59, 1, 59, 1, 59, 3,
+		59, 1362, 8, 59, 1, 59, 1, 59, 1, 59, 1, 59, 1, 59, 1, 59, 3, 59, 1370,
+		8, 59, 1, 60, 1, 60, 1, 61, 1, 61, 1, 61, 3, 61, 1377, 8, 61, 1, 61, 1,
+		61, 1, 61, 1, 61, 1, 61, 5, 61, 1384, 8, 61, 10, 61, 12, 61, 1387, 9, 61,
+		3, 61, 1389, 8, 61, 1, 61, 1, 61, 1, 62, 1, 62, 1, 62, 5, 62, 1396, 8,
+		62, 10, 62, 12, 62, 1399, 9, 62, 1, 62, 1, 62, 1, 63, 1, 63, 1, 63, 1,
+		63, 1, 64, 1, 64, 1, 64, 1, 64, 3, 64, 1411, 8, 64, 1, 65, 1, 65, 1, 65,
+		1, 65, 3, 65, 1417, 8, 65, 1, 65, 1, 65, 1, 66, 1, 66, 1, 66, 1, 66, 1,
+		66, 3, 66, 1426, 8, 66, 1, 66, 1, 66, 1, 66, 1, 66, 1, 66, 1, 66, 5, 66,
+		1434, 8, 66, 10, 66, 12, 66, 1437, 9, 66, 1, 66, 1, 66, 1, 66, 1, 66, 1,
+		66, 1, 66, 5, 66, 1445, 8, 66, 10, 66, 12, 66, 1448, 9, 66, 1, 66, 3, 66,
+		1451, 8, 66, 1, 67, 1, 67, 1, 67, 1, 67, 3, 67, 1457, 8, 67, 1, 67, 1,
+		67, 1, 68, 1, 68, 1, 68, 1, 69, 1, 69, 1, 69, 1, 69, 1, 69, 3, 69, 1469,
+		8, 69, 1, 69, 1, 69, 1, 69, 1, 69, 1, 69, 1, 69, 1, 69, 1, 63, 0, 2, 104,
+		114, 70, 0, 2, 4, 6, 8, 10, 12, 14, 16, 18, 20, 22, 24, 26, 28, 30, 32,
+		34, 36, 38, 40, 42, 44, 46, 48, 50, 52, 54, 56, 58, 60, 62, 64, 66, 68,
+		70, 72, 74, 76, 78, 80, 82, 84, 86, 88, 90, 92, 94, 96, 98, 100, 102, 104,
+		106, 108, 110, 112, 114, 116, 118, 120, 122, 124, 126, 128, 130, 132, 134,
+		136, 138, 0, 17, 1, 0, 20, 21, 1, 0, 138, 139, 13, 0, 34, 35, 37, 39, 41,
+		43, 46, 49, 52, 52, 54, 54, 56, 56, 63, 63, 87, 87, 112, 118, 125, 129,
+		131, 136, 148, 148, 1, 0, 149, 150, 1, 0, 58, 59, 1, 0, 53, 54, 6, 0, 34,
+		34, 38, 39, 42, 42, 58, 59, 98, 99, 135, 136, 1, 0, 79, 80, 1, 0, 106,
+		107, 2, 0, 75, 77, 101, 101, 3, 0, 14, 14, 19, 19, 22, 22, 1, 0, 66, 67,
+		2, 0, 15, 16, 24, 28, 2, 0, 11, 11, 20, 21, 2, 0, 15, 15, 31, 31, 1, 0,
+		116, 117, 2, 0, 30, 30, 149, 149, 1702, 0, 140, 1, 0, 0, 0, 2, 157, 1,
+		0, 0, 0, 4, 196, 1, 0, 0, 0, 6, 203, 1, 0, 0, 0, 8, 205, 1, 0, 0, 0, 10,
+		207, 1, 0, 0, 0, 12, 215, 1, 0, 0, 0, 14, 229, 1, 0, 0, 0, 16, 232, 1,
+		0, 0, 0, 18, 234, 1, 0, 0, 0, 20, 242, 1, 0, 0, 0, 22, 250, 1, 0, 0, 0,
+		24, 274, 1, 0, 0, 0, 26, 276, 1, 0, 0, 0, 28, 288, 1, 0, 0, 0, 30, 304,
+		1, 0, 0, 0, 32, 330, 1, 0, 0, 0, 34, 338, 1, 0, 0, 0, 36, 358, 1, 0, 0,
+		0, 38, 385, 1, 0, 0, 0, 40, 412, 1, 0, 0, 0, 42, 414, 1, 0, 0, 0, 44, 424,
+		1, 0, 0, 0, 46, 489, 1, 0, 0, 0, 48, 491, 1, 0, 0, 0, 50, 510, 1, 0, 0,
+		0, 52, 518, 1, 0, 0, 0, 54, 527, 1, 0, 0, 0, 56, 535, 1, 0, 0, 0, 58, 555,
+		1, 0, 0, 0, 60, 574, 1, 0, 0, 0, 62, 581, 1, 0, 0, 0, 64, 589, 1, 0, 0,
+		0, 66, 591, 1, 0, 0, 0, 68, 633, 1, 0, 0, 0, 70, 641, 1, 0, 0, 0, 72, 670,
+		1, 0, 0, 0, 74, 676, 1, 0, 0, 0, 76, 685, 1, 0, 0, 0, 78, 693, 1, 0, 0,
+		0, 80, 699, 1, 0, 0, 0, 82, 734, 1, 0, 0, 0, 84, 736, 1, 0, 0, 0, 86, 744,
+		1, 0, 0, 0, 88, 816, 1, 0, 0, 0, 90, 819, 1, 0, 0, 0, 92, 839, 1, 0, 0,
+		0, 94, 841, 1, 0, 0, 0, 96, 872, 1, 0, 0, 0, 98, 876, 1, 0, 0, 0, 100,
+		911, 1, 0, 0, 0, 102, 940, 1, 0, 0, 0, 104, 1035, 1, 0, 0, 0, 106, 1128,
+		1, 0, 0, 0, 108, 1148, 1, 0, 0, 0, 110, 1153, 1, 0, 0, 0, 112, 1161, 1,
+		0, 0, 0, 114, 1206, 1, 0, 0, 0, 116, 1269, 1, 0, 0, 0, 118, 1369, 1, 0,
+		0, 0, 120, 1371, 1, 0, 0, 0, 122, 1376, 1, 0, 0, 0, 124, 1392, 1, 0, 0,
+		0, 126, 1402, 1, 0, 0, 0, 128, 1406, 1, 0, 0, 0, 130, 1416, 1, 0, 0, 0,
+		132, 1420, 1, 0, 0, 0, 134, 1452, 1, 0, 0, 0, 136, 1460, 1, 0, 0, 0, 138,
+		1463, 1, 0, 0, 0, 140, 145, 3, 2, 1, 0, 141, 142, 5, 6, 0, 0, 142, 144,
+		3, 2, 1, 0, 143, 141, 1, 0, 0, 0, 144, 147, 1, 0, 0, 0, 145, 143, 1, 0,
+		0, 0, 145, 146, 1, 0, 0, 0, 146, 149, 1, 0, 0, 0, 147, 145, 1, 0, 0, 0,
+		148, 150, 5, 6, 0, 0, 149, 148, 1, 0, 0, 0, 149, 150, 1, 0, 0, 0, 150,
+		151, 1, 0, 0, 0, 151, 152, 5, 0, 0, 1, 152, 1, 1, 0, 0, 0, 153, 154, 5,
+		1, 0, 0, 154, 155, 3, 6, 3, 0, 155, 156, 5, 2, 0, 0, 156, 158, 1, 0, 0,
+		0, 157, 153, 1, 0, 0, 0, 157, 158, 1, 0, 0, 0, 158, 180, 1, 0, 0, 0, 159,
+		181, 3, 32, 16, 0, 160, 181, 3, 36, 18, 0, 161, 181, 3, 44, 22, 0, 162,
+		181, 3, 42, 21, 0, 163, 181, 3, 48, 24, 0, 164, 181, 3, 50, 25, 0, 165,
+		181, 3, 52, 26, 0, 166, 181, 3, 54, 27, 0, 167, 181, 3, 56, 28, 0, 168,
+		181, 3, 58, 29, 0, 169, 181, 3, 60, 30, 0, 170, 181, 3, 66, 33, 0, 171,
+		181, 3, 68, 34, 0, 172, 181, 3, 70, 35, 0, 173, 181, 3, 72, 36, 0, 174,
+		181, 3, 74, 37, 0, 175, 181, 3, 76, 38, 0, 176, 181, 3, 78, 39, 0, 177,
+		181, 3, 132, 66, 0, 178, 181, 3, 134, 67, 0, 179, 181, 3, 138, 69, 0, 180,
+		159, 1, 0, 0, 0, 180, 160, 1, 0, 0, 0, 180, 161, 1, 0, 0, 0, 180, 162,
+		1, 0, 0, 0, 180, 163, 1, 0, 0, 0, 180, 164, 1, 0, 0, 0, 180, 165, 1, 0,
+		0, 0, 180, 166, 1, 0, 0, 0, 180, 167, 1, 0, 0, 0, 180, 168, 1, 0, 0, 0,
+		180, 169, 1, 0, 0, 0, 180, 170, 1, 0, 0, 0, 180, 171, 1, 0, 0, 0, 180,
+		172, 1, 0, 0, 0, 180, 173, 1, 0, 0, 0, 180, 174, 1, 0, 0, 0, 180, 175,
+		1, 0, 0, 0, 180, 176, 1, 0, 0, 0, 180, 177, 1, 0, 0, 0, 180, 178, 1, 0,
+		0, 0, 180, 179, 1, 0, 0, 0, 181, 3, 1, 0, 0, 0, 182, 197, 5, 137, 0, 0,
+		183, 185, 7, 0, 0, 0, 184, 183, 1, 0, 0, 0, 184, 185, 1, 0, 0, 0, 185,
+		186, 1, 0, 0, 0, 186, 197, 5, 140, 0, 0, 187, 189, 7, 0, 0, 0, 188, 187,
+		1, 0, 0, 0, 188, 189, 1, 0, 0, 0, 189, 190, 1, 0, 0, 0, 190, 191, 5, 140,
+		0, 0, 191, 192, 5, 12, 0, 0, 192, 197, 5, 140, 0, 0, 193, 197, 7, 1, 0,
+		0, 194, 197, 5, 57, 0, 0, 195, 197, 5, 141, 0, 0, 196, 182, 1, 0, 0, 0,
+		196, 184, 1, 0, 0, 0, 196, 188, 1, 0, 0, 0, 196, 193, 1, 0, 0, 0, 196,
+		194, 1, 0, 0, 0, 196, 195, 1, 0, 0, 0, 197, 5, 1, 0, 0, 0, 198, 199, 5,
+		33, 0, 0, 199, 200, 3, 8, 4, 0, 200, 201, 5, 33, 0, 0, 201, 204, 1, 0,
+		0, 0, 202, 204, 3, 8, 4, 0, 203, 198, 1, 0, 0, 0, 203, 202, 1, 0, 0, 0,
+		204, 7, 1, 0, 0, 0, 205, 206, 7, 2, 0, 0, 206, 9, 1, 0, 0, 0, 207, 212,
+		3, 6, 3, 0, 208, 209, 5, 9, 0, 0, 209, 211, 3, 6, 3, 0, 210, 208, 1, 0,
+		0, 0, 211, 214, 1, 0, 0, 0, 212, 210, 1, 0, 0, 0, 212, 213, 1, 0, 0, 0,
+		213, 11, 1, 0, 0, 0, 214, 212, 1, 0, 0, 0, 215, 223, 3, 6, 3, 0, 216, 217,
+		5, 7, 0, 0, 217, 220, 5, 140, 0, 0, 218, 219, 5, 9, 0, 0, 219, 221, 5,
+		140, 0, 0, 220, 218, 1, 0, 0, 0, 220, 221, 1, 0, 0, 0, 221, 222, 1, 0,
+		0, 0, 222, 224, 5, 8, 0, 0, 223, 216, 1, 0, 0, 0, 223, 224, 1, 0, 0, 0,
+		224, 227, 1, 0, 0, 0, 225, 226, 5, 3, 0, 0, 226, 228, 5, 4, 0, 0, 227,
+		225, 1, 0, 0, 0, 227, 228, 1, 0, 0, 0, 228, 13, 1, 0, 0, 0, 229, 230, 5,
+		29, 0, 0, 230, 231, 3, 12, 6, 0, 231, 15, 1, 0, 0, 0, 232, 233, 7, 3, 0,
+		0, 233, 17, 1, 0, 0, 0, 234, 235, 3, 6, 3, 0, 235, 239, 3, 12, 6, 0, 236,
+		238, 3, 24, 12, 0, 237, 236, 1, 0, 0, 0, 238, 241, 1, 0, 0, 0, 239, 237,
+		1, 0, 0, 0, 239, 240, 1, 0, 0, 0, 240, 19, 1, 0, 0, 0, 241, 239, 1, 0,
+		0, 0, 242, 247, 3, 12, 6, 0, 243, 244, 5, 9, 0, 0, 244, 246, 3, 12, 6,
+		0, 245, 243, 1, 0, 0, 0, 246, 249, 1, 0, 0, 0, 247, 245, 1, 0, 0, 0, 247,
+		248, 1, 0, 0, 0, 248, 21, 1, 0, 0, 0, 249, 247, 1, 0, 0, 0, 250, 251, 3,
+		6, 3, 0, 251, 258, 3, 12, 6, 0, 252, 253, 5, 9, 0, 0, 253, 254, 3, 6, 3,
+		0, 254, 255, 3, 12, 6, 0, 255, 257, 1, 0, 0, 0, 256, 252, 1, 0, 0, 0, 257,
+		260, 1, 0, 0, 0, 258, 256, 1, 0, 0, 0, 258, 259, 1, 0, 0, 0, 259, 23, 1,
+		0, 0, 0, 260, 258, 1, 0, 0, 0, 261, 262, 5, 48, 0, 0, 262, 275, 5, 49,
+		0, 0, 263, 275, 5, 52, 0, 0, 264, 265, 5, 62, 0, 0, 265, 275, 5, 57, 0,
+		0, 266, 267, 5, 56, 0, 0, 267, 275, 3, 114, 57, 0, 268, 275, 3, 28, 14,
+		0, 269, 270, 5, 46, 0, 0, 270, 271, 5, 7, 0, 0, 271, 272, 3, 104, 52, 0,
+		272, 273, 5, 8, 0, 0, 273, 275, 1, 0, 0, 0, 274, 261, 1, 0, 0, 0, 274,
+		263, 1, 0, 0, 0, 274, 264, 1, 0, 0, 0, 274, 266, 1, 0, 0, 0, 274, 268,
+		1, 0, 0, 0, 274, 269, 1, 0, 0, 0, 275, 25, 1, 0, 0, 0, 276, 277, 5, 50,
+		0, 0, 277, 286, 7, 4, 0, 0, 278, 279, 5, 55, 0, 0, 279, 287, 5, 57, 0,
+		0, 280, 281, 5, 55, 0, 0, 281, 287, 5, 56, 0, 0, 282, 287, 5, 54, 0, 0,
+		283, 284, 5, 88, 0, 0, 284, 287, 5, 37, 0, 0, 285, 287, 5, 53, 0, 0, 286,
+		278, 1, 0, 0, 0, 286, 280, 1, 0, 0, 0, 286, 282, 1, 0, 0, 0, 286, 283,
+		1, 0, 0, 0, 286, 285, 1, 0, 0, 0, 287, 27, 1, 0, 0, 0, 288, 292, 5, 60,
+		0, 0, 289, 290, 3, 6, 3, 0, 290, 291, 5, 12, 0, 0, 291, 293, 1, 0, 0, 0,
+		292, 289, 1, 0, 0, 0, 292, 293, 1, 0, 0, 0, 293, 294, 1, 0, 0, 0, 294,
+		295, 3, 6, 3, 0, 295, 296, 5, 7, 0, 0, 296, 297, 3, 10, 5, 0, 297, 302,
+		5, 8, 0, 0, 298, 300, 3, 26, 13, 0, 299, 301, 3, 26, 13, 0, 300, 299, 1,
+		0, 0, 0, 300, 301, 1, 0, 0, 0, 301, 303, 1, 0, 0, 0, 302, 298, 1, 0, 0,
+		0, 302, 303, 1, 0, 0, 0, 303, 29, 1, 0, 0, 0, 304, 316, 5, 87, 0, 0, 305,
+		307, 5, 36, 0, 0, 306, 305, 1, 0, 0, 0, 306, 307, 1, 0, 0, 0, 307, 308,
+		1, 0, 0, 0, 308, 309, 5, 7, 0, 0, 309, 310, 3, 22, 11, 0, 310, 311, 5,
+		8, 0, 0, 311, 317, 1, 0, 0, 0, 312, 313, 5, 7, 0, 0, 313, 314, 3, 20, 10,
+		0, 314, 315, 5, 8, 0, 0, 315, 317, 1, 0, 0, 0, 316, 306, 1, 0, 0, 0, 316,
+		312, 1, 0, 0, 0, 317, 31, 1, 0, 0, 0, 318, 320, 5, 89, 0, 0, 319, 321,
+		5, 124, 0, 0, 320, 319, 1, 0, 0, 0, 320, 321, 1, 0, 0, 0, 321, 322, 1,
+		0, 0, 0, 322, 327, 3, 34, 17, 0, 323, 324, 5, 9, 0, 0, 324, 326, 3, 34,
+		17, 0, 325, 323, 1, 0, 0, 0, 326, 329, 1, 0, 0, 0, 327, 325, 1, 0, 0, 0,
+		327, 328, 1, 0, 0, 0, 328, 331, 1, 0, 0, 0, 329, 327, 1, 0, 0, 0, 330,
+		318, 1, 0, 0, 0, 330, 331, 1, 0, 0, 0, 331, 336, 1, 0, 0, 0, 332, 337,
+		3, 80, 40, 0, 333, 337, 3, 94, 47, 0, 334, 337, 3, 98, 49, 0, 335, 337,
+		3, 102, 51, 0, 336, 332, 1, 0, 0, 0, 336, 333, 1, 0, 0, 0, 336, 334, 1,
+		0, 0, 0, 336, 335, 1, 0, 0, 0, 337, 33, 1, 0, 0, 0, 338, 351, 3, 6, 3,
+		0, 339, 348, 5, 7, 0, 0, 340, 345, 3, 6, 3, 0, 341, 342, 5, 9, 0, 0, 342,
+		344, 3, 6, 3, 0, 343, 341, 1, 0, 0, 0, 344, 347, 1, 0, 0, 0, 345, 343,
+		1, 0, 0, 0, 345, 346, 1, 0, 0, 0, 346, 349, 1, 0, 0, 0, 347, 345, 1, 0,
+		0, 0, 348, 340, 1, 0, 0, 0, 348, 349, 1, 0, 0, 0, 349, 350, 1, 0, 0, 0,
+		350, 352, 5, 8, 0, 0, 351, 339, 1, 0, 0, 0, 351, 352, 1, 0, 0, 0, 352,
+		353, 1, 0, 0, 0, 353, 354, 5, 78, 0, 0, 354, 355, 5, 7, 0, 0, 355, 356,
+		3, 80, 40, 0, 356, 357, 5, 8, 0, 0, 357, 35, 1, 0, 0, 0, 358, 359, 5, 38,
+		0, 0, 359, 363, 5, 36, 0, 0, 360, 361, 5, 113, 0, 0, 361, 362, 5, 62, 0,
+		0, 362, 364, 5, 71, 0, 0, 363, 360, 1, 0, 0, 0, 363, 364, 1, 0, 0, 0, 364,
+		365, 1, 0, 0, 0, 365, 366, 3, 6, 3, 0, 366, 369, 5, 7, 0, 0, 367, 370,
+		3, 18, 9, 0, 368, 370, 3, 38, 19, 0, 369, 367, 1, 0, 0, 0, 369, 368, 1,
+		0, 0, 0, 370, 378, 1, 0, 0, 0, 371, 374, 5, 9, 0, 0, 372, 375, 3, 18, 9,
+		0, 373, 375, 3, 38, 19, 0, 374, 372, 1, 0, 0, 0, 374, 373, 1, 0, 0, 0,
+		375, 377, 1, 0, 0, 0, 376, 371, 1, 0, 0, 0, 377, 380, 1, 0, 0, 0, 378,
+		376, 1, 0, 0, 0, 378, 379, 1, 0, 0, 0, 379, 381, 1, 0, 0, 0, 380, 378,
+		1, 0, 0, 0, 381, 382, 5, 8, 0, 0, 382, 37, 1, 0, 0, 0, 383, 384, 5, 45,
+		0, 0, 384, 386, 3, 6, 3, 0, 385, 383, 1, 0, 0, 0, 385, 386, 1, 0, 0, 0,
+		386, 410, 1, 0, 0, 0, 387, 388, 5, 52, 0, 0, 388, 389, 5, 7, 0, 0, 389,
+		390, 3, 10, 5, 0, 390, 391, 5, 8, 0, 0, 391, 411, 1, 0, 0, 0, 392, 393,
+		5, 46, 0, 0, 393, 394, 5, 7, 0, 0, 394, 395, 3, 104, 52, 0, 395, 396, 5,
+		8, 0, 0, 396, 411, 1, 0, 0, 0, 397, 398, 5, 47, 0, 0, 398, 399, 5, 49,
+		0, 0, 399, 400, 5, 7, 0, 0, 400, 401, 3, 10, 5, 0, 401, 402, 5, 8, 0, 0,
+		402, 403, 3, 28, 14, 0, 403, 411, 1, 0, 0, 0, 404, 405, 5, 48, 0, 0, 405,
+		406, 5, 49, 0, 0, 406, 407, 5, 7, 0, 0, 407, 408, 3, 10, 5, 0, 408, 409,
+		5, 8, 0, 0, 409, 411, 1, 0, 0, 0, 410, 387, 1, 0, 0, 0, 410, 392, 1, 0,
+		0, 0, 410, 397, 1, 0, 0, 0, 410, 404, 1, 0, 0, 0, 411, 39, 1, 0, 0, 0,
+		412, 413, 7, 5, 0, 0, 413, 41, 1, 0, 0, 0, 414, 415, 5, 42, 0, 0, 415,
+		418, 5, 36, 0, 0, 416, 417, 5, 113, 0, 0, 417, 419, 5, 71, 0, 0, 418, 416,
+		1, 0, 0, 0, 418, 419, 1, 0, 0, 0, 419, 420, 1, 0, 0, 0, 420, 422, 3, 10,
+		5, 0, 421, 423, 3, 40, 20, 0, 422, 421, 1, 0, 0, 0, 422, 423, 1, 0, 0,
+		0, 423, 43, 1, 0, 0, 0, 424, 425, 5, 39, 0, 0, 425, 426, 5, 36, 0, 0, 426,
+		427, 3, 6, 3, 0, 427, 432, 3, 46, 23, 0, 428, 429, 5, 9, 0, 0, 429, 431,
+		3, 46, 23, 0, 430, 428, 1, 0, 0, 0, 431, 434, 1, 0, 0, 0, 432, 430, 1,
+		0, 0, 0, 432, 433, 1, 0, 0, 0, 433, 45, 1, 0, 0, 0, 434, 432, 1, 0, 0,
+		0, 435, 436, 5, 39, 0, 0, 436, 437, 5, 40, 0, 0, 437, 438, 3, 6, 3, 0,
+		438, 443, 5, 55, 0, 0, 439, 440, 5, 62, 0, 0, 440, 444, 5, 57, 0, 0, 441,
+		442, 5, 56, 0, 0, 442, 444, 3, 114, 57, 0, 443, 439, 1, 0, 0, 0, 443, 441,
+		1, 0, 0, 0, 444, 490, 1, 0, 0, 0, 445, 446, 5, 39, 0, 0, 446, 447, 5, 40,
+		0, 0, 447, 448, 3, 6, 3, 0, 448, 452, 5, 42, 0, 0, 449, 450, 5, 62, 0,
+		0, 450, 453, 5, 57, 0, 0, 451, 453, 5, 56, 0, 0, 452, 449, 1, 0, 0, 0,
+		452, 451, 1, 0, 0, 0, 453, 490, 1, 0, 0, 0, 454, 455, 5, 41, 0, 0, 455,
+		459, 5, 40, 0, 0, 456, 457, 5, 113, 0, 0, 457, 458, 5, 62, 0, 0, 458, 460,
+		5, 71, 0, 0, 459, 456, 1, 0, 0, 0, 459, 460, 1, 0, 0, 0, 460, 461, 1, 0,
+		0, 0, 461, 462, 3, 6, 3, 0, 462, 463, 3, 12, 6, 0, 463, 490, 1, 0, 0, 0,
+		464, 465, 5, 42, 0, 0, 465, 468, 5, 40, 0, 0, 466, 467, 5, 113, 0, 0, 467,
+		469, 5, 71, 0, 0, 468, 466, 1, 0, 0, 0, 468, 469, 1, 0, 0, 0, 469, 470,
+		1, 0, 0, 0, 470, 490, 3, 6, 3, 0, 471, 472, 5, 43, 0, 0, 472, 473, 5, 40,
+		0, 0, 473, 474, 3, 6, 3, 0, 474, 475, 5, 44, 0, 0, 475, 476, 3, 6, 3, 0,
+		476, 490, 1, 0, 0, 0, 477, 478, 5, 43, 0, 0, 478, 479, 5, 44, 0, 0, 479,
+		490, 3, 6, 3, 0, 480, 481, 5, 41, 0, 0, 481, 490, 3, 38, 19, 0, 482, 483,
+		5, 42, 0, 0, 483, 486, 5, 45, 0, 0, 484, 485, 5, 113, 0, 0, 485, 487, 5,
+		71, 0, 0, 486, 484, 1, 0, 0, 0, 486, 487, 1, 0, 0, 0, 487, 488, 1, 0, 0,
+		0, 488, 490, 3, 6, 3, 0, 489, 435, 1, 0, 0, 0, 489, 445, 1, 0, 0, 0, 489,
+		454, 1, 0, 0, 0, 489, 464, 1, 0, 0, 0, 489, 471, 1, 0, 0, 0, 489, 477,
+		1, 0, 0, 0, 489, 480, 1, 0, 0, 0, 489, 482, 1, 0, 0, 0, 490, 47, 1, 0,
+		0, 0, 491, 493, 5, 38, 0, 0, 492, 494, 5, 52, 0, 0, 493, 492, 1, 0, 0,
+		0, 493, 494, 1, 0, 0, 0, 494, 495, 1, 0, 0, 0, 495, 499, 5, 63, 0, 0, 496,
+		497, 5, 113, 0, 0, 497, 498, 5, 62, 0, 0, 498, 500, 5, 71, 0, 0, 499, 496,
+		1, 0, 0, 0, 499, 500, 1, 0, 0, 0, 500, 502, 1, 0, 0, 0, 501, 503, 3, 6,
+		3, 0, 502, 501, 1, 0, 0, 0, 502, 503, 1, 0, 0, 0, 503, 504, 1, 0, 0, 0,
+		504, 505, 5, 50, 0, 0, 505, 506, 3, 6, 3, 0, 506, 507, 5, 7, 0, 0, 507,
+		508, 3, 10, 5, 0, 508, 509, 5, 8, 0, 0, 509, 49, 1, 0, 0, 0, 510, 511,
+		5, 42, 0, 0, 511, 514, 5, 63, 0, 0, 512, 513, 5, 113, 0, 0, 513, 515, 5,
+		71, 0, 0, 514, 512, 1, 0, 0, 0, 514, 515, 1, 0, 0, 0, 515, 516, 1, 0, 0,
+		0, 516, 517, 3, 6, 3, 0, 517, 51, 1, 0, 0, 0, 518, 519, 5, 38, 0, 0, 519,
+		523, 5, 128, 0, 0, 520, 521, 5, 113, 0, 0, 521, 522, 5, 62, 0, 0, 522,
+		524, 5, 71, 0, 0, 523, 520, 1, 0, 0, 0, 523, 524, 1, 0, 0, 0, 524, 525,
+		1, 0, 0, 0, 525, 526, 3, 6, 3, 0, 526, 53, 1, 0, 0, 0, 527, 528, 5, 42,
+		0, 0, 528, 531, 5, 128, 0, 0, 529, 530, 5, 113, 0, 0, 530, 532, 5, 71,
+		0, 0, 531, 529, 1, 0, 0, 0, 531, 532, 1, 0, 0, 0, 532, 533, 1, 0, 0, 0,
+		533, 534, 3, 6, 3, 0, 534, 55, 1, 0, 0, 0, 535, 539, 5, 125, 0, 0, 536,
+		537, 5, 113, 0, 0, 537, 538, 5, 62, 0, 0, 538, 540, 5, 126, 0, 0, 539,
+		536, 1, 0, 0, 0, 539, 540, 1, 0, 0, 0, 540, 543, 1, 0, 0, 0, 541, 544,
+		3, 62, 31, 0, 542, 544, 3, 6, 3, 0, 543, 541, 1, 0, 0, 0, 543, 542, 1,
+		0, 0, 0, 544, 547, 1, 0, 0, 0, 545, 546, 5, 50, 0, 0, 546, 548, 3, 6, 3,
+		0, 547, 545, 1, 0, 0, 0, 547, 548, 1, 0, 0, 0, 548, 549, 1, 0, 0, 0, 549,
+		553, 5, 44, 0, 0, 550, 554, 3, 6, 3, 0, 551, 554, 5, 137, 0, 0, 552, 554,
+		3, 114, 57, 0, 553, 550, 1, 0, 0, 0, 553, 551, 1, 0, 0, 0, 553, 552, 1,
+		0, 0, 0, 554, 57, 1, 0, 0, 0, 555, 558, 5, 127, 0, 0, 556, 557, 5, 113,
+		0, 0, 557, 559, 5, 126, 0, 0, 558, 556, 1, 0, 0, 0, 558, 559, 1, 0, 0,
+		0, 559, 562, 1, 0, 0, 0, 560, 563, 3, 62, 31, 0, 561, 563, 3, 6, 3, 0,
+		562, 560, 1, 0, 0, 0, 562, 561, 1, 0, 0, 0, 563, 566, 1, 0, 0, 0, 564,
+		565, 5, 50, 0, 0, 565, 567, 3, 6, 3, 0, 566, 564, 1, 0, 0, 0, 566, 567,
+		1, 0, 0, 0, 567, 568, 1, 0, 0, 0, 568, 572, 5, 95, 0, 0, 569, 573, 3, 6,
+		3, 0, 570, 573, 5, 137, 0, 0, 571, 573, 3, 114, 57, 0, 572, 569, 1, 0,
+		0, 0, 572, 570, 1, 0, 0, 0, 572, 571, 1, 0, 0, 0, 573, 59, 1, 0, 0, 0,
+		574, 575, 5, 133, 0, 0, 575, 576, 5, 134, 0, 0, 576, 579, 5, 44, 0, 0,
+		577, 580, 5, 137, 0, 0, 578, 580, 3, 114, 57, 0, 579, 577, 1, 0, 0, 0,
+		579, 578, 1, 0, 0, 0, 580, 61, 1, 0, 0, 0, 581, 586, 3, 64, 32, 0, 582,
+		583, 5, 9, 0, 0, 583, 585, 3, 64, 32, 0, 584, 582, 1, 0, 0, 0, 585, 588,
+		1, 0, 0, 0, 586, 584, 1, 0, 0, 0, 586, 587, 1, 0, 0, 0, 587, 63, 1, 0,
+		0, 0, 588, 586, 1, 0, 0, 0, 589, 590, 7, 6, 0, 0, 590, 65, 1, 0, 0, 0,
+		591, 594, 5, 38, 0, 0, 592, 593, 5, 65, 0, 0, 593, 595, 5, 129, 0, 0, 594,
+		592, 1, 0, 0, 0, 594, 595, 1, 0, 0, 0, 595, 596, 1, 0, 0, 0, 596, 600,
+		5, 37, 0, 0, 597, 598, 5, 113, 0, 0, 598, 599, 5, 62, 0, 0, 599, 601, 5,
+		71, 0, 0, 600, 597, 1, 0, 0, 0, 600, 601, 1, 0, 0, 0, 601, 602, 1, 0, 0,
+		0, 602, 603, 3, 6, 3, 0, 603, 612, 5, 7, 0, 0, 604, 609, 3, 128, 64, 0,
+		605, 606, 5, 9, 0, 0, 606, 608, 3, 128, 64, 0, 607, 605, 1, 0, 0, 0, 608,
+		611, 1, 0, 0, 0, 609, 607, 1, 0, 0, 0, 609, 610, 1, 0, 0, 0, 610, 613,
+		1, 0, 0, 0, 611, 609, 1, 0, 0, 0, 612, 604, 1, 0, 0, 0, 612, 613, 1, 0,
+		0, 0, 613, 614, 1, 0, 0, 0, 614, 618, 5, 8, 0, 0, 615, 617, 3, 6, 3, 0,
+		616, 615, 1, 0, 0, 0, 617, 620, 1, 0, 0, 0, 618, 616, 1, 0, 0, 0, 618,
+		619, 1, 0, 0, 0, 619, 622, 1, 0, 0, 0, 620, 618, 1, 0, 0, 0, 621, 623,
+		3, 30, 15, 0, 622, 621, 1, 0, 0, 0, 622, 623, 1, 0, 0, 0, 623, 624, 1,
+		0, 0, 0, 624, 628, 5, 1, 0, 0, 625, 627, 3, 118, 59, 0, 626, 625, 1, 0,
+		0, 0, 627, 630, 1, 0, 0, 0, 628, 626, 1, 0, 0, 0, 628, 629, 1, 0, 0, 0,
+		629, 631, 1, 0, 0, 0, 630, 628, 1, 0, 0, 0, 631, 632, 5, 2, 0, 0, 632,
+		67, 1, 0, 0, 0, 633, 634, 5, 42, 0, 0, 634, 637, 5, 37, 0, 0, 635, 636,
+		5, 113, 0, 0, 636, 638, 5, 71, 0, 0, 637, 635, 1, 0, 0, 0, 637, 638, 1,
+		0, 0, 0, 638, 639, 1, 0, 0, 0, 639, 640, 3, 6, 3, 0, 640, 69, 1, 0, 0,
+		0, 641, 645, 5, 34, 0, 0, 642, 643, 5, 113, 0, 0, 643, 644, 5, 62, 0, 0,
+		644, 646, 5, 71, 0, 0, 645, 642, 1, 0, 0, 0, 645, 646, 1, 0, 0, 0, 646,
+		647, 1, 0, 0, 0, 647, 665, 3, 6, 3, 0, 648, 662, 5, 1, 0, 0, 649, 650,
+		3, 6, 3, 0, 650, 651, 5, 5, 0, 0, 651, 659, 3, 114, 57, 0, 652, 653, 5,
+		9, 0, 0, 653, 654, 3, 6, 3, 0, 654, 655, 5, 5, 0, 0, 655, 656, 3, 114,
+		57, 0, 656, 658, 1, 0, 0, 0, 657, 652, 1, 0, 0, 0, 658, 661, 1, 0, 0, 0,
+		659, 657, 1, 0, 0, 0, 659, 660, 1, 0, 0, 0, 660, 663, 1, 0, 0, 0, 661,
+		659, 1, 0, 0, 0, 662, 649, 1, 0, 0, 0, 662, 663, 1, 0, 0, 0, 663, 664,
+		1, 0, 0, 0, 664, 666, 5, 2, 0, 0, 665, 648, 1, 0, 0, 0, 665, 666, 1, 0,
+		0, 0, 666, 667, 1, 0, 0, 0, 667, 668, 5, 78, 0, 0, 668, 669, 3, 6, 3, 0,
+		669, 71, 1, 0, 0, 0, 670, 671, 5, 35, 0, 0, 671, 674, 3, 6, 3, 0, 672,
+		673, 5, 113, 0, 0, 673, 675, 5, 71, 0, 0, 674, 672, 1, 0, 0, 0, 674, 675,
+		1, 0, 0, 0, 675, 73, 1, 0, 0, 0, 676, 677, 5, 38, 0, 0, 677, 681, 5, 132,
+		0, 0, 678, 679, 5, 113, 0, 0, 679, 680, 5, 62, 0, 0, 680, 682, 5, 71, 0,
+		0, 681, 678, 1, 0, 0, 0, 681, 682, 1, 0, 0, 0, 682, 683, 1, 0, 0, 0, 683,
+		684, 3, 6, 3, 0, 684, 75, 1, 0, 0, 0, 685, 686, 5, 42, 0, 0, 686, 689,
+		5, 132, 0, 0, 687, 688, 5, 113, 0, 0, 688, 690, 5, 71, 0, 0, 689, 687,
+		1, 0, 0, 0, 689, 690, 1, 0, 0, 0, 690, 691, 1, 0, 0, 0, 691, 692, 3, 6,
+		3, 0, 692, 77, 1, 0, 0, 0, 693, 694, 5, 55, 0, 0, 694, 695, 5, 131, 0,
+		0, 695, 696, 5, 132, 0, 0, 696, 697, 5, 44, 0, 0, 697, 698, 3, 6, 3, 0,
+		698, 79, 1, 0, 0, 0, 699, 705, 3, 86, 43, 0, 700, 701, 3, 82, 41, 0, 701,
+		702, 3, 86, 43, 0, 702, 704, 1, 0, 0, 0, 703, 700, 1, 0, 0, 0, 704, 707,
+		1, 0, 0, 0, 705, 703, 1, 0, 0, 0, 705, 706, 1, 0, 0, 0, 706, 718, 1, 0,
+		0, 0, 707, 705, 1, 0, 0, 0, 708, 709, 5, 83, 0, 0, 709, 710, 5, 84, 0,
+		0, 710, 715, 3, 84, 42, 0, 711, 712, 5, 9, 0, 0, 712, 714, 3, 84, 42, 0,
+		713, 711, 1, 0, 0, 0, 714, 717, 1, 0, 0, 0, 715, 713, 1, 0, 0, 0, 715,
+		716, 1, 0, 0, 0, 716, 719, 1, 0, 0, 0, 717, 715, 1, 0, 0, 0, 718, 708,
+		1, 0, 0, 0, 718, 719, 1, 0, 0, 0, 719, 722, 1, 0, 0, 0, 720, 721, 5, 81,
+		0, 0, 721, 723, 3, 104, 52, 0, 722, 720, 1, 0, 0, 0, 722, 723, 1, 0, 0,
+		0, 723, 726, 1, 0, 0, 0, 724, 725, 5, 82, 0, 0, 725, 727, 3, 104, 52, 0,
+		726, 724, 1, 0, 0, 0, 726, 727, 1, 0, 0, 0, 727, 81, 1, 0, 0, 0, 728, 730,
+		5, 102, 0, 0, 729, 731, 5, 72, 0, 0, 730, 729, 1, 0, 0, 0, 730, 731, 1,
+		0, 0, 0, 731, 735, 1, 0, 0, 0, 732, 735, 5, 103, 0, 0, 733, 735, 5, 104,
+		0, 0, 734, 728, 1, 0, 0, 0, 734, 732, 1, 0, 0, 0, 734, 733, 1, 0, 0, 0,
+		735, 83, 1, 0, 0, 0, 736, 738, 3, 104, 52, 0, 737, 739, 7, 7, 0, 0, 738,
+		737, 1, 0, 0, 0, 738, 739, 1, 0, 0, 0, 739, 742, 1, 0, 0, 0, 740, 741,
+		5, 105, 0, 0, 741, 743, 7, 8, 0, 0, 742, 740, 1, 0, 0, 0, 742, 743, 1,
+		0, 0, 0, 743, 85, 1, 0, 0, 0, 744, 746, 5, 98, 0, 0, 745, 747, 5, 94, 0,
+		0, 746, 745, 1, 0, 0, 0, 746, 747, 1, 0, 0, 0, 747, 748, 1, 0, 0, 0, 748,
+		753, 3, 92, 46, 0, 749, 750, 5, 9, 0, 0, 750, 752, 3, 92, 46, 0, 751, 749,
+		1, 0, 0, 0, 752, 755, 1, 0, 0, 0, 753, 751, 1, 0, 0, 0, 753, 754, 1, 0,
+		0, 0, 754, 764, 1, 0, 0, 0, 755, 753, 1, 0, 0, 0, 756, 757, 5, 95, 0, 0,
+		757, 761, 3, 88, 44, 0, 758, 760, 3, 90, 45, 0, 759, 758, 1, 0, 0, 0, 760,
+		763, 1, 0, 0, 0, 761, 759, 1, 0, 0, 0, 761, 762, 1, 0, 0, 0, 762, 765,
+		1, 0, 0, 0, 763, 761, 1, 0, 0, 0, 764, 756, 1, 0, 0, 0, 764, 765, 1, 0,
+		0, 0, 765, 768, 1, 0, 0, 0, 766, 767, 5, 96, 0, 0, 767, 769, 3, 104, 52,
+		0, 768, 766, 1, 0, 0, 0, 768, 769, 1, 0, 0, 0, 769, 777, 1, 0, 0, 0, 770,
+		771, 5, 85, 0, 0, 771, 772, 5, 84, 0, 0, 772, 775, 3, 110, 55, 0, 773,
+		774, 5, 86, 0, 0, 774, 776, 3, 104, 52, 0, 775, 773, 1, 0, 0, 0, 775, 776,
+		1, 0, 0, 0, 776, 778, 1, 0, 0, 0, 777, 770, 1, 0, 0, 0, 777, 778, 1, 0,
+		0, 0, 778, 793, 1, 0, 0, 0, 779, 780, 5, 122, 0, 0, 780, 781, 3, 6, 3,
+		0, 781, 782, 5, 78, 0, 0, 782, 790, 3, 106, 53, 0, 783, 784, 5, 9, 0, 0,
+		784, 785, 3, 6, 3, 0, 785, 786, 5, 78, 0, 0, 786, 787, 3, 106, 53, 0, 787,
+		789, 1, 0, 0, 0, 788, 783, 1, 0, 0, 0, 789, 792, 1, 0, 0, 0, 790, 788,
+		1, 0, 0, 0, 790, 791, 1, 0, 0, 0, 791, 794, 1, 0, 0, 0, 792, 790, 1, 0,
+		0, 0, 793, 779, 1, 0, 0, 0, 793, 794, 1, 0, 0, 0, 794, 87, 1, 0, 0, 0,
+		795, 796, 3, 6, 3, 0, 796, 797, 5, 12, 0, 0, 797, 799, 1, 0, 0, 0, 798,
+		795, 1, 0, 0, 0, 798, 799, 1, 0, 0, 0, 799, 800, 1, 0, 0, 0, 800, 805,
+		3, 6, 3, 0, 801, 803, 5, 78, 0, 0, 802, 801, 1, 0, 0, 0, 802, 803, 1, 0,
+		0, 0, 803, 804, 1, 0, 0, 0, 804, 806, 3, 6, 3, 0, 805, 802, 1, 0, 0, 0,
+		805, 806, 1, 0, 0, 0, 806, 817, 1, 0, 0, 0, 807, 808, 5, 7, 0, 0, 808,
+		809, 3, 80, 40, 0, 809, 814, 5, 8, 0, 0, 810, 812, 5, 78, 0, 0, 811, 810,
+		1, 0, 0, 0, 811, 812, 1, 0, 0, 0, 812, 813, 1, 0, 0, 0, 813, 815, 3, 6,
+		3, 0, 814, 811, 1, 0, 0, 0, 814, 815, 1, 0, 0, 0, 815, 817, 1, 0, 0, 0,
+		816, 798, 1, 0, 0, 0, 816, 807, 1, 0, 0, 0, 817, 89, 1, 0, 0, 0, 818, 820,
+		7, 9, 0, 0, 819, 818, 1, 0, 0, 0, 819, 820, 1, 0, 0, 0, 820, 821, 1, 0,
+		0, 0, 821, 822, 5, 74, 0, 0, 822, 823, 3, 88, 44, 0, 823, 824, 5, 50, 0,
+		0, 824, 825, 3, 104, 52, 0, 825, 91, 1, 0, 0, 0, 826, 831, 3, 104, 52,
+		0, 827, 829, 5, 78, 0, 0, 828, 827, 1, 0, 0, 0, 828, 829, 1, 0, 0, 0, 829,
+		830, 1, 0, 0, 0, 830, 832, 3, 6, 3, 0, 831, 828, 1, 0, 0, 0, 831, 832,
+		1, 0, 0, 0, 832, 840, 1, 0, 0, 0, 833, 834, 3, 6, 3, 0, 834, 835, 5, 12,
+		0, 0, 835, 837, 1, 0, 0, 0, 836, 833, 1, 0, 0, 0, 836, 837, 1, 0, 0, 0,
+		837, 838, 1, 0, 0, 0, 838, 840, 5, 14, 0, 0, 839, 826, 1, 0, 0, 0, 839,
+		836, 1, 0, 0, 0, 840, 93, 1, 0, 0, 0, 841, 842, 5, 59, 0, 0, 842, 847,
+		3, 6, 3, 0, 843, 845, 5, 78, 0, 0, 844, 843, 1, 0, 0, 0, 844, 845, 1, 0,
+		0, 0, 845, 846, 1, 0, 0, 0, 846, 848, 3, 6, 3, 0, 847, 844, 1, 0, 0, 0,
+		847, 848, 1, 0, 0, 0, 848, 849, 1, 0, 0, 0, 849, 850, 5, 55, 0, 0, 850,
+		855, 3, 96, 48, 0, 851, 852, 5, 9, 0, 0, 852, 854, 3, 96, 48, 0, 853, 851,
+		1, 0, 0, 0, 854, 857, 1, 0, 0, 0, 855, 853, 1, 0, 0, 0, 855, 856, 1, 0,
+		0, 0, 856, 866, 1, 0, 0, 0, 857, 855, 1, 0, 0, 0, 858, 859, 5, 95, 0, 0,
+		859, 863, 3, 88, 44, 0, 860, 862, 3, 90, 45, 0, 861, 860, 1, 0, 0, 0, 862,
+		865, 1, 0, 0, 0, 863, 861, 1, 0, 0, 0, 863, 864, 1, 0, 0, 0, 864, 867,
+		1, 0, 0, 0, 865, 863, 1, 0, 0, 0, 866, 858, 1, 0, 0, 0, 866, 867, 1, 0,
+		0, 0, 867, 870, 1, 0, 0, 0, 868, 869, 5, 96, 0, 0, 869, 871, 3, 104, 52,
+		0, 870, 868, 1, 0, 0, 0, 870, 871, 1, 0, 0, 0, 871, 95, 1, 0, 0, 0, 872,
+		873, 3, 6, 3, 0, 873, 874, 5, 15, 0, 0, 874, 875, 3, 104, 52, 0, 875, 97,
+		1, 0, 0, 0, 876, 877, 5, 99, 0, 0, 877, 878, 5, 109, 0, 0, 878, 883, 3,
+		6, 3, 0, 879, 881, 5, 78, 0, 0, 880, 879, 1, 0, 0, 0, 880, 881, 1, 0, 0,
+		0, 881, 882, 1, 0, 0, 0, 882, 884, 3, 6, 3, 0, 883, 880, 1, 0, 0, 0, 883,
+		884, 1, 0, 0, 0, 884, 889, 1, 0, 0, 0, 885, 886, 5, 7, 0, 0, 886, 887,
+		3, 10, 5, 0, 887, 888, 5, 8, 0, 0, 888, 890, 1, 0, 0, 0, 889, 885, 1, 0,
+		0, 0, 889, 890, 1, 0, 0, 0, 890, 906, 1, 0, 0, 0, 891, 892, 5, 100, 0,
+		0, 892, 893, 5, 7, 0, 0, 893, 894, 3, 110, 55, 0, 894, 902, 5, 8, 0, 0,
+		895, 896, 5, 9, 0, 0, 896, 897, 5, 7, 0, 0, 897, 898, 3, 110, 55, 0, 898,
+		899, 5, 8, 0, 0, 899, 901, 1, 0, 0, 0, 900, 895, 1, 0, 0, 0, 901, 904,
+		1, 0, 0, 0, 902, 900, 1, 0, 0, 0, 902, 903, 1, 0, 0, 0, 903, 907, 1, 0,
+		0, 0, 904, 902, 1, 0, 0, 0, 905, 907, 3, 80, 40, 0, 906, 891, 1, 0, 0,
+		0, 906, 905, 1, 0, 0, 0, 907, 909, 1, 0, 0, 0, 908, 910, 3, 100, 50, 0,
+		909, 908, 1, 0, 0, 0, 909, 910, 1, 0, 0, 0, 910, 99, 1, 0, 0, 0, 911, 912,
+		5, 50, 0, 0, 912, 920, 5, 110, 0, 0, 913, 914, 5, 7, 0, 0, 914, 915, 3,
+		10, 5, 0, 915, 918, 5, 8, 0, 0, 916, 917, 5, 96, 0, 0, 917, 919, 3, 104,
+		52, 0, 918, 916, 1, 0, 0, 0, 918, 919, 1, 0, 0, 0, 919, 921, 1, 0, 0, 0,
+		920, 913, 1, 0, 0, 0, 920, 921, 1, 0, 0, 0, 921, 922, 1, 0, 0, 0, 922,
+		938, 5, 51, 0, 0, 923, 939, 5, 111, 0, 0, 924, 925, 5, 59, 0, 0, 925, 926,
+		5, 55, 0, 0, 926, 931, 3, 96, 48, 0, 927, 928, 5, 9, 0, 0, 928, 930, 3,
+		96, 48, 0, 929, 927, 1, 0, 0, 0, 930, 933, 1, 0, 0, 0, 931, 929, 1, 0,
+		0, 0, 931, 932, 1, 0, 0, 0, 932, 936, 1, 0, 0, 0, 933, 931, 1, 0, 0, 0,
+		934, 935, 5, 96, 0, 0, 935, 937, 3, 104, 52, 0, 936, 934, 1, 0, 0, 0, 936,
+		937, 1, 0, 0, 0, 937, 939, 1, 0, 0, 0, 938, 923, 1, 0, 0, 0, 938, 924,
+		1, 0, 0, 0, 939, 101, 1, 0, 0, 0, 940, 941, 5, 58, 0, 0, 941, 942, 5, 95,
+		0, 0, 942, 947, 3, 6, 3, 0, 943, 945, 5, 78, 0, 0, 944, 943, 1, 0, 0, 0,
+		944, 945, 1, 0, 0, 0, 945, 946, 1, 0, 0, 0, 946, 948, 3, 6, 3, 0, 947,
+		944, 1, 0, 0, 0, 947, 948, 1, 0, 0, 0, 948, 951, 1, 0, 0, 0, 949, 950,
+		5, 96, 0, 0, 950, 952, 3, 104, 52, 0, 951, 949, 1, 0, 0, 0, 951, 952, 1,
+		0, 0, 0, 952, 103, 1, 0, 0, 0, 953, 954, 6, 52, -1, 0, 954, 955, 5, 7,
+		0, 0, 955, 956, 3, 104, 52, 0, 956, 958, 5, 8, 0, 0, 957, 959, 3, 14, 7,
+		0, 958, 957, 1, 0, 0, 0, 958, 959, 1, 0, 0, 0, 959, 1036, 1, 0, 0, 0, 960,
+		961, 7, 0, 0, 0, 961, 1036, 3, 104, 52, 22, 962, 964, 3, 4, 2, 0, 963,
+		965, 3, 14, 7, 0, 964, 963, 1, 0, 0, 0, 964, 965, 1, 0, 0, 0, 965, 1036,
+		1, 0, 0, 0, 966, 973, 3, 112, 56, 0, 967, 968, 5, 123, 0, 0, 968, 969,
+		5, 7, 0, 0, 969, 970, 5, 96, 0, 0, 970, 971, 3, 104, 52, 0, 971, 972, 5,
+		8, 0, 0, 972, 974, 1, 0, 0, 0, 973, 967, 1, 0, 0, 0, 973, 974, 1, 0, 0,
+		0, 974, 975, 1, 0, 0, 0, 975, 978, 5, 120, 0, 0, 976, 979, 3, 106, 53,
+		0, 977, 979, 3, 6, 3, 0, 978, 976, 1, 0, 0, 0, 978, 977, 1, 0, 0, 0, 979,
+		1036, 1, 0, 0, 0, 980, 982, 3, 112, 56, 0, 981, 983, 3, 14, 7, 0, 982,
+		981, 1, 0, 0, 0, 982, 983, 1, 0, 0, 0, 983, 1036, 1, 0, 0, 0, 984, 986,
+		3, 16, 8, 0, 985, 987, 3, 14, 7, 0, 986, 985, 1, 0, 0, 0, 986, 987, 1,
+		0, 0, 0, 987, 1036, 1, 0, 0, 0, 988, 989, 5, 130, 0, 0, 989, 991, 5, 3,
+		0, 0, 990, 992, 3, 110, 55, 0, 991, 990, 1, 0, 0, 0, 991, 992, 1, 0, 0,
+		0, 992, 993, 1, 0, 0, 0, 993, 995, 5, 4, 0, 0, 994, 996, 3, 14, 7, 0, 995,
+		994, 1, 0, 0, 0, 995, 996, 1, 0, 0, 0, 996, 1036, 1, 0, 0, 0, 997, 998,
+		3, 6, 3, 0, 998, 999, 5, 12, 0, 0, 999, 1001, 1, 0, 0, 0, 1000, 997, 1,
+		0, 0, 0, 1000, 1001, 1, 0, 0, 0, 1001, 1002, 1, 0, 0, 0, 1002, 1004, 3,
+		6, 3, 0, 1003, 1005, 3, 14, 7, 0, 1004, 1003, 1, 0, 0, 0, 1004, 1005, 1,
+		0, 0, 0, 1005, 1036, 1, 0, 0, 0, 1006, 1008, 5, 90, 0, 0, 1007, 1009, 3,
+		104, 52, 0, 1008, 1007, 1, 0, 0, 0, 1008, 1009, 1, 0, 0, 0, 1009, 1011,
+		1, 0, 0, 0, 1010, 1012, 3, 108, 54, 0, 1011, 1010, 1, 0, 0, 0, 1012, 1013,
+		1, 0, 0, 0, 1013, 1011, 1, 0, 0, 0, 1013, 1014, 1, 0, 0, 0, 1014, 1017,
+		1, 0, 0, 0, 1015, 1016, 5, 115, 0, 0, 1016, 1018, 3, 104, 52, 0, 1017,
+		1015, 1, 0, 0, 0, 1017, 1018, 1, 0, 0, 0, 1018, 1019, 1, 0, 0, 0, 1019,
+		1020, 5, 93, 0, 0, 1020, 1036, 1, 0, 0, 0, 1021, 1023, 5, 62, 0, 0, 1022,
+		1021, 1, 0, 0, 0, 1022, 1023, 1, 0, 0, 0, 1023, 1024, 1, 0, 0, 0, 1024,
+		1026, 5, 71, 0, 0, 1025, 1022, 1, 0, 0, 0, 1025, 1026, 1, 0, 0, 0, 1026,
+		1027, 1, 0, 0, 0, 1027, 1028, 5, 7, 0, 0, 1028, 1029, 3, 80, 40, 0, 1029,
+		1031, 5, 8, 0, 0, 1030, 1032, 3, 14, 7, 0, 1031, 1030, 1, 0, 0, 0, 1031,
+		1032, 1, 0, 0, 0, 1032, 1036, 1, 0, 0, 0, 1033, 1034, 5, 62, 0, 0, 1034,
+		1036, 3, 104, 52, 3, 1035, 953, 1, 0, 0, 0, 1035, 960, 1, 0, 0, 0, 1035,
+		962, 1, 0, 0, 0, 1035, 966, 1, 0, 0, 0, 1035, 980, 1, 0, 0, 0, 1035, 984,
+		1, 0, 0, 0, 1035, 988, 1, 0, 0, 0, 1035, 1000, 1, 0, 0, 0, 1035, 1006,
+		1, 0, 0, 0, 1035, 1025, 1, 0, 0, 0, 1035, 1033, 1, 0, 0, 0, 1036, 1125,
+		1, 0, 0, 0, 1037, 1038, 10, 20, 0, 0, 1038, 1039, 5, 23, 0, 0, 1039, 1124,
+		3, 104, 52, 21, 1040, 1041, 10, 19, 0, 0, 1041, 1042, 7, 10, 0, 0, 1042,
+		1124, 3, 104, 52, 20, 1043, 1044, 10, 18, 0, 0, 1044, 1045, 7, 0, 0, 0,
+		1045, 1124, 3, 104, 52, 19, 1046, 1047, 10, 9, 0, 0, 1047, 1048, 5, 13,
+		0, 0, 1048, 1124, 3, 104, 52, 10, 1049, 1051, 10, 7, 0, 0, 1050, 1052,
+		5, 62, 0, 0, 1051, 1050, 1, 0, 0, 0, 1051, 1052, 1, 0, 0, 0, 1052, 1053,
+		1, 0, 0, 0, 1053, 1054, 7, 11, 0, 0, 1054, 1124, 3, 104, 52, 8, 1055, 1057,
+		10, 6, 0, 0, 1056, 1058, 5, 62, 0, 0, 1057, 1056, 1, 0, 0, 0, 1057, 1058,
+		1, 0, 0, 0, 1058, 1059, 1, 0, 0, 0, 1059, 1060, 5, 69, 0, 0, 1060, 1061,
+		3, 104, 52, 0, 1061, 1062, 5, 64, 0, 0, 1062, 1063, 3, 104, 52, 7, 1063,
+		1124, 1, 0, 0, 0, 1064, 1065, 10, 5, 0, 0, 1065, 1066, 7, 12, 0, 0, 1066,
+		1124, 3, 104, 52, 6, 1067, 1068, 10, 2, 0, 0, 1068, 1069, 5, 64, 0, 0,
+		1069, 1124, 3, 104, 52, 3, 1070, 1071, 10, 1, 0, 0, 1071, 1072, 5, 65,
+		0, 0, 1072, 1124, 3, 104, 52, 2, 1073, 1074, 10, 24, 0, 0, 1074, 1075,
+		5, 12, 0, 0, 1075, 1077, 3, 6, 3, 0, 1076, 1078, 3, 14, 7, 0, 1077, 1076,
+		1, 0, 0, 0, 1077, 1078, 1, 0, 0, 0, 1078, 1124, 1, 0, 0, 0, 1079, 1080,
+		10, 23, 0, 0, 1080, 1089, 5, 3, 0, 0, 1081, 1090, 3, 104, 52, 0, 1082,
+		1084, 3, 104, 52, 0, 1083, 1082, 1, 0, 0, 0, 1083, 1084, 1, 0, 0, 0, 1084,
+		1085, 1, 0, 0, 0, 1085, 1087, 5, 5, 0, 0, 1086, 1088, 3, 104, 52, 0, 1087,
+		1086, 1, 0, 0, 0, 1087, 1088, 1, 0, 0, 0, 1088, 1090, 1, 0, 0, 0, 1089,
+		1081, 1, 0, 0, 0, 1089, 1083, 1, 0, 0, 0, 1090, 1091, 1, 0, 0, 0, 1091,
+		1093, 5, 4, 0, 0, 1092, 1094, 3, 14, 7, 0, 1093, 1092, 1, 0, 0, 0, 1093,
+		1094, 1, 0, 0, 0, 1094, 1124, 1, 0, 0, 0, 1095, 1096, 10, 21, 0, 0, 1096,
+		1097, 5, 97, 0, 0, 1097, 1124, 3, 6, 3, 0, 1098, 1100, 10, 8, 0, 0, 1099,
+		1101, 5, 62, 0, 0, 1100, 1099, 1, 0, 0, 0, 1100, 1101, 1, 0, 0, 0, 1101,
+		1102, 1, 0, 0, 0, 1102, 1103, 5, 68, 0, 0, 1103, 1106, 5, 7, 0, 0, 1104,
+		1107, 3, 110, 55, 0, 1105, 1107, 3, 80, 40, 0, 1106, 1104, 1, 0, 0, 0,
+		1106, 1105, 1, 0, 0, 0, 1107, 1108, 1, 0, 0, 0, 1108, 1109, 5, 8, 0, 0,
+		1109, 1124, 1, 0, 0, 0, 1110, 1111, 10, 4, 0, 0, 1111, 1113, 5, 70, 0,
+		0, 1112, 1114, 5, 62, 0, 0, 1113, 1112, 1, 0, 0, 0, 1113, 1114, 1, 0, 0,
+		0, 1114, 1121, 1, 0, 0, 0, 1115, 1116, 5, 94, 0, 0, 1116, 1117, 5, 95,
+		0, 0, 1117, 1122, 3, 104, 52, 0, 1118, 1122, 5, 57, 0, 0, 1119, 1122, 5,
+		138, 0, 0, 1120, 1122, 5, 139, 0, 0, 1121, 1115, 1, 0, 0, 0, 1121, 1118,
+		1, 0, 0, 0, 1121, 1119, 1, 0, 0, 0, 1121, 1120, 1, 0, 0, 0, 1122, 1124,
+		1, 0, 0, 0, 1123, 1037, 1, 0, 0, 0, 1123, 1040, 1, 0, 0, 0, 1123, 1043,
+		1, 0, 0, 0, 1123, 1046, 1, 0, 0, 0, 1123, 1049, 1, 0, 0, 0, 1123, 1055,
+		1, 0, 0, 0, 1123, 1064, 1, 0, 0, 0, 1123, 1067, 1, 0, 0, 0, 1123, 1070,
+		1, 0, 0, 0, 1123, 1073, 1, 0, 0, 0, 1123, 1079, 1, 0, 0, 0, 1123, 1095,
+		1, 0, 0, 0, 1123, 1098, 1, 0, 0, 0, 1123, 1110, 1, 0, 0, 0, 1124, 1127,
+		1, 0, 0, 0, 1125, 1123, 1, 0, 0, 0, 1125, 1126, 1, 0, 0, 0, 1126, 105,
+		1, 0, 0, 0, 1127, 1125, 1, 0, 0, 0, 1128, 1132, 5, 7, 0, 0, 1129, 1130,
+		5, 121, 0, 0, 1130, 1131, 5, 84, 0, 0, 1131, 1133, 3, 110, 55, 0, 1132,
+		1129, 1, 0, 0, 0, 1132, 1133, 1, 0, 0, 0, 1133, 1144, 1, 0, 0, 0, 1134,
+		1135, 5, 83, 0, 0, 1135, 1136, 5, 84, 0, 0, 1136, 1141, 3, 84, 42, 0, 1137,
+		1138, 5, 9, 0, 0, 1138, 1140, 3, 84, 42, 0, 1139, 1137, 1, 0, 0, 0, 1140,
+		1143, 1, 0, 0, 0, 1141, 1139, 1, 0, 0, 0, 1141, 1142, 1, 0, 0, 0, 1142,
+		1145, 1, 0, 0, 0, 1143, 1141, 1, 0, 0, 0, 1144, 1134, 1, 0, 0, 0, 1144,
+		1145, 1, 0, 0, 0, 1145, 1146, 1, 0, 0, 0, 1146, 1147, 5, 8, 0, 0, 1147,
+		107, 1, 0, 0, 0, 1148, 1149, 5, 91, 0, 0, 1149, 1150, 3, 104, 52, 0, 1150,
+		1151, 5, 92, 0, 0, 1151, 1152, 3, 104, 52, 0, 1152, 109, 1, 0, 0, 0, 1153,
+		1158, 3, 104, 52, 0, 1154, 1155, 5, 9, 0, 0, 1155, 1157, 3, 104, 52, 0,
+		1156, 1154, 1, 0, 0, 0, 1157, 1160, 1, 0, 0, 0, 1158, 1156, 1, 0, 0, 0,
+		1158, 1159, 1, 0, 0, 0, 1159, 111, 1, 0, 0, 0, 1160, 1158, 1, 0, 0, 0,
+		1161, 1162, 3, 6, 3, 0, 1162, 1168, 5, 7, 0, 0, 1163, 1165, 5, 94, 0, 0,
+		1164, 1163, 1, 0, 0, 0, 1164, 1165, 1, 0, 0, 0, 1165, 1166, 1, 0, 0, 0,
+		1166, 1169, 3, 110, 55, 0, 1167, 1169, 5, 14, 0, 0, 1168, 1164, 1, 0, 0,
+		0, 1168, 1167, 1, 0, 0, 0, 1168, 1169, 1, 0, 0, 0, 1169, 1170, 1, 0, 0,
+		0, 1170, 1171, 5, 8, 0, 0, 1171, 113, 1, 0, 0, 0, 1172, 1173, 6, 57, -1,
+		0, 1173, 1174, 5, 7, 0, 0, 1174, 1175, 3, 114, 57, 0, 1175, 1177, 5, 8,
+		0, 0, 1176, 1178, 3, 14, 7, 0, 1177, 1176, 1, 0, 0, 0, 1177, 1178, 1, 0,
+		0, 0, 1178, 1207, 1, 0, 0, 0, 1179, 1180, 7, 13, 0, 0, 1180, 1207, 3, 114,
+		57, 14, 1181, 1183, 3, 4, 2, 0, 1182, 1184, 3, 14, 7, 0, 1183, 1182, 1,
+		0, 0, 0, 1183, 1184, 1, 0, 0, 0, 1184, 1207, 1, 0, 0, 0, 1185, 1187, 3,
+		122, 61, 0, 1186, 1188, 3, 14, 7, 0, 1187, 1186, 1, 0, 0, 0, 1187, 1188,
+		1, 0, 0, 0, 1188, 1207, 1, 0, 0, 0, 1189, 1191, 3, 16, 8, 0, 1190, 1192,
+		3, 14, 7, 0, 1191, 1190, 1, 0, 0, 0, 1191, 1192, 1, 0, 0, 0, 1192, 1207,
+		1, 0, 0, 0, 1193, 1195, 5, 130, 0, 0, 1194, 1193, 1, 0, 0, 0, 1194, 1195,
+		1, 0, 0, 0, 1195, 1196, 1, 0, 0, 0, 1196, 1198, 5, 3, 0, 0, 1197, 1199,
+		3, 116, 58, 0, 1198, 1197, 1, 0, 0, 0, 1198, 1199, 1, 0, 0, 0, 1199, 1200,
+		1, 0, 0, 0, 1200, 1202, 5, 4, 0, 0, 1201, 1203, 3, 14, 7, 0, 1202, 1201,
+		1, 0, 0, 0, 1202, 1203, 1, 0, 0, 0, 1203, 1207, 1, 0, 0, 0, 1204, 1205,
+		5, 62, 0, 0, 1205, 1207, 3, 114, 57, 3, 1206, 1172, 1, 0, 0, 0, 1206, 1179,
+		1, 0, 0, 0, 1206, 1181, 1, 0, 0, 0, 1206, 1185, 1, 0, 0, 0, 1206, 1189,
+		1, 0, 0, 0, 1206, 1194, 1, 0, 0, 0, 1206, 1204, 1, 0, 0, 0, 1207, 1266,
+		1, 0, 0, 0, 1208, 1209, 10, 13, 0, 0, 1209, 1210, 5, 23, 0, 0, 1210, 1265,
+		3, 114, 57, 14, 1211, 1212, 10, 12, 0, 0, 1212, 1213, 7, 10, 0, 0, 1213,
+		1265, 3, 114, 57, 13, 1214, 1215, 10, 11, 0, 0, 1215, 1216, 7, 0, 0, 0,
+		1216, 1265, 3, 114, 57, 12, 1217, 1218, 10, 6, 0, 0, 1218, 1219, 5, 13,
+		0, 0, 1219, 1265, 3, 114, 57, 7, 1220, 1221, 10, 5, 0, 0, 1221, 1222, 7,
+		12, 0, 0, 1222, 1265, 3, 114, 57, 6, 1223, 1224, 10, 2, 0, 0, 1224, 1225,
+		5, 64, 0, 0, 1225, 1265, 3, 114, 57, 3, 1226, 1227, 10, 1, 0, 0, 1227,
+		1228, 5, 65, 0, 0, 1228, 1265, 3, 114, 57, 2, 1229, 1230, 10, 16, 0, 0,
+		1230, 1231, 5, 12, 0, 0, 1231, 1233, 3, 6, 3, 0, 1232, 1234, 3, 14, 7,
+		0, 1233, 1232, 1, 0, 0, 0, 1233, 1234, 1, 0, 0, 0, 1234, 1265, 1, 0, 0,
+		0, 1235, 1236, 10, 15, 0, 0, 1236, 1245, 5, 3, 0, 0, 1237, 1246, 3, 114,
+		57, 0, 1238, 1240, 3, 114, 57, 0, 1239, 1238, 1, 0, 0, 0, 1239, 1240, 1,
+		0, 0, 0, 1240, 1241, 1, 0, 0, 0, 1241, 1243, 5, 5, 0, 0, 1242, 1244, 3,
+		114, 57, 0, 1243, 1242, 1, 0, 0, 0, 1243, 1244, 1, 0, 0, 0, 1244, 1246,
+		1, 0, 0, 0, 1245, 1237, 1, 0, 0, 0, 1245, 1239, 1, 0, 0, 0, 1246, 1247,
+		1, 0, 0, 0, 1247, 1249, 5, 4, 0, 0, 1248, 1250, 3, 14, 7, 0, 1249, 1248,
+		1, 0, 0, 0, 1249, 1250, 1, 0, 0, 0, 1250, 1265, 1, 0, 0, 0, 1251, 1252,
+		10, 4, 0, 0, 1252, 1254, 5, 70, 0, 0, 1253, 1255, 5, 62, 0, 0, 1254, 1253,
+		1, 0, 0, 0, 1254, 1255, 1, 0, 0, 0, 1255, 1262, 1, 0, 0, 0, 1256, 1257,
+		5, 94, 0, 0, 1257, 1258, 5, 95, 0, 0, 1258, 1263, 3, 114, 57, 0, 1259,
+		1263, 5, 57, 0, 0, 1260, 1263, 5, 138, 0, 0, 1261, 1263, 5, 139, 0, 0,
+		1262, 1256, 1, 0, 0, 0, 1262, 1259, 1, 0, 0, 0, 1262, 1260, 1, 0, 0, 0,
+		1262, 1261, 1, 0, 0, 0, 1263, 1265, 1, 0, 0, 0, 1264, 1208, 1, 0, 0, 0,
+		1264, 1211, 1, 0, 0, 0, 1264, 1214, 1, 0, 0, 0, 1264, 1217, 1, 0, 0, 0,
+		1264, 1220, 1, 0, 0, 0, 1264, 1223, 1, 0, 0, 0, 1264, 1226, 1, 0, 0, 0,
+		1264, 1229, 1, 0, 0, 0, 1264, 1235, 1, 0, 0, 0, 1264, 1251, 1, 0, 0, 0,
+		1265, 1268, 1, 0, 0, 0, 1266, 1264, 1, 0, 0, 0, 1266, 1267, 1, 0, 0, 0,
+		1267, 115, 1, 0, 0, 0, 1268, 1266, 1, 0, 0, 0, 1269, 1274, 3, 114, 57,
+		0, 1270, 1271, 5, 9, 0, 0, 1271, 1273, 3, 114, 57, 0, 1272, 1270, 1, 0,
+		0, 0, 1273, 1276, 1, 0, 0, 0, 1274, 1272, 1, 0, 0, 0, 1274, 1275, 1, 0,
+		0, 0, 1275, 117, 1, 0, 0, 0, 1276, 1274, 1, 0, 0, 0, 1277, 1278, 5, 149,
+		0, 0, 1278, 1279, 3, 12, 6, 0, 1279, 1280, 5, 6, 0, 0, 1280, 1370, 1, 0,
+		0, 0, 1281, 1286, 3, 120, 60, 0, 1282, 1283, 5, 9, 0, 0, 1283, 1285, 3,
+		120, 60, 0, 1284, 1282, 1, 0, 0, 0, 1285, 1288, 1, 0, 0, 0, 1286, 1284,
+		1, 0, 0, 0, 1286, 1287, 1, 0, 0, 0, 1287, 1289, 1, 0, 0, 0, 1288, 1286,
+		1, 0, 0, 0, 1289, 1290, 7, 14, 0, 0, 1290, 1292, 1, 0, 0, 0, 1291, 1281,
+		1, 0, 0, 0, 1291, 1292, 1, 0, 0, 0, 1292, 1293, 1, 0, 0, 0, 1293, 1294,
+		3, 122, 61, 0, 1294, 1295, 5, 6, 0, 0, 1295, 1370, 1, 0, 0, 0, 1296, 1298,
+		3, 114, 57, 0, 1297, 1299, 3, 12, 6, 0, 1298, 1297, 1, 0, 0, 0, 1298, 1299,
+		1, 0, 0, 0, 1299, 1300, 1, 0, 0, 0, 1300, 1301, 7, 14, 0, 0, 1301, 1302,
+		3, 114, 57, 0, 1302, 1303, 5, 6, 0, 0, 1303, 1370, 1, 0, 0, 0, 1304, 1305,
+		5, 112, 0, 0, 1305, 1306, 5, 149, 0, 0, 1306, 1313, 5, 68, 0, 0, 1307,
+		1314, 3, 126, 63, 0, 1308, 1314, 3, 32, 16, 0, 1309, 1311, 5, 130, 0, 0,
+		1310, 1309, 1, 0, 0, 0, 1310, 1311, 1, 0, 0, 0, 1311, 1312, 1, 0, 0, 0,
+		1312, 1314, 3, 114, 57, 0, 1313, 1307, 1, 0, 0, 0, 1313, 1308, 1, 0, 0,
+		0, 1313, 1310, 1, 0, 0, 0, 1314, 1315, 1, 0, 0, 0, 1315, 1319, 5, 1, 0,
+		0, 1316, 1318, 3, 118, 59, 0, 1317, 1316, 1, 0, 0, 0, 1318, 1321, 1, 0,
+		0, 0, 1319, 1317, 1, 0, 0, 0, 1319, 1320, 1, 0, 0, 0, 1320, 1322, 1, 0,
+		0, 0, 1321, 1319, 1, 0, 0, 0, 1322, 1324, 5, 2, 0, 0, 1323, 1325, 5, 6,
+		0, 0, 1324, 1323, 1, 0, 0, 0, 1324, 1325, 1, 0, 0, 0, 1325, 1370, 1, 0,
+		0, 0, 1326, 1327, 5, 113, 0, 0, 1327, 1336, 3, 124, 62, 0, 1328, 1332,
+		5, 114, 0, 0, 1329, 1330, 5, 115, 0, 0, 1330, 1332, 5, 113, 0, 0, 1331,
+		1328, 1, 0, 0, 0, 1331, 1329, 1, 0, 0, 0, 1332, 1333, 1, 0, 0, 0, 1333,
+		1335, 3, 124, 62, 0, 1334, 1331, 1, 0, 0, 0, 1335, 1338, 1, 0, 0, 0, 1336,
+		1334, 1, 0, 0, 0, 1336, 1337, 1, 0, 0, 0, 1337, 1348, 1, 0, 0, 0, 1338,
+		1336, 1, 0, 0, 0, 1339, 1340, 5, 115, 0, 0, 1340, 1344, 5, 1, 0, 0, 1341,
+		1343, 3, 118, 59, 0, 1342, 1341, 1, 0, 0, 0, 1343, 1346, 1, 0, 0, 0, 1344,
+		1342, 1, 0, 0, 0, 1344, 1345, 1, 0, 0, 0, 1345, 1347, 1, 0, 0, 0, 1346,
+		1344, 1, 0, 0, 0, 1347, 1349, 5, 2, 0, 0, 1348, 1339, 1, 0, 0, 0, 1348,
+		1349, 1, 0, 0, 0, 1349, 1351, 1, 0, 0, 0, 1350, 1352, 5, 6, 0, 0, 1351,
+		1350, 1, 0, 0, 0, 1351, 1352, 1, 0, 0, 0, 1352, 1370, 1, 0, 0, 0, 1353,
+		1354, 3, 32, 16, 0, 1354, 1355, 5, 6, 0, 0, 1355, 1370, 1, 0, 0, 0, 1356,
+		1357, 7, 15, 0, 0, 1357, 1370, 5, 6, 0, 0, 1358, 1361, 5, 118, 0, 0, 1359,
+		1362, 3, 116, 58, 0, 1360, 1362, 3, 32, 16, 0, 1361, 1359, 1, 0, 0, 0,
+		1361, 1360, 1, 0, 0, 0, 1361, 1362, 1, 0, 0, 0, 1362, 1363, 1, 0, 0, 0,
+		1363, 1370, 5, 6, 0, 0, 1364, 1365, 5, 118, 0, 0, 1365, 1366, 5, 119, 0,
+		0, 1366, 1367, 3, 116, 58, 0, 1367, 1368, 5, 6, 0, 0, 1368, 1370, 1, 0,
+		0, 0, 1369, 1277, 1, 0, 0, 0, 1369, 1291, 1, 0, 0, 0, 1369, 1296, 1, 0,
+		0, 0, 1369, 1304, 1, 0, 0, 0, 1369, 1326, 1, 0, 0, 0, 1369, 1353, 1, 0,
+		0, 0, 1369, 1356, 1, 0, 0, 0, 1369, 1358, 1, 0, 0, 0, 1369, 1364, 1, 0,
+		0, 0, 1370, 119, 1, 0, 0, 0, 1371, 1372, 7, 16, 0, 0, 1372, 121, 1, 0,
+		0, 0, 1373, 1374, 3, 6, 3, 0, 1374, 1375, 5, 12, 0, 0, 1375, 1377, 1, 0,
+		0, 0, 1376, 1373, 1, 0, 0, 0, 1376, 1377, 1, 0, 0, 0, 1377, 1378, 1, 0,
+		0, 0, 1378, 1379, 3, 6, 3, 0, 1379, 1388, 5, 7, 0, 0, 1380, 1385, 3, 130,
+		65, 0, 1381, 1382, 5, 9, 0, 0, 1382, 1384, 3, 130, 65, 0, 1383, 1381, 1,
+		0, 0, 0, 1384, 1387, 1, 0, 0, 0, 1385, 1383, 1, 0, 0, 0, 1385, 1386, 1,
+		0, 0, 0, 1386, 1389, 1, 0, 0, 0, 1387, 1385, 1, 0, 0, 0, 1388, 1380, 1,
+		0, 0, 0, 1388, 1389, 1, 0, 0, 0, 1389, 1390, 1, 0, 0, 0, 1390, 1391, 5,
+		8, 0, 0, 1391, 123, 1, 0, 0, 0, 1392, 1393, 3, 114, 57, 0, 1393, 1397,
+		5, 1, 0, 0, 1394, 1396, 3, 118, 59, 0, 1395, 1394, 1, 0, 0, 0, 1396, 1399,
+		1, 0, 0, 0, 1397, 1395, 1, 0, 0, 0, 1397, 1398, 1, 0, 0, 0, 1398, 1400,
+		1, 0, 0, 0, 1399, 1397, 1, 0, 0, 0, 1400, 1401, 5, 2, 0, 0, 1401, 125,
+		1, 0, 0, 0, 1402, 1403, 3, 114, 57, 0, 1403, 1404, 5, 32, 0, 0, 1404, 1405,
+		3, 114, 57, 0, 1405, 127, 1, 0, 0, 0, 1406, 1407, 5, 149, 0, 0, 1407, 1410,
+		3, 12, 6, 0, 1408, 1409, 5, 56, 0, 0, 1409, 1411, 3, 114, 57, 0, 1410,
+		1408, 1, 0, 0, 0, 1410, 1411, 1, 0, 0, 0, 1411, 129, 1, 0, 0, 0, 1412,
+		1413, 9, 0, 0, 0, 1413, 1414, 5, 15, 0, 0, 1414, 1415, 5, 27, 0, 0, 1415,
+		1417, 1, 0, 0, 0, 1416, 1412, 1, 0, 0, 0, 1416, 1417, 1, 0, 0, 0, 1417,
+		1418, 1, 0, 0, 0, 1418, 1419, 3, 114, 57, 0, 1419, 131, 1, 0, 0, 0, 1420,
+		1421, 5, 38, 0, 0, 1421, 1425, 5, 148, 0, 0, 1422, 1423, 5, 113, 0, 0,
+		1423, 1424, 5, 62, 0, 0, 1424, 1426, 5, 71, 0, 0, 1425, 1422, 1, 0, 0,
+		0, 1425, 1426, 1, 0, 0, 0, 1426, 1427, 1, 0, 0, 0, 1427, 1428, 3, 6, 3,
+		0, 1428, 1450, 5, 78, 0, 0, 1429, 1430, 5, 7, 0, 0, 1430, 1435, 3, 136,
+		68, 0, 1431, 1432, 5, 9, 0, 0, 1432, 1434, 3, 136, 68, 0, 1433, 1431, 1,
+		0, 0, 0, 1434, 1437, 1, 0, 0, 0, 1435, 1433, 1, 0, 0, 0, 1435, 1436, 1,
+		0, 0, 0, 1436, 1438, 1, 0, 0, 0, 1437, 1435, 1, 0, 0, 0, 1438, 1451, 5,
+		8, 0, 0, 1439, 1440, 5, 148, 0, 0, 1440, 1441, 5, 7, 0, 0, 1441, 1446,
+		5, 137, 0, 0, 1442, 1443, 5, 9, 0, 0, 1443, 1445, 5, 137, 0, 0, 1444, 1442,
+		1, 0, 0, 0, 1445, 1448, 1, 0, 0, 0, 1446, 1444, 1, 0, 0, 0, 1446, 1447,
+		1, 0, 0, 0, 1447, 1449, 1, 0, 0, 0, 1448, 1446, 1, 0, 0, 0, 1449, 1451,
+		5, 8, 0, 0, 1450, 1429, 1, 0, 0, 0, 1450, 1439, 1, 0, 0, 0, 1451, 133,
+		1, 0, 0, 0, 1452, 1453, 5, 42, 0, 0, 1453, 1456, 5, 148, 0, 0, 1454, 1455,
+		5, 113, 0, 0, 1455, 1457, 5, 71, 0, 0, 1456, 1454, 1, 0, 0, 0, 1456, 1457,
+		1, 0, 0, 0, 1457, 1458, 1, 0, 0, 0, 1458, 1459, 3, 6, 3, 0, 1459, 135,
+		1, 0, 0, 0, 1460, 1461, 3, 6, 3, 0, 1461, 1462, 3, 12, 6, 0, 1462, 137,
+		1, 0, 0, 0, 1463, 1464, 5, 38, 0, 0, 1464, 1468, 5, 148, 0, 0, 1465, 1466,
+		5, 113, 0, 0, 1466, 1467, 5, 62, 0, 0, 1467, 1469, 5, 71, 0, 0, 1468, 1465,
+		1, 0, 0, 0, 1468, 1469, 1, 0, 0, 0, 1469, 1470, 1, 0, 0, 0, 1470, 1471,
+		3, 6, 3, 0, 1471, 1472, 5, 148, 0, 0, 1472, 1473, 5, 140, 0, 0, 1473, 1474,
+		5, 148, 0, 0, 1474, 1475, 5, 136, 0, 0, 1475, 1476, 3, 122, 61, 0, 1476,
+		139, 1, 0, 0, 0, 204, 145, 149, 157, 180, 184, 188, 196, 203, 212, 220,
+		223, 227, 239, 247, 258, 274, 286, 292, 300, 302, 306, 316, 320, 327, 330,
+		336, 345, 348, 351, 363, 369, 374, 378, 385, 410, 418, 422, 432, 443, 452,
+		459, 468, 486, 489, 493, 499, 502, 514, 523, 531, 539, 543, 547, 553, 558,
+		562, 566, 572, 579, 586, 594, 600, 609, 612, 618, 622, 628, 637, 645, 659,
+		662, 665, 674, 681, 689, 705, 715, 718, 722, 726, 730, 734, 738, 742, 746,
+		753, 761, 764, 768, 775, 777, 790, 793, 798, 802, 805, 811, 814, 816, 819,
+		828, 831, 836, 839, 844, 847, 855, 863, 866, 870, 880, 883, 889, 902, 906,
+		909, 918, 920, 931, 936, 938, 944, 947, 951, 958, 964, 973, 978, 982, 986,
+		991, 995, 1000, 1004, 1008, 1013, 1017, 1022, 1025, 1031, 1035, 1051, 1057,
+		1077, 1083, 1087, 1089, 1093, 1100, 1106, 1113, 1121, 1123, 1125, 1132,
+		1141, 1144, 1158, 1164, 1168, 1177, 1183, 1187, 1191, 1194, 1198, 1202,
+		1206, 1233, 1239, 1243, 1245, 1249, 1254, 1262, 1264, 1266, 1274, 1286,
+		1291, 1298, 1310, 1313, 1319, 1324, 1331, 1336, 1344, 1348, 1351, 1361,
+		1369, 1376, 1385, 1388, 1397, 1410, 1416, 1425, 1435, 1446, 1450, 1456,
+		1468,
 	}
 	deserializer := antlr.NewATNDeserializer(nil)
 	staticData.atn = deserializer.Deserialize(staticData.serializedATN)
@@ -1047,6 +1092,12 @@ const (
 	KuneiformParserRULE_action_function_call            = 61
 	KuneiformParserRULE_if_then_block                   = 62
 	KuneiformParserRULE_range                           = 63
+	KuneiformParserRULE_action_parameter                = 64
+	KuneiformParserRULE_action_argument                 = 65
+	KuneiformParserRULE_create_type_statement           = 66
+	KuneiformParserRULE_drop_object_statement           = 67
+	KuneiformParserRULE_type_field                      = 68
+	KuneiformParserRULE_create_schedule_statement       = 69
 )
 
 // IEntryContext is an interface to support dynamic dispatch.
@@ -1179,10 +1230,10 @@ func (p *KuneiformParser) Entry() (localctx IEntryContext) {
 
 	p.EnterOuterAlt(localctx, 1)
 	{
-		p.SetState(128)
+		p.SetState(140)
 		p.Statement()
 	}
-	p.SetState(133)
+	p.SetState(145)
 	p.GetErrorHandler().Sync(p)
 	if p.HasError() {
 		goto errorExit
@@ -1194,7 +1245,7 @@ func (p *KuneiformParser) Entry() (localctx IEntryContext) {
 	for _alt != 2 && _alt != antlr.ATNInvalidAltNumber {
 		if _alt == 1 {
 			{
-				p.SetState(129)
+				p.SetState(141)
 				p.Match(KuneiformParserSCOL)
 				if p.HasError() {
 					// Recognition error - abort rule
@@ -1202,12 +1253,12 @@ func (p *KuneiformParser) Entry() (localctx IEntryContext) {
 				}
 			}
 			{
-				p.SetState(130)
+				p.SetState(142)
 				p.Statement()
 			}
 
 		}
-		p.SetState(135)
+		p.SetState(147)
 		p.GetErrorHandler().Sync(p)
 		if p.HasError() {
 			goto errorExit
@@ -1217,7 +1268,7 @@ func (p *KuneiformParser) Entry() (localctx IEntryContext) {
 			goto errorExit
 		}
 	}
-	p.SetState(137)
+	p.SetState(149)
 	p.GetErrorHandler().Sync(p)
 	if p.HasError() {
 		goto errorExit
@@ -1226,7 +1277,7 @@ func (p *KuneiformParser) Entry() (localctx IEntryContext) {
 
 	if _la == KuneiformParserSCOL {
 		{
-			p.SetState(136)
+			p.SetState(148)
 			p.Match(KuneiformParserSCOL)
 			if p.HasError() {
 				// Recognition error - abort rule
@@ -1236,7 +1287,7 @@ func (p *KuneiformParser) Entry() (localctx IEntryContext) {
 
 	}
 	{
-		p.SetState(139)
+		p.SetState(151)
 		p.Match(KuneiformParserEOF)
 		if p.HasError() {
 			// Recognition error - abort rule
@@ -1289,6 +1340,9 @@ type IStatementContext interface {
 	Create_namespace_statement() ICreate_namespace_statementContext
 	Drop_namespace_statement() IDrop_namespace_statementContext
 	Set_current_namespace_statement() ISet_current_namespace_statementContext
+	Create_type_statement() ICreate_type_statementContext
+	Drop_object_statement() IDrop_object_statementContext
+	Create_schedule_statement() ICreate_schedule_statementContext
 	LBRACE() antlr.TerminalNode
 	RBRACE() antlr.TerminalNode
 	Identifier() IIdentifierContext
@@ -1622,6 +1676,54 @@ func (s *StatementContext) Set_current_namespace_statement() ISet_current_namesp
 	return t.(ISet_current_namespace_statementContext)
 }
 
+func (s *StatementContext) Create_type_statement() ICreate_type_statementContext {
+	var t antlr.RuleContext
+	for _, ctx := range s.GetChildren() {
+		if _, ok := ctx.(ICreate_type_statementContext); ok {
+			t = ctx.(antlr.RuleContext)
+			break
+		}
+	}
+
+	if t == nil {
+		return nil
+	}
+
+	return t.(ICreate_type_statementContext)
+}
+
+func (s *StatementContext) Drop_object_statement() IDrop_object_statementContext {
+	var t antlr.RuleContext
+	for _, ctx := range s.GetChildren() {
+		if _, ok := ctx.(IDrop_object_statementContext); ok {
+			t = ctx.(antlr.RuleContext)
+			break
+		}
+	}
+
+	if t == nil {
+		return nil
+	}
+
+	return t.(IDrop_object_statementContext)
+}
+
+func (s *StatementContext) Create_schedule_statement() ICreate_schedule_statementContext {
+	var t antlr.RuleContext
+	for _, ctx := range s.GetChildren() {
+		if _, ok := ctx.(ICreate_schedule_statementContext); ok {
+			t = ctx.(antlr.RuleContext)
+			break
+		}
+	}
+
+	if t == nil {
+		return nil
+	}
+
+	return t.(ICreate_schedule_statementContext)
+}
+
 func (s *StatementContext) LBRACE() antlr.TerminalNode {
 	return s.GetToken(KuneiformParserLBRACE, 0)
 }
@@ -1670,7 +1772,7 @@ func (p *KuneiformParser) Statement() (localctx IStatementContext) {
 	var _la int
 
 	p.EnterOuterAlt(localctx, 1)
-	p.SetState(145)
+	p.SetState(157)
 	p.GetErrorHandler().Sync(p)
 	if p.HasError() {
 		goto errorExit
@@ -1679,7 +1781,7 @@ func (p *KuneiformParser) Statement() (localctx IStatementContext) {
 
 	if _la == KuneiformParserLBRACE {
 		{
-			p.SetState(141)
+			p.SetState(153)
 			p.Match(KuneiformParserLBRACE)
 			if p.HasError() {
 				// Recognition error - abort rule
@@ -1687,14 +1789,14 @@ func (p *KuneiformParser) Statement() (localctx IStatementContext) {
 			}
 		}
 		{
-			p.SetState(142)
+			p.SetState(154)
 
 			var _x = p.Identifier()
 
 			localctx.(*StatementContext).namespace = _x
 		}
 		{
-			p.SetState(143)
+			p.SetState(155)
 			p.Match(KuneiformParserRBRACE)
 			if p.HasError() {
 				// Recognition error - abort rule
@@ -1703,7 +1805,7 @@ func (p *KuneiformParser) Statement() (localctx IStatementContext) {
 		}
 
 	}
-	p.SetState(165)
+	p.SetState(180)
 	p.GetErrorHandler().Sync(p)
 	if p.HasError() {
 		goto errorExit
@@ -1712,112 +1814,130 @@ func (p *KuneiformParser) Statement() (localctx IStatementContext) {
 	switch p.GetInterpreter().AdaptivePredict(p.BaseParser, p.GetTokenStream(), 3, p.GetParserRuleContext()) {
 	case 1:
 		{
-			p.SetState(147)
+			p.SetState(159)
 			p.Sql_statement()
 		}
 
 	case 2:
 		{
-			p.SetState(148)
+			p.SetState(160)
 			p.Create_table_statement()
 		}
 
 	case 3:
 		{
-			p.SetState(149)
+			p.SetState(161)
 			p.Alter_table_statement()
 		}
 
 	case 4:
 		{
-			p.SetState(150)
+			p.SetState(162)
 			p.Drop_table_statement()
 		}
 
 	case 5:
 		{
-			p.SetState(151)
+			p.SetState(163)
 			p.Create_index_statement()
 		}
 
 	case 6:
 		{
-			p.SetState(152)
+			p.SetState(164)
 			p.Drop_index_statement()
 		}
 
 	case 7:
 		{
-			p.SetState(153)
+			p.SetState(165)
 			p.Create_role_statement()
 		}
 
 	case 8:
 		{
-			p.SetState(154)
+			p.SetState(166)
 			p.Drop_role_statement()
 		}
 
 	case 9:
 		{
-			p.SetState(155)
+			p.SetState(167)
 			p.Grant_statement()
 		}
 
 	case 10:
 		{
-			p.SetState(156)
+			p.SetState(168)
 			p.Revoke_statement()
 		}
 
 	case 11:
 		{
-			p.SetState(157)
+			p.SetState(169)
 			p.Transfer_ownership_statement()
 		}
 
 	case 12:
 		{
-			p.SetState(158)
+			p.SetState(170)
 			p.Create_action_statement()
 		}
 
 	case 13:
 		{
-			p.SetState(159)
+			p.SetState(171)
 			p.Drop_action_statement()
 		}
 
 	case 14:
 		{
-			p.SetState(160)
+			p.SetState(172)
 			p.Use_extension_statement()
 		}
 
 	case 15:
 		{
-			p.SetState(161)
+			p.SetState(173)
 			p.Unuse_extension_statement()
 		}
 
 	case 16:
 		{
-			p.SetState(162)
+			p.SetState(174)
 			p.Create_namespace_statement()
 		}
 
 	case 17:
 		{
-			p.SetState(163)
+			p.SetState(175)
 			p.Drop_namespace_statement()
 		}
 
 	case 18:
 		{
-			p.SetState(164)
+			p.SetState(176)
 			p.Set_current_namespace_statement()
 		}
 
+	case 19:
+		{
+			p.SetState(177)
+			p.Create_type_statement()
+		}
+
+	case 20:
+		{
+			p.SetState(178)
+			p.Drop_object_statement()
+		}
+
+	case 21:
+		{
+			p.SetState(179)
+			p.Create_schedule_statement()
+		}
+
 	case antlr.ATNInvalidAltNumber:
 		goto errorExit
 	}
@@ -2114,7 +2234,7 @@ func (p *KuneiformParser) Literal() (localctx ILiteralContext) {
 	p.EnterRule(localctx, 4, KuneiformParserRULE_literal)
 	var _la int
 
-	p.SetState(181)
+	p.SetState(196)
 	p.GetErrorHandler().Sync(p)
 	if p.HasError() {
 		goto errorExit
@@ -2125,7 +2245,7 @@ func (p *KuneiformParser) Literal() (localctx ILiteralContext) {
 		localctx = NewString_literalContext(p, localctx)
 		p.EnterOuterAlt(localctx, 1)
 		{
-			p.SetState(167)
+			p.SetState(182)
 			p.Match(KuneiformParserSTRING_)
 			if p.HasError() {
 				// Recognition error - abort rule
@@ -2136,7 +2256,7 @@ func (p *KuneiformParser) Literal() (localctx ILiteralContext) {
 	case 2:
 		localctx = NewInteger_literalContext(p, localctx)
 		p.EnterOuterAlt(localctx, 2)
-		p.SetState(169)
+		p.SetState(184)
 		p.GetErrorHandler().Sync(p)
 		if p.HasError() {
 			goto errorExit
@@ -2145,7 +2265,7 @@ func (p *KuneiformParser) Literal() (localctx ILiteralContext) {
 
 		if _la == KuneiformParserPLUS || _la == KuneiformParserMINUS {
 			{
-				p.SetState(168)
+				p.SetState(183)
 				_la = p.GetTokenStream().LA(1)
 
 				if !(_la == KuneiformParserPLUS || _la == KuneiformParserMINUS) {
@@ -2158,7 +2278,7 @@ func (p *KuneiformParser) Literal() (localctx ILiteralContext) {
 
 		}
 		{
-			p.SetState(171)
+			p.SetState(186)
 			p.Match(KuneiformParserDIGITS_)
 			if p.HasError() {
 				// Recognition error - abort rule
@@ -2169,7 +2289,7 @@ func (p *KuneiformParser) Literal() (localctx ILiteralContext) {
 	case 3:
 		localctx = NewDecimal_literalContext(p, localctx)
 		p.EnterOuterAlt(localctx, 3)
-		p.SetState(173)
+		p.SetState(188)
 		p.GetErrorHandler().Sync(p)
 		if p.HasError() {
 			goto errorExit
@@ -2178,7 +2298,7 @@ func (p *KuneiformParser) Literal() (localctx ILiteralContext) {
 
 		if _la == KuneiformParserPLUS || _la == KuneiformParserMINUS {
 			{
-				p.SetState(172)
+				p.SetState(187)
 				_la = p.GetTokenStream().LA(1)
 
 				if !(_la == KuneiformParserPLUS || _la == KuneiformParserMINUS) {
@@ -2191,7 +2311,7 @@ func (p *KuneiformParser) Literal() (localctx ILiteralContext) {
 
 		}
 		{
-			p.SetState(175)
+			p.SetState(190)
 			p.Match(KuneiformParserDIGITS_)
 			if p.HasError() {
 				// Recognition error - abort rule
@@ -2199,7 +2319,7 @@ func (p *KuneiformParser) Literal() (localctx ILiteralContext) {
 			}
 		}
 		{
-			p.SetState(176)
+			p.SetState(191)
 			p.Match(KuneiformParserPERIOD)
 			if p.HasError() {
 				// Recognition error - abort rule
@@ -2207,7 +2327,7 @@ func (p *KuneiformParser) Literal() (localctx ILiteralContext) {
 			}
 		}
 		{
-			p.SetState(177)
+			p.SetState(192)
 			p.Match(KuneiformParserDIGITS_)
 			if p.HasError() {
 				// Recognition error - abort rule
@@ -2219,7 +2339,7 @@ func (p *KuneiformParser) Literal() (localctx ILiteralContext) {
 		localctx = NewBoolean_literalContext(p, localctx)
 		p.EnterOuterAlt(localctx, 4)
 		{
-			p.SetState(178)
+			p.SetState(193)
 			_la = p.GetTokenStream().LA(1)
 
 			if !(_la == KuneiformParserTRUE || _la == KuneiformParserFALSE) {
@@ -2234,7 +2354,7 @@ func (p *KuneiformParser) Literal() (localctx ILiteralContext) {
 		localctx = NewNull_literalContext(p, localctx)
 		p.EnterOuterAlt(localctx, 5)
 		{
-			p.SetState(179)
+			p.SetState(194)
 			p.Match(KuneiformParserNULL)
 			if p.HasError() {
 				// Recognition error - abort rule
@@ -2246,7 +2366,7 @@ func (p *KuneiformParser) Literal() (localctx ILiteralContext) {
 		localctx = NewBinary_literalContext(p, localctx)
 		p.EnterOuterAlt(localctx, 6)
 		{
-			p.SetState(180)
+			p.SetState(195)
 			p.Match(KuneiformParserBINARY_)
 			if p.HasError() {
 				// Recognition error - abort rule
@@ -2364,7 +2484,7 @@ func (s *IdentifierContext) Accept(visitor antlr.ParseTreeVisitor) interface{} {
 func (p *KuneiformParser) Identifier() (localctx IIdentifierContext) {
 	localctx = NewIdentifierContext(p, p.GetParserRuleContext(), p.GetState())
 	p.EnterRule(localctx, 6, KuneiformParserRULE_identifier)
-	p.SetState(188)
+	p.SetState(203)
 	p.GetErrorHandler().Sync(p)
 	if p.HasError() {
 		goto errorExit
@@ -2374,7 +2494,7 @@ func (p *KuneiformParser) Identifier() (localctx IIdentifierContext) {
 	case KuneiformParserDOUBLE_QUOTE:
 		p.EnterOuterAlt(localctx, 1)
 		{
-			p.SetState(183)
+			p.SetState(198)
 			p.Match(KuneiformParserDOUBLE_QUOTE)
 			if p.HasError() {
 				// Recognition error - abort rule
@@ -2382,11 +2502,11 @@ func (p *KuneiformParser) Identifier() (localctx IIdentifierContext) {
 			}
 		}
 		{
-			p.SetState(184)
+			p.SetState(199)
 			p.Allowed_identifier()
 		}
 		{
-			p.SetState(185)
+			p.SetState(200)
 			p.Match(KuneiformParserDOUBLE_QUOTE)
 			if p.HasError() {
 				// Recognition error - abort rule
@@ -2397,7 +2517,7 @@ func (p *KuneiformParser) Identifier() (localctx IIdentifierContext) {
 	case KuneiformParserUSE, KuneiformParserUNUSE, KuneiformParserACTION, KuneiformParserCREATE, KuneiformParserALTER, KuneiformParserADD, KuneiformParserDROP, KuneiformParserRENAME, KuneiformParserCHECK, KuneiformParserFOREIGN, KuneiformParserPRIMARY, KuneiformParserKEY, KuneiformParserUNIQUE, KuneiformParserRESTRICT, KuneiformParserDEFAULT, KuneiformParserINDEX, KuneiformParserRETURNS, KuneiformParserFOR, KuneiformParserIF, KuneiformParserELSEIF, KuneiformParserELSE, KuneiformParserBREAK, KuneiformParserCONTINUE, KuneiformParserRETURN, KuneiformParserGRANT, KuneiformParserGRANTED, KuneiformParserREVOKE, KuneiformParserROLE, KuneiformParserREPLACE, KuneiformParserCURRENT, KuneiformParserNAMESPACE, KuneiformParserTRANSFER, KuneiformParserOWNERSHIP, KuneiformParserROLES, KuneiformParserCALL, KuneiformParserIDENTIFIER:
 		p.EnterOuterAlt(localctx, 2)
 		{
-			p.SetState(187)
+			p.SetState(202)
 			p.Allowed_identifier()
 		}
 
@@ -2669,7 +2789,7 @@ func (p *KuneiformParser) Allowed_identifier() (localctx IAllowed_identifierCont
 
 	p.EnterOuterAlt(localctx, 1)
 	{
-		p.SetState(190)
+		p.SetState(205)
 		_la = p.GetTokenStream().LA(1)
 
 		if !(((int64(_la) & ^0x3f) == 0 && ((int64(1)<<_la)&-9127724506742259712) != 0) || ((int64((_la-87)) & ^0x3f) == 0 && ((int64(1)<<(_la-87))&2306959842411020289) != 0)) {
@@ -2816,10 +2936,10 @@ func (p *KuneiformParser) Identifier_list() (localctx IIdentifier_listContext) {
 
 	p.EnterOuterAlt(localctx, 1)
 	{
-		p.SetState(192)
+		p.SetState(207)
 		p.Identifier()
 	}
-	p.SetState(197)
+	p.SetState(212)
 	p.GetErrorHandler().Sync(p)
 	if p.HasError() {
 		goto errorExit
@@ -2828,7 +2948,7 @@ func (p *KuneiformParser) Identifier_list() (localctx IIdentifier_listContext) {
 
 	for _la == KuneiformParserCOMMA {
 		{
-			p.SetState(193)
+			p.SetState(208)
 			p.Match(KuneiformParserCOMMA)
 			if p.HasError() {
 				// Recognition error - abort rule
@@ -2836,11 +2956,11 @@ func (p *KuneiformParser) Identifier_list() (localctx IIdentifier_listContext) {
 			}
 		}
 		{
-			p.SetState(194)
+			p.SetState(209)
 			p.Identifier()
 		}
 
-		p.SetState(199)
+		p.SetState(214)
 		p.GetErrorHandler().Sync(p)
 		if p.HasError() {
 			goto errorExit
@@ -3005,15 +3125,15 @@ func (p *KuneiformParser) Type_() (localctx ITypeContext) {
 
 	p.EnterOuterAlt(localctx, 1)
 	{
-		p.SetState(200)
+		p.SetState(215)
 		p.Identifier()
 	}
-	p.SetState(208)
+	p.SetState(223)
 	p.GetErrorHandler().Sync(p)
 
 	if p.GetInterpreter().AdaptivePredict(p.BaseParser, p.GetTokenStream(), 10, p.GetParserRuleContext()) == 1 {
 		{
-			p.SetState(201)
+			p.SetState(216)
 			p.Match(KuneiformParserLPAREN)
 			if p.HasError() {
 				// Recognition error - abort rule
@@ -3021,7 +3141,7 @@ func (p *KuneiformParser) Type_() (localctx ITypeContext) {
 			}
 		}
 		{
-			p.SetState(202)
+			p.SetState(217)
 
 			var _m = p.Match(KuneiformParserDIGITS_)
 
@@ -3031,7 +3151,7 @@ func (p *KuneiformParser) Type_() (localctx ITypeContext) {
 				goto errorExit
 			}
 		}
-		p.SetState(205)
+		p.SetState(220)
 		p.GetErrorHandler().Sync(p)
 		if p.HasError() {
 			goto errorExit
@@ -3040,7 +3160,7 @@ func (p *KuneiformParser) Type_() (localctx ITypeContext) {
 
 		if _la == KuneiformParserCOMMA {
 			{
-				p.SetState(203)
+				p.SetState(218)
 				p.Match(KuneiformParserCOMMA)
 				if p.HasError() {
 					// Recognition error - abort rule
@@ -3048,7 +3168,7 @@ func (p *KuneiformParser) Type_() (localctx ITypeContext) {
 				}
 			}
 			{
-				p.SetState(204)
+				p.SetState(219)
 
 				var _m = p.Match(KuneiformParserDIGITS_)
 
@@ -3061,7 +3181,7 @@ func (p *KuneiformParser) Type_() (localctx ITypeContext) {
 
 		}
 		{
-			p.SetState(207)
+			p.SetState(222)
 			p.Match(KuneiformParserRPAREN)
 			if p.HasError() {
 				// Recognition error - abort rule
@@ -3072,12 +3192,12 @@ func (p *KuneiformParser) Type_() (localctx ITypeContext) {
 	} else if p.HasError() { // JIM
 		goto errorExit
 	}
-	p.SetState(212)
+	p.SetState(227)
 	p.GetErrorHandler().Sync(p)
 
 	if p.GetInterpreter().AdaptivePredict(p.BaseParser, p.GetTokenStream(), 11, p.GetParserRuleContext()) == 1 {
 		{
-			p.SetState(210)
+			p.SetState(225)
 			p.Match(KuneiformParserLBRACKET)
 			if p.HasError() {
 				// Recognition error - abort rule
@@ -3085,7 +3205,7 @@ func (p *KuneiformParser) Type_() (localctx ITypeContext) {
 			}
 		}
 		{
-			p.SetState(211)
+			p.SetState(226)
 			p.Match(KuneiformParserRBRACKET)
 			if p.HasError() {
 				// Recognition error - abort rule
@@ -3200,7 +3320,7 @@ func (p *KuneiformParser) Type_cast() (localctx IType_castContext) {
 	p.EnterRule(localctx, 14, KuneiformParserRULE_type_cast)
 	p.EnterOuterAlt(localctx, 1)
 	{
-		p.SetState(214)
+		p.SetState(229)
 		p.Match(KuneiformParserTYPE_CAST)
 		if p.HasError() {
 			// Recognition error - abort rule
@@ -3208,7 +3328,7 @@ func (p *KuneiformParser) Type_cast() (localctx IType_castContext) {
 		}
 	}
 	{
-		p.SetState(215)
+		p.SetState(230)
 		p.Type_()
 	}
 
@@ -3305,7 +3425,7 @@ func (p *KuneiformParser) Variable() (localctx IVariableContext) {
 
 	p.EnterOuterAlt(localctx, 1)
 	{
-		p.SetState(217)
+		p.SetState(232)
 		_la = p.GetTokenStream().LA(1)
 
 		if !(_la == KuneiformParserVARIABLE || _la == KuneiformParserCONTEXTUAL_VARIABLE) {
@@ -3487,17 +3607,17 @@ func (p *KuneiformParser) Table_column_def() (localctx ITable_column_defContext)
 
 	p.EnterOuterAlt(localctx, 1)
 	{
-		p.SetState(219)
+		p.SetState(234)
 
 		var _x = p.Identifier()
 
 		localctx.(*Table_column_defContext).name = _x
 	}
 	{
-		p.SetState(220)
+		p.SetState(235)
 		p.Type_()
 	}
-	p.SetState(224)
+	p.SetState(239)
 	p.GetErrorHandler().Sync(p)
 	if p.HasError() {
 		goto errorExit
@@ -3506,11 +3626,11 @@ func (p *KuneiformParser) Table_column_def() (localctx ITable_column_defContext)
 
 	for (int64(_la) & ^0x3f) == 0 && ((int64(1)<<_la)&5841520560420421632) != 0 {
 		{
-			p.SetState(221)
+			p.SetState(236)
 			p.Inline_constraint()
 		}
 
-		p.SetState(226)
+		p.SetState(241)
 		p.GetErrorHandler().Sync(p)
 		if p.HasError() {
 			goto errorExit
@@ -3654,10 +3774,10 @@ func (p *KuneiformParser) Type_list() (localctx IType_listContext) {
 
 	p.EnterOuterAlt(localctx, 1)
 	{
-		p.SetState(227)
+		p.SetState(242)
 		p.Type_()
 	}
-	p.SetState(232)
+	p.SetState(247)
 	p.GetErrorHandler().Sync(p)
 	if p.HasError() {
 		goto errorExit
@@ -3666,7 +3786,7 @@ func (p *KuneiformParser) Type_list() (localctx IType_listContext) {
 
 	for _la == KuneiformParserCOMMA {
 		{
-			p.SetState(228)
+			p.SetState(243)
 			p.Match(KuneiformParserCOMMA)
 			if p.HasError() {
 				// Recognition error - abort rule
@@ -3674,11 +3794,11 @@ func (p *KuneiformParser) Type_list() (localctx IType_listContext) {
 			}
 		}
 		{
-			p.SetState(229)
+			p.SetState(244)
 			p.Type_()
 		}
 
-		p.SetState(234)
+		p.SetState(249)
 		p.GetErrorHandler().Sync(p)
 		if p.HasError() {
 			goto errorExit
@@ -3865,14 +3985,14 @@ func (p *KuneiformParser) Named_type_list() (localctx INamed_type_listContext) {
 
 	p.EnterOuterAlt(localctx, 1)
 	{
-		p.SetState(235)
+		p.SetState(250)
 		p.Identifier()
 	}
 	{
-		p.SetState(236)
+		p.SetState(251)
 		p.Type_()
 	}
-	p.SetState(243)
+	p.SetState(258)
 	p.GetErrorHandler().Sync(p)
 	if p.HasError() {
 		goto errorExit
@@ -3881,7 +4001,7 @@ func (p *KuneiformParser) Named_type_list() (localctx INamed_type_listContext) {
 
 	for _la == KuneiformParserCOMMA {
 		{
-			p.SetState(237)
+			p.SetState(252)
 			p.Match(KuneiformParserCOMMA)
 			if p.HasError() {
 				// Recognition error - abort rule
@@ -3889,15 +4009,15 @@ func (p *KuneiformParser) Named_type_list() (localctx INamed_type_listContext) {
 			}
 		}
 		{
-			p.SetState(238)
+			p.SetState(253)
 			p.Identifier()
 		}
 		{
-			p.SetState(239)
+			p.SetState(254)
 			p.Type_()
 		}
 
-		p.SetState(245)
+		p.SetState(260)
 		p.GetErrorHandler().Sync(p)
 		if p.HasError() {
 			goto errorExit
@@ -4080,7 +4200,7 @@ func (s *Inline_constraintContext) Accept(visitor antlr.ParseTreeVisitor) interf
 func (p *KuneiformParser) Inline_constraint() (localctx IInline_constraintContext) {
 	localctx = NewInline_constraintContext(p, p.GetParserRuleContext(), p.GetState())
 	p.EnterRule(localctx, 24, KuneiformParserRULE_inline_constraint)
-	p.SetState(259)
+	p.SetState(274)
 	p.GetErrorHandler().Sync(p)
 	if p.HasError() {
 		goto errorExit
@@ -4090,7 +4210,7 @@ func (p *KuneiformParser) Inline_constraint() (localctx IInline_constraintContex
 	case KuneiformParserPRIMARY:
 		p.EnterOuterAlt(localctx, 1)
 		{
-			p.SetState(246)
+			p.SetState(261)
 			p.Match(KuneiformParserPRIMARY)
 			if p.HasError() {
 				// Recognition error - abort rule
@@ -4098,7 +4218,7 @@ func (p *KuneiformParser) Inline_constraint() (localctx IInline_constraintContex
 			}
 		}
 		{
-			p.SetState(247)
+			p.SetState(262)
 			p.Match(KuneiformParserKEY)
 			if p.HasError() {
 				// Recognition error - abort rule
@@ -4109,7 +4229,7 @@ func (p *KuneiformParser) Inline_constraint() (localctx IInline_constraintContex
 	case KuneiformParserUNIQUE:
 		p.EnterOuterAlt(localctx, 2)
 		{
-			p.SetState(248)
+			p.SetState(263)
 			p.Match(KuneiformParserUNIQUE)
 			if p.HasError() {
 				// Recognition error - abort rule
@@ -4120,7 +4240,7 @@ func (p *KuneiformParser) Inline_constraint() (localctx IInline_constraintContex
 	case KuneiformParserNOT:
 		p.EnterOuterAlt(localctx, 3)
 		{
-			p.SetState(249)
+			p.SetState(264)
 			p.Match(KuneiformParserNOT)
 			if p.HasError() {
 				// Recognition error - abort rule
@@ -4128,7 +4248,7 @@ func (p *KuneiformParser) Inline_constraint() (localctx IInline_constraintContex
 			}
 		}
 		{
-			p.SetState(250)
+			p.SetState(265)
 			p.Match(KuneiformParserNULL)
 			if p.HasError() {
 				// Recognition error - abort rule
@@ -4139,7 +4259,7 @@ func (p *KuneiformParser) Inline_constraint() (localctx IInline_constraintContex
 	case KuneiformParserDEFAULT:
 		p.EnterOuterAlt(localctx, 4)
 		{
-			p.SetState(251)
+			p.SetState(266)
 			p.Match(KuneiformParserDEFAULT)
 			if p.HasError() {
 				// Recognition error - abort rule
@@ -4147,21 +4267,21 @@ func (p *KuneiformParser) Inline_constraint() (localctx IInline_constraintContex
 			}
 		}
 		{
-			p.SetState(252)
+			p.SetState(267)
 			p.action_expr(0)
 		}
 
 	case KuneiformParserREFERENCES:
 		p.EnterOuterAlt(localctx, 5)
 		{
-			p.SetState(253)
+			p.SetState(268)
 			p.Fk_constraint()
 		}
 
 	case KuneiformParserCHECK:
 		p.EnterOuterAlt(localctx, 6)
 		{
-			p.SetState(254)
+			p.SetState(269)
 			p.Match(KuneiformParserCHECK)
 			if p.HasError() {
 				// Recognition error - abort rule
@@ -4170,7 +4290,7 @@ func (p *KuneiformParser) Inline_constraint() (localctx IInline_constraintContex
 		}
 
 		{
-			p.SetState(255)
+			p.SetState(270)
 			p.Match(KuneiformParserLPAREN)
 			if p.HasError() {
 				// Recognition error - abort rule
@@ -4178,11 +4298,11 @@ func (p *KuneiformParser) Inline_constraint() (localctx IInline_constraintContex
 			}
 		}
 		{
-			p.SetState(256)
+			p.SetState(271)
 			p.sql_expr(0)
 		}
 		{
-			p.SetState(257)
+			p.SetState(272)
 			p.Match(KuneiformParserRPAREN)
 			if p.HasError() {
 				// Recognition error - abort rule
@@ -4328,7 +4448,7 @@ func (p *KuneiformParser) Fk_action() (localctx IFk_actionContext) {
 
 	p.EnterOuterAlt(localctx, 1)
 	{
-		p.SetState(261)
+		p.SetState(276)
 		p.Match(KuneiformParserON)
 		if p.HasError() {
 			// Recognition error - abort rule
@@ -4336,7 +4456,7 @@ func (p *KuneiformParser) Fk_action() (localctx IFk_actionContext) {
 		}
 	}
 	{
-		p.SetState(262)
+		p.SetState(277)
 		_la = p.GetTokenStream().LA(1)
 
 		if !(_la == KuneiformParserDELETE || _la == KuneiformParserUPDATE) {
@@ -4346,7 +4466,7 @@ func (p *KuneiformParser) Fk_action() (localctx IFk_actionContext) {
 			p.Consume()
 		}
 	}
-	p.SetState(271)
+	p.SetState(286)
 	p.GetErrorHandler().Sync(p)
 	if p.HasError() {
 		goto errorExit
@@ -4355,7 +4475,7 @@ func (p *KuneiformParser) Fk_action() (localctx IFk_actionContext) {
 	switch p.GetInterpreter().AdaptivePredict(p.BaseParser, p.GetTokenStream(), 16, p.GetParserRuleContext()) {
 	case 1:
 		{
-			p.SetState(263)
+			p.SetState(278)
 			p.Match(KuneiformParserSET)
 			if p.HasError() {
 				// Recognition error - abort rule
@@ -4363,7 +4483,7 @@ func (p *KuneiformParser) Fk_action() (localctx IFk_actionContext) {
 			}
 		}
 		{
-			p.SetState(264)
+			p.SetState(279)
 			p.Match(KuneiformParserNULL)
 			if p.HasError() {
 				// Recognition error - abort rule
@@ -4373,7 +4493,7 @@ func (p *KuneiformParser) Fk_action() (localctx IFk_actionContext) {
 
 	case 2:
 		{
-			p.SetState(265)
+			p.SetState(280)
 			p.Match(KuneiformParserSET)
 			if p.HasError() {
 				// Recognition error - abort rule
@@ -4381,7 +4501,7 @@ func (p *KuneiformParser) Fk_action() (localctx IFk_actionContext) {
 			}
 		}
 		{
-			p.SetState(266)
+			p.SetState(281)
 			p.Match(KuneiformParserDEFAULT)
 			if p.HasError() {
 				// Recognition error - abort rule
@@ -4391,7 +4511,7 @@ func (p *KuneiformParser) Fk_action() (localctx IFk_actionContext) {
 
 	case 3:
 		{
-			p.SetState(267)
+			p.SetState(282)
 			p.Match(KuneiformParserRESTRICT)
 			if p.HasError() {
 				// Recognition error - abort rule
@@ -4401,7 +4521,7 @@ func (p *KuneiformParser) Fk_action() (localctx IFk_actionContext) {
 
 	case 4:
 		{
-			p.SetState(268)
+			p.SetState(283)
 			p.Match(KuneiformParserNO)
 			if p.HasError() {
 				// Recognition error - abort rule
@@ -4409,7 +4529,7 @@ func (p *KuneiformParser) Fk_action() (localctx IFk_actionContext) {
 			}
 		}
 		{
-			p.SetState(269)
+			p.SetState(284)
 			p.Match(KuneiformParserACTION)
 			if p.HasError() {
 				// Recognition error - abort rule
@@ -4419,7 +4539,7 @@ func (p *KuneiformParser) Fk_action() (localctx IFk_actionContext) {
 
 	case 5:
 		{
-			p.SetState(270)
+			p.SetState(285)
 			p.Match(KuneiformParserCASCADE)
 			if p.HasError() {
 				// Recognition error - abort rule
@@ -4659,26 +4779,26 @@ func (p *KuneiformParser) Fk_constraint() (localctx IFk_constraintContext) {
 
 	p.EnterOuterAlt(localctx, 1)
 	{
-		p.SetState(273)
+		p.SetState(288)
 		p.Match(KuneiformParserREFERENCES)
 		if p.HasError() {
 			// Recognition error - abort rule
 			goto errorExit
 		}
 	}
-	p.SetState(277)
+	p.SetState(292)
 	p.GetErrorHandler().Sync(p)
 
 	if p.GetInterpreter().AdaptivePredict(p.BaseParser, p.GetTokenStream(), 17, p.GetParserRuleContext()) == 1 {
 		{
-			p.SetState(274)
+			p.SetState(289)
 
 			var _x = p.Identifier()
 
 			localctx.(*Fk_constraintContext).namespace = _x
 		}
 		{
-			p.SetState(275)
+			p.SetState(290)
 			p.Match(KuneiformParserPERIOD)
 			if p.HasError() {
 				// Recognition error - abort rule
@@ -4690,14 +4810,14 @@ func (p *KuneiformParser) Fk_constraint() (localctx IFk_constraintContext) {
 		goto errorExit
 	}
 	{
-		p.SetState(279)
+		p.SetState(294)
 
 		var _x = p.Identifier()
 
 		localctx.(*Fk_constraintContext).table = _x
 	}
 	{
-		p.SetState(280)
+		p.SetState(295)
 		p.Match(KuneiformParserLPAREN)
 		if p.HasError() {
 			// Recognition error - abort rule
@@ -4705,18 +4825,18 @@ func (p *KuneiformParser) Fk_constraint() (localctx IFk_constraintContext) {
 		}
 	}
 	{
-		p.SetState(281)
+		p.SetState(296)
 		p.Identifier_list()
 	}
 	{
-		p.SetState(282)
+		p.SetState(297)
 		p.Match(KuneiformParserRPAREN)
 		if p.HasError() {
 			// Recognition error - abort rule
 			goto errorExit
 		}
 	}
-	p.SetState(287)
+	p.SetState(302)
 	p.GetErrorHandler().Sync(p)
 	if p.HasError() {
 		goto errorExit
@@ -4725,10 +4845,10 @@ func (p *KuneiformParser) Fk_constraint() (localctx IFk_constraintContext) {
 
 	if _la == KuneiformParserON {
 		{
-			p.SetState(283)
+			p.SetState(298)
 			p.Fk_action()
 		}
-		p.SetState(285)
+		p.SetState(300)
 		p.GetErrorHandler().Sync(p)
 		if p.HasError() {
 			goto errorExit
@@ -4737,7 +4857,7 @@ func (p *KuneiformParser) Fk_constraint() (localctx IFk_constraintContext) {
 
 		if _la == KuneiformParserON {
 			{
-				p.SetState(284)
+				p.SetState(299)
 				p.Fk_action()
 			}
 
@@ -4908,14 +5028,14 @@ func (p *KuneiformParser) Action_return() (localctx IAction_returnContext) {
 
 	p.EnterOuterAlt(localctx, 1)
 	{
-		p.SetState(289)
+		p.SetState(304)
 		p.Match(KuneiformParserRETURNS)
 		if p.HasError() {
 			// Recognition error - abort rule
 			goto errorExit
 		}
 	}
-	p.SetState(301)
+	p.SetState(316)
 	p.GetErrorHandler().Sync(p)
 	if p.HasError() {
 		goto errorExit
@@ -4923,7 +5043,7 @@ func (p *KuneiformParser) Action_return() (localctx IAction_returnContext) {
 
 	switch p.GetInterpreter().AdaptivePredict(p.BaseParser, p.GetTokenStream(), 21, p.GetParserRuleContext()) {
 	case 1:
-		p.SetState(291)
+		p.SetState(306)
 		p.GetErrorHandler().Sync(p)
 		if p.HasError() {
 			goto errorExit
@@ -4932,7 +5052,7 @@ func (p *KuneiformParser) Action_return() (localctx IAction_returnContext) {
 
 		if _la == KuneiformParserTABLE {
 			{
-				p.SetState(290)
+				p.SetState(305)
 				p.Match(KuneiformParserTABLE)
 				if p.HasError() {
 					// Recognition error - abort rule
@@ -4942,7 +5062,7 @@ func (p *KuneiformParser) Action_return() (localctx IAction_returnContext) {
 
 		}
 		{
-			p.SetState(293)
+			p.SetState(308)
 			p.Match(KuneiformParserLPAREN)
 			if p.HasError() {
 				// Recognition error - abort rule
@@ -4950,14 +5070,14 @@ func (p *KuneiformParser) Action_return() (localctx IAction_returnContext) {
 			}
 		}
 		{
-			p.SetState(294)
+			p.SetState(309)
 
 			var _x = p.Named_type_list()
 
 			localctx.(*Action_returnContext).return_columns = _x
 		}
 		{
-			p.SetState(295)
+			p.SetState(310)
 			p.Match(KuneiformParserRPAREN)
 			if p.HasError() {
 				// Recognition error - abort rule
@@ -4967,7 +5087,7 @@ func (p *KuneiformParser) Action_return() (localctx IAction_returnContext) {
 
 	case 2:
 		{
-			p.SetState(297)
+			p.SetState(312)
 			p.Match(KuneiformParserLPAREN)
 			if p.HasError() {
 				// Recognition error - abort rule
@@ -4975,14 +5095,14 @@ func (p *KuneiformParser) Action_return() (localctx IAction_returnContext) {
 			}
 		}
 		{
-			p.SetState(298)
+			p.SetState(313)
 
 			var _x = p.Type_list()
 
 			localctx.(*Action_returnContext).unnamed_return_types = _x
 		}
 		{
-			p.SetState(299)
+			p.SetState(314)
 			p.Match(KuneiformParserRPAREN)
 			if p.HasError() {
 				// Recognition error - abort rule
@@ -5207,7 +5327,7 @@ func (p *KuneiformParser) Sql_statement() (localctx ISql_statementContext) {
 	var _la int
 
 	p.EnterOuterAlt(localctx, 1)
-	p.SetState(315)
+	p.SetState(330)
 	p.GetErrorHandler().Sync(p)
 	if p.HasError() {
 		goto errorExit
@@ -5216,14 +5336,14 @@ func (p *KuneiformParser) Sql_statement() (localctx ISql_statementContext) {
 
 	if _la == KuneiformParserWITH {
 		{
-			p.SetState(303)
+			p.SetState(318)
 			p.Match(KuneiformParserWITH)
 			if p.HasError() {
 				// Recognition error - abort rule
 				goto errorExit
 			}
 		}
-		p.SetState(305)
+		p.SetState(320)
 		p.GetErrorHandler().Sync(p)
 		if p.HasError() {
 			goto errorExit
@@ -5232,7 +5352,7 @@ func (p *KuneiformParser) Sql_statement() (localctx ISql_statementContext) {
 
 		if _la == KuneiformParserRECURSIVE {
 			{
-				p.SetState(304)
+				p.SetState(319)
 				p.Match(KuneiformParserRECURSIVE)
 				if p.HasError() {
 					// Recognition error - abort rule
@@ -5242,10 +5362,10 @@ func (p *KuneiformParser) Sql_statement() (localctx ISql_statementContext) {
 
 		}
 		{
-			p.SetState(307)
+			p.SetState(322)
 			p.Common_table_expression()
 		}
-		p.SetState(312)
+		p.SetState(327)
 		p.GetErrorHandler().Sync(p)
 		if p.HasError() {
 			goto errorExit
@@ -5254,7 +5374,7 @@ func (p *KuneiformParser) Sql_statement() (localctx ISql_statementContext) {
 
 		for _la == KuneiformParserCOMMA {
 			{
-				p.SetState(308)
+				p.SetState(323)
 				p.Match(KuneiformParserCOMMA)
 				if p.HasError() {
 					// Recognition error - abort rule
@@ -5262,11 +5382,11 @@ func (p *KuneiformParser) Sql_statement() (localctx ISql_statementContext) {
 				}
 			}
 			{
-				p.SetState(309)
+				p.SetState(324)
 				p.Common_table_expression()
 			}
 
-			p.SetState(314)
+			p.SetState(329)
 			p.GetErrorHandler().Sync(p)
 			if p.HasError() {
 				goto errorExit
@@ -5275,7 +5395,7 @@ func (p *KuneiformParser) Sql_statement() (localctx ISql_statementContext) {
 		}
 
 	}
-	p.SetState(321)
+	p.SetState(336)
 	p.GetErrorHandler().Sync(p)
 	if p.HasError() {
 		goto errorExit
@@ -5284,25 +5404,25 @@ func (p *KuneiformParser) Sql_statement() (localctx ISql_statementContext) {
 	switch p.GetTokenStream().LA(1) {
 	case KuneiformParserSELECT:
 		{
-			p.SetState(317)
+			p.SetState(332)
 			p.Select_statement()
 		}
 
 	case KuneiformParserUPDATE:
 		{
-			p.SetState(318)
+			p.SetState(333)
 			p.Update_statement()
 		}
 
 	case KuneiformParserINSERT:
 		{
-			p.SetState(319)
+			p.SetState(334)
 			p.Insert_statement()
 		}
 
 	case KuneiformParserDELETE:
 		{
-			p.SetState(320)
+			p.SetState(335)
 			p.Delete_statement()
 		}
 
@@ -5489,10 +5609,10 @@ func (p *KuneiformParser) Common_table_expression() (localctx ICommon_table_expr
 
 	p.EnterOuterAlt(localctx, 1)
 	{
-		p.SetState(323)
+		p.SetState(338)
 		p.Identifier()
 	}
-	p.SetState(336)
+	p.SetState(351)
 	p.GetErrorHandler().Sync(p)
 	if p.HasError() {
 		goto errorExit
@@ -5501,14 +5621,14 @@ func (p *KuneiformParser) Common_table_expression() (localctx ICommon_table_expr
 
 	if _la == KuneiformParserLPAREN {
 		{
-			p.SetState(324)
+			p.SetState(339)
 			p.Match(KuneiformParserLPAREN)
 			if p.HasError() {
 				// Recognition error - abort rule
 				goto errorExit
 			}
 		}
-		p.SetState(333)
+		p.SetState(348)
 		p.GetErrorHandler().Sync(p)
 		if p.HasError() {
 			goto errorExit
@@ -5517,10 +5637,10 @@ func (p *KuneiformParser) Common_table_expression() (localctx ICommon_table_expr
 
 		if ((int64(_la) & ^0x3f) == 0 && ((int64(1)<<_la)&-9127724498152325120) != 0) || ((int64((_la-87)) & ^0x3f) == 0 && ((int64(1)<<(_la-87))&2306959842411020289) != 0) {
 			{
-				p.SetState(325)
+				p.SetState(340)
 				p.Identifier()
 			}
-			p.SetState(330)
+			p.SetState(345)
 			p.GetErrorHandler().Sync(p)
 			if p.HasError() {
 				goto errorExit
@@ -5529,7 +5649,7 @@ func (p *KuneiformParser) Common_table_expression() (localctx ICommon_table_expr
 
 			for _la == KuneiformParserCOMMA {
 				{
-					p.SetState(326)
+					p.SetState(341)
 					p.Match(KuneiformParserCOMMA)
 					if p.HasError() {
 						// Recognition error - abort rule
@@ -5537,11 +5657,11 @@ func (p *KuneiformParser) Common_table_expression() (localctx ICommon_table_expr
 					}
 				}
 				{
-					p.SetState(327)
+					p.SetState(342)
 					p.Identifier()
 				}
 
-				p.SetState(332)
+				p.SetState(347)
 				p.GetErrorHandler().Sync(p)
 				if p.HasError() {
 					goto errorExit
@@ -5551,7 +5671,7 @@ func (p *KuneiformParser) Common_table_expression() (localctx ICommon_table_expr
 
 		}
 		{
-			p.SetState(335)
+			p.SetState(350)
 			p.Match(KuneiformParserRPAREN)
 			if p.HasError() {
 				// Recognition error - abort rule
@@ -5561,7 +5681,7 @@ func (p *KuneiformParser) Common_table_expression() (localctx ICommon_table_expr
 
 	}
 	{
-		p.SetState(338)
+		p.SetState(353)
 		p.Match(KuneiformParserAS)
 		if p.HasError() {
 			// Recognition error - abort rule
@@ -5569,7 +5689,7 @@ func (p *KuneiformParser) Common_table_expression() (localctx ICommon_table_expr
 		}
 	}
 	{
-		p.SetState(339)
+		p.SetState(354)
 		p.Match(KuneiformParserLPAREN)
 		if p.HasError() {
 			// Recognition error - abort rule
@@ -5577,11 +5697,11 @@ func (p *KuneiformParser) Common_table_expression() (localctx ICommon_table_expr
 		}
 	}
 	{
-		p.SetState(340)
+		p.SetState(355)
 		p.Select_statement()
 	}
 	{
-		p.SetState(341)
+		p.SetState(356)
 		p.Match(KuneiformParserRPAREN)
 		if p.HasError() {
 			// Recognition error - abort rule
@@ -5831,7 +5951,7 @@ func (p *KuneiformParser) Create_table_statement() (localctx ICreate_table_state
 
 	p.EnterOuterAlt(localctx, 1)
 	{
-		p.SetState(343)
+		p.SetState(358)
 		p.Match(KuneiformParserCREATE)
 		if p.HasError() {
 			// Recognition error - abort rule
@@ -5839,19 +5959,19 @@ func (p *KuneiformParser) Create_table_statement() (localctx ICreate_table_state
 		}
 	}
 	{
-		p.SetState(344)
+		p.SetState(359)
 		p.Match(KuneiformParserTABLE)
 		if p.HasError() {
 			// Recognition error - abort rule
 			goto errorExit
 		}
 	}
-	p.SetState(348)
+	p.SetState(363)
 	p.GetErrorHandler().Sync(p)
 
 	if p.GetInterpreter().AdaptivePredict(p.BaseParser, p.GetTokenStream(), 29, p.GetParserRuleContext()) == 1 {
 		{
-			p.SetState(345)
+			p.SetState(360)
 			p.Match(KuneiformParserIF)
 			if p.HasError() {
 				// Recognition error - abort rule
@@ -5859,7 +5979,7 @@ func (p *KuneiformParser) Create_table_statement() (localctx ICreate_table_state
 			}
 		}
 		{
-			p.SetState(346)
+			p.SetState(361)
 			p.Match(KuneiformParserNOT)
 			if p.HasError() {
 				// Recognition error - abort rule
@@ -5867,7 +5987,7 @@ func (p *KuneiformParser) Create_table_statement() (localctx ICreate_table_state
 			}
 		}
 		{
-			p.SetState(347)
+			p.SetState(362)
 			p.Match(KuneiformParserEXISTS)
 			if p.HasError() {
 				// Recognition error - abort rule
@@ -5879,21 +5999,21 @@ func (p *KuneiformParser) Create_table_statement() (localctx ICreate_table_state
 		goto errorExit
 	}
 	{
-		p.SetState(350)
+		p.SetState(365)
 
 		var _x = p.Identifier()
 
 		localctx.(*Create_table_statementContext).name = _x
 	}
 	{
-		p.SetState(351)
+		p.SetState(366)
 		p.Match(KuneiformParserLPAREN)
 		if p.HasError() {
 			// Recognition error - abort rule
 			goto errorExit
 		}
 	}
-	p.SetState(354)
+	p.SetState(369)
 	p.GetErrorHandler().Sync(p)
 	if p.HasError() {
 		goto errorExit
@@ -5902,20 +6022,20 @@ func (p *KuneiformParser) Create_table_statement() (localctx ICreate_table_state
 	switch p.GetInterpreter().AdaptivePredict(p.BaseParser, p.GetTokenStream(), 30, p.GetParserRuleContext()) {
 	case 1:
 		{
-			p.SetState(352)
+			p.SetState(367)
 			p.Table_column_def()
 		}
 
 	case 2:
 		{
-			p.SetState(353)
+			p.SetState(368)
 			p.Table_constraint_def()
 		}
 
 	case antlr.ATNInvalidAltNumber:
 		goto errorExit
 	}
-	p.SetState(363)
+	p.SetState(378)
 	p.GetErrorHandler().Sync(p)
 	if p.HasError() {
 		goto errorExit
@@ -5924,14 +6044,14 @@ func (p *KuneiformParser) Create_table_statement() (localctx ICreate_table_state
 
 	for _la == KuneiformParserCOMMA {
 		{
-			p.SetState(356)
+			p.SetState(371)
 			p.Match(KuneiformParserCOMMA)
 			if p.HasError() {
 				// Recognition error - abort rule
 				goto errorExit
 			}
 		}
-		p.SetState(359)
+		p.SetState(374)
 		p.GetErrorHandler().Sync(p)
 		if p.HasError() {
 			goto errorExit
@@ -5940,13 +6060,13 @@ func (p *KuneiformParser) Create_table_statement() (localctx ICreate_table_state
 		switch p.GetInterpreter().AdaptivePredict(p.BaseParser, p.GetTokenStream(), 31, p.GetParserRuleContext()) {
 		case 1:
 			{
-				p.SetState(357)
+				p.SetState(372)
 				p.Table_column_def()
 			}
 
 		case 2:
 			{
-				p.SetState(358)
+				p.SetState(373)
 				p.Table_constraint_def()
 			}
 
@@ -5954,7 +6074,7 @@ func (p *KuneiformParser) Create_table_statement() (localctx ICreate_table_state
 			goto errorExit
 		}
 
-		p.SetState(365)
+		p.SetState(380)
 		p.GetErrorHandler().Sync(p)
 		if p.HasError() {
 			goto errorExit
@@ -5962,7 +6082,7 @@ func (p *KuneiformParser) Create_table_statement() (localctx ICreate_table_state
 		_la = p.GetTokenStream().LA(1)
 	}
 	{
-		p.SetState(366)
+		p.SetState(381)
 		p.Match(KuneiformParserRPAREN)
 		if p.HasError() {
 			// Recognition error - abort rule
@@ -6171,7 +6291,7 @@ func (p *KuneiformParser) Table_constraint_def() (localctx ITable_constraint_def
 	var _la int
 
 	p.EnterOuterAlt(localctx, 1)
-	p.SetState(370)
+	p.SetState(385)
 	p.GetErrorHandler().Sync(p)
 	if p.HasError() {
 		goto errorExit
@@ -6180,7 +6300,7 @@ func (p *KuneiformParser) Table_constraint_def() (localctx ITable_constraint_def
 
 	if _la == KuneiformParserCONSTRAINT {
 		{
-			p.SetState(368)
+			p.SetState(383)
 			p.Match(KuneiformParserCONSTRAINT)
 			if p.HasError() {
 				// Recognition error - abort rule
@@ -6188,7 +6308,7 @@ func (p *KuneiformParser) Table_constraint_def() (localctx ITable_constraint_def
 			}
 		}
 		{
-			p.SetState(369)
+			p.SetState(384)
 
 			var _x = p.Identifier()
 
@@ -6196,7 +6316,7 @@ func (p *KuneiformParser) Table_constraint_def() (localctx ITable_constraint_def
 		}
 
 	}
-	p.SetState(395)
+	p.SetState(410)
 	p.GetErrorHandler().Sync(p)
 	if p.HasError() {
 		goto errorExit
@@ -6205,7 +6325,7 @@ func (p *KuneiformParser) Table_constraint_def() (localctx ITable_constraint_def
 	switch p.GetTokenStream().LA(1) {
 	case KuneiformParserUNIQUE:
 		{
-			p.SetState(372)
+			p.SetState(387)
 			p.Match(KuneiformParserUNIQUE)
 			if p.HasError() {
 				// Recognition error - abort rule
@@ -6213,7 +6333,7 @@ func (p *KuneiformParser) Table_constraint_def() (localctx ITable_constraint_def
 			}
 		}
 		{
-			p.SetState(373)
+			p.SetState(388)
 			p.Match(KuneiformParserLPAREN)
 			if p.HasError() {
 				// Recognition error - abort rule
@@ -6221,11 +6341,11 @@ func (p *KuneiformParser) Table_constraint_def() (localctx ITable_constraint_def
 			}
 		}
 		{
-			p.SetState(374)
+			p.SetState(389)
 			p.Identifier_list()
 		}
 		{
-			p.SetState(375)
+			p.SetState(390)
 			p.Match(KuneiformParserRPAREN)
 			if p.HasError() {
 				// Recognition error - abort rule
@@ -6235,7 +6355,7 @@ func (p *KuneiformParser) Table_constraint_def() (localctx ITable_constraint_def
 
 	case KuneiformParserCHECK:
 		{
-			p.SetState(377)
+			p.SetState(392)
 			p.Match(KuneiformParserCHECK)
 			if p.HasError() {
 				// Recognition error - abort rule
@@ -6243,7 +6363,7 @@ func (p *KuneiformParser) Table_constraint_def() (localctx ITable_constraint_def
 			}
 		}
 		{
-			p.SetState(378)
+			p.SetState(393)
 			p.Match(KuneiformParserLPAREN)
 			if p.HasError() {
 				// Recognition error - abort rule
@@ -6251,11 +6371,11 @@ func (p *KuneiformParser) Table_constraint_def() (localctx ITable_constraint_def
 			}
 		}
 		{
-			p.SetState(379)
+			p.SetState(394)
 			p.sql_expr(0)
 		}
 		{
-			p.SetState(380)
+			p.SetState(395)
 			p.Match(KuneiformParserRPAREN)
 			if p.HasError() {
 				// Recognition error - abort rule
@@ -6265,7 +6385,7 @@ func (p *KuneiformParser) Table_constraint_def() (localctx ITable_constraint_def
 
 	case KuneiformParserFOREIGN:
 		{
-			p.SetState(382)
+			p.SetState(397)
 			p.Match(KuneiformParserFOREIGN)
 			if p.HasError() {
 				// Recognition error - abort rule
@@ -6273,7 +6393,7 @@ func (p *KuneiformParser) Table_constraint_def() (localctx ITable_constraint_def
 			}
 		}
 		{
-			p.SetState(383)
+			p.SetState(398)
 			p.Match(KuneiformParserKEY)
 			if p.HasError() {
 				// Recognition error - abort rule
@@ -6281,7 +6401,7 @@ func (p *KuneiformParser) Table_constraint_def() (localctx ITable_constraint_def
 			}
 		}
 		{
-			p.SetState(384)
+			p.SetState(399)
 			p.Match(KuneiformParserLPAREN)
 			if p.HasError() {
 				// Recognition error - abort rule
@@ -6289,11 +6409,11 @@ func (p *KuneiformParser) Table_constraint_def() (localctx ITable_constraint_def
 			}
 		}
 		{
-			p.SetState(385)
+			p.SetState(400)
 			p.Identifier_list()
 		}
 		{
-			p.SetState(386)
+			p.SetState(401)
 			p.Match(KuneiformParserRPAREN)
 			if p.HasError() {
 				// Recognition error - abort rule
@@ -6301,13 +6421,13 @@ func (p *KuneiformParser) Table_constraint_def() (localctx ITable_constraint_def
 			}
 		}
 		{
-			p.SetState(387)
+			p.SetState(402)
 			p.Fk_constraint()
 		}
 
 	case KuneiformParserPRIMARY:
 		{
-			p.SetState(389)
+			p.SetState(404)
 			p.Match(KuneiformParserPRIMARY)
 			if p.HasError() {
 				// Recognition error - abort rule
@@ -6315,7 +6435,7 @@ func (p *KuneiformParser) Table_constraint_def() (localctx ITable_constraint_def
 			}
 		}
 		{
-			p.SetState(390)
+			p.SetState(405)
 			p.Match(KuneiformParserKEY)
 			if p.HasError() {
 				// Recognition error - abort rule
@@ -6323,7 +6443,7 @@ func (p *KuneiformParser) Table_constraint_def() (localctx ITable_constraint_def
 			}
 		}
 		{
-			p.SetState(391)
+			p.SetState(406)
 			p.Match(KuneiformParserLPAREN)
 			if p.HasError() {
 				// Recognition error - abort rule
@@ -6331,11 +6451,11 @@ func (p *KuneiformParser) Table_constraint_def() (localctx ITable_constraint_def
 			}
 		}
 		{
-			p.SetState(392)
+			p.SetState(407)
 			p.Identifier_list()
 		}
 		{
-			p.SetState(393)
+			p.SetState(408)
 			p.Match(KuneiformParserRPAREN)
 			if p.HasError() {
 				// Recognition error - abort rule
@@ -6441,7 +6561,7 @@ func (p *KuneiformParser) Opt_drop_behavior() (localctx IOpt_drop_behaviorContex
 
 	p.EnterOuterAlt(localctx, 1)
 	{
-		p.SetState(397)
+		p.SetState(412)
 		_la = p.GetTokenStream().LA(1)
 
 		if !(_la == KuneiformParserCASCADE || _la == KuneiformParserRESTRICT) {
@@ -6600,7 +6720,7 @@ func (p *KuneiformParser) Drop_table_statement() (localctx IDrop_table_statement
 
 	p.EnterOuterAlt(localctx, 1)
 	{
-		p.SetState(399)
+		p.SetState(414)
 		p.Match(KuneiformParserDROP)
 		if p.HasError() {
 			// Recognition error - abort rule
@@ -6608,19 +6728,19 @@ func (p *KuneiformParser) Drop_table_statement() (localctx IDrop_table_statement
 		}
 	}
 	{
-		p.SetState(400)
+		p.SetState(415)
 		p.Match(KuneiformParserTABLE)
 		if p.HasError() {
 			// Recognition error - abort rule
 			goto errorExit
 		}
 	}
-	p.SetState(403)
+	p.SetState(418)
 	p.GetErrorHandler().Sync(p)
 
 	if p.GetInterpreter().AdaptivePredict(p.BaseParser, p.GetTokenStream(), 35, p.GetParserRuleContext()) == 1 {
 		{
-			p.SetState(401)
+			p.SetState(416)
 			p.Match(KuneiformParserIF)
 			if p.HasError() {
 				// Recognition error - abort rule
@@ -6628,7 +6748,7 @@ func (p *KuneiformParser) Drop_table_statement() (localctx IDrop_table_statement
 			}
 		}
 		{
-			p.SetState(402)
+			p.SetState(417)
 			p.Match(KuneiformParserEXISTS)
 			if p.HasError() {
 				// Recognition error - abort rule
@@ -6640,13 +6760,13 @@ func (p *KuneiformParser) Drop_table_statement() (localctx IDrop_table_statement
 		goto errorExit
 	}
 	{
-		p.SetState(405)
+		p.SetState(420)
 
 		var _x = p.Identifier_list()
 
 		localctx.(*Drop_table_statementContext).tables = _x
 	}
-	p.SetState(407)
+	p.SetState(422)
 	p.GetErrorHandler().Sync(p)
 	if p.HasError() {
 		goto errorExit
@@ -6655,7 +6775,7 @@ func (p *KuneiformParser) Drop_table_statement() (localctx IDrop_table_statement
 
 	if _la == KuneiformParserCASCADE || _la == KuneiformParserRESTRICT {
 		{
-			p.SetState(406)
+			p.SetState(421)
 			p.Opt_drop_behavior()
 		}
 
@@ -6835,7 +6955,7 @@ func (p *KuneiformParser) Alter_table_statement() (localctx IAlter_table_stateme
 
 	p.EnterOuterAlt(localctx, 1)
 	{
-		p.SetState(409)
+		p.SetState(424)
 		p.Match(KuneiformParserALTER)
 		if p.HasError() {
 			// Recognition error - abort rule
@@ -6843,7 +6963,7 @@ func (p *KuneiformParser) Alter_table_statement() (localctx IAlter_table_stateme
 		}
 	}
 	{
-		p.SetState(410)
+		p.SetState(425)
 		p.Match(KuneiformParserTABLE)
 		if p.HasError() {
 			// Recognition error - abort rule
@@ -6851,17 +6971,17 @@ func (p *KuneiformParser) Alter_table_statement() (localctx IAlter_table_stateme
 		}
 	}
 	{
-		p.SetState(411)
+		p.SetState(426)
 
 		var _x = p.Identifier()
 
 		localctx.(*Alter_table_statementContext).table = _x
 	}
 	{
-		p.SetState(412)
+		p.SetState(427)
 		p.Alter_table_action()
 	}
-	p.SetState(417)
+	p.SetState(432)
 	p.GetErrorHandler().Sync(p)
 	if p.HasError() {
 		goto errorExit
@@ -6870,7 +6990,7 @@ func (p *KuneiformParser) Alter_table_statement() (localctx IAlter_table_stateme
 
 	for _la == KuneiformParserCOMMA {
 		{
-			p.SetState(413)
+			p.SetState(428)
 			p.Match(KuneiformParserCOMMA)
 			if p.HasError() {
 				// Recognition error - abort rule
@@ -6878,11 +6998,11 @@ func (p *KuneiformParser) Alter_table_statement() (localctx IAlter_table_stateme
 			}
 		}
 		{
-			p.SetState(414)
+			p.SetState(429)
 			p.Alter_table_action()
 		}
 
-		p.SetState(419)
+		p.SetState(434)
 		p.GetErrorHandler().Sync(p)
 		if p.HasError() {
 			goto errorExit
@@ -7528,7 +7648,7 @@ func (s *Drop_columnContext) Accept(visitor antlr.ParseTreeVisitor) interface{}
 func (p *KuneiformParser) Alter_table_action() (localctx IAlter_table_actionContext) {
 	localctx = NewAlter_table_actionContext(p, p.GetParserRuleContext(), p.GetState())
 	p.EnterRule(localctx, 46, KuneiformParserRULE_alter_table_action)
-	p.SetState(474)
+	p.SetState(489)
 	p.GetErrorHandler().Sync(p)
 	if p.HasError() {
 		goto errorExit
@@ -7539,7 +7659,7 @@ func (p *KuneiformParser) Alter_table_action() (localctx IAlter_table_actionCont
 		localctx = NewAdd_column_constraintContext(p, localctx)
 		p.EnterOuterAlt(localctx, 1)
 		{
-			p.SetState(420)
+			p.SetState(435)
 			p.Match(KuneiformParserALTER)
 			if p.HasError() {
 				// Recognition error - abort rule
@@ -7547,7 +7667,7 @@ func (p *KuneiformParser) Alter_table_action() (localctx IAlter_table_actionCont
 			}
 		}
 		{
-			p.SetState(421)
+			p.SetState(436)
 			p.Match(KuneiformParserCOLUMN)
 			if p.HasError() {
 				// Recognition error - abort rule
@@ -7555,21 +7675,21 @@ func (p *KuneiformParser) Alter_table_action() (localctx IAlter_table_actionCont
 			}
 		}
 		{
-			p.SetState(422)
+			p.SetState(437)
 
 			var _x = p.Identifier()
 
 			localctx.(*Add_column_constraintContext).column = _x
 		}
 		{
-			p.SetState(423)
+			p.SetState(438)
 			p.Match(KuneiformParserSET)
 			if p.HasError() {
 				// Recognition error - abort rule
 				goto errorExit
 			}
 		}
-		p.SetState(428)
+		p.SetState(443)
 		p.GetErrorHandler().Sync(p)
 		if p.HasError() {
 			goto errorExit
@@ -7578,7 +7698,7 @@ func (p *KuneiformParser) Alter_table_action() (localctx IAlter_table_actionCont
 		switch p.GetTokenStream().LA(1) {
 		case KuneiformParserNOT:
 			{
-				p.SetState(424)
+				p.SetState(439)
 				p.Match(KuneiformParserNOT)
 				if p.HasError() {
 					// Recognition error - abort rule
@@ -7586,7 +7706,7 @@ func (p *KuneiformParser) Alter_table_action() (localctx IAlter_table_actionCont
 				}
 			}
 			{
-				p.SetState(425)
+				p.SetState(440)
 				p.Match(KuneiformParserNULL)
 				if p.HasError() {
 					// Recognition error - abort rule
@@ -7596,7 +7716,7 @@ func (p *KuneiformParser) Alter_table_action() (localctx IAlter_table_actionCont
 
 		case KuneiformParserDEFAULT:
 			{
-				p.SetState(426)
+				p.SetState(441)
 				p.Match(KuneiformParserDEFAULT)
 				if p.HasError() {
 					// Recognition error - abort rule
@@ -7604,7 +7724,7 @@ func (p *KuneiformParser) Alter_table_action() (localctx IAlter_table_actionCont
 				}
 			}
 			{
-				p.SetState(427)
+				p.SetState(442)
 				p.action_expr(0)
 			}
 
@@ -7617,7 +7737,7 @@ func (p *KuneiformParser) Alter_table_action() (localctx IAlter_table_actionCont
 		localctx = NewDrop_column_constraintContext(p, localctx)
 		p.EnterOuterAlt(localctx, 2)
 		{
-			p.SetState(430)
+			p.SetState(445)
 			p.Match(KuneiformParserALTER)
 			if p.HasError() {
 				// Recognition error - abort rule
@@ -7625,7 +7745,7 @@ func (p *KuneiformParser) Alter_table_action() (localctx IAlter_table_actionCont
 			}
 		}
 		{
-			p.SetState(431)
+			p.SetState(446)
 			p.Match(KuneiformParserCOLUMN)
 			if p.HasError() {
 				// Recognition error - abort rule
@@ -7633,21 +7753,21 @@ func (p *KuneiformParser) Alter_table_action() (localctx IAlter_table_actionCont
 			}
 		}
 		{
-			p.SetState(432)
+			p.SetState(447)
 
 			var _x = p.Identifier()
 
 			localctx.(*Drop_column_constraintContext).column = _x
 		}
 		{
-			p.SetState(433)
+			p.SetState(448)
 			p.Match(KuneiformParserDROP)
 			if p.HasError() {
 				// Recognition error - abort rule
 				goto errorExit
 			}
 		}
-		p.SetState(437)
+		p.SetState(452)
 		p.GetErrorHandler().Sync(p)
 		if p.HasError() {
 			goto errorExit
@@ -7656,7 +7776,7 @@ func (p *KuneiformParser) Alter_table_action() (localctx IAlter_table_actionCont
 		switch p.GetTokenStream().LA(1) {
 		case KuneiformParserNOT:
 			{
-				p.SetState(434)
+				p.SetState(449)
 				p.Match(KuneiformParserNOT)
 				if p.HasError() {
 					// Recognition error - abort rule
@@ -7664,7 +7784,7 @@ func (p *KuneiformParser) Alter_table_action() (localctx IAlter_table_actionCont
 				}
 			}
 			{
-				p.SetState(435)
+				p.SetState(450)
 				p.Match(KuneiformParserNULL)
 				if p.HasError() {
 					// Recognition error - abort rule
@@ -7674,7 +7794,7 @@ func (p *KuneiformParser) Alter_table_action() (localctx IAlter_table_actionCont
 
 		case KuneiformParserDEFAULT:
 			{
-				p.SetState(436)
+				p.SetState(451)
 				p.Match(KuneiformParserDEFAULT)
 				if p.HasError() {
 					// Recognition error - abort rule
@@ -7691,7 +7811,7 @@ func (p *KuneiformParser) Alter_table_action() (localctx IAlter_table_actionCont
 		localctx = NewAdd_columnContext(p, localctx)
 		p.EnterOuterAlt(localctx, 3)
 		{
-			p.SetState(439)
+			p.SetState(454)
 			p.Match(KuneiformParserADD)
 			if p.HasError() {
 				// Recognition error - abort rule
@@ -7699,19 +7819,19 @@ func (p *KuneiformParser) Alter_table_action() (localctx IAlter_table_actionCont
 			}
 		}
 		{
-			p.SetState(440)
+			p.SetState(455)
 			p.Match(KuneiformParserCOLUMN)
 			if p.HasError() {
 				// Recognition error - abort rule
 				goto errorExit
 			}
 		}
-		p.SetState(444)
+		p.SetState(459)
 		p.GetErrorHandler().Sync(p)
 
 		if p.GetInterpreter().AdaptivePredict(p.BaseParser, p.GetTokenStream(), 40, p.GetParserRuleContext()) == 1 {
 			{
-				p.SetState(441)
+				p.SetState(456)
 				p.Match(KuneiformParserIF)
 				if p.HasError() {
 					// Recognition error - abort rule
@@ -7719,7 +7839,7 @@ func (p *KuneiformParser) Alter_table_action() (localctx IAlter_table_actionCont
 				}
 			}
 			{
-				p.SetState(442)
+				p.SetState(457)
 				p.Match(KuneiformParserNOT)
 				if p.HasError() {
 					// Recognition error - abort rule
@@ -7727,7 +7847,7 @@ func (p *KuneiformParser) Alter_table_action() (localctx IAlter_table_actionCont
 				}
 			}
 			{
-				p.SetState(443)
+				p.SetState(458)
 				p.Match(KuneiformParserEXISTS)
 				if p.HasError() {
 					// Recognition error - abort rule
@@ -7739,14 +7859,14 @@ func (p *KuneiformParser) Alter_table_action() (localctx IAlter_table_actionCont
 			goto errorExit
 		}
 		{
-			p.SetState(446)
+			p.SetState(461)
 
 			var _x = p.Identifier()
 
 			localctx.(*Add_columnContext).column = _x
 		}
 		{
-			p.SetState(447)
+			p.SetState(462)
 			p.Type_()
 		}
 
@@ -7754,7 +7874,7 @@ func (p *KuneiformParser) Alter_table_action() (localctx IAlter_table_actionCont
 		localctx = NewDrop_columnContext(p, localctx)
 		p.EnterOuterAlt(localctx, 4)
 		{
-			p.SetState(449)
+			p.SetState(464)
 			p.Match(KuneiformParserDROP)
 			if p.HasError() {
 				// Recognition error - abort rule
@@ -7762,19 +7882,19 @@ func (p *KuneiformParser) Alter_table_action() (localctx IAlter_table_actionCont
 			}
 		}
 		{
-			p.SetState(450)
+			p.SetState(465)
 			p.Match(KuneiformParserCOLUMN)
 			if p.HasError() {
 				// Recognition error - abort rule
 				goto errorExit
 			}
 		}
-		p.SetState(453)
+		p.SetState(468)
 		p.GetErrorHandler().Sync(p)
 
 		if p.GetInterpreter().AdaptivePredict(p.BaseParser, p.GetTokenStream(), 41, p.GetParserRuleContext()) == 1 {
 			{
-				p.SetState(451)
+				p.SetState(466)
 				p.Match(KuneiformParserIF)
 				if p.HasError() {
 					// Recognition error - abort rule
@@ -7782,7 +7902,7 @@ func (p *KuneiformParser) Alter_table_action() (localctx IAlter_table_actionCont
 				}
 			}
 			{
-				p.SetState(452)
+				p.SetState(467)
 				p.Match(KuneiformParserEXISTS)
 				if p.HasError() {
 					// Recognition error - abort rule
@@ -7794,7 +7914,7 @@ func (p *KuneiformParser) Alter_table_action() (localctx IAlter_table_actionCont
 			goto errorExit
 		}
 		{
-			p.SetState(455)
+			p.SetState(470)
 
 			var _x = p.Identifier()
 
@@ -7805,7 +7925,7 @@ func (p *KuneiformParser) Alter_table_action() (localctx IAlter_table_actionCont
 		localctx = NewRename_columnContext(p, localctx)
 		p.EnterOuterAlt(localctx, 5)
 		{
-			p.SetState(456)
+			p.SetState(471)
 			p.Match(KuneiformParserRENAME)
 			if p.HasError() {
 				// Recognition error - abort rule
@@ -7813,7 +7933,7 @@ func (p *KuneiformParser) Alter_table_action() (localctx IAlter_table_actionCont
 			}
 		}
 		{
-			p.SetState(457)
+			p.SetState(472)
 			p.Match(KuneiformParserCOLUMN)
 			if p.HasError() {
 				// Recognition error - abort rule
@@ -7821,14 +7941,14 @@ func (p *KuneiformParser) Alter_table_action() (localctx IAlter_table_actionCont
 			}
 		}
 		{
-			p.SetState(458)
+			p.SetState(473)
 
 			var _x = p.Identifier()
 
 			localctx.(*Rename_columnContext).old_column = _x
 		}
 		{
-			p.SetState(459)
+			p.SetState(474)
 			p.Match(KuneiformParserTO)
 			if p.HasError() {
 				// Recognition error - abort rule
@@ -7836,7 +7956,7 @@ func (p *KuneiformParser) Alter_table_action() (localctx IAlter_table_actionCont
 			}
 		}
 		{
-			p.SetState(460)
+			p.SetState(475)
 
 			var _x = p.Identifier()
 
@@ -7847,7 +7967,7 @@ func (p *KuneiformParser) Alter_table_action() (localctx IAlter_table_actionCont
 		localctx = NewRename_tableContext(p, localctx)
 		p.EnterOuterAlt(localctx, 6)
 		{
-			p.SetState(462)
+			p.SetState(477)
 			p.Match(KuneiformParserRENAME)
 			if p.HasError() {
 				// Recognition error - abort rule
@@ -7855,7 +7975,7 @@ func (p *KuneiformParser) Alter_table_action() (localctx IAlter_table_actionCont
 			}
 		}
 		{
-			p.SetState(463)
+			p.SetState(478)
 			p.Match(KuneiformParserTO)
 			if p.HasError() {
 				// Recognition error - abort rule
@@ -7863,7 +7983,7 @@ func (p *KuneiformParser) Alter_table_action() (localctx IAlter_table_actionCont
 			}
 		}
 		{
-			p.SetState(464)
+			p.SetState(479)
 
 			var _x = p.Identifier()
 
@@ -7874,7 +7994,7 @@ func (p *KuneiformParser) Alter_table_action() (localctx IAlter_table_actionCont
 		localctx = NewAdd_table_constraintContext(p, localctx)
 		p.EnterOuterAlt(localctx, 7)
 		{
-			p.SetState(465)
+			p.SetState(480)
 			p.Match(KuneiformParserADD)
 			if p.HasError() {
 				// Recognition error - abort rule
@@ -7882,7 +8002,7 @@ func (p *KuneiformParser) Alter_table_action() (localctx IAlter_table_actionCont
 			}
 		}
 		{
-			p.SetState(466)
+			p.SetState(481)
 			p.Table_constraint_def()
 		}
 
@@ -7890,7 +8010,7 @@ func (p *KuneiformParser) Alter_table_action() (localctx IAlter_table_actionCont
 		localctx = NewDrop_table_constraintContext(p, localctx)
 		p.EnterOuterAlt(localctx, 8)
 		{
-			p.SetState(467)
+			p.SetState(482)
 			p.Match(KuneiformParserDROP)
 			if p.HasError() {
 				// Recognition error - abort rule
@@ -7898,19 +8018,19 @@ func (p *KuneiformParser) Alter_table_action() (localctx IAlter_table_actionCont
 			}
 		}
 		{
-			p.SetState(468)
+			p.SetState(483)
 			p.Match(KuneiformParserCONSTRAINT)
 			if p.HasError() {
 				// Recognition error - abort rule
 				goto errorExit
 			}
 		}
-		p.SetState(471)
+		p.SetState(486)
 		p.GetErrorHandler().Sync(p)
 
 		if p.GetInterpreter().AdaptivePredict(p.BaseParser, p.GetTokenStream(), 42, p.GetParserRuleContext()) == 1 {
 			{
-				p.SetState(469)
+				p.SetState(484)
 				p.Match(KuneiformParserIF)
 				if p.HasError() {
 					// Recognition error - abort rule
@@ -7918,7 +8038,7 @@ func (p *KuneiformParser) Alter_table_action() (localctx IAlter_table_actionCont
 				}
 			}
 			{
-				p.SetState(470)
+				p.SetState(485)
 				p.Match(KuneiformParserEXISTS)
 				if p.HasError() {
 					// Recognition error - abort rule
@@ -7930,7 +8050,7 @@ func (p *KuneiformParser) Alter_table_action() (localctx IAlter_table_actionCont
 			goto errorExit
 		}
 		{
-			p.SetState(473)
+			p.SetState(488)
 			p.Identifier()
 		}
 
@@ -8159,14 +8279,14 @@ func (p *KuneiformParser) Create_index_statement() (localctx ICreate_index_state
 
 	p.EnterOuterAlt(localctx, 1)
 	{
-		p.SetState(476)
+		p.SetState(491)
 		p.Match(KuneiformParserCREATE)
 		if p.HasError() {
 			// Recognition error - abort rule
 			goto errorExit
 		}
 	}
-	p.SetState(478)
+	p.SetState(493)
 	p.GetErrorHandler().Sync(p)
 	if p.HasError() {
 		goto errorExit
@@ -8175,7 +8295,7 @@ func (p *KuneiformParser) Create_index_statement() (localctx ICreate_index_state
 
 	if _la == KuneiformParserUNIQUE {
 		{
-			p.SetState(477)
+			p.SetState(492)
 			p.Match(KuneiformParserUNIQUE)
 			if p.HasError() {
 				// Recognition error - abort rule
@@ -8185,19 +8305,19 @@ func (p *KuneiformParser) Create_index_statement() (localctx ICreate_index_state
 
 	}
 	{
-		p.SetState(480)
+		p.SetState(495)
 		p.Match(KuneiformParserINDEX)
 		if p.HasError() {
 			// Recognition error - abort rule
 			goto errorExit
 		}
 	}
-	p.SetState(484)
+	p.SetState(499)
 	p.GetErrorHandler().Sync(p)
 
 	if p.GetInterpreter().AdaptivePredict(p.BaseParser, p.GetTokenStream(), 45, p.GetParserRuleContext()) == 1 {
 		{
-			p.SetState(481)
+			p.SetState(496)
 			p.Match(KuneiformParserIF)
 			if p.HasError() {
 				// Recognition error - abort rule
@@ -8205,7 +8325,7 @@ func (p *KuneiformParser) Create_index_statement() (localctx ICreate_index_state
 			}
 		}
 		{
-			p.SetState(482)
+			p.SetState(497)
 			p.Match(KuneiformParserNOT)
 			if p.HasError() {
 				// Recognition error - abort rule
@@ -8213,7 +8333,7 @@ func (p *KuneiformParser) Create_index_statement() (localctx ICreate_index_state
 			}
 		}
 		{
-			p.SetState(483)
+			p.SetState(498)
 			p.Match(KuneiformParserEXISTS)
 			if p.HasError() {
 				// Recognition error - abort rule
@@ -8224,7 +8344,7 @@ func (p *KuneiformParser) Create_index_statement() (localctx ICreate_index_state
 	} else if p.HasError() { // JIM
 		goto errorExit
 	}
-	p.SetState(487)
+	p.SetState(502)
 	p.GetErrorHandler().Sync(p)
 	if p.HasError() {
 		goto errorExit
@@ -8233,7 +8353,7 @@ func (p *KuneiformParser) Create_index_statement() (localctx ICreate_index_state
 
 	if ((int64(_la) & ^0x3f) == 0 && ((int64(1)<<_la)&-9127724498152325120) != 0) || ((int64((_la-87)) & ^0x3f) == 0 && ((int64(1)<<(_la-87))&2306959842411020289) != 0) {
 		{
-			p.SetState(486)
+			p.SetState(501)
 
 			var _x = p.Identifier()
 
@@ -8242,7 +8362,7 @@ func (p *KuneiformParser) Create_index_statement() (localctx ICreate_index_state
 
 	}
 	{
-		p.SetState(489)
+		p.SetState(504)
 		p.Match(KuneiformParserON)
 		if p.HasError() {
 			// Recognition error - abort rule
@@ -8250,14 +8370,14 @@ func (p *KuneiformParser) Create_index_statement() (localctx ICreate_index_state
 		}
 	}
 	{
-		p.SetState(490)
+		p.SetState(505)
 
 		var _x = p.Identifier()
 
 		localctx.(*Create_index_statementContext).table = _x
 	}
 	{
-		p.SetState(491)
+		p.SetState(506)
 		p.Match(KuneiformParserLPAREN)
 		if p.HasError() {
 			// Recognition error - abort rule
@@ -8265,14 +8385,14 @@ func (p *KuneiformParser) Create_index_statement() (localctx ICreate_index_state
 		}
 	}
 	{
-		p.SetState(492)
+		p.SetState(507)
 
 		var _x = p.Identifier_list()
 
 		localctx.(*Create_index_statementContext).columns = _x
 	}
 	{
-		p.SetState(493)
+		p.SetState(508)
 		p.Match(KuneiformParserRPAREN)
 		if p.HasError() {
 			// Recognition error - abort rule
@@ -8409,7 +8529,7 @@ func (p *KuneiformParser) Drop_index_statement() (localctx IDrop_index_statement
 	p.EnterRule(localctx, 50, KuneiformParserRULE_drop_index_statement)
 	p.EnterOuterAlt(localctx, 1)
 	{
-		p.SetState(495)
+		p.SetState(510)
 		p.Match(KuneiformParserDROP)
 		if p.HasError() {
 			// Recognition error - abort rule
@@ -8417,19 +8537,19 @@ func (p *KuneiformParser) Drop_index_statement() (localctx IDrop_index_statement
 		}
 	}
 	{
-		p.SetState(496)
+		p.SetState(511)
 		p.Match(KuneiformParserINDEX)
 		if p.HasError() {
 			// Recognition error - abort rule
 			goto errorExit
 		}
 	}
-	p.SetState(499)
+	p.SetState(514)
 	p.GetErrorHandler().Sync(p)
 
 	if p.GetInterpreter().AdaptivePredict(p.BaseParser, p.GetTokenStream(), 47, p.GetParserRuleContext()) == 1 {
 		{
-			p.SetState(497)
+			p.SetState(512)
 			p.Match(KuneiformParserIF)
 			if p.HasError() {
 				// Recognition error - abort rule
@@ -8437,7 +8557,7 @@ func (p *KuneiformParser) Drop_index_statement() (localctx IDrop_index_statement
 			}
 		}
 		{
-			p.SetState(498)
+			p.SetState(513)
 			p.Match(KuneiformParserEXISTS)
 			if p.HasError() {
 				// Recognition error - abort rule
@@ -8449,7 +8569,7 @@ func (p *KuneiformParser) Drop_index_statement() (localctx IDrop_index_statement
 		goto errorExit
 	}
 	{
-		p.SetState(501)
+		p.SetState(516)
 
 		var _x = p.Identifier()
 
@@ -8579,7 +8699,7 @@ func (p *KuneiformParser) Create_role_statement() (localctx ICreate_role_stateme
 	p.EnterRule(localctx, 52, KuneiformParserRULE_create_role_statement)
 	p.EnterOuterAlt(localctx, 1)
 	{
-		p.SetState(503)
+		p.SetState(518)
 		p.Match(KuneiformParserCREATE)
 		if p.HasError() {
 			// Recognition error - abort rule
@@ -8587,19 +8707,19 @@ func (p *KuneiformParser) Create_role_statement() (localctx ICreate_role_stateme
 		}
 	}
 	{
-		p.SetState(504)
+		p.SetState(519)
 		p.Match(KuneiformParserROLE)
 		if p.HasError() {
 			// Recognition error - abort rule
 			goto errorExit
 		}
 	}
-	p.SetState(508)
+	p.SetState(523)
 	p.GetErrorHandler().Sync(p)
 
 	if p.GetInterpreter().AdaptivePredict(p.BaseParser, p.GetTokenStream(), 48, p.GetParserRuleContext()) == 1 {
 		{
-			p.SetState(505)
+			p.SetState(520)
 			p.Match(KuneiformParserIF)
 			if p.HasError() {
 				// Recognition error - abort rule
@@ -8607,7 +8727,7 @@ func (p *KuneiformParser) Create_role_statement() (localctx ICreate_role_stateme
 			}
 		}
 		{
-			p.SetState(506)
+			p.SetState(521)
 			p.Match(KuneiformParserNOT)
 			if p.HasError() {
 				// Recognition error - abort rule
@@ -8615,7 +8735,7 @@ func (p *KuneiformParser) Create_role_statement() (localctx ICreate_role_stateme
 			}
 		}
 		{
-			p.SetState(507)
+			p.SetState(522)
 			p.Match(KuneiformParserEXISTS)
 			if p.HasError() {
 				// Recognition error - abort rule
@@ -8627,7 +8747,7 @@ func (p *KuneiformParser) Create_role_statement() (localctx ICreate_role_stateme
 		goto errorExit
 	}
 	{
-		p.SetState(510)
+		p.SetState(525)
 		p.Identifier()
 	}
 
@@ -8749,7 +8869,7 @@ func (p *KuneiformParser) Drop_role_statement() (localctx IDrop_role_statementCo
 	p.EnterRule(localctx, 54, KuneiformParserRULE_drop_role_statement)
 	p.EnterOuterAlt(localctx, 1)
 	{
-		p.SetState(512)
+		p.SetState(527)
 		p.Match(KuneiformParserDROP)
 		if p.HasError() {
 			// Recognition error - abort rule
@@ -8757,19 +8877,19 @@ func (p *KuneiformParser) Drop_role_statement() (localctx IDrop_role_statementCo
 		}
 	}
 	{
-		p.SetState(513)
+		p.SetState(528)
 		p.Match(KuneiformParserROLE)
 		if p.HasError() {
 			// Recognition error - abort rule
 			goto errorExit
 		}
 	}
-	p.SetState(516)
+	p.SetState(531)
 	p.GetErrorHandler().Sync(p)
 
 	if p.GetInterpreter().AdaptivePredict(p.BaseParser, p.GetTokenStream(), 49, p.GetParserRuleContext()) == 1 {
 		{
-			p.SetState(514)
+			p.SetState(529)
 			p.Match(KuneiformParserIF)
 			if p.HasError() {
 				// Recognition error - abort rule
@@ -8777,7 +8897,7 @@ func (p *KuneiformParser) Drop_role_statement() (localctx IDrop_role_statementCo
 			}
 		}
 		{
-			p.SetState(515)
+			p.SetState(530)
 			p.Match(KuneiformParserEXISTS)
 			if p.HasError() {
 				// Recognition error - abort rule
@@ -8789,7 +8909,7 @@ func (p *KuneiformParser) Drop_role_statement() (localctx IDrop_role_statementCo
 		goto errorExit
 	}
 	{
-		p.SetState(518)
+		p.SetState(533)
 		p.Identifier()
 	}
 
@@ -9043,19 +9163,19 @@ func (p *KuneiformParser) Grant_statement() (localctx IGrant_statementContext) {
 
 	p.EnterOuterAlt(localctx, 1)
 	{
-		p.SetState(520)
+		p.SetState(535)
 		p.Match(KuneiformParserGRANT)
 		if p.HasError() {
 			// Recognition error - abort rule
 			goto errorExit
 		}
 	}
-	p.SetState(524)
+	p.SetState(539)
 	p.GetErrorHandler().Sync(p)
 
 	if p.GetInterpreter().AdaptivePredict(p.BaseParser, p.GetTokenStream(), 50, p.GetParserRuleContext()) == 1 {
 		{
-			p.SetState(521)
+			p.SetState(536)
 			p.Match(KuneiformParserIF)
 			if p.HasError() {
 				// Recognition error - abort rule
@@ -9063,7 +9183,7 @@ func (p *KuneiformParser) Grant_statement() (localctx IGrant_statementContext) {
 			}
 		}
 		{
-			p.SetState(522)
+			p.SetState(537)
 			p.Match(KuneiformParserNOT)
 			if p.HasError() {
 				// Recognition error - abort rule
@@ -9071,7 +9191,7 @@ func (p *KuneiformParser) Grant_statement() (localctx IGrant_statementContext) {
 			}
 		}
 		{
-			p.SetState(523)
+			p.SetState(538)
 			p.Match(KuneiformParserGRANTED)
 			if p.HasError() {
 				// Recognition error - abort rule
@@ -9082,7 +9202,7 @@ func (p *KuneiformParser) Grant_statement() (localctx IGrant_statementContext) {
 	} else if p.HasError() { // JIM
 		goto errorExit
 	}
-	p.SetState(528)
+	p.SetState(543)
 	p.GetErrorHandler().Sync(p)
 	if p.HasError() {
 		goto errorExit
@@ -9091,13 +9211,13 @@ func (p *KuneiformParser) Grant_statement() (localctx IGrant_statementContext) {
 	switch p.GetInterpreter().AdaptivePredict(p.BaseParser, p.GetTokenStream(), 51, p.GetParserRuleContext()) {
 	case 1:
 		{
-			p.SetState(526)
+			p.SetState(541)
 			p.Privilege_list()
 		}
 
 	case 2:
 		{
-			p.SetState(527)
+			p.SetState(542)
 
 			var _x = p.Identifier()
 
@@ -9107,7 +9227,7 @@ func (p *KuneiformParser) Grant_statement() (localctx IGrant_statementContext) {
 	case antlr.ATNInvalidAltNumber:
 		goto errorExit
 	}
-	p.SetState(532)
+	p.SetState(547)
 	p.GetErrorHandler().Sync(p)
 	if p.HasError() {
 		goto errorExit
@@ -9116,7 +9236,7 @@ func (p *KuneiformParser) Grant_statement() (localctx IGrant_statementContext) {
 
 	if _la == KuneiformParserON {
 		{
-			p.SetState(530)
+			p.SetState(545)
 			p.Match(KuneiformParserON)
 			if p.HasError() {
 				// Recognition error - abort rule
@@ -9124,7 +9244,7 @@ func (p *KuneiformParser) Grant_statement() (localctx IGrant_statementContext) {
 			}
 		}
 		{
-			p.SetState(531)
+			p.SetState(546)
 
 			var _x = p.Identifier()
 
@@ -9133,14 +9253,14 @@ func (p *KuneiformParser) Grant_statement() (localctx IGrant_statementContext) {
 
 	}
 	{
-		p.SetState(534)
+		p.SetState(549)
 		p.Match(KuneiformParserTO)
 		if p.HasError() {
 			// Recognition error - abort rule
 			goto errorExit
 		}
 	}
-	p.SetState(538)
+	p.SetState(553)
 	p.GetErrorHandler().Sync(p)
 	if p.HasError() {
 		goto errorExit
@@ -9149,7 +9269,7 @@ func (p *KuneiformParser) Grant_statement() (localctx IGrant_statementContext) {
 	switch p.GetInterpreter().AdaptivePredict(p.BaseParser, p.GetTokenStream(), 53, p.GetParserRuleContext()) {
 	case 1:
 		{
-			p.SetState(535)
+			p.SetState(550)
 
 			var _x = p.Identifier()
 
@@ -9158,7 +9278,7 @@ func (p *KuneiformParser) Grant_statement() (localctx IGrant_statementContext) {
 
 	case 2:
 		{
-			p.SetState(536)
+			p.SetState(551)
 
 			var _m = p.Match(KuneiformParserSTRING_)
 
@@ -9171,7 +9291,7 @@ func (p *KuneiformParser) Grant_statement() (localctx IGrant_statementContext) {
 
 	case 3:
 		{
-			p.SetState(537)
+			p.SetState(552)
 
 			var _x = p.action_expr(0)
 
@@ -9427,19 +9547,19 @@ func (p *KuneiformParser) Revoke_statement() (localctx IRevoke_statementContext)
 
 	p.EnterOuterAlt(localctx, 1)
 	{
-		p.SetState(540)
+		p.SetState(555)
 		p.Match(KuneiformParserREVOKE)
 		if p.HasError() {
 			// Recognition error - abort rule
 			goto errorExit
 		}
 	}
-	p.SetState(543)
+	p.SetState(558)
 	p.GetErrorHandler().Sync(p)
 
 	if p.GetInterpreter().AdaptivePredict(p.BaseParser, p.GetTokenStream(), 54, p.GetParserRuleContext()) == 1 {
 		{
-			p.SetState(541)
+			p.SetState(556)
 			p.Match(KuneiformParserIF)
 			if p.HasError() {
 				// Recognition error - abort rule
@@ -9447,7 +9567,7 @@ func (p *KuneiformParser) Revoke_statement() (localctx IRevoke_statementContext)
 			}
 		}
 		{
-			p.SetState(542)
+			p.SetState(557)
 			p.Match(KuneiformParserGRANTED)
 			if p.HasError() {
 				// Recognition error - abort rule
@@ -9458,7 +9578,7 @@ func (p *KuneiformParser) Revoke_statement() (localctx IRevoke_statementContext)
 	} else if p.HasError() { // JIM
 		goto errorExit
 	}
-	p.SetState(547)
+	p.SetState(562)
 	p.GetErrorHandler().Sync(p)
 	if p.HasError() {
 		goto errorExit
@@ -9467,13 +9587,13 @@ func (p *KuneiformParser) Revoke_statement() (localctx IRevoke_statementContext)
 	switch p.GetInterpreter().AdaptivePredict(p.BaseParser, p.GetTokenStream(), 55, p.GetParserRuleContext()) {
 	case 1:
 		{
-			p.SetState(545)
+			p.SetState(560)
 			p.Privilege_list()
 		}
 
 	case 2:
 		{
-			p.SetState(546)
+			p.SetState(561)
 
 			var _x = p.Identifier()
 
@@ -9483,7 +9603,7 @@ func (p *KuneiformParser) Revoke_statement() (localctx IRevoke_statementContext)
 	case antlr.ATNInvalidAltNumber:
 		goto errorExit
 	}
-	p.SetState(551)
+	p.SetState(566)
 	p.GetErrorHandler().Sync(p)
 	if p.HasError() {
 		goto errorExit
@@ -9492,7 +9612,7 @@ func (p *KuneiformParser) Revoke_statement() (localctx IRevoke_statementContext)
 
 	if _la == KuneiformParserON {
 		{
-			p.SetState(549)
+			p.SetState(564)
 			p.Match(KuneiformParserON)
 			if p.HasError() {
 				// Recognition error - abort rule
@@ -9500,7 +9620,7 @@ func (p *KuneiformParser) Revoke_statement() (localctx IRevoke_statementContext)
 			}
 		}
 		{
-			p.SetState(550)
+			p.SetState(565)
 
 			var _x = p.Identifier()
 
@@ -9509,14 +9629,14 @@ func (p *KuneiformParser) Revoke_statement() (localctx IRevoke_statementContext)
 
 	}
 	{
-		p.SetState(553)
+		p.SetState(568)
 		p.Match(KuneiformParserFROM)
 		if p.HasError() {
 			// Recognition error - abort rule
 			goto errorExit
 		}
 	}
-	p.SetState(557)
+	p.SetState(572)
 	p.GetErrorHandler().Sync(p)
 	if p.HasError() {
 		goto errorExit
@@ -9525,7 +9645,7 @@ func (p *KuneiformParser) Revoke_statement() (localctx IRevoke_statementContext)
 	switch p.GetInterpreter().AdaptivePredict(p.BaseParser, p.GetTokenStream(), 57, p.GetParserRuleContext()) {
 	case 1:
 		{
-			p.SetState(554)
+			p.SetState(569)
 
 			var _x = p.Identifier()
 
@@ -9534,7 +9654,7 @@ func (p *KuneiformParser) Revoke_statement() (localctx IRevoke_statementContext)
 
 	case 2:
 		{
-			p.SetState(555)
+			p.SetState(570)
 
 			var _m = p.Match(KuneiformParserSTRING_)
 
@@ -9547,7 +9667,7 @@ func (p *KuneiformParser) Revoke_statement() (localctx IRevoke_statementContext)
 
 	case 3:
 		{
-			p.SetState(556)
+			p.SetState(571)
 
 			var _x = p.action_expr(0)
 
@@ -9698,7 +9818,7 @@ func (p *KuneiformParser) Transfer_ownership_statement() (localctx ITransfer_own
 	p.EnterRule(localctx, 60, KuneiformParserRULE_transfer_ownership_statement)
 	p.EnterOuterAlt(localctx, 1)
 	{
-		p.SetState(559)
+		p.SetState(574)
 		p.Match(KuneiformParserTRANSFER)
 		if p.HasError() {
 			// Recognition error - abort rule
@@ -9706,7 +9826,7 @@ func (p *KuneiformParser) Transfer_ownership_statement() (localctx ITransfer_own
 		}
 	}
 	{
-		p.SetState(560)
+		p.SetState(575)
 		p.Match(KuneiformParserOWNERSHIP)
 		if p.HasError() {
 			// Recognition error - abort rule
@@ -9714,14 +9834,14 @@ func (p *KuneiformParser) Transfer_ownership_statement() (localctx ITransfer_own
 		}
 	}
 	{
-		p.SetState(561)
+		p.SetState(576)
 		p.Match(KuneiformParserTO)
 		if p.HasError() {
 			// Recognition error - abort rule
 			goto errorExit
 		}
 	}
-	p.SetState(564)
+	p.SetState(579)
 	p.GetErrorHandler().Sync(p)
 	if p.HasError() {
 		goto errorExit
@@ -9730,7 +9850,7 @@ func (p *KuneiformParser) Transfer_ownership_statement() (localctx ITransfer_own
 	switch p.GetInterpreter().AdaptivePredict(p.BaseParser, p.GetTokenStream(), 58, p.GetParserRuleContext()) {
 	case 1:
 		{
-			p.SetState(562)
+			p.SetState(577)
 
 			var _m = p.Match(KuneiformParserSTRING_)
 
@@ -9743,7 +9863,7 @@ func (p *KuneiformParser) Transfer_ownership_statement() (localctx ITransfer_own
 
 	case 2:
 		{
-			p.SetState(563)
+			p.SetState(578)
 
 			var _x = p.action_expr(0)
 
@@ -9890,10 +10010,10 @@ func (p *KuneiformParser) Privilege_list() (localctx IPrivilege_listContext) {
 
 	p.EnterOuterAlt(localctx, 1)
 	{
-		p.SetState(566)
+		p.SetState(581)
 		p.Privilege()
 	}
-	p.SetState(571)
+	p.SetState(586)
 	p.GetErrorHandler().Sync(p)
 	if p.HasError() {
 		goto errorExit
@@ -9902,7 +10022,7 @@ func (p *KuneiformParser) Privilege_list() (localctx IPrivilege_listContext) {
 
 	for _la == KuneiformParserCOMMA {
 		{
-			p.SetState(567)
+			p.SetState(582)
 			p.Match(KuneiformParserCOMMA)
 			if p.HasError() {
 				// Recognition error - abort rule
@@ -9910,11 +10030,11 @@ func (p *KuneiformParser) Privilege_list() (localctx IPrivilege_listContext) {
 			}
 		}
 		{
-			p.SetState(568)
+			p.SetState(583)
 			p.Privilege()
 		}
 
-		p.SetState(573)
+		p.SetState(588)
 		p.GetErrorHandler().Sync(p)
 		if p.HasError() {
 			goto errorExit
@@ -10055,7 +10175,7 @@ func (p *KuneiformParser) Privilege() (localctx IPrivilegeContext) {
 
 	p.EnterOuterAlt(localctx, 1)
 	{
-		p.SetState(574)
+		p.SetState(589)
 		_la = p.GetTokenStream().LA(1)
 
 		if !(((int64(_la) & ^0x3f) == 0 && ((int64(1)<<_la)&864696368315236352) != 0) || ((int64((_la-98)) & ^0x3f) == 0 && ((int64(1)<<(_la-98))&412316860419) != 0)) {
@@ -10100,10 +10220,8 @@ type ICreate_action_statementContext interface {
 	IF() antlr.TerminalNode
 	NOT() antlr.TerminalNode
 	EXISTS() antlr.TerminalNode
-	AllVARIABLE() []antlr.TerminalNode
-	VARIABLE(i int) antlr.TerminalNode
-	AllType_() []ITypeContext
-	Type_(i int) ITypeContext
+	AllAction_parameter() []IAction_parameterContext
+	Action_parameter(i int) IAction_parameterContext
 	Action_return() IAction_returnContext
 	AllAction_statement() []IAction_statementContext
 	Action_statement(i int) IAction_statementContext
@@ -10231,28 +10349,20 @@ func (s *Create_action_statementContext) EXISTS() antlr.TerminalNode {
 	return s.GetToken(KuneiformParserEXISTS, 0)
 }
 
-func (s *Create_action_statementContext) AllVARIABLE() []antlr.TerminalNode {
-	return s.GetTokens(KuneiformParserVARIABLE)
-}
-
-func (s *Create_action_statementContext) VARIABLE(i int) antlr.TerminalNode {
-	return s.GetToken(KuneiformParserVARIABLE, i)
-}
-
-func (s *Create_action_statementContext) AllType_() []ITypeContext {
+func (s *Create_action_statementContext) AllAction_parameter() []IAction_parameterContext {
 	children := s.GetChildren()
 	len := 0
 	for _, ctx := range children {
-		if _, ok := ctx.(ITypeContext); ok {
+		if _, ok := ctx.(IAction_parameterContext); ok {
 			len++
 		}
 	}
 
-	tst := make([]ITypeContext, len)
+	tst := make([]IAction_parameterContext, len)
 	i := 0
 	for _, ctx := range children {
-		if t, ok := ctx.(ITypeContext); ok {
-			tst[i] = t.(ITypeContext)
+		if t, ok := ctx.(IAction_parameterContext); ok {
+			tst[i] = t.(IAction_parameterContext)
 			i++
 		}
 	}
@@ -10260,11 +10370,11 @@ func (s *Create_action_statementContext) AllType_() []ITypeContext {
 	return tst
 }
 
-func (s *Create_action_statementContext) Type_(i int) ITypeContext {
+func (s *Create_action_statementContext) Action_parameter(i int) IAction_parameterContext {
 	var t antlr.RuleContext
 	j := 0
 	for _, ctx := range s.GetChildren() {
-		if _, ok := ctx.(ITypeContext); ok {
+		if _, ok := ctx.(IAction_parameterContext); ok {
 			if j == i {
 				t = ctx.(antlr.RuleContext)
 				break
@@ -10277,7 +10387,7 @@ func (s *Create_action_statementContext) Type_(i int) ITypeContext {
 		return nil
 	}
 
-	return t.(ITypeContext)
+	return t.(IAction_parameterContext)
 }
 
 func (s *Create_action_statementContext) Action_return() IAction_returnContext {
@@ -10372,14 +10482,14 @@ func (p *KuneiformParser) Create_action_statement() (localctx ICreate_action_sta
 
 	p.EnterOuterAlt(localctx, 1)
 	{
-		p.SetState(576)
+		p.SetState(591)
 		p.Match(KuneiformParserCREATE)
 		if p.HasError() {
 			// Recognition error - abort rule
 			goto errorExit
 		}
 	}
-	p.SetState(579)
+	p.SetState(594)
 	p.GetErrorHandler().Sync(p)
 	if p.HasError() {
 		goto errorExit
@@ -10388,7 +10498,7 @@ func (p *KuneiformParser) Create_action_statement() (localctx ICreate_action_sta
 
 	if _la == KuneiformParserOR {
 		{
-			p.SetState(577)
+			p.SetState(592)
 			p.Match(KuneiformParserOR)
 			if p.HasError() {
 				// Recognition error - abort rule
@@ -10396,7 +10506,7 @@ func (p *KuneiformParser) Create_action_statement() (localctx ICreate_action_sta
 			}
 		}
 		{
-			p.SetState(578)
+			p.SetState(593)
 			p.Match(KuneiformParserREPLACE)
 			if p.HasError() {
 				// Recognition error - abort rule
@@ -10406,19 +10516,19 @@ func (p *KuneiformParser) Create_action_statement() (localctx ICreate_action_sta
 
 	}
 	{
-		p.SetState(581)
+		p.SetState(596)
 		p.Match(KuneiformParserACTION)
 		if p.HasError() {
 			// Recognition error - abort rule
 			goto errorExit
 		}
 	}
-	p.SetState(585)
+	p.SetState(600)
 	p.GetErrorHandler().Sync(p)
 
 	if p.GetInterpreter().AdaptivePredict(p.BaseParser, p.GetTokenStream(), 61, p.GetParserRuleContext()) == 1 {
 		{
-			p.SetState(582)
+			p.SetState(597)
 			p.Match(KuneiformParserIF)
 			if p.HasError() {
 				// Recognition error - abort rule
@@ -10426,7 +10536,7 @@ func (p *KuneiformParser) Create_action_statement() (localctx ICreate_action_sta
 			}
 		}
 		{
-			p.SetState(583)
+			p.SetState(598)
 			p.Match(KuneiformParserNOT)
 			if p.HasError() {
 				// Recognition error - abort rule
@@ -10434,7 +10544,7 @@ func (p *KuneiformParser) Create_action_statement() (localctx ICreate_action_sta
 			}
 		}
 		{
-			p.SetState(584)
+			p.SetState(599)
 			p.Match(KuneiformParserEXISTS)
 			if p.HasError() {
 				// Recognition error - abort rule
@@ -10446,18 +10556,18 @@ func (p *KuneiformParser) Create_action_statement() (localctx ICreate_action_sta
 		goto errorExit
 	}
 	{
-		p.SetState(587)
+		p.SetState(602)
 		p.Identifier()
 	}
 	{
-		p.SetState(588)
+		p.SetState(603)
 		p.Match(KuneiformParserLPAREN)
 		if p.HasError() {
 			// Recognition error - abort rule
 			goto errorExit
 		}
 	}
-	p.SetState(599)
+	p.SetState(612)
 	p.GetErrorHandler().Sync(p)
 	if p.HasError() {
 		goto errorExit
@@ -10466,18 +10576,10 @@ func (p *KuneiformParser) Create_action_statement() (localctx ICreate_action_sta
 
 	if _la == KuneiformParserVARIABLE {
 		{
-			p.SetState(589)
-			p.Match(KuneiformParserVARIABLE)
-			if p.HasError() {
-				// Recognition error - abort rule
-				goto errorExit
-			}
-		}
-		{
-			p.SetState(590)
-			p.Type_()
+			p.SetState(604)
+			p.Action_parameter()
 		}
-		p.SetState(596)
+		p.SetState(609)
 		p.GetErrorHandler().Sync(p)
 		if p.HasError() {
 			goto errorExit
@@ -10486,7 +10588,7 @@ func (p *KuneiformParser) Create_action_statement() (localctx ICreate_action_sta
 
 		for _la == KuneiformParserCOMMA {
 			{
-				p.SetState(591)
+				p.SetState(605)
 				p.Match(KuneiformParserCOMMA)
 				if p.HasError() {
 					// Recognition error - abort rule
@@ -10494,19 +10596,11 @@ func (p *KuneiformParser) Create_action_statement() (localctx ICreate_action_sta
 				}
 			}
 			{
-				p.SetState(592)
-				p.Match(KuneiformParserVARIABLE)
-				if p.HasError() {
-					// Recognition error - abort rule
-					goto errorExit
-				}
-			}
-			{
-				p.SetState(593)
-				p.Type_()
+				p.SetState(606)
+				p.Action_parameter()
 			}
 
-			p.SetState(598)
+			p.SetState(611)
 			p.GetErrorHandler().Sync(p)
 			if p.HasError() {
 				goto errorExit
@@ -10516,14 +10610,14 @@ func (p *KuneiformParser) Create_action_statement() (localctx ICreate_action_sta
 
 	}
 	{
-		p.SetState(601)
+		p.SetState(614)
 		p.Match(KuneiformParserRPAREN)
 		if p.HasError() {
 			// Recognition error - abort rule
 			goto errorExit
 		}
 	}
-	p.SetState(605)
+	p.SetState(618)
 	p.GetErrorHandler().Sync(p)
 	if p.HasError() {
 		goto errorExit
@@ -10535,12 +10629,12 @@ func (p *KuneiformParser) Create_action_statement() (localctx ICreate_action_sta
 	for _alt != 2 && _alt != antlr.ATNInvalidAltNumber {
 		if _alt == 1 {
 			{
-				p.SetState(602)
+				p.SetState(615)
 				p.Identifier()
 			}
 
 		}
-		p.SetState(607)
+		p.SetState(620)
 		p.GetErrorHandler().Sync(p)
 		if p.HasError() {
 			goto errorExit
@@ -10550,7 +10644,7 @@ func (p *KuneiformParser) Create_action_statement() (localctx ICreate_action_sta
 			goto errorExit
 		}
 	}
-	p.SetState(609)
+	p.SetState(622)
 	p.GetErrorHandler().Sync(p)
 	if p.HasError() {
 		goto errorExit
@@ -10559,20 +10653,20 @@ func (p *KuneiformParser) Create_action_statement() (localctx ICreate_action_sta
 
 	if _la == KuneiformParserRETURNS {
 		{
-			p.SetState(608)
+			p.SetState(621)
 			p.Action_return()
 		}
 
 	}
 	{
-		p.SetState(611)
+		p.SetState(624)
 		p.Match(KuneiformParserLBRACE)
 		if p.HasError() {
 			// Recognition error - abort rule
 			goto errorExit
 		}
 	}
-	p.SetState(615)
+	p.SetState(628)
 	p.GetErrorHandler().Sync(p)
 	if p.HasError() {
 		goto errorExit
@@ -10581,11 +10675,11 @@ func (p *KuneiformParser) Create_action_statement() (localctx ICreate_action_sta
 
 	for ((int64(_la) & ^0x3f) == 0 && ((int64(1)<<_la)&-3507232162117056376) != 0) || ((int64((_la-87)) & ^0x3f) == 0 && ((int64(1)<<(_la-87))&-2269814482811217915) != 0) {
 		{
-			p.SetState(612)
+			p.SetState(625)
 			p.Action_statement()
 		}
 
-		p.SetState(617)
+		p.SetState(630)
 		p.GetErrorHandler().Sync(p)
 		if p.HasError() {
 			goto errorExit
@@ -10593,7 +10687,7 @@ func (p *KuneiformParser) Create_action_statement() (localctx ICreate_action_sta
 		_la = p.GetTokenStream().LA(1)
 	}
 	{
-		p.SetState(618)
+		p.SetState(631)
 		p.Match(KuneiformParserRBRACE)
 		if p.HasError() {
 			// Recognition error - abort rule
@@ -10719,7 +10813,7 @@ func (p *KuneiformParser) Drop_action_statement() (localctx IDrop_action_stateme
 	p.EnterRule(localctx, 68, KuneiformParserRULE_drop_action_statement)
 	p.EnterOuterAlt(localctx, 1)
 	{
-		p.SetState(620)
+		p.SetState(633)
 		p.Match(KuneiformParserDROP)
 		if p.HasError() {
 			// Recognition error - abort rule
@@ -10727,19 +10821,19 @@ func (p *KuneiformParser) Drop_action_statement() (localctx IDrop_action_stateme
 		}
 	}
 	{
-		p.SetState(621)
+		p.SetState(634)
 		p.Match(KuneiformParserACTION)
 		if p.HasError() {
 			// Recognition error - abort rule
 			goto errorExit
 		}
 	}
-	p.SetState(624)
+	p.SetState(637)
 	p.GetErrorHandler().Sync(p)
 
 	if p.GetInterpreter().AdaptivePredict(p.BaseParser, p.GetTokenStream(), 67, p.GetParserRuleContext()) == 1 {
 		{
-			p.SetState(622)
+			p.SetState(635)
 			p.Match(KuneiformParserIF)
 			if p.HasError() {
 				// Recognition error - abort rule
@@ -10747,7 +10841,7 @@ func (p *KuneiformParser) Drop_action_statement() (localctx IDrop_action_stateme
 			}
 		}
 		{
-			p.SetState(623)
+			p.SetState(636)
 			p.Match(KuneiformParserEXISTS)
 			if p.HasError() {
 				// Recognition error - abort rule
@@ -10759,7 +10853,7 @@ func (p *KuneiformParser) Drop_action_statement() (localctx IDrop_action_stateme
 		goto errorExit
 	}
 	{
-		p.SetState(626)
+		p.SetState(639)
 		p.Identifier()
 	}
 
@@ -11013,19 +11107,19 @@ func (p *KuneiformParser) Use_extension_statement() (localctx IUse_extension_sta
 
 	p.EnterOuterAlt(localctx, 1)
 	{
-		p.SetState(628)
+		p.SetState(641)
 		p.Match(KuneiformParserUSE)
 		if p.HasError() {
 			// Recognition error - abort rule
 			goto errorExit
 		}
 	}
-	p.SetState(632)
+	p.SetState(645)
 	p.GetErrorHandler().Sync(p)
 
 	if p.GetInterpreter().AdaptivePredict(p.BaseParser, p.GetTokenStream(), 68, p.GetParserRuleContext()) == 1 {
 		{
-			p.SetState(629)
+			p.SetState(642)
 			p.Match(KuneiformParserIF)
 			if p.HasError() {
 				// Recognition error - abort rule
@@ -11033,7 +11127,7 @@ func (p *KuneiformParser) Use_extension_statement() (localctx IUse_extension_sta
 			}
 		}
 		{
-			p.SetState(630)
+			p.SetState(643)
 			p.Match(KuneiformParserNOT)
 			if p.HasError() {
 				// Recognition error - abort rule
@@ -11041,7 +11135,7 @@ func (p *KuneiformParser) Use_extension_statement() (localctx IUse_extension_sta
 			}
 		}
 		{
-			p.SetState(631)
+			p.SetState(644)
 			p.Match(KuneiformParserEXISTS)
 			if p.HasError() {
 				// Recognition error - abort rule
@@ -11053,13 +11147,13 @@ func (p *KuneiformParser) Use_extension_statement() (localctx IUse_extension_sta
 		goto errorExit
 	}
 	{
-		p.SetState(634)
+		p.SetState(647)
 
 		var _x = p.Identifier()
 
 		localctx.(*Use_extension_statementContext).extension_name = _x
 	}
-	p.SetState(652)
+	p.SetState(665)
 	p.GetErrorHandler().Sync(p)
 	if p.HasError() {
 		goto errorExit
@@ -11068,14 +11162,14 @@ func (p *KuneiformParser) Use_extension_statement() (localctx IUse_extension_sta
 
 	if _la == KuneiformParserLBRACE {
 		{
-			p.SetState(635)
+			p.SetState(648)
 			p.Match(KuneiformParserLBRACE)
 			if p.HasError() {
 				// Recognition error - abort rule
 				goto errorExit
 			}
 		}
-		p.SetState(649)
+		p.SetState(662)
 		p.GetErrorHandler().Sync(p)
 		if p.HasError() {
 			goto errorExit
@@ -11084,11 +11178,11 @@ func (p *KuneiformParser) Use_extension_statement() (localctx IUse_extension_sta
 
 		if ((int64(_la) & ^0x3f) == 0 && ((int64(1)<<_la)&-9127724498152325120) != 0) || ((int64((_la-87)) & ^0x3f) == 0 && ((int64(1)<<(_la-87))&2306959842411020289) != 0) {
 			{
-				p.SetState(636)
+				p.SetState(649)
 				p.Identifier()
 			}
 			{
-				p.SetState(637)
+				p.SetState(650)
 				p.Match(KuneiformParserCOL)
 				if p.HasError() {
 					// Recognition error - abort rule
@@ -11096,10 +11190,10 @@ func (p *KuneiformParser) Use_extension_statement() (localctx IUse_extension_sta
 				}
 			}
 			{
-				p.SetState(638)
+				p.SetState(651)
 				p.action_expr(0)
 			}
-			p.SetState(646)
+			p.SetState(659)
 			p.GetErrorHandler().Sync(p)
 			if p.HasError() {
 				goto errorExit
@@ -11108,7 +11202,7 @@ func (p *KuneiformParser) Use_extension_statement() (localctx IUse_extension_sta
 
 			for _la == KuneiformParserCOMMA {
 				{
-					p.SetState(639)
+					p.SetState(652)
 					p.Match(KuneiformParserCOMMA)
 					if p.HasError() {
 						// Recognition error - abort rule
@@ -11116,11 +11210,11 @@ func (p *KuneiformParser) Use_extension_statement() (localctx IUse_extension_sta
 					}
 				}
 				{
-					p.SetState(640)
+					p.SetState(653)
 					p.Identifier()
 				}
 				{
-					p.SetState(641)
+					p.SetState(654)
 					p.Match(KuneiformParserCOL)
 					if p.HasError() {
 						// Recognition error - abort rule
@@ -11128,11 +11222,11 @@ func (p *KuneiformParser) Use_extension_statement() (localctx IUse_extension_sta
 					}
 				}
 				{
-					p.SetState(642)
+					p.SetState(655)
 					p.action_expr(0)
 				}
 
-				p.SetState(648)
+				p.SetState(661)
 				p.GetErrorHandler().Sync(p)
 				if p.HasError() {
 					goto errorExit
@@ -11142,7 +11236,7 @@ func (p *KuneiformParser) Use_extension_statement() (localctx IUse_extension_sta
 
 		}
 		{
-			p.SetState(651)
+			p.SetState(664)
 			p.Match(KuneiformParserRBRACE)
 			if p.HasError() {
 				// Recognition error - abort rule
@@ -11152,7 +11246,7 @@ func (p *KuneiformParser) Use_extension_statement() (localctx IUse_extension_sta
 
 	}
 	{
-		p.SetState(654)
+		p.SetState(667)
 		p.Match(KuneiformParserAS)
 		if p.HasError() {
 			// Recognition error - abort rule
@@ -11160,7 +11254,7 @@ func (p *KuneiformParser) Use_extension_statement() (localctx IUse_extension_sta
 		}
 	}
 	{
-		p.SetState(655)
+		p.SetState(668)
 
 		var _x = p.Identifier()
 
@@ -11293,7 +11387,7 @@ func (p *KuneiformParser) Unuse_extension_statement() (localctx IUnuse_extension
 
 	p.EnterOuterAlt(localctx, 1)
 	{
-		p.SetState(657)
+		p.SetState(670)
 		p.Match(KuneiformParserUNUSE)
 		if p.HasError() {
 			// Recognition error - abort rule
@@ -11301,13 +11395,13 @@ func (p *KuneiformParser) Unuse_extension_statement() (localctx IUnuse_extension
 		}
 	}
 	{
-		p.SetState(658)
+		p.SetState(671)
 
 		var _x = p.Identifier()
 
 		localctx.(*Unuse_extension_statementContext).alias = _x
 	}
-	p.SetState(661)
+	p.SetState(674)
 	p.GetErrorHandler().Sync(p)
 	if p.HasError() {
 		goto errorExit
@@ -11316,7 +11410,7 @@ func (p *KuneiformParser) Unuse_extension_statement() (localctx IUnuse_extension
 
 	if _la == KuneiformParserIF {
 		{
-			p.SetState(659)
+			p.SetState(672)
 			p.Match(KuneiformParserIF)
 			if p.HasError() {
 				// Recognition error - abort rule
@@ -11324,7 +11418,7 @@ func (p *KuneiformParser) Unuse_extension_statement() (localctx IUnuse_extension
 			}
 		}
 		{
-			p.SetState(660)
+			p.SetState(673)
 			p.Match(KuneiformParserEXISTS)
 			if p.HasError() {
 				// Recognition error - abort rule
@@ -11457,7 +11551,7 @@ func (p *KuneiformParser) Create_namespace_statement() (localctx ICreate_namespa
 	p.EnterRule(localctx, 74, KuneiformParserRULE_create_namespace_statement)
 	p.EnterOuterAlt(localctx, 1)
 	{
-		p.SetState(663)
+		p.SetState(676)
 		p.Match(KuneiformParserCREATE)
 		if p.HasError() {
 			// Recognition error - abort rule
@@ -11465,19 +11559,19 @@ func (p *KuneiformParser) Create_namespace_statement() (localctx ICreate_namespa
 		}
 	}
 	{
-		p.SetState(664)
+		p.SetState(677)
 		p.Match(KuneiformParserNAMESPACE)
 		if p.HasError() {
 			// Recognition error - abort rule
 			goto errorExit
 		}
 	}
-	p.SetState(668)
+	p.SetState(681)
 	p.GetErrorHandler().Sync(p)
 
 	if p.GetInterpreter().AdaptivePredict(p.BaseParser, p.GetTokenStream(), 73, p.GetParserRuleContext()) == 1 {
 		{
-			p.SetState(665)
+			p.SetState(678)
 			p.Match(KuneiformParserIF)
 			if p.HasError() {
 				// Recognition error - abort rule
@@ -11485,7 +11579,7 @@ func (p *KuneiformParser) Create_namespace_statement() (localctx ICreate_namespa
 			}
 		}
 		{
-			p.SetState(666)
+			p.SetState(679)
 			p.Match(KuneiformParserNOT)
 			if p.HasError() {
 				// Recognition error - abort rule
@@ -11493,7 +11587,7 @@ func (p *KuneiformParser) Create_namespace_statement() (localctx ICreate_namespa
 			}
 		}
 		{
-			p.SetState(667)
+			p.SetState(680)
 			p.Match(KuneiformParserEXISTS)
 			if p.HasError() {
 				// Recognition error - abort rule
@@ -11505,7 +11599,7 @@ func (p *KuneiformParser) Create_namespace_statement() (localctx ICreate_namespa
 		goto errorExit
 	}
 	{
-		p.SetState(670)
+		p.SetState(683)
 		p.Identifier()
 	}
 
@@ -11627,7 +11721,7 @@ func (p *KuneiformParser) Drop_namespace_statement() (localctx IDrop_namespace_s
 	p.EnterRule(localctx, 76, KuneiformParserRULE_drop_namespace_statement)
 	p.EnterOuterAlt(localctx, 1)
 	{
-		p.SetState(672)
+		p.SetState(685)
 		p.Match(KuneiformParserDROP)
 		if p.HasError() {
 			// Recognition error - abort rule
@@ -11635,19 +11729,19 @@ func (p *KuneiformParser) Drop_namespace_statement() (localctx IDrop_namespace_s
 		}
 	}
 	{
-		p.SetState(673)
+		p.SetState(686)
 		p.Match(KuneiformParserNAMESPACE)
 		if p.HasError() {
 			// Recognition error - abort rule
 			goto errorExit
 		}
 	}
-	p.SetState(676)
+	p.SetState(689)
 	p.GetErrorHandler().Sync(p)
 
 	if p.GetInterpreter().AdaptivePredict(p.BaseParser, p.GetTokenStream(), 74, p.GetParserRuleContext()) == 1 {
 		{
-			p.SetState(674)
+			p.SetState(687)
 			p.Match(KuneiformParserIF)
 			if p.HasError() {
 				// Recognition error - abort rule
@@ -11655,7 +11749,7 @@ func (p *KuneiformParser) Drop_namespace_statement() (localctx IDrop_namespace_s
 			}
 		}
 		{
-			p.SetState(675)
+			p.SetState(688)
 			p.Match(KuneiformParserEXISTS)
 			if p.HasError() {
 				// Recognition error - abort rule
@@ -11667,7 +11761,7 @@ func (p *KuneiformParser) Drop_namespace_statement() (localctx IDrop_namespace_s
 		goto errorExit
 	}
 	{
-		p.SetState(678)
+		p.SetState(691)
 		p.Identifier()
 	}
 
@@ -11789,7 +11883,7 @@ func (p *KuneiformParser) Set_current_namespace_statement() (localctx ISet_curre
 	p.EnterRule(localctx, 78, KuneiformParserRULE_set_current_namespace_statement)
 	p.EnterOuterAlt(localctx, 1)
 	{
-		p.SetState(680)
+		p.SetState(693)
 		p.Match(KuneiformParserSET)
 		if p.HasError() {
 			// Recognition error - abort rule
@@ -11797,7 +11891,7 @@ func (p *KuneiformParser) Set_current_namespace_statement() (localctx ISet_curre
 		}
 	}
 	{
-		p.SetState(681)
+		p.SetState(694)
 		p.Match(KuneiformParserCURRENT)
 		if p.HasError() {
 			// Recognition error - abort rule
@@ -11805,7 +11899,7 @@ func (p *KuneiformParser) Set_current_namespace_statement() (localctx ISet_curre
 		}
 	}
 	{
-		p.SetState(682)
+		p.SetState(695)
 		p.Match(KuneiformParserNAMESPACE)
 		if p.HasError() {
 			// Recognition error - abort rule
@@ -11813,7 +11907,7 @@ func (p *KuneiformParser) Set_current_namespace_statement() (localctx ISet_curre
 		}
 	}
 	{
-		p.SetState(683)
+		p.SetState(696)
 		p.Match(KuneiformParserTO)
 		if p.HasError() {
 			// Recognition error - abort rule
@@ -11821,7 +11915,7 @@ func (p *KuneiformParser) Set_current_namespace_statement() (localctx ISet_curre
 		}
 	}
 	{
-		p.SetState(684)
+		p.SetState(697)
 		p.Identifier()
 	}
 
@@ -12132,10 +12226,10 @@ func (p *KuneiformParser) Select_statement() (localctx ISelect_statementContext)
 
 	p.EnterOuterAlt(localctx, 1)
 	{
-		p.SetState(686)
+		p.SetState(699)
 		p.Select_core()
 	}
-	p.SetState(692)
+	p.SetState(705)
 	p.GetErrorHandler().Sync(p)
 	if p.HasError() {
 		goto errorExit
@@ -12144,22 +12238,22 @@ func (p *KuneiformParser) Select_statement() (localctx ISelect_statementContext)
 
 	for (int64((_la-102)) & ^0x3f) == 0 && ((int64(1)<<(_la-102))&7) != 0 {
 		{
-			p.SetState(687)
+			p.SetState(700)
 			p.Compound_operator()
 		}
 		{
-			p.SetState(688)
+			p.SetState(701)
 			p.Select_core()
 		}
 
-		p.SetState(694)
+		p.SetState(707)
 		p.GetErrorHandler().Sync(p)
 		if p.HasError() {
 			goto errorExit
 		}
 		_la = p.GetTokenStream().LA(1)
 	}
-	p.SetState(705)
+	p.SetState(718)
 	p.GetErrorHandler().Sync(p)
 	if p.HasError() {
 		goto errorExit
@@ -12168,7 +12262,7 @@ func (p *KuneiformParser) Select_statement() (localctx ISelect_statementContext)
 
 	if _la == KuneiformParserORDER {
 		{
-			p.SetState(695)
+			p.SetState(708)
 			p.Match(KuneiformParserORDER)
 			if p.HasError() {
 				// Recognition error - abort rule
@@ -12176,7 +12270,7 @@ func (p *KuneiformParser) Select_statement() (localctx ISelect_statementContext)
 			}
 		}
 		{
-			p.SetState(696)
+			p.SetState(709)
 			p.Match(KuneiformParserBY)
 			if p.HasError() {
 				// Recognition error - abort rule
@@ -12184,10 +12278,10 @@ func (p *KuneiformParser) Select_statement() (localctx ISelect_statementContext)
 			}
 		}
 		{
-			p.SetState(697)
+			p.SetState(710)
 			p.Ordering_term()
 		}
-		p.SetState(702)
+		p.SetState(715)
 		p.GetErrorHandler().Sync(p)
 		if p.HasError() {
 			goto errorExit
@@ -12196,7 +12290,7 @@ func (p *KuneiformParser) Select_statement() (localctx ISelect_statementContext)
 
 		for _la == KuneiformParserCOMMA {
 			{
-				p.SetState(698)
+				p.SetState(711)
 				p.Match(KuneiformParserCOMMA)
 				if p.HasError() {
 					// Recognition error - abort rule
@@ -12204,11 +12298,11 @@ func (p *KuneiformParser) Select_statement() (localctx ISelect_statementContext)
 				}
 			}
 			{
-				p.SetState(699)
+				p.SetState(712)
 				p.Ordering_term()
 			}
 
-			p.SetState(704)
+			p.SetState(717)
 			p.GetErrorHandler().Sync(p)
 			if p.HasError() {
 				goto errorExit
@@ -12217,7 +12311,7 @@ func (p *KuneiformParser) Select_statement() (localctx ISelect_statementContext)
 		}
 
 	}
-	p.SetState(709)
+	p.SetState(722)
 	p.GetErrorHandler().Sync(p)
 	if p.HasError() {
 		goto errorExit
@@ -12226,7 +12320,7 @@ func (p *KuneiformParser) Select_statement() (localctx ISelect_statementContext)
 
 	if _la == KuneiformParserLIMIT {
 		{
-			p.SetState(707)
+			p.SetState(720)
 			p.Match(KuneiformParserLIMIT)
 			if p.HasError() {
 				// Recognition error - abort rule
@@ -12234,7 +12328,7 @@ func (p *KuneiformParser) Select_statement() (localctx ISelect_statementContext)
 			}
 		}
 		{
-			p.SetState(708)
+			p.SetState(721)
 
 			var _x = p.sql_expr(0)
 
@@ -12242,7 +12336,7 @@ func (p *KuneiformParser) Select_statement() (localctx ISelect_statementContext)
 		}
 
 	}
-	p.SetState(713)
+	p.SetState(726)
 	p.GetErrorHandler().Sync(p)
 	if p.HasError() {
 		goto errorExit
@@ -12251,7 +12345,7 @@ func (p *KuneiformParser) Select_statement() (localctx ISelect_statementContext)
 
 	if _la == KuneiformParserOFFSET {
 		{
-			p.SetState(711)
+			p.SetState(724)
 			p.Match(KuneiformParserOFFSET)
 			if p.HasError() {
 				// Recognition error - abort rule
@@ -12259,7 +12353,7 @@ func (p *KuneiformParser) Select_statement() (localctx ISelect_statementContext)
 			}
 		}
 		{
-			p.SetState(712)
+			p.SetState(725)
 
 			var _x = p.sql_expr(0)
 
@@ -12369,7 +12463,7 @@ func (p *KuneiformParser) Compound_operator() (localctx ICompound_operatorContex
 	p.EnterRule(localctx, 82, KuneiformParserRULE_compound_operator)
 	var _la int
 
-	p.SetState(721)
+	p.SetState(734)
 	p.GetErrorHandler().Sync(p)
 	if p.HasError() {
 		goto errorExit
@@ -12379,14 +12473,14 @@ func (p *KuneiformParser) Compound_operator() (localctx ICompound_operatorContex
 	case KuneiformParserUNION:
 		p.EnterOuterAlt(localctx, 1)
 		{
-			p.SetState(715)
+			p.SetState(728)
 			p.Match(KuneiformParserUNION)
 			if p.HasError() {
 				// Recognition error - abort rule
 				goto errorExit
 			}
 		}
-		p.SetState(717)
+		p.SetState(730)
 		p.GetErrorHandler().Sync(p)
 		if p.HasError() {
 			goto errorExit
@@ -12395,7 +12489,7 @@ func (p *KuneiformParser) Compound_operator() (localctx ICompound_operatorContex
 
 		if _la == KuneiformParserALL {
 			{
-				p.SetState(716)
+				p.SetState(729)
 				p.Match(KuneiformParserALL)
 				if p.HasError() {
 					// Recognition error - abort rule
@@ -12408,7 +12502,7 @@ func (p *KuneiformParser) Compound_operator() (localctx ICompound_operatorContex
 	case KuneiformParserINTERSECT:
 		p.EnterOuterAlt(localctx, 2)
 		{
-			p.SetState(719)
+			p.SetState(732)
 			p.Match(KuneiformParserINTERSECT)
 			if p.HasError() {
 				// Recognition error - abort rule
@@ -12419,7 +12513,7 @@ func (p *KuneiformParser) Compound_operator() (localctx ICompound_operatorContex
 	case KuneiformParserEXCEPT:
 		p.EnterOuterAlt(localctx, 3)
 		{
-			p.SetState(720)
+			p.SetState(733)
 			p.Match(KuneiformParserEXCEPT)
 			if p.HasError() {
 				// Recognition error - abort rule
@@ -12557,10 +12651,10 @@ func (p *KuneiformParser) Ordering_term() (localctx IOrdering_termContext) {
 
 	p.EnterOuterAlt(localctx, 1)
 	{
-		p.SetState(723)
+		p.SetState(736)
 		p.sql_expr(0)
 	}
-	p.SetState(725)
+	p.SetState(738)
 	p.GetErrorHandler().Sync(p)
 	if p.HasError() {
 		goto errorExit
@@ -12569,7 +12663,7 @@ func (p *KuneiformParser) Ordering_term() (localctx IOrdering_termContext) {
 
 	if _la == KuneiformParserASC || _la == KuneiformParserDESC {
 		{
-			p.SetState(724)
+			p.SetState(737)
 			_la = p.GetTokenStream().LA(1)
 
 			if !(_la == KuneiformParserASC || _la == KuneiformParserDESC) {
@@ -12581,7 +12675,7 @@ func (p *KuneiformParser) Ordering_term() (localctx IOrdering_termContext) {
 		}
 
 	}
-	p.SetState(729)
+	p.SetState(742)
 	p.GetErrorHandler().Sync(p)
 	if p.HasError() {
 		goto errorExit
@@ -12590,7 +12684,7 @@ func (p *KuneiformParser) Ordering_term() (localctx IOrdering_termContext) {
 
 	if _la == KuneiformParserNULLS {
 		{
-			p.SetState(727)
+			p.SetState(740)
 			p.Match(KuneiformParserNULLS)
 			if p.HasError() {
 				// Recognition error - abort rule
@@ -12598,7 +12692,7 @@ func (p *KuneiformParser) Ordering_term() (localctx IOrdering_termContext) {
 			}
 		}
 		{
-			p.SetState(728)
+			p.SetState(741)
 			_la = p.GetTokenStream().LA(1)
 
 			if !(_la == KuneiformParserFIRST || _la == KuneiformParserLAST) {
@@ -13036,14 +13130,14 @@ func (p *KuneiformParser) Select_core() (localctx ISelect_coreContext) {
 
 	p.EnterOuterAlt(localctx, 1)
 	{
-		p.SetState(731)
+		p.SetState(744)
 		p.Match(KuneiformParserSELECT)
 		if p.HasError() {
 			// Recognition error - abort rule
 			goto errorExit
 		}
 	}
-	p.SetState(733)
+	p.SetState(746)
 	p.GetErrorHandler().Sync(p)
 	if p.HasError() {
 		goto errorExit
@@ -13052,7 +13146,7 @@ func (p *KuneiformParser) Select_core() (localctx ISelect_coreContext) {
 
 	if _la == KuneiformParserDISTINCT {
 		{
-			p.SetState(732)
+			p.SetState(745)
 			p.Match(KuneiformParserDISTINCT)
 			if p.HasError() {
 				// Recognition error - abort rule
@@ -13062,10 +13156,10 @@ func (p *KuneiformParser) Select_core() (localctx ISelect_coreContext) {
 
 	}
 	{
-		p.SetState(735)
+		p.SetState(748)
 		p.Result_column()
 	}
-	p.SetState(740)
+	p.SetState(753)
 	p.GetErrorHandler().Sync(p)
 	if p.HasError() {
 		goto errorExit
@@ -13074,7 +13168,7 @@ func (p *KuneiformParser) Select_core() (localctx ISelect_coreContext) {
 
 	for _la == KuneiformParserCOMMA {
 		{
-			p.SetState(736)
+			p.SetState(749)
 			p.Match(KuneiformParserCOMMA)
 			if p.HasError() {
 				// Recognition error - abort rule
@@ -13082,18 +13176,18 @@ func (p *KuneiformParser) Select_core() (localctx ISelect_coreContext) {
 			}
 		}
 		{
-			p.SetState(737)
+			p.SetState(750)
 			p.Result_column()
 		}
 
-		p.SetState(742)
+		p.SetState(755)
 		p.GetErrorHandler().Sync(p)
 		if p.HasError() {
 			goto errorExit
 		}
 		_la = p.GetTokenStream().LA(1)
 	}
-	p.SetState(751)
+	p.SetState(764)
 	p.GetErrorHandler().Sync(p)
 	if p.HasError() {
 		goto errorExit
@@ -13102,7 +13196,7 @@ func (p *KuneiformParser) Select_core() (localctx ISelect_coreContext) {
 
 	if _la == KuneiformParserFROM {
 		{
-			p.SetState(743)
+			p.SetState(756)
 			p.Match(KuneiformParserFROM)
 			if p.HasError() {
 				// Recognition error - abort rule
@@ -13110,10 +13204,10 @@ func (p *KuneiformParser) Select_core() (localctx ISelect_coreContext) {
 			}
 		}
 		{
-			p.SetState(744)
+			p.SetState(757)
 			p.Relation()
 		}
-		p.SetState(748)
+		p.SetState(761)
 		p.GetErrorHandler().Sync(p)
 		if p.HasError() {
 			goto errorExit
@@ -13122,11 +13216,11 @@ func (p *KuneiformParser) Select_core() (localctx ISelect_coreContext) {
 
 		for (int64((_la-74)) & ^0x3f) == 0 && ((int64(1)<<(_la-74))&134217743) != 0 {
 			{
-				p.SetState(745)
+				p.SetState(758)
 				p.Join()
 			}
 
-			p.SetState(750)
+			p.SetState(763)
 			p.GetErrorHandler().Sync(p)
 			if p.HasError() {
 				goto errorExit
@@ -13135,7 +13229,7 @@ func (p *KuneiformParser) Select_core() (localctx ISelect_coreContext) {
 		}
 
 	}
-	p.SetState(755)
+	p.SetState(768)
 	p.GetErrorHandler().Sync(p)
 	if p.HasError() {
 		goto errorExit
@@ -13144,7 +13238,7 @@ func (p *KuneiformParser) Select_core() (localctx ISelect_coreContext) {
 
 	if _la == KuneiformParserWHERE {
 		{
-			p.SetState(753)
+			p.SetState(766)
 			p.Match(KuneiformParserWHERE)
 			if p.HasError() {
 				// Recognition error - abort rule
@@ -13152,7 +13246,7 @@ func (p *KuneiformParser) Select_core() (localctx ISelect_coreContext) {
 			}
 		}
 		{
-			p.SetState(754)
+			p.SetState(767)
 
 			var _x = p.sql_expr(0)
 
@@ -13160,7 +13254,7 @@ func (p *KuneiformParser) Select_core() (localctx ISelect_coreContext) {
 		}
 
 	}
-	p.SetState(764)
+	p.SetState(777)
 	p.GetErrorHandler().Sync(p)
 	if p.HasError() {
 		goto errorExit
@@ -13169,7 +13263,7 @@ func (p *KuneiformParser) Select_core() (localctx ISelect_coreContext) {
 
 	if _la == KuneiformParserGROUP {
 		{
-			p.SetState(757)
+			p.SetState(770)
 			p.Match(KuneiformParserGROUP)
 			if p.HasError() {
 				// Recognition error - abort rule
@@ -13177,7 +13271,7 @@ func (p *KuneiformParser) Select_core() (localctx ISelect_coreContext) {
 			}
 		}
 		{
-			p.SetState(758)
+			p.SetState(771)
 			p.Match(KuneiformParserBY)
 			if p.HasError() {
 				// Recognition error - abort rule
@@ -13185,13 +13279,13 @@ func (p *KuneiformParser) Select_core() (localctx ISelect_coreContext) {
 			}
 		}
 		{
-			p.SetState(759)
+			p.SetState(772)
 
 			var _x = p.Sql_expr_list()
 
 			localctx.(*Select_coreContext).group_by = _x
 		}
-		p.SetState(762)
+		p.SetState(775)
 		p.GetErrorHandler().Sync(p)
 		if p.HasError() {
 			goto errorExit
@@ -13200,7 +13294,7 @@ func (p *KuneiformParser) Select_core() (localctx ISelect_coreContext) {
 
 		if _la == KuneiformParserHAVING {
 			{
-				p.SetState(760)
+				p.SetState(773)
 				p.Match(KuneiformParserHAVING)
 				if p.HasError() {
 					// Recognition error - abort rule
@@ -13208,7 +13302,7 @@ func (p *KuneiformParser) Select_core() (localctx ISelect_coreContext) {
 				}
 			}
 			{
-				p.SetState(761)
+				p.SetState(774)
 
 				var _x = p.sql_expr(0)
 
@@ -13218,7 +13312,7 @@ func (p *KuneiformParser) Select_core() (localctx ISelect_coreContext) {
 		}
 
 	}
-	p.SetState(780)
+	p.SetState(793)
 	p.GetErrorHandler().Sync(p)
 	if p.HasError() {
 		goto errorExit
@@ -13227,7 +13321,7 @@ func (p *KuneiformParser) Select_core() (localctx ISelect_coreContext) {
 
 	if _la == KuneiformParserWINDOW {
 		{
-			p.SetState(766)
+			p.SetState(779)
 			p.Match(KuneiformParserWINDOW)
 			if p.HasError() {
 				// Recognition error - abort rule
@@ -13235,11 +13329,11 @@ func (p *KuneiformParser) Select_core() (localctx ISelect_coreContext) {
 			}
 		}
 		{
-			p.SetState(767)
+			p.SetState(780)
 			p.Identifier()
 		}
 		{
-			p.SetState(768)
+			p.SetState(781)
 			p.Match(KuneiformParserAS)
 			if p.HasError() {
 				// Recognition error - abort rule
@@ -13247,10 +13341,10 @@ func (p *KuneiformParser) Select_core() (localctx ISelect_coreContext) {
 			}
 		}
 		{
-			p.SetState(769)
+			p.SetState(782)
 			p.Window()
 		}
-		p.SetState(777)
+		p.SetState(790)
 		p.GetErrorHandler().Sync(p)
 		if p.HasError() {
 			goto errorExit
@@ -13259,7 +13353,7 @@ func (p *KuneiformParser) Select_core() (localctx ISelect_coreContext) {
 
 		for _la == KuneiformParserCOMMA {
 			{
-				p.SetState(770)
+				p.SetState(783)
 				p.Match(KuneiformParserCOMMA)
 				if p.HasError() {
 					// Recognition error - abort rule
@@ -13267,11 +13361,11 @@ func (p *KuneiformParser) Select_core() (localctx ISelect_coreContext) {
 				}
 			}
 			{
-				p.SetState(771)
+				p.SetState(784)
 				p.Identifier()
 			}
 			{
-				p.SetState(772)
+				p.SetState(785)
 				p.Match(KuneiformParserAS)
 				if p.HasError() {
 					// Recognition error - abort rule
@@ -13279,11 +13373,11 @@ func (p *KuneiformParser) Select_core() (localctx ISelect_coreContext) {
 				}
 			}
 			{
-				p.SetState(773)
+				p.SetState(786)
 				p.Window()
 			}
 
-			p.SetState(779)
+			p.SetState(792)
 			p.GetErrorHandler().Sync(p)
 			if p.HasError() {
 				goto errorExit
@@ -13534,7 +13628,7 @@ func (p *KuneiformParser) Relation() (localctx IRelationContext) {
 	p.EnterRule(localctx, 88, KuneiformParserRULE_relation)
 	var _la int
 
-	p.SetState(803)
+	p.SetState(816)
 	p.GetErrorHandler().Sync(p)
 	if p.HasError() {
 		goto errorExit
@@ -13544,19 +13638,19 @@ func (p *KuneiformParser) Relation() (localctx IRelationContext) {
 	case KuneiformParserDOUBLE_QUOTE, KuneiformParserUSE, KuneiformParserUNUSE, KuneiformParserACTION, KuneiformParserCREATE, KuneiformParserALTER, KuneiformParserADD, KuneiformParserDROP, KuneiformParserRENAME, KuneiformParserCHECK, KuneiformParserFOREIGN, KuneiformParserPRIMARY, KuneiformParserKEY, KuneiformParserUNIQUE, KuneiformParserRESTRICT, KuneiformParserDEFAULT, KuneiformParserINDEX, KuneiformParserRETURNS, KuneiformParserFOR, KuneiformParserIF, KuneiformParserELSEIF, KuneiformParserELSE, KuneiformParserBREAK, KuneiformParserCONTINUE, KuneiformParserRETURN, KuneiformParserGRANT, KuneiformParserGRANTED, KuneiformParserREVOKE, KuneiformParserROLE, KuneiformParserREPLACE, KuneiformParserCURRENT, KuneiformParserNAMESPACE, KuneiformParserTRANSFER, KuneiformParserOWNERSHIP, KuneiformParserROLES, KuneiformParserCALL, KuneiformParserIDENTIFIER:
 		localctx = NewTable_relationContext(p, localctx)
 		p.EnterOuterAlt(localctx, 1)
-		p.SetState(785)
+		p.SetState(798)
 		p.GetErrorHandler().Sync(p)
 
 		if p.GetInterpreter().AdaptivePredict(p.BaseParser, p.GetTokenStream(), 93, p.GetParserRuleContext()) == 1 {
 			{
-				p.SetState(782)
+				p.SetState(795)
 
 				var _x = p.Identifier()
 
 				localctx.(*Table_relationContext).namespace = _x
 			}
 			{
-				p.SetState(783)
+				p.SetState(796)
 				p.Match(KuneiformParserPERIOD)
 				if p.HasError() {
 					// Recognition error - abort rule
@@ -13568,13 +13662,13 @@ func (p *KuneiformParser) Relation() (localctx IRelationContext) {
 			goto errorExit
 		}
 		{
-			p.SetState(787)
+			p.SetState(800)
 
 			var _x = p.Identifier()
 
 			localctx.(*Table_relationContext).table_name = _x
 		}
-		p.SetState(792)
+		p.SetState(805)
 		p.GetErrorHandler().Sync(p)
 		if p.HasError() {
 			goto errorExit
@@ -13582,7 +13676,7 @@ func (p *KuneiformParser) Relation() (localctx IRelationContext) {
 		_la = p.GetTokenStream().LA(1)
 
 		if ((int64((_la-33)) & ^0x3f) == 0 && ((int64(1)<<(_la-33))&18049583966447479) != 0) || ((int64((_la-112)) & ^0x3f) == 0 && ((int64(1)<<(_la-112))&68752760959) != 0) {
-			p.SetState(789)
+			p.SetState(802)
 			p.GetErrorHandler().Sync(p)
 			if p.HasError() {
 				goto errorExit
@@ -13591,7 +13685,7 @@ func (p *KuneiformParser) Relation() (localctx IRelationContext) {
 
 			if _la == KuneiformParserAS {
 				{
-					p.SetState(788)
+					p.SetState(801)
 					p.Match(KuneiformParserAS)
 					if p.HasError() {
 						// Recognition error - abort rule
@@ -13601,7 +13695,7 @@ func (p *KuneiformParser) Relation() (localctx IRelationContext) {
 
 			}
 			{
-				p.SetState(791)
+				p.SetState(804)
 
 				var _x = p.Identifier()
 
@@ -13614,7 +13708,7 @@ func (p *KuneiformParser) Relation() (localctx IRelationContext) {
 		localctx = NewSubquery_relationContext(p, localctx)
 		p.EnterOuterAlt(localctx, 2)
 		{
-			p.SetState(794)
+			p.SetState(807)
 			p.Match(KuneiformParserLPAREN)
 			if p.HasError() {
 				// Recognition error - abort rule
@@ -13622,18 +13716,18 @@ func (p *KuneiformParser) Relation() (localctx IRelationContext) {
 			}
 		}
 		{
-			p.SetState(795)
+			p.SetState(808)
 			p.Select_statement()
 		}
 		{
-			p.SetState(796)
+			p.SetState(809)
 			p.Match(KuneiformParserRPAREN)
 			if p.HasError() {
 				// Recognition error - abort rule
 				goto errorExit
 			}
 		}
-		p.SetState(801)
+		p.SetState(814)
 		p.GetErrorHandler().Sync(p)
 		if p.HasError() {
 			goto errorExit
@@ -13641,7 +13735,7 @@ func (p *KuneiformParser) Relation() (localctx IRelationContext) {
 		_la = p.GetTokenStream().LA(1)
 
 		if ((int64((_la-33)) & ^0x3f) == 0 && ((int64(1)<<(_la-33))&18049583966447479) != 0) || ((int64((_la-112)) & ^0x3f) == 0 && ((int64(1)<<(_la-112))&68752760959) != 0) {
-			p.SetState(798)
+			p.SetState(811)
 			p.GetErrorHandler().Sync(p)
 			if p.HasError() {
 				goto errorExit
@@ -13650,7 +13744,7 @@ func (p *KuneiformParser) Relation() (localctx IRelationContext) {
 
 			if _la == KuneiformParserAS {
 				{
-					p.SetState(797)
+					p.SetState(810)
 					p.Match(KuneiformParserAS)
 					if p.HasError() {
 						// Recognition error - abort rule
@@ -13660,7 +13754,7 @@ func (p *KuneiformParser) Relation() (localctx IRelationContext) {
 
 			}
 			{
-				p.SetState(800)
+				p.SetState(813)
 
 				var _x = p.Identifier()
 
@@ -13820,7 +13914,7 @@ func (p *KuneiformParser) Join() (localctx IJoinContext) {
 	var _la int
 
 	p.EnterOuterAlt(localctx, 1)
-	p.SetState(806)
+	p.SetState(819)
 	p.GetErrorHandler().Sync(p)
 	if p.HasError() {
 		goto errorExit
@@ -13829,7 +13923,7 @@ func (p *KuneiformParser) Join() (localctx IJoinContext) {
 
 	if (int64((_la-75)) & ^0x3f) == 0 && ((int64(1)<<(_la-75))&67108871) != 0 {
 		{
-			p.SetState(805)
+			p.SetState(818)
 			_la = p.GetTokenStream().LA(1)
 
 			if !((int64((_la-75)) & ^0x3f) == 0 && ((int64(1)<<(_la-75))&67108871) != 0) {
@@ -13842,7 +13936,7 @@ func (p *KuneiformParser) Join() (localctx IJoinContext) {
 
 	}
 	{
-		p.SetState(808)
+		p.SetState(821)
 		p.Match(KuneiformParserJOIN)
 		if p.HasError() {
 			// Recognition error - abort rule
@@ -13850,11 +13944,11 @@ func (p *KuneiformParser) Join() (localctx IJoinContext) {
 		}
 	}
 	{
-		p.SetState(809)
+		p.SetState(822)
 		p.Relation()
 	}
 	{
-		p.SetState(810)
+		p.SetState(823)
 		p.Match(KuneiformParserON)
 		if p.HasError() {
 			// Recognition error - abort rule
@@ -13862,7 +13956,7 @@ func (p *KuneiformParser) Join() (localctx IJoinContext) {
 		}
 	}
 	{
-		p.SetState(811)
+		p.SetState(824)
 		p.sql_expr(0)
 	}
 
@@ -14059,7 +14153,7 @@ func (p *KuneiformParser) Result_column() (localctx IResult_columnContext) {
 	p.EnterRule(localctx, 92, KuneiformParserRULE_result_column)
 	var _la int
 
-	p.SetState(826)
+	p.SetState(839)
 	p.GetErrorHandler().Sync(p)
 	if p.HasError() {
 		goto errorExit
@@ -14070,10 +14164,10 @@ func (p *KuneiformParser) Result_column() (localctx IResult_columnContext) {
 		localctx = NewExpression_result_columnContext(p, localctx)
 		p.EnterOuterAlt(localctx, 1)
 		{
-			p.SetState(813)
+			p.SetState(826)
 			p.sql_expr(0)
 		}
-		p.SetState(818)
+		p.SetState(831)
 		p.GetErrorHandler().Sync(p)
 		if p.HasError() {
 			goto errorExit
@@ -14081,7 +14175,7 @@ func (p *KuneiformParser) Result_column() (localctx IResult_columnContext) {
 		_la = p.GetTokenStream().LA(1)
 
 		if ((int64((_la-33)) & ^0x3f) == 0 && ((int64(1)<<(_la-33))&18049583966447479) != 0) || ((int64((_la-112)) & ^0x3f) == 0 && ((int64(1)<<(_la-112))&68752760959) != 0) {
-			p.SetState(815)
+			p.SetState(828)
 			p.GetErrorHandler().Sync(p)
 			if p.HasError() {
 				goto errorExit
@@ -14090,7 +14184,7 @@ func (p *KuneiformParser) Result_column() (localctx IResult_columnContext) {
 
 			if _la == KuneiformParserAS {
 				{
-					p.SetState(814)
+					p.SetState(827)
 					p.Match(KuneiformParserAS)
 					if p.HasError() {
 						// Recognition error - abort rule
@@ -14100,7 +14194,7 @@ func (p *KuneiformParser) Result_column() (localctx IResult_columnContext) {
 
 			}
 			{
-				p.SetState(817)
+				p.SetState(830)
 				p.Identifier()
 			}
 
@@ -14109,7 +14203,7 @@ func (p *KuneiformParser) Result_column() (localctx IResult_columnContext) {
 	case 2:
 		localctx = NewWildcard_result_columnContext(p, localctx)
 		p.EnterOuterAlt(localctx, 2)
-		p.SetState(823)
+		p.SetState(836)
 		p.GetErrorHandler().Sync(p)
 		if p.HasError() {
 			goto errorExit
@@ -14118,14 +14212,14 @@ func (p *KuneiformParser) Result_column() (localctx IResult_columnContext) {
 
 		if ((int64(_la) & ^0x3f) == 0 && ((int64(1)<<_la)&-9127724498152325120) != 0) || ((int64((_la-87)) & ^0x3f) == 0 && ((int64(1)<<(_la-87))&2306959842411020289) != 0) {
 			{
-				p.SetState(820)
+				p.SetState(833)
 
 				var _x = p.Identifier()
 
 				localctx.(*Wildcard_result_columnContext).table_name = _x
 			}
 			{
-				p.SetState(821)
+				p.SetState(834)
 				p.Match(KuneiformParserPERIOD)
 				if p.HasError() {
 					// Recognition error - abort rule
@@ -14135,7 +14229,7 @@ func (p *KuneiformParser) Result_column() (localctx IResult_columnContext) {
 
 		}
 		{
-			p.SetState(825)
+			p.SetState(838)
 			p.Match(KuneiformParserSTAR)
 			if p.HasError() {
 				// Recognition error - abort rule
@@ -14461,7 +14555,7 @@ func (p *KuneiformParser) Update_statement() (localctx IUpdate_statementContext)
 
 	p.EnterOuterAlt(localctx, 1)
 	{
-		p.SetState(828)
+		p.SetState(841)
 		p.Match(KuneiformParserUPDATE)
 		if p.HasError() {
 			// Recognition error - abort rule
@@ -14469,13 +14563,13 @@ func (p *KuneiformParser) Update_statement() (localctx IUpdate_statementContext)
 		}
 	}
 	{
-		p.SetState(829)
+		p.SetState(842)
 
 		var _x = p.Identifier()
 
 		localctx.(*Update_statementContext).table_name = _x
 	}
-	p.SetState(834)
+	p.SetState(847)
 	p.GetErrorHandler().Sync(p)
 	if p.HasError() {
 		goto errorExit
@@ -14483,7 +14577,7 @@ func (p *KuneiformParser) Update_statement() (localctx IUpdate_statementContext)
 	_la = p.GetTokenStream().LA(1)
 
 	if ((int64((_la-33)) & ^0x3f) == 0 && ((int64(1)<<(_la-33))&18049583966447479) != 0) || ((int64((_la-112)) & ^0x3f) == 0 && ((int64(1)<<(_la-112))&68752760959) != 0) {
-		p.SetState(831)
+		p.SetState(844)
 		p.GetErrorHandler().Sync(p)
 		if p.HasError() {
 			goto errorExit
@@ -14492,7 +14586,7 @@ func (p *KuneiformParser) Update_statement() (localctx IUpdate_statementContext)
 
 		if _la == KuneiformParserAS {
 			{
-				p.SetState(830)
+				p.SetState(843)
 				p.Match(KuneiformParserAS)
 				if p.HasError() {
 					// Recognition error - abort rule
@@ -14502,7 +14596,7 @@ func (p *KuneiformParser) Update_statement() (localctx IUpdate_statementContext)
 
 		}
 		{
-			p.SetState(833)
+			p.SetState(846)
 
 			var _x = p.Identifier()
 
@@ -14511,7 +14605,7 @@ func (p *KuneiformParser) Update_statement() (localctx IUpdate_statementContext)
 
 	}
 	{
-		p.SetState(836)
+		p.SetState(849)
 		p.Match(KuneiformParserSET)
 		if p.HasError() {
 			// Recognition error - abort rule
@@ -14519,10 +14613,10 @@ func (p *KuneiformParser) Update_statement() (localctx IUpdate_statementContext)
 		}
 	}
 	{
-		p.SetState(837)
+		p.SetState(850)
 		p.Update_set_clause()
 	}
-	p.SetState(842)
+	p.SetState(855)
 	p.GetErrorHandler().Sync(p)
 	if p.HasError() {
 		goto errorExit
@@ -14531,7 +14625,7 @@ func (p *KuneiformParser) Update_statement() (localctx IUpdate_statementContext)
 
 	for _la == KuneiformParserCOMMA {
 		{
-			p.SetState(838)
+			p.SetState(851)
 			p.Match(KuneiformParserCOMMA)
 			if p.HasError() {
 				// Recognition error - abort rule
@@ -14539,18 +14633,18 @@ func (p *KuneiformParser) Update_statement() (localctx IUpdate_statementContext)
 			}
 		}
 		{
-			p.SetState(839)
+			p.SetState(852)
 			p.Update_set_clause()
 		}
 
-		p.SetState(844)
+		p.SetState(857)
 		p.GetErrorHandler().Sync(p)
 		if p.HasError() {
 			goto errorExit
 		}
 		_la = p.GetTokenStream().LA(1)
 	}
-	p.SetState(853)
+	p.SetState(866)
 	p.GetErrorHandler().Sync(p)
 	if p.HasError() {
 		goto errorExit
@@ -14559,7 +14653,7 @@ func (p *KuneiformParser) Update_statement() (localctx IUpdate_statementContext)
 
 	if _la == KuneiformParserFROM {
 		{
-			p.SetState(845)
+			p.SetState(858)
 			p.Match(KuneiformParserFROM)
 			if p.HasError() {
 				// Recognition error - abort rule
@@ -14567,10 +14661,10 @@ func (p *KuneiformParser) Update_statement() (localctx IUpdate_statementContext)
 			}
 		}
 		{
-			p.SetState(846)
+			p.SetState(859)
 			p.Relation()
 		}
-		p.SetState(850)
+		p.SetState(863)
 		p.GetErrorHandler().Sync(p)
 		if p.HasError() {
 			goto errorExit
@@ -14579,11 +14673,11 @@ func (p *KuneiformParser) Update_statement() (localctx IUpdate_statementContext)
 
 		for (int64((_la-74)) & ^0x3f) == 0 && ((int64(1)<<(_la-74))&134217743) != 0 {
 			{
-				p.SetState(847)
+				p.SetState(860)
 				p.Join()
 			}
 
-			p.SetState(852)
+			p.SetState(865)
 			p.GetErrorHandler().Sync(p)
 			if p.HasError() {
 				goto errorExit
@@ -14592,7 +14686,7 @@ func (p *KuneiformParser) Update_statement() (localctx IUpdate_statementContext)
 		}
 
 	}
-	p.SetState(857)
+	p.SetState(870)
 	p.GetErrorHandler().Sync(p)
 	if p.HasError() {
 		goto errorExit
@@ -14601,7 +14695,7 @@ func (p *KuneiformParser) Update_statement() (localctx IUpdate_statementContext)
 
 	if _la == KuneiformParserWHERE {
 		{
-			p.SetState(855)
+			p.SetState(868)
 			p.Match(KuneiformParserWHERE)
 			if p.HasError() {
 				// Recognition error - abort rule
@@ -14609,7 +14703,7 @@ func (p *KuneiformParser) Update_statement() (localctx IUpdate_statementContext)
 			}
 		}
 		{
-			p.SetState(856)
+			p.SetState(869)
 
 			var _x = p.sql_expr(0)
 
@@ -14749,14 +14843,14 @@ func (p *KuneiformParser) Update_set_clause() (localctx IUpdate_set_clauseContex
 	p.EnterRule(localctx, 96, KuneiformParserRULE_update_set_clause)
 	p.EnterOuterAlt(localctx, 1)
 	{
-		p.SetState(859)
+		p.SetState(872)
 
 		var _x = p.Identifier()
 
 		localctx.(*Update_set_clauseContext).column = _x
 	}
 	{
-		p.SetState(860)
+		p.SetState(873)
 		p.Match(KuneiformParserEQUALS)
 		if p.HasError() {
 			// Recognition error - abort rule
@@ -14764,7 +14858,7 @@ func (p *KuneiformParser) Update_set_clause() (localctx IUpdate_set_clauseContex
 		}
 	}
 	{
-		p.SetState(861)
+		p.SetState(874)
 		p.sql_expr(0)
 	}
 
@@ -15073,7 +15167,7 @@ func (p *KuneiformParser) Insert_statement() (localctx IInsert_statementContext)
 
 	p.EnterOuterAlt(localctx, 1)
 	{
-		p.SetState(863)
+		p.SetState(876)
 		p.Match(KuneiformParserINSERT)
 		if p.HasError() {
 			// Recognition error - abort rule
@@ -15081,7 +15175,7 @@ func (p *KuneiformParser) Insert_statement() (localctx IInsert_statementContext)
 		}
 	}
 	{
-		p.SetState(864)
+		p.SetState(877)
 		p.Match(KuneiformParserINTO)
 		if p.HasError() {
 			// Recognition error - abort rule
@@ -15089,13 +15183,13 @@ func (p *KuneiformParser) Insert_statement() (localctx IInsert_statementContext)
 		}
 	}
 	{
-		p.SetState(865)
+		p.SetState(878)
 
 		var _x = p.Identifier()
 
 		localctx.(*Insert_statementContext).table_name = _x
 	}
-	p.SetState(870)
+	p.SetState(883)
 	p.GetErrorHandler().Sync(p)
 	if p.HasError() {
 		goto errorExit
@@ -15103,7 +15197,7 @@ func (p *KuneiformParser) Insert_statement() (localctx IInsert_statementContext)
 	_la = p.GetTokenStream().LA(1)
 
 	if ((int64((_la-33)) & ^0x3f) == 0 && ((int64(1)<<(_la-33))&18049583966447479) != 0) || ((int64((_la-112)) & ^0x3f) == 0 && ((int64(1)<<(_la-112))&68752760959) != 0) {
-		p.SetState(867)
+		p.SetState(880)
 		p.GetErrorHandler().Sync(p)
 		if p.HasError() {
 			goto errorExit
@@ -15112,7 +15206,7 @@ func (p *KuneiformParser) Insert_statement() (localctx IInsert_statementContext)
 
 		if _la == KuneiformParserAS {
 			{
-				p.SetState(866)
+				p.SetState(879)
 				p.Match(KuneiformParserAS)
 				if p.HasError() {
 					// Recognition error - abort rule
@@ -15122,7 +15216,7 @@ func (p *KuneiformParser) Insert_statement() (localctx IInsert_statementContext)
 
 		}
 		{
-			p.SetState(869)
+			p.SetState(882)
 
 			var _x = p.Identifier()
 
@@ -15130,7 +15224,7 @@ func (p *KuneiformParser) Insert_statement() (localctx IInsert_statementContext)
 		}
 
 	}
-	p.SetState(876)
+	p.SetState(889)
 	p.GetErrorHandler().Sync(p)
 	if p.HasError() {
 		goto errorExit
@@ -15139,7 +15233,7 @@ func (p *KuneiformParser) Insert_statement() (localctx IInsert_statementContext)
 
 	if _la == KuneiformParserLPAREN {
 		{
-			p.SetState(872)
+			p.SetState(885)
 			p.Match(KuneiformParserLPAREN)
 			if p.HasError() {
 				// Recognition error - abort rule
@@ -15147,14 +15241,14 @@ func (p *KuneiformParser) Insert_statement() (localctx IInsert_statementContext)
 			}
 		}
 		{
-			p.SetState(873)
+			p.SetState(886)
 
 			var _x = p.Identifier_list()
 
 			localctx.(*Insert_statementContext).target_columns = _x
 		}
 		{
-			p.SetState(874)
+			p.SetState(887)
 			p.Match(KuneiformParserRPAREN)
 			if p.HasError() {
 				// Recognition error - abort rule
@@ -15163,7 +15257,7 @@ func (p *KuneiformParser) Insert_statement() (localctx IInsert_statementContext)
 		}
 
 	}
-	p.SetState(893)
+	p.SetState(906)
 	p.GetErrorHandler().Sync(p)
 	if p.HasError() {
 		goto errorExit
@@ -15172,7 +15266,7 @@ func (p *KuneiformParser) Insert_statement() (localctx IInsert_statementContext)
 	switch p.GetTokenStream().LA(1) {
 	case KuneiformParserVALUES:
 		{
-			p.SetState(878)
+			p.SetState(891)
 			p.Match(KuneiformParserVALUES)
 			if p.HasError() {
 				// Recognition error - abort rule
@@ -15180,7 +15274,7 @@ func (p *KuneiformParser) Insert_statement() (localctx IInsert_statementContext)
 			}
 		}
 		{
-			p.SetState(879)
+			p.SetState(892)
 			p.Match(KuneiformParserLPAREN)
 			if p.HasError() {
 				// Recognition error - abort rule
@@ -15188,18 +15282,18 @@ func (p *KuneiformParser) Insert_statement() (localctx IInsert_statementContext)
 			}
 		}
 		{
-			p.SetState(880)
+			p.SetState(893)
 			p.Sql_expr_list()
 		}
 		{
-			p.SetState(881)
+			p.SetState(894)
 			p.Match(KuneiformParserRPAREN)
 			if p.HasError() {
 				// Recognition error - abort rule
 				goto errorExit
 			}
 		}
-		p.SetState(889)
+		p.SetState(902)
 		p.GetErrorHandler().Sync(p)
 		if p.HasError() {
 			goto errorExit
@@ -15208,7 +15302,7 @@ func (p *KuneiformParser) Insert_statement() (localctx IInsert_statementContext)
 
 		for _la == KuneiformParserCOMMA {
 			{
-				p.SetState(882)
+				p.SetState(895)
 				p.Match(KuneiformParserCOMMA)
 				if p.HasError() {
 					// Recognition error - abort rule
@@ -15216,7 +15310,7 @@ func (p *KuneiformParser) Insert_statement() (localctx IInsert_statementContext)
 				}
 			}
 			{
-				p.SetState(883)
+				p.SetState(896)
 				p.Match(KuneiformParserLPAREN)
 				if p.HasError() {
 					// Recognition error - abort rule
@@ -15224,11 +15318,11 @@ func (p *KuneiformParser) Insert_statement() (localctx IInsert_statementContext)
 				}
 			}
 			{
-				p.SetState(884)
+				p.SetState(897)
 				p.Sql_expr_list()
 			}
 			{
-				p.SetState(885)
+				p.SetState(898)
 				p.Match(KuneiformParserRPAREN)
 				if p.HasError() {
 					// Recognition error - abort rule
@@ -15236,7 +15330,7 @@ func (p *KuneiformParser) Insert_statement() (localctx IInsert_statementContext)
 				}
 			}
 
-			p.SetState(891)
+			p.SetState(904)
 			p.GetErrorHandler().Sync(p)
 			if p.HasError() {
 				goto errorExit
@@ -15246,7 +15340,7 @@ func (p *KuneiformParser) Insert_statement() (localctx IInsert_statementContext)
 
 	case KuneiformParserSELECT:
 		{
-			p.SetState(892)
+			p.SetState(905)
 			p.Select_statement()
 		}
 
@@ -15254,7 +15348,7 @@ func (p *KuneiformParser) Insert_statement() (localctx IInsert_statementContext)
 		p.SetError(antlr.NewNoViableAltException(p, nil, nil, nil, nil, nil))
 		goto errorExit
 	}
-	p.SetState(896)
+	p.SetState(909)
 	p.GetErrorHandler().Sync(p)
 	if p.HasError() {
 		goto errorExit
@@ -15263,7 +15357,7 @@ func (p *KuneiformParser) Insert_statement() (localctx IInsert_statementContext)
 
 	if _la == KuneiformParserON {
 		{
-			p.SetState(895)
+			p.SetState(908)
 			p.Upsert_clause()
 		}
 
@@ -15550,7 +15644,7 @@ func (p *KuneiformParser) Upsert_clause() (localctx IUpsert_clauseContext) {
 
 	p.EnterOuterAlt(localctx, 1)
 	{
-		p.SetState(898)
+		p.SetState(911)
 		p.Match(KuneiformParserON)
 		if p.HasError() {
 			// Recognition error - abort rule
@@ -15558,14 +15652,14 @@ func (p *KuneiformParser) Upsert_clause() (localctx IUpsert_clauseContext) {
 		}
 	}
 	{
-		p.SetState(899)
+		p.SetState(912)
 		p.Match(KuneiformParserCONFLICT)
 		if p.HasError() {
 			// Recognition error - abort rule
 			goto errorExit
 		}
 	}
-	p.SetState(907)
+	p.SetState(920)
 	p.GetErrorHandler().Sync(p)
 	if p.HasError() {
 		goto errorExit
@@ -15574,7 +15668,7 @@ func (p *KuneiformParser) Upsert_clause() (localctx IUpsert_clauseContext) {
 
 	if _la == KuneiformParserLPAREN {
 		{
-			p.SetState(900)
+			p.SetState(913)
 			p.Match(KuneiformParserLPAREN)
 			if p.HasError() {
 				// Recognition error - abort rule
@@ -15582,21 +15676,21 @@ func (p *KuneiformParser) Upsert_clause() (localctx IUpsert_clauseContext) {
 			}
 		}
 		{
-			p.SetState(901)
+			p.SetState(914)
 
 			var _x = p.Identifier_list()
 
 			localctx.(*Upsert_clauseContext).conflict_columns = _x
 		}
 		{
-			p.SetState(902)
+			p.SetState(915)
 			p.Match(KuneiformParserRPAREN)
 			if p.HasError() {
 				// Recognition error - abort rule
 				goto errorExit
 			}
 		}
-		p.SetState(905)
+		p.SetState(918)
 		p.GetErrorHandler().Sync(p)
 		if p.HasError() {
 			goto errorExit
@@ -15605,7 +15699,7 @@ func (p *KuneiformParser) Upsert_clause() (localctx IUpsert_clauseContext) {
 
 		if _la == KuneiformParserWHERE {
 			{
-				p.SetState(903)
+				p.SetState(916)
 				p.Match(KuneiformParserWHERE)
 				if p.HasError() {
 					// Recognition error - abort rule
@@ -15613,7 +15707,7 @@ func (p *KuneiformParser) Upsert_clause() (localctx IUpsert_clauseContext) {
 				}
 			}
 			{
-				p.SetState(904)
+				p.SetState(917)
 
 				var _x = p.sql_expr(0)
 
@@ -15624,14 +15718,14 @@ func (p *KuneiformParser) Upsert_clause() (localctx IUpsert_clauseContext) {
 
 	}
 	{
-		p.SetState(909)
+		p.SetState(922)
 		p.Match(KuneiformParserDO)
 		if p.HasError() {
 			// Recognition error - abort rule
 			goto errorExit
 		}
 	}
-	p.SetState(925)
+	p.SetState(938)
 	p.GetErrorHandler().Sync(p)
 	if p.HasError() {
 		goto errorExit
@@ -15640,7 +15734,7 @@ func (p *KuneiformParser) Upsert_clause() (localctx IUpsert_clauseContext) {
 	switch p.GetTokenStream().LA(1) {
 	case KuneiformParserNOTHING:
 		{
-			p.SetState(910)
+			p.SetState(923)
 			p.Match(KuneiformParserNOTHING)
 			if p.HasError() {
 				// Recognition error - abort rule
@@ -15650,7 +15744,7 @@ func (p *KuneiformParser) Upsert_clause() (localctx IUpsert_clauseContext) {
 
 	case KuneiformParserUPDATE:
 		{
-			p.SetState(911)
+			p.SetState(924)
 			p.Match(KuneiformParserUPDATE)
 			if p.HasError() {
 				// Recognition error - abort rule
@@ -15658,7 +15752,7 @@ func (p *KuneiformParser) Upsert_clause() (localctx IUpsert_clauseContext) {
 			}
 		}
 		{
-			p.SetState(912)
+			p.SetState(925)
 			p.Match(KuneiformParserSET)
 			if p.HasError() {
 				// Recognition error - abort rule
@@ -15666,10 +15760,10 @@ func (p *KuneiformParser) Upsert_clause() (localctx IUpsert_clauseContext) {
 			}
 		}
 		{
-			p.SetState(913)
+			p.SetState(926)
 			p.Update_set_clause()
 		}
-		p.SetState(918)
+		p.SetState(931)
 		p.GetErrorHandler().Sync(p)
 		if p.HasError() {
 			goto errorExit
@@ -15678,7 +15772,7 @@ func (p *KuneiformParser) Upsert_clause() (localctx IUpsert_clauseContext) {
 
 		for _la == KuneiformParserCOMMA {
 			{
-				p.SetState(914)
+				p.SetState(927)
 				p.Match(KuneiformParserCOMMA)
 				if p.HasError() {
 					// Recognition error - abort rule
@@ -15686,18 +15780,18 @@ func (p *KuneiformParser) Upsert_clause() (localctx IUpsert_clauseContext) {
 				}
 			}
 			{
-				p.SetState(915)
+				p.SetState(928)
 				p.Update_set_clause()
 			}
 
-			p.SetState(920)
+			p.SetState(933)
 			p.GetErrorHandler().Sync(p)
 			if p.HasError() {
 				goto errorExit
 			}
 			_la = p.GetTokenStream().LA(1)
 		}
-		p.SetState(923)
+		p.SetState(936)
 		p.GetErrorHandler().Sync(p)
 		if p.HasError() {
 			goto errorExit
@@ -15706,7 +15800,7 @@ func (p *KuneiformParser) Upsert_clause() (localctx IUpsert_clauseContext) {
 
 		if _la == KuneiformParserWHERE {
 			{
-				p.SetState(921)
+				p.SetState(934)
 				p.Match(KuneiformParserWHERE)
 				if p.HasError() {
 					// Recognition error - abort rule
@@ -15714,7 +15808,7 @@ func (p *KuneiformParser) Upsert_clause() (localctx IUpsert_clauseContext) {
 				}
 			}
 			{
-				p.SetState(922)
+				p.SetState(935)
 
 				var _x = p.sql_expr(0)
 
@@ -15924,7 +16018,7 @@ func (p *KuneiformParser) Delete_statement() (localctx IDelete_statementContext)
 
 	p.EnterOuterAlt(localctx, 1)
 	{
-		p.SetState(927)
+		p.SetState(940)
 		p.Match(KuneiformParserDELETE)
 		if p.HasError() {
 			// Recognition error - abort rule
@@ -15932,7 +16026,7 @@ func (p *KuneiformParser) Delete_statement() (localctx IDelete_statementContext)
 		}
 	}
 	{
-		p.SetState(928)
+		p.SetState(941)
 		p.Match(KuneiformParserFROM)
 		if p.HasError() {
 			// Recognition error - abort rule
@@ -15940,13 +16034,13 @@ func (p *KuneiformParser) Delete_statement() (localctx IDelete_statementContext)
 		}
 	}
 	{
-		p.SetState(929)
+		p.SetState(942)
 
 		var _x = p.Identifier()
 
 		localctx.(*Delete_statementContext).table_name = _x
 	}
-	p.SetState(934)
+	p.SetState(947)
 	p.GetErrorHandler().Sync(p)
 	if p.HasError() {
 		goto errorExit
@@ -15954,7 +16048,7 @@ func (p *KuneiformParser) Delete_statement() (localctx IDelete_statementContext)
 	_la = p.GetTokenStream().LA(1)
 
 	if ((int64((_la-33)) & ^0x3f) == 0 && ((int64(1)<<(_la-33))&18049583966447479) != 0) || ((int64((_la-112)) & ^0x3f) == 0 && ((int64(1)<<(_la-112))&68752760959) != 0) {
-		p.SetState(931)
+		p.SetState(944)
 		p.GetErrorHandler().Sync(p)
 		if p.HasError() {
 			goto errorExit
@@ -15963,7 +16057,7 @@ func (p *KuneiformParser) Delete_statement() (localctx IDelete_statementContext)
 
 		if _la == KuneiformParserAS {
 			{
-				p.SetState(930)
+				p.SetState(943)
 				p.Match(KuneiformParserAS)
 				if p.HasError() {
 					// Recognition error - abort rule
@@ -15973,7 +16067,7 @@ func (p *KuneiformParser) Delete_statement() (localctx IDelete_statementContext)
 
 		}
 		{
-			p.SetState(933)
+			p.SetState(946)
 
 			var _x = p.Identifier()
 
@@ -15981,7 +16075,7 @@ func (p *KuneiformParser) Delete_statement() (localctx IDelete_statementContext)
 		}
 
 	}
-	p.SetState(938)
+	p.SetState(951)
 	p.GetErrorHandler().Sync(p)
 	if p.HasError() {
 		goto errorExit
@@ -15990,7 +16084,7 @@ func (p *KuneiformParser) Delete_statement() (localctx IDelete_statementContext)
 
 	if _la == KuneiformParserWHERE {
 		{
-			p.SetState(936)
+			p.SetState(949)
 			p.Match(KuneiformParserWHERE)
 			if p.HasError() {
 				// Recognition error - abort rule
@@ -15998,7 +16092,7 @@ func (p *KuneiformParser) Delete_statement() (localctx IDelete_statementContext)
 			}
 		}
 		{
-			p.SetState(937)
+			p.SetState(950)
 
 			var _x = p.sql_expr(0)
 
@@ -17835,7 +17929,7 @@ func (p *KuneiformParser) sql_expr(_p int) (localctx ISql_exprContext) {
 	var _alt int
 
 	p.EnterOuterAlt(localctx, 1)
-	p.SetState(1022)
+	p.SetState(1035)
 	p.GetErrorHandler().Sync(p)
 	if p.HasError() {
 		goto errorExit
@@ -17848,7 +17942,7 @@ func (p *KuneiformParser) sql_expr(_p int) (localctx ISql_exprContext) {
 		_prevctx = localctx
 
 		{
-			p.SetState(941)
+			p.SetState(954)
 			p.Match(KuneiformParserLPAREN)
 			if p.HasError() {
 				// Recognition error - abort rule
@@ -17856,23 +17950,23 @@ func (p *KuneiformParser) sql_expr(_p int) (localctx ISql_exprContext) {
 			}
 		}
 		{
-			p.SetState(942)
+			p.SetState(955)
 			p.sql_expr(0)
 		}
 		{
-			p.SetState(943)
+			p.SetState(956)
 			p.Match(KuneiformParserRPAREN)
 			if p.HasError() {
 				// Recognition error - abort rule
 				goto errorExit
 			}
 		}
-		p.SetState(945)
+		p.SetState(958)
 		p.GetErrorHandler().Sync(p)
 
 		if p.GetInterpreter().AdaptivePredict(p.BaseParser, p.GetTokenStream(), 124, p.GetParserRuleContext()) == 1 {
 			{
-				p.SetState(944)
+				p.SetState(957)
 				p.Type_cast()
 			}
 
@@ -17885,7 +17979,7 @@ func (p *KuneiformParser) sql_expr(_p int) (localctx ISql_exprContext) {
 		p.SetParserRuleContext(localctx)
 		_prevctx = localctx
 		{
-			p.SetState(947)
+			p.SetState(960)
 			_la = p.GetTokenStream().LA(1)
 
 			if !(_la == KuneiformParserPLUS || _la == KuneiformParserMINUS) {
@@ -17896,7 +17990,7 @@ func (p *KuneiformParser) sql_expr(_p int) (localctx ISql_exprContext) {
 			}
 		}
 		{
-			p.SetState(948)
+			p.SetState(961)
 			p.sql_expr(22)
 		}
 
@@ -17905,15 +17999,15 @@ func (p *KuneiformParser) sql_expr(_p int) (localctx ISql_exprContext) {
 		p.SetParserRuleContext(localctx)
 		_prevctx = localctx
 		{
-			p.SetState(949)
+			p.SetState(962)
 			p.Literal()
 		}
-		p.SetState(951)
+		p.SetState(964)
 		p.GetErrorHandler().Sync(p)
 
 		if p.GetInterpreter().AdaptivePredict(p.BaseParser, p.GetTokenStream(), 125, p.GetParserRuleContext()) == 1 {
 			{
-				p.SetState(950)
+				p.SetState(963)
 				p.Type_cast()
 			}
 
@@ -17926,10 +18020,10 @@ func (p *KuneiformParser) sql_expr(_p int) (localctx ISql_exprContext) {
 		p.SetParserRuleContext(localctx)
 		_prevctx = localctx
 		{
-			p.SetState(953)
+			p.SetState(966)
 			p.Sql_function_call()
 		}
-		p.SetState(960)
+		p.SetState(973)
 		p.GetErrorHandler().Sync(p)
 		if p.HasError() {
 			goto errorExit
@@ -17938,7 +18032,7 @@ func (p *KuneiformParser) sql_expr(_p int) (localctx ISql_exprContext) {
 
 		if _la == KuneiformParserFILTER {
 			{
-				p.SetState(954)
+				p.SetState(967)
 				p.Match(KuneiformParserFILTER)
 				if p.HasError() {
 					// Recognition error - abort rule
@@ -17946,7 +18040,7 @@ func (p *KuneiformParser) sql_expr(_p int) (localctx ISql_exprContext) {
 				}
 			}
 			{
-				p.SetState(955)
+				p.SetState(968)
 				p.Match(KuneiformParserLPAREN)
 				if p.HasError() {
 					// Recognition error - abort rule
@@ -17954,7 +18048,7 @@ func (p *KuneiformParser) sql_expr(_p int) (localctx ISql_exprContext) {
 				}
 			}
 			{
-				p.SetState(956)
+				p.SetState(969)
 				p.Match(KuneiformParserWHERE)
 				if p.HasError() {
 					// Recognition error - abort rule
@@ -17962,11 +18056,11 @@ func (p *KuneiformParser) sql_expr(_p int) (localctx ISql_exprContext) {
 				}
 			}
 			{
-				p.SetState(957)
+				p.SetState(970)
 				p.sql_expr(0)
 			}
 			{
-				p.SetState(958)
+				p.SetState(971)
 				p.Match(KuneiformParserRPAREN)
 				if p.HasError() {
 					// Recognition error - abort rule
@@ -17976,14 +18070,14 @@ func (p *KuneiformParser) sql_expr(_p int) (localctx ISql_exprContext) {
 
 		}
 		{
-			p.SetState(962)
+			p.SetState(975)
 			p.Match(KuneiformParserOVER)
 			if p.HasError() {
 				// Recognition error - abort rule
 				goto errorExit
 			}
 		}
-		p.SetState(965)
+		p.SetState(978)
 		p.GetErrorHandler().Sync(p)
 		if p.HasError() {
 			goto errorExit
@@ -17992,13 +18086,13 @@ func (p *KuneiformParser) sql_expr(_p int) (localctx ISql_exprContext) {
 		switch p.GetTokenStream().LA(1) {
 		case KuneiformParserLPAREN:
 			{
-				p.SetState(963)
+				p.SetState(976)
 				p.Window()
 			}
 
 		case KuneiformParserDOUBLE_QUOTE, KuneiformParserUSE, KuneiformParserUNUSE, KuneiformParserACTION, KuneiformParserCREATE, KuneiformParserALTER, KuneiformParserADD, KuneiformParserDROP, KuneiformParserRENAME, KuneiformParserCHECK, KuneiformParserFOREIGN, KuneiformParserPRIMARY, KuneiformParserKEY, KuneiformParserUNIQUE, KuneiformParserRESTRICT, KuneiformParserDEFAULT, KuneiformParserINDEX, KuneiformParserRETURNS, KuneiformParserFOR, KuneiformParserIF, KuneiformParserELSEIF, KuneiformParserELSE, KuneiformParserBREAK, KuneiformParserCONTINUE, KuneiformParserRETURN, KuneiformParserGRANT, KuneiformParserGRANTED, KuneiformParserREVOKE, KuneiformParserROLE, KuneiformParserREPLACE, KuneiformParserCURRENT, KuneiformParserNAMESPACE, KuneiformParserTRANSFER, KuneiformParserOWNERSHIP, KuneiformParserROLES, KuneiformParserCALL, KuneiformParserIDENTIFIER:
 			{
-				p.SetState(964)
+				p.SetState(977)
 				p.Identifier()
 			}
 
@@ -18012,15 +18106,15 @@ func (p *KuneiformParser) sql_expr(_p int) (localctx ISql_exprContext) {
 		p.SetParserRuleContext(localctx)
 		_prevctx = localctx
 		{
-			p.SetState(967)
+			p.SetState(980)
 			p.Sql_function_call()
 		}
-		p.SetState(969)
+		p.SetState(982)
 		p.GetErrorHandler().Sync(p)
 
 		if p.GetInterpreter().AdaptivePredict(p.BaseParser, p.GetTokenStream(), 128, p.GetParserRuleContext()) == 1 {
 			{
-				p.SetState(968)
+				p.SetState(981)
 				p.Type_cast()
 			}
 
@@ -18033,15 +18127,15 @@ func (p *KuneiformParser) sql_expr(_p int) (localctx ISql_exprContext) {
 		p.SetParserRuleContext(localctx)
 		_prevctx = localctx
 		{
-			p.SetState(971)
+			p.SetState(984)
 			p.Variable()
 		}
-		p.SetState(973)
+		p.SetState(986)
 		p.GetErrorHandler().Sync(p)
 
 		if p.GetInterpreter().AdaptivePredict(p.BaseParser, p.GetTokenStream(), 129, p.GetParserRuleContext()) == 1 {
 			{
-				p.SetState(972)
+				p.SetState(985)
 				p.Type_cast()
 			}
 
@@ -18054,7 +18148,7 @@ func (p *KuneiformParser) sql_expr(_p int) (localctx ISql_exprContext) {
 		p.SetParserRuleContext(localctx)
 		_prevctx = localctx
 		{
-			p.SetState(975)
+			p.SetState(988)
 			p.Match(KuneiformParserARRAY)
 			if p.HasError() {
 				// Recognition error - abort rule
@@ -18062,14 +18156,14 @@ func (p *KuneiformParser) sql_expr(_p int) (localctx ISql_exprContext) {
 			}
 		}
 		{
-			p.SetState(976)
+			p.SetState(989)
 			p.Match(KuneiformParserLBRACKET)
 			if p.HasError() {
 				// Recognition error - abort rule
 				goto errorExit
 			}
 		}
-		p.SetState(978)
+		p.SetState(991)
 		p.GetErrorHandler().Sync(p)
 		if p.HasError() {
 			goto errorExit
@@ -18078,25 +18172,25 @@ func (p *KuneiformParser) sql_expr(_p int) (localctx ISql_exprContext) {
 
 		if ((int64(_la) & ^0x3f) == 0 && ((int64(1)<<_la)&-4371923291645935488) != 0) || ((int64((_la-71)) & ^0x3f) == 0 && ((int64(1)<<(_la-71))&-17735122555437055) != 0) || ((int64((_la-135)) & ^0x3f) == 0 && ((int64(1)<<(_la-135))&57471) != 0) {
 			{
-				p.SetState(977)
+				p.SetState(990)
 				p.Sql_expr_list()
 			}
 
 		}
 		{
-			p.SetState(980)
+			p.SetState(993)
 			p.Match(KuneiformParserRBRACKET)
 			if p.HasError() {
 				// Recognition error - abort rule
 				goto errorExit
 			}
 		}
-		p.SetState(982)
+		p.SetState(995)
 		p.GetErrorHandler().Sync(p)
 
 		if p.GetInterpreter().AdaptivePredict(p.BaseParser, p.GetTokenStream(), 131, p.GetParserRuleContext()) == 1 {
 			{
-				p.SetState(981)
+				p.SetState(994)
 				p.Type_cast()
 			}
 
@@ -18108,19 +18202,19 @@ func (p *KuneiformParser) sql_expr(_p int) (localctx ISql_exprContext) {
 		localctx = NewColumn_sql_exprContext(p, localctx)
 		p.SetParserRuleContext(localctx)
 		_prevctx = localctx
-		p.SetState(987)
+		p.SetState(1000)
 		p.GetErrorHandler().Sync(p)
 
 		if p.GetInterpreter().AdaptivePredict(p.BaseParser, p.GetTokenStream(), 132, p.GetParserRuleContext()) == 1 {
 			{
-				p.SetState(984)
+				p.SetState(997)
 
 				var _x = p.Identifier()
 
 				localctx.(*Column_sql_exprContext).table = _x
 			}
 			{
-				p.SetState(985)
+				p.SetState(998)
 				p.Match(KuneiformParserPERIOD)
 				if p.HasError() {
 					// Recognition error - abort rule
@@ -18132,18 +18226,18 @@ func (p *KuneiformParser) sql_expr(_p int) (localctx ISql_exprContext) {
 			goto errorExit
 		}
 		{
-			p.SetState(989)
+			p.SetState(1002)
 
 			var _x = p.Identifier()
 
 			localctx.(*Column_sql_exprContext).column = _x
 		}
-		p.SetState(991)
+		p.SetState(1004)
 		p.GetErrorHandler().Sync(p)
 
 		if p.GetInterpreter().AdaptivePredict(p.BaseParser, p.GetTokenStream(), 133, p.GetParserRuleContext()) == 1 {
 			{
-				p.SetState(990)
+				p.SetState(1003)
 				p.Type_cast()
 			}
 
@@ -18156,14 +18250,14 @@ func (p *KuneiformParser) sql_expr(_p int) (localctx ISql_exprContext) {
 		p.SetParserRuleContext(localctx)
 		_prevctx = localctx
 		{
-			p.SetState(993)
+			p.SetState(1006)
 			p.Match(KuneiformParserCASE)
 			if p.HasError() {
 				// Recognition error - abort rule
 				goto errorExit
 			}
 		}
-		p.SetState(995)
+		p.SetState(1008)
 		p.GetErrorHandler().Sync(p)
 		if p.HasError() {
 			goto errorExit
@@ -18172,7 +18266,7 @@ func (p *KuneiformParser) sql_expr(_p int) (localctx ISql_exprContext) {
 
 		if ((int64(_la) & ^0x3f) == 0 && ((int64(1)<<_la)&-4371923291645935488) != 0) || ((int64((_la-71)) & ^0x3f) == 0 && ((int64(1)<<(_la-71))&-17735122555437055) != 0) || ((int64((_la-135)) & ^0x3f) == 0 && ((int64(1)<<(_la-135))&57471) != 0) {
 			{
-				p.SetState(994)
+				p.SetState(1007)
 
 				var _x = p.sql_expr(0)
 
@@ -18180,7 +18274,7 @@ func (p *KuneiformParser) sql_expr(_p int) (localctx ISql_exprContext) {
 			}
 
 		}
-		p.SetState(998)
+		p.SetState(1011)
 		p.GetErrorHandler().Sync(p)
 		if p.HasError() {
 			goto errorExit
@@ -18189,18 +18283,18 @@ func (p *KuneiformParser) sql_expr(_p int) (localctx ISql_exprContext) {
 
 		for ok := true; ok; ok = _la == KuneiformParserWHEN {
 			{
-				p.SetState(997)
+				p.SetState(1010)
 				p.When_then_clause()
 			}
 
-			p.SetState(1000)
+			p.SetState(1013)
 			p.GetErrorHandler().Sync(p)
 			if p.HasError() {
 				goto errorExit
 			}
 			_la = p.GetTokenStream().LA(1)
 		}
-		p.SetState(1004)
+		p.SetState(1017)
 		p.GetErrorHandler().Sync(p)
 		if p.HasError() {
 			goto errorExit
@@ -18209,7 +18303,7 @@ func (p *KuneiformParser) sql_expr(_p int) (localctx ISql_exprContext) {
 
 		if _la == KuneiformParserELSE {
 			{
-				p.SetState(1002)
+				p.SetState(1015)
 				p.Match(KuneiformParserELSE)
 				if p.HasError() {
 					// Recognition error - abort rule
@@ -18217,7 +18311,7 @@ func (p *KuneiformParser) sql_expr(_p int) (localctx ISql_exprContext) {
 				}
 			}
 			{
-				p.SetState(1003)
+				p.SetState(1016)
 
 				var _x = p.sql_expr(0)
 
@@ -18226,7 +18320,7 @@ func (p *KuneiformParser) sql_expr(_p int) (localctx ISql_exprContext) {
 
 		}
 		{
-			p.SetState(1006)
+			p.SetState(1019)
 			p.Match(KuneiformParserEND)
 			if p.HasError() {
 				// Recognition error - abort rule
@@ -18238,7 +18332,7 @@ func (p *KuneiformParser) sql_expr(_p int) (localctx ISql_exprContext) {
 		localctx = NewSubquery_sql_exprContext(p, localctx)
 		p.SetParserRuleContext(localctx)
 		_prevctx = localctx
-		p.SetState(1012)
+		p.SetState(1025)
 		p.GetErrorHandler().Sync(p)
 		if p.HasError() {
 			goto errorExit
@@ -18246,7 +18340,7 @@ func (p *KuneiformParser) sql_expr(_p int) (localctx ISql_exprContext) {
 		_la = p.GetTokenStream().LA(1)
 
 		if _la == KuneiformParserNOT || _la == KuneiformParserEXISTS {
-			p.SetState(1009)
+			p.SetState(1022)
 			p.GetErrorHandler().Sync(p)
 			if p.HasError() {
 				goto errorExit
@@ -18255,7 +18349,7 @@ func (p *KuneiformParser) sql_expr(_p int) (localctx ISql_exprContext) {
 
 			if _la == KuneiformParserNOT {
 				{
-					p.SetState(1008)
+					p.SetState(1021)
 					p.Match(KuneiformParserNOT)
 					if p.HasError() {
 						// Recognition error - abort rule
@@ -18265,7 +18359,7 @@ func (p *KuneiformParser) sql_expr(_p int) (localctx ISql_exprContext) {
 
 			}
 			{
-				p.SetState(1011)
+				p.SetState(1024)
 				p.Match(KuneiformParserEXISTS)
 				if p.HasError() {
 					// Recognition error - abort rule
@@ -18275,7 +18369,7 @@ func (p *KuneiformParser) sql_expr(_p int) (localctx ISql_exprContext) {
 
 		}
 		{
-			p.SetState(1014)
+			p.SetState(1027)
 			p.Match(KuneiformParserLPAREN)
 			if p.HasError() {
 				// Recognition error - abort rule
@@ -18283,23 +18377,23 @@ func (p *KuneiformParser) sql_expr(_p int) (localctx ISql_exprContext) {
 			}
 		}
 		{
-			p.SetState(1015)
+			p.SetState(1028)
 			p.Select_statement()
 		}
 		{
-			p.SetState(1016)
+			p.SetState(1029)
 			p.Match(KuneiformParserRPAREN)
 			if p.HasError() {
 				// Recognition error - abort rule
 				goto errorExit
 			}
 		}
-		p.SetState(1018)
+		p.SetState(1031)
 		p.GetErrorHandler().Sync(p)
 
 		if p.GetInterpreter().AdaptivePredict(p.BaseParser, p.GetTokenStream(), 139, p.GetParserRuleContext()) == 1 {
 			{
-				p.SetState(1017)
+				p.SetState(1030)
 				p.Type_cast()
 			}
 
@@ -18313,7 +18407,7 @@ func (p *KuneiformParser) sql_expr(_p int) (localctx ISql_exprContext) {
 		_prevctx = localctx
 
 		{
-			p.SetState(1020)
+			p.SetState(1033)
 			p.Match(KuneiformParserNOT)
 			if p.HasError() {
 				// Recognition error - abort rule
@@ -18322,7 +18416,7 @@ func (p *KuneiformParser) sql_expr(_p int) (localctx ISql_exprContext) {
 		}
 
 		{
-			p.SetState(1021)
+			p.SetState(1034)
 			p.sql_expr(3)
 		}
 
@@ -18330,7 +18424,7 @@ func (p *KuneiformParser) sql_expr(_p int) (localctx ISql_exprContext) {
 		goto errorExit
 	}
 	p.GetParserRuleContext().SetStop(p.GetTokenStream().LT(-1))
-	p.SetState(1112)
+	p.SetState(1125)
 	p.GetErrorHandler().Sync(p)
 	if p.HasError() {
 		goto errorExit
@@ -18345,7 +18439,7 @@ func (p *KuneiformParser) sql_expr(_p int) (localctx ISql_exprContext) {
 				p.TriggerExitRuleEvent()
 			}
 			_prevctx = localctx
-			p.SetState(1110)
+			p.SetState(1123)
 			p.GetErrorHandler().Sync(p)
 			if p.HasError() {
 				goto errorExit
@@ -18357,14 +18451,14 @@ func (p *KuneiformParser) sql_expr(_p int) (localctx ISql_exprContext) {
 				localctx.(*Arithmetic_sql_exprContext).left = _prevctx
 
 				p.PushNewRecursionContext(localctx, _startState, KuneiformParserRULE_sql_expr)
-				p.SetState(1024)
+				p.SetState(1037)
 
 				if !(p.Precpred(p.GetParserRuleContext(), 20)) {
 					p.SetError(antlr.NewFailedPredicateException(p, "p.Precpred(p.GetParserRuleContext(), 20)", ""))
 					goto errorExit
 				}
 				{
-					p.SetState(1025)
+					p.SetState(1038)
 					p.Match(KuneiformParserEXP)
 					if p.HasError() {
 						// Recognition error - abort rule
@@ -18372,7 +18466,7 @@ func (p *KuneiformParser) sql_expr(_p int) (localctx ISql_exprContext) {
 					}
 				}
 				{
-					p.SetState(1026)
+					p.SetState(1039)
 
 					var _x = p.sql_expr(21)
 
@@ -18384,14 +18478,14 @@ func (p *KuneiformParser) sql_expr(_p int) (localctx ISql_exprContext) {
 				localctx.(*Arithmetic_sql_exprContext).left = _prevctx
 
 				p.PushNewRecursionContext(localctx, _startState, KuneiformParserRULE_sql_expr)
-				p.SetState(1027)
+				p.SetState(1040)
 
 				if !(p.Precpred(p.GetParserRuleContext(), 19)) {
 					p.SetError(antlr.NewFailedPredicateException(p, "p.Precpred(p.GetParserRuleContext(), 19)", ""))
 					goto errorExit
 				}
 				{
-					p.SetState(1028)
+					p.SetState(1041)
 					_la = p.GetTokenStream().LA(1)
 
 					if !((int64(_la) & ^0x3f) == 0 && ((int64(1)<<_la)&4734976) != 0) {
@@ -18402,7 +18496,7 @@ func (p *KuneiformParser) sql_expr(_p int) (localctx ISql_exprContext) {
 					}
 				}
 				{
-					p.SetState(1029)
+					p.SetState(1042)
 
 					var _x = p.sql_expr(20)
 
@@ -18414,14 +18508,14 @@ func (p *KuneiformParser) sql_expr(_p int) (localctx ISql_exprContext) {
 				localctx.(*Arithmetic_sql_exprContext).left = _prevctx
 
 				p.PushNewRecursionContext(localctx, _startState, KuneiformParserRULE_sql_expr)
-				p.SetState(1030)
+				p.SetState(1043)
 
 				if !(p.Precpred(p.GetParserRuleContext(), 18)) {
 					p.SetError(antlr.NewFailedPredicateException(p, "p.Precpred(p.GetParserRuleContext(), 18)", ""))
 					goto errorExit
 				}
 				{
-					p.SetState(1031)
+					p.SetState(1044)
 					_la = p.GetTokenStream().LA(1)
 
 					if !(_la == KuneiformParserPLUS || _la == KuneiformParserMINUS) {
@@ -18432,7 +18526,7 @@ func (p *KuneiformParser) sql_expr(_p int) (localctx ISql_exprContext) {
 					}
 				}
 				{
-					p.SetState(1032)
+					p.SetState(1045)
 
 					var _x = p.sql_expr(19)
 
@@ -18444,14 +18538,14 @@ func (p *KuneiformParser) sql_expr(_p int) (localctx ISql_exprContext) {
 				localctx.(*Arithmetic_sql_exprContext).left = _prevctx
 
 				p.PushNewRecursionContext(localctx, _startState, KuneiformParserRULE_sql_expr)
-				p.SetState(1033)
+				p.SetState(1046)
 
 				if !(p.Precpred(p.GetParserRuleContext(), 9)) {
 					p.SetError(antlr.NewFailedPredicateException(p, "p.Precpred(p.GetParserRuleContext(), 9)", ""))
 					goto errorExit
 				}
 				{
-					p.SetState(1034)
+					p.SetState(1047)
 					p.Match(KuneiformParserCONCAT)
 					if p.HasError() {
 						// Recognition error - abort rule
@@ -18459,7 +18553,7 @@ func (p *KuneiformParser) sql_expr(_p int) (localctx ISql_exprContext) {
 					}
 				}
 				{
-					p.SetState(1035)
+					p.SetState(1048)
 
 					var _x = p.sql_expr(10)
 
@@ -18471,13 +18565,13 @@ func (p *KuneiformParser) sql_expr(_p int) (localctx ISql_exprContext) {
 				localctx.(*Like_sql_exprContext).left = _prevctx
 
 				p.PushNewRecursionContext(localctx, _startState, KuneiformParserRULE_sql_expr)
-				p.SetState(1036)
+				p.SetState(1049)
 
 				if !(p.Precpred(p.GetParserRuleContext(), 7)) {
 					p.SetError(antlr.NewFailedPredicateException(p, "p.Precpred(p.GetParserRuleContext(), 7)", ""))
 					goto errorExit
 				}
-				p.SetState(1038)
+				p.SetState(1051)
 				p.GetErrorHandler().Sync(p)
 				if p.HasError() {
 					goto errorExit
@@ -18486,7 +18580,7 @@ func (p *KuneiformParser) sql_expr(_p int) (localctx ISql_exprContext) {
 
 				if _la == KuneiformParserNOT {
 					{
-						p.SetState(1037)
+						p.SetState(1050)
 						p.Match(KuneiformParserNOT)
 						if p.HasError() {
 							// Recognition error - abort rule
@@ -18496,7 +18590,7 @@ func (p *KuneiformParser) sql_expr(_p int) (localctx ISql_exprContext) {
 
 				}
 				{
-					p.SetState(1040)
+					p.SetState(1053)
 					_la = p.GetTokenStream().LA(1)
 
 					if !(_la == KuneiformParserLIKE || _la == KuneiformParserILIKE) {
@@ -18507,7 +18601,7 @@ func (p *KuneiformParser) sql_expr(_p int) (localctx ISql_exprContext) {
 					}
 				}
 				{
-					p.SetState(1041)
+					p.SetState(1054)
 
 					var _x = p.sql_expr(8)
 
@@ -18519,13 +18613,13 @@ func (p *KuneiformParser) sql_expr(_p int) (localctx ISql_exprContext) {
 				localctx.(*Between_sql_exprContext).element = _prevctx
 
 				p.PushNewRecursionContext(localctx, _startState, KuneiformParserRULE_sql_expr)
-				p.SetState(1042)
+				p.SetState(1055)
 
 				if !(p.Precpred(p.GetParserRuleContext(), 6)) {
 					p.SetError(antlr.NewFailedPredicateException(p, "p.Precpred(p.GetParserRuleContext(), 6)", ""))
 					goto errorExit
 				}
-				p.SetState(1044)
+				p.SetState(1057)
 				p.GetErrorHandler().Sync(p)
 				if p.HasError() {
 					goto errorExit
@@ -18534,7 +18628,7 @@ func (p *KuneiformParser) sql_expr(_p int) (localctx ISql_exprContext) {
 
 				if _la == KuneiformParserNOT {
 					{
-						p.SetState(1043)
+						p.SetState(1056)
 						p.Match(KuneiformParserNOT)
 						if p.HasError() {
 							// Recognition error - abort rule
@@ -18544,7 +18638,7 @@ func (p *KuneiformParser) sql_expr(_p int) (localctx ISql_exprContext) {
 
 				}
 				{
-					p.SetState(1046)
+					p.SetState(1059)
 					p.Match(KuneiformParserBETWEEN)
 					if p.HasError() {
 						// Recognition error - abort rule
@@ -18552,14 +18646,14 @@ func (p *KuneiformParser) sql_expr(_p int) (localctx ISql_exprContext) {
 					}
 				}
 				{
-					p.SetState(1047)
+					p.SetState(1060)
 
 					var _x = p.sql_expr(0)
 
 					localctx.(*Between_sql_exprContext).lower = _x
 				}
 				{
-					p.SetState(1048)
+					p.SetState(1061)
 					p.Match(KuneiformParserAND)
 					if p.HasError() {
 						// Recognition error - abort rule
@@ -18567,7 +18661,7 @@ func (p *KuneiformParser) sql_expr(_p int) (localctx ISql_exprContext) {
 					}
 				}
 				{
-					p.SetState(1049)
+					p.SetState(1062)
 
 					var _x = p.sql_expr(7)
 
@@ -18579,14 +18673,14 @@ func (p *KuneiformParser) sql_expr(_p int) (localctx ISql_exprContext) {
 				localctx.(*Comparison_sql_exprContext).left = _prevctx
 
 				p.PushNewRecursionContext(localctx, _startState, KuneiformParserRULE_sql_expr)
-				p.SetState(1051)
+				p.SetState(1064)
 
 				if !(p.Precpred(p.GetParserRuleContext(), 5)) {
 					p.SetError(antlr.NewFailedPredicateException(p, "p.Precpred(p.GetParserRuleContext(), 5)", ""))
 					goto errorExit
 				}
 				{
-					p.SetState(1052)
+					p.SetState(1065)
 					_la = p.GetTokenStream().LA(1)
 
 					if !((int64(_la) & ^0x3f) == 0 && ((int64(1)<<_la)&520192000) != 0) {
@@ -18597,7 +18691,7 @@ func (p *KuneiformParser) sql_expr(_p int) (localctx ISql_exprContext) {
 					}
 				}
 				{
-					p.SetState(1053)
+					p.SetState(1066)
 
 					var _x = p.sql_expr(6)
 
@@ -18609,14 +18703,14 @@ func (p *KuneiformParser) sql_expr(_p int) (localctx ISql_exprContext) {
 				localctx.(*Logical_sql_exprContext).left = _prevctx
 
 				p.PushNewRecursionContext(localctx, _startState, KuneiformParserRULE_sql_expr)
-				p.SetState(1054)
+				p.SetState(1067)
 
 				if !(p.Precpred(p.GetParserRuleContext(), 2)) {
 					p.SetError(antlr.NewFailedPredicateException(p, "p.Precpred(p.GetParserRuleContext(), 2)", ""))
 					goto errorExit
 				}
 				{
-					p.SetState(1055)
+					p.SetState(1068)
 					p.Match(KuneiformParserAND)
 					if p.HasError() {
 						// Recognition error - abort rule
@@ -18624,7 +18718,7 @@ func (p *KuneiformParser) sql_expr(_p int) (localctx ISql_exprContext) {
 					}
 				}
 				{
-					p.SetState(1056)
+					p.SetState(1069)
 
 					var _x = p.sql_expr(3)
 
@@ -18636,14 +18730,14 @@ func (p *KuneiformParser) sql_expr(_p int) (localctx ISql_exprContext) {
 				localctx.(*Logical_sql_exprContext).left = _prevctx
 
 				p.PushNewRecursionContext(localctx, _startState, KuneiformParserRULE_sql_expr)
-				p.SetState(1057)
+				p.SetState(1070)
 
 				if !(p.Precpred(p.GetParserRuleContext(), 1)) {
 					p.SetError(antlr.NewFailedPredicateException(p, "p.Precpred(p.GetParserRuleContext(), 1)", ""))
 					goto errorExit
 				}
 				{
-					p.SetState(1058)
+					p.SetState(1071)
 					p.Match(KuneiformParserOR)
 					if p.HasError() {
 						// Recognition error - abort rule
@@ -18651,7 +18745,7 @@ func (p *KuneiformParser) sql_expr(_p int) (localctx ISql_exprContext) {
 					}
 				}
 				{
-					p.SetState(1059)
+					p.SetState(1072)
 
 					var _x = p.sql_expr(2)
 
@@ -18661,14 +18755,14 @@ func (p *KuneiformParser) sql_expr(_p int) (localctx ISql_exprContext) {
 			case 10:
 				localctx = NewField_access_sql_exprContext(p, NewSql_exprContext(p, _parentctx, _parentState))
 				p.PushNewRecursionContext(localctx, _startState, KuneiformParserRULE_sql_expr)
-				p.SetState(1060)
+				p.SetState(1073)
 
 				if !(p.Precpred(p.GetParserRuleContext(), 24)) {
 					p.SetError(antlr.NewFailedPredicateException(p, "p.Precpred(p.GetParserRuleContext(), 24)", ""))
 					goto errorExit
 				}
 				{
-					p.SetState(1061)
+					p.SetState(1074)
 					p.Match(KuneiformParserPERIOD)
 					if p.HasError() {
 						// Recognition error - abort rule
@@ -18676,15 +18770,15 @@ func (p *KuneiformParser) sql_expr(_p int) (localctx ISql_exprContext) {
 					}
 				}
 				{
-					p.SetState(1062)
+					p.SetState(1075)
 					p.Identifier()
 				}
-				p.SetState(1064)
+				p.SetState(1077)
 				p.GetErrorHandler().Sync(p)
 
 				if p.GetInterpreter().AdaptivePredict(p.BaseParser, p.GetTokenStream(), 143, p.GetParserRuleContext()) == 1 {
 					{
-						p.SetState(1063)
+						p.SetState(1076)
 						p.Type_cast()
 					}
 
@@ -18697,21 +18791,21 @@ func (p *KuneiformParser) sql_expr(_p int) (localctx ISql_exprContext) {
 				localctx.(*Array_access_sql_exprContext).array_element = _prevctx
 
 				p.PushNewRecursionContext(localctx, _startState, KuneiformParserRULE_sql_expr)
-				p.SetState(1066)
+				p.SetState(1079)
 
 				if !(p.Precpred(p.GetParserRuleContext(), 23)) {
 					p.SetError(antlr.NewFailedPredicateException(p, "p.Precpred(p.GetParserRuleContext(), 23)", ""))
 					goto errorExit
 				}
 				{
-					p.SetState(1067)
+					p.SetState(1080)
 					p.Match(KuneiformParserLBRACKET)
 					if p.HasError() {
 						// Recognition error - abort rule
 						goto errorExit
 					}
 				}
-				p.SetState(1076)
+				p.SetState(1089)
 				p.GetErrorHandler().Sync(p)
 				if p.HasError() {
 					goto errorExit
@@ -18720,7 +18814,7 @@ func (p *KuneiformParser) sql_expr(_p int) (localctx ISql_exprContext) {
 				switch p.GetInterpreter().AdaptivePredict(p.BaseParser, p.GetTokenStream(), 146, p.GetParserRuleContext()) {
 				case 1:
 					{
-						p.SetState(1068)
+						p.SetState(1081)
 
 						var _x = p.sql_expr(0)
 
@@ -18728,7 +18822,7 @@ func (p *KuneiformParser) sql_expr(_p int) (localctx ISql_exprContext) {
 					}
 
 				case 2:
-					p.SetState(1070)
+					p.SetState(1083)
 					p.GetErrorHandler().Sync(p)
 					if p.HasError() {
 						goto errorExit
@@ -18737,7 +18831,7 @@ func (p *KuneiformParser) sql_expr(_p int) (localctx ISql_exprContext) {
 
 					if ((int64(_la) & ^0x3f) == 0 && ((int64(1)<<_la)&-4371923291645935488) != 0) || ((int64((_la-71)) & ^0x3f) == 0 && ((int64(1)<<(_la-71))&-17735122555437055) != 0) || ((int64((_la-135)) & ^0x3f) == 0 && ((int64(1)<<(_la-135))&57471) != 0) {
 						{
-							p.SetState(1069)
+							p.SetState(1082)
 
 							var _x = p.sql_expr(0)
 
@@ -18746,14 +18840,14 @@ func (p *KuneiformParser) sql_expr(_p int) (localctx ISql_exprContext) {
 
 					}
 					{
-						p.SetState(1072)
+						p.SetState(1085)
 						p.Match(KuneiformParserCOL)
 						if p.HasError() {
 							// Recognition error - abort rule
 							goto errorExit
 						}
 					}
-					p.SetState(1074)
+					p.SetState(1087)
 					p.GetErrorHandler().Sync(p)
 					if p.HasError() {
 						goto errorExit
@@ -18762,7 +18856,7 @@ func (p *KuneiformParser) sql_expr(_p int) (localctx ISql_exprContext) {
 
 					if ((int64(_la) & ^0x3f) == 0 && ((int64(1)<<_la)&-4371923291645935488) != 0) || ((int64((_la-71)) & ^0x3f) == 0 && ((int64(1)<<(_la-71))&-17735122555437055) != 0) || ((int64((_la-135)) & ^0x3f) == 0 && ((int64(1)<<(_la-135))&57471) != 0) {
 						{
-							p.SetState(1073)
+							p.SetState(1086)
 
 							var _x = p.sql_expr(0)
 
@@ -18775,19 +18869,19 @@ func (p *KuneiformParser) sql_expr(_p int) (localctx ISql_exprContext) {
 					goto errorExit
 				}
 				{
-					p.SetState(1078)
+					p.SetState(1091)
 					p.Match(KuneiformParserRBRACKET)
 					if p.HasError() {
 						// Recognition error - abort rule
 						goto errorExit
 					}
 				}
-				p.SetState(1080)
+				p.SetState(1093)
 				p.GetErrorHandler().Sync(p)
 
 				if p.GetInterpreter().AdaptivePredict(p.BaseParser, p.GetTokenStream(), 147, p.GetParserRuleContext()) == 1 {
 					{
-						p.SetState(1079)
+						p.SetState(1092)
 						p.Type_cast()
 					}
 
@@ -18798,14 +18892,14 @@ func (p *KuneiformParser) sql_expr(_p int) (localctx ISql_exprContext) {
 			case 12:
 				localctx = NewCollate_sql_exprContext(p, NewSql_exprContext(p, _parentctx, _parentState))
 				p.PushNewRecursionContext(localctx, _startState, KuneiformParserRULE_sql_expr)
-				p.SetState(1082)
+				p.SetState(1095)
 
 				if !(p.Precpred(p.GetParserRuleContext(), 21)) {
 					p.SetError(antlr.NewFailedPredicateException(p, "p.Precpred(p.GetParserRuleContext(), 21)", ""))
 					goto errorExit
 				}
 				{
-					p.SetState(1083)
+					p.SetState(1096)
 					p.Match(KuneiformParserCOLLATE)
 					if p.HasError() {
 						// Recognition error - abort rule
@@ -18813,20 +18907,20 @@ func (p *KuneiformParser) sql_expr(_p int) (localctx ISql_exprContext) {
 					}
 				}
 				{
-					p.SetState(1084)
+					p.SetState(1097)
 					p.Identifier()
 				}
 
 			case 13:
 				localctx = NewIn_sql_exprContext(p, NewSql_exprContext(p, _parentctx, _parentState))
 				p.PushNewRecursionContext(localctx, _startState, KuneiformParserRULE_sql_expr)
-				p.SetState(1085)
+				p.SetState(1098)
 
 				if !(p.Precpred(p.GetParserRuleContext(), 8)) {
 					p.SetError(antlr.NewFailedPredicateException(p, "p.Precpred(p.GetParserRuleContext(), 8)", ""))
 					goto errorExit
 				}
-				p.SetState(1087)
+				p.SetState(1100)
 				p.GetErrorHandler().Sync(p)
 				if p.HasError() {
 					goto errorExit
@@ -18835,7 +18929,7 @@ func (p *KuneiformParser) sql_expr(_p int) (localctx ISql_exprContext) {
 
 				if _la == KuneiformParserNOT {
 					{
-						p.SetState(1086)
+						p.SetState(1099)
 						p.Match(KuneiformParserNOT)
 						if p.HasError() {
 							// Recognition error - abort rule
@@ -18845,7 +18939,7 @@ func (p *KuneiformParser) sql_expr(_p int) (localctx ISql_exprContext) {
 
 				}
 				{
-					p.SetState(1089)
+					p.SetState(1102)
 					p.Match(KuneiformParserIN)
 					if p.HasError() {
 						// Recognition error - abort rule
@@ -18853,14 +18947,14 @@ func (p *KuneiformParser) sql_expr(_p int) (localctx ISql_exprContext) {
 					}
 				}
 				{
-					p.SetState(1090)
+					p.SetState(1103)
 					p.Match(KuneiformParserLPAREN)
 					if p.HasError() {
 						// Recognition error - abort rule
 						goto errorExit
 					}
 				}
-				p.SetState(1093)
+				p.SetState(1106)
 				p.GetErrorHandler().Sync(p)
 				if p.HasError() {
 					goto errorExit
@@ -18869,13 +18963,13 @@ func (p *KuneiformParser) sql_expr(_p int) (localctx ISql_exprContext) {
 				switch p.GetTokenStream().LA(1) {
 				case KuneiformParserLPAREN, KuneiformParserPLUS, KuneiformParserMINUS, KuneiformParserDOUBLE_QUOTE, KuneiformParserUSE, KuneiformParserUNUSE, KuneiformParserACTION, KuneiformParserCREATE, KuneiformParserALTER, KuneiformParserADD, KuneiformParserDROP, KuneiformParserRENAME, KuneiformParserCHECK, KuneiformParserFOREIGN, KuneiformParserPRIMARY, KuneiformParserKEY, KuneiformParserUNIQUE, KuneiformParserRESTRICT, KuneiformParserDEFAULT, KuneiformParserNULL, KuneiformParserNOT, KuneiformParserINDEX, KuneiformParserEXISTS, KuneiformParserRETURNS, KuneiformParserCASE, KuneiformParserFOR, KuneiformParserIF, KuneiformParserELSEIF, KuneiformParserELSE, KuneiformParserBREAK, KuneiformParserCONTINUE, KuneiformParserRETURN, KuneiformParserGRANT, KuneiformParserGRANTED, KuneiformParserREVOKE, KuneiformParserROLE, KuneiformParserREPLACE, KuneiformParserARRAY, KuneiformParserCURRENT, KuneiformParserNAMESPACE, KuneiformParserTRANSFER, KuneiformParserOWNERSHIP, KuneiformParserROLES, KuneiformParserCALL, KuneiformParserSTRING_, KuneiformParserTRUE, KuneiformParserFALSE, KuneiformParserDIGITS_, KuneiformParserBINARY_, KuneiformParserIDENTIFIER, KuneiformParserVARIABLE, KuneiformParserCONTEXTUAL_VARIABLE:
 					{
-						p.SetState(1091)
+						p.SetState(1104)
 						p.Sql_expr_list()
 					}
 
 				case KuneiformParserSELECT:
 					{
-						p.SetState(1092)
+						p.SetState(1105)
 						p.Select_statement()
 					}
 
@@ -18884,7 +18978,7 @@ func (p *KuneiformParser) sql_expr(_p int) (localctx ISql_exprContext) {
 					goto errorExit
 				}
 				{
-					p.SetState(1095)
+					p.SetState(1108)
 					p.Match(KuneiformParserRPAREN)
 					if p.HasError() {
 						// Recognition error - abort rule
@@ -18897,21 +18991,21 @@ func (p *KuneiformParser) sql_expr(_p int) (localctx ISql_exprContext) {
 				localctx.(*Is_sql_exprContext).left = _prevctx
 
 				p.PushNewRecursionContext(localctx, _startState, KuneiformParserRULE_sql_expr)
-				p.SetState(1097)
+				p.SetState(1110)
 
 				if !(p.Precpred(p.GetParserRuleContext(), 4)) {
 					p.SetError(antlr.NewFailedPredicateException(p, "p.Precpred(p.GetParserRuleContext(), 4)", ""))
 					goto errorExit
 				}
 				{
-					p.SetState(1098)
+					p.SetState(1111)
 					p.Match(KuneiformParserIS)
 					if p.HasError() {
 						// Recognition error - abort rule
 						goto errorExit
 					}
 				}
-				p.SetState(1100)
+				p.SetState(1113)
 				p.GetErrorHandler().Sync(p)
 				if p.HasError() {
 					goto errorExit
@@ -18920,7 +19014,7 @@ func (p *KuneiformParser) sql_expr(_p int) (localctx ISql_exprContext) {
 
 				if _la == KuneiformParserNOT {
 					{
-						p.SetState(1099)
+						p.SetState(1112)
 						p.Match(KuneiformParserNOT)
 						if p.HasError() {
 							// Recognition error - abort rule
@@ -18929,7 +19023,7 @@ func (p *KuneiformParser) sql_expr(_p int) (localctx ISql_exprContext) {
 					}
 
 				}
-				p.SetState(1108)
+				p.SetState(1121)
 				p.GetErrorHandler().Sync(p)
 				if p.HasError() {
 					goto errorExit
@@ -18938,7 +19032,7 @@ func (p *KuneiformParser) sql_expr(_p int) (localctx ISql_exprContext) {
 				switch p.GetTokenStream().LA(1) {
 				case KuneiformParserDISTINCT:
 					{
-						p.SetState(1102)
+						p.SetState(1115)
 						p.Match(KuneiformParserDISTINCT)
 						if p.HasError() {
 							// Recognition error - abort rule
@@ -18946,7 +19040,7 @@ func (p *KuneiformParser) sql_expr(_p int) (localctx ISql_exprContext) {
 						}
 					}
 					{
-						p.SetState(1103)
+						p.SetState(1116)
 						p.Match(KuneiformParserFROM)
 						if p.HasError() {
 							// Recognition error - abort rule
@@ -18954,7 +19048,7 @@ func (p *KuneiformParser) sql_expr(_p int) (localctx ISql_exprContext) {
 						}
 					}
 					{
-						p.SetState(1104)
+						p.SetState(1117)
 
 						var _x = p.sql_expr(0)
 
@@ -18963,7 +19057,7 @@ func (p *KuneiformParser) sql_expr(_p int) (localctx ISql_exprContext) {
 
 				case KuneiformParserNULL:
 					{
-						p.SetState(1105)
+						p.SetState(1118)
 						p.Match(KuneiformParserNULL)
 						if p.HasError() {
 							// Recognition error - abort rule
@@ -18973,7 +19067,7 @@ func (p *KuneiformParser) sql_expr(_p int) (localctx ISql_exprContext) {
 
 				case KuneiformParserTRUE:
 					{
-						p.SetState(1106)
+						p.SetState(1119)
 						p.Match(KuneiformParserTRUE)
 						if p.HasError() {
 							// Recognition error - abort rule
@@ -18983,7 +19077,7 @@ func (p *KuneiformParser) sql_expr(_p int) (localctx ISql_exprContext) {
 
 				case KuneiformParserFALSE:
 					{
-						p.SetState(1107)
+						p.SetState(1120)
 						p.Match(KuneiformParserFALSE)
 						if p.HasError() {
 							// Recognition error - abort rule
@@ -19001,7 +19095,7 @@ func (p *KuneiformParser) sql_expr(_p int) (localctx ISql_exprContext) {
 			}
 
 		}
-		p.SetState(1114)
+		p.SetState(1127)
 		p.GetErrorHandler().Sync(p)
 		if p.HasError() {
 			goto errorExit
@@ -19206,14 +19300,14 @@ func (p *KuneiformParser) Window() (localctx IWindowContext) {
 
 	p.EnterOuterAlt(localctx, 1)
 	{
-		p.SetState(1115)
+		p.SetState(1128)
 		p.Match(KuneiformParserLPAREN)
 		if p.HasError() {
 			// Recognition error - abort rule
 			goto errorExit
 		}
 	}
-	p.SetState(1119)
+	p.SetState(1132)
 	p.GetErrorHandler().Sync(p)
 	if p.HasError() {
 		goto errorExit
@@ -19222,7 +19316,7 @@ func (p *KuneiformParser) Window() (localctx IWindowContext) {
 
 	if _la == KuneiformParserPARTITION {
 		{
-			p.SetState(1116)
+			p.SetState(1129)
 			p.Match(KuneiformParserPARTITION)
 			if p.HasError() {
 				// Recognition error - abort rule
@@ -19230,7 +19324,7 @@ func (p *KuneiformParser) Window() (localctx IWindowContext) {
 			}
 		}
 		{
-			p.SetState(1117)
+			p.SetState(1130)
 			p.Match(KuneiformParserBY)
 			if p.HasError() {
 				// Recognition error - abort rule
@@ -19238,7 +19332,7 @@ func (p *KuneiformParser) Window() (localctx IWindowContext) {
 			}
 		}
 		{
-			p.SetState(1118)
+			p.SetState(1131)
 
 			var _x = p.Sql_expr_list()
 
@@ -19246,7 +19340,7 @@ func (p *KuneiformParser) Window() (localctx IWindowContext) {
 		}
 
 	}
-	p.SetState(1131)
+	p.SetState(1144)
 	p.GetErrorHandler().Sync(p)
 	if p.HasError() {
 		goto errorExit
@@ -19255,7 +19349,7 @@ func (p *KuneiformParser) Window() (localctx IWindowContext) {
 
 	if _la == KuneiformParserORDER {
 		{
-			p.SetState(1121)
+			p.SetState(1134)
 			p.Match(KuneiformParserORDER)
 			if p.HasError() {
 				// Recognition error - abort rule
@@ -19263,7 +19357,7 @@ func (p *KuneiformParser) Window() (localctx IWindowContext) {
 			}
 		}
 		{
-			p.SetState(1122)
+			p.SetState(1135)
 			p.Match(KuneiformParserBY)
 			if p.HasError() {
 				// Recognition error - abort rule
@@ -19271,10 +19365,10 @@ func (p *KuneiformParser) Window() (localctx IWindowContext) {
 			}
 		}
 		{
-			p.SetState(1123)
+			p.SetState(1136)
 			p.Ordering_term()
 		}
-		p.SetState(1128)
+		p.SetState(1141)
 		p.GetErrorHandler().Sync(p)
 		if p.HasError() {
 			goto errorExit
@@ -19283,7 +19377,7 @@ func (p *KuneiformParser) Window() (localctx IWindowContext) {
 
 		for _la == KuneiformParserCOMMA {
 			{
-				p.SetState(1124)
+				p.SetState(1137)
 				p.Match(KuneiformParserCOMMA)
 				if p.HasError() {
 					// Recognition error - abort rule
@@ -19291,11 +19385,11 @@ func (p *KuneiformParser) Window() (localctx IWindowContext) {
 				}
 			}
 			{
-				p.SetState(1125)
+				p.SetState(1138)
 				p.Ordering_term()
 			}
 
-			p.SetState(1130)
+			p.SetState(1143)
 			p.GetErrorHandler().Sync(p)
 			if p.HasError() {
 				goto errorExit
@@ -19305,7 +19399,7 @@ func (p *KuneiformParser) Window() (localctx IWindowContext) {
 
 	}
 	{
-		p.SetState(1133)
+		p.SetState(1146)
 		p.Match(KuneiformParserRPAREN)
 		if p.HasError() {
 			// Recognition error - abort rule
@@ -19469,7 +19563,7 @@ func (p *KuneiformParser) When_then_clause() (localctx IWhen_then_clauseContext)
 	p.EnterRule(localctx, 108, KuneiformParserRULE_when_then_clause)
 	p.EnterOuterAlt(localctx, 1)
 	{
-		p.SetState(1135)
+		p.SetState(1148)
 		p.Match(KuneiformParserWHEN)
 		if p.HasError() {
 			// Recognition error - abort rule
@@ -19477,14 +19571,14 @@ func (p *KuneiformParser) When_then_clause() (localctx IWhen_then_clauseContext)
 		}
 	}
 	{
-		p.SetState(1136)
+		p.SetState(1149)
 
 		var _x = p.sql_expr(0)
 
 		localctx.(*When_then_clauseContext).when_condition = _x
 	}
 	{
-		p.SetState(1137)
+		p.SetState(1150)
 		p.Match(KuneiformParserTHEN)
 		if p.HasError() {
 			// Recognition error - abort rule
@@ -19492,7 +19586,7 @@ func (p *KuneiformParser) When_then_clause() (localctx IWhen_then_clauseContext)
 		}
 	}
 	{
-		p.SetState(1138)
+		p.SetState(1151)
 
 		var _x = p.sql_expr(0)
 
@@ -19635,10 +19729,10 @@ func (p *KuneiformParser) Sql_expr_list() (localctx ISql_expr_listContext) {
 
 	p.EnterOuterAlt(localctx, 1)
 	{
-		p.SetState(1140)
+		p.SetState(1153)
 		p.sql_expr(0)
 	}
-	p.SetState(1145)
+	p.SetState(1158)
 	p.GetErrorHandler().Sync(p)
 	if p.HasError() {
 		goto errorExit
@@ -19647,7 +19741,7 @@ func (p *KuneiformParser) Sql_expr_list() (localctx ISql_expr_listContext) {
 
 	for _la == KuneiformParserCOMMA {
 		{
-			p.SetState(1141)
+			p.SetState(1154)
 			p.Match(KuneiformParserCOMMA)
 			if p.HasError() {
 				// Recognition error - abort rule
@@ -19655,11 +19749,11 @@ func (p *KuneiformParser) Sql_expr_list() (localctx ISql_expr_listContext) {
 			}
 		}
 		{
-			p.SetState(1142)
+			p.SetState(1155)
 			p.sql_expr(0)
 		}
 
-		p.SetState(1147)
+		p.SetState(1160)
 		p.GetErrorHandler().Sync(p)
 		if p.HasError() {
 			goto errorExit
@@ -19818,25 +19912,25 @@ func (p *KuneiformParser) Sql_function_call() (localctx ISql_function_callContex
 	localctx = NewNormal_call_sqlContext(p, localctx)
 	p.EnterOuterAlt(localctx, 1)
 	{
-		p.SetState(1148)
+		p.SetState(1161)
 		p.Identifier()
 	}
 	{
-		p.SetState(1149)
+		p.SetState(1162)
 		p.Match(KuneiformParserLPAREN)
 		if p.HasError() {
 			// Recognition error - abort rule
 			goto errorExit
 		}
 	}
-	p.SetState(1155)
+	p.SetState(1168)
 	p.GetErrorHandler().Sync(p)
 	if p.HasError() {
 		goto errorExit
 	}
 	switch p.GetTokenStream().LA(1) {
 	case KuneiformParserLPAREN, KuneiformParserPLUS, KuneiformParserMINUS, KuneiformParserDOUBLE_QUOTE, KuneiformParserUSE, KuneiformParserUNUSE, KuneiformParserACTION, KuneiformParserCREATE, KuneiformParserALTER, KuneiformParserADD, KuneiformParserDROP, KuneiformParserRENAME, KuneiformParserCHECK, KuneiformParserFOREIGN, KuneiformParserPRIMARY, KuneiformParserKEY, KuneiformParserUNIQUE, KuneiformParserRESTRICT, KuneiformParserDEFAULT, KuneiformParserNULL, KuneiformParserNOT, KuneiformParserINDEX, KuneiformParserEXISTS, KuneiformParserRETURNS, KuneiformParserCASE, KuneiformParserDISTINCT, KuneiformParserFOR, KuneiformParserIF, KuneiformParserELSEIF, KuneiformParserELSE, KuneiformParserBREAK, KuneiformParserCONTINUE, KuneiformParserRETURN, KuneiformParserGRANT, KuneiformParserGRANTED, KuneiformParserREVOKE, KuneiformParserROLE, KuneiformParserREPLACE, KuneiformParserARRAY, KuneiformParserCURRENT, KuneiformParserNAMESPACE, KuneiformParserTRANSFER, KuneiformParserOWNERSHIP, KuneiformParserROLES, KuneiformParserCALL, KuneiformParserSTRING_, KuneiformParserTRUE, KuneiformParserFALSE, KuneiformParserDIGITS_, KuneiformParserBINARY_, KuneiformParserIDENTIFIER, KuneiformParserVARIABLE, KuneiformParserCONTEXTUAL_VARIABLE:
-		p.SetState(1151)
+		p.SetState(1164)
 		p.GetErrorHandler().Sync(p)
 		if p.HasError() {
 			goto errorExit
@@ -19845,7 +19939,7 @@ func (p *KuneiformParser) Sql_function_call() (localctx ISql_function_callContex
 
 		if _la == KuneiformParserDISTINCT {
 			{
-				p.SetState(1150)
+				p.SetState(1163)
 				p.Match(KuneiformParserDISTINCT)
 				if p.HasError() {
 					// Recognition error - abort rule
@@ -19855,13 +19949,13 @@ func (p *KuneiformParser) Sql_function_call() (localctx ISql_function_callContex
 
 		}
 		{
-			p.SetState(1153)
+			p.SetState(1166)
 			p.Sql_expr_list()
 		}
 
 	case KuneiformParserSTAR:
 		{
-			p.SetState(1154)
+			p.SetState(1167)
 			p.Match(KuneiformParserSTAR)
 			if p.HasError() {
 				// Recognition error - abort rule
@@ -19874,7 +19968,7 @@ func (p *KuneiformParser) Sql_function_call() (localctx ISql_function_callContex
 	default:
 	}
 	{
-		p.SetState(1157)
+		p.SetState(1170)
 		p.Match(KuneiformParserRPAREN)
 		if p.HasError() {
 			// Recognition error - abort rule
@@ -20926,7 +21020,7 @@ func (p *KuneiformParser) action_expr(_p int) (localctx IAction_exprContext) {
 	var _alt int
 
 	p.EnterOuterAlt(localctx, 1)
-	p.SetState(1193)
+	p.SetState(1206)
 	p.GetErrorHandler().Sync(p)
 	if p.HasError() {
 		goto errorExit
@@ -20939,7 +21033,7 @@ func (p *KuneiformParser) action_expr(_p int) (localctx IAction_exprContext) {
 		_prevctx = localctx
 
 		{
-			p.SetState(1160)
+			p.SetState(1173)
 			p.Match(KuneiformParserLPAREN)
 			if p.HasError() {
 				// Recognition error - abort rule
@@ -20947,23 +21041,23 @@ func (p *KuneiformParser) action_expr(_p int) (localctx IAction_exprContext) {
 			}
 		}
 		{
-			p.SetState(1161)
+			p.SetState(1174)
 			p.action_expr(0)
 		}
 		{
-			p.SetState(1162)
+			p.SetState(1175)
 			p.Match(KuneiformParserRPAREN)
 			if p.HasError() {
 				// Recognition error - abort rule
 				goto errorExit
 			}
 		}
-		p.SetState(1164)
+		p.SetState(1177)
 		p.GetErrorHandler().Sync(p)
 
 		if p.GetInterpreter().AdaptivePredict(p.BaseParser, p.GetTokenStream(), 160, p.GetParserRuleContext()) == 1 {
 			{
-				p.SetState(1163)
+				p.SetState(1176)
 				p.Type_cast()
 			}
 
@@ -20976,7 +21070,7 @@ func (p *KuneiformParser) action_expr(_p int) (localctx IAction_exprContext) {
 		p.SetParserRuleContext(localctx)
 		_prevctx = localctx
 		{
-			p.SetState(1166)
+			p.SetState(1179)
 			_la = p.GetTokenStream().LA(1)
 
 			if !((int64(_la) & ^0x3f) == 0 && ((int64(1)<<_la)&3147776) != 0) {
@@ -20987,7 +21081,7 @@ func (p *KuneiformParser) action_expr(_p int) (localctx IAction_exprContext) {
 			}
 		}
 		{
-			p.SetState(1167)
+			p.SetState(1180)
 			p.action_expr(14)
 		}
 
@@ -20996,15 +21090,15 @@ func (p *KuneiformParser) action_expr(_p int) (localctx IAction_exprContext) {
 		p.SetParserRuleContext(localctx)
 		_prevctx = localctx
 		{
-			p.SetState(1168)
+			p.SetState(1181)
 			p.Literal()
 		}
-		p.SetState(1170)
+		p.SetState(1183)
 		p.GetErrorHandler().Sync(p)
 
 		if p.GetInterpreter().AdaptivePredict(p.BaseParser, p.GetTokenStream(), 161, p.GetParserRuleContext()) == 1 {
 			{
-				p.SetState(1169)
+				p.SetState(1182)
 				p.Type_cast()
 			}
 
@@ -21017,15 +21111,15 @@ func (p *KuneiformParser) action_expr(_p int) (localctx IAction_exprContext) {
 		p.SetParserRuleContext(localctx)
 		_prevctx = localctx
 		{
-			p.SetState(1172)
+			p.SetState(1185)
 			p.Action_function_call()
 		}
-		p.SetState(1174)
+		p.SetState(1187)
 		p.GetErrorHandler().Sync(p)
 
 		if p.GetInterpreter().AdaptivePredict(p.BaseParser, p.GetTokenStream(), 162, p.GetParserRuleContext()) == 1 {
 			{
-				p.SetState(1173)
+				p.SetState(1186)
 				p.Type_cast()
 			}
 
@@ -21038,15 +21132,15 @@ func (p *KuneiformParser) action_expr(_p int) (localctx IAction_exprContext) {
 		p.SetParserRuleContext(localctx)
 		_prevctx = localctx
 		{
-			p.SetState(1176)
+			p.SetState(1189)
 			p.Variable()
 		}
-		p.SetState(1178)
+		p.SetState(1191)
 		p.GetErrorHandler().Sync(p)
 
 		if p.GetInterpreter().AdaptivePredict(p.BaseParser, p.GetTokenStream(), 163, p.GetParserRuleContext()) == 1 {
 			{
-				p.SetState(1177)
+				p.SetState(1190)
 				p.Type_cast()
 			}
 
@@ -21058,7 +21152,7 @@ func (p *KuneiformParser) action_expr(_p int) (localctx IAction_exprContext) {
 		localctx = NewMake_array_action_exprContext(p, localctx)
 		p.SetParserRuleContext(localctx)
 		_prevctx = localctx
-		p.SetState(1181)
+		p.SetState(1194)
 		p.GetErrorHandler().Sync(p)
 		if p.HasError() {
 			goto errorExit
@@ -21067,7 +21161,7 @@ func (p *KuneiformParser) action_expr(_p int) (localctx IAction_exprContext) {
 
 		if _la == KuneiformParserARRAY {
 			{
-				p.SetState(1180)
+				p.SetState(1193)
 				p.Match(KuneiformParserARRAY)
 				if p.HasError() {
 					// Recognition error - abort rule
@@ -21077,14 +21171,14 @@ func (p *KuneiformParser) action_expr(_p int) (localctx IAction_exprContext) {
 
 		}
 		{
-			p.SetState(1183)
+			p.SetState(1196)
 			p.Match(KuneiformParserLBRACKET)
 			if p.HasError() {
 				// Recognition error - abort rule
 				goto errorExit
 			}
 		}
-		p.SetState(1185)
+		p.SetState(1198)
 		p.GetErrorHandler().Sync(p)
 		if p.HasError() {
 			goto errorExit
@@ -21093,25 +21187,25 @@ func (p *KuneiformParser) action_expr(_p int) (localctx IAction_exprContext) {
 
 		if ((int64(_la) & ^0x3f) == 0 && ((int64(1)<<_la)&-4371923291645933432) != 0) || ((int64((_la-87)) & ^0x3f) == 0 && ((int64(1)<<(_la-87))&-2269814482811224063) != 0) {
 			{
-				p.SetState(1184)
+				p.SetState(1197)
 				p.Action_expr_list()
 			}
 
 		}
 		{
-			p.SetState(1187)
+			p.SetState(1200)
 			p.Match(KuneiformParserRBRACKET)
 			if p.HasError() {
 				// Recognition error - abort rule
 				goto errorExit
 			}
 		}
-		p.SetState(1189)
+		p.SetState(1202)
 		p.GetErrorHandler().Sync(p)
 
 		if p.GetInterpreter().AdaptivePredict(p.BaseParser, p.GetTokenStream(), 166, p.GetParserRuleContext()) == 1 {
 			{
-				p.SetState(1188)
+				p.SetState(1201)
 				p.Type_cast()
 			}
 
@@ -21125,7 +21219,7 @@ func (p *KuneiformParser) action_expr(_p int) (localctx IAction_exprContext) {
 		_prevctx = localctx
 
 		{
-			p.SetState(1191)
+			p.SetState(1204)
 			p.Match(KuneiformParserNOT)
 			if p.HasError() {
 				// Recognition error - abort rule
@@ -21134,7 +21228,7 @@ func (p *KuneiformParser) action_expr(_p int) (localctx IAction_exprContext) {
 		}
 
 		{
-			p.SetState(1192)
+			p.SetState(1205)
 			p.action_expr(3)
 		}
 
@@ -21142,7 +21236,7 @@ func (p *KuneiformParser) action_expr(_p int) (localctx IAction_exprContext) {
 		goto errorExit
 	}
 	p.GetParserRuleContext().SetStop(p.GetTokenStream().LT(-1))
-	p.SetState(1253)
+	p.SetState(1266)
 	p.GetErrorHandler().Sync(p)
 	if p.HasError() {
 		goto errorExit
@@ -21157,7 +21251,7 @@ func (p *KuneiformParser) action_expr(_p int) (localctx IAction_exprContext) {
 				p.TriggerExitRuleEvent()
 			}
 			_prevctx = localctx
-			p.SetState(1251)
+			p.SetState(1264)
 			p.GetErrorHandler().Sync(p)
 			if p.HasError() {
 				goto errorExit
@@ -21167,14 +21261,14 @@ func (p *KuneiformParser) action_expr(_p int) (localctx IAction_exprContext) {
 			case 1:
 				localctx = NewAction_expr_arithmeticContext(p, NewAction_exprContext(p, _parentctx, _parentState))
 				p.PushNewRecursionContext(localctx, _startState, KuneiformParserRULE_action_expr)
-				p.SetState(1195)
+				p.SetState(1208)
 
 				if !(p.Precpred(p.GetParserRuleContext(), 13)) {
 					p.SetError(antlr.NewFailedPredicateException(p, "p.Precpred(p.GetParserRuleContext(), 13)", ""))
 					goto errorExit
 				}
 				{
-					p.SetState(1196)
+					p.SetState(1209)
 					p.Match(KuneiformParserEXP)
 					if p.HasError() {
 						// Recognition error - abort rule
@@ -21182,21 +21276,21 @@ func (p *KuneiformParser) action_expr(_p int) (localctx IAction_exprContext) {
 					}
 				}
 				{
-					p.SetState(1197)
+					p.SetState(1210)
 					p.action_expr(14)
 				}
 
 			case 2:
 				localctx = NewAction_expr_arithmeticContext(p, NewAction_exprContext(p, _parentctx, _parentState))
 				p.PushNewRecursionContext(localctx, _startState, KuneiformParserRULE_action_expr)
-				p.SetState(1198)
+				p.SetState(1211)
 
 				if !(p.Precpred(p.GetParserRuleContext(), 12)) {
 					p.SetError(antlr.NewFailedPredicateException(p, "p.Precpred(p.GetParserRuleContext(), 12)", ""))
 					goto errorExit
 				}
 				{
-					p.SetState(1199)
+					p.SetState(1212)
 					_la = p.GetTokenStream().LA(1)
 
 					if !((int64(_la) & ^0x3f) == 0 && ((int64(1)<<_la)&4734976) != 0) {
@@ -21207,21 +21301,21 @@ func (p *KuneiformParser) action_expr(_p int) (localctx IAction_exprContext) {
 					}
 				}
 				{
-					p.SetState(1200)
+					p.SetState(1213)
 					p.action_expr(13)
 				}
 
 			case 3:
 				localctx = NewAction_expr_arithmeticContext(p, NewAction_exprContext(p, _parentctx, _parentState))
 				p.PushNewRecursionContext(localctx, _startState, KuneiformParserRULE_action_expr)
-				p.SetState(1201)
+				p.SetState(1214)
 
 				if !(p.Precpred(p.GetParserRuleContext(), 11)) {
 					p.SetError(antlr.NewFailedPredicateException(p, "p.Precpred(p.GetParserRuleContext(), 11)", ""))
 					goto errorExit
 				}
 				{
-					p.SetState(1202)
+					p.SetState(1215)
 					_la = p.GetTokenStream().LA(1)
 
 					if !(_la == KuneiformParserPLUS || _la == KuneiformParserMINUS) {
@@ -21232,21 +21326,21 @@ func (p *KuneiformParser) action_expr(_p int) (localctx IAction_exprContext) {
 					}
 				}
 				{
-					p.SetState(1203)
+					p.SetState(1216)
 					p.action_expr(12)
 				}
 
 			case 4:
 				localctx = NewAction_expr_arithmeticContext(p, NewAction_exprContext(p, _parentctx, _parentState))
 				p.PushNewRecursionContext(localctx, _startState, KuneiformParserRULE_action_expr)
-				p.SetState(1204)
+				p.SetState(1217)
 
 				if !(p.Precpred(p.GetParserRuleContext(), 6)) {
 					p.SetError(antlr.NewFailedPredicateException(p, "p.Precpred(p.GetParserRuleContext(), 6)", ""))
 					goto errorExit
 				}
 				{
-					p.SetState(1205)
+					p.SetState(1218)
 					p.Match(KuneiformParserCONCAT)
 					if p.HasError() {
 						// Recognition error - abort rule
@@ -21254,21 +21348,21 @@ func (p *KuneiformParser) action_expr(_p int) (localctx IAction_exprContext) {
 					}
 				}
 				{
-					p.SetState(1206)
+					p.SetState(1219)
 					p.action_expr(7)
 				}
 
 			case 5:
 				localctx = NewComparison_action_exprContext(p, NewAction_exprContext(p, _parentctx, _parentState))
 				p.PushNewRecursionContext(localctx, _startState, KuneiformParserRULE_action_expr)
-				p.SetState(1207)
+				p.SetState(1220)
 
 				if !(p.Precpred(p.GetParserRuleContext(), 5)) {
 					p.SetError(antlr.NewFailedPredicateException(p, "p.Precpred(p.GetParserRuleContext(), 5)", ""))
 					goto errorExit
 				}
 				{
-					p.SetState(1208)
+					p.SetState(1221)
 					_la = p.GetTokenStream().LA(1)
 
 					if !((int64(_la) & ^0x3f) == 0 && ((int64(1)<<_la)&520192000) != 0) {
@@ -21279,21 +21373,21 @@ func (p *KuneiformParser) action_expr(_p int) (localctx IAction_exprContext) {
 					}
 				}
 				{
-					p.SetState(1209)
+					p.SetState(1222)
 					p.action_expr(6)
 				}
 
 			case 6:
 				localctx = NewLogical_action_exprContext(p, NewAction_exprContext(p, _parentctx, _parentState))
 				p.PushNewRecursionContext(localctx, _startState, KuneiformParserRULE_action_expr)
-				p.SetState(1210)
+				p.SetState(1223)
 
 				if !(p.Precpred(p.GetParserRuleContext(), 2)) {
 					p.SetError(antlr.NewFailedPredicateException(p, "p.Precpred(p.GetParserRuleContext(), 2)", ""))
 					goto errorExit
 				}
 				{
-					p.SetState(1211)
+					p.SetState(1224)
 					p.Match(KuneiformParserAND)
 					if p.HasError() {
 						// Recognition error - abort rule
@@ -21301,21 +21395,21 @@ func (p *KuneiformParser) action_expr(_p int) (localctx IAction_exprContext) {
 					}
 				}
 				{
-					p.SetState(1212)
+					p.SetState(1225)
 					p.action_expr(3)
 				}
 
 			case 7:
 				localctx = NewLogical_action_exprContext(p, NewAction_exprContext(p, _parentctx, _parentState))
 				p.PushNewRecursionContext(localctx, _startState, KuneiformParserRULE_action_expr)
-				p.SetState(1213)
+				p.SetState(1226)
 
 				if !(p.Precpred(p.GetParserRuleContext(), 1)) {
 					p.SetError(antlr.NewFailedPredicateException(p, "p.Precpred(p.GetParserRuleContext(), 1)", ""))
 					goto errorExit
 				}
 				{
-					p.SetState(1214)
+					p.SetState(1227)
 					p.Match(KuneiformParserOR)
 					if p.HasError() {
 						// Recognition error - abort rule
@@ -21323,21 +21417,21 @@ func (p *KuneiformParser) action_expr(_p int) (localctx IAction_exprContext) {
 					}
 				}
 				{
-					p.SetState(1215)
+					p.SetState(1228)
 					p.action_expr(2)
 				}
 
 			case 8:
 				localctx = NewField_access_action_exprContext(p, NewAction_exprContext(p, _parentctx, _parentState))
 				p.PushNewRecursionContext(localctx, _startState, KuneiformParserRULE_action_expr)
-				p.SetState(1216)
+				p.SetState(1229)
 
 				if !(p.Precpred(p.GetParserRuleContext(), 16)) {
 					p.SetError(antlr.NewFailedPredicateException(p, "p.Precpred(p.GetParserRuleContext(), 16)", ""))
 					goto errorExit
 				}
 				{
-					p.SetState(1217)
+					p.SetState(1230)
 					p.Match(KuneiformParserPERIOD)
 					if p.HasError() {
 						// Recognition error - abort rule
@@ -21345,15 +21439,15 @@ func (p *KuneiformParser) action_expr(_p int) (localctx IAction_exprContext) {
 					}
 				}
 				{
-					p.SetState(1218)
+					p.SetState(1231)
 					p.Identifier()
 				}
-				p.SetState(1220)
+				p.SetState(1233)
 				p.GetErrorHandler().Sync(p)
 
 				if p.GetInterpreter().AdaptivePredict(p.BaseParser, p.GetTokenStream(), 168, p.GetParserRuleContext()) == 1 {
 					{
-						p.SetState(1219)
+						p.SetState(1232)
 						p.Type_cast()
 					}
 
@@ -21366,21 +21460,21 @@ func (p *KuneiformParser) action_expr(_p int) (localctx IAction_exprContext) {
 				localctx.(*Array_access_action_exprContext).array_element = _prevctx
 
 				p.PushNewRecursionContext(localctx, _startState, KuneiformParserRULE_action_expr)
-				p.SetState(1222)
+				p.SetState(1235)
 
 				if !(p.Precpred(p.GetParserRuleContext(), 15)) {
 					p.SetError(antlr.NewFailedPredicateException(p, "p.Precpred(p.GetParserRuleContext(), 15)", ""))
 					goto errorExit
 				}
 				{
-					p.SetState(1223)
+					p.SetState(1236)
 					p.Match(KuneiformParserLBRACKET)
 					if p.HasError() {
 						// Recognition error - abort rule
 						goto errorExit
 					}
 				}
-				p.SetState(1232)
+				p.SetState(1245)
 				p.GetErrorHandler().Sync(p)
 				if p.HasError() {
 					goto errorExit
@@ -21389,7 +21483,7 @@ func (p *KuneiformParser) action_expr(_p int) (localctx IAction_exprContext) {
 				switch p.GetInterpreter().AdaptivePredict(p.BaseParser, p.GetTokenStream(), 171, p.GetParserRuleContext()) {
 				case 1:
 					{
-						p.SetState(1224)
+						p.SetState(1237)
 
 						var _x = p.action_expr(0)
 
@@ -21397,7 +21491,7 @@ func (p *KuneiformParser) action_expr(_p int) (localctx IAction_exprContext) {
 					}
 
 				case 2:
-					p.SetState(1226)
+					p.SetState(1239)
 					p.GetErrorHandler().Sync(p)
 					if p.HasError() {
 						goto errorExit
@@ -21406,7 +21500,7 @@ func (p *KuneiformParser) action_expr(_p int) (localctx IAction_exprContext) {
 
 					if ((int64(_la) & ^0x3f) == 0 && ((int64(1)<<_la)&-4371923291645933432) != 0) || ((int64((_la-87)) & ^0x3f) == 0 && ((int64(1)<<(_la-87))&-2269814482811224063) != 0) {
 						{
-							p.SetState(1225)
+							p.SetState(1238)
 
 							var _x = p.action_expr(0)
 
@@ -21415,14 +21509,14 @@ func (p *KuneiformParser) action_expr(_p int) (localctx IAction_exprContext) {
 
 					}
 					{
-						p.SetState(1228)
+						p.SetState(1241)
 						p.Match(KuneiformParserCOL)
 						if p.HasError() {
 							// Recognition error - abort rule
 							goto errorExit
 						}
 					}
-					p.SetState(1230)
+					p.SetState(1243)
 					p.GetErrorHandler().Sync(p)
 					if p.HasError() {
 						goto errorExit
@@ -21431,7 +21525,7 @@ func (p *KuneiformParser) action_expr(_p int) (localctx IAction_exprContext) {
 
 					if ((int64(_la) & ^0x3f) == 0 && ((int64(1)<<_la)&-4371923291645933432) != 0) || ((int64((_la-87)) & ^0x3f) == 0 && ((int64(1)<<(_la-87))&-2269814482811224063) != 0) {
 						{
-							p.SetState(1229)
+							p.SetState(1242)
 
 							var _x = p.action_expr(0)
 
@@ -21444,19 +21538,19 @@ func (p *KuneiformParser) action_expr(_p int) (localctx IAction_exprContext) {
 					goto errorExit
 				}
 				{
-					p.SetState(1234)
+					p.SetState(1247)
 					p.Match(KuneiformParserRBRACKET)
 					if p.HasError() {
 						// Recognition error - abort rule
 						goto errorExit
 					}
 				}
-				p.SetState(1236)
+				p.SetState(1249)
 				p.GetErrorHandler().Sync(p)
 
 				if p.GetInterpreter().AdaptivePredict(p.BaseParser, p.GetTokenStream(), 172, p.GetParserRuleContext()) == 1 {
 					{
-						p.SetState(1235)
+						p.SetState(1248)
 						p.Type_cast()
 					}
 
@@ -21469,21 +21563,21 @@ func (p *KuneiformParser) action_expr(_p int) (localctx IAction_exprContext) {
 				localctx.(*Is_action_exprContext).left = _prevctx
 
 				p.PushNewRecursionContext(localctx, _startState, KuneiformParserRULE_action_expr)
-				p.SetState(1238)
+				p.SetState(1251)
 
 				if !(p.Precpred(p.GetParserRuleContext(), 4)) {
 					p.SetError(antlr.NewFailedPredicateException(p, "p.Precpred(p.GetParserRuleContext(), 4)", ""))
 					goto errorExit
 				}
 				{
-					p.SetState(1239)
+					p.SetState(1252)
 					p.Match(KuneiformParserIS)
 					if p.HasError() {
 						// Recognition error - abort rule
 						goto errorExit
 					}
 				}
-				p.SetState(1241)
+				p.SetState(1254)
 				p.GetErrorHandler().Sync(p)
 				if p.HasError() {
 					goto errorExit
@@ -21492,7 +21586,7 @@ func (p *KuneiformParser) action_expr(_p int) (localctx IAction_exprContext) {
 
 				if _la == KuneiformParserNOT {
 					{
-						p.SetState(1240)
+						p.SetState(1253)
 						p.Match(KuneiformParserNOT)
 						if p.HasError() {
 							// Recognition error - abort rule
@@ -21501,7 +21595,7 @@ func (p *KuneiformParser) action_expr(_p int) (localctx IAction_exprContext) {
 					}
 
 				}
-				p.SetState(1249)
+				p.SetState(1262)
 				p.GetErrorHandler().Sync(p)
 				if p.HasError() {
 					goto errorExit
@@ -21510,7 +21604,7 @@ func (p *KuneiformParser) action_expr(_p int) (localctx IAction_exprContext) {
 				switch p.GetTokenStream().LA(1) {
 				case KuneiformParserDISTINCT:
 					{
-						p.SetState(1243)
+						p.SetState(1256)
 						p.Match(KuneiformParserDISTINCT)
 						if p.HasError() {
 							// Recognition error - abort rule
@@ -21518,7 +21612,7 @@ func (p *KuneiformParser) action_expr(_p int) (localctx IAction_exprContext) {
 						}
 					}
 					{
-						p.SetState(1244)
+						p.SetState(1257)
 						p.Match(KuneiformParserFROM)
 						if p.HasError() {
 							// Recognition error - abort rule
@@ -21526,7 +21620,7 @@ func (p *KuneiformParser) action_expr(_p int) (localctx IAction_exprContext) {
 						}
 					}
 					{
-						p.SetState(1245)
+						p.SetState(1258)
 
 						var _x = p.action_expr(0)
 
@@ -21535,7 +21629,7 @@ func (p *KuneiformParser) action_expr(_p int) (localctx IAction_exprContext) {
 
 				case KuneiformParserNULL:
 					{
-						p.SetState(1246)
+						p.SetState(1259)
 						p.Match(KuneiformParserNULL)
 						if p.HasError() {
 							// Recognition error - abort rule
@@ -21545,7 +21639,7 @@ func (p *KuneiformParser) action_expr(_p int) (localctx IAction_exprContext) {
 
 				case KuneiformParserTRUE:
 					{
-						p.SetState(1247)
+						p.SetState(1260)
 						p.Match(KuneiformParserTRUE)
 						if p.HasError() {
 							// Recognition error - abort rule
@@ -21555,7 +21649,7 @@ func (p *KuneiformParser) action_expr(_p int) (localctx IAction_exprContext) {
 
 				case KuneiformParserFALSE:
 					{
-						p.SetState(1248)
+						p.SetState(1261)
 						p.Match(KuneiformParserFALSE)
 						if p.HasError() {
 							// Recognition error - abort rule
@@ -21573,7 +21667,7 @@ func (p *KuneiformParser) action_expr(_p int) (localctx IAction_exprContext) {
 			}
 
 		}
-		p.SetState(1255)
+		p.SetState(1268)
 		p.GetErrorHandler().Sync(p)
 		if p.HasError() {
 			goto errorExit
@@ -21720,10 +21814,10 @@ func (p *KuneiformParser) Action_expr_list() (localctx IAction_expr_listContext)
 
 	p.EnterOuterAlt(localctx, 1)
 	{
-		p.SetState(1256)
+		p.SetState(1269)
 		p.action_expr(0)
 	}
-	p.SetState(1261)
+	p.SetState(1274)
 	p.GetErrorHandler().Sync(p)
 	if p.HasError() {
 		goto errorExit
@@ -21732,7 +21826,7 @@ func (p *KuneiformParser) Action_expr_list() (localctx IAction_expr_listContext)
 
 	for _la == KuneiformParserCOMMA {
 		{
-			p.SetState(1257)
+			p.SetState(1270)
 			p.Match(KuneiformParserCOMMA)
 			if p.HasError() {
 				// Recognition error - abort rule
@@ -21740,11 +21834,11 @@ func (p *KuneiformParser) Action_expr_list() (localctx IAction_expr_listContext)
 			}
 		}
 		{
-			p.SetState(1258)
+			p.SetState(1271)
 			p.action_expr(0)
 		}
 
-		p.SetState(1263)
+		p.SetState(1276)
 		p.GetErrorHandler().Sync(p)
 		if p.HasError() {
 			goto errorExit
@@ -22588,7 +22682,7 @@ func (p *KuneiformParser) Action_statement() (localctx IAction_statementContext)
 
 	var _alt int
 
-	p.SetState(1356)
+	p.SetState(1369)
 	p.GetErrorHandler().Sync(p)
 	if p.HasError() {
 		goto errorExit
@@ -22599,7 +22693,7 @@ func (p *KuneiformParser) Action_statement() (localctx IAction_statementContext)
 		localctx = NewStmt_variable_declarationContext(p, localctx)
 		p.EnterOuterAlt(localctx, 1)
 		{
-			p.SetState(1264)
+			p.SetState(1277)
 			p.Match(KuneiformParserVARIABLE)
 			if p.HasError() {
 				// Recognition error - abort rule
@@ -22607,11 +22701,11 @@ func (p *KuneiformParser) Action_statement() (localctx IAction_statementContext)
 			}
 		}
 		{
-			p.SetState(1265)
+			p.SetState(1278)
 			p.Type_()
 		}
 		{
-			p.SetState(1266)
+			p.SetState(1279)
 			p.Match(KuneiformParserSCOL)
 			if p.HasError() {
 				// Recognition error - abort rule
@@ -22622,7 +22716,7 @@ func (p *KuneiformParser) Action_statement() (localctx IAction_statementContext)
 	case 2:
 		localctx = NewStmt_action_callContext(p, localctx)
 		p.EnterOuterAlt(localctx, 2)
-		p.SetState(1278)
+		p.SetState(1291)
 		p.GetErrorHandler().Sync(p)
 		if p.HasError() {
 			goto errorExit
@@ -22631,11 +22725,11 @@ func (p *KuneiformParser) Action_statement() (localctx IAction_statementContext)
 
 		if _la == KuneiformParserUNDERSCORE || _la == KuneiformParserVARIABLE {
 			{
-				p.SetState(1268)
+				p.SetState(1281)
 				p.Variable_or_underscore()
 			}
 
-			p.SetState(1273)
+			p.SetState(1286)
 			p.GetErrorHandler().Sync(p)
 			if p.HasError() {
 				goto errorExit
@@ -22644,7 +22738,7 @@ func (p *KuneiformParser) Action_statement() (localctx IAction_statementContext)
 
 			for _la == KuneiformParserCOMMA {
 				{
-					p.SetState(1269)
+					p.SetState(1282)
 					p.Match(KuneiformParserCOMMA)
 					if p.HasError() {
 						// Recognition error - abort rule
@@ -22653,11 +22747,11 @@ func (p *KuneiformParser) Action_statement() (localctx IAction_statementContext)
 				}
 
 				{
-					p.SetState(1270)
+					p.SetState(1283)
 					p.Variable_or_underscore()
 				}
 
-				p.SetState(1275)
+				p.SetState(1288)
 				p.GetErrorHandler().Sync(p)
 				if p.HasError() {
 					goto errorExit
@@ -22665,7 +22759,7 @@ func (p *KuneiformParser) Action_statement() (localctx IAction_statementContext)
 				_la = p.GetTokenStream().LA(1)
 			}
 			{
-				p.SetState(1276)
+				p.SetState(1289)
 				_la = p.GetTokenStream().LA(1)
 
 				if !(_la == KuneiformParserEQUALS || _la == KuneiformParserASSIGN) {
@@ -22678,11 +22772,11 @@ func (p *KuneiformParser) Action_statement() (localctx IAction_statementContext)
 
 		}
 		{
-			p.SetState(1280)
+			p.SetState(1293)
 			p.Action_function_call()
 		}
 		{
-			p.SetState(1281)
+			p.SetState(1294)
 			p.Match(KuneiformParserSCOL)
 			if p.HasError() {
 				// Recognition error - abort rule
@@ -22694,10 +22788,10 @@ func (p *KuneiformParser) Action_statement() (localctx IAction_statementContext)
 		localctx = NewStmt_variable_assignmentContext(p, localctx)
 		p.EnterOuterAlt(localctx, 3)
 		{
-			p.SetState(1283)
+			p.SetState(1296)
 			p.action_expr(0)
 		}
-		p.SetState(1285)
+		p.SetState(1298)
 		p.GetErrorHandler().Sync(p)
 		if p.HasError() {
 			goto errorExit
@@ -22706,13 +22800,13 @@ func (p *KuneiformParser) Action_statement() (localctx IAction_statementContext)
 
 		if ((int64(_la) & ^0x3f) == 0 && ((int64(1)<<_la)&-9127724498152325120) != 0) || ((int64((_la-87)) & ^0x3f) == 0 && ((int64(1)<<(_la-87))&2306959842411020289) != 0) {
 			{
-				p.SetState(1284)
+				p.SetState(1297)
 				p.Type_()
 			}
 
 		}
 		{
-			p.SetState(1287)
+			p.SetState(1300)
 			_la = p.GetTokenStream().LA(1)
 
 			if !(_la == KuneiformParserEQUALS || _la == KuneiformParserASSIGN) {
@@ -22723,11 +22817,11 @@ func (p *KuneiformParser) Action_statement() (localctx IAction_statementContext)
 			}
 		}
 		{
-			p.SetState(1288)
+			p.SetState(1301)
 			p.action_expr(0)
 		}
 		{
-			p.SetState(1289)
+			p.SetState(1302)
 			p.Match(KuneiformParserSCOL)
 			if p.HasError() {
 				// Recognition error - abort rule
@@ -22739,7 +22833,7 @@ func (p *KuneiformParser) Action_statement() (localctx IAction_statementContext)
 		localctx = NewStmt_for_loopContext(p, localctx)
 		p.EnterOuterAlt(localctx, 4)
 		{
-			p.SetState(1291)
+			p.SetState(1304)
 			p.Match(KuneiformParserFOR)
 			if p.HasError() {
 				// Recognition error - abort rule
@@ -22747,7 +22841,7 @@ func (p *KuneiformParser) Action_statement() (localctx IAction_statementContext)
 			}
 		}
 		{
-			p.SetState(1292)
+			p.SetState(1305)
 
 			var _m = p.Match(KuneiformParserVARIABLE)
 
@@ -22758,14 +22852,14 @@ func (p *KuneiformParser) Action_statement() (localctx IAction_statementContext)
 			}
 		}
 		{
-			p.SetState(1293)
+			p.SetState(1306)
 			p.Match(KuneiformParserIN)
 			if p.HasError() {
 				// Recognition error - abort rule
 				goto errorExit
 			}
 		}
-		p.SetState(1300)
+		p.SetState(1313)
 		p.GetErrorHandler().Sync(p)
 		if p.HasError() {
 			goto errorExit
@@ -22774,23 +22868,23 @@ func (p *KuneiformParser) Action_statement() (localctx IAction_statementContext)
 		switch p.GetInterpreter().AdaptivePredict(p.BaseParser, p.GetTokenStream(), 182, p.GetParserRuleContext()) {
 		case 1:
 			{
-				p.SetState(1294)
+				p.SetState(1307)
 				p.Range_()
 			}
 
 		case 2:
 			{
-				p.SetState(1295)
+				p.SetState(1308)
 				p.Sql_statement()
 			}
 
 		case 3:
-			p.SetState(1297)
+			p.SetState(1310)
 			p.GetErrorHandler().Sync(p)
 
 			if p.GetInterpreter().AdaptivePredict(p.BaseParser, p.GetTokenStream(), 181, p.GetParserRuleContext()) == 1 {
 				{
-					p.SetState(1296)
+					p.SetState(1309)
 					p.Match(KuneiformParserARRAY)
 					if p.HasError() {
 						// Recognition error - abort rule
@@ -22802,7 +22896,7 @@ func (p *KuneiformParser) Action_statement() (localctx IAction_statementContext)
 				goto errorExit
 			}
 			{
-				p.SetState(1299)
+				p.SetState(1312)
 				p.action_expr(0)
 			}
 
@@ -22810,14 +22904,14 @@ func (p *KuneiformParser) Action_statement() (localctx IAction_statementContext)
 			goto errorExit
 		}
 		{
-			p.SetState(1302)
+			p.SetState(1315)
 			p.Match(KuneiformParserLBRACE)
 			if p.HasError() {
 				// Recognition error - abort rule
 				goto errorExit
 			}
 		}
-		p.SetState(1306)
+		p.SetState(1319)
 		p.GetErrorHandler().Sync(p)
 		if p.HasError() {
 			goto errorExit
@@ -22826,11 +22920,11 @@ func (p *KuneiformParser) Action_statement() (localctx IAction_statementContext)
 
 		for ((int64(_la) & ^0x3f) == 0 && ((int64(1)<<_la)&-3507232162117056376) != 0) || ((int64((_la-87)) & ^0x3f) == 0 && ((int64(1)<<(_la-87))&-2269814482811217915) != 0) {
 			{
-				p.SetState(1303)
+				p.SetState(1316)
 				p.Action_statement()
 			}
 
-			p.SetState(1308)
+			p.SetState(1321)
 			p.GetErrorHandler().Sync(p)
 			if p.HasError() {
 				goto errorExit
@@ -22838,14 +22932,14 @@ func (p *KuneiformParser) Action_statement() (localctx IAction_statementContext)
 			_la = p.GetTokenStream().LA(1)
 		}
 		{
-			p.SetState(1309)
+			p.SetState(1322)
 			p.Match(KuneiformParserRBRACE)
 			if p.HasError() {
 				// Recognition error - abort rule
 				goto errorExit
 			}
 		}
-		p.SetState(1311)
+		p.SetState(1324)
 		p.GetErrorHandler().Sync(p)
 		if p.HasError() {
 			goto errorExit
@@ -22854,7 +22948,7 @@ func (p *KuneiformParser) Action_statement() (localctx IAction_statementContext)
 
 		if _la == KuneiformParserSCOL {
 			{
-				p.SetState(1310)
+				p.SetState(1323)
 				p.Match(KuneiformParserSCOL)
 				if p.HasError() {
 					// Recognition error - abort rule
@@ -22868,7 +22962,7 @@ func (p *KuneiformParser) Action_statement() (localctx IAction_statementContext)
 		localctx = NewStmt_ifContext(p, localctx)
 		p.EnterOuterAlt(localctx, 5)
 		{
-			p.SetState(1313)
+			p.SetState(1326)
 			p.Match(KuneiformParserIF)
 			if p.HasError() {
 				// Recognition error - abort rule
@@ -22876,10 +22970,10 @@ func (p *KuneiformParser) Action_statement() (localctx IAction_statementContext)
 			}
 		}
 		{
-			p.SetState(1314)
+			p.SetState(1327)
 			p.If_then_block()
 		}
-		p.SetState(1323)
+		p.SetState(1336)
 		p.GetErrorHandler().Sync(p)
 		if p.HasError() {
 			goto errorExit
@@ -22890,7 +22984,7 @@ func (p *KuneiformParser) Action_statement() (localctx IAction_statementContext)
 		}
 		for _alt != 2 && _alt != antlr.ATNInvalidAltNumber {
 			if _alt == 1 {
-				p.SetState(1318)
+				p.SetState(1331)
 				p.GetErrorHandler().Sync(p)
 				if p.HasError() {
 					goto errorExit
@@ -22899,7 +22993,7 @@ func (p *KuneiformParser) Action_statement() (localctx IAction_statementContext)
 				switch p.GetTokenStream().LA(1) {
 				case KuneiformParserELSEIF:
 					{
-						p.SetState(1315)
+						p.SetState(1328)
 						p.Match(KuneiformParserELSEIF)
 						if p.HasError() {
 							// Recognition error - abort rule
@@ -22909,7 +23003,7 @@ func (p *KuneiformParser) Action_statement() (localctx IAction_statementContext)
 
 				case KuneiformParserELSE:
 					{
-						p.SetState(1316)
+						p.SetState(1329)
 						p.Match(KuneiformParserELSE)
 						if p.HasError() {
 							// Recognition error - abort rule
@@ -22917,7 +23011,7 @@ func (p *KuneiformParser) Action_statement() (localctx IAction_statementContext)
 						}
 					}
 					{
-						p.SetState(1317)
+						p.SetState(1330)
 						p.Match(KuneiformParserIF)
 						if p.HasError() {
 							// Recognition error - abort rule
@@ -22930,12 +23024,12 @@ func (p *KuneiformParser) Action_statement() (localctx IAction_statementContext)
 					goto errorExit
 				}
 				{
-					p.SetState(1320)
+					p.SetState(1333)
 					p.If_then_block()
 				}
 
 			}
-			p.SetState(1325)
+			p.SetState(1338)
 			p.GetErrorHandler().Sync(p)
 			if p.HasError() {
 				goto errorExit
@@ -22945,12 +23039,12 @@ func (p *KuneiformParser) Action_statement() (localctx IAction_statementContext)
 				goto errorExit
 			}
 		}
-		p.SetState(1335)
+		p.SetState(1348)
 		p.GetErrorHandler().Sync(p)
 
 		if p.GetInterpreter().AdaptivePredict(p.BaseParser, p.GetTokenStream(), 188, p.GetParserRuleContext()) == 1 {
 			{
-				p.SetState(1326)
+				p.SetState(1339)
 				p.Match(KuneiformParserELSE)
 				if p.HasError() {
 					// Recognition error - abort rule
@@ -22958,14 +23052,14 @@ func (p *KuneiformParser) Action_statement() (localctx IAction_statementContext)
 				}
 			}
 			{
-				p.SetState(1327)
+				p.SetState(1340)
 				p.Match(KuneiformParserLBRACE)
 				if p.HasError() {
 					// Recognition error - abort rule
 					goto errorExit
 				}
 			}
-			p.SetState(1331)
+			p.SetState(1344)
 			p.GetErrorHandler().Sync(p)
 			if p.HasError() {
 				goto errorExit
@@ -22974,11 +23068,11 @@ func (p *KuneiformParser) Action_statement() (localctx IAction_statementContext)
 
 			for ((int64(_la) & ^0x3f) == 0 && ((int64(1)<<_la)&-3507232162117056376) != 0) || ((int64((_la-87)) & ^0x3f) == 0 && ((int64(1)<<(_la-87))&-2269814482811217915) != 0) {
 				{
-					p.SetState(1328)
+					p.SetState(1341)
 					p.Action_statement()
 				}
 
-				p.SetState(1333)
+				p.SetState(1346)
 				p.GetErrorHandler().Sync(p)
 				if p.HasError() {
 					goto errorExit
@@ -22986,7 +23080,7 @@ func (p *KuneiformParser) Action_statement() (localctx IAction_statementContext)
 				_la = p.GetTokenStream().LA(1)
 			}
 			{
-				p.SetState(1334)
+				p.SetState(1347)
 				p.Match(KuneiformParserRBRACE)
 				if p.HasError() {
 					// Recognition error - abort rule
@@ -22997,7 +23091,7 @@ func (p *KuneiformParser) Action_statement() (localctx IAction_statementContext)
 		} else if p.HasError() { // JIM
 			goto errorExit
 		}
-		p.SetState(1338)
+		p.SetState(1351)
 		p.GetErrorHandler().Sync(p)
 		if p.HasError() {
 			goto errorExit
@@ -23006,7 +23100,7 @@ func (p *KuneiformParser) Action_statement() (localctx IAction_statementContext)
 
 		if _la == KuneiformParserSCOL {
 			{
-				p.SetState(1337)
+				p.SetState(1350)
 				p.Match(KuneiformParserSCOL)
 				if p.HasError() {
 					// Recognition error - abort rule
@@ -23020,11 +23114,11 @@ func (p *KuneiformParser) Action_statement() (localctx IAction_statementContext)
 		localctx = NewStmt_sqlContext(p, localctx)
 		p.EnterOuterAlt(localctx, 6)
 		{
-			p.SetState(1340)
+			p.SetState(1353)
 			p.Sql_statement()
 		}
 		{
-			p.SetState(1341)
+			p.SetState(1354)
 			p.Match(KuneiformParserSCOL)
 			if p.HasError() {
 				// Recognition error - abort rule
@@ -23036,7 +23130,7 @@ func (p *KuneiformParser) Action_statement() (localctx IAction_statementContext)
 		localctx = NewStmt_loop_controlContext(p, localctx)
 		p.EnterOuterAlt(localctx, 7)
 		{
-			p.SetState(1343)
+			p.SetState(1356)
 			_la = p.GetTokenStream().LA(1)
 
 			if !(_la == KuneiformParserBREAK || _la == KuneiformParserCONTINUE) {
@@ -23047,7 +23141,7 @@ func (p *KuneiformParser) Action_statement() (localctx IAction_statementContext)
 			}
 		}
 		{
-			p.SetState(1344)
+			p.SetState(1357)
 			p.Match(KuneiformParserSCOL)
 			if p.HasError() {
 				// Recognition error - abort rule
@@ -23059,14 +23153,14 @@ func (p *KuneiformParser) Action_statement() (localctx IAction_statementContext)
 		localctx = NewStmt_returnContext(p, localctx)
 		p.EnterOuterAlt(localctx, 8)
 		{
-			p.SetState(1345)
+			p.SetState(1358)
 			p.Match(KuneiformParserRETURN)
 			if p.HasError() {
 				// Recognition error - abort rule
 				goto errorExit
 			}
 		}
-		p.SetState(1348)
+		p.SetState(1361)
 		p.GetErrorHandler().Sync(p)
 		if p.HasError() {
 			goto errorExit
@@ -23074,13 +23168,13 @@ func (p *KuneiformParser) Action_statement() (localctx IAction_statementContext)
 		switch p.GetTokenStream().LA(1) {
 		case KuneiformParserLBRACKET, KuneiformParserLPAREN, KuneiformParserEXCL, KuneiformParserPLUS, KuneiformParserMINUS, KuneiformParserDOUBLE_QUOTE, KuneiformParserUSE, KuneiformParserUNUSE, KuneiformParserACTION, KuneiformParserCREATE, KuneiformParserALTER, KuneiformParserADD, KuneiformParserDROP, KuneiformParserRENAME, KuneiformParserCHECK, KuneiformParserFOREIGN, KuneiformParserPRIMARY, KuneiformParserKEY, KuneiformParserUNIQUE, KuneiformParserRESTRICT, KuneiformParserDEFAULT, KuneiformParserNULL, KuneiformParserNOT, KuneiformParserINDEX, KuneiformParserRETURNS, KuneiformParserFOR, KuneiformParserIF, KuneiformParserELSEIF, KuneiformParserELSE, KuneiformParserBREAK, KuneiformParserCONTINUE, KuneiformParserRETURN, KuneiformParserGRANT, KuneiformParserGRANTED, KuneiformParserREVOKE, KuneiformParserROLE, KuneiformParserREPLACE, KuneiformParserARRAY, KuneiformParserCURRENT, KuneiformParserNAMESPACE, KuneiformParserTRANSFER, KuneiformParserOWNERSHIP, KuneiformParserROLES, KuneiformParserCALL, KuneiformParserSTRING_, KuneiformParserTRUE, KuneiformParserFALSE, KuneiformParserDIGITS_, KuneiformParserBINARY_, KuneiformParserIDENTIFIER, KuneiformParserVARIABLE, KuneiformParserCONTEXTUAL_VARIABLE:
 			{
-				p.SetState(1346)
+				p.SetState(1359)
 				p.Action_expr_list()
 			}
 
 		case KuneiformParserDELETE, KuneiformParserUPDATE, KuneiformParserWITH, KuneiformParserSELECT, KuneiformParserINSERT:
 			{
-				p.SetState(1347)
+				p.SetState(1360)
 				p.Sql_statement()
 			}
 
@@ -23089,7 +23183,7 @@ func (p *KuneiformParser) Action_statement() (localctx IAction_statementContext)
 		default:
 		}
 		{
-			p.SetState(1350)
+			p.SetState(1363)
 			p.Match(KuneiformParserSCOL)
 			if p.HasError() {
 				// Recognition error - abort rule
@@ -23101,7 +23195,7 @@ func (p *KuneiformParser) Action_statement() (localctx IAction_statementContext)
 		localctx = NewStmt_return_nextContext(p, localctx)
 		p.EnterOuterAlt(localctx, 9)
 		{
-			p.SetState(1351)
+			p.SetState(1364)
 			p.Match(KuneiformParserRETURN)
 			if p.HasError() {
 				// Recognition error - abort rule
@@ -23109,7 +23203,7 @@ func (p *KuneiformParser) Action_statement() (localctx IAction_statementContext)
 			}
 		}
 		{
-			p.SetState(1352)
+			p.SetState(1365)
 			p.Match(KuneiformParserNEXT)
 			if p.HasError() {
 				// Recognition error - abort rule
@@ -23117,11 +23211,11 @@ func (p *KuneiformParser) Action_statement() (localctx IAction_statementContext)
 			}
 		}
 		{
-			p.SetState(1353)
+			p.SetState(1366)
 			p.Action_expr_list()
 		}
 		{
-			p.SetState(1354)
+			p.SetState(1367)
 			p.Match(KuneiformParserSCOL)
 			if p.HasError() {
 				// Recognition error - abort rule
@@ -23226,7 +23320,7 @@ func (p *KuneiformParser) Variable_or_underscore() (localctx IVariable_or_unders
 
 	p.EnterOuterAlt(localctx, 1)
 	{
-		p.SetState(1358)
+		p.SetState(1371)
 		_la = p.GetTokenStream().LA(1)
 
 		if !(_la == KuneiformParserUNDERSCORE || _la == KuneiformParserVARIABLE) {
@@ -23385,12 +23479,37 @@ func (s *Normal_call_actionContext) PERIOD() antlr.TerminalNode {
 	return s.GetToken(KuneiformParserPERIOD, 0)
 }
 
-func (s *Normal_call_actionContext) Action_expr_list() IAction_expr_listContext {
+func (s *Normal_call_actionContext) AllAction_argument() []IAction_argumentContext {
+	children := s.GetChildren()
+	len := 0
+	for _, ctx := range children {
+		if _, ok := ctx.(IAction_argumentContext); ok {
+			len++
+		}
+	}
+
+	tst := make([]IAction_argumentContext, len)
+	i := 0
+	for _, ctx := range children {
+		if t, ok := ctx.(IAction_argumentContext); ok {
+			tst[i] = t.(IAction_argumentContext)
+			i++
+		}
+	}
+
+	return tst
+}
+
+func (s *Normal_call_actionContext) Action_argument(i int) IAction_argumentContext {
 	var t antlr.RuleContext
+	j := 0
 	for _, ctx := range s.GetChildren() {
-		if _, ok := ctx.(IAction_expr_listContext); ok {
-			t = ctx.(antlr.RuleContext)
-			break
+		if _, ok := ctx.(IAction_argumentContext); ok {
+			if j == i {
+				t = ctx.(antlr.RuleContext)
+				break
+			}
+			j++
 		}
 	}
 
@@ -23398,7 +23517,15 @@ func (s *Normal_call_actionContext) Action_expr_list() IAction_expr_listContext
 		return nil
 	}
 
-	return t.(IAction_expr_listContext)
+	return t.(IAction_argumentContext)
+}
+
+func (s *Normal_call_actionContext) AllCOMMA() []antlr.TerminalNode {
+	return s.GetTokens(KuneiformParserCOMMA)
+}
+
+func (s *Normal_call_actionContext) COMMA(i int) antlr.TerminalNode {
+	return s.GetToken(KuneiformParserCOMMA, i)
 }
 
 func (s *Normal_call_actionContext) Accept(visitor antlr.ParseTreeVisitor) interface{} {
@@ -23418,19 +23545,19 @@ func (p *KuneiformParser) Action_function_call() (localctx IAction_function_call
 
 	localctx = NewNormal_call_actionContext(p, localctx)
 	p.EnterOuterAlt(localctx, 1)
-	p.SetState(1363)
+	p.SetState(1376)
 	p.GetErrorHandler().Sync(p)
 
 	if p.GetInterpreter().AdaptivePredict(p.BaseParser, p.GetTokenStream(), 192, p.GetParserRuleContext()) == 1 {
 		{
-			p.SetState(1360)
+			p.SetState(1373)
 
 			var _x = p.Identifier()
 
 			localctx.(*Normal_call_actionContext).namespace = _x
 		}
 		{
-			p.SetState(1361)
+			p.SetState(1374)
 			p.Match(KuneiformParserPERIOD)
 			if p.HasError() {
 				// Recognition error - abort rule
@@ -23442,36 +23569,62 @@ func (p *KuneiformParser) Action_function_call() (localctx IAction_function_call
 		goto errorExit
 	}
 	{
-		p.SetState(1365)
+		p.SetState(1378)
 
 		var _x = p.Identifier()
 
 		localctx.(*Normal_call_actionContext).function = _x
 	}
 	{
-		p.SetState(1366)
+		p.SetState(1379)
 		p.Match(KuneiformParserLPAREN)
 		if p.HasError() {
 			// Recognition error - abort rule
 			goto errorExit
 		}
 	}
-	p.SetState(1368)
+	p.SetState(1388)
 	p.GetErrorHandler().Sync(p)
-	if p.HasError() {
-		goto errorExit
-	}
-	_la = p.GetTokenStream().LA(1)
 
-	if ((int64(_la) & ^0x3f) == 0 && ((int64(1)<<_la)&-4371923291645933432) != 0) || ((int64((_la-87)) & ^0x3f) == 0 && ((int64(1)<<(_la-87))&-2269814482811224063) != 0) {
+	if p.GetInterpreter().AdaptivePredict(p.BaseParser, p.GetTokenStream(), 194, p.GetParserRuleContext()) == 1 {
 		{
-			p.SetState(1367)
-			p.Action_expr_list()
+			p.SetState(1380)
+			p.Action_argument()
+		}
+		p.SetState(1385)
+		p.GetErrorHandler().Sync(p)
+		if p.HasError() {
+			goto errorExit
+		}
+		_la = p.GetTokenStream().LA(1)
+
+		for _la == KuneiformParserCOMMA {
+			{
+				p.SetState(1381)
+				p.Match(KuneiformParserCOMMA)
+				if p.HasError() {
+					// Recognition error - abort rule
+					goto errorExit
+				}
+			}
+			{
+				p.SetState(1382)
+				p.Action_argument()
+			}
+
+			p.SetState(1387)
+			p.GetErrorHandler().Sync(p)
+			if p.HasError() {
+				goto errorExit
+			}
+			_la = p.GetTokenStream().LA(1)
 		}
 
+	} else if p.HasError() { // JIM
+		goto errorExit
 	}
 	{
-		p.SetState(1370)
+		p.SetState(1390)
 		p.Match(KuneiformParserRPAREN)
 		if p.HasError() {
 			// Recognition error - abort rule
@@ -23632,18 +23785,18 @@ func (p *KuneiformParser) If_then_block() (localctx IIf_then_blockContext) {
 
 	p.EnterOuterAlt(localctx, 1)
 	{
-		p.SetState(1372)
+		p.SetState(1392)
 		p.action_expr(0)
 	}
 	{
-		p.SetState(1373)
+		p.SetState(1393)
 		p.Match(KuneiformParserLBRACE)
 		if p.HasError() {
 			// Recognition error - abort rule
 			goto errorExit
 		}
 	}
-	p.SetState(1377)
+	p.SetState(1397)
 	p.GetErrorHandler().Sync(p)
 	if p.HasError() {
 		goto errorExit
@@ -23652,11 +23805,11 @@ func (p *KuneiformParser) If_then_block() (localctx IIf_then_blockContext) {
 
 	for ((int64(_la) & ^0x3f) == 0 && ((int64(1)<<_la)&-3507232162117056376) != 0) || ((int64((_la-87)) & ^0x3f) == 0 && ((int64(1)<<(_la-87))&-2269814482811217915) != 0) {
 		{
-			p.SetState(1374)
+			p.SetState(1394)
 			p.Action_statement()
 		}
 
-		p.SetState(1379)
+		p.SetState(1399)
 		p.GetErrorHandler().Sync(p)
 		if p.HasError() {
 			goto errorExit
@@ -23664,7 +23817,7 @@ func (p *KuneiformParser) If_then_block() (localctx IIf_then_blockContext) {
 		_la = p.GetTokenStream().LA(1)
 	}
 	{
-		p.SetState(1380)
+		p.SetState(1400)
 		p.Match(KuneiformParserRBRACE)
 		if p.HasError() {
 			// Recognition error - abort rule
@@ -23801,11 +23954,11 @@ func (p *KuneiformParser) Range_() (localctx IRangeContext) {
 	p.EnterRule(localctx, 126, KuneiformParserRULE_range)
 	p.EnterOuterAlt(localctx, 1)
 	{
-		p.SetState(1382)
+		p.SetState(1402)
 		p.action_expr(0)
 	}
 	{
-		p.SetState(1383)
+		p.SetState(1403)
 		p.Match(KuneiformParserRANGE)
 		if p.HasError() {
 			// Recognition error - abort rule
@@ -23813,7 +23966,7 @@ func (p *KuneiformParser) Range_() (localctx IRangeContext) {
 		}
 	}
 	{
-		p.SetState(1384)
+		p.SetState(1404)
 		p.action_expr(0)
 	}
 
@@ -23830,6 +23983,1258 @@ errorExit:
 	goto errorExit // Trick to prevent compiler error if the label is not used
 }
 
+// IAction_parameterContext is an interface to support dynamic dispatch.
+type IAction_parameterContext interface {
+	antlr.ParserRuleContext
+
+	// GetParser returns the parser.
+	GetParser() antlr.Parser
+
+	// Getter signatures
+	VARIABLE() antlr.TerminalNode
+	Type_() ITypeContext
+	DEFAULT() antlr.TerminalNode
+	Action_expr() IAction_exprContext
+
+	// IsAction_parameterContext differentiates from other interfaces.
+	IsAction_parameterContext()
+}
+
+type Action_parameterContext struct {
+	antlr.BaseParserRuleContext
+	parser antlr.Parser
+}
+
+func NewEmptyAction_parameterContext() *Action_parameterContext {
+	var p = new(Action_parameterContext)
+	antlr.InitBaseParserRuleContext(&p.BaseParserRuleContext, nil, -1)
+	p.RuleIndex = KuneiformParserRULE_action_parameter
+	return p
+}
+
+func InitEmptyAction_parameterContext(p *Action_parameterContext) {
+	antlr.InitBaseParserRuleContext(&p.BaseParserRuleContext, nil, -1)
+	p.RuleIndex = KuneiformParserRULE_action_parameter
+}
+
+func (*Action_parameterContext) IsAction_parameterContext() {}
+
+func NewAction_parameterContext(parser antlr.Parser, parent antlr.ParserRuleContext, invokingState int) *Action_parameterContext {
+	var p = new(Action_parameterContext)
+
+	antlr.InitBaseParserRuleContext(&p.BaseParserRuleContext, parent, invokingState)
+
+	p.parser = parser
+	p.RuleIndex = KuneiformParserRULE_action_parameter
+
+	return p
+}
+
+func (s *Action_parameterContext) GetParser() antlr.Parser { return s.parser }
+
+func (s *Action_parameterContext) VARIABLE() antlr.TerminalNode {
+	return s.GetToken(KuneiformParserVARIABLE, 0)
+}
+
+func (s *Action_parameterContext) Type_() ITypeContext {
+	var t antlr.RuleContext
+	for _, ctx := range s.GetChildren() {
+		if _, ok := ctx.(ITypeContext); ok {
+			t = ctx.(antlr.RuleContext)
+			break
+		}
+	}
+
+	if t == nil {
+		return nil
+	}
+
+	return t.(ITypeContext)
+}
+
+func (s *Action_parameterContext) DEFAULT() antlr.TerminalNode {
+	return s.GetToken(KuneiformParserDEFAULT, 0)
+}
+
+func (s *Action_parameterContext) Action_expr() IAction_exprContext {
+	var t antlr.RuleContext
+	for _, ctx := range s.GetChildren() {
+		if _, ok := ctx.(IAction_exprContext); ok {
+			t = ctx.(antlr.RuleContext)
+			break
+		}
+	}
+
+	if t == nil {
+		return nil
+	}
+
+	return t.(IAction_exprContext)
+}
+
+func (s *Action_parameterContext) GetRuleContext() antlr.RuleContext {
+	return s
+}
+
+func (s *Action_parameterContext) ToStringTree(ruleNames []string, recog antlr.Recognizer) string {
+	return antlr.TreesStringTree(s, ruleNames, recog)
+}
+
+func (s *Action_parameterContext) Accept(visitor antlr.ParseTreeVisitor) interface{} {
+	switch t := visitor.(type) {
+	case KuneiformParserVisitor:
+		return t.VisitAction_parameter(s)
+
+	default:
+		return t.VisitChildren(s)
+	}
+}
+
+func (p *KuneiformParser) Action_parameter() (localctx IAction_parameterContext) {
+	localctx = NewAction_parameterContext(p, p.GetParserRuleContext(), p.GetState())
+	p.EnterRule(localctx, 128, KuneiformParserRULE_action_parameter)
+	var _la int
+
+	p.EnterOuterAlt(localctx, 1)
+	{
+		p.SetState(1406)
+		p.Match(KuneiformParserVARIABLE)
+		if p.HasError() {
+			// Recognition error - abort rule
+			goto errorExit
+		}
+	}
+	{
+		p.SetState(1407)
+		p.Type_()
+	}
+	p.SetState(1410)
+	p.GetErrorHandler().Sync(p)
+	if p.HasError() {
+		goto errorExit
+	}
+	_la = p.GetTokenStream().LA(1)
+
+	if _la == KuneiformParserDEFAULT {
+		{
+			p.SetState(1408)
+			p.Match(KuneiformParserDEFAULT)
+			if p.HasError() {
+				// Recognition error - abort rule
+				goto errorExit
+			}
+		}
+		{
+			p.SetState(1409)
+			p.action_expr(0)
+		}
+
+	}
+
+errorExit:
+	if p.HasError() {
+		v := p.GetError()
+		localctx.SetException(v)
+		p.GetErrorHandler().ReportError(p, v)
+		p.GetErrorHandler().Recover(p, v)
+		p.SetError(nil)
+	}
+	p.ExitRule()
+	return localctx
+	goto errorExit // Trick to prevent compiler error if the label is not used
+}
+
+// IAction_argumentContext is an interface to support dynamic dispatch.
+type IAction_argumentContext interface {
+	antlr.ParserRuleContext
+
+	// GetParser returns the parser.
+	GetParser() antlr.Parser
+
+	// GetName returns the name token.
+	GetName() antlr.Token
+
+	// SetName sets the name token.
+	SetName(antlr.Token)
+
+	// Getter signatures
+	Action_expr() IAction_exprContext
+	EQUALS() antlr.TerminalNode
+	GT() antlr.TerminalNode
+
+	// IsAction_argumentContext differentiates from other interfaces.
+	IsAction_argumentContext()
+}
+
+type Action_argumentContext struct {
+	antlr.BaseParserRuleContext
+	parser antlr.Parser
+	name   antlr.Token
+}
+
+func NewEmptyAction_argumentContext() *Action_argumentContext {
+	var p = new(Action_argumentContext)
+	antlr.InitBaseParserRuleContext(&p.BaseParserRuleContext, nil, -1)
+	p.RuleIndex = KuneiformParserRULE_action_argument
+	return p
+}
+
+func InitEmptyAction_argumentContext(p *Action_argumentContext) {
+	antlr.InitBaseParserRuleContext(&p.BaseParserRuleContext, nil, -1)
+	p.RuleIndex = KuneiformParserRULE_action_argument
+}
+
+func (*Action_argumentContext) IsAction_argumentContext() {}
+
+func NewAction_argumentContext(parser antlr.Parser, parent antlr.ParserRuleContext, invokingState int) *Action_argumentContext {
+	var p = new(Action_argumentContext)
+
+	antlr.InitBaseParserRuleContext(&p.BaseParserRuleContext, parent, invokingState)
+
+	p.parser = parser
+	p.RuleIndex = KuneiformParserRULE_action_argument
+
+	return p
+}
+
+func (s *Action_argumentContext) GetParser() antlr.Parser { return s.parser }
+
+func (s *Action_argumentContext) GetName() antlr.Token { return s.name }
+
+func (s *Action_argumentContext) SetName(v antlr.Token) { s.name = v }
+
+func (s *Action_argumentContext) Action_expr() IAction_exprContext {
+	var t antlr.RuleContext
+	for _, ctx := range s.GetChildren() {
+		if _, ok := ctx.(IAction_exprContext); ok {
+			t = ctx.(antlr.RuleContext)
+			break
+		}
+	}
+
+	if t == nil {
+		return nil
+	}
+
+	return t.(IAction_exprContext)
+}
+
+func (s *Action_argumentContext) EQUALS() antlr.TerminalNode {
+	return s.GetToken(KuneiformParserEQUALS, 0)
+}
+
+func (s *Action_argumentContext) GT() antlr.TerminalNode {
+	return s.GetToken(KuneiformParserGT, 0)
+}
+
+func (s *Action_argumentContext) GetRuleContext() antlr.RuleContext {
+	return s
+}
+
+func (s *Action_argumentContext) ToStringTree(ruleNames []string, recog antlr.Recognizer) string {
+	return antlr.TreesStringTree(s, ruleNames, recog)
+}
+
+func (s *Action_argumentContext) Accept(visitor antlr.ParseTreeVisitor) interface{} {
+	switch t := visitor.(type) {
+	case KuneiformParserVisitor:
+		return t.VisitAction_argument(s)
+
+	default:
+		return t.VisitChildren(s)
+	}
+}
+
+func (p *KuneiformParser) Action_argument() (localctx IAction_argumentContext) {
+	localctx = NewAction_argumentContext(p, p.GetParserRuleContext(), p.GetState())
+	p.EnterRule(localctx, 130, KuneiformParserRULE_action_argument)
+	p.EnterOuterAlt(localctx, 1)
+	p.SetState(1416)
+	p.GetErrorHandler().Sync(p)
+
+	if p.GetInterpreter().AdaptivePredict(p.BaseParser, p.GetTokenStream(), 197, p.GetParserRuleContext()) == 1 {
+		{
+			p.SetState(1412)
+
+			var _mwc = p.MatchWildcard()
+
+			localctx.(*Action_argumentContext).name = _mwc
+			if p.HasError() {
+				// Recognition error - abort rule
+				goto errorExit
+			}
+		}
+		{
+			p.SetState(1413)
+			p.Match(KuneiformParserEQUALS)
+			if p.HasError() {
+				// Recognition error - abort rule
+				goto errorExit
+			}
+		}
+		{
+			p.SetState(1414)
+			p.Match(KuneiformParserGT)
+			if p.HasError() {
+				// Recognition error - abort rule
+				goto errorExit
+			}
+		}
+
+	} else if p.HasError() { // JIM
+		goto errorExit
+	}
+	{
+		p.SetState(1418)
+		p.action_expr(0)
+	}
+
+errorExit:
+	if p.HasError() {
+		v := p.GetError()
+		localctx.SetException(v)
+		p.GetErrorHandler().ReportError(p, v)
+		p.GetErrorHandler().Recover(p, v)
+		p.SetError(nil)
+	}
+	p.ExitRule()
+	return localctx
+	goto errorExit // Trick to prevent compiler error if the label is not used
+}
+
+// ICreate_type_statementContext is an interface to support dynamic dispatch.
+type ICreate_type_statementContext interface {
+	antlr.ParserRuleContext
+
+	// GetParser returns the parser.
+	GetParser() antlr.Parser
+
+	// Getter signatures
+	CREATE() antlr.TerminalNode
+	AllIDENTIFIER() []antlr.TerminalNode
+	IDENTIFIER(i int) antlr.TerminalNode
+	Identifier() IIdentifierContext
+	AS() antlr.TerminalNode
+	IF() antlr.TerminalNode
+	NOT() antlr.TerminalNode
+	EXISTS() antlr.TerminalNode
+	AllLPAREN() []antlr.TerminalNode
+	LPAREN(i int) antlr.TerminalNode
+	AllType_field() []IType_fieldContext
+	Type_field(i int) IType_fieldContext
+	AllRPAREN() []antlr.TerminalNode
+	RPAREN(i int) antlr.TerminalNode
+	AllSTRING_() []antlr.TerminalNode
+	STRING_(i int) antlr.TerminalNode
+	AllCOMMA() []antlr.TerminalNode
+	COMMA(i int) antlr.TerminalNode
+
+	// IsCreate_type_statementContext differentiates from other interfaces.
+	IsCreate_type_statementContext()
+}
+
+type Create_type_statementContext struct {
+	antlr.BaseParserRuleContext
+	parser antlr.Parser
+}
+
+func NewEmptyCreate_type_statementContext() *Create_type_statementContext {
+	var p = new(Create_type_statementContext)
+	antlr.InitBaseParserRuleContext(&p.BaseParserRuleContext, nil, -1)
+	p.RuleIndex = KuneiformParserRULE_create_type_statement
+	return p
+}
+
+func InitEmptyCreate_type_statementContext(p *Create_type_statementContext) {
+	antlr.InitBaseParserRuleContext(&p.BaseParserRuleContext, nil, -1)
+	p.RuleIndex = KuneiformParserRULE_create_type_statement
+}
+
+func (*Create_type_statementContext) IsCreate_type_statementContext() {}
+
+func NewCreate_type_statementContext(parser antlr.Parser, parent antlr.ParserRuleContext, invokingState int) *Create_type_statementContext {
+	var p = new(Create_type_statementContext)
+
+	antlr.InitBaseParserRuleContext(&p.BaseParserRuleContext, parent, invokingState)
+
+	p.parser = parser
+	p.RuleIndex = KuneiformParserRULE_create_type_statement
+
+	return p
+}
+
+func (s *Create_type_statementContext) GetParser() antlr.Parser { return s.parser }
+
+func (s *Create_type_statementContext) CREATE() antlr.TerminalNode {
+	return s.GetToken(KuneiformParserCREATE, 0)
+}
+
+func (s *Create_type_statementContext) AllIDENTIFIER() []antlr.TerminalNode {
+	return s.GetTokens(KuneiformParserIDENTIFIER)
+}
+
+func (s *Create_type_statementContext) IDENTIFIER(i int) antlr.TerminalNode {
+	return s.GetToken(KuneiformParserIDENTIFIER, i)
+}
+
+func (s *Create_type_statementContext) Identifier() IIdentifierContext {
+	var t antlr.RuleContext
+	for _, ctx := range s.GetChildren() {
+		if _, ok := ctx.(IIdentifierContext); ok {
+			t = ctx.(antlr.RuleContext)
+			break
+		}
+	}
+
+	if t == nil {
+		return nil
+	}
+
+	return t.(IIdentifierContext)
+}
+
+func (s *Create_type_statementContext) AS() antlr.TerminalNode {
+	return s.GetToken(KuneiformParserAS, 0)
+}
+
+func (s *Create_type_statementContext) IF() antlr.TerminalNode {
+	return s.GetToken(KuneiformParserIF, 0)
+}
+
+func (s *Create_type_statementContext) NOT() antlr.TerminalNode {
+	return s.GetToken(KuneiformParserNOT, 0)
+}
+
+func (s *Create_type_statementContext) EXISTS() antlr.TerminalNode {
+	return s.GetToken(KuneiformParserEXISTS, 0)
+}
+
+func (s *Create_type_statementContext) AllLPAREN() []antlr.TerminalNode {
+	return s.GetTokens(KuneiformParserLPAREN)
+}
+
+func (s *Create_type_statementContext) LPAREN(i int) antlr.TerminalNode {
+	return s.GetToken(KuneiformParserLPAREN, i)
+}
+
+func (s *Create_type_statementContext) AllType_field() []IType_fieldContext {
+	children := s.GetChildren()
+	len := 0
+	for _, ctx := range children {
+		if _, ok := ctx.(IType_fieldContext); ok {
+			len++
+		}
+	}
+
+	tst := make([]IType_fieldContext, len)
+	i := 0
+	for _, ctx := range children {
+		if t, ok := ctx.(IType_fieldContext); ok {
+			tst[i] = t.(IType_fieldContext)
+			i++
+		}
+	}
+
+	return tst
+}
+
+func (s *Create_type_statementContext) Type_field(i int) IType_fieldContext {
+	var t antlr.RuleContext
+	j := 0
+	for _, ctx := range s.GetChildren() {
+		if _, ok := ctx.(IType_fieldContext); ok {
+			if j == i {
+				t = ctx.(antlr.RuleContext)
+				break
+			}
+			j++
+		}
+	}
+
+	if t == nil {
+		return nil
+	}
+
+	return t.(IType_fieldContext)
+}
+
+func (s *Create_type_statementContext) AllRPAREN() []antlr.TerminalNode {
+	return s.GetTokens(KuneiformParserRPAREN)
+}
+
+func (s *Create_type_statementContext) RPAREN(i int) antlr.TerminalNode {
+	return s.GetToken(KuneiformParserRPAREN, i)
+}
+
+func (s *Create_type_statementContext) AllSTRING_() []antlr.TerminalNode {
+	return s.GetTokens(KuneiformParserSTRING_)
+}
+
+func (s *Create_type_statementContext) STRING_(i int) antlr.TerminalNode {
+	return s.GetToken(KuneiformParserSTRING_, i)
+}
+
+func (s *Create_type_statementContext) AllCOMMA() []antlr.TerminalNode {
+	return s.GetTokens(KuneiformParserCOMMA)
+}
+
+func (s *Create_type_statementContext) COMMA(i int) antlr.TerminalNode {
+	return s.GetToken(KuneiformParserCOMMA, i)
+}
+
+func (s *Create_type_statementContext) GetRuleContext() antlr.RuleContext {
+	return s
+}
+
+func (s *Create_type_statementContext) ToStringTree(ruleNames []string, recog antlr.Recognizer) string {
+	return antlr.TreesStringTree(s, ruleNames, recog)
+}
+
+func (s *Create_type_statementContext) Accept(visitor antlr.ParseTreeVisitor) interface{} {
+	switch t := visitor.(type) {
+	case KuneiformParserVisitor:
+		return t.VisitCreate_type_statement(s)
+
+	default:
+		return t.VisitChildren(s)
+	}
+}
+
+func (p *KuneiformParser) Create_type_statement() (localctx ICreate_type_statementContext) {
+	localctx = NewCreate_type_statementContext(p, p.GetParserRuleContext(), p.GetState())
+	p.EnterRule(localctx, 132, KuneiformParserRULE_create_type_statement)
+	var _la int
+
+	p.EnterOuterAlt(localctx, 1)
+	{
+		p.SetState(1420)
+		p.Match(KuneiformParserCREATE)
+		if p.HasError() {
+			// Recognition error - abort rule
+			goto errorExit
+		}
+	}
+	{
+		p.SetState(1421)
+		p.Match(KuneiformParserIDENTIFIER)
+		if p.HasError() {
+			// Recognition error - abort rule
+			goto errorExit
+		}
+	}
+	p.SetState(1425)
+	p.GetErrorHandler().Sync(p)
+
+	if p.GetInterpreter().AdaptivePredict(p.BaseParser, p.GetTokenStream(), 198, p.GetParserRuleContext()) == 1 {
+		{
+			p.SetState(1422)
+			p.Match(KuneiformParserIF)
+			if p.HasError() {
+				// Recognition error - abort rule
+				goto errorExit
+			}
+		}
+		{
+			p.SetState(1423)
+			p.Match(KuneiformParserNOT)
+			if p.HasError() {
+				// Recognition error - abort rule
+				goto errorExit
+			}
+		}
+		{
+			p.SetState(1424)
+			p.Match(KuneiformParserEXISTS)
+			if p.HasError() {
+				// Recognition error - abort rule
+				goto errorExit
+			}
+		}
+
+	} else if p.HasError() { // JIM
+		goto errorExit
+	}
+	{
+		p.SetState(1427)
+		p.Identifier()
+	}
+	{
+		p.SetState(1428)
+		p.Match(KuneiformParserAS)
+		if p.HasError() {
+			// Recognition error - abort rule
+			goto errorExit
+		}
+	}
+	p.SetState(1450)
+	p.GetErrorHandler().Sync(p)
+	if p.HasError() {
+		goto errorExit
+	}
+
+	switch p.GetTokenStream().LA(1) {
+	case KuneiformParserLPAREN:
+		{
+			p.SetState(1429)
+			p.Match(KuneiformParserLPAREN)
+			if p.HasError() {
+				// Recognition error - abort rule
+				goto errorExit
+			}
+		}
+		{
+			p.SetState(1430)
+			p.Type_field()
+		}
+		p.SetState(1435)
+		p.GetErrorHandler().Sync(p)
+		if p.HasError() {
+			goto errorExit
+		}
+		_la = p.GetTokenStream().LA(1)
+
+		for _la == KuneiformParserCOMMA {
+			{
+				p.SetState(1431)
+				p.Match(KuneiformParserCOMMA)
+				if p.HasError() {
+					// Recognition error - abort rule
+					goto errorExit
+				}
+			}
+			{
+				p.SetState(1432)
+				p.Type_field()
+			}
+
+			p.SetState(1437)
+			p.GetErrorHandler().Sync(p)
+			if p.HasError() {
+				goto errorExit
+			}
+			_la = p.GetTokenStream().LA(1)
+		}
+		{
+			p.SetState(1438)
+			p.Match(KuneiformParserRPAREN)
+			if p.HasError() {
+				// Recognition error - abort rule
+				goto errorExit
+			}
+		}
+
+	case KuneiformParserIDENTIFIER:
+		{
+			p.SetState(1439)
+			p.Match(KuneiformParserIDENTIFIER)
+			if p.HasError() {
+				// Recognition error - abort rule
+				goto errorExit
+			}
+		}
+		{
+			p.SetState(1440)
+			p.Match(KuneiformParserLPAREN)
+			if p.HasError() {
+				// Recognition error - abort rule
+				goto errorExit
+			}
+		}
+		{
+			p.SetState(1441)
+			p.Match(KuneiformParserSTRING_)
+			if p.HasError() {
+				// Recognition error - abort rule
+				goto errorExit
+			}
+		}
+		p.SetState(1446)
+		p.GetErrorHandler().Sync(p)
+		if p.HasError() {
+			goto errorExit
+		}
+		_la = p.GetTokenStream().LA(1)
+
+		for _la == KuneiformParserCOMMA {
+			{
+				p.SetState(1442)
+				p.Match(KuneiformParserCOMMA)
+				if p.HasError() {
+					// Recognition error - abort rule
+					goto errorExit
+				}
+			}
+			{
+				p.SetState(1443)
+				p.Match(KuneiformParserSTRING_)
+				if p.HasError() {
+					// Recognition error - abort rule
+					goto errorExit
+				}
+			}
+
+			p.SetState(1448)
+			p.GetErrorHandler().Sync(p)
+			if p.HasError() {
+				goto errorExit
+			}
+			_la = p.GetTokenStream().LA(1)
+		}
+		{
+			p.SetState(1449)
+			p.Match(KuneiformParserRPAREN)
+			if p.HasError() {
+				// Recognition error - abort rule
+				goto errorExit
+			}
+		}
+
+	default:
+		p.SetError(antlr.NewNoViableAltException(p, nil, nil, nil, nil, nil))
+		goto errorExit
+	}
+
+errorExit:
+	if p.HasError() {
+		v := p.GetError()
+		localctx.SetException(v)
+		p.GetErrorHandler().ReportError(p, v)
+		p.GetErrorHandler().Recover(p, v)
+		p.SetError(nil)
+	}
+	p.ExitRule()
+	return localctx
+	goto errorExit // Trick to prevent compiler error if the label is not used
+}
+
+// IDrop_object_statementContext is an interface to support dynamic dispatch.
+type IDrop_object_statementContext interface {
+	antlr.ParserRuleContext
+
+	// GetParser returns the parser.
+	GetParser() antlr.Parser
+
+	// Getter signatures
+	DROP() antlr.TerminalNode
+	IDENTIFIER() antlr.TerminalNode
+	Identifier() IIdentifierContext
+	IF() antlr.TerminalNode
+	EXISTS() antlr.TerminalNode
+
+	// IsDrop_object_statementContext differentiates from other interfaces.
+	IsDrop_object_statementContext()
+}
+
+type Drop_object_statementContext struct {
+	antlr.BaseParserRuleContext
+	parser antlr.Parser
+}
+
+func NewEmptyDrop_object_statementContext() *Drop_object_statementContext {
+	var p = new(Drop_object_statementContext)
+	antlr.InitBaseParserRuleContext(&p.BaseParserRuleContext, nil, -1)
+	p.RuleIndex = KuneiformParserRULE_drop_object_statement
+	return p
+}
+
+func InitEmptyDrop_object_statementContext(p *Drop_object_statementContext) {
+	antlr.InitBaseParserRuleContext(&p.BaseParserRuleContext, nil, -1)
+	p.RuleIndex = KuneiformParserRULE_drop_object_statement
+}
+
+func (*Drop_object_statementContext) IsDrop_object_statementContext() {}
+
+func NewDrop_object_statementContext(parser antlr.Parser, parent antlr.ParserRuleContext, invokingState int) *Drop_object_statementContext {
+	var p = new(Drop_object_statementContext)
+
+	antlr.InitBaseParserRuleContext(&p.BaseParserRuleContext, parent, invokingState)
+
+	p.parser = parser
+	p.RuleIndex = KuneiformParserRULE_drop_object_statement
+
+	return p
+}
+
+func (s *Drop_object_statementContext) GetParser() antlr.Parser { return s.parser }
+
+func (s *Drop_object_statementContext) DROP() antlr.TerminalNode {
+	return s.GetToken(KuneiformParserDROP, 0)
+}
+
+func (s *Drop_object_statementContext) IDENTIFIER() antlr.TerminalNode {
+	return s.GetToken(KuneiformParserIDENTIFIER, 0)
+}
+
+func (s *Drop_object_statementContext) Identifier() IIdentifierContext {
+	var t antlr.RuleContext
+	for _, ctx := range s.GetChildren() {
+		if _, ok := ctx.(IIdentifierContext); ok {
+			t = ctx.(antlr.RuleContext)
+			break
+		}
+	}
+
+	if t == nil {
+		return nil
+	}
+
+	return t.(IIdentifierContext)
+}
+
+func (s *Drop_object_statementContext) IF() antlr.TerminalNode {
+	return s.GetToken(KuneiformParserIF, 0)
+}
+
+func (s *Drop_object_statementContext) EXISTS() antlr.TerminalNode {
+	return s.GetToken(KuneiformParserEXISTS, 0)
+}
+
+func (s *Drop_object_statementContext) GetRuleContext() antlr.RuleContext {
+	return s
+}
+
+func (s *Drop_object_statementContext) ToStringTree(ruleNames []string, recog antlr.Recognizer) string {
+	return antlr.TreesStringTree(s, ruleNames, recog)
+}
+
+func (s *Drop_object_statementContext) Accept(visitor antlr.ParseTreeVisitor) interface{} {
+	switch t := visitor.(type) {
+	case KuneiformParserVisitor:
+		return t.VisitDrop_object_statement(s)
+
+	default:
+		return t.VisitChildren(s)
+	}
+}
+
+func (p *KuneiformParser) Drop_object_statement() (localctx IDrop_object_statementContext) {
+	localctx = NewDrop_object_statementContext(p, p.GetParserRuleContext(), p.GetState())
+	p.EnterRule(localctx, 134, KuneiformParserRULE_drop_object_statement)
+	p.EnterOuterAlt(localctx, 1)
+	{
+		p.SetState(1452)
+		p.Match(KuneiformParserDROP)
+		if p.HasError() {
+			// Recognition error - abort rule
+			goto errorExit
+		}
+	}
+	{
+		p.SetState(1453)
+		p.Match(KuneiformParserIDENTIFIER)
+		if p.HasError() {
+			// Recognition error - abort rule
+			goto errorExit
+		}
+	}
+	p.SetState(1456)
+	p.GetErrorHandler().Sync(p)
+
+	if p.GetInterpreter().AdaptivePredict(p.BaseParser, p.GetTokenStream(), 202, p.GetParserRuleContext()) == 1 {
+		{
+			p.SetState(1454)
+			p.Match(KuneiformParserIF)
+			if p.HasError() {
+				// Recognition error - abort rule
+				goto errorExit
+			}
+		}
+		{
+			p.SetState(1455)
+			p.Match(KuneiformParserEXISTS)
+			if p.HasError() {
+				// Recognition error - abort rule
+				goto errorExit
+			}
+		}
+
+	} else if p.HasError() { // JIM
+		goto errorExit
+	}
+	{
+		p.SetState(1458)
+		p.Identifier()
+	}
+
+errorExit:
+	if p.HasError() {
+		v := p.GetError()
+		localctx.SetException(v)
+		p.GetErrorHandler().ReportError(p, v)
+		p.GetErrorHandler().Recover(p, v)
+		p.SetError(nil)
+	}
+	p.ExitRule()
+	return localctx
+	goto errorExit // Trick to prevent compiler error if the label is not used
+}
+
+// IType_fieldContext is an interface to support dynamic dispatch.
+type IType_fieldContext interface {
+	antlr.ParserRuleContext
+
+	// GetParser returns the parser.
+	GetParser() antlr.Parser
+
+	// Getter signatures
+	Identifier() IIdentifierContext
+	Type_() ITypeContext
+
+	// IsType_fieldContext differentiates from other interfaces.
+	IsType_fieldContext()
+}
+
+type Type_fieldContext struct {
+	antlr.BaseParserRuleContext
+	parser antlr.Parser
+}
+
+func NewEmptyType_fieldContext() *Type_fieldContext {
+	var p = new(Type_fieldContext)
+	antlr.InitBaseParserRuleContext(&p.BaseParserRuleContext, nil, -1)
+	p.RuleIndex = KuneiformParserRULE_type_field
+	return p
+}
+
+func InitEmptyType_fieldContext(p *Type_fieldContext) {
+	antlr.InitBaseParserRuleContext(&p.BaseParserRuleContext, nil, -1)
+	p.RuleIndex = KuneiformParserRULE_type_field
+}
+
+func (*Type_fieldContext) IsType_fieldContext() {}
+
+func NewType_fieldContext(parser antlr.Parser, parent antlr.ParserRuleContext, invokingState int) *Type_fieldContext {
+	var p = new(Type_fieldContext)
+
+	antlr.InitBaseParserRuleContext(&p.BaseParserRuleContext, parent, invokingState)
+
+	p.parser = parser
+	p.RuleIndex = KuneiformParserRULE_type_field
+
+	return p
+}
+
+func (s *Type_fieldContext) GetParser() antlr.Parser { return s.parser }
+
+func (s *Type_fieldContext) Identifier() IIdentifierContext {
+	var t antlr.RuleContext
+	for _, ctx := range s.GetChildren() {
+		if _, ok := ctx.(IIdentifierContext); ok {
+			t = ctx.(antlr.RuleContext)
+			break
+		}
+	}
+
+	if t == nil {
+		return nil
+	}
+
+	return t.(IIdentifierContext)
+}
+
+func (s *Type_fieldContext) Type_() ITypeContext {
+	var t antlr.RuleContext
+	for _, ctx := range s.GetChildren() {
+		if _, ok := ctx.(ITypeContext); ok {
+			t = ctx.(antlr.RuleContext)
+			break
+		}
+	}
+
+	if t == nil {
+		return nil
+	}
+
+	return t.(ITypeContext)
+}
+
+func (s *Type_fieldContext) GetRuleContext() antlr.RuleContext {
+	return s
+}
+
+func (s *Type_fieldContext) ToStringTree(ruleNames []string, recog antlr.Recognizer) string {
+	return antlr.TreesStringTree(s, ruleNames, recog)
+}
+
+func (s *Type_fieldContext) Accept(visitor antlr.ParseTreeVisitor) interface{} {
+	switch t := visitor.(type) {
+	case KuneiformParserVisitor:
+		return t.VisitType_field(s)
+
+	default:
+		return t.VisitChildren(s)
+	}
+}
+
+func (p *KuneiformParser) Type_field() (localctx IType_fieldContext) {
+	localctx = NewType_fieldContext(p, p.GetParserRuleContext(), p.GetState())
+	p.EnterRule(localctx, 136, KuneiformParserRULE_type_field)
+	p.EnterOuterAlt(localctx, 1)
+	{
+		p.SetState(1460)
+		p.Identifier()
+	}
+	{
+		p.SetState(1461)
+		p.Type_()
+	}
+
+errorExit:
+	if p.HasError() {
+		v := p.GetError()
+		localctx.SetException(v)
+		p.GetErrorHandler().ReportError(p, v)
+		p.GetErrorHandler().Recover(p, v)
+		p.SetError(nil)
+	}
+	p.ExitRule()
+	return localctx
+	goto errorExit // Trick to prevent compiler error if the label is not used
+}
+
+// ICreate_schedule_statementContext is an interface to support dynamic dispatch.
+type ICreate_schedule_statementContext interface {
+	antlr.ParserRuleContext
+
+	// GetParser returns the parser.
+	GetParser() antlr.Parser
+
+	// Getter signatures
+	CREATE() antlr.TerminalNode
+	AllIDENTIFIER() []antlr.TerminalNode
+	IDENTIFIER(i int) antlr.TerminalNode
+	Identifier() IIdentifierContext
+	DIGITS_() antlr.TerminalNode
+	CALL() antlr.TerminalNode
+	Action_function_call() IAction_function_callContext
+	IF() antlr.TerminalNode
+	NOT() antlr.TerminalNode
+	EXISTS() antlr.TerminalNode
+
+	// IsCreate_schedule_statementContext differentiates from other interfaces.
+	IsCreate_schedule_statementContext()
+}
+
+type Create_schedule_statementContext struct {
+	antlr.BaseParserRuleContext
+	parser antlr.Parser
+}
+
+func NewEmptyCreate_schedule_statementContext() *Create_schedule_statementContext {
+	var p = new(Create_schedule_statementContext)
+	antlr.InitBaseParserRuleContext(&p.BaseParserRuleContext, nil, -1)
+	p.RuleIndex = KuneiformParserRULE_create_schedule_statement
+	return p
+}
+
+func InitEmptyCreate_schedule_statementContext(p *Create_schedule_statementContext) {
+	antlr.InitBaseParserRuleContext(&p.BaseParserRuleContext, nil, -1)
+	p.RuleIndex = KuneiformParserRULE_create_schedule_statement
+}
+
+func (*Create_schedule_statementContext) IsCreate_schedule_statementContext() {}
+
+func NewCreate_schedule_statementContext(parser antlr.Parser, parent antlr.ParserRuleContext, invokingState int) *Create_schedule_statementContext {
+	var p = new(Create_schedule_statementContext)
+
+	antlr.InitBaseParserRuleContext(&p.BaseParserRuleContext, parent, invokingState)
+
+	p.parser = parser
+	p.RuleIndex = KuneiformParserRULE_create_schedule_statement
+
+	return p
+}
+
+func (s *Create_schedule_statementContext) GetParser() antlr.Parser { return s.parser }
+
+func (s *Create_schedule_statementContext) CREATE() antlr.TerminalNode {
+	return s.GetToken(KuneiformParserCREATE, 0)
+}
+
+func (s *Create_schedule_statementContext) AllIDENTIFIER() []antlr.TerminalNode {
+	return s.GetTokens(KuneiformParserIDENTIFIER)
+}
+
+func (s *Create_schedule_statementContext) IDENTIFIER(i int) antlr.TerminalNode {
+	return s.GetToken(KuneiformParserIDENTIFIER, i)
+}
+
+func (s *Create_schedule_statementContext) Identifier() IIdentifierContext {
+	var t antlr.RuleContext
+	for _, ctx := range s.GetChildren() {
+		if _, ok := ctx.(IIdentifierContext); ok {
+			t = ctx.(antlr.RuleContext)
+			break
+		}
+	}
+
+	if t == nil {
+		return nil
+	}
+
+	return t.(IIdentifierContext)
+}
+
+func (s *Create_schedule_statementContext) DIGITS_() antlr.TerminalNode {
+	return s.GetToken(KuneiformParserDIGITS_, 0)
+}
+
+func (s *Create_schedule_statementContext) CALL() antlr.TerminalNode {
+	return s.GetToken(KuneiformParserCALL, 0)
+}
+
+func (s *Create_schedule_statementContext) Action_function_call() IAction_function_callContext {
+	var t antlr.RuleContext
+	for _, ctx := range s.GetChildren() {
+		if _, ok := ctx.(IAction_function_callContext); ok {
+			t = ctx.(antlr.RuleContext)
+			break
+		}
+	}
+
+	if t == nil {
+		return nil
+	}
+
+	return t.(IAction_function_callContext)
+}
+
+func (s *Create_schedule_statementContext) IF() antlr.TerminalNode {
+	return s.GetToken(KuneiformParserIF, 0)
+}
+
+func (s *Create_schedule_statementContext) NOT() antlr.TerminalNode {
+	return s.GetToken(KuneiformParserNOT, 0)
+}
+
+func (s *Create_schedule_statementContext) EXISTS() antlr.TerminalNode {
+	return s.GetToken(KuneiformParserEXISTS, 0)
+}
+
+func (s *Create_schedule_statementContext) GetRuleContext() antlr.RuleContext {
+	return s
+}
+
+func (s *Create_schedule_statementContext) ToStringTree(ruleNames []string, recog antlr.Recognizer) string {
+	return antlr.TreesStringTree(s, ruleNames, recog)
+}
+
+func (s *Create_schedule_statementContext) Accept(visitor antlr.ParseTreeVisitor) interface{} {
+	switch t := visitor.(type) {
+	case KuneiformParserVisitor:
+		return t.VisitCreate_schedule_statement(s)
+
+	default:
+		return t.VisitChildren(s)
+	}
+}
+
+func (p *KuneiformParser) Create_schedule_statement() (localctx ICreate_schedule_statementContext) {
+	localctx = NewCreate_schedule_statementContext(p, p.GetParserRuleContext(), p.GetState())
+	p.EnterRule(localctx, 138, KuneiformParserRULE_create_schedule_statement)
+	p.EnterOuterAlt(localctx, 1)
+	{
+		p.SetState(1463)
+		p.Match(KuneiformParserCREATE)
+		if p.HasError() {
+			// Recognition error - abort rule
+			goto errorExit
+		}
+	}
+	{
+		p.SetState(1464)
+		p.Match(KuneiformParserIDENTIFIER)
+		if p.HasError() {
+			// Recognition error - abort rule
+			goto errorExit
+		}
+	}
+	p.SetState(1468)
+	p.GetErrorHandler().Sync(p)
+
+	if p.GetInterpreter().AdaptivePredict(p.BaseParser, p.GetTokenStream(), 203, p.GetParserRuleContext()) == 1 {
+		{
+			p.SetState(1465)
+			p.Match(KuneiformParserIF)
+			if p.HasError() {
+				// Recognition error - abort rule
+				goto errorExit
+			}
+		}
+		{
+			p.SetState(1466)
+			p.Match(KuneiformParserNOT)
+			if p.HasError() {
+				// Recognition error - abort rule
+				goto errorExit
+			}
+		}
+		{
+			p.SetState(1467)
+			p.Match(KuneiformParserEXISTS)
+			if p.HasError() {
+				// Recognition error - abort rule
+				goto errorExit
+			}
+		}
+
+	} else if p.HasError() { // JIM
+		goto errorExit
+	}
+	{
+		p.SetState(1470)
+		p.Identifier()
+	}
+	{
+		p.SetState(1471)
+		p.Match(KuneiformParserIDENTIFIER)
+		if p.HasError() {
+			// Recognition error - abort rule
+			goto errorExit
+		}
+	}
+	{
+		p.SetState(1472)
+		p.Match(KuneiformParserDIGITS_)
+		if p.HasError() {
+			// Recognition error - abort rule
+			goto errorExit
+		}
+	}
+	{
+		p.SetState(1473)
+		p.Match(KuneiformParserIDENTIFIER)
+		if p.HasError() {
+			// Recognition error - abort rule
+			goto errorExit
+		}
+	}
+	{
+		p.SetState(1474)
+		p.Match(KuneiformParserCALL)
+		if p.HasError() {
+			// Recognition error - abort rule
+			goto errorExit
+		}
+	}
+	{
+		p.SetState(1475)
+		p.Action_function_call()
+	}
+
+errorExit:
+	if p.HasError() {
+		v := p.GetError()
+		localctx.SetException(v)
+		p.GetErrorHandler().ReportError(p, v)
+		p.GetErrorHandler().Recover(p, v)
+		p.SetError(nil)
+	}
+	p.ExitRule()
+	return localctx
+	goto errorExit // Trick to prevent compiler error if the label is not used
+}
+
 func (p *KuneiformParser) Sempred(localctx antlr.RuleContext, ruleIndex, predIndex int) bool {
 	switch ruleIndex {
 	case 52:
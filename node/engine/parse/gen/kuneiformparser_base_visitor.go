@@ -470,3 +470,27 @@ func (v *BaseKuneiformParserVisitor) VisitIf_then_block(ctx *If_then_blockContex
 func (v *BaseKuneiformParserVisitor) VisitRange(ctx *RangeContext) interface{} {
 	return v.VisitChildren(ctx)
 }
+
+func (v *BaseKuneiformParserVisitor) VisitAction_parameter(ctx *Action_parameterContext) interface{} {
+	return v.VisitChildren(ctx)
+}
+
+func (v *BaseKuneiformParserVisitor) VisitAction_argument(ctx *Action_argumentContext) interface{} {
+	return v.VisitChildren(ctx)
+}
+
+func (v *BaseKuneiformParserVisitor) VisitCreate_type_statement(ctx *Create_type_statementContext) interface{} {
+	return v.VisitChildren(ctx)
+}
+
+func (v *BaseKuneiformParserVisitor) VisitDrop_object_statement(ctx *Drop_object_statementContext) interface{} {
+	return v.VisitChildren(ctx)
+}
+
+func (v *BaseKuneiformParserVisitor) VisitType_field(ctx *Type_fieldContext) interface{} {
+	return v.VisitChildren(ctx)
+}
+
+func (v *BaseKuneiformParserVisitor) VisitCreate_schedule_statement(ctx *Create_schedule_statementContext) interface{} {
+	return v.VisitChildren(ctx)
+}
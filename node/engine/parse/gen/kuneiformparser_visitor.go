@@ -354,4 +354,22 @@ type KuneiformParserVisitor interface {
 
 	// Visit a parse tree produced by KuneiformParser#range.
 	VisitRange(ctx *RangeContext) interface{}
+
+	// Visit a parse tree produced by KuneiformParser#action_parameter.
+	VisitAction_parameter(ctx *Action_parameterContext) interface{}
+
+	// Visit a parse tree produced by KuneiformParser#action_argument.
+	VisitAction_argument(ctx *Action_argumentContext) interface{}
+
+	// Visit a parse tree produced by KuneiformParser#create_type_statement.
+	VisitCreate_type_statement(ctx *Create_type_statementContext) interface{}
+
+	// Visit a parse tree produced by KuneiformParser#drop_object_statement.
+	VisitDrop_object_statement(ctx *Drop_object_statementContext) interface{}
+
+	// Visit a parse tree produced by KuneiformParser#type_field.
+	VisitType_field(ctx *Type_fieldContext) interface{}
+
+	// Visit a parse tree produced by KuneiformParser#create_schedule_statement.
+	VisitCreate_schedule_statement(ctx *Create_schedule_statementContext) interface{}
 }
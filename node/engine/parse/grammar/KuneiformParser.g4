@@ -35,6 +35,9 @@ statement:
         | create_namespace_statement
         | drop_namespace_statement
         | set_current_namespace_statement
+        | create_type_statement
+        | drop_object_statement
+        | create_schedule_statement
     )
 ;
 
@@ -250,7 +253,7 @@ privilege:
 
 create_action_statement:
     CREATE (OR REPLACE)? ACTION (IF NOT EXISTS)? identifier
-    LPAREN (VARIABLE type (COMMA VARIABLE type)*)? RPAREN
+    LPAREN (action_parameter (COMMA action_parameter)*)? RPAREN
     identifier*
     action_return?
     LBRACE action_statement* RBRACE
@@ -488,7 +491,7 @@ variable_or_underscore:
 ;
 
 action_function_call:
-    (namespace=identifier PERIOD)? function=identifier LPAREN (action_expr_list)? RPAREN                                #normal_call_action
+    (namespace=identifier PERIOD)? function=identifier LPAREN (action_argument (COMMA action_argument)*)? RPAREN        #normal_call_action
 ;
 
 if_then_block:
@@ -498,4 +501,43 @@ if_then_block:
 // range used for for loops
 range:
     action_expr RANGE action_expr
-;
\ No newline at end of file
+;
+
+// action parameters can have a constant default value, which is used
+// when the caller omits the argument
+action_parameter:
+    VARIABLE type (DEFAULT action_expr)?
+;
+
+// arguments to action calls can be named (e.g. limit => 10).
+// "=>" is lexed as EQUALS GT, and the name is matched as any token
+// so that keywords can be used as parameter names.
+action_argument:
+    (name=. EQUALS GT)? action_expr
+;
+
+// TYPE and ENUM are matched as identifiers, so that they can still be used
+// as names. The visitor checks them.
+create_type_statement:
+    CREATE IDENTIFIER (IF NOT EXISTS)? identifier AS
+    (
+        LPAREN type_field (COMMA type_field)* RPAREN
+        | IDENTIFIER LPAREN STRING_ (COMMA STRING_)* RPAREN
+    )
+;
+
+// drop statements for objects whose keyword is matched as an identifier
+// (TYPE and SCHEDULE) share a rule, since they are otherwise ambiguous.
+drop_object_statement:
+    DROP IDENTIFIER (IF EXISTS)? identifier
+;
+
+type_field:
+    identifier type
+;
+
+// SCHEDULE, EVERY and BLOCKS are matched as identifiers, so that they can
+// still be used as names. The visitor checks them.
+create_schedule_statement:
+    CREATE IDENTIFIER (IF NOT EXISTS)? identifier IDENTIFIER DIGITS_ IDENTIFIER CALL action_function_call
+;
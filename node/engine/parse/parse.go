@@ -7,6 +7,7 @@ import (
 	"reflect"
 	"runtime"
 	"strings"
+	"unicode"
 
 	"github.com/antlr4-go/antlr/v4"
 	"github.com/trufnetwork/kwil-db/node/engine/parse/gen"
@@ -78,6 +79,16 @@ func ParseWithErrListener(sql string) (p *ParseResult, err error) {
 	return p, nil
 }
 
+// TrimmedOffset returns the number of lines, and the number of columns on
+// the first line, that are trimmed from the start of sql before it is parsed.
+// Positions in the AST are relative to the trimmed input, so these must be
+// added to them to get positions in sql.
+func TrimmedOffset(sql string) (lines, cols int) {
+	trimmed := sql[:len(sql)-len(strings.TrimLeftFunc(sql, unicode.IsSpace))]
+	lines = strings.Count(trimmed, "\n")
+	return lines, len(trimmed) - (strings.LastIndex(trimmed, "\n") + 1)
+}
+
 func setupParser(sql string) (parser *gen.KuneiformParser, errList *errorListener, parserVisitor *schemaVisitor, deferFn func(any) error, err error) {
 	// trim whitespace
 	sql = strings.TrimSpace(sql)
@@ -519,6 +519,93 @@ func Test_DDL(t *testing.T) {
 				CheckExist: true,
 			},
 		},
+		{
+			name: "create composite type",
+			sql:  `CREATE TYPE IF NOT EXISTS Point AS (x int, y text, label mood);`,
+			want: &CreateTypeStatement{
+				IfNotExists: true,
+				Name:        "point",
+				Fields: []*engine.NamedType{
+					{Name: "x", Type: types.IntType},
+					{Name: "y", Type: types.TextType},
+					{Name: "label", Type: &types.DataType{Name: "mood"}},
+				},
+			},
+		},
+		{
+			name: "create enum type",
+			sql:  `create type mood as enum ('sad', 'ok', 'happy');`,
+			want: &CreateTypeStatement{
+				Name:   "mood",
+				Labels: []string{"sad", "ok", "happy"},
+			},
+		},
+		{
+			name: "create type with reserved name",
+			sql:  `CREATE TYPE int8 AS ENUM ('a');`,
+			err:  ErrIdentifier,
+		},
+		{
+			name: "create type without type keyword",
+			sql:  `CREATE TPYE mood AS ENUM ('a');`,
+			err:  ErrSyntax,
+		},
+		{
+			name: "create enum with duplicate labels",
+			sql:  `CREATE TYPE mood AS ENUM ('a', 'b', 'a');`,
+			err:  ErrSyntax,
+		},
+		{
+			name: "create composite type with duplicate fields",
+			sql:  `CREATE TYPE point AS (x int, x int);`,
+			err:  ErrSyntax,
+		},
+		{
+			name: "drop type",
+			sql:  `DROP TYPE IF EXISTS mood;`,
+			want: &DropTypeStatement{
+				Name:     "mood",
+				IfExists: true,
+			},
+		},
+		{
+			name: "create schedule",
+			sql:  `CREATE SCHEDULE IF NOT EXISTS Tick EVERY 10 BLOCKS CALL ns.record(@height, 'x');`,
+			want: &CreateScheduleStatement{
+				IfNotExists: true,
+				Name:        "tick",
+				Interval:    10,
+				Call: &ExpressionFunctionCall{
+					Namespace: "ns",
+					Name:      "record",
+					Args:      []Expression{exprVar("@height"), exprLit("x")},
+				},
+				Raw: `CREATE SCHEDULE IF NOT EXISTS Tick EVERY 10 BLOCKS CALL ns.record(@height, 'x');`,
+			},
+		},
+		{
+			name: "create schedule with zero interval",
+			sql:  `CREATE SCHEDULE tick EVERY 0 BLOCKS CALL record();`,
+			err:  ErrSyntax,
+		},
+		{
+			name: "create schedule with non-constant argument",
+			sql:  `CREATE SCHEDULE tick EVERY 5 BLOCKS CALL record($x);`,
+			err:  ErrSyntax,
+		},
+		{
+			name: "create schedule without blocks keyword",
+			sql:  `CREATE SCHEDULE tick EVERY 5 BLOKS CALL record();`,
+			err:  ErrSyntax,
+		},
+		{
+			name: "drop schedule",
+			sql:  `DROP SCHEDULE IF EXISTS tick;`,
+			want: &DropScheduleStatement{
+				Name:     "tick",
+				IfExists: true,
+			},
+		},
 	}
 
 	for _, tt := range tests {
@@ -2940,6 +3027,77 @@ func TestCreateActionStatements(t *testing.T) {
 				},
 			},
 		},
+		{
+			name: "create action with default parameter values and named arguments",
+			input: `CREATE ACTION paged($owner text, $limit int DEFAULT 10, $offset int DEFAULT -1, $tags text[] DEFAULT ['a', 'b']) PUBLIC {
+				$total := ns.count_posts(owner => $owner);
+				list_posts($owner, limit => $limit, $offset => 0);
+			};`,
+			expect: &CreateActionStatement{
+				Name:      "paged",
+				Modifiers: []string{"public"},
+				Parameters: []*engine.NamedType{
+					{Name: "$owner", Type: types.TextType},
+					{Name: "$limit", Type: types.IntType},
+					{Name: "$offset", Type: types.IntType},
+					{Name: "$tags", Type: types.TextArrayType},
+				},
+				Defaults: []Expression{
+					nil,
+					exprLit(10),
+					exprLit(-1),
+					&ExpressionMakeArray{Values: []Expression{exprLit("a"), exprLit("b")}},
+				},
+				Statements: []ActionStmt{
+					&ActionStmtCall{
+						Receivers: []*ExpressionVariable{exprVar("$total")},
+						Call: &ExpressionFunctionCall{
+							Namespace: "ns",
+							Name:      "count_posts",
+							Args:      []Expression{exprVar("$owner")},
+							ArgNames:  []string{"owner"},
+						},
+					},
+					&ActionStmtCall{
+						Call: &ExpressionFunctionCall{
+							Name:     "list_posts",
+							Args:     []Expression{exprVar("$owner"), exprVar("$limit"), exprLit(0)},
+							ArgNames: []string{"", "limit", "offset"},
+						},
+					},
+				},
+			},
+		},
+		{
+			name:  "create action with a required parameter after a default",
+			input: `CREATE ACTION bad_defaults($a int DEFAULT 1, $b int) PUBLIC {};`,
+			err:   ErrParameterDefault,
+		},
+		{
+			name:  "create action with a non-constant default",
+			input: `CREATE ACTION bad_defaults($a int DEFAULT abs(1)) PUBLIC {};`,
+			err:   ErrParameterDefault,
+		},
+		{
+			name:  "create action with a positional argument after a named argument",
+			input: `CREATE ACTION bad_call() PUBLIC { other(a => 1, 2); };`,
+			err:   ErrNamedArgument,
+		},
+		{
+			name:  "create action with a repeated named argument",
+			input: `CREATE ACTION bad_call() PUBLIC { other(a => 1, a => 2); };`,
+			err:   ErrNamedArgument,
+		},
+		{
+			name:  "create action with an invalid argument name",
+			input: `CREATE ACTION bad_call() PUBLIC { other(1 => 2); };`,
+			err:   ErrNamedArgument,
+		},
+		{
+			name:  "create action with a split named argument arrow",
+			input: `CREATE ACTION bad_call() PUBLIC { other(a = > 1); };`,
+			err:   ErrSyntax,
+		},
 		{
 			name:  "create action with duplicate parameters",
 			input: `CREATE ACTION duplicate_params($a int, $a text) PUBLIC {};`,
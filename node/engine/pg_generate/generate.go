@@ -60,11 +60,7 @@ func (s *sqlGenerator) VisitExpressionLiteral(p0 *parse.ExpressionLiteral) any {
 	}
 
 	if p0.GetTypeCast() != nil {
-		pgStr, err := p0.GetTypeCast().PGString()
-		if err != nil {
-			panic(err)
-		}
-		str += "::" + pgStr
+		str += "::" + s.pgType(p0.GetTypeCast())
 	}
 
 	return str
@@ -102,22 +98,35 @@ func (s *sqlGenerator) VisitExpressionFunctionCall(p0 *parse.ExpressionFunctionC
 	}
 	str.WriteString(pgFmt)
 
-	typeCast(p0, &str)
+	s.typeCast(p0, &str)
 
 	return str.String()
 }
 
 // typeCast adds a typecast to the string builder if the typecast is not nil
-func typeCast(t interface{ GetTypeCast() *types.DataType }, s *strings.Builder) {
+func (s *sqlGenerator) typeCast(t interface{ GetTypeCast() *types.DataType }, str *strings.Builder) {
 	if t.GetTypeCast() != nil {
-		pgStr, err := t.GetTypeCast().PGString()
+		str.WriteString("::")
+		str.WriteString(s.pgType(t.GetTypeCast()))
+	}
+}
+
+// pgType returns the Postgres representation of the type. User-defined types
+// are qualified with the schema name, since they are created in the schema.
+func (s *sqlGenerator) pgType(t *types.DataType) string {
+	if !t.IsUserDefined() {
+		pgStr, err := t.PGString()
 		if err != nil {
 			panic(err)
 		}
+		return pgStr
+	}
 
-		s.WriteString("::")
-		s.WriteString(pgStr)
+	str := s.qualify(t.Name)
+	if t.IsArray {
+		str += "[]"
 	}
+	return str
 }
 
 func (s *sqlGenerator) VisitExpressionWindowFunctionCall(p0 *parse.ExpressionWindowFunctionCall) any {
@@ -174,9 +183,16 @@ func (s *sqlGenerator) VisitExpressionVariable(p0 *parse.ExpressionVariable) any
 		panic(err)
 	}
 
-	pgStr, err := engine.MakeTypeCast(dt)
-	if err != nil {
-		panic(err)
+	// user-defined types are not known to MakeTypeCast, since they must be
+	// qualified with the namespace they are defined in.
+	var pgStr string
+	if dt.IsUserDefined() {
+		pgStr = "::" + s.pgType(dt)
+	} else {
+		pgStr, err = engine.MakeTypeCast(dt)
+		if err != nil {
+			panic(err)
+		}
 	}
 
 	// if it already exists, we write it as that index.
@@ -195,7 +211,7 @@ func (s *sqlGenerator) VisitExpressionVariable(p0 *parse.ExpressionVariable) any
 	res.WriteString("$")
 	res.WriteString(strconv.Itoa(len(s.orderedParams)))
 	res.WriteString(pgStr)
-	typeCast(p0, &res)
+	s.typeCast(p0, &res)
 	return res.String()
 }
 
@@ -216,7 +232,7 @@ func (s *sqlGenerator) VisitExpressionArrayAccess(p0 *parse.ExpressionArrayAcces
 		}
 	}
 	str.WriteString("]")
-	typeCast(p0, &str)
+	s.typeCast(p0, &str)
 	return str.String()
 }
 
@@ -230,7 +246,7 @@ func (s *sqlGenerator) VisitExpressionMakeArray(p0 *parse.ExpressionMakeArray) a
 		str.WriteString(arg.Accept(s).(string))
 	}
 	str.WriteString("]")
-	typeCast(p0, &str)
+	s.typeCast(p0, &str)
 	return str.String()
 }
 
@@ -239,7 +255,7 @@ func (s *sqlGenerator) VisitExpressionFieldAccess(p0 *parse.ExpressionFieldAcces
 	str.WriteString(p0.Record.Accept(s).(string))
 	str.WriteString(".")
 	str.WriteString(p0.Field)
-	typeCast(p0, &str)
+	s.typeCast(p0, &str)
 	return str.String()
 }
 
@@ -248,7 +264,7 @@ func (s *sqlGenerator) VisitExpressionParenthesized(p0 *parse.ExpressionParenthe
 	str.WriteString("(")
 	str.WriteString(p0.Inner.Accept(s).(string))
 	str.WriteString(")")
-	typeCast(p0, &str)
+	s.typeCast(p0, &str)
 	return str.String()
 }
 
@@ -301,7 +317,7 @@ func (s *sqlGenerator) VisitExpressionColumn(p0 *parse.ExpressionColumn) any {
 		str.WriteString(".")
 	}
 	str.WriteString(p0.Column)
-	typeCast(p0, &str)
+	s.typeCast(p0, &str)
 	return str.String()
 }
 
@@ -394,7 +410,7 @@ func (s *sqlGenerator) VisitExpressionSubquery(p0 *parse.ExpressionSubquery) any
 	str.WriteString("(")
 	str.WriteString(p0.Subquery.Accept(s).(string))
 	str.WriteString(")")
-	typeCast(p0, &str)
+	s.typeCast(p0, &str)
 	return str.String()
 }
 
@@ -978,12 +994,7 @@ func (s *sqlGenerator) VisitAddColumn(p0 *parse.AddColumn) any {
 	str.WriteString(p0.Name)
 	str.WriteString(" ")
 
-	typeStr, err := p0.Type.PGString()
-	if err != nil {
-		panic(err)
-	}
-
-	str.WriteString(typeStr)
+	str.WriteString(s.pgType(p0.Type))
 	return str.String()
 }
 
@@ -1035,12 +1046,7 @@ func (s *sqlGenerator) VisitColumn(p0 *parse.Column) any {
 	str.WriteString(p0.Name)
 	str.WriteString(" ")
 
-	typeStr, err := p0.Type.PGString()
-	if err != nil {
-		panic(err)
-	}
-
-	str.WriteString(typeStr)
+	str.WriteString(s.pgType(p0.Type))
 	for _, con := range p0.Constraints {
 		str.WriteString(" ")
 		str.WriteString(con.Accept(s).(string))
@@ -1236,6 +1242,61 @@ func (s *sqlGenerator) VisitDropActionStatement(p0 *parse.DropActionStatement) a
 	return nil
 }
 
+func (s *sqlGenerator) VisitCreateScheduleStatement(p0 *parse.CreateScheduleStatement) any {
+	generateErr(s)
+	return nil
+}
+
+func (s *sqlGenerator) VisitDropScheduleStatement(p0 *parse.DropScheduleStatement) any {
+	generateErr(s)
+	return nil
+}
+
+func (s *sqlGenerator) VisitCreateTypeStatement(p0 *parse.CreateTypeStatement) any {
+	str := strings.Builder{}
+	str.WriteString("CREATE TYPE ")
+	str.WriteString(s.qualify(p0.Name))
+	str.WriteString(" AS ")
+
+	if p0.IsEnum() {
+		str.WriteString("ENUM (")
+		for i, label := range p0.Labels {
+			if i > 0 {
+				str.WriteString(", ")
+			}
+			lit, err := formatPGLiteral(label)
+			if err != nil {
+				panic(err)
+			}
+			str.WriteString(lit)
+		}
+		str.WriteString(")")
+		return str.String()
+	}
+
+	str.WriteString("(")
+	for i, field := range p0.Fields {
+		if i > 0 {
+			str.WriteString(", ")
+		}
+		str.WriteString(field.Name)
+		str.WriteString(" ")
+		str.WriteString(s.pgType(field.Type))
+	}
+	str.WriteString(")")
+	return str.String()
+}
+
+func (s *sqlGenerator) VisitDropTypeStatement(p0 *parse.DropTypeStatement) any {
+	str := strings.Builder{}
+	str.WriteString("DROP TYPE ")
+	if p0.IfExists {
+		str.WriteString("IF EXISTS ")
+	}
+	str.WriteString(s.qualify(p0.Name))
+	return str.String()
+}
+
 func (s *sqlGenerator) VisitActionStmtDeclaration(p0 *parse.ActionStmtDeclaration) any {
 	generateErr(s)
 	return nil
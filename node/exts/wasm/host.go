@@ -0,0 +1,472 @@
+// Package wasm loads WebAssembly modules as precompile extensions, so custom
+// logic can be added to kwild without compiling it into the binary.
+//
+// A module must export its memory and the following functions:
+//   - kwil_alloc(size i32) i32: allocates size bytes, and returns a pointer to them
+//   - kwil_methods() i64: returns the JSON array of the methods of the module
+//   - kwil_call(ptr i32, len i32) i64: calls a method
+//
+// Functions that return i64 return a pointer to their output in the upper 32
+// bits, and its length in the lower 32 bits. Each method is described as:
+//
+//	{"name": "add", "modifiers": ["PUBLIC", "VIEW"],
+//	 "parameters": [{"name": "a", "type": "int8", "nullable": false}, ...],
+//	 "returns": {"table": false, "fields": [{"name": "sum", "type": "int8"}]}}
+//
+// The input to kwil_call is {"method", "args", "caller", "txid", "height"},
+// and its output is either {"rows": [[...], ...]} or {"error": "message"}.
+//
+// Modules can import a single host function, kwil.execute(ptr i32, len i32) i64,
+// which executes a statement in the engine. Its input is {"statement",
+// "params"}, and its output is {"columns": [...], "rows": [[...], ...]} or
+// {"error": "message"}. Modules have no other access to the node. In
+// particular, there is no WASI, so there are no clocks or sources of
+// randomness.
+//
+// Every call instantiates the module anew, so no state is kept between calls,
+// and calls are metered by the number of instructions executed. Floating point
+// instructions are allowed, but NaN payloads are not guaranteed to be the same
+// on every platform, so modules must not depend on them.
+package wasm
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"math"
+
+	"github.com/tetratelabs/wazero"
+	"github.com/tetratelabs/wazero/api"
+
+	"github.com/trufnetwork/kwil-db/common"
+	"github.com/trufnetwork/kwil-db/core/types"
+	"github.com/trufnetwork/kwil-db/extensions/precompiles"
+)
+
+const (
+	// memoryLimitPages limits the memory of a module to 64 MiB.
+	memoryLimitPages = 1024
+	// executeGas is the gas charged for each statement executed by a module,
+	// in addition to executeByteGas for each byte of its input and output.
+	executeGas     = 10_000
+	executeByteGas = 1
+)
+
+// ErrOutOfGas is returned when a call uses more gas than its limit.
+var ErrOutOfGas = errors.New("wasm precompile out of gas")
+
+// Module is a compiled WebAssembly module.
+type Module struct {
+	runtime  wazero.Runtime
+	compiled wazero.CompiledModule
+	methods  []*methodSpec
+}
+
+type valueSpec struct {
+	Name     string `json:"name"`
+	Type     string `json:"type"`
+	Nullable bool   `json:"nullable"`
+}
+
+type methodSpec struct {
+	Name       string      `json:"name"`
+	Modifiers  []string    `json:"modifiers"`
+	Parameters []valueSpec `json:"parameters"`
+	Returns    *struct {
+		Table  bool        `json:"table"`
+		Fields []valueSpec `json:"fields"`
+	} `json:"returns"`
+}
+
+type callRequest struct {
+	Method string `json:"method"`
+	Args   []any  `json:"args"`
+	Caller string `json:"caller"`
+	TxID   string `json:"txid"`
+	Height int64  `json:"height"`
+}
+
+type callResponse struct {
+	Rows  [][]json.RawMessage `json:"rows"`
+	Error *string             `json:"error"`
+}
+
+type executeRequest struct {
+	Statement string                     `json:"statement"`
+	Params    map[string]json.RawMessage `json:"params"`
+}
+
+type executeResponse struct {
+	Columns []string `json:"columns,omitempty"`
+	Rows    [][]any  `json:"rows,omitempty"`
+	Error   *string  `json:"error,omitempty"`
+}
+
+// callState is the state of a call, which is available to host functions.
+type callState struct {
+	engineCtx *common.EngineContext
+	app       *common.App
+}
+
+type callStateKey struct{}
+
+// Load instruments and compiles a module, and reads its methods. Each call to
+// the module starts with gasLimit gas.
+func Load(ctx context.Context, code []byte, gasLimit int64) (*Module, error) {
+	if gasLimit <= 0 {
+		return nil, errors.New("gas limit must be positive")
+	}
+
+	metered, err := instrument(code, gasLimit)
+	if err != nil {
+		return nil, fmt.Errorf("failed to instrument module: %w", err)
+	}
+
+	runtime := wazero.NewRuntimeWithConfig(ctx, wazero.NewRuntimeConfig().
+		WithMemoryLimitPages(memoryLimitPages).
+		WithCloseOnContextDone(true))
+
+	_, err = runtime.NewHostModuleBuilder("kwil").
+		NewFunctionBuilder().
+		WithGoModuleFunction(api.GoModuleFunc(hostExecute), []api.ValueType{api.ValueTypeI32, api.ValueTypeI32}, []api.ValueType{api.ValueTypeI64}).
+		Export("execute").
+		Instantiate(ctx)
+	if err != nil {
+		return nil, errors.Join(err, runtime.Close(ctx))
+	}
+
+	compiled, err := runtime.CompileModule(ctx, metered)
+	if err != nil {
+		return nil, errors.Join(fmt.Errorf("failed to compile module: %w", err), runtime.Close(ctx))
+	}
+
+	m := &Module{runtime: runtime, compiled: compiled}
+	err = m.run(ctx, func(ctx context.Context, mod api.Module) error {
+		out, err := callPacked(ctx, mod, "kwil_methods")
+		if err != nil {
+			return err
+		}
+		return json.Unmarshal(out, &m.methods)
+	})
+	if err != nil {
+		return nil, errors.Join(fmt.Errorf("failed to read methods: %w", err), runtime.Close(ctx))
+	}
+
+	return m, nil
+}
+
+// Register loads a module and registers it as a precompile extension.
+func Register(ctx context.Context, name string, code []byte, gasLimit int64) error {
+	m, err := Load(ctx, code, gasLimit)
+	if err != nil {
+		return err
+	}
+
+	p, err := m.Precompile()
+	if err != nil {
+		return errors.Join(err, m.Close(ctx))
+	}
+
+	err = precompiles.RegisterPrecompile(name, p)
+	if err != nil {
+		return errors.Join(err, m.Close(ctx))
+	}
+
+	return nil
+}
+
+// Close releases the resources of the module.
+func (m *Module) Close(ctx context.Context) error {
+	return m.runtime.Close(ctx)
+}
+
+// Precompile returns a precompile that calls the methods of the module.
+func (m *Module) Precompile() (precompiles.Precompile, error) {
+	var p precompiles.Precompile
+	for _, spec := range m.methods {
+		method := precompiles.Method{
+			Name: spec.Name,
+		}
+		for _, mod := range spec.Modifiers {
+			method.AccessModifiers = append(method.AccessModifiers, precompiles.Modifier(mod))
+		}
+
+		var err error
+		method.Parameters, err = convertValueSpecs(spec.Parameters)
+		if err != nil {
+			return p, fmt.Errorf("method %s: %w", spec.Name, err)
+		}
+
+		if spec.Returns != nil {
+			fields, err := convertValueSpecs(spec.Returns.Fields)
+			if err != nil {
+				return p, fmt.Errorf("method %s: %w", spec.Name, err)
+			}
+			method.Returns = &precompiles.MethodReturn{
+				IsTable: spec.Returns.Table,
+				Fields:  fields,
+			}
+		}
+
+		method.Handler = m.handler(method.Name, method.Returns)
+		p.Methods = append(p.Methods, method)
+	}
+
+	return p, precompiles.CleanPrecompile(&p)
+}
+
+func convertValueSpecs(specs []valueSpec) ([]precompiles.PrecompileValue, error) {
+	res := make([]precompiles.PrecompileValue, len(specs))
+	for i, spec := range specs {
+		dt, err := types.ParseDataType(spec.Type)
+		if err != nil {
+			return nil, fmt.Errorf("%s: %w", spec.Name, err)
+		}
+		res[i] = precompiles.NewPrecompileValue(spec.Name, dt, spec.Nullable)
+	}
+	return res, nil
+}
+
+// handler returns the handler of a method.
+func (m *Module) handler(name string, returns *precompiles.MethodReturn) precompiles.HandlerFunc {
+	return func(ctx *common.EngineContext, app *common.App, inputs []any, resultFn func([]any) error) error {
+		req := callRequest{
+			Method: name,
+			Args:   make([]any, len(inputs)),
+			Caller: ctx.TxContext.Caller,
+			TxID:   ctx.TxContext.TxID,
+		}
+		if ctx.TxContext.BlockContext != nil {
+			req.Height = ctx.TxContext.BlockContext.Height
+		}
+		for i, input := range inputs {
+			arg, err := encodeValue(input)
+			if err != nil {
+				return err
+			}
+			req.Args[i] = arg
+		}
+
+		in, err := json.Marshal(req)
+		if err != nil {
+			return err
+		}
+
+		var res callResponse
+		callCtx := context.WithValue(ctx.TxContext.Ctx, callStateKey{}, &callState{engineCtx: ctx, app: app})
+		err = m.run(callCtx, func(ctx context.Context, mod api.Module) error {
+			out, err := callPacked(ctx, mod, "kwil_call", in)
+			if err != nil {
+				return err
+			}
+			return json.Unmarshal(out, &res)
+		})
+		if err != nil {
+			return err
+		}
+		if res.Error != nil {
+			return errors.New(*res.Error)
+		}
+
+		if returns == nil {
+			if len(res.Rows) > 0 {
+				return fmt.Errorf("method %s returned rows, but does not declare any return", name)
+			}
+			return nil
+		}
+		if !returns.IsTable && len(res.Rows) != 1 {
+			return fmt.Errorf("method %s must return exactly one row, got %d", name, len(res.Rows))
+		}
+
+		for _, row := range res.Rows {
+			if len(row) != len(returns.Fields) {
+				return fmt.Errorf("method %s must return %d columns, got %d", name, len(returns.Fields), len(row))
+			}
+
+			vals := make([]any, len(row))
+			for i, raw := range row {
+				vals[i], err = decodeValue(raw, returns.Fields[i].Type)
+				if err != nil {
+					return fmt.Errorf("method %s column %s: %w", name, returns.Fields[i].Name, err)
+				}
+			}
+
+			if err = resultFn(vals); err != nil {
+				return err
+			}
+		}
+
+		return nil
+	}
+}
+
+// run instantiates the module and calls fn with the instance. If the call runs
+// out of gas, ErrOutOfGas is returned.
+func (m *Module) run(ctx context.Context, fn func(ctx context.Context, mod api.Module) error) error {
+	// the instance is anonymous so that instances can run concurrently, and
+	// no start functions beyond the start section of the module are called
+	mod, err := m.runtime.InstantiateModule(ctx, m.compiled, wazero.NewModuleConfig().WithName("").WithStartFunctions())
+	if err != nil {
+		return fmt.Errorf("failed to instantiate module: %w", err)
+	}
+	defer mod.Close(ctx)
+
+	err = fn(ctx, mod)
+	if err != nil && remainingGas(mod) < 0 {
+		return ErrOutOfGas
+	}
+	return err
+}
+
+// callPacked calls an exported function with the inputs written to the memory
+// of the module, and returns the output that the packed result points to.
+func callPacked(ctx context.Context, mod api.Module, name string, inputs ...[]byte) ([]byte, error) {
+	fn := mod.ExportedFunction(name)
+	if fn == nil {
+		return nil, fmt.Errorf("module does not export %s", name)
+	}
+
+	var params []uint64
+	for _, input := range inputs {
+		ptr, err := writeMemory(ctx, mod, input)
+		if err != nil {
+			return nil, err
+		}
+		params = append(params, uint64(ptr), uint64(len(input)))
+	}
+
+	res, err := fn.Call(ctx, params...)
+	if err != nil {
+		return nil, err
+	}
+	if len(res) != 1 {
+		return nil, fmt.Errorf("%s must return a single i64", name)
+	}
+
+	return readMemory(mod, res[0])
+}
+
+// writeMemory allocates memory in the module, and writes b to it.
+func writeMemory(ctx context.Context, mod api.Module, b []byte) (uint32, error) {
+	alloc := mod.ExportedFunction("kwil_alloc")
+	if alloc == nil {
+		return 0, errors.New("module does not export kwil_alloc")
+	}
+	if len(b) > math.MaxInt32 {
+		return 0, errors.New("input too large")
+	}
+
+	res, err := alloc.Call(ctx, uint64(len(b)))
+	if err != nil {
+		return 0, err
+	}
+	if len(res) != 1 {
+		return 0, errors.New("kwil_alloc must return a single i32")
+	}
+
+	ptr := api.DecodeU32(res[0])
+	if !mod.Memory().Write(ptr, b) {
+		return 0, fmt.Errorf("kwil_alloc returned out of bounds memory %d", ptr)
+	}
+	return ptr, nil
+}
+
+// readMemory copies the memory that a packed pointer and length point to.
+func readMemory(mod api.Module, packed uint64) ([]byte, error) {
+	ptr, size := uint32(packed>>32), uint32(packed)
+	b, ok := mod.Memory().Read(ptr, size)
+	if !ok {
+		return nil, fmt.Errorf("out of bounds memory %d+%d", ptr, size)
+	}
+	return append([]byte(nil), b...), nil
+}
+
+func remainingGas(mod api.Module) int64 {
+	g := mod.ExportedGlobal(gasGlobal)
+	if g == nil {
+		return 0
+	}
+	return int64(g.Get())
+}
+
+// useGas subtracts gas from the module, and panics with ErrOutOfGas, which
+// traps the module, if there is not enough.
+func useGas(mod api.Module, gas int64) {
+	g := mod.ExportedGlobal(gasGlobal).(api.MutableGlobal)
+	remaining := int64(g.Get()) - gas
+	g.Set(uint64(remaining))
+	if remaining < 0 {
+		panic(ErrOutOfGas)
+	}
+}
+
+// hostExecute implements kwil.execute.
+func hostExecute(ctx context.Context, mod api.Module, stack []uint64) {
+	in, err := readMemory(mod, uint64(api.DecodeU32(stack[0]))<<32|uint64(api.DecodeU32(stack[1])))
+	if err != nil {
+		panic(err)
+	}
+	useGas(mod, executeGas+executeByteGas*int64(len(in)))
+
+	res := execute(ctx, in)
+	out, err := json.Marshal(res)
+	if err != nil {
+		panic(err)
+	}
+	useGas(mod, executeByteGas*int64(len(out)))
+
+	ptr, err := writeMemory(ctx, mod, out)
+	if err != nil {
+		panic(err)
+	}
+	stack[0] = uint64(ptr)<<32 | uint64(len(out))
+}
+
+// execute executes a statement for a module. Errors of the statement are
+// returned to the module, so that it can handle them.
+func execute(ctx context.Context, in []byte) *executeResponse {
+	fail := func(err error) *executeResponse {
+		msg := err.Error()
+		return &executeResponse{Error: &msg}
+	}
+
+	state, ok := ctx.Value(callStateKey{}).(*callState)
+	if !ok {
+		return fail(errors.New("statements can only be executed by methods"))
+	}
+
+	var req executeRequest
+	if err := json.Unmarshal(in, &req); err != nil {
+		return fail(err)
+	}
+
+	params := make(map[string]any, len(req.Params))
+	for name, raw := range req.Params {
+		v, err := decodeParam(raw)
+		if err != nil {
+			return fail(fmt.Errorf("parameter %s: %w", name, err))
+		}
+		params[name] = v
+	}
+
+	res := &executeResponse{Rows: [][]any{}}
+	err := state.app.Engine.Execute(state.engineCtx, state.app.DB, req.Statement, params, func(row *common.Row) error {
+		res.Columns = row.ColumnNames
+		vals := make([]any, len(row.Values))
+		for i, v := range row.Values {
+			enc, err := encodeValue(v)
+			if err != nil {
+				return err
+			}
+			vals[i] = enc
+		}
+		res.Rows = append(res.Rows, vals)
+		return nil
+	})
+	if err != nil {
+		return fail(err)
+	}
+
+	return res
+}
@@ -0,0 +1,211 @@
+package wasm
+
+import (
+	"bytes"
+	"context"
+	"math"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/trufnetwork/kwil-db/common"
+	"github.com/trufnetwork/kwil-db/core/types"
+	"github.com/trufnetwork/kwil-db/extensions/precompiles"
+	"github.com/trufnetwork/kwil-db/node/types/sql"
+)
+
+const testMethods = `[
+	{"name": "answer", "modifiers": ["PUBLIC", "VIEW"],
+	 "returns": {"table": false, "fields": [{"name": "n", "type": "int8"}]}},
+	{"name": "query", "modifiers": ["PUBLIC"], "parameters": [{"name": "id", "type": "int8"}],
+	 "returns": {"table": true, "fields": [{"name": "n", "type": "int8"}, {"name": "b", "type": "bytea"},
+		{"name": "d", "type": "numeric(10,2)"}, {"name": "arr", "type": "text[]", "nullable": true}]}}
+]`
+
+// testModule assembles a module whose kwil_call has the given body. The
+// methods are at address 0, and data at address 1024. kwil.execute is
+// function 0, kwil_alloc is function 1, kwil_methods is function 2, and
+// kwil_call is function 3.
+func testModule(callBody []byte, data string) []byte {
+	vec := func(elems ...[]byte) []byte {
+		return append(appendU32(nil, uint32(len(elems))), bytes.Join(elems, nil)...)
+	}
+	section := func(id byte, content []byte) []byte {
+		return append(appendU32([]byte{id}, uint32(len(content))), content...)
+	}
+	code := func(body ...byte) []byte {
+		body = append([]byte{0x00}, body...) // no locals
+		return append(appendU32(nil, uint32(len(body))), body...)
+	}
+	i64Const := func(v int64) []byte {
+		return appendS64([]byte{0x42}, v)
+	}
+	i32Const := func(v int32) []byte {
+		return appendS64([]byte{0x41}, int64(v))
+	}
+	dataSegment := func(offset int32, b string) []byte {
+		seg := append([]byte{0x00}, i32Const(offset)...)
+		seg = append(seg, 0x0B)
+		return append(appendU32(seg, uint32(len(b))), b...)
+	}
+
+	module := []byte(wasmMagic + wasmVersion)
+	module = append(module, section(1, vec(
+		[]byte{0x60, 0x00, 0x01, 0x7E},             // () -> i64
+		[]byte{0x60, 0x02, 0x7F, 0x7F, 0x01, 0x7E}, // (i32, i32) -> i64
+		[]byte{0x60, 0x01, 0x7F, 0x01, 0x7F},       // (i32) -> i32
+	))...)
+	module = append(module, section(2, vec(
+		append(appendName(appendName(nil, "kwil"), "execute"), externFunc, 1),
+	))...)
+	module = append(module, section(3, vec([]byte{2}, []byte{0}, []byte{1}))...)
+	module = append(module, section(5, vec([]byte{0x00, 0x01}))...)
+	// the heap of the bump allocator starts at 4096
+	module = append(module, section(6, vec(append([]byte{0x7F, 0x01}, append(i32Const(4096), 0x0B)...)))...)
+	module = append(module, section(7, vec(
+		append(appendName(nil, "memory"), externMemory, 0),
+		append(appendName(nil, "kwil_alloc"), externFunc, 1),
+		append(appendName(nil, "kwil_methods"), externFunc, 2),
+		append(appendName(nil, "kwil_call"), externFunc, 3),
+	))...)
+	module = append(module, section(10, vec(
+		// global.get 0, global.get 0, local.get 0, i32.add, global.set 0
+		code(0x23, 0x00, 0x23, 0x00, 0x20, 0x00, 0x6A, 0x24, 0x00, 0x0B),
+		code(append(i64Const(int64(len(testMethods))), 0x0B)...),
+		code(callBody...),
+	))...)
+	module = append(module, section(11, vec(
+		dataSegment(0, testMethods),
+		dataSegment(1024, data),
+	))...)
+
+	return module
+}
+
+type testEngine struct {
+	common.Engine
+	statement string
+	params    map[string]any
+}
+
+func (e *testEngine) Execute(ctx *common.EngineContext, db sql.DB, statement string, params map[string]any, fn func(*common.Row) error) error {
+	e.statement, e.params = statement, params
+	a, b := "a", "b"
+	for i := range int64(2) {
+		err := fn(&common.Row{
+			ColumnNames: []string{"n", "b", "d", "arr"},
+			Values:      []any{i, []byte{1, 2}, types.MustParseDecimal("1.5"), []*string{&a, nil, &b}},
+		})
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func Test_Module(t *testing.T) {
+	ctx := context.Background()
+	engineCtx := &common.EngineContext{TxContext: &common.TxContext{Ctx: ctx, Caller: "alice"}}
+
+	load := func(callBody []byte, data string) precompiles.Precompile {
+		m, err := Load(ctx, testModule(callBody, data), 100_000)
+		require.NoError(t, err)
+		t.Cleanup(func() { m.Close(ctx) })
+
+		p, err := m.Precompile()
+		require.NoError(t, err)
+		require.Len(t, p.Methods, 2)
+		return p
+	}
+	call := func(p precompiles.Precompile, method int, app *common.App, inputs ...any) ([][]any, error) {
+		var rows [][]any
+		err := p.Methods[method].Handler(engineCtx, app, inputs, func(row []any) error {
+			rows = append(rows, row)
+			return nil
+		})
+		return rows, err
+	}
+	// returns the data at 1024
+	returnData := func(data string) []byte {
+		return append(appendS64([]byte{0x42}, 1024<<32|int64(len(data))), 0x0B)
+	}
+
+	t.Run("methods", func(t *testing.T) {
+		p := load(returnData(`{"rows": [[42]]}`), `{"rows": [[42]]}`)
+		assert.Equal(t, "answer", p.Methods[0].Name)
+		assert.Equal(t, []precompiles.Modifier{precompiles.PUBLIC, precompiles.VIEW}, p.Methods[0].AccessModifiers)
+		assert.True(t, p.Methods[1].Returns.IsTable)
+		assert.Equal(t, types.IntType, p.Methods[1].Parameters[0].Type)
+		assert.True(t, p.Methods[1].Returns.Fields[3].Nullable)
+
+		rows, err := call(p, 0, nil)
+		require.NoError(t, err)
+		assert.Equal(t, [][]any{{int64(42)}}, rows)
+	})
+
+	t.Run("errors", func(t *testing.T) {
+		p := load(returnData(`{"error": "boom"}`), `{"error": "boom"}`)
+		_, err := call(p, 0, nil)
+		assert.EqualError(t, err, "boom")
+	})
+
+	t.Run("out of gas", func(t *testing.T) {
+		// loop, br 0, end, i64.const 0, end
+		p := load([]byte{0x03, 0x40, 0x0C, 0x00, 0x0B, 0x42, 0x00, 0x0B}, "")
+		_, err := call(p, 0, nil)
+		assert.ErrorIs(t, err, ErrOutOfGas)
+	})
+
+	t.Run("gas is not writable", func(t *testing.T) {
+		// a loop that resets the gas global, which is global 1, before
+		// branching: loop, i64.const max, global.set 1, br 0, end,
+		// i64.const 0, end
+		body := appendS64([]byte{0x03, 0x40, 0x42}, math.MaxInt64)
+		body = append(body, 0x24, 0x01, 0x0C, 0x00, 0x0B, 0x42, 0x00, 0x0B)
+		_, err := Load(ctx, testModule(body, ""), 100_000)
+		assert.ErrorContains(t, err, "global 1 is not defined")
+
+		// reading it is also rejected: global.get 1, end
+		_, err = Load(ctx, testModule([]byte{0x23, 0x01, 0x0B}, ""), 100_000)
+		assert.ErrorContains(t, err, "global 1 is not defined")
+
+		// the same loop without the reset runs out of gas
+		p := load([]byte{0x03, 0x40, 0x42, 0x00, 0x1A, 0x0C, 0x00, 0x0B, 0x42, 0x00, 0x0B}, "")
+		_, err = call(p, 0, nil)
+		assert.ErrorIs(t, err, ErrOutOfGas)
+	})
+
+	t.Run("execute", func(t *testing.T) {
+		// kwil_call executes the statement at 1024, and returns its rows
+		req := `{"statement": "SELECT 1", "params": {"id": 5, "name": "bob"}}`
+		body := appendS64([]byte{0x41}, 1024)
+		body = appendS64(append(body, 0x41), int64(len(req)))
+		body = append(body, 0x10, 0x00, 0x0B) // call kwil.execute
+		p := load(body, req)
+
+		engine := &testEngine{}
+		rows, err := call(p, 1, &common.App{Engine: engine}, int64(5))
+		require.NoError(t, err)
+
+		assert.Equal(t, "SELECT 1", engine.statement)
+		assert.Equal(t, map[string]any{"id": int64(5), "name": "bob"}, engine.params)
+
+		a, b := "a", "b"
+		d, err := types.ParseDecimalExplicit("1.5", 10, 2)
+		require.NoError(t, err)
+		assert.Equal(t, [][]any{
+			{int64(0), []byte{1, 2}, d, []*string{&a, nil, &b}},
+			{int64(1), []byte{1, 2}, d, []*string{&a, nil, &b}},
+		}, rows)
+	})
+}
+
+func Test_InstrumentRejectsUnsupported(t *testing.T) {
+	// a SIMD instruction
+	_, err := Load(context.Background(), testModule([]byte{0xFD, 0x0C, 0x0B}, ""), 100_000)
+	assert.ErrorContains(t, err, "unsupported instruction")
+
+	_, err = Load(context.Background(), []byte("not wasm"), 100_000)
+	assert.Error(t, err)
+}
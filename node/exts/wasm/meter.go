@@ -0,0 +1,547 @@
+package wasm
+
+import (
+	"bytes"
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"io"
+	"math"
+	"slices"
+)
+
+// Modules are metered by rewriting their code before it is compiled. A mutable
+// i64 global holding the remaining gas is appended to the module and exported
+// as gasGlobal. The code of every function is split into straight-line
+// segments, which end at each instruction that can change the control flow,
+// and each segment is prefixed with code that subtracts the number of
+// instructions in the segment from the gas, and traps if the gas is negative.
+// Since the cost of a call only depends on the instructions that run, it is
+// the same on every node. The gas global did not exist in the original module,
+// so code that accesses it is rejected, which keeps the gas out of the guest's
+// reach. Only the host reads and charges it through the export.
+
+const (
+	// gasGlobal is the name of the export of the remaining gas.
+	gasGlobal = "kwil_gas"
+
+	wasmMagic   = "\x00asm"
+	wasmVersion = "\x01\x00\x00\x00"
+)
+
+// section ids
+const (
+	sectionCustom    = 0
+	sectionImport    = 2
+	sectionGlobal    = 6
+	sectionExport    = 7
+	sectionCode      = 10
+	sectionDataCount = 12
+	sectionTag       = 13
+)
+
+// sectionOrder is the position of each known section in a module. Custom
+// sections can be anywhere.
+var sectionOrder = map[byte]int{
+	1:                1,  // type
+	sectionImport:    2,  // import
+	3:                3,  // function
+	4:                4,  // table
+	5:                5,  // memory
+	sectionTag:       6,  // tag
+	sectionGlobal:    7,  // global
+	sectionExport:    8,  // export
+	8:                9,  // start
+	9:                10, // element
+	sectionDataCount: 11, // data count
+	sectionCode:      12, // code
+	11:               13, // data
+}
+
+// kinds of imports and exports
+const (
+	externFunc   = 0
+	externTable  = 1
+	externMemory = 2
+	externGlobal = 3
+	externTag    = 4
+)
+
+// instrument returns the module with gas metering added. The gas global
+// starts at gasLimit, so the start function of the module is also metered.
+func instrument(module []byte, gasLimit int64) ([]byte, error) {
+	if len(module) < 8 || string(module[:4]) != wasmMagic || string(module[4:8]) != wasmVersion {
+		return nil, errors.New("not a WebAssembly 1.0 binary module")
+	}
+
+	type section struct {
+		id      byte
+		content []byte
+	}
+
+	var sections []section
+	r := bytes.NewReader(module[8:])
+	for r.Len() > 0 {
+		id, err := r.ReadByte()
+		if err != nil {
+			return nil, err
+		}
+		content, err := readBytes(r)
+		if err != nil {
+			return nil, fmt.Errorf("section %d: %w", id, err)
+		}
+		if _, ok := sectionOrder[id]; !ok && id != sectionCustom {
+			return nil, fmt.Errorf("unknown section %d", id)
+		}
+		sections = append(sections, section{id: id, content: content})
+	}
+
+	// the new global comes after the imported and defined globals
+	var gasIndex uint32
+	for _, s := range sections {
+		var err error
+		var n uint32
+		switch s.id {
+		case sectionImport:
+			n, err = countImportedGlobals(s.content)
+		case sectionGlobal:
+			n, err = readU32(bytes.NewReader(s.content))
+		}
+		if err != nil {
+			return nil, err
+		}
+		gasIndex += n
+	}
+
+	newGlobal := []byte{0x7E, 0x01, 0x42} // mutable i64 initialized with i64.const
+	newGlobal = appendS64(newGlobal, gasLimit)
+	newGlobal = append(newGlobal, 0x0B)
+
+	newExport := appendName(nil, gasGlobal)
+	newExport = append(newExport, externGlobal)
+	newExport = appendU32(newExport, gasIndex)
+
+	var out bytes.Buffer
+	out.WriteString(wasmMagic)
+	out.WriteString(wasmVersion)
+	writeSection := func(id byte, parts ...[]byte) {
+		content := bytes.Join(parts, nil)
+		out.WriteByte(id)
+		out.Write(appendU32(nil, uint32(len(content))))
+		out.Write(content)
+	}
+
+	wroteGlobal, wroteExport := false, false
+	for _, s := range sections {
+		order := sectionOrder[s.id]
+		if s.id != sectionCustom {
+			if !wroteGlobal && order > sectionOrder[sectionGlobal] {
+				writeSection(sectionGlobal, appendU32(nil, 1), newGlobal)
+				wroteGlobal = true
+			}
+			if !wroteExport && order > sectionOrder[sectionExport] {
+				writeSection(sectionExport, appendU32(nil, 1), newExport)
+				wroteExport = true
+			}
+		}
+
+		switch s.id {
+		case sectionGlobal:
+			content, err := appendToVec(s.content, newGlobal)
+			if err != nil {
+				return nil, err
+			}
+			writeSection(s.id, content)
+			wroteGlobal = true
+		case sectionExport:
+			content, err := appendToVec(s.content, newExport)
+			if err != nil {
+				return nil, err
+			}
+			writeSection(s.id, content)
+			wroteExport = true
+		case sectionCode:
+			content, err := meterCode(s.content, gasIndex)
+			if err != nil {
+				return nil, err
+			}
+			writeSection(s.id, content)
+		default:
+			writeSection(s.id, s.content)
+		}
+	}
+	if !wroteGlobal {
+		writeSection(sectionGlobal, appendU32(nil, 1), newGlobal)
+	}
+	if !wroteExport {
+		writeSection(sectionExport, appendU32(nil, 1), newExport)
+	}
+
+	return out.Bytes(), nil
+}
+
+// countImportedGlobals returns the number of globals in an import section.
+func countImportedGlobals(content []byte) (uint32, error) {
+	r := bytes.NewReader(content)
+	n, err := readU32(r)
+	if err != nil {
+		return 0, err
+	}
+
+	var globals uint32
+	for range n {
+		// module and field names
+		for range 2 {
+			if _, err = readBytes(r); err != nil {
+				return 0, err
+			}
+		}
+
+		kind, err := r.ReadByte()
+		if err != nil {
+			return 0, err
+		}
+		switch kind {
+		case externFunc:
+			_, err = readU32(r)
+		case externTable:
+			if _, err = r.ReadByte(); err == nil { // reference type
+				err = skipLimits(r)
+			}
+		case externMemory:
+			err = skipLimits(r)
+		case externGlobal:
+			globals++
+			_, err = r.Seek(2, io.SeekCurrent) // value type and mutability
+		case externTag:
+			if _, err = r.ReadByte(); err == nil { // attribute
+				_, err = readU32(r)
+			}
+		default:
+			return 0, fmt.Errorf("unknown import kind %d", kind)
+		}
+		if err != nil {
+			return 0, err
+		}
+	}
+
+	return globals, nil
+}
+
+func skipLimits(r *bytes.Reader) error {
+	flags, err := r.ReadByte()
+	if err != nil {
+		return err
+	}
+	if _, err = readU64(r); err != nil {
+		return err
+	}
+	if flags&1 != 0 {
+		_, err = readU64(r)
+	}
+	return err
+}
+
+// appendToVec appends an encoded element to a section that is a vector.
+func appendToVec(content []byte, elem []byte) ([]byte, error) {
+	r := bytes.NewReader(content)
+	n, err := readU32(r)
+	if err != nil {
+		return nil, err
+	}
+
+	res := appendU32(nil, n+1)
+	res = append(res, content[len(content)-r.Len():]...)
+	return append(res, elem...), nil
+}
+
+// meterCode meters every function body in a code section.
+func meterCode(content []byte, gasIndex uint32) ([]byte, error) {
+	r := bytes.NewReader(content)
+	n, err := readU32(r)
+	if err != nil {
+		return nil, err
+	}
+
+	res := appendU32(nil, n)
+	for i := range n {
+		body, err := readBytes(r)
+		if err != nil {
+			return nil, err
+		}
+
+		metered, err := meterFunc(body, gasIndex)
+		if err != nil {
+			return nil, fmt.Errorf("function %d: %w", i, err)
+		}
+
+		res = appendU32(res, uint32(len(metered)))
+		res = append(res, metered...)
+	}
+
+	return res, nil
+}
+
+// meterFunc meters a function body.
+func meterFunc(body []byte, gasIndex uint32) ([]byte, error) {
+	r := bytes.NewReader(body)
+
+	// local declarations are kept as they are
+	n, err := readU32(r)
+	if err != nil {
+		return nil, err
+	}
+	for range n {
+		if _, err = readU32(r); err != nil {
+			return nil, err
+		}
+		if _, err = r.ReadByte(); err != nil {
+			return nil, err
+		}
+	}
+	res := slices.Clip(body[:len(body)-r.Len()])
+
+	// charges are added before the code of each segment
+	segStart, count := len(body)-r.Len(), int64(0)
+	endSegment := func(end int) {
+		if count == 0 {
+			return
+		}
+		res = appendCharge(res, gasIndex, count)
+		res = append(res, body[segStart:end]...)
+		segStart, count = end, 0
+	}
+
+	for r.Len() > 0 {
+		op, err := r.ReadByte()
+		if err != nil {
+			return nil, err
+		}
+		immediates := len(body) - r.Len()
+		if err = skipImmediates(op, r); err != nil {
+			return nil, err
+		}
+		if op == 0x23 || op == 0x24 {
+			// global.get, global.set
+			global, err := readU32(bytes.NewReader(body[immediates:]))
+			if err != nil {
+				return nil, err
+			}
+			if global >= gasIndex {
+				return nil, fmt.Errorf("global %d is not defined by the module", global)
+			}
+		}
+		count++
+
+		switch op {
+		case 0x00, 0x02, 0x03, 0x04, 0x05, 0x0B, 0x0C, 0x0D, 0x0E, 0x0F, 0x10, 0x11:
+			// unreachable, block, loop, if, else, end, br, br_if, br_table,
+			// return, call, call_indirect
+			endSegment(len(body) - r.Len())
+		}
+	}
+	endSegment(len(body))
+
+	return res, nil
+}
+
+// appendCharge appends code that subtracts the cost from the gas global, and
+// traps if the gas is negative.
+func appendCharge(b []byte, gasIndex uint32, cost int64) []byte {
+	b = append(b, 0x23) // global.get
+	b = appendU32(b, gasIndex)
+	b = append(b, 0x42) // i64.const
+	b = appendS64(b, cost)
+	b = append(b, 0x7D, 0x24) // i64.sub, global.set
+	b = appendU32(b, gasIndex)
+	b = append(b, 0x23) // global.get
+	b = appendU32(b, gasIndex)
+	b = append(b, 0x42, 0x00, 0x53)          // i64.const 0, i64.lt_s
+	return append(b, 0x04, 0x40, 0x00, 0x0B) // if, unreachable, end
+}
+
+// skipImmediates reads the immediate arguments of an instruction. Instructions
+// of proposals that are not supported by the runtime, such as SIMD and
+// threads, are rejected.
+func skipImmediates(op byte, r *bytes.Reader) error {
+	var err error
+	switch {
+	case op == 0x00, op == 0x01, op == 0x05, op == 0x0B, op == 0x0F, op == 0x1A, op == 0x1B, op == 0xD1:
+		// no immediates
+	case op >= 0x45 && op <= 0xC4:
+		// numeric instructions, including sign extension
+	case op == 0x02, op == 0x03, op == 0x04:
+		err = skipBlockType(r)
+	case op == 0x0C, op == 0x0D, op == 0x10, op == 0xD2,
+		op >= 0x20 && op <= 0x26, op == 0x3F, op == 0x40:
+		// branches, calls, variables, tables, memory.size, memory.grow, ref.func
+		_, err = readU32(r)
+	case op == 0x0E:
+		var n uint32
+		n, err = readU32(r)
+		for i := uint32(0); err == nil && i <= n; i++ {
+			_, err = readU32(r)
+		}
+	case op == 0x11:
+		if _, err = readU32(r); err == nil {
+			_, err = readU32(r)
+		}
+	case op == 0x1C:
+		var n uint32
+		n, err = readU32(r)
+		if err == nil {
+			_, err = r.Seek(int64(n), io.SeekCurrent)
+		}
+	case op >= 0x28 && op <= 0x3E:
+		err = skipMemArg(r)
+	case op == 0x41:
+		_, err = readS64(r)
+	case op == 0x42:
+		_, err = readS64(r)
+	case op == 0x43:
+		_, err = r.Seek(4, io.SeekCurrent)
+	case op == 0x44:
+		_, err = r.Seek(8, io.SeekCurrent)
+	case op == 0xD0:
+		_, err = r.ReadByte()
+	case op == 0xFC:
+		var sub uint32
+		sub, err = readU32(r)
+		if err != nil {
+			return err
+		}
+		// number of index immediates of each sub-instruction
+		var indexes int
+		switch {
+		case sub <= 7:
+			// saturating truncation
+		case sub == 8, sub == 10, sub == 12, sub == 14:
+			// memory.init, memory.copy, table.init, table.copy
+			indexes = 2
+		case sub <= 17:
+			// data.drop, memory.fill, elem.drop, table.grow, table.size, table.fill
+			indexes = 1
+		default:
+			return fmt.Errorf("unsupported instruction 0xFC %d", sub)
+		}
+		for i := 0; err == nil && i < indexes; i++ {
+			_, err = readU32(r)
+		}
+	default:
+		return fmt.Errorf("unsupported instruction 0x%02X", op)
+	}
+
+	return err
+}
+
+func skipBlockType(r *bytes.Reader) error {
+	b, err := r.ReadByte()
+	if err != nil {
+		return err
+	}
+	switch b {
+	case 0x40, 0x7F, 0x7E, 0x7D, 0x7C, 0x7B, 0x70, 0x6F:
+		// empty or a single value type
+		return nil
+	}
+
+	// otherwise it is a type index, encoded as a signed 33 bit integer
+	if err = r.UnreadByte(); err != nil {
+		return err
+	}
+	_, err = readS64(r)
+	return err
+}
+
+func skipMemArg(r *bytes.Reader) error {
+	align, err := readU32(r)
+	if err != nil {
+		return err
+	}
+	if align&0x40 != 0 {
+		// multiple memories put the memory index after the alignment
+		if _, err = readU32(r); err != nil {
+			return err
+		}
+	}
+	_, err = readU64(r)
+	return err
+}
+
+// LEB128 encoding
+
+func readU64(r *bytes.Reader) (uint64, error) {
+	v, err := binary.ReadUvarint(r)
+	if err == io.EOF {
+		err = io.ErrUnexpectedEOF
+	}
+	return v, err
+}
+
+func readU32(r *bytes.Reader) (uint32, error) {
+	v, err := readU64(r)
+	if err != nil {
+		return 0, err
+	}
+	if v > math.MaxUint32 {
+		return 0, errors.New("integer overflows 32 bits")
+	}
+	return uint32(v), nil
+}
+
+// readS64 reads a signed LEB128 integer. It is also used for the smaller
+// signed integers, since only the length of the encoding matters here.
+func readS64(r *bytes.Reader) (int64, error) {
+	var v int64
+	var shift uint
+	for {
+		b, err := r.ReadByte()
+		if err != nil {
+			return 0, io.ErrUnexpectedEOF
+		}
+		if shift >= 64 {
+			return 0, errors.New("integer overflows 64 bits")
+		}
+		v |= int64(b&0x7F) << shift
+		shift += 7
+		if b&0x80 == 0 {
+			if shift < 64 && b&0x40 != 0 {
+				v |= -1 << shift
+			}
+			return v, nil
+		}
+	}
+}
+
+func readBytes(r *bytes.Reader) ([]byte, error) {
+	n, err := readU32(r)
+	if err != nil {
+		return nil, err
+	}
+	if int(n) > r.Len() {
+		return nil, io.ErrUnexpectedEOF
+	}
+	b := make([]byte, n)
+	_, err = io.ReadFull(r, b)
+	return b, err
+}
+
+func appendU32(b []byte, v uint32) []byte {
+	return binary.AppendUvarint(b, uint64(v))
+}
+
+func appendS64(b []byte, v int64) []byte {
+	for {
+		c := byte(v & 0x7F)
+		v >>= 7
+		if (v == 0 && c&0x40 == 0) || (v == -1 && c&0x40 != 0) {
+			return append(b, c)
+		}
+		b = append(b, c|0x80)
+	}
+}
+
+func appendName(b []byte, name string) []byte {
+	b = appendU32(b, uint32(len(name)))
+	return append(b, name...)
+}
@@ -0,0 +1,240 @@
+package wasm
+
+import (
+	"bytes"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"strings"
+
+	"github.com/trufnetwork/kwil-db/core/types"
+)
+
+// Values are passed to and from modules as JSON:
+//   - int8 is a number
+//   - text, uuid, and numeric are strings
+//   - bytea is a hex string
+//   - bool is a boolean
+//   - arrays are arrays, and null is null
+
+// encodeValue converts a value of the engine to its JSON form.
+func encodeValue(v any) (any, error) {
+	switch v := v.(type) {
+	case nil:
+		return nil, nil
+	case string, int64, bool:
+		return v, nil
+	case []byte:
+		if v == nil {
+			return nil, nil
+		}
+		return hex.EncodeToString(v), nil
+	case *types.UUID:
+		if v == nil {
+			return nil, nil
+		}
+		return v.String(), nil
+	case *types.Decimal:
+		if v == nil {
+			return nil, nil
+		}
+		return v.String(), nil
+	case []*string:
+		return encodeArray(v)
+	case []*int64:
+		return encodeArray(v)
+	case []*bool:
+		return encodeArray(v)
+	case []*types.UUID:
+		return encodeArray(v)
+	case []*types.Decimal:
+		return encodeArray(v)
+	case [][]byte:
+		return encodeArray(v)
+	default:
+		return nil, fmt.Errorf("unsupported value type %T", v)
+	}
+}
+
+func encodeArray[T any](arr []T) (any, error) {
+	if arr == nil {
+		return nil, nil
+	}
+
+	res := make([]any, len(arr))
+	for i, v := range arr {
+		var elem any = v
+		// nil pointers to scalars are null
+		switch v := elem.(type) {
+		case *string:
+			if v != nil {
+				elem = *v
+			} else {
+				elem = nil
+			}
+		case *int64:
+			if v != nil {
+				elem = *v
+			} else {
+				elem = nil
+			}
+		case *bool:
+			if v != nil {
+				elem = *v
+			} else {
+				elem = nil
+			}
+		}
+
+		enc, err := encodeValue(elem)
+		if err != nil {
+			return nil, err
+		}
+		res[i] = enc
+	}
+
+	return res, nil
+}
+
+// decodeValue converts the JSON form of a value to a value of the given type.
+func decodeValue(raw json.RawMessage, dt *types.DataType) (any, error) {
+	if isNull(raw) {
+		return nil, nil
+	}
+
+	if !dt.IsArray {
+		return decodeScalar(raw, dt)
+	}
+
+	var elems []json.RawMessage
+	if err := json.Unmarshal(raw, &elems); err != nil {
+		return nil, fmt.Errorf("expected %s: %w", dt, err)
+	}
+
+	scalar := dt.Copy()
+	scalar.IsArray = false
+	switch {
+	case strings.EqualFold(dt.Name, types.TextType.Name):
+		return decodeArray[string](elems, scalar)
+	case strings.EqualFold(dt.Name, types.IntType.Name):
+		return decodeArray[int64](elems, scalar)
+	case strings.EqualFold(dt.Name, types.BoolType.Name):
+		return decodeArray[bool](elems, scalar)
+	case strings.EqualFold(dt.Name, types.UUIDType.Name):
+		return decodeArray[types.UUID](elems, scalar)
+	case strings.EqualFold(dt.Name, types.NumericStr):
+		return decodeArray[types.Decimal](elems, scalar)
+	case strings.EqualFold(dt.Name, types.ByteaType.Name):
+		res := make([][]byte, len(elems))
+		for i, elem := range elems {
+			v, err := decodeValue(elem, scalar)
+			if err != nil {
+				return nil, err
+			}
+			if v != nil {
+				res[i] = v.([]byte)
+			}
+		}
+		return res, nil
+	default:
+		return nil, fmt.Errorf("unsupported type %s", dt)
+	}
+}
+
+// decodeArray decodes the elements of an array of pointers to T.
+func decodeArray[T any](elems []json.RawMessage, scalar *types.DataType) ([]*T, error) {
+	res := make([]*T, len(elems))
+	for i, elem := range elems {
+		v, err := decodeValue(elem, scalar)
+		if err != nil {
+			return nil, err
+		}
+
+		switch v := v.(type) {
+		case nil:
+		case *T:
+			res[i] = v
+		case T:
+			res[i] = &v
+		default:
+			return nil, fmt.Errorf("internal bug: unexpected %T decoding %s", v, scalar)
+		}
+	}
+
+	return res, nil
+}
+
+func decodeScalar(raw json.RawMessage, dt *types.DataType) (any, error) {
+	switch {
+	case strings.EqualFold(dt.Name, types.IntType.Name):
+		var v int64
+		err := json.Unmarshal(raw, &v)
+		return v, wrapDecodeErr(err, dt)
+	case strings.EqualFold(dt.Name, types.BoolType.Name):
+		var v bool
+		err := json.Unmarshal(raw, &v)
+		return v, wrapDecodeErr(err, dt)
+	}
+
+	var s string
+	if err := json.Unmarshal(raw, &s); err != nil {
+		return nil, wrapDecodeErr(err, dt)
+	}
+
+	switch {
+	case strings.EqualFold(dt.Name, types.TextType.Name):
+		return s, nil
+	case strings.EqualFold(dt.Name, types.ByteaType.Name):
+		b, err := hex.DecodeString(s)
+		return b, wrapDecodeErr(err, dt)
+	case strings.EqualFold(dt.Name, types.UUIDType.Name):
+		u, err := types.ParseUUID(s)
+		return u, wrapDecodeErr(err, dt)
+	case strings.EqualFold(dt.Name, types.NumericStr):
+		if !dt.HasMetadata() {
+			d, err := types.ParseDecimal(s)
+			return d, wrapDecodeErr(err, dt)
+		}
+		d, err := types.ParseDecimalExplicit(s, dt.Metadata[0], dt.Metadata[1])
+		return d, wrapDecodeErr(err, dt)
+	default:
+		return nil, fmt.Errorf("unsupported type %s", dt)
+	}
+}
+
+func wrapDecodeErr(err error, dt *types.DataType) error {
+	if err != nil {
+		return fmt.Errorf("expected %s: %w", dt, err)
+	}
+	return nil
+}
+
+// decodeParam converts the JSON form of a statement parameter, which has no
+// declared type. Integers are int8, other numbers are numeric, and strings are
+// text. Arrays are not supported.
+func decodeParam(raw json.RawMessage) (any, error) {
+	dec := json.NewDecoder(bytes.NewReader(raw))
+	dec.UseNumber()
+
+	var v any
+	if err := dec.Decode(&v); err != nil {
+		return nil, err
+	}
+
+	switch v := v.(type) {
+	case nil, string, bool:
+		return v, nil
+	case json.Number:
+		if i, err := v.Int64(); err == nil {
+			return i, nil
+		}
+		return types.ParseDecimal(v.String())
+	default:
+		return nil, errors.New("parameters must be null, numbers, strings, or booleans")
+	}
+}
+
+func isNull(raw json.RawMessage) bool {
+	return len(raw) == 0 || string(bytes.TrimSpace(raw)) == "null"
+}
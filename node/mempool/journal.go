@@ -0,0 +1,154 @@
+package mempool
+
+import (
+	"bufio"
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"time"
+
+	"github.com/trufnetwork/kwil-db/core/log"
+	ktypes "github.com/trufnetwork/kwil-db/core/types"
+	"github.com/trufnetwork/kwil-db/node/types"
+)
+
+// journal is an append-only file of the transactions accepted into the
+// mempool, from which they are restored when the node restarts. Each record is
+// the time the transaction was received in Unix nanoseconds, the length of the
+// serialized transaction, and the transaction, with little-endian integers.
+//
+// Transactions that leave the mempool are not recorded, so the journal is
+// rewritten with the contents of the mempool once it has twice as many records
+// as the mempool has transactions.
+type journal struct {
+	path    string
+	file    *os.File
+	records int
+	log     log.Logger
+}
+
+const journalHeaderLen = 8 + 4 // received time and length
+
+// journalRecord is a transaction read from the journal.
+type journalRecord struct {
+	tx       *ktypes.Transaction
+	received time.Time
+}
+
+// readJournal reads the records of a journal file, which may not exist. It
+// stops at the first incomplete or invalid record, such as one left by a crash
+// while writing, and returns the records before it with an error.
+func readJournal(path string, maxTxSize int64) ([]*journalRecord, error) {
+	f, err := os.Open(path)
+	if err != nil {
+		if errors.Is(err, os.ErrNotExist) {
+			return nil, nil
+		}
+		return nil, err
+	}
+	defer f.Close()
+
+	r := bufio.NewReader(f)
+	var records []*journalRecord
+	for {
+		var hdr [journalHeaderLen]byte
+		if _, err := io.ReadFull(r, hdr[:]); err != nil {
+			if errors.Is(err, io.EOF) {
+				return records, nil
+			}
+			return records, fmt.Errorf("incomplete journal record %d: %w", len(records), err)
+		}
+		received := time.Unix(0, int64(binary.LittleEndian.Uint64(hdr[:8])))
+		sz := binary.LittleEndian.Uint32(hdr[8:])
+		if int64(sz) > maxTxSize {
+			return records, fmt.Errorf("journal record %d: transaction size %d exceeds the maximum %d",
+				len(records), sz, maxTxSize)
+		}
+
+		raw := make([]byte, sz)
+		if _, err := io.ReadFull(r, raw); err != nil {
+			return records, fmt.Errorf("incomplete journal record %d: %w", len(records), err)
+		}
+		tx := &ktypes.Transaction{}
+		if err := tx.UnmarshalBinary(raw); err != nil {
+			return records, fmt.Errorf("journal record %d: %w", len(records), err)
+		}
+
+		records = append(records, &journalRecord{tx: tx, received: received})
+	}
+}
+
+// openJournal opens a journal file for appending, creating it if needed.
+func openJournal(path string, logger log.Logger) (*journal, error) {
+	f, err := os.OpenFile(path, os.O_WRONLY|os.O_APPEND|os.O_CREATE, 0600)
+	if err != nil {
+		return nil, err
+	}
+	return &journal{
+		path: path,
+		file: f,
+		log:  logger,
+	}, nil
+}
+
+// appendRecord encodes a journal record for a transaction.
+func appendRecord(b []byte, tx *types.Tx, received time.Time) ([]byte, error) {
+	raw, err := tx.MarshalBinary()
+	if err != nil {
+		return nil, err
+	}
+	b = binary.LittleEndian.AppendUint64(b, uint64(received.UnixNano()))
+	b = binary.LittleEndian.AppendUint32(b, uint32(len(raw)))
+	return append(b, raw...), nil
+}
+
+// add appends a transaction to the journal. A failure is only logged, since
+// the journal is not needed for the operation of the mempool.
+func (j *journal) add(tx *types.Tx, received time.Time) {
+	b, err := appendRecord(nil, tx, received)
+	if err == nil {
+		_, err = j.file.Write(b)
+	}
+	if err != nil {
+		j.log.Warn("failed to journal mempool transaction", "tx", tx.Hash(), "error", err)
+		return
+	}
+	j.records++
+}
+
+// rewrite replaces the journal with records of the given transactions.
+func (j *journal) rewrite(txs []*sizedTx) error {
+	var b []byte
+	for _, tx := range txs {
+		var err error
+		b, err = appendRecord(b, tx.Tx, tx.received)
+		if err != nil {
+			return err
+		}
+	}
+
+	tmpPath := j.path + ".tmp"
+	if err := os.WriteFile(tmpPath, b, 0600); err != nil {
+		return err
+	}
+	if err := os.Rename(tmpPath, j.path); err != nil {
+		return err
+	}
+
+	f, err := os.OpenFile(j.path, os.O_WRONLY|os.O_APPEND, 0600)
+	if err != nil {
+		return err
+	}
+	if err := j.file.Close(); err != nil {
+		j.log.Warn("failed to close replaced mempool journal", "error", err)
+	}
+	j.file = f
+	j.records = len(txs)
+	return nil
+}
+
+func (j *journal) close() error {
+	return j.file.Close()
+}
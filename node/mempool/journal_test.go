@@ -0,0 +1,175 @@
+package mempool
+
+import (
+	"context"
+	"errors"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/trufnetwork/kwil-db/core/log"
+	"github.com/trufnetwork/kwil-db/node/types"
+)
+
+func TestMempool_Journal(t *testing.T) {
+	ctx := context.Background()
+
+	accept := func(ctx context.Context, tx *types.Tx) error { return nil }
+
+	t.Run("restored and checked", func(t *testing.T) {
+		path := filepath.Join(t.TempDir(), "mempool.journal")
+
+		mp := New(mempoolSz, maxTxSz)
+		restored, err := mp.LoadJournal(path, time.Hour, log.DiscardLogger)
+		require.NoError(t, err)
+		require.Zero(t, restored)
+
+		txA1, txA2, txB1, txB3 := newFeeTx(1, "A", 10), newFeeTx(2, "A", 10), newFeeTx(1, "B", 20), newTx(3, "B")
+		txC1 := newTx(1, "C")
+		require.NoError(t, mp.Store(txA1))
+		require.NoError(t, mp.Store(txA2))
+		require.NoError(t, mp.Store(txB1))
+		require.NoError(t, mp.StoreFuture(txB3))
+		require.NoError(t, mp.Store(txC1))
+		require.NoError(t, mp.CloseJournal())
+
+		mp = New(mempoolSz, maxTxSz)
+		loaded, err := mp.LoadJournal(path, time.Hour, log.DiscardLogger)
+		require.NoError(t, err)
+		require.Equal(t, 5, loaded)
+		assert.Empty(t, mp.txns) // not until checked
+
+		// A1 was committed before the restart, and C1 fails the check, such as
+		// for an invalid signature
+		errVerify := errors.New("invalid signature")
+		check := nonceCheck(map[string]uint64{"A": 2, "B": 1, "C": 1})
+		restored = mp.RestoreJournal(ctx, func(ctx context.Context, tx *types.Tx) error {
+			if tx.Hash() == txC1.Hash() {
+				return errVerify
+			}
+			return check(ctx, tx)
+		})
+		require.Equal(t, 3, restored)
+
+		assert.Equal(t, []types.Hash{txB1.Hash(), txA2.Hash()}, queueHashes(mp))
+		require.Len(t, mp.future["B"], 1)
+		assert.Equal(t, txB3.Hash(), mp.future["B"][0].Hash())
+		assert.False(t, mp.Have(txA1.Hash()))
+		assert.False(t, mp.Have(txC1.Hash()))
+		assert.Len(t, mp.txns, 3)
+		require.NoError(t, mp.CloseJournal())
+
+		// the journal was rewritten without the transactions not restored
+		records, err := readJournal(path, maxTxSz)
+		require.NoError(t, err)
+		var hashes []types.Hash
+		for _, rec := range records {
+			hashes = append(hashes, rec.tx.Hash())
+		}
+		assert.Equal(t, []types.Hash{txB1.Hash(), txA2.Hash(), txB3.Hash()}, hashes)
+	})
+
+	t.Run("restored in nonce order", func(t *testing.T) {
+		path := filepath.Join(t.TempDir(), "mempool.journal")
+		txA1, txA2 := newTx(1, "A"), newTx(2, "A")
+
+		j, err := openJournal(path, log.DiscardLogger)
+		require.NoError(t, err)
+		require.NoError(t, j.rewrite([]*sizedTx{
+			{Tx: txA2, received: time.Now()},
+			{Tx: txA1, received: time.Now()},
+		}))
+		require.NoError(t, j.close())
+
+		mp := New(mempoolSz, maxTxSz)
+		_, err = mp.LoadJournal(path, 0, log.DiscardLogger)
+		require.NoError(t, err)
+		restored := mp.RestoreJournal(ctx, nonceCheck(map[string]uint64{"A": 1}))
+		require.Equal(t, 2, restored)
+		assert.Equal(t, []types.Hash{txA1.Hash(), txA2.Hash()}, queueHashes(mp))
+		assert.Empty(t, mp.future)
+		require.NoError(t, mp.CloseJournal())
+	})
+
+	t.Run("max age", func(t *testing.T) {
+		path := filepath.Join(t.TempDir(), "mempool.journal")
+		txA1, txB1 := newTx(1, "A"), newTx(1, "B")
+
+		j, err := openJournal(path, log.DiscardLogger)
+		require.NoError(t, err)
+		require.NoError(t, j.rewrite([]*sizedTx{
+			{Tx: txA1, received: time.Now().Add(-2 * time.Hour)},
+			{Tx: txB1, received: time.Now().Add(-time.Minute)},
+		}))
+		require.NoError(t, j.close())
+
+		mp := New(mempoolSz, maxTxSz)
+		loaded, err := mp.LoadJournal(path, time.Hour, log.DiscardLogger)
+		require.NoError(t, err)
+		require.Equal(t, 1, loaded)
+		require.Equal(t, 1, mp.RestoreJournal(ctx, accept))
+		assert.False(t, mp.Have(txA1.Hash()))
+		assert.True(t, mp.Have(txB1.Hash()))
+		require.NoError(t, mp.CloseJournal())
+
+		// the journal was rewritten without the expired transaction
+		records, err := readJournal(path, maxTxSz)
+		require.NoError(t, err)
+		require.Len(t, records, 1)
+		assert.Equal(t, txB1.Hash(), records[0].tx.Hash())
+	})
+
+	t.Run("incomplete record", func(t *testing.T) {
+		path := filepath.Join(t.TempDir(), "mempool.journal")
+		txA1, txA2 := newTx(1, "A"), newTx(2, "A")
+
+		mp := New(mempoolSz, maxTxSz)
+		_, err := mp.LoadJournal(path, 0, log.DiscardLogger)
+		require.NoError(t, err)
+		require.NoError(t, mp.Store(txA1))
+		require.NoError(t, mp.Store(txA2))
+		require.NoError(t, mp.CloseJournal())
+
+		// a crash while writing the last record
+		fi, err := os.Stat(path)
+		require.NoError(t, err)
+		require.NoError(t, os.Truncate(path, fi.Size()-5))
+
+		mp = New(mempoolSz, maxTxSz)
+		loaded, err := mp.LoadJournal(path, 0, log.DiscardLogger)
+		require.NoError(t, err)
+		require.Equal(t, 1, loaded)
+		require.Equal(t, 1, mp.RestoreJournal(ctx, accept))
+		assert.True(t, mp.Have(txA1.Hash()))
+		require.NoError(t, mp.CloseJournal())
+	})
+
+	t.Run("rewritten on recheck", func(t *testing.T) {
+		path := filepath.Join(t.TempDir(), "mempool.journal")
+
+		mp := New(mempoolSz, maxTxSz)
+		_, err := mp.LoadJournal(path, 0, log.DiscardLogger)
+		require.NoError(t, err)
+		txs := []*types.Tx{newTx(1, "A"), newTx(1, "B"), newTx(1, "C")}
+		for _, tx := range txs {
+			require.NoError(t, mp.Store(tx))
+		}
+		assert.Equal(t, 3, mp.journal.records)
+
+		// committed
+		mp.Remove(txs[0].Hash())
+		mp.Remove(txs[1].Hash())
+		mp.RecheckTxs(ctx, accept)
+		assert.Equal(t, 1, mp.journal.records)
+		require.NoError(t, mp.CloseJournal())
+
+		records, err := readJournal(path, maxTxSz)
+		require.NoError(t, err)
+		require.Len(t, records, 1)
+		assert.Equal(t, txs[2].Hash(), records[0].tx.Hash())
+	})
+}
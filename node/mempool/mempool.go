@@ -1,32 +1,72 @@
 package mempool
 
 import (
+	"bytes"
+	"cmp"
 	"context"
+	"errors"
+	"fmt"
+	"maps"
+	"math/big"
 	"slices"
 	"sync"
+	"time"
 
+	"github.com/trufnetwork/kwil-db/core/log"
 	ktypes "github.com/trufnetwork/kwil-db/core/types"
 	"github.com/trufnetwork/kwil-db/node/types"
 )
 
 // Mempool maintains a thread-safe pool of unconfirmed transactions with size limits.
+//
+// Besides the queue of pending transactions, which may be included in a block,
+// the mempool holds the future-nonce transactions of each sender, which have a
+// nonce gap with the sender's account. These are promoted to the queue once the
+// gap is filled. See [Mempool.StoreFuture] and [Mempool.PromoteFuture].
 type Mempool struct {
 	mtx         sync.RWMutex
-	txns        map[types.Hash]*sizedTx
-	txQ         []*types.Tx
+	txns        map[types.Hash]*sizedTx // pending and future
+	txQ         []*types.Tx             // by fee, and nonce for each sender
+	future      map[string][]*types.Tx  // by sender, in nonce order
+	senders     map[string]*senderUsage // pending and future
 	fetching    map[types.Hash]bool
 	currentSize int64 // bytes
+	futureSize  int64 // bytes
+	seq         uint64
 
 	maxSize int64 // bytes
 
 	// maximum allowed transaction size in bytes
 	// Ensure that this value is less than the maximum block size.
 	maxTxSize int64 // bytes
+
+	// maximum number and total size of the transactions of any one sender,
+	// including future-nonce transactions, with zero meaning no limit
+	maxSenderTxs  int
+	maxSenderSize int64 // bytes
+
+	// maximum age of future-nonce transactions, which are removed when the
+	// mempool is rechecked, with zero meaning no limit
+	maxFutureAge time.Duration
+
+	journal   *journal         // nil if not journaling
+	journaled []*journalRecord // loaded, but not yet restored
+
+	evictFn EvictFn // nil if not set
 }
 
 type sizedTx struct {
 	*types.Tx
-	size int64
+	size     int64
+	future   bool
+	seq      uint64 // order received
+	received time.Time
+}
+
+// senderUsage is the number and total size of the transactions of a sender.
+type senderUsage struct {
+	count int
+	size  int64
 }
 
 // New creates a new Mempool instance with a default max size of 200MB.
@@ -34,6 +74,8 @@ type sizedTx struct {
 func New(sz, txSz int64) *Mempool {
 	return &Mempool{
 		txns:      make(map[types.Hash]*sizedTx),
+		future:    make(map[string][]*types.Tx),
+		senders:   make(map[string]*senderUsage),
 		fetching:  make(map[types.Hash]bool),
 		maxSize:   sz,
 		maxTxSize: txSz,
@@ -54,6 +96,41 @@ func (mp *Mempool) SetMaxTxSize(maxBytes int64) {
 	mp.maxTxSize = maxBytes
 }
 
+// SetSenderLimits updates the maximum number and total size in bytes of the
+// transactions of any one sender, including its future-nonce transactions. A
+// limit of zero means no limit.
+func (mp *Mempool) SetSenderLimits(maxTxs int, maxBytes int64) {
+	mp.mtx.Lock()
+	defer mp.mtx.Unlock()
+	mp.maxSenderTxs = maxTxs
+	mp.maxSenderSize = maxBytes
+}
+
+// SetMaxFutureAge updates the maximum age of the future-nonce transactions,
+// which are removed once they are older when the mempool is rechecked. A limit
+// of zero means no limit.
+func (mp *Mempool) SetMaxFutureAge(maxAge time.Duration) {
+	mp.mtx.Lock()
+	defer mp.mtx.Unlock()
+	mp.maxFutureAge = maxAge
+}
+
+// EvictFn is a function type that is called with each pending transaction that
+// is evicted from the mempool to make room for another.
+type EvictFn func(tx *types.Tx)
+
+// SetEvictFn sets the function that is called with each pending transaction
+// that is evicted, so that the state the application keeps for pending
+// transactions, such as account nonces and balances, can be rolled back. It is
+// called with the mempool locked, and must not call back into the mempool.
+// Future-nonce transactions are not reported, since they are not yet checked
+// against that state.
+func (mp *Mempool) SetEvictFn(fn EvictFn) {
+	mp.mtx.Lock()
+	defer mp.mtx.Unlock()
+	mp.evictFn = fn
+}
+
 // CapMaxTxSize updates the maximum allowed transaction size based on the
 // network parameter maxBlockSize.
 func (mp *Mempool) CapMaxTxSize(maxBlockSize int64) {
@@ -83,9 +160,18 @@ func (mp *Mempool) remove(txid types.Hash) {
 	if !have {
 		return
 	}
-	mp.currentSize -= tx.size
-
-	delete(mp.txns, txid)
+	mp.release(tx)
+
+	if tx.future {
+		sender := string(tx.Sender)
+		mp.future[sender] = slices.DeleteFunc(mp.future[sender], func(a *types.Tx) bool {
+			return a.Hash() == txid
+		})
+		if len(mp.future[sender]) == 0 {
+			delete(mp.future, sender)
+		}
+		return
+	}
 
 	idx := slices.IndexFunc(mp.txQ, func(a *types.Tx) bool {
 		return a.Hash() == txid
@@ -95,41 +181,384 @@ func (mp *Mempool) remove(txid types.Hash) {
 	} // else there's a bug!
 }
 
+// add adds a transaction to the pending queue, or to the future-nonce
+// transactions of its sender.
+func (mp *Mempool) add(tx *types.Tx, sz int64, future bool, received time.Time) {
+	mp.seq++
+	mp.txns[tx.Hash()] = &sizedTx{
+		Tx:       tx,
+		size:     sz,
+		future:   future,
+		seq:      mp.seq,
+		received: received,
+	}
+
+	usage := mp.senders[string(tx.Sender)]
+	if usage == nil {
+		usage = &senderUsage{}
+		mp.senders[string(tx.Sender)] = usage
+	}
+	usage.count++
+	usage.size += sz
+
+	if future {
+		mp.futureSize += sz
+		txs := mp.future[string(tx.Sender)]
+		idx, _ := slices.BinarySearchFunc(txs, tx.Body.Nonce, func(a *types.Tx, nonce uint64) int {
+			return cmp.Compare(a.Body.Nonce, nonce)
+		})
+		mp.future[string(tx.Sender)] = slices.Insert(txs, idx, tx)
+		return
+	}
+
+	mp.currentSize += sz
+	mp.txQ = slices.Insert(mp.txQ, mp.insertIdx(tx, txFee(tx)), tx)
+}
+
+// release deletes a transaction from the transaction index and the sizes, but
+// not from the pending queue or the future-nonce transactions.
+func (mp *Mempool) release(tx *sizedTx) {
+	delete(mp.txns, tx.Hash())
+	if tx.future {
+		mp.futureSize -= tx.size
+	} else {
+		mp.currentSize -= tx.size
+	}
+
+	usage := mp.senders[string(tx.Sender)]
+	if usage == nil {
+		return // bug, don't crash
+	}
+	usage.count--
+	usage.size -= tx.size
+	if usage.count <= 0 {
+		delete(mp.senders, string(tx.Sender))
+	}
+}
+
 // Store adds a transaction to the mempool. It returns an error if the transaction
 // cannot be stored, such as if the transaction already exists, exceeds the maximum
 // allowed transaction size,or if the mempool is full.
 // To remove a transaction, use [Remove]; this will panic with a nil pointer.
+//
+// Transactions are queued in order of their fee, highest first, while the
+// transactions of each sender are kept in nonce order. A transaction with the
+// same sender and nonce as one in the mempool replaces it if its fee is higher.
+// If the mempool is full, the lowest-fee transactions are evicted to make room
+// for a transaction with a higher fee.
+//
+// The transaction should already pass the checks of the application. Use
+// [CheckAndStore] to check it before anything is replaced or evicted.
 func (mp *Mempool) Store(tx *types.Tx) error {
+	return mp.CheckAndStore(context.Background(), tx, nil)
+}
+
+// CheckAndStore adds a transaction to the mempool as with [Store], but only if
+// it passes the check function, which is called once the transaction is known
+// to fit in the mempool, but before any transaction is replaced or evicted. If
+// the check fails, its error is returned and the mempool is unchanged. A nil
+// check function accepts every transaction.
+//
+// If the check fails with [types.ErrFutureNonce], the transaction is stored
+// among the future-nonce transactions of its sender as with [StoreFuture], so
+// it can only evict other future-nonce transactions. The check function must
+// verify the transaction before it reports a nonce gap, since future-nonce
+// transactions are only checked for their nonce when they are promoted.
+func (mp *Mempool) CheckAndStore(ctx context.Context, tx *types.Tx, fn CheckFn) error {
 	mp.mtx.Lock()
 	defer mp.mtx.Unlock()
+	return mp.checkAndStore(ctx, tx, fn, time.Now())
+}
+
+func (mp *Mempool) checkAndStore(ctx context.Context, tx *types.Tx, fn CheckFn, received time.Time) error {
+	var replaced *sizedTx
+	if idx := mp.senderNonceIdx(tx.Sender, tx.Body.Nonce); idx != -1 {
+		replaced = mp.txns[mp.txQ[idx].Hash()]
+	}
+
+	sz, evict, err := mp.prepare(tx, replaced, false)
+	if err != nil {
+		return err
+	}
+	if fn != nil {
+		err := fn(ctx, tx)
+		if errors.Is(err, types.ErrFutureNonce) {
+			return mp.storeFuture(tx, received)
+		}
+		if err != nil {
+			return err
+		}
+	}
+
+	mp.insert(tx, sz, replaced, evict, false, received)
+	mp.journalTx(tx)
+	return nil
+}
+
+// StoreFuture adds a transaction with a nonce gap to the future-nonce
+// transactions of its sender, which are not included in blocks until they are
+// promoted with [PromoteFuture]. It returns an error if the transaction cannot
+// be stored for the same reasons as [Store]. Future-nonce transactions are
+// evicted before pending ones, and can only evict other future-nonce
+// transactions.
+func (mp *Mempool) StoreFuture(tx *types.Tx) error {
+	mp.mtx.Lock()
+	defer mp.mtx.Unlock()
+	return mp.storeFuture(tx, time.Now())
+}
 
+func (mp *Mempool) storeFuture(tx *types.Tx, received time.Time) error {
+	var replaced *sizedTx
+	for _, a := range mp.future[string(tx.Sender)] {
+		if a.Body.Nonce == tx.Body.Nonce {
+			replaced = mp.txns[a.Hash()]
+			break
+		}
+	}
+
+	if err := mp.store(tx, replaced, true, received); err != nil {
+		return err
+	}
+	mp.journalTx(tx)
+	return nil
+}
+
+func (mp *Mempool) store(tx *types.Tx, replaced *sizedTx, future bool, received time.Time) error {
+	sz, evict, err := mp.prepare(tx, replaced, future)
+	if err != nil {
+		return err
+	}
+	mp.insert(tx, sz, replaced, evict, future, received)
+	return nil
+}
+
+// prepare checks that a transaction can be stored in place of the transaction
+// it replaces, if any, without changing the mempool. It returns the size of the
+// transaction and the transactions to evict to make room for it.
+func (mp *Mempool) prepare(tx *types.Tx, replaced *sizedTx, future bool) (int64, []types.Hash, error) {
 	txid := tx.Hash()
 	delete(mp.fetching, txid)
 
 	if _, ok := mp.txns[txid]; ok {
-		return ktypes.ErrTxAlreadyExists // already have it
+		return 0, nil, ktypes.ErrTxAlreadyExists // already have it
 	}
 
 	sz := tx.SerializeSize()
 
 	if sz > mp.maxTxSize {
-		return ktypes.ErrTxTooLarge // too big
+		return 0, nil, ktypes.ErrTxTooLarge // too big
 	}
 
-	if mp.currentSize+sz > mp.maxSize {
-		return ktypes.ErrMempoolFull // full
+	fee := txFee(tx)
+
+	// A transaction with the same sender and nonce may only be replaced by one
+	// with a higher fee.
+	if replaced != nil {
+		if oldFee := txFee(replaced.Tx); fee.Cmp(oldFee) <= 0 {
+			return 0, nil, fmt.Errorf("%w: replacement fee %s must be greater than the fee %s of the pending transaction",
+				ktypes.ErrInsufficientFee, fee, oldFee)
+		}
 	}
 
-	mp.currentSize += sz
+	if err := mp.checkSenderLimits(tx.Sender, sz, replaced); err != nil {
+		return 0, nil, err
+	}
 
-	mp.txns[txid] = &sizedTx{
-		Tx:   tx,
-		size: sz,
+	freed := int64(0)
+	if replaced != nil {
+		freed = replaced.size
+	}
+	var evict []types.Hash
+	if need := mp.currentSize + mp.futureSize - freed + sz - mp.maxSize; need > 0 {
+		var ok bool
+		evict, ok = mp.evictable(tx, fee, need, future)
+		if !ok {
+			return 0, nil, ktypes.ErrMempoolFull // full
+		}
+	}
+
+	return sz, evict, nil
+}
+
+// insert adds a prepared transaction to the mempool, removing the transaction
+// it replaces and the transactions to evict.
+func (mp *Mempool) insert(tx *types.Tx, sz int64, replaced *sizedTx, evict []types.Hash, future bool, received time.Time) {
+	if replaced != nil {
+		mp.remove(replaced.Hash())
+	}
+	for _, txid := range evict {
+		evicted := mp.txns[txid]
+		mp.remove(txid)
+		if evicted != nil && !evicted.future && mp.evictFn != nil {
+			mp.evictFn(evicted.Tx)
+		}
+	}
+
+	mp.add(tx, sz, future, received)
+}
+
+// checkSenderLimits checks that a sender may add a transaction of the given
+// size to the mempool, in place of the transaction it replaces, if any.
+func (mp *Mempool) checkSenderLimits(sender []byte, sz int64, replaced *sizedTx) error {
+	count, size := 1, sz
+	if usage := mp.senders[string(sender)]; usage != nil {
+		count += usage.count
+		size += usage.size
+	}
+	if replaced != nil {
+		count--
+		size -= replaced.size
+	}
+
+	if mp.maxSenderTxs > 0 && count > mp.maxSenderTxs {
+		return fmt.Errorf("%w: sender may have at most %d transactions in the mempool",
+			ktypes.ErrMempoolFull, mp.maxSenderTxs)
+	}
+	if mp.maxSenderSize > 0 && size > mp.maxSenderSize {
+		return fmt.Errorf("%w: sender may have at most %d bytes of transactions in the mempool",
+			ktypes.ErrMempoolFull, mp.maxSenderSize)
 	}
-	mp.txQ = append(mp.txQ, tx)
 	return nil
 }
 
+// txFee returns the fee of a transaction, which is zero if it is not set.
+func txFee(tx *types.Tx) *big.Int {
+	if tx.Body.Fee == nil {
+		return big.NewInt(0)
+	}
+	return tx.Body.Fee
+}
+
+// senderNonceIdx returns the index in the queue of the transaction with the
+// given sender and nonce, or -1 if there is none.
+func (mp *Mempool) senderNonceIdx(sender []byte, nonce uint64) int {
+	return slices.IndexFunc(mp.txQ, func(a *types.Tx) bool {
+		return a.Body.Nonce == nonce && bytes.Equal(a.Sender, sender)
+	})
+}
+
+// insertIdx returns the index in the queue at which to insert a transaction.
+// This is before the first transaction with a lower fee, so transactions with
+// equal fees are kept in the order they were received, but after the sender's
+// transactions with lower nonces and before those with higher nonces.
+func (mp *Mempool) insertIdx(tx *types.Tx, fee *big.Int) int {
+	lo, hi := 0, len(mp.txQ)
+	for i, a := range mp.txQ {
+		if !bytes.Equal(a.Sender, tx.Sender) {
+			continue
+		}
+		if a.Body.Nonce < tx.Body.Nonce {
+			lo = i + 1
+		} else if hi == len(mp.txQ) {
+			hi = i
+		}
+	}
+	if hi < lo { // the sender's transactions are out of nonce order
+		hi = lo
+	}
+
+	for i := lo; i < hi; i++ {
+		if txFee(mp.txQ[i]).Cmp(fee) < 0 {
+			return i
+		}
+	}
+	return hi
+}
+
+// evictable returns the transactions to evict to free at least need bytes for
+// a transaction with the given fee, or false if that is not possible. Only the
+// last transactions of other senders, with fees lower than the transaction's,
+// are evicted, so no sender is left with a nonce gap. Future-nonce transactions
+// are evicted first, and a future-nonce transaction may only evict those. Then
+// the lowest fees are evicted first, and of equal fees, the last received.
+func (mp *Mempool) evictable(tx *types.Tx, fee *big.Int, need int64, future bool) ([]types.Hash, bool) {
+	// the pending and future-nonce transactions of each other sender, in nonce
+	// order
+	type senderTxs struct {
+		pending, future []*types.Tx
+	}
+	senders := make(map[string]*senderTxs)
+	get := func(sender string) *senderTxs {
+		txs := senders[sender]
+		if txs == nil {
+			txs = &senderTxs{}
+			senders[sender] = txs
+		}
+		return txs
+	}
+	for sender, txs := range mp.future {
+		if sender != string(tx.Sender) {
+			get(sender).future = txs
+		}
+	}
+	if !future {
+		for _, a := range mp.txQ {
+			if !bytes.Equal(a.Sender, tx.Sender) {
+				txs := get(string(a.Sender))
+				txs.pending = append(txs.pending, a)
+			}
+		}
+	}
+
+	var evict []types.Hash
+	for need > 0 {
+		var victim *sizedTx
+		var victimTxs *senderTxs
+		for _, txs := range senders {
+			var last *types.Tx
+			if n := len(txs.future); n > 0 {
+				last = txs.future[n-1]
+			} else if n := len(txs.pending); n > 0 {
+				last = txs.pending[n-1]
+			} else {
+				continue
+			}
+			if txFee(last).Cmp(fee) >= 0 {
+				continue
+			}
+			if stx := mp.txns[last.Hash()]; victim == nil || evictBefore(stx, victim) {
+				victim, victimTxs = stx, txs
+			}
+		}
+		if victim == nil {
+			return nil, false
+		}
+
+		evict = append(evict, victim.Hash())
+		need -= victim.size
+		if victim.future {
+			victimTxs.future = victimTxs.future[:len(victimTxs.future)-1]
+		} else {
+			victimTxs.pending = victimTxs.pending[:len(victimTxs.pending)-1]
+		}
+	}
+
+	return evict, true
+}
+
+// evictBefore says if a transaction should be evicted before another.
+func evictBefore(a, b *sizedTx) bool {
+	if a.future != b.future {
+		return a.future
+	}
+	if c := txFee(a.Tx).Cmp(txFee(b.Tx)); c != 0 {
+		return c < 0
+	}
+	return a.seq > b.seq
+}
+
+// GetByNonce retrieves the pending transaction with the given sender and
+// nonce, returns nil if not found.
+func (mp *Mempool) GetByNonce(sender []byte, nonce uint64) *types.Tx {
+	mp.mtx.RLock()
+	defer mp.mtx.RUnlock()
+	idx := mp.senderNonceIdx(sender, nonce)
+	if idx == -1 {
+		return nil
+	}
+	return mp.txQ[idx]
+}
+
 // PreFetch marks a transaction as being fetched. Returns true if the tx should be fetched.
 // Always defer the returned "done" function if true is returned.
 func (mp *Mempool) PreFetch(txid types.Hash) (bool, func()) { // probably make node business
@@ -151,7 +580,8 @@ func (mp *Mempool) PreFetch(txid types.Hash) (bool, func()) { // probably make n
 	} // go get it
 }
 
-// Size returns the current total size in bytes and number of transactions in the mempool.
+// Size returns the current total size in bytes and number of pending
+// transactions in the mempool, which excludes future-nonce transactions.
 func (mp *Mempool) Size() (totalBytes, numTxns int) {
 	mp.mtx.RLock()
 	defer mp.mtx.RUnlock()
@@ -169,7 +599,8 @@ func (mp *Mempool) Get(txid types.Hash) *types.Tx {
 	return tx.Tx
 }
 
-// ReapN removes and returns up to n transactions from the front of the queue.
+// ReapN removes and returns up to n transactions from the front of the queue,
+// which are those with the highest fees.
 func (mp *Mempool) ReapN(n int) []*types.Tx {
 	mp.mtx.Lock()
 	defer mp.mtx.Unlock()
@@ -181,19 +612,19 @@ func (mp *Mempool) ReapN(n int) []*types.Tx {
 		if szTx == nil {
 			continue // bug, don't crash
 		}
-		mp.currentSize -= szTx.size
-		delete(mp.txns, tx.Hash())
+		mp.release(szTx)
 	}
 	return txns
 }
 
-// PeekN returns up to n transactions from the front of the queue without
-// removing them, the number of transactions returned may be less than n if the
-// total size in bytes of the transactions exceeds szLimit.
+// PeekN returns up to n transactions from the front of the queue, which are
+// those with the highest fees, without removing them, the number of
+// transactions returned may be less than n if the total size in bytes of the
+// transactions exceeds szLimit.
 func (mp *Mempool) PeekN(n, szLimit int) []*types.Tx {
 	mp.mtx.RLock()
 	defer mp.mtx.RUnlock()
-	n = min(n, len(mp.txns))
+	n = min(n, len(mp.txQ))
 	var totalPickedSz int
 	txns := make([]*types.Tx, 0, n)
 	for _, tx := range mp.txQ[:n] {
@@ -214,7 +645,11 @@ type CheckFn func(ctx context.Context, tx *types.Tx) error
 
 // RecheckTxs validates all transactions in the mempool using the provided check
 // function, removing any that fail validation. This function will check the
-// transaction queue in order.
+// transaction queue in order. Pending transactions that fail with
+// [types.ErrFutureNonce] are moved back to the future-nonce transactions of
+// their sender. Then the future-nonce transactions older than the maximum age
+// are removed, and the rest are checked, promoting those that pass as with
+// [PromoteFuture] and removing those that fail for any reason but a nonce gap.
 func (mp *Mempool) RecheckTxs(ctx context.Context, fn CheckFn) {
 	mp.mtx.Lock()
 	defer mp.mtx.Unlock()
@@ -224,6 +659,7 @@ func (mp *Mempool) RecheckTxs(ctx context.Context, fn CheckFn) {
 		txid types.Hash
 	}
 	var toRemove []indexedTx
+	var toFuture []*sizedTx
 	for idx, tx := range mp.txQ { // must check in order
 		// remove transactions that don't pass the maxBlockSize check
 		rawTx, ok := mp.txns[tx.Hash()]
@@ -237,25 +673,104 @@ func (mp *Mempool) RecheckTxs(ctx context.Context, fn CheckFn) {
 
 		if err := fn(ctx, tx); err != nil {
 			toRemove = append(toRemove, indexedTx{idx: idx, txid: tx.Hash()})
+			if errors.Is(err, types.ErrFutureNonce) {
+				toFuture = append(toFuture, rawTx)
+			}
 		}
 	}
 
-	if len(toRemove) == 0 {
-		return
-	}
-
 	// Remove in reverse order to avoid shifting indices in the txQ slice.
 	slices.Reverse(toRemove)
 
 	for _, itx := range toRemove {
 		tx, have := mp.txns[itx.txid]
 		if have { // we should!
-			mp.currentSize -= tx.size
+			mp.release(tx)
 		}
-		delete(mp.txns, itx.txid)
 
 		mp.txQ = slices.Delete(mp.txQ, itx.idx, itx.idx+1) // remove txQ[idx]
 	}
+
+	// A pending transaction has a nonce gap again if one with a lower nonce was
+	// removed, and waits for it to be filled as a future-nonce transaction,
+	// unless the sender already has one with the same nonce.
+	for _, tx := range toFuture {
+		if slices.ContainsFunc(mp.future[string(tx.Sender)], func(a *types.Tx) bool {
+			return a.Body.Nonce == tx.Body.Nonce
+		}) {
+			continue
+		}
+		mp.add(tx.Tx, tx.size, true, tx.received)
+	}
+
+	if mp.maxFutureAge > 0 {
+		now := time.Now()
+		var expired []types.Hash
+		for txid, tx := range mp.txns {
+			if tx.future && now.Sub(tx.received) > mp.maxFutureAge {
+				expired = append(expired, txid)
+			}
+		}
+		for _, txid := range expired {
+			mp.remove(txid)
+		}
+	}
+
+	// The committed transactions may have filled the nonce gaps of future-nonce
+	// transactions, and the others may no longer be valid.
+	for _, sender := range slices.Sorted(maps.Keys(mp.future)) {
+		mp.promote(ctx, sender, fn, true)
+	}
+
+	if mp.journal != nil && mp.journal.records > 2*len(mp.txns) {
+		if err := mp.journal.rewrite(mp.contents()); err != nil {
+			mp.journal.log.Warn("failed to rewrite mempool journal", "error", err)
+		}
+	}
+}
+
+// PromoteFuture moves the future-nonce transactions of a sender to the pending
+// queue in nonce order, for as long as they pass the check function. The check
+// function fails with [types.ErrFutureNonce] while there is still a nonce gap,
+// and those transactions remain queued. Transactions that fail the check for
+// any other reason are removed.
+func (mp *Mempool) PromoteFuture(ctx context.Context, sender []byte, fn CheckFn) {
+	mp.mtx.Lock()
+	defer mp.mtx.Unlock()
+	mp.promote(ctx, string(sender), fn, false)
+}
+
+// promote promotes the future-nonce transactions of a sender as described for
+// [PromoteFuture]. If all is set, the transactions after the first with a
+// nonce gap are checked as well, and removed if they fail for another reason,
+// such as having expired.
+func (mp *Mempool) promote(ctx context.Context, sender string, fn CheckFn, all bool) {
+	for i := 0; i < len(mp.future[sender]); {
+		tx := mp.future[sender][i]
+		szTx := mp.txns[tx.Hash()]
+		if szTx == nil {
+			return // bug, don't loop forever
+		}
+
+		// a pending transaction with the same nonce takes precedence
+		if mp.senderNonceIdx(tx.Sender, tx.Body.Nonce) != -1 || szTx.size > mp.maxTxSize {
+			mp.remove(tx.Hash())
+			continue
+		}
+
+		err := fn(ctx, tx)
+		if errors.Is(err, types.ErrFutureNonce) {
+			if !all {
+				return
+			}
+			i++
+			continue
+		}
+		mp.remove(tx.Hash())
+		if err == nil {
+			mp.add(tx, szTx.size, false, szTx.received)
+		}
+	}
 }
 
 // TxsAvailable returns true if there are any transactions in the mempool.
@@ -264,3 +779,114 @@ func (mp *Mempool) TxsAvailable() bool {
 	defer mp.mtx.RUnlock()
 	return len(mp.txQ) > 0
 }
+
+// LoadJournal loads the transactions in the journal file at the given path
+// that were received within maxAge, or of any age if maxAge is zero, and then
+// journals the transactions stored in the mempool to the file until
+// [CloseJournal]. It returns the number of transactions loaded.
+//
+// The loaded transactions are not in the mempool until [RestoreJournal] checks
+// them. An incomplete or invalid record at the end of the journal is logged and
+// ignored.
+func (mp *Mempool) LoadJournal(path string, maxAge time.Duration, logger log.Logger) (int, error) {
+	mp.mtx.Lock()
+	defer mp.mtx.Unlock()
+
+	if mp.journal != nil {
+		return 0, errors.New("mempool journal already loaded")
+	}
+
+	records, err := readJournal(path, mp.maxTxSize)
+	if err != nil {
+		logger.Warn("ignoring invalid mempool journal records", "path", path, "error", err)
+	}
+
+	now := time.Now()
+	records = slices.DeleteFunc(records, func(rec *journalRecord) bool {
+		return maxAge > 0 && now.Sub(rec.received) > maxAge
+	})
+
+	j, err := openJournal(path, logger)
+	if err != nil {
+		return 0, err
+	}
+	mp.journal = j
+	mp.journaled = records
+
+	return len(records), nil
+}
+
+// RestoreJournal adds the transactions loaded by [LoadJournal] to the mempool
+// in the order they were journaled, for those that pass the check function, as
+// with [CheckAndStore]. The check function must fully check the transactions,
+// including their signatures, since they were read from a file. The journal is
+// then rewritten without the transactions that were not restored. It returns
+// the number of transactions restored.
+func (mp *Mempool) RestoreJournal(ctx context.Context, fn CheckFn) int {
+	mp.mtx.Lock()
+	defer mp.mtx.Unlock()
+
+	if mp.journal == nil {
+		return 0
+	}
+
+	var restored int
+	for _, rec := range mp.journaled {
+		tx := types.NewTx(rec.tx)
+		if err := mp.checkAndStore(ctx, tx, fn, rec.received); err != nil {
+			mp.journal.log.Debug("not restoring journaled transaction", "tx", tx.Hash(), "error", err)
+			continue
+		}
+		restored++
+	}
+	mp.journaled = nil
+
+	// A transaction may have been journaled before the one that fills its
+	// nonce gap.
+	for _, sender := range slices.Sorted(maps.Keys(mp.future)) {
+		mp.promote(ctx, sender, fn, false)
+	}
+
+	if err := mp.journal.rewrite(mp.contents()); err != nil {
+		mp.journal.log.Warn("failed to rewrite mempool journal", "error", err)
+	}
+
+	return restored
+}
+
+// CloseJournal stops journaling the transactions stored in the mempool.
+func (mp *Mempool) CloseJournal() error {
+	mp.mtx.Lock()
+	defer mp.mtx.Unlock()
+
+	if mp.journal == nil {
+		return nil
+	}
+	err := mp.journal.close()
+	mp.journal = nil
+	mp.journaled = nil
+	return err
+}
+
+// journalTx journals a transaction stored in the mempool, if journaling.
+func (mp *Mempool) journalTx(tx *types.Tx) {
+	if mp.journal == nil {
+		return
+	}
+	mp.journal.add(tx, mp.txns[tx.Hash()].received)
+}
+
+// contents returns the transactions in the mempool, pending in queue order,
+// then future-nonce by sender, in nonce order.
+func (mp *Mempool) contents() []*sizedTx {
+	txs := make([]*sizedTx, 0, len(mp.txns))
+	for _, tx := range mp.txQ {
+		txs = append(txs, mp.txns[tx.Hash()])
+	}
+	for _, sender := range slices.Sorted(maps.Keys(mp.future)) {
+		for _, tx := range mp.future[sender] {
+			txs = append(txs, mp.txns[tx.Hash()])
+		}
+	}
+	return txs
+}
@@ -6,6 +6,7 @@ import (
 	"math/big"
 	"strings"
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
@@ -462,3 +463,419 @@ func TestMempool_PeekN(t *testing.T) {
 		assert.Empty(t, txns)
 	})
 }
+
+func newFeeTx(nonce uint64, sender string, fee int64) *types.Tx {
+	tx := newTx(nonce, sender)
+	tx.Body.Fee = big.NewInt(fee)
+	return types.NewTx(tx.Transaction)
+}
+
+func queueHashes(mp *Mempool) []types.Hash {
+	hashes := make([]types.Hash, len(mp.txQ))
+	for i, tx := range mp.txQ {
+		hashes[i] = tx.Hash()
+	}
+	return hashes
+}
+
+func TestMempool_FeePriority(t *testing.T) {
+	t.Run("highest fee first", func(t *testing.T) {
+		mp := New(mempoolSz, maxTxSz)
+		txA := newFeeTx(1, "A", 10)
+		txB := newFeeTx(1, "B", 30)
+		txC := newFeeTx(1, "C", 20)
+		txD := newFeeTx(1, "D", 20)
+		for _, tx := range []*types.Tx{txA, txB, txC, txD} {
+			require.NoError(t, mp.Store(tx))
+		}
+
+		// equal fees are kept in the order received
+		assert.Equal(t, []types.Hash{txB.Hash(), txC.Hash(), txD.Hash(), txA.Hash()}, queueHashes(mp))
+	})
+
+	t.Run("sender nonce order", func(t *testing.T) {
+		mp := New(mempoolSz, maxTxSz)
+		txA1 := newFeeTx(1, "A", 10)
+		txA2 := newFeeTx(2, "A", 50)
+		txB1 := newFeeTx(1, "B", 20)
+		txA3 := newFeeTx(3, "A", 5)
+		for _, tx := range []*types.Tx{txA1, txB1, txA2, txA3} {
+			require.NoError(t, mp.Store(tx))
+		}
+
+		// A2 has the highest fee, but may not be ahead of A1
+		assert.Equal(t, []types.Hash{txB1.Hash(), txA1.Hash(), txA2.Hash(), txA3.Hash()}, queueHashes(mp))
+	})
+}
+
+func TestMempool_ReplaceByFee(t *testing.T) {
+	t.Run("higher fee replaces", func(t *testing.T) {
+		mp := New(mempoolSz, maxTxSz)
+		txA1 := newFeeTx(1, "A", 10)
+		txA2 := newFeeTx(2, "A", 10)
+		txB1 := newFeeTx(1, "B", 20)
+		for _, tx := range []*types.Tx{txA1, txA2, txB1} {
+			require.NoError(t, mp.Store(tx))
+		}
+		_, count := mp.Size()
+		require.Equal(t, 3, count)
+
+		txA1Replacement := newFeeTx(1, "A", 30)
+		require.NoError(t, mp.Store(txA1Replacement))
+
+		assert.Equal(t, []types.Hash{txA1Replacement.Hash(), txB1.Hash(), txA2.Hash()}, queueHashes(mp))
+		assert.False(t, mp.Have(txA1.Hash()))
+		assert.Equal(t, txA1Replacement, mp.GetByNonce([]byte("A"), 1))
+
+		bts, count := mp.Size()
+		require.Equal(t, 3, count)
+		require.EqualValues(t, txA1Replacement.SerializeSize()+txA2.SerializeSize()+txB1.SerializeSize(), bts)
+	})
+
+	t.Run("equal or lower fee rejected", func(t *testing.T) {
+		mp := New(mempoolSz, maxTxSz)
+		txA1 := newFeeTx(1, "A", 10)
+		require.NoError(t, mp.Store(txA1))
+
+		txA1Equal := newFeeTx(1, "A", 10)
+		txA1Equal.Body.Description = "same fee"
+		err := mp.Store(types.NewTx(txA1Equal.Transaction))
+		require.ErrorIs(t, err, ktypes.ErrInsufficientFee)
+		err = mp.Store(newFeeTx(1, "A", 5))
+		require.ErrorIs(t, err, ktypes.ErrInsufficientFee)
+
+		assert.Equal(t, []types.Hash{txA1.Hash()}, queueHashes(mp))
+	})
+
+	t.Run("failed check keeps the pending transaction", func(t *testing.T) {
+		mp := New(mempoolSz, maxTxSz)
+		txA1 := newFeeTx(1, "A", 10)
+		require.NoError(t, mp.Store(txA1))
+
+		errCheck := errors.New("check failed")
+		var checked bool
+		err := mp.CheckAndStore(context.Background(), newFeeTx(1, "A", 30), func(ctx context.Context, tx *types.Tx) error {
+			checked = true
+			assert.NotNil(t, mp.txns[txA1.Hash()]) // not yet replaced
+			return errCheck
+		})
+		require.ErrorIs(t, err, errCheck)
+		require.True(t, checked)
+
+		assert.Equal(t, []types.Hash{txA1.Hash()}, queueHashes(mp))
+	})
+}
+
+func TestMempool_Evict(t *testing.T) {
+	txA1 := newFeeTx(1, "A", 10)
+	txA2 := newFeeTx(2, "A", 40)
+	txB1 := newFeeTx(1, "B", 20)
+	txC1 := newFeeTx(1, "C", 20)
+	sz := txA1.SerializeSize() // all the same size
+
+	newFull := func(t *testing.T) *Mempool {
+		mp := New(4*sz, maxTxSz)
+		for _, tx := range []*types.Tx{txA1, txA2, txB1, txC1} {
+			require.NoError(t, mp.Store(tx))
+		}
+		return mp
+	}
+
+	t.Run("lowest fee sender tail evicted", func(t *testing.T) {
+		mp := newFull(t)
+
+		// A1 has the lowest fee, but A2 would be left with a nonce gap, so the
+		// last received of the next lowest fees is evicted.
+		txD1 := newFeeTx(1, "D", 30)
+		require.NoError(t, mp.Store(txD1))
+
+		assert.Equal(t, []types.Hash{txD1.Hash(), txB1.Hash(), txA1.Hash(), txA2.Hash()}, queueHashes(mp))
+		assert.False(t, mp.Have(txC1.Hash()))
+		bts, _ := mp.Size()
+		assert.EqualValues(t, 4*sz, bts)
+	})
+
+	t.Run("no lower fee to evict", func(t *testing.T) {
+		mp := newFull(t)
+
+		err := mp.Store(newFeeTx(1, "D", 20))
+		require.ErrorIs(t, err, ktypes.ErrMempoolFull)
+		assert.Equal(t, []types.Hash{txB1.Hash(), txC1.Hash(), txA1.Hash(), txA2.Hash()}, queueHashes(mp))
+	})
+
+	t.Run("own transactions not evicted", func(t *testing.T) {
+		mp := New(2*sz, maxTxSz)
+		txA1 := newFeeTx(1, "A", 60)
+		require.NoError(t, mp.Store(txA1))
+		require.NoError(t, mp.Store(txB1))
+
+		// only B1 has a lower fee, which B2 would be left without
+		err := mp.Store(newFeeTx(2, "B", 50))
+		require.ErrorIs(t, err, ktypes.ErrMempoolFull)
+		assert.Equal(t, []types.Hash{txA1.Hash(), txB1.Hash()}, queueHashes(mp))
+	})
+
+	t.Run("nothing evicted for a failed check", func(t *testing.T) {
+		mp := newFull(t)
+
+		errCheck := errors.New("check failed")
+		err := mp.CheckAndStore(context.Background(), newFeeTx(1, "D", 30), func(ctx context.Context, tx *types.Tx) error {
+			return errCheck
+		})
+		require.ErrorIs(t, err, errCheck)
+		assert.Equal(t, []types.Hash{txB1.Hash(), txC1.Hash(), txA1.Hash(), txA2.Hash()}, queueHashes(mp))
+	})
+
+	t.Run("evicted transactions reported", func(t *testing.T) {
+		mp := newFull(t)
+
+		// the check function keeps the next nonce of each sender, which is
+		// rolled back for the evicted transactions
+		next := map[string]uint64{"A": 3, "B": 2, "C": 2, "D": 1}
+		var evicted []types.Hash
+		mp.SetEvictFn(func(tx *types.Tx) {
+			evicted = append(evicted, tx.Hash())
+			next[string(tx.Sender)]--
+		})
+		check := nonceCheck(next)
+
+		require.NoError(t, mp.CheckAndStore(context.Background(), newFeeTx(1, "D", 30), check))
+		assert.Equal(t, []types.Hash{txC1.Hash()}, evicted)
+
+		// C's next nonce has a gap now, so it is queued as a future transaction
+		mp.SetMaxSize(5 * sz)
+		txC2 := newFeeTx(2, "C", 40)
+		require.NoError(t, mp.CheckAndStore(context.Background(), txC2, check))
+		assert.NotContains(t, queueHashes(mp), txC2.Hash())
+		assert.True(t, mp.Have(txC2.Hash()))
+	})
+
+	t.Run("not checked if it does not fit", func(t *testing.T) {
+		mp := newFull(t)
+
+		err := mp.CheckAndStore(context.Background(), newFeeTx(1, "D", 20), func(ctx context.Context, tx *types.Tx) error {
+			t.Fatal("checked a transaction that does not fit")
+			return nil
+		})
+		require.ErrorIs(t, err, ktypes.ErrMempoolFull)
+	})
+}
+
+// nonceCheck returns a check function for accounts with the given next nonces,
+// which it advances for the transactions that pass.
+func nonceCheck(next map[string]uint64) CheckFn {
+	return func(ctx context.Context, tx *types.Tx) error {
+		switch want := next[string(tx.Sender)]; {
+		case tx.Body.Nonce > want:
+			return types.ErrFutureNonce
+		case tx.Body.Nonce < want:
+			return ktypes.ErrInvalidNonce
+		}
+		next[string(tx.Sender)]++
+		return nil
+	}
+}
+
+func TestMempool_Future(t *testing.T) {
+	t.Run("promoted once the gap is filled", func(t *testing.T) {
+		mp := New(mempoolSz, maxTxSz)
+		txA1, txA2, txA3, txA5 := newTx(1, "A"), newTx(2, "A"), newTx(3, "A"), newTx(5, "A")
+		require.NoError(t, mp.StoreFuture(txA3))
+		require.NoError(t, mp.StoreFuture(txA5))
+		require.NoError(t, mp.StoreFuture(txA2))
+
+		// future-nonce transactions are in the mempool, but not pending
+		assert.True(t, mp.Have(txA2.Hash()))
+		assert.Equal(t, txA3, mp.Get(txA3.Hash()))
+		assert.False(t, mp.TxsAvailable())
+		assert.Empty(t, mp.PeekN(10, 0))
+		bts, count := mp.Size()
+		assert.Zero(t, bts)
+		assert.Zero(t, count)
+		require.ErrorIs(t, mp.StoreFuture(txA2), ktypes.ErrTxAlreadyExists)
+
+		next := map[string]uint64{"A": 1}
+		check := nonceCheck(next)
+		mp.PromoteFuture(context.Background(), []byte("A"), check)
+		assert.Empty(t, mp.txQ) // still a gap
+
+		require.NoError(t, mp.Store(txA1))
+		require.NoError(t, check(context.Background(), txA1))
+		mp.PromoteFuture(context.Background(), []byte("A"), check)
+
+		assert.Equal(t, []types.Hash{txA1.Hash(), txA2.Hash(), txA3.Hash()}, queueHashes(mp))
+		assert.Equal(t, []*types.Tx{txA5}, mp.future["A"])
+		_, count = mp.Size()
+		assert.Equal(t, 3, count)
+	})
+
+	t.Run("promoted on recheck", func(t *testing.T) {
+		mp := New(mempoolSz, maxTxSz)
+		txA2, txA3, txB1 := newTx(2, "A"), newTx(3, "A"), newTx(1, "B")
+		txA1Stale := newTx(1, "A")
+		require.NoError(t, mp.StoreFuture(txA1Stale))
+		require.NoError(t, mp.StoreFuture(txA2))
+		require.NoError(t, mp.StoreFuture(txA3))
+		require.NoError(t, mp.Store(txB1))
+
+		// A1 was committed from another node's mempool
+		mp.RecheckTxs(context.Background(), nonceCheck(map[string]uint64{"A": 2, "B": 1}))
+
+		assert.Equal(t, []types.Hash{txB1.Hash(), txA2.Hash(), txA3.Hash()}, queueHashes(mp))
+		assert.False(t, mp.Have(txA1Stale.Hash()))
+		assert.Empty(t, mp.future)
+	})
+
+	t.Run("moved back on recheck", func(t *testing.T) {
+		mp := New(mempoolSz, maxTxSz)
+		txA1, txA2, txA3, txB1 := newTx(1, "A"), newTx(2, "A"), newTx(3, "A"), newTx(1, "B")
+		for _, tx := range []*types.Tx{txA1, txA2, txA3, txB1} {
+			require.NoError(t, mp.Store(tx))
+		}
+
+		// A1 expired, so the rest of A's transactions have a nonce gap
+		errExpired := errors.New("expired")
+		check := nonceCheck(map[string]uint64{"A": 1, "B": 1})
+		mp.RecheckTxs(context.Background(), func(ctx context.Context, tx *types.Tx) error {
+			if tx.Hash() == txA1.Hash() {
+				return errExpired
+			}
+			return check(ctx, tx)
+		})
+
+		assert.Equal(t, []types.Hash{txB1.Hash()}, queueHashes(mp))
+		assert.Equal(t, []*types.Tx{txA2, txA3}, mp.future["A"])
+		assert.False(t, mp.Have(txA1.Hash()))
+		bts, count := mp.Size()
+		assert.EqualValues(t, txB1.SerializeSize(), bts)
+		assert.Equal(t, 1, count)
+
+		// and they are promoted once the gap is filled
+		require.NoError(t, mp.Store(txA1))
+		mp.RecheckTxs(context.Background(), nonceCheck(map[string]uint64{"A": 1, "B": 1}))
+		assert.Equal(t, []types.Hash{txB1.Hash(), txA1.Hash(), txA2.Hash(), txA3.Hash()}, queueHashes(mp))
+		assert.Empty(t, mp.future)
+	})
+
+	t.Run("checked and expired on recheck", func(t *testing.T) {
+		mp := New(mempoolSz, maxTxSz)
+		mp.SetMaxFutureAge(time.Hour)
+		txA3, txA5, txA6, txB3 := newTx(3, "A"), newTx(5, "A"), newTx(6, "A"), newTx(3, "B")
+		for _, tx := range []*types.Tx{txA3, txA5, txA6, txB3} {
+			require.NoError(t, mp.StoreFuture(tx))
+		}
+		mp.txns[txA5.Hash()].received = time.Now().Add(-2 * time.Hour)
+
+		// every future-nonce transaction is checked, not only the first of
+		// each sender
+		errInvalid := errors.New("invalid")
+		check := nonceCheck(map[string]uint64{"A": 1, "B": 1})
+		var checked []types.Hash
+		mp.RecheckTxs(context.Background(), func(ctx context.Context, tx *types.Tx) error {
+			checked = append(checked, tx.Hash())
+			if tx.Hash() == txA6.Hash() {
+				return errInvalid
+			}
+			return check(ctx, tx)
+		})
+
+		assert.Equal(t, []types.Hash{txA3.Hash(), txA6.Hash(), txB3.Hash()}, checked)
+		assert.Equal(t, []*types.Tx{txA3}, mp.future["A"])
+		assert.Equal(t, []*types.Tx{txB3}, mp.future["B"])
+		assert.False(t, mp.Have(txA5.Hash())) // expired
+		assert.False(t, mp.Have(txA6.Hash()))
+	})
+
+	t.Run("replace by fee", func(t *testing.T) {
+		mp := New(mempoolSz, maxTxSz)
+		txA2 := newFeeTx(2, "A", 10)
+		require.NoError(t, mp.StoreFuture(txA2))
+		require.ErrorIs(t, mp.StoreFuture(newFeeTx(2, "A", 5)), ktypes.ErrInsufficientFee)
+
+		txA2Replacement := newFeeTx(2, "A", 20)
+		require.NoError(t, mp.StoreFuture(txA2Replacement))
+		assert.Equal(t, []*types.Tx{txA2Replacement}, mp.future["A"])
+		assert.False(t, mp.Have(txA2.Hash()))
+	})
+
+	t.Run("evicted first", func(t *testing.T) {
+		txA1 := newFeeTx(1, "A", 10)
+		txB3 := newFeeTx(3, "B", 30)
+		sz := txA1.SerializeSize()
+		mp := New(2*sz, maxTxSz)
+		require.NoError(t, mp.Store(txA1))
+		require.NoError(t, mp.StoreFuture(txB3))
+
+		// a future-nonce transaction only evicts future-nonce transactions
+		require.ErrorIs(t, mp.StoreFuture(newFeeTx(3, "C", 20)), ktypes.ErrMempoolFull)
+
+		// a pending transaction evicts future-nonce transactions first
+		txC1 := newFeeTx(1, "C", 40)
+		require.NoError(t, mp.Store(txC1))
+		assert.Equal(t, []types.Hash{txC1.Hash(), txA1.Hash()}, queueHashes(mp))
+		assert.Empty(t, mp.future)
+	})
+
+	t.Run("stored on a nonce gap", func(t *testing.T) {
+		txA1 := newFeeTx(1, "A", 10)
+		txB1 := newFeeTx(1, "B", 10)
+		sz := txA1.SerializeSize()
+		mp := New(2*sz, maxTxSz)
+		require.NoError(t, mp.Store(txA1))
+
+		check := nonceCheck(map[string]uint64{"A": 2, "B": 1, "C": 1})
+		txC3 := newFeeTx(3, "C", 5)
+		require.NoError(t, mp.CheckAndStore(context.Background(), txC3, check))
+		assert.Equal(t, []*types.Tx{txC3}, mp.future["C"])
+		assert.Equal(t, []types.Hash{txA1.Hash()}, queueHashes(mp))
+
+		// a pending transaction evicts it, but the pending transactions are not
+		// evicted for a future-nonce transaction, even with a higher fee
+		require.NoError(t, mp.CheckAndStore(context.Background(), txB1, check))
+		err := mp.CheckAndStore(context.Background(), newFeeTx(3, "B", 40), check)
+		require.ErrorIs(t, err, ktypes.ErrMempoolFull)
+		assert.Equal(t, []types.Hash{txA1.Hash(), txB1.Hash()}, queueHashes(mp))
+		assert.Empty(t, mp.future)
+
+		// nor is a transaction that fails the check stored
+		errVerify := errors.New("invalid signature")
+		err = mp.CheckAndStore(context.Background(), newFeeTx(3, "D", 50), func(ctx context.Context, tx *types.Tx) error {
+			return errVerify
+		})
+		require.ErrorIs(t, err, errVerify)
+		assert.Empty(t, mp.future)
+	})
+}
+
+func TestMempool_SenderLimits(t *testing.T) {
+	t.Run("count", func(t *testing.T) {
+		mp := New(mempoolSz, maxTxSz)
+		mp.SetSenderLimits(2, 0)
+		txA1 := newFeeTx(1, "A", 10)
+		require.NoError(t, mp.Store(txA1))
+		require.NoError(t, mp.StoreFuture(newTx(3, "A")))
+		require.ErrorIs(t, mp.Store(newTx(2, "A")), ktypes.ErrMempoolFull)
+		require.NoError(t, mp.Store(newTx(1, "B")))
+
+		// replacing does not add to the count
+		require.NoError(t, mp.Store(newFeeTx(1, "A", 20)))
+
+		// removing frees the quota
+		mp.Remove(mp.GetByNonce([]byte("A"), 1).Hash())
+		require.NoError(t, mp.Store(newTx(2, "A")))
+	})
+
+	t.Run("bytes", func(t *testing.T) {
+		txA1 := newTx(1, "A")
+		sz := txA1.SerializeSize()
+		mp := New(mempoolSz, maxTxSz)
+		mp.SetSenderLimits(0, 2*sz)
+		require.NoError(t, mp.Store(txA1))
+		require.NoError(t, mp.Store(newTx(2, "A")))
+		require.ErrorIs(t, mp.StoreFuture(newTx(4, "A")), ktypes.ErrMempoolFull)
+
+		// reaping frees the quota
+		require.Len(t, mp.ReapN(1), 1)
+		require.NoError(t, mp.StoreFuture(newTx(4, "A")))
+	})
+}
@@ -17,6 +17,7 @@ var (
 	Consensus ConsensusMetrics = consensusMetrics{}
 	Node      NodeMetrics      = nodeMetrics{}
 	Store     StoreMetrics     = storeMetrics{}
+	Engine    EngineMetrics    = engineMetrics{}
 )
 
 // If we do not want to use the otel global meter provider, we can create our
@@ -48,6 +49,10 @@ var (
 	dbQueryErrorCount  metric.Int64Counter
 
 	// Engine metrics
+	actionLatencyHist    metric.Float64Histogram
+	actionRowsCounter    metric.Int64Counter
+	statementLatencyHist metric.Float64Histogram
+	statementRowsCounter metric.Int64Counter
 	// engineNumNamespaces metric.Int64Gauge // TODO
 	// engineStatementParseCount metric.Int64Counter
 
@@ -134,6 +139,13 @@ func init() {
 	txReannounceBytesCounter, _ = nodeMeter.Int64Counter("node.tx_reannounce.bytes")
 	// rebroadcasts etc...
 
+	// Engine metrics, recorded only if execution profiling is enabled
+	engineMeter := otel.Meter(EngineMeterName)
+	actionLatencyHist, _ = engineMeter.Float64Histogram("action.latency")
+	actionRowsCounter, _ = engineMeter.Int64Counter("action.rows")
+	statementLatencyHist, _ = engineMeter.Float64Histogram("statement.latency")
+	statementRowsCounter, _ = engineMeter.Int64Counter("statement.rows")
+
 	// Consensus metrics
 	consensusMeter := otel.Meter(ConsensusMeterName)
 	commitLatencyHist, _ = consensusMeter.Float64Histogram("consensus.commit.latency")
@@ -275,6 +287,28 @@ func (dbMetrics) RecordQueryFailure(ctx context.Context, crudType string, err er
 	)
 }
 
+type EngineMetrics interface {
+	RecordAction(ctx context.Context, namespace, action string, latency time.Duration, rows int64)
+	RecordStatement(ctx context.Context, namespace, action string, line int, latency time.Duration, rows int64)
+}
+
+type engineMetrics struct{}
+
+// RecordAction logs the execution of an action in a block.
+func (engineMetrics) RecordAction(ctx context.Context, namespace, action string, latency time.Duration, rows int64) {
+	attrs := metric.WithAttributes(attribute.String("namespace", namespace), attribute.String("action", action))
+	actionLatencyHist.Record(ctx, 1000*latency.Seconds(), attrs)
+	actionRowsCounter.Add(ctx, rows, attrs)
+}
+
+// RecordStatement logs the execution of a statement of an action in a block.
+func (engineMetrics) RecordStatement(ctx context.Context, namespace, action string, line int, latency time.Duration, rows int64) {
+	attrs := metric.WithAttributes(attribute.String("namespace", namespace), attribute.String("action", action),
+		attribute.Int("line", line))
+	statementLatencyHist.Record(ctx, 1000*latency.Seconds(), attrs)
+	statementRowsCounter.Add(ctx, rows, attrs)
+}
+
 type consensusMetrics struct{}
 
 func (consensusMetrics) RecordExecuted(ctx context.Context, latency time.Duration, height, numTxns int64) {
@@ -255,13 +255,24 @@ func queryTx(ctx context.Context, oidToDataType map[uint32]*datatype, dbTx txBeg
 func queryRowFunc(ctx context.Context, conn *pgx.Conn, stmt string,
 	scans []any, fn func() error, args ...any) error {
 	rows, _ := conn.Query(ctx, stmt, args...)
-	_, err := pgx.ForEachRow(rows, scans, fn)
+	tag, err := pgx.ForEachRow(rows, scans, fn)
+	if n, ok := ctx.Value(rowsAffectedKey{}).(*int64); ok && err == nil {
+		*n = tag.RowsAffected()
+	}
 	if sql.IsFatalDBError(err) {
 		err = errors.Join(err, sql.ErrDBFailure)
 	}
 	return err
 }
 
+type rowsAffectedKey struct{}
+
+// WithRowsAffected returns a context that makes QueryRowFunc store the number
+// of rows returned or changed by the statement in n.
+func WithRowsAffected(ctx context.Context, n *int64) context.Context {
+	return context.WithValue(ctx, rowsAffectedKey{}, n)
+}
+
 // QueryRowFunc will attempt to execute an SQL statement, handling the rows and
 // returned values as described by the sql.QueryScanner interface. If the
 // provided Executor is also a sql.QueryScanner, that method will be used,
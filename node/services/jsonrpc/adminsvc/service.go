@@ -54,6 +54,12 @@ type App interface {
 	BlockExecutionStatus() *ktypes.BlockExecutionStatus
 }
 
+// ExecProfiler reports the time spent executing actions and statements in
+// blocks.
+type ExecProfiler interface {
+	Top(limit int, namespace string) *types.ExecProfile
+}
+
 type Validators interface {
 	SetValidatorPower(ctx context.Context, tx sql.Executor, pubKey []byte, pubKeyType crypto.KeyType, power int64) error
 	GetValidatorPower(ctx context.Context, pubKey []byte, pubKeyType crypto.KeyType) (int64, error)
@@ -68,6 +74,7 @@ type Service struct {
 	voting     Validators
 	db         sql.DelayedReadTxMaker
 	whitelist  Whitelister
+	profile    ExecProfiler // nil unless execution profiling is enabled
 
 	cfg     *config.Config
 	chainID string
@@ -223,6 +230,10 @@ func (svc *Service) Methods() map[jsonrpc.Method]rpcserver.MethodDef {
 			"cancel the block execution at the given height and discard the specified transactions from the mempool",
 			"",
 		),
+		adminjson.MethodExecProfile: rpcserver.MakeMethodDef(svc.ExecProfile,
+			"get the slowest actions and statements executed in blocks, if execution profiling is enabled",
+			"the actions and statements with the most total execution time",
+		),
 	}
 }
 
@@ -234,11 +245,21 @@ func (svc *Service) Handlers() map[jsonrpc.Method]rpcserver.MethodHandler {
 	return handlers
 }
 
+// Opt is an optional setting of the Service.
+type Opt func(*Service)
+
+// WithExecProfile serves the execution profile of blocks from profile.
+func WithExecProfile(profile ExecProfiler) Opt {
+	return func(svc *Service) {
+		svc.profile = profile
+	}
+}
+
 // NewService constructs a new Service.
 func NewService(db sql.DelayedReadTxMaker, blockchain Node, app App,
 	vs Validators, wl Whitelister, txSigner auth.Signer, cfg *config.Config,
-	chainID string, logger log.Logger) *Service {
-	return &Service{
+	chainID string, logger log.Logger, opts ...Opt) *Service {
+	svc := &Service{
 		blockchain: blockchain,
 		whitelist:  wl,
 		app:        app,
@@ -249,6 +270,10 @@ func NewService(db sql.DelayedReadTxMaker, blockchain Node, app App,
 		log:        logger,
 		db:         db,
 	}
+	for _, opt := range opts {
+		opt(svc)
+	}
+	return svc
 }
 
 func convertSyncInfo(si *types.SyncInfo) *adminjson.SyncInfo {
@@ -668,3 +693,22 @@ func (svc *Service) AbortBlockExecution(ctx context.Context, req *adminjson.Abor
 
 	return &adminjson.AbortBlockExecResponse{}, nil
 }
+
+// defaultExecProfileLimit is the number of actions and statements returned by
+// ExecProfile if the request does not set a limit.
+const defaultExecProfileLimit = 10
+
+func (svc *Service) ExecProfile(ctx context.Context, req *adminjson.ExecProfileRequest) (*adminjson.ExecProfileResponse, *jsonrpc.Error) {
+	if svc.profile == nil {
+		return nil, jsonrpc.NewError(jsonrpc.ErrorUnknownMethod, "execution profiling is not enabled (exec_profile setting)", nil)
+	}
+
+	limit := req.Limit
+	if limit <= 0 {
+		limit = defaultExecProfileLimit
+	}
+
+	return &adminjson.ExecProfileResponse{
+		Profile: svc.profile.Top(limit, req.Namespace),
+	}, nil
+}
@@ -47,6 +47,7 @@ type Node interface {
 	GetBlockHeader(hash ktypes.Hash) (*ktypes.BlockHeader, error)
 	GetBlockHeaderByHeight(height int64) (*ktypes.BlockHeader, error)
 	BlockResultByHash(hash ktypes.Hash) ([]ktypes.TxResult, error)
+	BlockScheduleResultsByHash(hash ktypes.Hash) ([]*ktypes.ScheduleResult, error)
 	ChainTx(hash ktypes.Hash) (*chaintypes.Tx, error)
 	BlockHeight() int64
 	ChainUnconfirmedTx(limit int) (int, []*nodetypes.Tx)
@@ -285,10 +286,17 @@ func (svc *Service) BlockResult(_ context.Context, req *chainjson.BlockResultReq
 			return nil, jsonrpc.NewError(jsonrpc.ErrorNodeInternal, "failed to get block result: "+err.Error(), nil)
 		}
 
+		schedResults, err := svc.blockchain.BlockScheduleResultsByHash(req.Hash)
+		if err != nil {
+			svc.log.Error("block schedule results by hash", "hash", req.Hash, "error", err)
+			return nil, jsonrpc.NewError(jsonrpc.ErrorNodeInternal, "failed to get block schedule results: "+err.Error(), nil)
+		}
+
 		return &chainjson.BlockResultResponse{
-			Height:    blockHeader.Height,
-			Hash:      req.Hash,
-			TxResults: txResults,
+			Height:          blockHeader.Height,
+			Hash:            req.Hash,
+			TxResults:       txResults,
+			ScheduleResults: schedResults,
 		}, nil
 	}
 
@@ -311,10 +319,17 @@ func (svc *Service) BlockResult(_ context.Context, req *chainjson.BlockResultReq
 		return nil, jsonrpc.NewError(jsonrpc.ErrorNodeInternal, "failed to get block result: "+err.Error(), nil)
 	}
 
+	schedResults, err := svc.blockchain.BlockScheduleResultsByHash(blockHash)
+	if err != nil {
+		svc.log.Error("block schedule results by hash", "hash", blockHash, "error", err)
+		return nil, jsonrpc.NewError(jsonrpc.ErrorNodeInternal, "failed to get block schedule results: "+err.Error(), nil)
+	}
+
 	return &chainjson.BlockResultResponse{
-		Height:    blockHeader.Height,
-		Hash:      blockHash,
-		TxResults: txResults,
+		Height:          blockHeader.Height,
+		Hash:            blockHash,
+		TxResults:       txResults,
+		ScheduleResults: schedResults,
 	}, nil
 }
 
@@ -195,6 +195,9 @@ func reflectTypeInfo(t reflect.Type, knownSchemas map[reflect.Type]Schema) Schem
 		// For an "object" (dereferenced if pointer), set the "properties".
 		// Recurse for each field's type, merging fields of embedded types.
 		schema.Properties = make(map[string]Schema)
+		// Register the schema before recursing, so that fields that refer
+		// back to the type, such as a tree's children, get a reference.
+		knownSchemas[t] = schema
 		for i := range t.NumField() {
 			field := t.Field(i)
 
@@ -1,6 +1,7 @@
 package usersvc
 
 import (
+	"bytes"
 	// errors from engine
 
 	"context"
@@ -22,6 +23,7 @@ import (
 	"github.com/trufnetwork/kwil-db/core/types"
 	adminTypes "github.com/trufnetwork/kwil-db/core/types/admin"
 	authExt "github.com/trufnetwork/kwil-db/extensions/auth"
+	"github.com/trufnetwork/kwil-db/node/accounts"
 	nodeConsensus "github.com/trufnetwork/kwil-db/node/consensus"
 	"github.com/trufnetwork/kwil-db/node/engine"
 	"github.com/trufnetwork/kwil-db/node/metrics"
@@ -36,6 +38,8 @@ import (
 type EngineReader interface {
 	Call(ctx *common.EngineContext, tx sql.DB, namespace, action string, args []any, resultFn func(*common.Row) error) (*common.CallResult, error)
 	Execute(ctx *common.EngineContext, tx sql.DB, query string, params map[string]any, resultFn func(*common.Row) error) error
+	Schema(ctx context.Context, tx sql.DB, namespace string) (*types.Schema, error)
+	ExportSchema(ctx context.Context, tx sql.DB, namespace string) (string, error)
 }
 
 type BlockchainTransactor interface {
@@ -193,7 +197,7 @@ func NewService(db DB, engine EngineReader, chainClient BlockchainTransactor,
 // or any other breaking changes.
 const (
 	apiVerMajor = 0
-	apiVerMinor = 2
+	apiVerMinor = 3
 	apiVerPatch = 0
 
 	serviceName = "user"
@@ -203,6 +207,8 @@ const (
 //
 // apiVerMinor = 2 indicates the presence of the migration, challenge, and
 // health methods added in Kwil v0.9
+//
+// apiVerMinor = 3 indicates the presence of the export_schema method
 
 var (
 	apiVerSemver = fmt.Sprintf("%d.%d.%d", apiVerMajor, apiVerMinor, apiVerPatch)
@@ -336,6 +342,16 @@ func (svc *Service) Methods() map[jsonrpc.Method]rpcserver.MethodDef {
 			"perform an authenticated ad-hoc SQL query",
 			"the result of the query as a collection of records",
 		),
+		userjson.MethodSchema: rpcserver.MakeMethodDef(
+			svc.Schema,
+			"describe the actions of a namespace",
+			"the actions of the namespace, with their parameters and any parameter default values",
+		),
+		userjson.MethodExportSchema: rpcserver.MakeMethodDef(
+			svc.ExportSchema,
+			"export the schema of a namespace",
+			"a Kuneiform script that recreates the namespace's tables, indexes, actions, extensions, and privileges",
+		),
 		userjson.MethodTxQuery: rpcserver.MakeMethodDef(
 			svc.TxQuery,
 			"query for the status of a transaction",
@@ -557,6 +573,43 @@ func (svc *Service) Query(ctx context.Context, req *userjson.QueryRequest) (*use
 	}, nil
 }
 
+// Schema describes the actions of a namespace.
+func (svc *Service) Schema(ctx context.Context, req *userjson.SchemaRequest) (*userjson.SchemaResponse, *jsonrpc.Error) {
+	ctxExec, cancel := context.WithTimeout(ctx, svc.readTxTimeout)
+	defer cancel()
+
+	readTx := svc.db.BeginDelayedReadTx()
+	defer readTx.Rollback(ctx)
+
+	schema, err := svc.engine.Schema(ctxExec, readTx, req.Namespace)
+	if err != nil {
+		return nil, engineError(err)
+	}
+
+	return schema, nil
+}
+
+// ExportSchema returns a Kuneiform script that recreates a namespace.
+func (svc *Service) ExportSchema(ctx context.Context, req *userjson.ExportSchemaRequest) (*userjson.ExportSchemaResponse, *jsonrpc.Error) {
+	ctxExec, cancel := context.WithTimeout(ctx, svc.readTxTimeout)
+	defer cancel()
+
+	if svc.privateMode {
+		return nil, jsonrpc.NewError(jsonrpc.ErrorNoQueryWithPrivateRPC,
+			"schema export is prohibited when authenticated calls are enforced (private mode)", nil)
+	}
+
+	readTx := svc.db.BeginDelayedReadTx()
+	defer readTx.Rollback(ctx)
+
+	schema, err := svc.engine.ExportSchema(ctxExec, readTx, req.Namespace)
+	if err != nil {
+		return nil, engineError(err)
+	}
+
+	return &userjson.ExportSchemaResponse{Schema: schema}, nil
+}
+
 func (svc *Service) AuthenticatedQuery(ctx context.Context, req *userjson.AuthenticatedQueryRequest) (*userjson.QueryResponse, *jsonrpc.Error) {
 	ctxExec, cancel := context.WithTimeout(ctx, svc.readTxTimeout)
 	defer cancel()
@@ -566,7 +619,7 @@ func (svc *Service) AuthenticatedQuery(ctx context.Context, req *userjson.Authen
 		return nil, jsonrpc.NewError(jsonrpc.ErrorInvalidParams, "failed to create signature text: "+err.Error(), nil)
 	}
 
-	if jsonRPCErr := svc.authenticate(req.SignatureData, req.Challenge, req.Sender, req.AuthType, sigText); jsonRPCErr != nil {
+	if jsonRPCErr := svc.authenticate(ctx, req.SignatureData, req.Challenge, req.Sender, req.AuthType, sigText); jsonRPCErr != nil {
 		return nil, jsonRPCErr
 	}
 
@@ -719,7 +772,7 @@ func (svc *Service) Call(ctx context.Context, req *userjson.CallRequest) (*userj
 		return nil, jsonrpc.NewError(jsonrpc.ErrorInvalidParams, "failed to convert action call: "+err.Error(), nil)
 	}
 
-	if jsonRPCErr := svc.authenticate(msg.SignatureData, msg.Body.Challenge, msg.Sender, msg.AuthType, types.CallSigText(body.Namespace, body.Action,
+	if jsonRPCErr := svc.authenticate(ctx, msg.SignatureData, msg.Body.Challenge, msg.Sender, msg.AuthType, types.CallSigText(body.Namespace, body.Action,
 		msg.Body.Payload, msg.Body.Challenge)); jsonRPCErr != nil {
 		return nil, jsonRPCErr
 	}
@@ -750,7 +803,7 @@ func (svc *Service) Call(ctx context.Context, req *userjson.CallRequest) (*userj
 	defer readTx.Rollback(ctx)
 
 	r := &rowReader{}
-	callRes, err := svc.engine.Call(&common.EngineContext{TxContext: txContext}, readTx, body.Namespace, body.Action, args, r.read)
+	callRes, err := svc.engine.Call(&common.EngineContext{TxContext: txContext, Trace: req.Trace}, readTx, body.Namespace, body.Action, args, r.read)
 	if err != nil {
 		return nil, engineError(err)
 	}
@@ -765,6 +818,7 @@ func (svc *Service) Call(ctx context.Context, req *userjson.CallRequest) (*userj
 		QueryResult: &r.qr,
 		Logs:        callRes.FormatLogs(),
 		Error:       execErr,
+		Trace:       callRes.Trace,
 	}, nil
 }
 
@@ -845,7 +899,7 @@ func (svc *Service) txCtx(ctx context.Context, sender []byte, authtype string) (
 
 // authenticate enforces authentication for the given context and message
 // if private mode is enabled. It returns an error if authentication fails.
-func (svc *Service) authenticate(signature, challenge, sender []byte, authtype, sigTxt string) *jsonrpc.Error {
+func (svc *Service) authenticate(ctx context.Context, signature, challenge, sender []byte, authtype, sigTxt string) *jsonrpc.Error {
 	if !svc.privateMode {
 		return nil
 	}
@@ -872,6 +926,24 @@ func (svc *Service) authenticate(signature, challenge, sender []byte, authtype,
 		return jsonrpc.NewError(jsonrpc.ErrorInvalidCallSignature, "invalid signature on call message", nil)
 	}
 
+	// A multisig signature is only verified against the definition it
+	// includes, which must be the registered definition of the account.
+	if authtype == auth.MultisigAuth {
+		sig, err := auth.UnmarshalMultisigSignature(signature)
+		if err != nil {
+			return jsonrpc.NewError(jsonrpc.ErrorInvalidCallSignature, "invalid signature on call message", nil)
+		}
+		readTx := svc.db.BeginDelayedReadTx()
+		defer readTx.Rollback(ctx)
+		def, err := accounts.GetMultisig(ctx, readTx, sender)
+		if err != nil && !errors.Is(err, accounts.ErrMultisigNotFound) {
+			return jsonrpc.NewError(jsonrpc.ErrorNodeInternal, "failed to get multisig account", nil)
+		}
+		if !bytes.Equal(def, sig.Multisig.Bytes()) {
+			return jsonrpc.NewError(jsonrpc.ErrorInvalidCallSignature, "multisig signature is not for the registered account definition", nil)
+		}
+	}
+
 	return nil
 }
 
@@ -14,6 +14,6 @@ var (
 			Name: "CC0-1.0",
 			URL:  "https://creativecommons.org/publicdomain/zero/1.0/legalcode",
 		},
-		Version: "0.2.0",
+		Version: "0.4.0",
 	}
 )
@@ -24,6 +24,7 @@ type MemBS struct {
 	blocks     map[types.Hash]*types.Block
 	commitInfo map[types.Hash]*types.CommitInfo
 	txResults  map[types.Hash][]types.TxResult
+	schedules  map[types.Hash][]*types.ScheduleResult
 	txIds      map[types.Hash]types.Hash // tx hash -> block hash
 	fetching   map[types.Hash]bool       // TODO: remove, app concern
 }
@@ -34,6 +35,7 @@ func NewMemBS() *MemBS {
 		hashes:     make(map[int64]blockHashes),
 		blocks:     make(map[types.Hash]*types.Block),
 		txResults:  make(map[types.Hash][]types.TxResult),
+		schedules:  make(map[types.Hash][]*types.ScheduleResult),
 		txIds:      make(map[types.Hash]types.Hash),
 		fetching:   make(map[types.Hash]bool),
 		commitInfo: make(map[types.Hash]*types.CommitInfo),
@@ -161,6 +163,19 @@ func (bs *MemBS) Results(hash types.Hash) ([]types.TxResult, error) {
 	return res, nil
 }
 
+func (bs *MemBS) StoreScheduleResults(hash types.Hash, results []*types.ScheduleResult) error {
+	bs.mtx.Lock()
+	defer bs.mtx.Unlock()
+	bs.schedules[hash] = results
+	return nil
+}
+
+func (bs *MemBS) ScheduleResults(hash types.Hash) ([]*types.ScheduleResult, error) {
+	bs.mtx.RLock()
+	defer bs.mtx.RUnlock()
+	return bs.schedules[hash], nil
+}
+
 func (bs *MemBS) Result(hash types.Hash, idx uint32) (*types.TxResult, error) {
 	bs.mtx.RLock()
 	defer bs.mtx.RUnlock()
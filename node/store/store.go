@@ -51,6 +51,7 @@ var (
 	nsTxn        = []byte("t:") // transaction index by tx hash
 	nsResults    = []byte("r:") // block execution results by block hash
 	nsCommitInfo = []byte("c:") // commit info by block hash
+	nsSchedules  = []byte("s:") // schedule results by block hash
 )
 
 var _ types.BlockStore = &BlockStore{}
@@ -312,6 +313,63 @@ func (bki *BlockStore) Result(hash types.Hash, idx uint32) (*ktypes.TxResult, er
 	return &res, err
 }
 
+// StoreScheduleResults stores the results of the actions called by
+// schedules at the end of a block.
+func (bki *BlockStore) StoreScheduleResults(hash types.Hash, results []*ktypes.ScheduleResult) error {
+	txn := bki.db.NewTransaction(true)
+	defer txn.Discard()
+
+	// The key prefix will be nsSchedules + result index. The index is big
+	// endian so that iterating the keys yields the results in order.
+	for i, res := range results {
+		key := slices.Concat(nsSchedules, hash[:], binary.BigEndian.AppendUint32(nil, uint32(i)))
+		resBts, err := res.MarshalBinary()
+		if err != nil {
+			return err
+		}
+		err = txn.Set(key, resBts)
+		if err != nil {
+			txn, err = bki.mayReplaceTx(txn, err)
+			if err != nil {
+				return err
+			} // else we recovered
+			defer txn.Discard()
+		}
+	}
+
+	return txn.Commit()
+}
+
+// ScheduleResults returns the results of the actions called by schedules at
+// the end of a block, in the order they were called.
+func (bki *BlockStore) ScheduleResults(hash types.Hash) ([]*ktypes.ScheduleResult, error) {
+	var results []*ktypes.ScheduleResult
+	err := bki.db.View(func(txn *badger.Txn) error {
+		opts := badger.DefaultIteratorOptions
+		opts.Prefix = slices.Concat(nsSchedules, hash[:])
+
+		it := txn.NewIterator(opts)
+		defer it.Close()
+
+		for it.Rewind(); it.Valid(); it.Next() {
+			err := it.Item().Value(func(val []byte) error {
+				result := new(ktypes.ScheduleResult)
+				if err := result.UnmarshalBinary(val); err != nil {
+					return err
+				}
+				results = append(results, result)
+				return nil
+			})
+			if err != nil {
+				return err
+			}
+		}
+		return nil
+	})
+
+	return results, err
+}
+
 func (bki *BlockStore) Store(blk *ktypes.Block, commitInfo *ktypes.CommitInfo) error {
 	blkHash := blk.Hash()
 	height := blk.Header.Height
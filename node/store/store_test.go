@@ -626,6 +626,48 @@ func TestBlockStore_StoreAndGetResults(t *testing.T) {
 	}
 }
 
+func TestBlockStore_StoreAndGetScheduleResults(t *testing.T) {
+	bs, _ := setupTestBlockStore(t)
+
+	block, appHash, _ := createTestBlock(t, 1, 1)
+	err := bs.Store(block, &ktypes.CommitInfo{AppHash: appHash})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// blocks without schedule results have none
+	gotResults, err := bs.ScheduleResults(block.Hash())
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(gotResults) != 0 {
+		t.Fatalf("Expected no schedule results, got %d", len(gotResults))
+	}
+
+	// enough results that the order would break with little endian indexes
+	results := make([]*ktypes.ScheduleResult, 300)
+	for i := range results {
+		results[i] = &ktypes.ScheduleResult{
+			Namespace: "main",
+			Name:      fmt.Sprintf("sched_%d", i),
+			Logs:      "log",
+		}
+	}
+	results[1].Error = "failed"
+
+	err = bs.StoreScheduleResults(block.Hash(), results)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	gotResults, err = bs.ScheduleResults(block.Hash())
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	require.Equal(t, results, gotResults)
+}
+
 func TestBlockStore_StoreResultsEmptyBlock(t *testing.T) {
 	bs, _ := setupTestBlockStore(t)
 
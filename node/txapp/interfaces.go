@@ -6,21 +6,30 @@ import (
 
 	"github.com/trufnetwork/kwil-db/core/crypto"
 	"github.com/trufnetwork/kwil-db/core/types"
+	"github.com/trufnetwork/kwil-db/node/accounts"
 	"github.com/trufnetwork/kwil-db/node/types/sql"
 	"github.com/trufnetwork/kwil-db/node/voting"
 )
 
 type Accounts interface {
-	Spend(ctx context.Context, tx sql.Executor, acctID *types.AccountID, amount *big.Int, nonce int64) error
+	Spend(ctx context.Context, tx sql.Executor, acctID, payer *types.AccountID, amount *big.Int, nonce int64) error
 	Credit(ctx context.Context, tx sql.Executor, acctID *types.AccountID, amount *big.Int) error
 	Transfer(ctx context.Context, tx sql.TxMaker, from, to *types.AccountID, amount *big.Int) error
 	GetAccount(ctx context.Context, tx sql.Executor, acctID *types.AccountID) (*types.Account, error)
 	NumAccounts(ctx context.Context, tx sql.Executor) (int64, error)
 	ApplySpend(ctx context.Context, tx sql.Executor, acctID *types.AccountID, amount *big.Int, nonce int64) error
+	Checkpoint() (restore func())
 	Commit() error
 	Rollback()
 }
 
+// EngineCheckpointer is an engine that can restore its in-memory state, such
+// as namespaces and actions created by DDL, which is not rolled back with the
+// database. The interpreter implements it.
+type EngineCheckpointer interface {
+	Checkpoint() (restore func())
+}
+
 type Validators interface {
 	SetValidatorPower(ctx context.Context, tx sql.Executor, pubKey []byte, keyType crypto.KeyType, power int64) error
 	GetValidatorPower(ctx context.Context, pubKey []byte, pubKeyType crypto.KeyType) (int64, error)
@@ -66,4 +75,15 @@ var (
 	resolutionExists                 = voting.ResolutionExists
 	resolutionByID                   = voting.GetResolutionInfo
 	// deleteResolution                 = voting.DeleteResolution
+
+	// sessions
+	createSession      = accounts.CreateSession
+	getSession         = accounts.GetSession
+	updateSessionSpent = accounts.UpdateSessionSpent
+	revokeSession      = accounts.RevokeSession
+
+	// multisig accounts
+	registerMultisig = accounts.RegisterMultisig
+	getMultisig      = accounts.GetMultisig
+	updateMultisig   = accounts.UpdateMultisig
 )
@@ -14,6 +14,7 @@ import (
 	"github.com/trufnetwork/kwil-db/core/log"
 	"github.com/trufnetwork/kwil-db/core/types"
 	authExt "github.com/trufnetwork/kwil-db/extensions/auth"
+	nodetypes "github.com/trufnetwork/kwil-db/node/types"
 	"github.com/trufnetwork/kwil-db/node/types/sql"
 	"github.com/trufnetwork/kwil-db/node/voting"
 )
@@ -23,12 +24,47 @@ type mempool struct {
 	validatorMgr Validators
 
 	accounts map[string]*types.Account
-	acctsMtx sync.Mutex // protects accounts
+	spends   map[spendKey]*pendingSpend // by sender and nonce, for replacements
+	acctsMtx sync.Mutex                 // protects accounts and spends
 
 	nodeIdent auth.Signer
 	log       log.Logger
 }
 
+// spendKey identifies a pending transaction by its sender account and nonce.
+type spendKey struct {
+	acct  string
+	nonce uint64
+}
+
+// pendingSpend is what a pending transaction deducted from the pending
+// balances of its sender and fee payer, which is credited back if the
+// transaction is replaced.
+type pendingSpend struct {
+	sender    *types.Account
+	senderAmt *big.Int // transferred by the sender of a sponsored transaction
+	payer     *types.Account
+	payerAmt  *big.Int // the fee, and the amount transferred if not sponsored
+}
+
+// credit adds the spend back to the pending balances.
+func (s *pendingSpend) credit() {
+	if s == nil {
+		return
+	}
+	s.sender.Balance.Add(s.sender.Balance, s.senderAmt)
+	s.payer.Balance.Add(s.payer.Balance, s.payerAmt)
+}
+
+// debit deducts the spend from the pending balances again.
+func (s *pendingSpend) debit() {
+	if s == nil {
+		return
+	}
+	s.sender.Balance.Sub(s.sender.Balance, s.senderAmt)
+	s.payer.Balance.Sub(s.payer.Balance, s.payerAmt)
+}
+
 // accountInfo retrieves the account info from the mempool state or the account store.
 func (m *mempool) accountInfo(ctx context.Context, tx sql.Executor, acctID *types.AccountID) (*types.Account, error) {
 	id, err := acctID.MarshalBinary()
@@ -88,6 +124,19 @@ func (m *mempool) applyTransaction(ctx *common.TxContext, tx *types.Transaction,
 			return fmt.Errorf("%w: raw statement", types.ErrDisallowedInMigration)
 		case types.PayloadTypeTransfer:
 			return fmt.Errorf("%w: transfer", types.ErrDisallowedInMigration)
+		case types.PayloadTypeMultiOperation:
+			multi := &types.MultiOperation{}
+			if err := multi.UnmarshalBinary(tx.Body.Payload); err != nil {
+				return err
+			}
+			for _, op := range multi.Operations {
+				switch op.Type() {
+				case types.PayloadTypeRawStatement:
+					return fmt.Errorf("%w: raw statement operation", types.ErrDisallowedInMigration)
+				case types.PayloadTypeTransfer:
+					return fmt.Errorf("%w: transfer operation", types.ErrDisallowedInMigration)
+				}
+			}
 		}
 	}
 
@@ -167,17 +216,54 @@ func (m *mempool) applyTransaction(ctx *common.TxContext, tx *types.Transaction,
 		return err
 	}
 
+	// the fee of a sponsored transaction is paid by its fee payer
+	payerID, err := txFeePayer(ctx.Ctx, tx)
+	if err != nil {
+		return err
+	}
+	feeAcct := acct
+	if payerID != nil {
+		feeAcct, err = m.accountInfo(ctx.Ctx, dbTx, payerID)
+		if err != nil {
+			return err
+		}
+	}
+
 	// reject the transactions from unfunded user accounts in gasEnabled mode
-	if !ctx.BlockContext.ChainContext.NetworkParameters.DisabledGasCosts && acct.Nonce == 0 && acct.Balance.Sign() == 0 {
-		delete(m.accounts, string(tx.Sender))
-		return types.ErrInsufficientBalance
+	if !ctx.BlockContext.ChainContext.NetworkParameters.DisabledGasCosts {
+		if payerID == nil && acct.Nonce == 0 && acct.Balance.Sign() == 0 {
+			delete(m.accounts, string(tx.Sender))
+			return types.ErrInsufficientBalance
+		}
+		if payerID != nil && feeAcct.Balance.Sign() == 0 {
+			return fmt.Errorf("%w: fee payer has zero balance", types.ErrInsufficientBalance)
+		}
 	}
 
 	// It is normally permissible to accept a transaction with the same nonce as
 	// a tx already in mempool (but not in a block), however without gas we
 	// would not want to allow that since there is no criteria for selecting the
-	// one to mine (normally higher fee).
-	if tx.Body.Nonce != uint64(acct.Nonce)+1 {
+	// one to mine (normally higher fee). With gas, the node's mempool only
+	// accepts such a replacement if it has a higher fee. The pending nonce does
+	// not change, and the spend of the replaced transaction is credited back to
+	// the pending balances before the replacement is checked.
+	var replacement bool
+	if !ctx.BlockContext.ChainContext.NetworkParameters.DisabledGasCosts && tx.Body.Nonce <= uint64(acct.Nonce) {
+		committed, err := m.accountMgr.GetAccount(ctx.Ctx, dbTx, acctID)
+		if err != nil {
+			return err
+		}
+		replacement = tx.Body.Nonce > uint64(committed.Nonce)
+	}
+
+	if !replacement && tx.Body.Nonce != uint64(acct.Nonce)+1 {
+		// A transaction with a nonce gap may be queued by the node's mempool
+		// until the gap is filled.
+		nonceErr := types.ErrInvalidNonce
+		if tx.Body.Nonce > uint64(acct.Nonce)+1 {
+			nonceErr = nodetypes.ErrFutureNonce
+		}
+
 		// If the transaction with invalid nonce is a ValidatorVoteIDs transaction,
 		// then mark the events for rebroadcast before discarding the transaction
 		// as the votes for these events are not yet received by the network.
@@ -197,32 +283,47 @@ func (m *mempool) applyTransaction(ctx *common.TxContext, tx *types.Transaction,
 			if err != nil {
 				return err
 			}
+			nonceErr = types.ErrInvalidNonce // rebroadcast instead of queued
 		}
 		return fmt.Errorf("%w for account %s: got %d, expected %d",
-			types.ErrInvalidNonce, hex.EncodeToString(tx.Sender),
+			nonceErr, hex.EncodeToString(tx.Sender),
 			tx.Body.Nonce, acct.Nonce+1)
 	}
 
+	id, err := acctID.MarshalBinary()
+	if err != nil {
+		return err
+	}
+	key := spendKey{string(id), tx.Body.Nonce}
+
+	var replaced *pendingSpend
+	if replacement {
+		replaced = m.spends[key]
+		replaced.credit()
+	}
+
 	spend := big.NewInt(0).Set(tx.Body.Fee) // NOTE: this could be the fee *limit*, but it depends on how the modules work
+	senderAmt := big.NewInt(0)
 
 	switch tx.Body.PayloadType {
-	case types.PayloadTypeTransfer:
-		transfer := &types.Transfer{}
-		err = transfer.UnmarshalBinary(tx.Body.Payload)
+	case types.PayloadTypeTransfer, types.PayloadTypeMultiOperation:
+		amt, err := transferAmount(tx)
 		if err != nil {
+			replaced.debit()
 			return err
 		}
 
-		amt := transfer.Amount
-		if amt.Cmp(&big.Int{}) < 0 {
-			return errors.Join(types.ErrInvalidAmount, errors.New("negative transfer not permitted"))
-		}
-
 		if amt.Cmp(acct.Balance) > 0 {
+			replaced.debit()
 			return types.ErrInsufficientBalance
 		}
 
-		spend.Add(spend, amt)
+		if payerID != nil { // the sender still transfers its own tokens
+			senderAmt = amt
+			acct.Balance.Sub(acct.Balance, amt)
+		} else {
+			spend.Add(spend, amt)
+		}
 	}
 
 	// We'd check balance against the total spend (fees plus value sent) if we
@@ -236,10 +337,19 @@ func (m *mempool) applyTransaction(ctx *common.TxContext, tx *types.Transaction,
 	// Since we're not yet operating with different policy depending on whether
 	// gas is enabled for the chain, we're just going to reduce the account's
 	// pending balance, but no lower than zero. Tx execution will handle it.
-	if spend.Cmp(acct.Balance) > 0 {
-		acct.Balance.SetUint64(0)
-	} else {
-		acct.Balance.Sub(acct.Balance, spend)
+	if spend.Cmp(feeAcct.Balance) > 0 {
+		spend.Set(feeAcct.Balance)
+	}
+	feeAcct.Balance.Sub(feeAcct.Balance, spend)
+
+	if m.spends == nil {
+		m.spends = make(map[spendKey]*pendingSpend)
+	}
+	m.spends[key] = &pendingSpend{
+		sender:    acct,
+		senderAmt: senderAmt,
+		payer:     feeAcct,
+		payerAmt:  spend,
 	}
 
 	// Account nonces and spends tracked by mempool should be incremented only for the
@@ -247,14 +357,89 @@ func (m *mempool) applyTransaction(ctx *common.TxContext, tx *types.Transaction,
 	// due to insufficient balance, but the account nonce and spend are already incremented.
 	// Due to which it accepts the next transaction with nonce+1, instead of nonce
 	// (but Tx with nonce is never pushed to the consensus pool).
-	acct.Nonce = int64(tx.Body.Nonce)
+	if !replacement {
+		acct.Nonce = int64(tx.Body.Nonce)
+	}
 
 	m.log.Debug("applied transaction to mempool state", "account", log.LazyHex(tx.Sender),
+		"nonce", acct.Nonce, "balance", acct.Balance, "replacement", replacement)
+
+	return nil
+}
+
+// revertTransaction rolls back the mempool state of a transaction that was
+// applied, but then evicted from the node's mempool, by crediting back its spend
+// and restoring the pending nonce of its sender. It must be the last pending
+// transaction of the sender, otherwise the later ones would be left with a
+// nonce gap.
+func (m *mempool) revertTransaction(tx *types.Transaction) error {
+	acctID, err := TxSenderAcctID(tx)
+	if err != nil {
+		return err
+	}
+	id, err := acctID.MarshalBinary()
+	if err != nil {
+		return err
+	}
+
+	m.acctsMtx.Lock()
+	defer m.acctsMtx.Unlock()
+
+	key := spendKey{string(id), tx.Body.Nonce}
+	spend, ok := m.spends[key]
+	if !ok {
+		return nil // not applied since the last reset
+	}
+
+	acct := m.accounts[string(id)]
+	if acct == nil || acct.Nonce != int64(tx.Body.Nonce) {
+		return fmt.Errorf("transaction with nonce %d is not the last pending transaction of account %s",
+			tx.Body.Nonce, hex.EncodeToString(tx.Sender))
+	}
+
+	spend.credit()
+	delete(m.spends, key)
+	acct.Nonce--
+
+	m.log.Debug("reverted transaction from mempool state", "account", log.LazyHex(tx.Sender),
 		"nonce", acct.Nonce, "balance", acct.Balance)
 
 	return nil
 }
 
+// transferAmount returns the total amount transferred by a transfer
+// transaction, or by the transfer operations of a multi-operation transaction.
+func transferAmount(tx *types.Transaction) (*big.Int, error) {
+	var transfers []*types.Transfer
+	switch tx.Body.PayloadType {
+	case types.PayloadTypeTransfer:
+		transfer := &types.Transfer{}
+		if err := transfer.UnmarshalBinary(tx.Body.Payload); err != nil {
+			return nil, err
+		}
+		transfers = append(transfers, transfer)
+	case types.PayloadTypeMultiOperation:
+		multi := &types.MultiOperation{}
+		if err := multi.UnmarshalBinary(tx.Body.Payload); err != nil {
+			return nil, err
+		}
+		for _, op := range multi.Operations {
+			if transfer, ok := op.(*types.Transfer); ok {
+				transfers = append(transfers, transfer)
+			}
+		}
+	}
+
+	total := big.NewInt(0)
+	for _, transfer := range transfers {
+		if transfer.Amount.Cmp(&big.Int{}) < 0 {
+			return nil, errors.Join(types.ErrInvalidAmount, errors.New("negative transfer not permitted"))
+		}
+		total.Add(total, transfer.Amount)
+	}
+	return total, nil
+}
+
 // reset clears the in-memory unconfirmed account states.
 // This should be done at the end of block commit.
 func (m *mempool) reset() {
@@ -262,4 +447,5 @@ func (m *mempool) reset() {
 	defer m.acctsMtx.Unlock()
 
 	m.accounts = make(map[string]*types.Account)
+	m.spends = make(map[spendKey]*pendingSpend)
 }
@@ -10,6 +10,8 @@ import (
 	"github.com/trufnetwork/kwil-db/core/crypto/auth"
 	"github.com/trufnetwork/kwil-db/core/log"
 	"github.com/trufnetwork/kwil-db/core/types"
+	"github.com/trufnetwork/kwil-db/extensions/hooks"
+	nodetypes "github.com/trufnetwork/kwil-db/node/types"
 	"github.com/trufnetwork/kwil-db/node/types/sql"
 
 	"github.com/stretchr/testify/assert"
@@ -132,6 +134,323 @@ func Test_MempoolWithGas(t *testing.T) {
 	assert.NoError(t, err)
 }
 
+func Test_MempoolReplacement(t *testing.T) {
+	m := &mempool{
+		accounts:   make(map[string]*types.Account),
+		accountMgr: &mockAccount{}, // committed nonce 0
+		log:        log.DiscardLogger,
+	}
+
+	txCtx := &common.TxContext{
+		Ctx: context.Background(),
+		BlockContext: &common.BlockContext{
+			ChainContext: &common.ChainContext{
+				NetworkParameters: &common.NetworkParameters{},
+			},
+		},
+	}
+
+	db := &mockDb{}
+	rebroadcast := &mockRebroadcast{}
+
+	tx := newTx(t, 1, "A")
+	senderAcct, err := TxSenderAcctID(tx)
+	require.NoError(t, err)
+	id, err := senderAcct.MarshalBinary()
+	require.NoError(t, err)
+	m.accounts[string(id)] = &types.Account{
+		ID:      senderAcct,
+		Balance: big.NewInt(100),
+	}
+
+	require.NoError(t, m.applyTransaction(txCtx, tx, db, rebroadcast))
+	require.NoError(t, m.applyTransaction(txCtx, newTx(t, 2, "A"), db, rebroadcast))
+	assert.EqualValues(t, 2, m.accounts[string(id)].Nonce)
+
+	// pending nonces may be replaced without changing the pending nonce
+	require.NoError(t, m.applyTransaction(txCtx, newTx(t, 1, "A"), db, rebroadcast))
+	require.NoError(t, m.applyTransaction(txCtx, newTx(t, 2, "A"), db, rebroadcast))
+	assert.EqualValues(t, 2, m.accounts[string(id)].Nonce)
+
+	// committed nonces and nonce gaps may not
+	err = m.applyTransaction(txCtx, newTx(t, 0, "A"), db, rebroadcast)
+	require.ErrorIs(t, err, types.ErrInvalidNonce)
+	require.NotErrorIs(t, err, nodetypes.ErrFutureNonce)
+	err = m.applyTransaction(txCtx, newTx(t, 4, "A"), db, rebroadcast)
+	require.ErrorIs(t, err, nodetypes.ErrFutureNonce) // may be queued
+	require.ErrorIs(t, err, types.ErrInvalidNonce)
+
+	require.NoError(t, m.applyTransaction(txCtx, newTx(t, 3, "A"), db, rebroadcast))
+	assert.EqualValues(t, 3, m.accounts[string(id)].Nonce)
+}
+
+func Test_MempoolReplacementSpend(t *testing.T) {
+	m := &mempool{
+		accounts:   make(map[string]*types.Account),
+		accountMgr: &mockAccount{},
+		log:        log.DiscardLogger,
+	}
+
+	txCtx := &common.TxContext{
+		Ctx: context.Background(),
+		BlockContext: &common.BlockContext{
+			ChainContext: &common.ChainContext{
+				NetworkParameters: &common.NetworkParameters{},
+			},
+		},
+	}
+
+	db := &mockDb{}
+	rebroadcast := &mockRebroadcast{}
+
+	to := &types.AccountID{Identifier: []byte("B"), KeyType: crypto.KeyTypeSecp256k1}
+	newTransferTx := func(nonce uint64, fee, amt int64) *types.Transaction {
+		payload, err := (&types.Transfer{To: to, Amount: big.NewInt(amt)}).MarshalBinary()
+		require.NoError(t, err)
+
+		tx := newTx(t, nonce, "A")
+		tx.Body.PayloadType = types.PayloadTypeTransfer
+		tx.Body.Payload = payload
+		tx.Body.Fee = big.NewInt(fee)
+		return tx
+	}
+
+	tx := newTransferTx(1, 10, 50)
+	senderAcct, err := TxSenderAcctID(tx)
+	require.NoError(t, err)
+	id, err := senderAcct.MarshalBinary()
+	require.NoError(t, err)
+	m.accounts[string(id)] = &types.Account{
+		ID:      senderAcct,
+		Balance: big.NewInt(100),
+	}
+
+	require.NoError(t, m.applyTransaction(txCtx, tx, db, rebroadcast))
+	assert.Equal(t, big.NewInt(40), m.accounts[string(id)].Balance)
+
+	// the spend of the replaced transaction is credited back, so the sender is
+	// not charged for both
+	require.NoError(t, m.applyTransaction(txCtx, newTransferTx(1, 20, 50), db, rebroadcast))
+	assert.Equal(t, big.NewInt(30), m.accounts[string(id)].Balance)
+
+	// a replacement that fails leaves the spend of the pending transaction
+	err = m.applyTransaction(txCtx, newTransferTx(1, 30, 200), db, rebroadcast)
+	require.ErrorIs(t, err, types.ErrInsufficientBalance)
+	assert.Equal(t, big.NewInt(30), m.accounts[string(id)].Balance)
+
+	require.NoError(t, m.applyTransaction(txCtx, newTransferTx(1, 30, 0), db, rebroadcast))
+	assert.Equal(t, big.NewInt(70), m.accounts[string(id)].Balance)
+	assert.EqualValues(t, 1, m.accounts[string(id)].Nonce)
+}
+
+func Test_MempoolEviction(t *testing.T) {
+	m := &mempool{
+		accounts:   make(map[string]*types.Account),
+		accountMgr: &mockAccount{},
+		log:        log.DiscardLogger,
+	}
+
+	txCtx := &common.TxContext{
+		Ctx: context.Background(),
+		BlockContext: &common.BlockContext{
+			ChainContext: &common.ChainContext{
+				NetworkParameters: &common.NetworkParameters{},
+			},
+		},
+	}
+
+	db := &mockDb{}
+	rebroadcast := &mockRebroadcast{}
+
+	to := &types.AccountID{Identifier: []byte("B"), KeyType: crypto.KeyTypeSecp256k1}
+	newTransferTx := func(nonce uint64, fee, amt int64) *types.Transaction {
+		payload, err := (&types.Transfer{To: to, Amount: big.NewInt(amt)}).MarshalBinary()
+		require.NoError(t, err)
+
+		tx := newTx(t, nonce, "A")
+		tx.Body.PayloadType = types.PayloadTypeTransfer
+		tx.Body.Payload = payload
+		tx.Body.Fee = big.NewInt(fee)
+		return tx
+	}
+
+	tx1, tx2 := newTransferTx(1, 10, 20), newTransferTx(2, 10, 30)
+	senderAcct, err := TxSenderAcctID(tx1)
+	require.NoError(t, err)
+	id, err := senderAcct.MarshalBinary()
+	require.NoError(t, err)
+	m.accounts[string(id)] = &types.Account{
+		ID:      senderAcct,
+		Balance: big.NewInt(100),
+	}
+
+	require.NoError(t, m.applyTransaction(txCtx, tx1, db, rebroadcast))
+	require.NoError(t, m.applyTransaction(txCtx, tx2, db, rebroadcast))
+	assert.Equal(t, big.NewInt(30), m.accounts[string(id)].Balance)
+
+	// only the last pending transaction of a sender may be evicted
+	require.Error(t, m.revertTransaction(tx1))
+
+	// evicting the last transaction credits back its spend and restores the
+	// pending nonce, so the next nonce has a gap
+	require.NoError(t, m.revertTransaction(tx2))
+	assert.Equal(t, big.NewInt(70), m.accounts[string(id)].Balance)
+	assert.EqualValues(t, 1, m.accounts[string(id)].Nonce)
+
+	err = m.applyTransaction(txCtx, newTransferTx(3, 10, 0), db, rebroadcast)
+	require.ErrorIs(t, err, nodetypes.ErrFutureNonce)
+
+	require.NoError(t, m.applyTransaction(txCtx, newTransferTx(2, 10, 0), db, rebroadcast))
+	assert.Equal(t, big.NewInt(60), m.accounts[string(id)].Balance)
+	assert.EqualValues(t, 2, m.accounts[string(id)].Nonce)
+
+	// a transaction that was not applied since the last reset is ignored
+	m.reset()
+	require.NoError(t, m.revertTransaction(tx2))
+}
+
+func Test_MempoolSponsored(t *testing.T) {
+	m := &mempool{
+		accounts:   make(map[string]*types.Account),
+		accountMgr: &mockAccount{},
+		log:        log.DiscardLogger,
+	}
+
+	txCtx := &common.TxContext{
+		Ctx: context.Background(),
+		BlockContext: &common.BlockContext{
+			ChainContext: &common.ChainContext{
+				NetworkParameters: &common.NetworkParameters{},
+			},
+		},
+	}
+
+	db := &mockDb{}
+	rebroadcast := &mockRebroadcast{}
+
+	newSponsoredTx := func(nonce uint64) *types.Transaction {
+		tx := newTx(t, nonce, "A")
+		tx.Body.Fee = big.NewInt(10)
+		tx.Body.FeePayer = &types.FeePayer{
+			Identifier: []byte("B"),
+			AuthType:   auth.EthPersonalSignAuth,
+		}
+		return tx
+	}
+
+	tx := newSponsoredTx(1)
+	senderAcct, err := TxSenderAcctID(tx)
+	require.NoError(t, err)
+	senderID, err := senderAcct.MarshalBinary()
+	require.NoError(t, err)
+	payerAcct, err := TxFeePayerAcctID(tx)
+	require.NoError(t, err)
+	payerID, err := payerAcct.MarshalBinary()
+	require.NoError(t, err)
+
+	// an unfunded fee payer may not sponsor a transaction
+	err = m.applyTransaction(txCtx, tx, db, rebroadcast)
+	require.ErrorIs(t, err, types.ErrInsufficientBalance)
+
+	// a funded fee payer pays for the unfunded sender
+	m.accounts[string(payerID)] = &types.Account{
+		ID:      payerAcct,
+		Balance: big.NewInt(100),
+	}
+	require.NoError(t, m.applyTransaction(txCtx, tx, db, rebroadcast))
+	require.NoError(t, m.applyTransaction(txCtx, newSponsoredTx(2), db, rebroadcast))
+
+	assert.EqualValues(t, 2, m.accounts[string(senderID)].Nonce)
+	assert.Equal(t, big.NewInt(0), m.accounts[string(senderID)].Balance)
+	assert.EqualValues(t, 0, m.accounts[string(payerID)].Nonce)
+	assert.Equal(t, big.NewInt(80), m.accounts[string(payerID)].Balance)
+}
+
+func Test_MempoolMultiOperation(t *testing.T) {
+	m := &mempool{
+		accounts:   make(map[string]*types.Account),
+		accountMgr: &mockAccount{},
+		log:        log.DiscardLogger,
+	}
+
+	txCtx := &common.TxContext{
+		Ctx: context.Background(),
+		BlockContext: &common.BlockContext{
+			ChainContext: &common.ChainContext{
+				NetworkParameters: &common.NetworkParameters{},
+			},
+		},
+	}
+
+	db := &mockDb{}
+	rebroadcast := &mockRebroadcast{}
+
+	to := &types.AccountID{Identifier: []byte("B"), KeyType: crypto.KeyTypeSecp256k1}
+	newMultiTx := func(nonce uint64, amounts ...int64) *types.Transaction {
+		multi := &types.MultiOperation{
+			Operations: []types.Payload{&types.ActionExecution{Namespace: "ns", Action: "act"}},
+		}
+		for _, amt := range amounts {
+			multi.Operations = append(multi.Operations, &types.Transfer{To: to, Amount: big.NewInt(amt)})
+		}
+		payload, err := multi.MarshalBinary()
+		require.NoError(t, err)
+
+		tx := newTx(t, nonce, "A")
+		tx.Body.PayloadType = types.PayloadTypeMultiOperation
+		tx.Body.Payload = payload
+		return tx
+	}
+
+	tx := newMultiTx(1, 60, 50)
+	senderAcct, err := TxSenderAcctID(tx)
+	require.NoError(t, err)
+	id, err := senderAcct.MarshalBinary()
+	require.NoError(t, err)
+	m.accounts[string(id)] = &types.Account{
+		ID:      senderAcct,
+		Balance: big.NewInt(100),
+	}
+
+	// the transfers of all operations are funded by the sender
+	err = m.applyTransaction(txCtx, tx, db, rebroadcast)
+	require.ErrorIs(t, err, types.ErrInsufficientBalance)
+
+	require.NoError(t, m.applyTransaction(txCtx, newMultiTx(1, 60, 30), db, rebroadcast))
+	assert.Equal(t, big.NewInt(10), m.accounts[string(id)].Balance)
+
+	// transfers are not allowed during a migration
+	txCtx.BlockContext.ChainContext.NetworkParameters.MigrationStatus = types.MigrationInProgress
+	err = m.applyTransaction(txCtx, newMultiTx(2, 1), db, rebroadcast)
+	require.ErrorIs(t, err, types.ErrDisallowedInMigration)
+}
+
+func Test_SponsorNamespaces(t *testing.T) {
+	payer := &types.AccountID{Identifier: []byte("B"), KeyType: crypto.KeyTypeSecp256k1}
+	other := &types.AccountID{Identifier: []byte("C"), KeyType: crypto.KeyTypeSecp256k1}
+
+	newExecTx := func(namespace string) *types.Transaction {
+		tx, err := types.CreateTransaction(&types.ActionExecution{Namespace: namespace, Action: "act"}, "chain", 1)
+		require.NoError(t, err)
+		return tx
+	}
+
+	policy := hooks.SponsorNamespaces(payer, "onboarding")
+	ctx := context.Background()
+
+	require.NoError(t, policy(ctx, payer, newExecTx("onboarding")))
+	require.NoError(t, policy(ctx, payer, newExecTx("Onboarding")))
+	require.ErrorIs(t, policy(ctx, payer, newExecTx("other")), types.ErrFeePayerRejected)
+	require.ErrorIs(t, policy(ctx, payer, newTx(t, 1, "A")), types.ErrFeePayerRejected)
+
+	// other fee payers are not restricted
+	require.NoError(t, policy(ctx, other, newExecTx("other")))
+
+	// every fee payer is restricted without a payer
+	require.ErrorIs(t, hooks.SponsorNamespaces(nil, "onboarding")(ctx, other, newExecTx("other")),
+		types.ErrFeePayerRejected)
+}
+
 func newTx(_ *testing.T, nonce uint64, sender string) *types.Transaction {
 	return &types.Transaction{
 		Signature: &auth.Signature{
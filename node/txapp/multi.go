@@ -0,0 +1,189 @@
+package txapp
+
+import (
+	"context"
+	"fmt"
+	"math/big"
+	"strings"
+
+	"github.com/trufnetwork/kwil-db/common"
+	"github.com/trufnetwork/kwil-db/core/types"
+	"github.com/trufnetwork/kwil-db/extensions/consensus"
+	"github.com/trufnetwork/kwil-db/node/types/sql"
+)
+
+// operationRoutes creates the route implementations of the operations of a
+// multi-operation payload. The routes keep the decoded payload between PreTx
+// and InTx, so each operation needs its own instance.
+var operationRoutes = map[types.PayloadType]func() consensus.Route{
+	types.PayloadTypeRawStatement: func() consensus.Route { return &rawStatementRoute{} },
+	types.PayloadTypeExecute:      func() consensus.Route { return &executeActionRoute{} },
+	types.PayloadTypeTransfer:     func() consensus.Route { return &transferRoute{} },
+}
+
+// operation is an operation of a multi-operation transaction, as a transaction
+// of its own payload that is executed with its route.
+type operation struct {
+	route consensus.Route
+	tx    *types.Transaction
+}
+
+// multiOperations decodes the operations of a multi-operation transaction.
+func multiOperations(tx *types.Transaction) ([]*operation, error) {
+	multi := &types.MultiOperation{}
+	if err := multi.UnmarshalBinary(tx.Body.Payload); err != nil {
+		return nil, err
+	}
+
+	ops := make([]*operation, len(multi.Operations))
+	for i, op := range multi.Operations {
+		newRoute, ok := operationRoutes[op.Type()]
+		if !ok { // the payload type is already checked when decoding
+			return nil, fmt.Errorf("operation %d: %w: %s", i, types.ErrUnknownPayloadType, op.Type())
+		}
+		payload, err := op.MarshalBinary()
+		if err != nil {
+			return nil, fmt.Errorf("operation %d: %w", i, err)
+		}
+
+		body := *tx.Body
+		body.PayloadType = op.Type()
+		body.Payload = payload
+		opTx := *tx
+		opTx.Body = &body
+
+		ops[i] = &operation{route: newRoute(), tx: &opTx}
+	}
+
+	return ops, nil
+}
+
+// multiOperationRoute executes the operations of a multi-operation transaction
+// in one nested DB transaction, so that either all of them take effect or none
+// do. The transaction is priced at the sum of the prices of its operations.
+type multiOperationRoute struct{}
+
+var _ Route = (*multiOperationRoute)(nil)
+
+func (d *multiOperationRoute) name() string {
+	return types.PayloadTypeMultiOperation.String()
+}
+
+func (d *multiOperationRoute) Price(ctx context.Context, router *TxApp, db sql.DB, tx *types.Transaction) (*big.Int, error) {
+	ops, err := multiOperations(tx)
+	if err != nil {
+		return nil, err
+	}
+
+	app := &common.App{
+		Service:    router.service.NamedLogger("route_" + d.name()),
+		DB:         db,
+		Engine:     router.Engine,
+		Accounts:   router.Accounts,
+		Validators: router.Validators,
+	}
+
+	total := big.NewInt(0)
+	for i, op := range ops {
+		price, err := op.route.Price(ctx, app, op.tx)
+		if err != nil {
+			return nil, fmt.Errorf("operation %d: %w", i, err)
+		}
+		total.Add(total, price)
+	}
+
+	return total, nil
+}
+
+func (d *multiOperationRoute) Execute(ctx *common.TxContext, router *TxApp, db sql.DB, tx *types.Transaction) *TxResponse {
+	dbTx, err := db.BeginTx(ctx.Ctx)
+	if err != nil {
+		return txRes(nil, types.CodeUnknownError, "", err)
+	}
+
+	spend, code, err := router.checkAndSpend(ctx, tx, d, dbTx)
+	if err != nil {
+		switch code {
+		case types.CodeOk, types.CodeInsufficientBalance, types.CodeInsufficientFee:
+			logErr(router.service.Logger, dbTx.Commit(ctx.Ctx))
+		default:
+			logErr(router.service.Logger, dbTx.Rollback(ctx.Ctx))
+		}
+		return txRes(spend, code, "", err)
+	}
+	defer func() {
+		// As in baseRoute, always commit the outer transaction with the spend.
+		err := dbTx.Commit(ctx.Ctx)
+		if err != nil {
+			router.service.Logger.Error("failed to commit DB tx for the spend", err)
+		}
+	}()
+
+	ops, err := multiOperations(tx)
+	if err != nil {
+		return txRes(spend, types.CodeEncodingError, "", err)
+	}
+
+	svc := router.service.NamedLogger("route_" + d.name())
+
+	tx2, err := dbTx.BeginTx(ctx.Ctx)
+	if err != nil {
+		return txRes(spend, types.CodeUnknownError, "", err)
+	}
+	defer tx2.Rollback(ctx.Ctx) // no-op if Commit succeeded
+
+	// Account updates and the engine's namespaces, tables, and actions are
+	// also recorded in memory, and must be discarded with tx2 if any operation
+	// fails.
+	restoreAccounts := router.Accounts.Checkpoint()
+	restoreEngine := func() {}
+	if eng, ok := router.Engine.(EngineCheckpointer); ok {
+		restoreEngine = eng.Checkpoint()
+	}
+	restore := func() {
+		restoreAccounts()
+		restoreEngine()
+	}
+
+	app := &common.App{
+		Service:    svc,
+		DB:         tx2,
+		Engine:     router.Engine,
+		Accounts:   router.Accounts,
+		Validators: router.Validators,
+	}
+
+	results := make([]types.OperationResult, 0, len(ops))
+	var logs []string
+	for i, op := range ops {
+		code, err := op.route.PreTx(ctx, svc, op.tx)
+		var log string
+		if err == nil {
+			code, log, err = op.route.InTx(ctx, app, op.tx)
+		}
+		if log != "" {
+			logs = append(logs, log)
+		}
+		if err != nil {
+			restore()
+			if log != "" {
+				log += "\n"
+			}
+			results = append(results, types.OperationResult{Code: uint32(code), Log: log + err.Error()})
+			res := txRes(spend, code, strings.Join(logs, "\n"), fmt.Errorf("operation %d: %w", i, err))
+			res.Operations = results
+			return res
+		}
+		results = append(results, types.OperationResult{Code: uint32(types.CodeOk), Log: log})
+	}
+
+	err = tx2.Commit(ctx.Ctx)
+	if err != nil {
+		restore()
+		return txRes(spend, types.CodeUnknownError, strings.Join(logs, "\n"), err)
+	}
+
+	res := txRes(spend, types.CodeOk, strings.Join(logs, "\n"), nil)
+	res.Operations = results
+	return res
+}
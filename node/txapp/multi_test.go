@@ -0,0 +1,113 @@
+package txapp
+
+import (
+	"context"
+	"math/big"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/trufnetwork/kwil-db/common"
+	"github.com/trufnetwork/kwil-db/core/crypto"
+	"github.com/trufnetwork/kwil-db/core/log"
+	"github.com/trufnetwork/kwil-db/core/types"
+	"github.com/trufnetwork/kwil-db/node/accounts"
+	"github.com/trufnetwork/kwil-db/node/types/sql"
+)
+
+// transferAccounts is a mockAccount that records transfers, and fails those of
+// more than its balance.
+type transferAccounts struct {
+	mockAccount
+	balance   *big.Int
+	transfers []*big.Int
+	restored  bool
+}
+
+func (a *transferAccounts) Transfer(_ context.Context, _ sql.TxMaker, from, to *types.AccountID, amount *big.Int) error {
+	if amount.Cmp(a.balance) > 0 {
+		return accounts.ErrInsufficientFunds
+	}
+	a.transfers = append(a.transfers, amount)
+	return nil
+}
+
+func (a *transferAccounts) Checkpoint() func() {
+	return func() { a.restored = true }
+}
+
+// checkpointEngine is an engine that records whether its checkpoint was
+// restored.
+type checkpointEngine struct {
+	common.Engine
+	restored bool
+}
+
+func (e *checkpointEngine) Checkpoint() func() {
+	return func() { e.restored = true }
+}
+
+func Test_ExecuteMultiOperation(t *testing.T) {
+	to := &types.AccountID{Identifier: signer2.CompactID(), KeyType: crypto.KeyTypeSecp256k1}
+	multi := func(amounts ...int64) *types.MultiOperation {
+		m := &types.MultiOperation{}
+		for _, amt := range amounts {
+			m.Operations = append(m.Operations, &types.Transfer{To: to, Amount: big.NewInt(amt)})
+		}
+		return m
+	}
+
+	ctx := &common.TxContext{
+		Ctx: context.Background(),
+		BlockContext: &common.BlockContext{
+			ChainContext: &common.ChainContext{
+				NetworkParameters: &types.NetworkParameters{DisabledGasCosts: true},
+			},
+		},
+	}
+
+	for _, tc := range []struct {
+		name      string
+		payload   *types.MultiOperation
+		code      types.TxCode
+		results   []types.TxCode
+		transfers int
+	}{
+		{"all succeed", multi(10, 20), types.CodeOk, []types.TxCode{types.CodeOk, types.CodeOk}, 2},
+		{"second fails", multi(10, 200, 20), types.CodeInsufficientBalance,
+			[]types.TxCode{types.CodeOk, types.CodeInsufficientBalance}, 1},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			accts := &transferAccounts{balance: big.NewInt(100)}
+			eng := &checkpointEngine{}
+			app := &TxApp{
+				Engine:     eng,
+				Accounts:   accts,
+				Validators: &mockValidator{},
+				signer:     signer1,
+				service: &common.Service{
+					Logger:   log.DiscardLogger,
+					Identity: signer1.CompactID(),
+				},
+			}
+
+			tx, err := types.CreateTransaction(tc.payload, "chainid", 1)
+			require.NoError(t, err)
+			tx.Body.Fee = big.NewInt(0)
+			require.NoError(t, tx.Sign(signer1))
+
+			res := app.Execute(ctx, &mockTx{&mockDb{}}, tx)
+			require.Equal(t, tc.code, res.ResponseCode)
+			require.Len(t, res.Operations, len(tc.results))
+			for i, code := range tc.results {
+				require.Equal(t, uint32(code), res.Operations[i].Code)
+			}
+			require.Len(t, accts.transfers, tc.transfers)
+			require.Equal(t, tc.code != types.CodeOk, accts.restored)
+			require.Equal(t, tc.code != types.CodeOk, eng.restored)
+			if tc.code != types.CodeOk {
+				require.ErrorIs(t, res.Error, accounts.ErrInsufficientFunds)
+			}
+		})
+	}
+}
@@ -0,0 +1,136 @@
+package txapp
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"fmt"
+	"math/big"
+
+	"github.com/trufnetwork/kwil-db/common"
+	"github.com/trufnetwork/kwil-db/core/crypto/auth"
+	"github.com/trufnetwork/kwil-db/core/types"
+	"github.com/trufnetwork/kwil-db/extensions/consensus"
+	"github.com/trufnetwork/kwil-db/node/accounts"
+	"github.com/trufnetwork/kwil-db/node/types/sql"
+)
+
+// authorizeMultisig checks that the multisig signatures of a transaction, by
+// its sender or fee payer, are for the registered definitions of the accounts.
+// The authenticator only verifies a multisig signature against the definition
+// that it includes, which the account may have replaced.
+func authorizeMultisig(ctx context.Context, db sql.Executor, tx *types.Transaction) error {
+	if tx.Signature != nil && tx.Signature.Type == auth.MultisigAuth {
+		if err := checkMultisigDefinition(ctx, db, tx.Sender, tx.Signature.Data); err != nil {
+			return err
+		}
+	}
+	if tx.FeePayerSignature != nil && tx.FeePayerSignature.Type == auth.MultisigAuth && tx.Body.FeePayer != nil {
+		if err := checkMultisigDefinition(ctx, db, tx.Body.FeePayer.Identifier, tx.FeePayerSignature.Data); err != nil {
+			return fmt.Errorf("fee payer: %w", err)
+		}
+	}
+	return nil
+}
+
+// checkMultisigDefinition checks that a multisig signature is for the
+// registered definition of the account.
+func checkMultisigDefinition(ctx context.Context, db sql.Executor, id, sigData []byte) error {
+	sig, err := auth.UnmarshalMultisigSignature(sigData)
+	if err != nil {
+		return fmt.Errorf("%w: %w", types.ErrMultisigRejected, err)
+	}
+	def, err := getMultisig(ctx, db, id)
+	if err != nil {
+		if errors.Is(err, accounts.ErrMultisigNotFound) {
+			return fmt.Errorf("%w: account %x is not registered", types.ErrMultisigRejected, id)
+		}
+		return err
+	}
+	if !bytes.Equal(def, sig.Multisig.Bytes()) {
+		return fmt.Errorf("%w: account %x", types.ErrMultisigRejected, id)
+	}
+	return nil
+}
+
+// registerMultisigRoute registers a multisig account, which any account may
+// do.
+type registerMultisigRoute struct {
+	multisig *auth.Multisig
+}
+
+var _ consensus.Route = (*registerMultisigRoute)(nil)
+
+func (d *registerMultisigRoute) Name() string {
+	return types.PayloadTypeRegisterMultisig.String()
+}
+
+func (d *registerMultisigRoute) Price(ctx context.Context, app *common.App, tx *types.Transaction) (*big.Int, error) {
+	return big.NewInt(210_000), nil
+}
+
+func (d *registerMultisigRoute) PreTx(ctx *common.TxContext, svc *common.Service, tx *types.Transaction) (types.TxCode, error) {
+	reg := &types.RegisterMultisig{}
+	if err := reg.UnmarshalBinary(tx.Body.Payload); err != nil {
+		return types.CodeEncodingError, err
+	}
+
+	multisig, err := auth.UnmarshalMultisig(reg.Definition)
+	if err != nil {
+		return types.CodeEncodingError, fmt.Errorf("invalid multisig definition: %w", err)
+	}
+
+	d.multisig = multisig
+	return 0, nil
+}
+
+func (d *registerMultisigRoute) InTx(ctx *common.TxContext, app *common.App, tx *types.Transaction) (types.TxCode, string, error) {
+	err := registerMultisig(ctx.Ctx, app.DB, d.multisig.ID(), d.multisig.Bytes())
+	if err != nil {
+		return types.CodeUnknownError, "", err
+	}
+	return 0, "", nil
+}
+
+// updateMultisigRoute replaces the definition of the multisig account that
+// sends it.
+type updateMultisigRoute struct {
+	multisig *auth.Multisig
+}
+
+var _ consensus.Route = (*updateMultisigRoute)(nil)
+
+func (d *updateMultisigRoute) Name() string {
+	return types.PayloadTypeUpdateMultisig.String()
+}
+
+func (d *updateMultisigRoute) Price(ctx context.Context, app *common.App, tx *types.Transaction) (*big.Int, error) {
+	return big.NewInt(210_000), nil
+}
+
+func (d *updateMultisigRoute) PreTx(ctx *common.TxContext, svc *common.Service, tx *types.Transaction) (types.TxCode, error) {
+	if tx.Signature.Type != auth.MultisigAuth {
+		return types.CodeInvalidSender, errors.New("only a multisig account may update its definition")
+	}
+
+	upd := &types.UpdateMultisig{}
+	if err := upd.UnmarshalBinary(tx.Body.Payload); err != nil {
+		return types.CodeEncodingError, err
+	}
+
+	multisig, err := auth.UnmarshalMultisig(upd.Definition)
+	if err != nil {
+		return types.CodeEncodingError, fmt.Errorf("invalid multisig definition: %w", err)
+	}
+
+	d.multisig = multisig
+	return 0, nil
+}
+
+func (d *updateMultisigRoute) InTx(ctx *common.TxContext, app *common.App, tx *types.Transaction) (types.TxCode, string, error) {
+	err := updateMultisig(ctx.Ctx, app.DB, tx.Sender, d.multisig.Bytes())
+	if err != nil {
+		return types.CodeUnknownError, "", err
+	}
+	return 0, "", nil
+}
@@ -0,0 +1,139 @@
+package txapp
+
+import (
+	"context"
+	"math/big"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/trufnetwork/kwil-db/common"
+	"github.com/trufnetwork/kwil-db/core/crypto/auth"
+	"github.com/trufnetwork/kwil-db/core/log"
+	"github.com/trufnetwork/kwil-db/core/types"
+	"github.com/trufnetwork/kwil-db/node/accounts"
+	"github.com/trufnetwork/kwil-db/node/types/sql"
+)
+
+func Test_Multisig(t *testing.T) {
+	newMultisig := func(t *testing.T, threshold uint8) *auth.Multisig {
+		m, err := auth.NewMultisig(threshold, []*auth.MultisigMember{
+			{AuthType: signer1.AuthType(), ID: signer1.CompactID()},
+			{AuthType: signer2.AuthType(), ID: signer2.CompactID()},
+		})
+		require.NoError(t, err)
+		return m
+	}
+	original, rotated := newMultisig(t, 1), newMultisig(t, 2)
+	id := original.ID()
+
+	newTx := func(t *testing.T, payload types.Payload, signer auth.Signer) *types.Transaction {
+		tx, err := types.CreateTransaction(payload, "chainid", 1)
+		require.NoError(t, err)
+		tx.Body.Fee = big.NewInt(0)
+		require.NoError(t, tx.Sign(signer))
+		return tx
+	}
+
+	ctx := &common.TxContext{
+		Ctx: context.Background(),
+		BlockContext: &common.BlockContext{
+			ChainContext: &common.ChainContext{
+				NetworkParameters: &types.NetworkParameters{DisabledGasCosts: true},
+			},
+			Height: 10,
+		},
+	}
+	app := &TxApp{
+		Engine:     &callerEngine{},
+		Accounts:   &mockAccount{},
+		Validators: &mockValidator{},
+		signer:     signer1,
+		service: &common.Service{
+			Logger:   log.DiscardLogger,
+			Identity: signer1.CompactID(),
+		},
+	}
+
+	// the registry has one account, with an ID and its current definition
+	var registered []byte
+	getMultisig = func(_ context.Context, _ sql.Executor, gotID []byte) ([]byte, error) {
+		if registered == nil {
+			return nil, accounts.ErrMultisigNotFound
+		}
+		require.Equal(t, id, gotID)
+		return registered, nil
+	}
+	registerMultisig = func(_ context.Context, _ sql.Executor, gotID, def []byte) error {
+		if registered != nil {
+			return accounts.ErrMultisigExists
+		}
+		require.Equal(t, id, gotID)
+		registered = def
+		return nil
+	}
+	updateMultisig = func(_ context.Context, _ sql.Executor, gotID, def []byte) error {
+		require.Equal(t, id, gotID)
+		registered = def
+		return nil
+	}
+	defer func() {
+		getMultisig = accounts.GetMultisig
+		registerMultisig = accounts.RegisterMultisig
+		updateMultisig = accounts.UpdateMultisig
+	}()
+
+	execute := &types.ActionExecution{Namespace: "main", Action: "move"}
+
+	t.Run("not registered", func(t *testing.T) {
+		tx := newTx(t, execute, &auth.MultisigSigner{Multisig: original, Signers: []auth.Signer{signer1}})
+		res := app.Execute(ctx, &mockTx{&mockDb{}}, tx)
+		require.ErrorIs(t, res.Error, types.ErrMultisigRejected)
+		require.Equal(t, types.CodeMultisigRejected, res.ResponseCode)
+
+		err := app.ApplyMempool(ctx, &mockTx{&mockDb{}}, tx)
+		require.ErrorIs(t, err, types.ErrMultisigRejected)
+	})
+
+	t.Run("register", func(t *testing.T) {
+		reg := &types.RegisterMultisig{Definition: original.Bytes()}
+		res := app.Execute(ctx, &mockTx{&mockDb{}}, newTx(t, reg, signer2))
+		require.NoError(t, res.Error)
+		require.Equal(t, original.Bytes(), registered)
+
+		res = app.Execute(ctx, &mockTx{&mockDb{}}, newTx(t, reg, signer2))
+		require.ErrorIs(t, res.Error, accounts.ErrMultisigExists)
+
+		res = app.Execute(ctx, &mockTx{&mockDb{}}, newTx(t, &types.RegisterMultisig{Definition: []byte{1}}, signer2))
+		require.Equal(t, types.CodeEncodingError, res.ResponseCode)
+	})
+
+	t.Run("registered definition", func(t *testing.T) {
+		tx := newTx(t, execute, &auth.MultisigSigner{Multisig: original, Signers: []auth.Signer{signer1}})
+		require.NoError(t, authorizeMultisig(ctx.Ctx, &mockDb{}, tx))
+		res := app.Execute(ctx, &mockTx{&mockDb{}}, tx)
+		require.NoError(t, res.Error)
+	})
+
+	t.Run("update", func(t *testing.T) {
+		upd := &types.UpdateMultisig{Definition: rotated.Bytes()}
+
+		// only the multisig account may update its definition
+		res := app.Execute(ctx, &mockTx{&mockDb{}}, newTx(t, upd, signer1))
+		require.Equal(t, types.CodeInvalidSender, res.ResponseCode)
+		require.Equal(t, original.Bytes(), registered)
+
+		res = app.Execute(ctx, &mockTx{&mockDb{}}, newTx(t, upd, &auth.MultisigSigner{Multisig: original, Signers: []auth.Signer{signer2}}))
+		require.NoError(t, res.Error)
+		require.Equal(t, rotated.Bytes(), registered)
+
+		// the replaced definition may no longer sign for the account, and the
+		// new one signs with the account's ID
+		tx := newTx(t, execute, &auth.MultisigSigner{Multisig: original, Signers: []auth.Signer{signer1}})
+		require.ErrorIs(t, authorizeMultisig(ctx.Ctx, &mockDb{}, tx), types.ErrMultisigRejected)
+
+		tx = newTx(t, execute, &auth.MultisigSigner{Multisig: rotated, ID: id, Signers: []auth.Signer{signer1, signer2}})
+		require.Equal(t, id, []byte(tx.Sender))
+		require.NoError(t, authorizeMultisig(ctx.Ctx, &mockDb{}, tx))
+	})
+}
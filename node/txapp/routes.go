@@ -34,6 +34,11 @@ func init() {
 		RegisterRoute(types.PayloadTypeValidatorVoteBodies, NewRoute(&validatorVoteBodiesRoute{})),
 		RegisterRoute(types.PayloadTypeCreateResolution, NewRoute(&createResolutionRoute{})),
 		RegisterRoute(types.PayloadTypeApproveResolution, NewRoute(&approveResolutionRoute{})),
+		RegisterRoute(types.PayloadTypeMultiOperation, &multiOperationRoute{}),
+		RegisterRoute(types.PayloadTypeCreateSession, NewRoute(&createSessionRoute{})),
+		RegisterRoute(types.PayloadTypeRevokeSession, NewRoute(&revokeSessionRoute{})),
+		RegisterRoute(types.PayloadTypeRegisterMultisig, NewRoute(&registerMultisigRoute{})),
+		RegisterRoute(types.PayloadTypeUpdateMultisig, NewRoute(&updateMultisigRoute{})),
 	)
 	if err != nil {
 		panic(fmt.Sprintf("failed to register routes: %s", err))
@@ -304,7 +304,7 @@ func (a *mockAccount) NumAccounts(ctx context.Context, tx sql.Executor) (int64,
 	return 1, nil
 }
 
-func (a *mockAccount) Spend(_ context.Context, _ sql.Executor, acctID *types.AccountID, amount *big.Int, nonce int64) error {
+func (a *mockAccount) Spend(_ context.Context, _ sql.Executor, acctID, payer *types.AccountID, amount *big.Int, nonce int64) error {
 	return nil
 }
 
@@ -325,6 +325,10 @@ func (a *mockAccount) Commit() error {
 
 func (a *mockAccount) Rollback() {}
 
+func (a *mockAccount) Checkpoint() func() {
+	return func() {}
+}
+
 type mockValidator struct {
 	getVoterFn getVoterPowerFunc
 }
@@ -359,3 +363,39 @@ func getSigner(hexPrivKey string) (crypto.PrivateKey, auth.Signer) {
 
 	return pk, auth.GetNodeSigner(pk)
 }
+
+func Test_ExecuteOutOfHeightRange(t *testing.T) {
+	tx, err := types.CreateTransaction(&types.ValidatorVoteIDs{}, "chainid", 1)
+	require.NoError(t, err)
+	tx.Body.ValidAfterHeight = 5
+	tx.Body.ValidUntilHeight = 10
+	require.NoError(t, tx.Sign(signer1))
+
+	app := &TxApp{
+		Accounts:   &mockAccount{},
+		Validators: &mockValidator{},
+		signer:     signer1,
+		service: &common.Service{
+			Logger:   log.DiscardLogger,
+			Identity: signer1.CompactID(),
+		},
+	}
+
+	for _, height := range []int64{5, 11} {
+		ctx := &common.TxContext{
+			BlockContext: &common.BlockContext{
+				ChainContext: &common.ChainContext{
+					NetworkParameters: &types.NetworkParameters{},
+				},
+				Height: height,
+			},
+		}
+
+		res := app.Execute(ctx, &mockTx{&mockDb{}}, tx)
+		require.ErrorIs(t, res.Error, types.ErrTxOutOfHeightRange)
+		require.Equal(t, types.CodeTxOutOfHeightRange, res.ResponseCode)
+
+		err := app.ApplyMempool(ctx, &mockTx{&mockDb{}}, tx)
+		require.ErrorIs(t, err, types.ErrTxOutOfHeightRange)
+	}
+}
@@ -0,0 +1,230 @@
+package txapp
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"math/big"
+	"slices"
+	"strings"
+
+	"github.com/trufnetwork/kwil-db/common"
+	"github.com/trufnetwork/kwil-db/core/types"
+	authExt "github.com/trufnetwork/kwil-db/extensions/auth"
+	"github.com/trufnetwork/kwil-db/extensions/consensus"
+	"github.com/trufnetwork/kwil-db/node/accounts"
+	"github.com/trufnetwork/kwil-db/node/engine"
+	"github.com/trufnetwork/kwil-db/node/types/sql"
+)
+
+// executeSession executes a transaction that a session key signed for its
+// delegator. The transaction is executed with the delegator as the caller, and
+// the amount spent is added to what the session has spent.
+func (r *TxApp) executeSession(ctx *common.TxContext, route Route, db sql.DB, tx *types.Transaction) *TxResponse {
+	session, err := authorizeSession(ctx.Ctx, db, tx, ctx.BlockContext.Height)
+	if err != nil {
+		if errors.Is(err, types.ErrSessionRejected) {
+			return txRes(nil, types.CodeSessionRejected, "", err)
+		}
+		return txRes(nil, types.CodeUnknownError, "", err)
+	}
+
+	delegator := tx.Body.Delegator
+	caller, err := authExt.GetIdentifier(delegator.AuthType, delegator.Identifier)
+	if err != nil {
+		return txRes(nil, types.CodeSessionRejected, "", fmt.Errorf("%w: %w", types.ErrSessionRejected, err))
+	}
+
+	delegated := *ctx
+	delegated.Signer = delegator.Identifier
+	delegated.Caller = caller
+	delegated.Authenticator = delegator.AuthType
+
+	res := route.Execute(&delegated, r, db, tx)
+	if res.Spend == 0 {
+		return res
+	}
+
+	session.Spent.Add(session.Spent, big.NewInt(res.Spend))
+	if err := updateSessionSpent(ctx.Ctx, db, session); err != nil {
+		return txRes(big.NewInt(res.Spend), types.CodeUnknownError, res.Log, fmt.Errorf("failed to record session spend: %w", err))
+	}
+
+	return res
+}
+
+// authorizeSession checks that the session key that signed a transaction for
+// its delegator is authorized to do so at the given height, and returns the
+// session. Session keys may only execute the actions in the scope of the
+// session, and only while the fee and transferred value of the transaction
+// would not take the session over its spend cap.
+func authorizeSession(ctx context.Context, db sql.Executor, tx *types.Transaction, height int64) (*accounts.Session, error) {
+	delegator := tx.Body.Delegator
+	session, err := getSession(ctx, db, delegator.Identifier, delegator.AuthType, tx.Sender, tx.Signature.Type)
+	if err != nil {
+		if errors.Is(err, accounts.ErrSessionNotFound) {
+			return nil, fmt.Errorf("%w: key %x has no session for delegator %x", types.ErrSessionRejected,
+				tx.Sender, delegator.Identifier)
+		}
+		return nil, err
+	}
+
+	if height > session.Expiry {
+		return nil, fmt.Errorf("%w: session expired at height %d", types.ErrSessionRejected, session.Expiry)
+	}
+
+	// the value that a transaction transfers counts toward the cap, so the
+	// cap holds even for payloads that a session may not sign
+	if session.SpendCap != nil {
+		amt, err := transferAmount(tx)
+		if err != nil {
+			return nil, err
+		}
+		spend := amt.Add(amt, tx.Body.Fee)
+		if total := new(big.Int).Add(session.Spent, spend); total.Cmp(session.SpendCap) > 0 {
+			return nil, fmt.Errorf("%w: spend %s would exceed the session spend cap %s, of which %s is spent",
+				types.ErrSessionRejected, spend, session.SpendCap, session.Spent)
+		}
+	}
+
+	actions, err := sessionActions(tx)
+	if err != nil {
+		return nil, err
+	}
+	for _, action := range actions {
+		namespace := action.Namespace
+		if namespace == "" {
+			namespace = engine.DefaultNamespace
+		}
+		if !inSessionScope(session.Namespaces, namespace) || !inSessionScope(session.Actions, action.Action) {
+			return nil, fmt.Errorf("%w: action %s.%s is not in the scope of the session", types.ErrSessionRejected,
+				namespace, action.Action)
+		}
+	}
+
+	return session, nil
+}
+
+// sessionActions returns the actions executed by a transaction signed by a
+// session key, which may either be an action execution, or a multi-operation
+// transaction of only action executions.
+func sessionActions(tx *types.Transaction) ([]*types.ActionExecution, error) {
+	switch tx.Body.PayloadType {
+	case types.PayloadTypeExecute:
+		action := &types.ActionExecution{}
+		if err := action.UnmarshalBinary(tx.Body.Payload); err != nil {
+			return nil, err
+		}
+		return []*types.ActionExecution{action}, nil
+	case types.PayloadTypeMultiOperation:
+		multi := &types.MultiOperation{}
+		if err := multi.UnmarshalBinary(tx.Body.Payload); err != nil {
+			return nil, err
+		}
+		actions := make([]*types.ActionExecution, len(multi.Operations))
+		for i, op := range multi.Operations {
+			action, ok := op.(*types.ActionExecution)
+			if !ok {
+				return nil, fmt.Errorf("%w: operation %d: session keys may not sign %s operations",
+					types.ErrSessionRejected, i, op.Type())
+			}
+			actions[i] = action
+		}
+		return actions, nil
+	default:
+		return nil, fmt.Errorf("%w: session keys may not sign %s transactions", types.ErrSessionRejected,
+			tx.Body.PayloadType)
+	}
+}
+
+// inSessionScope says if a name is in the scope of a session, which is any
+// name if the scope is empty. Names are case-insensitive.
+func inSessionScope(scope []string, name string) bool {
+	return len(scope) == 0 || slices.ContainsFunc(scope, func(s string) bool {
+		return strings.EqualFold(s, name)
+	})
+}
+
+// createSessionRoute authorizes a session key to sign transactions for the
+// sender.
+type createSessionRoute struct {
+	session *types.CreateSession
+}
+
+var _ consensus.Route = (*createSessionRoute)(nil)
+
+func (d *createSessionRoute) Name() string {
+	return types.PayloadTypeCreateSession.String()
+}
+
+func (d *createSessionRoute) Price(ctx context.Context, app *common.App, tx *types.Transaction) (*big.Int, error) {
+	return big.NewInt(210_000), nil
+}
+
+func (d *createSessionRoute) PreTx(ctx *common.TxContext, svc *common.Service, tx *types.Transaction) (types.TxCode, error) {
+	session := &types.CreateSession{}
+	if err := session.UnmarshalBinary(tx.Body.Payload); err != nil {
+		return types.CodeEncodingError, err
+	}
+
+	if _, err := authExt.GetAuthenticatorKeyType(session.AuthType); err != nil {
+		return types.CodeEncodingError, fmt.Errorf("invalid session key type: %w", err)
+	}
+	if session.SpendCap != nil && session.SpendCap.Sign() < 0 {
+		return types.CodeInvalidAmount, fmt.Errorf("invalid session spend cap: %s", session.SpendCap)
+	}
+
+	d.session = session
+	return 0, nil
+}
+
+func (d *createSessionRoute) InTx(ctx *common.TxContext, app *common.App, tx *types.Transaction) (types.TxCode, string, error) {
+	err := createSession(ctx.Ctx, app.DB, &accounts.Session{
+		Delegator:     tx.Sender,
+		DelegatorAuth: tx.Signature.Type,
+		SessionKey:    d.session.SessionKey,
+		SessionAuth:   d.session.AuthType,
+		Namespaces:    d.session.Namespaces,
+		Actions:       d.session.Actions,
+		Expiry:        d.session.Expiry,
+		SpendCap:      d.session.SpendCap,
+		Spent:         big.NewInt(0),
+	})
+	if err != nil {
+		return types.CodeUnknownError, "", err
+	}
+	return 0, "", nil
+}
+
+// revokeSessionRoute revokes a session key of the sender.
+type revokeSessionRoute struct {
+	session *types.RevokeSession
+}
+
+var _ consensus.Route = (*revokeSessionRoute)(nil)
+
+func (d *revokeSessionRoute) Name() string {
+	return types.PayloadTypeRevokeSession.String()
+}
+
+func (d *revokeSessionRoute) Price(ctx context.Context, app *common.App, tx *types.Transaction) (*big.Int, error) {
+	return big.NewInt(210_000), nil
+}
+
+func (d *revokeSessionRoute) PreTx(ctx *common.TxContext, svc *common.Service, tx *types.Transaction) (types.TxCode, error) {
+	session := &types.RevokeSession{}
+	if err := session.UnmarshalBinary(tx.Body.Payload); err != nil {
+		return types.CodeEncodingError, err
+	}
+
+	d.session = session
+	return 0, nil
+}
+
+func (d *revokeSessionRoute) InTx(ctx *common.TxContext, app *common.App, tx *types.Transaction) (types.TxCode, string, error) {
+	err := revokeSession(ctx.Ctx, app.DB, tx.Sender, tx.Signature.Type, d.session.SessionKey, d.session.AuthType)
+	if err != nil {
+		return types.CodeUnknownError, "", err
+	}
+	return 0, "", nil
+}
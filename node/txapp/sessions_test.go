@@ -0,0 +1,215 @@
+package txapp
+
+import (
+	"context"
+	"math/big"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/trufnetwork/kwil-db/common"
+	"github.com/trufnetwork/kwil-db/core/log"
+	"github.com/trufnetwork/kwil-db/core/types"
+	authExt "github.com/trufnetwork/kwil-db/extensions/auth"
+	"github.com/trufnetwork/kwil-db/node/accounts"
+	"github.com/trufnetwork/kwil-db/node/types/sql"
+)
+
+// callerEngine is an engine that records the callers of the actions it calls.
+type callerEngine struct {
+	common.Engine
+	callers []string
+}
+
+func (e *callerEngine) Call(ctx *common.EngineContext, _ sql.DB, _, _ string, _ []any, _ func(*common.Row) error) (*common.CallResult, error) {
+	e.callers = append(e.callers, ctx.TxContext.Caller)
+	return &common.CallResult{}, nil
+}
+
+func Test_Sessions(t *testing.T) {
+	delegator, sessionKey := signer1, signer2
+
+	newTx := func(t *testing.T, payload types.Payload, fee int64) *types.Transaction {
+		tx, err := types.CreateTransaction(payload, "chainid", 1)
+		require.NoError(t, err)
+		tx.Body.Fee = big.NewInt(fee)
+		tx.Body.Delegator = &types.Delegator{
+			Identifier: delegator.CompactID(),
+			AuthType:   delegator.AuthType(),
+		}
+		require.NoError(t, tx.Sign(sessionKey))
+		return tx
+	}
+	execute := func(namespace, action string) *types.ActionExecution {
+		return &types.ActionExecution{Namespace: namespace, Action: action}
+	}
+
+	ctx := &common.TxContext{
+		Ctx: context.Background(),
+		BlockContext: &common.BlockContext{
+			ChainContext: &common.ChainContext{
+				NetworkParameters: &types.NetworkParameters{DisabledGasCosts: true},
+			},
+			Height: 10,
+		},
+	}
+
+	var session *accounts.Session
+	getSession = func(_ context.Context, _ sql.Executor, delegatorID []byte, delegatorAuth string, key []byte, keyAuth string) (*accounts.Session, error) {
+		if session == nil {
+			return nil, accounts.ErrSessionNotFound
+		}
+		require.Equal(t, delegator.CompactID(), delegatorID)
+		require.Equal(t, delegator.AuthType(), delegatorAuth)
+		require.Equal(t, sessionKey.CompactID(), key)
+		require.Equal(t, sessionKey.AuthType(), keyAuth)
+		return session, nil
+	}
+	var spent *big.Int
+	updateSessionSpent = func(_ context.Context, _ sql.Executor, s *accounts.Session) error {
+		spent = new(big.Int).Set(s.Spent)
+		return nil
+	}
+	defer func() {
+		getSession = accounts.GetSession
+		updateSessionSpent = accounts.UpdateSessionSpent
+	}()
+
+	newSession := func() *accounts.Session {
+		return &accounts.Session{
+			Namespaces: []string{"main"},
+			Actions:    []string{"move", "attack"},
+			Expiry:     10,
+			SpendCap:   big.NewInt(100),
+			Spent:      big.NewInt(40),
+		}
+	}
+
+	t.Run("authorize", func(t *testing.T) {
+		for _, tc := range []struct {
+			name    string
+			session func(s *accounts.Session)
+			payload types.Payload
+			fee     int64
+			ok      bool
+		}{
+			{"in scope", nil, execute("", "MOVE"), 60, true},
+			{"unscoped", func(s *accounts.Session) { s.Namespaces, s.Actions = nil, nil },
+				execute("other", "anything"), 0, true},
+			{"multi in scope", nil, &types.MultiOperation{Operations: []types.Payload{
+				execute("main", "move"), execute("Main", "attack"),
+			}}, 0, true},
+			{"no session", nil, execute("main", "move"), 0, false},
+			{"expired", func(s *accounts.Session) { s.Expiry = 9 }, execute("main", "move"), 0, false},
+			{"namespace out of scope", nil, execute("other", "move"), 0, false},
+			{"action out of scope", nil, execute("main", "withdraw"), 0, false},
+			{"multi out of scope", nil, &types.MultiOperation{Operations: []types.Payload{
+				execute("main", "move"), execute("main", "withdraw"),
+			}}, 0, false},
+			{"transfer", nil, &types.Transfer{
+				To:     &types.AccountID{Identifier: delegator.CompactID(), KeyType: delegator.PubKey().Type()},
+				Amount: big.NewInt(1),
+			}, 0, false},
+			{"over spend cap", nil, execute("main", "move"), 61, false},
+			{"transfer over spend cap", nil, &types.Transfer{
+				To:     &types.AccountID{Identifier: delegator.CompactID(), KeyType: delegator.PubKey().Type()},
+				Amount: big.NewInt(51),
+			}, 10, false},
+			{"multi transfer over spend cap", func(s *accounts.Session) { s.Namespaces, s.Actions = nil, nil },
+				&types.MultiOperation{Operations: []types.Payload{
+					execute("main", "move"),
+					&types.Transfer{
+						To:     &types.AccountID{Identifier: delegator.CompactID(), KeyType: delegator.PubKey().Type()},
+						Amount: big.NewInt(61),
+					},
+				}}, 0, false},
+			{"uncapped", func(s *accounts.Session) { s.SpendCap = nil }, execute("main", "move"), 1000, true},
+		} {
+			t.Run(tc.name, func(t *testing.T) {
+				session = newSession()
+				if tc.name == "no session" {
+					session = nil
+				}
+				if tc.session != nil {
+					tc.session(session)
+				}
+
+				tx := newTx(t, tc.payload, tc.fee)
+				_, err := authorizeSession(ctx.Ctx, &mockDb{}, tx, ctx.BlockContext.Height)
+				if tc.ok {
+					require.NoError(t, err)
+				} else {
+					require.ErrorIs(t, err, types.ErrSessionRejected)
+				}
+				if strings.HasSuffix(tc.name, "over spend cap") {
+					require.ErrorContains(t, err, "would exceed the session spend cap")
+				}
+			})
+		}
+	})
+
+	t.Run("execute as delegator", func(t *testing.T) {
+		session, spent = newSession(), nil
+		eng := &callerEngine{}
+		app := &TxApp{
+			Engine:     eng,
+			Accounts:   &mockAccount{},
+			Validators: &mockValidator{},
+			signer:     signer1,
+			service: &common.Service{
+				Logger:   log.DiscardLogger,
+				Identity: signer1.CompactID(),
+			},
+		}
+
+		res := app.Execute(ctx, &mockTx{&mockDb{}}, newTx(t, execute("main", "move"), 0))
+		require.NoError(t, res.Error)
+		require.Equal(t, types.CodeOk, res.ResponseCode)
+
+		caller, err := authExt.GetIdentifier(delegator.AuthType(), delegator.CompactID())
+		require.NoError(t, err)
+		require.Equal(t, []string{caller}, eng.callers)
+		require.Nil(t, spent) // nothing spent without gas
+
+		res = app.Execute(ctx, &mockTx{&mockDb{}}, newTx(t, execute("main", "withdraw"), 0))
+		require.ErrorIs(t, res.Error, types.ErrSessionRejected)
+		require.Equal(t, types.CodeSessionRejected, res.ResponseCode)
+		require.Len(t, eng.callers, 1)
+
+		err = app.ApplyMempool(ctx, &mockTx{&mockDb{}}, newTx(t, execute("main", "withdraw"), 0))
+		require.ErrorIs(t, err, types.ErrSessionRejected)
+	})
+
+	t.Run("record spend", func(t *testing.T) {
+		session, spent = newSession(), nil
+		gasCtx := *ctx
+		gasCtx.BlockContext = &common.BlockContext{
+			ChainContext: &common.ChainContext{NetworkParameters: &types.NetworkParameters{}},
+			Height:       10,
+		}
+		app := &TxApp{
+			Engine:     &callerEngine{},
+			Accounts:   &mockAccount{},
+			Validators: &mockValidator{},
+			signer:     signer1,
+			service: &common.Service{
+				Logger:   log.DiscardLogger,
+				Identity: signer1.CompactID(),
+			},
+		}
+
+		// the fee is below the price, so only the fee is spent
+		res := app.Execute(&gasCtx, &mockTx{&mockDb{}}, newTx(t, execute("main", "move"), 50))
+		require.Equal(t, types.CodeInsufficientFee, res.ResponseCode)
+		require.Equal(t, int64(50), res.Spend)
+		require.Equal(t, big.NewInt(90), spent)
+	})
+
+	t.Run("fee payer", func(t *testing.T) {
+		tx := newTx(t, execute("main", "move"), 0)
+		payer, err := TxFeePayerAcctID(tx)
+		require.NoError(t, err)
+		require.Equal(t, delegator.CompactID(), []byte(payer.Identifier))
+	})
+}
@@ -66,6 +66,7 @@ func NewTxApp(ctx context.Context, db sql.Executor, engine common.Engine, signer
 		events: events,
 		mempool: &mempool{
 			accounts:     make(map[string]*types.Account),
+			spends:       make(map[spendKey]*pendingSpend),
 			accountMgr:   accounts,
 			validatorMgr: validators,
 			nodeIdent:    signer,
@@ -159,11 +160,27 @@ func (r *TxApp) Execute(ctx *common.TxContext, db sql.DB, tx *types.Transaction)
 		return txRes(nil, types.CodeInvalidTxType, "", fmt.Errorf("%w: %s", types.ErrUnknownPayloadType, tx.Body.PayloadType.String()))
 	}
 
+	// a proposer may include a transaction that is not valid in the block
+	if err := tx.Body.ValidAt(ctx.BlockContext.Height); err != nil {
+		return txRes(nil, types.CodeTxOutOfHeightRange, "", err)
+	}
+
 	r.service.Logger.Debug("executing transaction", "tx", tx)
 
 	// no need to error out if we cannot track the validator join approval
 	r.trackValidatorJoinApprovals(tx)
 
+	if err := authorizeMultisig(ctx.Ctx, db, tx); err != nil {
+		if errors.Is(err, types.ErrMultisigRejected) {
+			return txRes(nil, types.CodeMultisigRejected, "", err)
+		}
+		return txRes(nil, types.CodeUnknownError, "", err)
+	}
+
+	if tx.Body.Delegator != nil {
+		return r.executeSession(ctx, route, db, tx)
+	}
+
 	// track event count
 	return route.Execute(ctx, r, db, tx)
 }
@@ -474,6 +491,10 @@ type TxResponse struct {
 
 	// Error is the error returned by the transaction, if any
 	Error error
+
+	// Operations are the results of the operations of a multi-operation
+	// transaction.
+	Operations []types.OperationResult
 }
 
 // txRes wraps a spend, tx code, and error into a tx response.
@@ -516,6 +537,7 @@ func (r *TxApp) Price(ctx context.Context, dbTx sql.DB, tx *types.Transaction, c
 // it will return an error.
 // if the transaction does not have the correct nonce, it will return an error.
 // it will spend the tokens if the caller has enough tokens.
+// the tokens for a sponsored transaction are spent from its fee payer instead.
 // It also returns an error code.
 // if we allow users to implement their own routes, this function will need to
 // be exported.
@@ -535,25 +557,35 @@ func (r *TxApp) checkAndSpend(ctx *common.TxContext, tx *types.Transaction, pric
 		return nil, types.CodeInvalidSender, err
 	}
 
+	// The fee of a sponsored transaction is paid by its fee payer.
+	payer, err := txFeePayer(ctx.Ctx, tx)
+	if err != nil {
+		return nil, types.CodeFeePayerRejected, err
+	}
+	feeAcct := sender
+	if payer != nil {
+		feeAcct = payer
+	}
+
 	// Get account info
-	account, err := r.Accounts.GetAccount(ctx.Ctx, dbTx, sender)
+	account, err := r.Accounts.GetAccount(ctx.Ctx, dbTx, feeAcct)
 	if err == nil {
-		r.service.Logger.Debug("account info", "account", tx.Sender, "balance", account.Balance, "nonce", account.Nonce)
+		r.service.Logger.Debug("account info", "account", feeAcct, "balance", account.Balance, "nonce", account.Nonce)
 	}
 
 	// check if the transaction consented to spending enough tokens
 	if tx.Body.Fee.Cmp(amt) < 0 {
 		// If the transaction does not consent to spending required tokens for the transaction execution,
 		// spend the approved tx fee and terminate the transaction
-		err = r.Accounts.Spend(ctx.Ctx, dbTx, sender, tx.Body.Fee, int64(tx.Body.Nonce))
+		err = r.Accounts.Spend(ctx.Ctx, dbTx, sender, payer, tx.Body.Fee, int64(tx.Body.Nonce))
 		if errors.Is(err, accounts.ErrInsufficientFunds) {
 			// spend as much as possible
-			account, err := r.Accounts.GetAccount(ctx.Ctx, dbTx, sender)
+			account, err := r.Accounts.GetAccount(ctx.Ctx, dbTx, feeAcct)
 			if err != nil { // account will just be empty if not found
 				return nil, types.CodeUnknownError, err
 			}
 
-			err2 := r.Accounts.Spend(ctx.Ctx, dbTx, sender, account.Balance, int64(tx.Body.Nonce))
+			err2 := r.Accounts.Spend(ctx.Ctx, dbTx, sender, payer, account.Balance, int64(tx.Body.Nonce))
 			if err2 != nil {
 				if errors.Is(err2, accounts.ErrAccountNotFound) {
 					return nil, types.CodeInsufficientBalance, errors.New("account has zero balance")
@@ -575,15 +607,15 @@ func (r *TxApp) checkAndSpend(ctx *common.TxContext, tx *types.Transaction, pric
 	}
 
 	// spend the tokens
-	err = r.Accounts.Spend(ctx.Ctx, dbTx, sender, amt, int64(tx.Body.Nonce))
+	err = r.Accounts.Spend(ctx.Ctx, dbTx, sender, payer, amt, int64(tx.Body.Nonce))
 	if errors.Is(err, accounts.ErrInsufficientFunds) {
 		// spend as much as possible
-		account, err := r.Accounts.GetAccount(ctx.Ctx, dbTx, sender)
+		account, err := r.Accounts.GetAccount(ctx.Ctx, dbTx, feeAcct)
 		if err != nil {
 			return nil, types.CodeUnknownError, err
 		}
 
-		err2 := r.Accounts.Spend(ctx.Ctx, dbTx, sender, account.Balance, int64(tx.Body.Nonce))
+		err2 := r.Accounts.Spend(ctx.Ctx, dbTx, sender, payer, account.Balance, int64(tx.Body.Nonce))
 		if err2 != nil {
 			return nil, types.CodeUnknownError, err2
 		}
@@ -608,9 +640,33 @@ func (r *TxApp) ApplyMempool(ctx *common.TxContext, db sql.DB, tx *types.Transac
 		return fmt.Errorf("%w: %s", types.ErrUnknownPayloadType, tx.Body.PayloadType.String())
 	}
 
+	// the transaction must be valid in the next block, which also evicts
+	// expired transactions when the mempool is rechecked
+	if err := tx.Body.ValidAt(ctx.BlockContext.Height); err != nil {
+		return err
+	}
+
+	if err := authorizeMultisig(ctx.Ctx, db, tx); err != nil {
+		return err
+	}
+
+	if tx.Body.Delegator != nil {
+		if _, err := authorizeSession(ctx.Ctx, db, tx, ctx.BlockContext.Height); err != nil {
+			return err
+		}
+	}
+
 	return r.mempool.applyTransaction(ctx, tx, db, r.events)
 }
 
+// EvictMempool rolls back what ApplyMempool applied for a transaction that is
+// evicted from the mempool, which must be the last pending transaction of its
+// sender. Otherwise the sender's next transaction would be accepted with a nonce
+// gap, and the evicted transaction's spend would still be counted.
+func (r *TxApp) EvictMempool(tx *types.Transaction) error {
+	return r.mempool.revertTransaction(tx)
+}
+
 // AccountInfo gets account info from either the mempool or the account store.
 // It takes a flag to indicate whether it should check the mempool first.
 func (r *TxApp) AccountInfo(ctx context.Context, db sql.DB, acctID *types.AccountID, getUnconfirmed bool) (balance *big.Int, nonce int64, err error) {
@@ -674,6 +730,53 @@ func logErr(l log.Logger, err error) {
 	}
 }
 
+// TxFeePayerAcctID returns the account ID of the fee payer of a sponsored
+// transaction, or nil if the sender pays for the transaction. The fee of a
+// transaction signed by a session key is paid by its delegator, unless the
+// transaction is sponsored.
+func TxFeePayerAcctID(t *types.Transaction) (*types.AccountID, error) {
+	var payer *types.FeePayer
+	switch {
+	case t.Body.FeePayer != nil:
+		payer = t.Body.FeePayer
+	case t.Body.Delegator != nil:
+		payer = (*types.FeePayer)(t.Body.Delegator)
+	default:
+		return nil, nil
+	}
+	keyType, err := authExt.GetAuthenticatorKeyType(payer.AuthType)
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.AccountID{
+		Identifier: payer.Identifier,
+		KeyType:    keyType,
+	}, nil
+}
+
+// txFeePayer returns the fee payer of a sponsored transaction after checking
+// that the registered sponsor policies allow it to pay, or nil if the sender
+// pays for the transaction. The delegator of a session key is not subject to
+// the sponsor policies.
+func txFeePayer(ctx context.Context, tx *types.Transaction) (*types.AccountID, error) {
+	payer, err := TxFeePayerAcctID(tx)
+	if err != nil || payer == nil || tx.Body.FeePayer == nil {
+		return payer, err
+	}
+
+	for _, policy := range hooks.ListSponsorPolicies() {
+		if err := policy(ctx, payer, tx); err != nil {
+			if !errors.Is(err, types.ErrFeePayerRejected) {
+				err = fmt.Errorf("%w: %w", types.ErrFeePayerRejected, err)
+			}
+			return nil, err
+		}
+	}
+
+	return payer, nil
+}
+
 // TxSenderAcctID returns the transaction sender's account ID information.
 func TxSenderAcctID(t *types.Transaction) (*types.AccountID, error) {
 	if t.Sender == nil {
@@ -2,6 +2,7 @@ package types
 
 import (
 	"errors"
+	"fmt"
 	"time"
 
 	"github.com/trufnetwork/kwil-db/core/types"
@@ -20,6 +21,10 @@ var (
 	ErrStillProcessing = errors.New("block still being executed")
 	ErrNoResponse      = errors.New("stream closed without response")
 	ErrPeersNotFound   = errors.New("no peers available")
+
+	// ErrFutureNonce indicates that the nonce of a transaction is greater than
+	// the next nonce of the sender's account, so there is a nonce gap.
+	ErrFutureNonce = fmt.Errorf("%w: nonce gap", types.ErrInvalidNonce)
 )
 
 const HashLen = types.HashLen
@@ -60,6 +65,8 @@ type BlockResultsStorer interface {
 	StoreResults(hash Hash, results []types.TxResult) error
 	Results(hash Hash) ([]types.TxResult, error)
 	Result(hash Hash, idx uint32) (*types.TxResult, error)
+	StoreScheduleResults(hash Hash, results []*types.ScheduleResult) error
+	ScheduleResults(hash Hash) ([]*types.ScheduleResult, error)
 }
 
 type TxGetter interface {
@@ -110,11 +110,14 @@ func (j *jsonRPCCLIDriver) Identifier() string {
 	return ident
 }
 
-func (j *jsonRPCCLIDriver) Call(ctx context.Context, namespace string, action string, inputs []any) (*types.CallResult, error) {
+func (j *jsonRPCCLIDriver) Call(ctx context.Context, namespace string, action string, inputs []any, opts ...client.CallOpt) (*types.CallResult, error) {
 	args := []string{"call-action", "--logs", "--rpc-auth"} // always assume private RPC mode
 	if j.usingGateway {
 		args = append(args, "--gateway-auth")
 	}
+	if client.GetCallOpts(opts).Trace {
+		args = append(args, "--trace")
+	}
 
 	if namespace != "" {
 		args = append(args, "--namespace", namespace)
@@ -325,7 +328,96 @@ func (j *jsonRPCCLIDriver) ExecuteSQL(ctx context.Context, sql string, params ma
 	return j.exec(ctx, args, opts...)
 }
 
+func (j *jsonRPCCLIDriver) ExecuteMulti(ctx context.Context, ops []types.Payload, opts ...client.TxOpt) (types.Hash, error) {
+	// the operations are written in the JSON format read by exec-multi
+	var jsonOps []map[string]any
+	for _, op := range ops {
+		switch op := op.(type) {
+		case *types.ActionExecution:
+			if len(op.Arguments) > 1 {
+				return types.Hash{}, errors.New("batched action calls are not supported in exec-multi")
+			}
+			var inputs []string
+			if len(op.Arguments) == 1 {
+				vals := make([]any, len(op.Arguments[0]))
+				for i, arg := range op.Arguments[0] {
+					val, err := arg.Decode()
+					if err != nil {
+						return types.Hash{}, err
+					}
+					vals[i] = val
+				}
+				var err error
+				inputs, err = formatActionParams(vals)
+				if err != nil {
+					return types.Hash{}, err
+				}
+			}
+			jsonOps = append(jsonOps, map[string]any{"type": op.Type(), "namespace": op.Namespace,
+				"action": op.Action, "inputs": inputs})
+		case *types.RawStatement:
+			var params []string
+			for _, p := range op.Parameters {
+				val, err := p.Value.Decode()
+				if err != nil {
+					return types.Hash{}, err
+				}
+				params = append(params, p.Name+":"+p.Value.Type.String()+"="+stringifyCLIArg(val))
+			}
+			jsonOps = append(jsonOps, map[string]any{"type": op.Type(), "statement": op.Statement,
+				"params": params})
+		case *types.Transfer:
+			jsonOps = append(jsonOps, map[string]any{"type": op.Type(), "to": op.To.Identifier.String(),
+				"key_type": op.To.KeyType.String(), "amount": op.Amount.String()})
+		default:
+			return types.Hash{}, fmt.Errorf("unsupported operation type %s", op.Type())
+		}
+	}
+
+	bts, err := json.Marshal(jsonOps)
+	if err != nil {
+		return types.Hash{}, err
+	}
+	fp := filepath.Join(j.testCtx.tmpdir, randomName())
+	if err := os.WriteFile(fp, bts, 0600); err != nil {
+		return types.Hash{}, err
+	}
+
+	return j.exec(ctx, []string{"exec-multi", fp}, opts...)
+}
+
 // exec executes a kwil-cli command that issues a transaction and returns the hash.
+func (j *jsonRPCCLIDriver) CreateSession(ctx context.Context, session *types.CreateSession, opts ...client.TxOpt) (types.Hash, error) {
+	args := []string{"account", "session", "create", hex.EncodeToString(session.SessionKey),
+		"--auth-type", session.AuthType, "--expiry", strconv.FormatInt(session.Expiry, 10)}
+	for _, ns := range session.Namespaces {
+		args = append(args, "--namespace", ns)
+	}
+	for _, action := range session.Actions {
+		args = append(args, "--action", action)
+	}
+	if session.SpendCap != nil {
+		args = append(args, "--spend-cap", session.SpendCap.String())
+	}
+
+	return j.exec(ctx, args, opts...)
+}
+
+func (j *jsonRPCCLIDriver) RevokeSession(ctx context.Context, sessionKey []byte, authType string, opts ...client.TxOpt) (types.Hash, error) {
+	args := []string{"account", "session", "revoke", hex.EncodeToString(sessionKey), "--auth-type", authType}
+	return j.exec(ctx, args, opts...)
+}
+
+func (j *jsonRPCCLIDriver) RegisterMultisig(ctx context.Context, definition []byte, opts ...client.TxOpt) (types.Hash, error) {
+	return j.exec(ctx, []string{"account", "multisig", "register", hex.EncodeToString(definition)}, opts...)
+}
+
+// UpdateMultisig is not supported, as the CLI only prints the transactions of
+// multisig accounts for their members to sign.
+func (j *jsonRPCCLIDriver) UpdateMultisig(ctx context.Context, definition []byte, opts ...client.TxOpt) (types.Hash, error) {
+	return types.Hash{}, errors.New("multisig updates are not supported in cli driver")
+}
+
 func (j *jsonRPCCLIDriver) exec(ctx context.Context, args []string, opts ...client.TxOpt) (types.Hash, error) {
 	opts2 := client.GetTxOpts(opts)
 	if opts2.Fee != nil {
@@ -334,6 +426,9 @@ func (j *jsonRPCCLIDriver) exec(ctx context.Context, args []string, opts ...clie
 	if opts2.Nonce != 0 {
 		args = append(args, "--nonce", strconv.FormatInt(opts2.Nonce, 10))
 	}
+	if d := opts2.Delegator; d != nil {
+		args = append(args, "--delegator", d.AuthType+":"+hex.EncodeToString(d.Identifier))
+	}
 
 	if opts2.SyncBcast {
 		r := &display.TxHashResponse{}
@@ -388,6 +483,18 @@ func (j *jsonRPCCLIDriver) GetAccount(ctx context.Context, acct *types.AccountID
 	}, nil
 }
 
+func (j *jsonRPCCLIDriver) ExportSchema(ctx context.Context, namespace string) (string, error) {
+	var r struct {
+		Schema string `json:"schema"`
+	}
+	err := cmd(j, ctx, &r, "database", "export-schema", namespace)
+	return r.Schema, err
+}
+
+func (j *jsonRPCCLIDriver) Schema(ctx context.Context, namespace string) (*types.Schema, error) {
+	return nil, fmt.Errorf("schema is not supported in cli driver")
+}
+
 func (j *jsonRPCCLIDriver) Ping(ctx context.Context) (string, error) {
 	var r string
 	err := cmd(j, ctx, &r, "utils", "ping")
@@ -0,0 +1,200 @@
+//go:build pglive
+
+package main
+
+// The benchmarks in this file measure the throughput of action calls through
+// the engine's interpreter, with and without its cache of planned statements.
+// Like the other pglive tests, they require a running Postgres instance:
+//
+//	go test -tags pglive -run NONE -bench . ./stress
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/trufnetwork/kwil-db/common"
+	"github.com/trufnetwork/kwil-db/core/log"
+	"github.com/trufnetwork/kwil-db/node/engine/interpreter"
+	"github.com/trufnetwork/kwil-db/node/pg"
+)
+
+const (
+	benchNamespace = "stress_bench"
+	benchCaller    = "0xbench"
+	benchUsername  = "bench_user"
+)
+
+// newBenchDB connects to the test database, and drops the engine's schemas
+// both before and after the benchmark.
+func newBenchDB(b *testing.B) *pg.DB {
+	ctx := context.Background()
+	db, err := pg.NewDB(ctx, &pg.DBConfig{
+		PoolConfig: pg.PoolConfig{
+			ConnConfig: pg.ConnConfig{
+				Host:   "127.0.0.1",
+				Port:   "5432",
+				User:   "kwild",
+				Pass:   "kwild", // would be ignored if pg_hba.conf set with trust
+				DBName: "kwil_test_db",
+			},
+			MaxConns: 11,
+		},
+	})
+	if err != nil {
+		b.Fatal(err)
+	}
+
+	dropSchemas := func() {
+		for _, schema := range []string{benchNamespace, "main", "info", "kwild_engine"} {
+			if _, err := db.Pool().Execute(ctx, fmt.Sprintf(`DROP SCHEMA IF EXISTS %s CASCADE;`, schema)); err != nil {
+				b.Logf("cleanup issue: %v", err)
+			}
+		}
+	}
+	dropSchemas()
+	b.Cleanup(func() {
+		dropSchemas()
+		db.Close()
+	})
+
+	return db
+}
+
+func benchEngineCtx(txid string) *common.EngineContext {
+	return &common.EngineContext{
+		TxContext: &common.TxContext{
+			Ctx: context.Background(),
+			BlockContext: &common.BlockContext{
+				Height: 1,
+				ChainContext: &common.ChainContext{
+					NetworkParameters: &common.NetworkParameters{},
+					MigrationParams:   &common.MigrationContext{},
+				},
+			},
+			Caller:        benchCaller,
+			Signer:        []byte(benchCaller),
+			Authenticator: "secp256k1_ep",
+			TxID:          txid,
+		},
+	}
+}
+
+// deployBenchSchema deploys the stress schema and creates a profile with a
+// few posts, committing the result so that it is visible to read transactions.
+func deployBenchSchema(b *testing.B, db *pg.DB) *interpreter.ThreadSafeInterpreter {
+	ctx := context.Background()
+	tx, err := db.BeginTx(ctx)
+	if err != nil {
+		b.Fatal(err)
+	}
+	defer tx.Rollback(ctx)
+
+	interp, err := interpreter.NewInterpreter(ctx, tx, &common.Service{Logger: log.DiscardLogger}, nil, nil, nil)
+	if err != nil {
+		b.Fatal(err)
+	}
+
+	err = interp.ExecuteWithoutEngineCtx(ctx, tx, makeTestSchemaSQL(benchNamespace), nil, nil)
+	if err != nil {
+		b.Fatal(err)
+	}
+
+	res, err := interp.Call(benchEngineCtx("profile"), tx, benchNamespace, actCreateUser, []any{benchUsername, 30, "benchmarking"}, nil)
+	if err != nil {
+		b.Fatal(err)
+	}
+	if res.Error != nil {
+		b.Fatal(res.Error)
+	}
+
+	for i := range 10 {
+		res, err = interp.Call(benchEngineCtx(fmt.Sprintf("post%d", i)), tx, benchNamespace, actCreatePost, []any{benchUsername, "content", nil}, nil)
+		if err != nil {
+			b.Fatal(err)
+		}
+		if res.Error != nil {
+			b.Fatal(res.Error)
+		}
+	}
+
+	if err = tx.Commit(ctx); err != nil {
+		b.Fatal(err)
+	}
+
+	return interp
+}
+
+// forEachCacheMode runs the benchmark with and without the statement cache.
+func forEachCacheMode(b *testing.B, fn func(b *testing.B)) {
+	for _, mode := range []struct {
+		name   string
+		cached bool
+	}{
+		{"cached", true},
+		{"uncached", false},
+	} {
+		b.Run(mode.name, func(b *testing.B) {
+			interpreter.SetStatementCacheEnabled(mode.cached)
+			defer interpreter.SetStatementCacheEnabled(true)
+
+			fn(b)
+		})
+	}
+}
+
+// BenchmarkViewAction calls a read-only action through a read transaction,
+// which is the path served by the user.call RPC.
+func BenchmarkViewAction(b *testing.B) {
+	db := newBenchDB(b)
+	interp := deployBenchSchema(b, db)
+	ctx := context.Background()
+
+	forEachCacheMode(b, func(b *testing.B) {
+		tx, err := db.BeginReadTx(ctx)
+		if err != nil {
+			b.Fatal(err)
+		}
+		defer tx.Rollback(ctx)
+
+		b.ReportAllocs()
+		b.ResetTimer()
+		for range b.N {
+			res, err := interp.Call(benchEngineCtx(""), tx, benchNamespace, actGetUserPosts, []any{benchUsername}, nil)
+			if err != nil {
+				b.Fatal(err)
+			}
+			if res.Error != nil {
+				b.Fatal(res.Error)
+			}
+		}
+	})
+}
+
+// BenchmarkMutatingAction calls an action that reads and writes state
+// through a write transaction, which is the path used in block execution.
+func BenchmarkMutatingAction(b *testing.B) {
+	db := newBenchDB(b)
+	interp := deployBenchSchema(b, db)
+	ctx := context.Background()
+
+	forEachCacheMode(b, func(b *testing.B) {
+		tx, err := db.BeginTx(ctx)
+		if err != nil {
+			b.Fatal(err)
+		}
+		defer tx.Rollback(ctx) // nothing written here should persist
+
+		b.ReportAllocs()
+		b.ResetTimer()
+		for i := range b.N {
+			res, err := interp.Call(benchEngineCtx(fmt.Sprintf("bench%d", i)), tx, benchNamespace, actCreatePost, []any{benchUsername, "content", nil}, nil)
+			if err != nil {
+				b.Fatal(err)
+			}
+			if res.Error != nil {
+				b.Fatal(res.Error)
+			}
+		}
+	})
+}
@@ -20,11 +20,11 @@ type timedClient struct {
 
 var _ clientType.Client = (*timedClient)(nil)
 
-func (tc *timedClient) Call(ctx context.Context, namespace, action string, inputs []any) (*types.CallResult, error) {
+func (tc *timedClient) Call(ctx context.Context, namespace, action string, inputs []any, opts ...clientType.CallOpt) (*types.CallResult, error) {
 	if tc.showReqDur {
 		defer tc.printDur(time.Now(), "CallAction")
 	}
-	return tc.Client.Call(ctx, namespace, action, inputs)
+	return tc.Client.Call(ctx, namespace, action, inputs, opts...)
 }
 
 func (tc *timedClient) ChainID() string {
@@ -48,6 +48,20 @@ func (tc *timedClient) ExecuteAction(ctx context.Context, dbid string, action st
 	return tc.Client.Execute(ctx, dbid, action, tuples, opts...)
 }
 
+func (tc *timedClient) Schema(ctx context.Context, namespace string) (*types.Schema, error) {
+	if tc.showReqDur {
+		defer tc.printDur(time.Now(), "Schema")
+	}
+	return tc.Client.Schema(ctx, namespace)
+}
+
+func (tc *timedClient) ExportSchema(ctx context.Context, namespace string) (string, error) {
+	if tc.showReqDur {
+		defer tc.printDur(time.Now(), "ExportSchema")
+	}
+	return tc.Client.ExportSchema(ctx, namespace)
+}
+
 func (tc *timedClient) GetAccount(ctx context.Context, id *types.AccountID, status types.AccountStatus) (*types.Account, error) {
 	if tc.showReqDur {
 		defer tc.printDur(time.Now(), "GetAccount")
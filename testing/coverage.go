@@ -0,0 +1,210 @@
+package testing
+
+import (
+	"bufio"
+	"fmt"
+	"html/template"
+	"io"
+	"os"
+	"slices"
+	"strings"
+
+	"github.com/trufnetwork/kwil-db/node/engine/interpreter"
+)
+
+// fileCoverage is the coverage of the actions in a Kuneiform file.
+type fileCoverage struct {
+	path  string
+	lines []string
+	// hits maps the lines of statements in the file, starting at 1, to the
+	// number of times they were executed.
+	hits map[int]int
+}
+
+// statements returns the number of lines with statements, and how many of
+// them were executed.
+func (f *fileCoverage) statements() (total, hit int) {
+	for _, n := range f.hits {
+		total++
+		if n > 0 {
+			hit++
+		}
+	}
+	return total, hit
+}
+
+// mapCoverage maps the coverage of actions to the files that define them.
+// Actions are found by the text of their CREATE ACTION statement. Actions that
+// are not defined in any of the files, such as those of seed statements, are
+// not reported.
+func mapCoverage(actions []*interpreter.ActionCoverage, files []string) ([]*fileCoverage, error) {
+	var res []*fileCoverage
+	found := make([]bool, len(actions))
+	for _, path := range files {
+		bts, err := os.ReadFile(path)
+		if err != nil {
+			return nil, err
+		}
+		content := string(bts)
+
+		file := &fileCoverage{
+			path:  path,
+			lines: strings.Split(content, "\n"),
+			hits:  make(map[int]int),
+		}
+		for i, act := range actions {
+			if found[i] {
+				continue
+			}
+
+			// the raw statement has a semicolon appended, which may not
+			// directly follow the statement in the file
+			idx := strings.Index(content, strings.TrimSuffix(act.Source, ";"))
+			if idx < 0 {
+				continue
+			}
+			found[i] = true
+
+			offset := strings.Count(content[:idx], "\n")
+			for line, n := range act.Hits {
+				file.hits[offset+line] += n
+			}
+		}
+
+		res = append(res, file)
+	}
+
+	return res, nil
+}
+
+// CoveragePercent returns the percentage of the lines with statements in the
+// files that were executed.
+func CoveragePercent(coverage *interpreter.Coverage, files []string) (float64, error) {
+	covered, err := mapCoverage(coverage.Actions(), files)
+	if err != nil {
+		return 0, err
+	}
+
+	var total, hit int
+	for _, file := range covered {
+		t, h := file.statements()
+		total += t
+		hit += h
+	}
+	if total == 0 {
+		return 0, nil
+	}
+	return 100 * float64(hit) / float64(total), nil
+}
+
+// WriteLCOV writes the coverage of the actions defined in files as an lcov
+// tracefile, with a line record for each line with statements.
+func WriteLCOV(w io.Writer, coverage *interpreter.Coverage, files []string) error {
+	covered, err := mapCoverage(coverage.Actions(), files)
+	if err != nil {
+		return err
+	}
+
+	return writeLCOV(w, covered)
+}
+
+func writeLCOV(w io.Writer, covered []*fileCoverage) error {
+	bw := bufio.NewWriter(w)
+	for _, file := range covered {
+		fmt.Fprintf(bw, "TN:\nSF:%s\n", file.path)
+
+		lines := make([]int, 0, len(file.hits))
+		for line := range file.hits {
+			lines = append(lines, line)
+		}
+		slices.Sort(lines)
+		for _, line := range lines {
+			fmt.Fprintf(bw, "DA:%d,%d\n", line, file.hits[line])
+		}
+
+		total, hit := file.statements()
+		fmt.Fprintf(bw, "LF:%d\nLH:%d\nend_of_record\n", total, hit)
+	}
+
+	return bw.Flush()
+}
+
+var coverageHTML = template.Must(template.New("coverage").Parse(`<!DOCTYPE html>
+<html>
+<head>
+<meta charset="utf-8">
+<title>Kuneiform coverage</title>
+<style>
+body { font-family: sans-serif; }
+pre { margin: 0; }
+table { border-collapse: collapse; font-family: monospace; }
+td { padding: 0 8px; vertical-align: top; }
+.n { color: #888; text-align: right; }
+.hit { background: #dfd; }
+.miss { background: #fdd; }
+</style>
+</head>
+<body>
+<h1>Kuneiform coverage</h1>
+<ul>
+{{- range $i, $f := .}}
+<li><a href="#file{{$i}}">{{$f.Path}}</a>: {{$f.Percent}}</li>
+{{- end}}
+</ul>
+{{- range $i, $f := .}}
+<h2 id="file{{$i}}">{{$f.Path}}: {{$f.Percent}}</h2>
+<table>
+{{- range $f.Lines}}
+<tr{{if .Class}} class="{{.Class}}"{{end}}><td class="n">{{.Number}}</td><td class="n">{{.Hits}}</td><td><pre>{{.Text}}</pre></td></tr>
+{{- end}}
+</table>
+{{- end}}
+</body>
+</html>
+`))
+
+type htmlFile struct {
+	Path    string
+	Percent string
+	Lines   []htmlLine
+}
+
+type htmlLine struct {
+	Number int
+	Hits   string
+	Class  string
+	Text   string
+}
+
+// WriteCoverageHTML writes the coverage of the actions defined in files as an
+// HTML page that highlights the statements that were and were not executed.
+func WriteCoverageHTML(w io.Writer, coverage *interpreter.Coverage, files []string) error {
+	covered, err := mapCoverage(coverage.Actions(), files)
+	if err != nil {
+		return err
+	}
+
+	var data []htmlFile
+	for _, file := range covered {
+		total, hit := file.statements()
+		f := htmlFile{Path: file.path, Percent: "no statements"}
+		if total > 0 {
+			f.Percent = fmt.Sprintf("%.1f%%", 100*float64(hit)/float64(total))
+		}
+
+		for i, text := range file.lines {
+			line := htmlLine{Number: i + 1, Text: text}
+			if n, ok := file.hits[i+1]; ok {
+				line.Hits = fmt.Sprintf("%dx", n)
+				line.Class = "miss"
+				if n > 0 {
+					line.Class = "hit"
+				}
+			}
+			f.Lines = append(f.Lines, line)
+		}
+		data = append(data, f)
+	}
+
+	return coverageHTML.Execute(w, data)
+}
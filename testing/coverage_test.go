@@ -0,0 +1,51 @@
+package testing
+
+import (
+	"bytes"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/trufnetwork/kwil-db/node/engine/interpreter"
+)
+
+func Test_Coverage(t *testing.T) {
+	dir := t.TempDir()
+	path := filepath.Join(dir, "schema.sql")
+	require.NoError(t, os.WriteFile(path, []byte(`CREATE TABLE t (id INT PRIMARY KEY);
+
+CREATE ACTION act() public {
+	$a := 1;
+	$b := 2;
+}
+;
+`), 0644))
+
+	actions := []*interpreter.ActionCoverage{
+		{
+			Namespace: "main",
+			Name:      "act",
+			Source:    "CREATE ACTION act() public {\n\t$a := 1;\n\t$b := 2;\n};",
+			Hits:      map[int]int{2: 3, 3: 0},
+		},
+		{
+			// defined in a seed statement, so not reported
+			Namespace: "main",
+			Name:      "other",
+			Source:    "CREATE ACTION other() public {};",
+			Hits:      map[int]int{},
+		},
+	}
+
+	covered, err := mapCoverage(actions, []string{path})
+	require.NoError(t, err)
+	require.Len(t, covered, 1)
+	assert.Equal(t, map[int]int{4: 3, 5: 0}, covered[0].hits)
+
+	var buf bytes.Buffer
+	require.NoError(t, writeLCOV(&buf, covered))
+	assert.Equal(t, "TN:\nSF:"+path+"\nDA:4,3\nDA:5,0\nLF:2\nLH:1\nend_of_record\n", buf.String())
+}
@@ -0,0 +1,121 @@
+package testing
+
+import (
+	"encoding/xml"
+	"fmt"
+	"io"
+	"time"
+)
+
+// Report is the result of running YAML test files.
+type Report struct {
+	// Suites are the results of each file.
+	Suites []*SuiteResult
+}
+
+// Failed returns the number of failed tests.
+func (r *Report) Failed() int {
+	var n int
+	for _, suite := range r.Suites {
+		for _, test := range suite.Tests {
+			if test.Err != nil {
+				n++
+			}
+		}
+	}
+	return n
+}
+
+// SuiteResult is the result of the tests of a file.
+type SuiteResult struct {
+	// Name is the name of the file's test suite.
+	Name string
+	// Tests are the results of the tests, in the order of the file. Tests with
+	// cases have a result for each case.
+	Tests []*TestResult
+}
+
+// TestResult is the result of a test.
+type TestResult struct {
+	// Name identifies the test, and its case.
+	Name string
+	// Err is the reason the test failed. It is nil if the test passed.
+	Err error
+	// Duration is how long the test took.
+	Duration time.Duration
+}
+
+type junitTestSuites struct {
+	XMLName  xml.Name          `xml:"testsuites"`
+	Tests    int               `xml:"tests,attr"`
+	Failures int               `xml:"failures,attr"`
+	Time     string            `xml:"time,attr"`
+	Suites   []*junitTestSuite `xml:"testsuite"`
+}
+
+type junitTestSuite struct {
+	Name     string           `xml:"name,attr"`
+	Tests    int              `xml:"tests,attr"`
+	Failures int              `xml:"failures,attr"`
+	Time     string           `xml:"time,attr"`
+	Cases    []*junitTestCase `xml:"testcase"`
+}
+
+type junitTestCase struct {
+	Name      string        `xml:"name,attr"`
+	ClassName string        `xml:"classname,attr"`
+	Time      string        `xml:"time,attr"`
+	Failure   *junitFailure `xml:"failure,omitempty"`
+}
+
+type junitFailure struct {
+	Message string `xml:"message,attr"`
+	Text    string `xml:",chardata"`
+}
+
+// WriteJUnit writes the report in the JUnit XML format that CI systems
+// display.
+func WriteJUnit(w io.Writer, report *Report) error {
+	var total time.Duration
+	suites := &junitTestSuites{}
+	for _, suite := range report.Suites {
+		var suiteTime time.Duration
+		jSuite := &junitTestSuite{Name: suite.Name, Tests: len(suite.Tests)}
+		for _, test := range suite.Tests {
+			suiteTime += test.Duration
+			jCase := &junitTestCase{
+				Name:      test.Name,
+				ClassName: suite.Name,
+				Time:      junitTime(test.Duration),
+			}
+			if test.Err != nil {
+				jSuite.Failures++
+				jCase.Failure = &junitFailure{Message: test.Err.Error(), Text: test.Err.Error()}
+			}
+			jSuite.Cases = append(jSuite.Cases, jCase)
+		}
+		jSuite.Time = junitTime(suiteTime)
+
+		total += suiteTime
+		suites.Tests += jSuite.Tests
+		suites.Failures += jSuite.Failures
+		suites.Suites = append(suites.Suites, jSuite)
+	}
+	suites.Time = junitTime(total)
+
+	if _, err := io.WriteString(w, xml.Header); err != nil {
+		return err
+	}
+	enc := xml.NewEncoder(w)
+	enc.Indent("", "  ")
+	if err := enc.Encode(suites); err != nil {
+		return err
+	}
+	_, err := fmt.Fprintln(w)
+	return err
+}
+
+// junitTime formats a duration as seconds.
+func junitTime(d time.Duration) string {
+	return fmt.Sprintf("%.3f", d.Seconds())
+}
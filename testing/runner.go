@@ -0,0 +1,217 @@
+package testing
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sync"
+	"time"
+
+	"github.com/jackc/pgx/v5"
+
+	"github.com/trufnetwork/kwil-db/core/log"
+	"github.com/trufnetwork/kwil-db/node/pg"
+)
+
+// RunTestFiles runs the tests of YAML test files, and reports the result of
+// each. An error is only returned if the tests could not be run; failed tests
+// are recorded in the report.
+//
+// If parallel is greater than 1, that many tests are run at once. Kwil's
+// system schemas have fixed names, so each worker gets its own database,
+// which is created in the Postgres instance before the tests and dropped
+// after them. The connecting user must be able to create databases.
+func RunTestFiles(ctx context.Context, files []*TestFile, opts *Options, parallel int) (*Report, error) {
+	if opts == nil {
+		opts = &Options{}
+
+		// like SchemaTest.Run, a nil config means defaults.
+		opts.UseTestContainer = true
+	}
+
+	if opts.Logger == nil {
+		opts.Logger = &kwilLoggerWrapper{
+			Logger: log.New(log.WithLevel(log.LevelInfo)),
+		}
+	}
+
+	err := opts.valid()
+	if err != nil {
+		return nil, fmt.Errorf("test configuration error: %w", err)
+	}
+
+	if parallel < 1 {
+		parallel = 1
+	}
+
+	// read the seeds and expand the cases before connecting, so that errors in
+	// the files are reported without waiting on Postgres
+	report := &Report{Suites: make([]*SuiteResult, len(files))}
+	var jobs []*testJob
+	for i, file := range files {
+		seedStmts, err := file.seedStatements()
+		if err != nil {
+			return nil, fmt.Errorf("%s: %w", file.Name, err)
+		}
+
+		suite := &SuiteResult{Name: file.Name}
+		report.Suites[i] = suite
+
+		for _, test := range file.Tests {
+			for _, run := range test.expand() {
+				stmts := append([]string{}, seedStmts...)
+				for _, fixture := range run.fixtures {
+					stmts = append(stmts, file.Fixtures[fixture]...)
+				}
+
+				result := &TestResult{Name: run.name}
+				suite.Tests = append(suite.Tests, result)
+				jobs = append(jobs, &testJob{
+					file:      file,
+					run:       run,
+					seedStmts: stmts,
+					result:    result,
+				})
+			}
+		}
+	}
+
+	err = runWithPostgresConn(ctx, opts, func(ctx context.Context, conn *ConnConfig) error {
+		conns := []*ConnConfig{conn}
+		if parallel > 1 {
+			var dropAll func() error
+			conns, dropAll, err = createWorkerDBs(ctx, conn, min(parallel, len(jobs)))
+			if err != nil {
+				return err
+			}
+			defer func() {
+				if err2 := dropAll(); err2 != nil {
+					opts.Logger.Logf("error dropping worker databases: %v", err2)
+				}
+			}()
+		}
+
+		jobCh := make(chan *testJob)
+		errCh := make(chan error, len(conns))
+		var wg sync.WaitGroup
+		for _, conn := range conns {
+			wg.Add(1)
+			go func() {
+				defer wg.Done()
+
+				db, err := newDB(ctx, conn)
+				if err != nil {
+					errCh <- fmt.Errorf("error setting up database: %w", err)
+					// drain the jobs, so that other workers are not blocked
+					for range jobCh {
+					}
+					return
+				}
+				defer db.Close()
+
+				for job := range jobCh {
+					job.runOn(ctx, db, opts)
+				}
+			}()
+		}
+
+		for _, job := range jobs {
+			jobCh <- job
+		}
+		close(jobCh)
+		wg.Wait()
+		close(errCh)
+
+		var errs []error
+		for err := range errCh {
+			errs = append(errs, err)
+		}
+		return errors.Join(errs...)
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return report, nil
+}
+
+// testJob is a run of a test, with the statements that set it up.
+type testJob struct {
+	file      *TestFile
+	run       *caseRun
+	seedStmts []string
+	result    *TestResult
+}
+
+// runOn runs the test in a transaction of the database that is rolled back,
+// and records the result.
+func (j *testJob) runOn(ctx context.Context, db *pg.DB, opts *Options) {
+	start := time.Now()
+	defer func() { j.result.Duration = time.Since(start) }()
+
+	opts.Logger.Logf(`running test "%s/%s"`, j.file.Name, j.run.name)
+
+	owner := j.file.Owner
+	if owner == "" {
+		owner = string(deployer)
+	}
+
+	platform, rollback, err := setupPlatform(ctx, db, opts, owner, j.seedStmts)
+	if err != nil {
+		j.result.Err = fmt.Errorf("setup failed: %w", err)
+		return
+	}
+	defer rollback()
+
+	block := &blockState{}
+	for i, step := range j.run.steps {
+		err = step.run(ctx, platform, owner, block)
+		if err != nil {
+			j.result.Err = fmt.Errorf("step %d: %w", i+1, err)
+			return
+		}
+	}
+}
+
+// createWorkerDBs creates n databases in the Postgres instance of conn, and
+// returns their connection settings and a function that drops them.
+func createWorkerDBs(ctx context.Context, conn *ConnConfig, n int) ([]*ConnConfig, func() error, error) {
+	admin, err := pgx.Connect(ctx, conn.dsn())
+	if err != nil {
+		return nil, nil, err
+	}
+
+	var names []string
+	dropAll := func() error {
+		defer admin.Close(context.Background())
+
+		var errs []error
+		for _, name := range names {
+			_, err := admin.Exec(context.Background(), "DROP DATABASE IF EXISTS "+name+" WITH (FORCE)")
+			errs = append(errs, err)
+		}
+		return errors.Join(errs...)
+	}
+
+	conns := make([]*ConnConfig, n)
+	for i := range n {
+		dbName := fmt.Sprintf("%s_worker_%d", conn.DBName, i)
+		name := pgx.Identifier{dbName}.Sanitize()
+
+		// a previous run may have been interrupted before dropping it
+		_, err = admin.Exec(ctx, "DROP DATABASE IF EXISTS "+name+" WITH (FORCE)")
+		if err == nil {
+			_, err = admin.Exec(ctx, "CREATE DATABASE "+name)
+		}
+		if err != nil {
+			return nil, nil, errors.Join(fmt.Errorf("error creating worker database: %w", err), dropAll())
+		}
+		names = append(names, name)
+
+		conn2 := *conn
+		conn2.DBName = dbName
+		conns[i] = &conn2
+	}
+
+	return conns, dropAll, nil
+}
@@ -17,6 +17,7 @@ import (
 	"testing"
 	"time"
 
+	"github.com/jackc/pgx/v5"
 	"github.com/stretchr/testify/assert"
 	"github.com/trufnetwork/kwil-db/common"
 	"github.com/trufnetwork/kwil-db/config"
@@ -154,97 +155,11 @@ func (tc SchemaTest) Run(ctx context.Context, opts *Options) error {
 			err := func() error {
 				logger.Logf(`running test %s`, testFnIdentifiers[i])
 
-				// setup a tx and execution engine
-				outerTx, err := d.BeginPreparedTx(ctx)
+				platform, rollback, err := setupPlatform(ctx, d, opts, tc.Owner, seedStmts)
 				if err != nil {
 					return err
 				}
-				// always rollback the outer transaction to reset the database
-				defer outerTx.Rollback(ctx)
-
-				var logger log.Logger
-				// if this is a kwil logger, we can keep using it.
-				// If it is from testing.T, we should make a Kwil logger.
-				if wrapped, ok := opts.Logger.(*kwilLoggerWrapper); ok {
-					logger = wrapped.Logger
-				} else {
-					logger = log.New(log.WithLevel(log.LevelInfo))
-				}
-
-				accs, err := accounts.InitializeAccountStore(ctx, outerTx, logger)
-				if err != nil {
-					return err
-				}
-
-				votes, err := voting.InitializeVoteStore(ctx, outerTx)
-				if err != nil {
-					return err
-				}
-
-				interp, err := interpreter.NewInterpreter(ctx, outerTx, &common.Service{
-					Logger:      logger,
-					LocalConfig: &config.Config{},
-					Identity:    []byte("node"),
-				}, accs, votes, nil)
-				if err != nil {
-					return err
-				}
-
-				err = interp.Execute(&common.EngineContext{
-					TxContext: &common.TxContext{
-						Ctx:    ctx,
-						Signer: deployer,
-						Caller: string(deployer),
-						TxID:   "txid",
-						BlockContext: &common.BlockContext{
-							Height: 0,
-						},
-					},
-					OverrideAuthz: true,
-				}, outerTx, "TRANSFER OWNERSHIP TO $user", map[string]any{
-					"user": tc.Owner,
-				}, func(r *common.Row) error {
-					// do nothing
-					return nil
-				})
-				if err != nil {
-					return err
-				}
-
-				tx2, err := outerTx.BeginTx(ctx)
-				if err != nil {
-					return err
-				}
-				defer tx2.Rollback(ctx)
-
-				platform := &Platform{
-					Engine:   interp,
-					DB:       tx2,
-					Deployer: deployer,
-					Logger:   opts.Logger,
-				}
-
-				// deploy schemas
-				for _, stmt := range seedStmts {
-					err = interp.Execute(&common.EngineContext{
-						TxContext: &common.TxContext{
-							Ctx:    ctx,
-							Signer: deployer,
-							Caller: string(deployer),
-							TxID:   platform.Txid(),
-							BlockContext: &common.BlockContext{
-								Height: 0,
-							},
-						},
-						OverrideAuthz: true,
-					}, tx2, stmt, nil, func(r *common.Row) error {
-						// do nothing
-						return nil
-					})
-					if err != nil {
-						return err
-					}
-				}
+				defer rollback()
 
 				// run test function
 				err = testFn(ctx, platform)
@@ -267,6 +182,115 @@ func (tc SchemaTest) Run(ctx context.Context, opts *Options) error {
 
 var deployer = []byte("deployer")
 
+// setupPlatform sets up an engine in a transaction, transfers ownership of the
+// database to owner, and runs the seed statements. The returned rollback
+// function must always be called to roll back the transaction, which resets
+// the database.
+func setupPlatform(ctx context.Context, d *pg.DB, opts *Options, owner string, seedStmts []string) (platform *Platform, rollback func(), err error) {
+	outerTx, err := d.BeginPreparedTx(ctx)
+	if err != nil {
+		return nil, nil, err
+	}
+	rollback = func() { outerTx.Rollback(ctx) }
+	defer func() {
+		if err != nil {
+			rollback()
+		}
+	}()
+
+	var logger log.Logger
+	// if this is a kwil logger, we can keep using it.
+	// If it is from testing.T, we should make a Kwil logger.
+	if wrapped, ok := opts.Logger.(*kwilLoggerWrapper); ok {
+		logger = wrapped.Logger
+	} else {
+		logger = log.New(log.WithLevel(log.LevelInfo))
+	}
+
+	accs, err := accounts.InitializeAccountStore(ctx, outerTx, logger)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	votes, err := voting.InitializeVoteStore(ctx, outerTx)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	interp, err := interpreter.NewInterpreter(ctx, outerTx, &common.Service{
+		Logger:      logger,
+		LocalConfig: &config.Config{},
+		Identity:    []byte("node"),
+	}, accs, votes, nil)
+	if err != nil {
+		return nil, nil, err
+	}
+	if opts.Coverage != nil {
+		interp.SetCoverage(opts.Coverage)
+	}
+
+	err = interp.Execute(&common.EngineContext{
+		TxContext: &common.TxContext{
+			Ctx:    ctx,
+			Signer: deployer,
+			Caller: string(deployer),
+			TxID:   "txid",
+			BlockContext: &common.BlockContext{
+				Height: 0,
+			},
+		},
+		OverrideAuthz: true,
+	}, outerTx, "TRANSFER OWNERSHIP TO $user", map[string]any{
+		"user": owner,
+	}, func(r *common.Row) error {
+		// do nothing
+		return nil
+	})
+	if err != nil {
+		return nil, nil, err
+	}
+
+	tx2, err := outerTx.BeginTx(ctx)
+	if err != nil {
+		return nil, nil, err
+	}
+	rollback = func() {
+		tx2.Rollback(ctx)
+		outerTx.Rollback(ctx)
+	}
+
+	platform = &Platform{
+		Engine:   interp,
+		DB:       tx2,
+		Deployer: deployer,
+		Logger:   opts.Logger,
+	}
+
+	// deploy schemas
+	for _, stmt := range seedStmts {
+		err = interp.Execute(&common.EngineContext{
+			TxContext: &common.TxContext{
+				Ctx:    ctx,
+				Signer: deployer,
+				Caller: string(deployer),
+				TxID:   platform.Txid(),
+				BlockContext: &common.BlockContext{
+					Height: 0,
+				},
+			},
+			OverrideAuthz: true,
+		}, tx2, stmt, nil, func(r *common.Row) error {
+			// do nothing
+			return nil
+		})
+		if err != nil {
+			return nil, nil, err
+		}
+	}
+
+	return platform, rollback, nil
+}
+
 // TestFunc is a function that can be run against the database engine.
 // A returned error signals a failed test.
 type TestFunc func(ctx context.Context, platform *Platform) error
@@ -449,19 +473,8 @@ func (p *Platform) Txid() string {
 
 // runWithPostgres runs the callback function with a postgres container.
 func runWithPostgres(ctx context.Context, opts *Options, fn func(context.Context, *pg.DB, Logger) error) (err error) {
-	if !opts.UseTestContainer {
-		db, err := pg.NewDB(ctx, &pg.DBConfig{
-			PoolConfig: pg.PoolConfig{
-				MaxConns: 11,
-				ConnConfig: pg.ConnConfig{
-					Host:   opts.Conn.Host,
-					Port:   opts.Conn.Port,
-					User:   opts.Conn.User,
-					Pass:   opts.Conn.Pass,
-					DBName: opts.Conn.DBName,
-				},
-			},
-		})
+	return runWithPostgresConn(ctx, opts, func(ctx context.Context, conn *ConnConfig) error {
+		db, err := newDB(ctx, conn)
 		if err != nil {
 			return fmt.Errorf("error setting up database: %w", err)
 		}
@@ -469,6 +482,15 @@ func runWithPostgres(ctx context.Context, opts *Options, fn func(context.Context
 		defer db.Close()
 
 		return fn(ctx, db, opts.Logger)
+	})
+}
+
+// runWithPostgresConn runs the callback function with the connection settings
+// of Postgres. If a test container is used, it is ready before the callback is
+// called, and removed after it returns.
+func runWithPostgresConn(ctx context.Context, opts *Options, fn func(context.Context, *ConnConfig) error) (err error) {
+	if !opts.UseTestContainer {
+		return fn(ctx, opts.Conn)
 	}
 
 	port := "52853" // random port
@@ -531,14 +553,20 @@ func runWithPostgres(ctx context.Context, opts *Options, fn func(context.Context
 
 	opts.Logger.Logf("running test container: %s", string(out))
 
-	db, err := connectWithRetry(ctx, port, 10) // might take a while to start up on slower machines
+	conn := &ConnConfig{
+		Host:   "localhost",
+		Port:   port,
+		User:   "kwild",
+		Pass:   "kwild", // would be ignored if pg_hba.conf set with trust
+		DBName: "kwil_test_db",
+	}
+
+	err = waitForConn(ctx, conn, 10) // might take a while to start up on slower machines
 	if err != nil {
 		return fmt.Errorf("error connecting to database: %w", err)
 	}
 
-	defer db.Close()
-
-	return fn(ctx, db, opts.Logger)
+	return fn(ctx, conn)
 }
 
 // waitForLogs waits for the logs to be received from the container.
@@ -605,36 +633,40 @@ func dockerStartArgs(port string) (args []string) {
 		"-e", "POSTGRES_HOST_AUTH_METHOD=trust", "kwildb/postgres:16.8-1"}
 }
 
-// connectWithRetry tries to connect to Postgres, and will retry n times at
+// newDB connects to Postgres.
+func newDB(ctx context.Context, conn *ConnConfig) (*pg.DB, error) {
+	return pg.NewDB(ctx, &pg.DBConfig{
+		PoolConfig: pg.PoolConfig{
+			MaxConns: 11,
+			ConnConfig: pg.ConnConfig{
+				Host:   conn.Host,
+				Port:   conn.Port,
+				User:   conn.User,
+				Pass:   conn.Pass,
+				DBName: conn.DBName,
+			},
+		},
+	})
+}
+
+// waitForConn tries to connect to Postgres, and will retry n times at
 // 1 second intervals if it fails.
-func connectWithRetry(ctx context.Context, port string, n int) (*pg.DB, error) {
-	var db *pg.DB
+func waitForConn(ctx context.Context, conn *ConnConfig, n int) error {
 	var err error
-
 	for range n {
-		db, err = pg.NewDB(ctx, &pg.DBConfig{
-			PoolConfig: pg.PoolConfig{
-				MaxConns: 11,
-				ConnConfig: pg.ConnConfig{
-					Host:   "localhost",
-					Port:   port,
-					User:   "kwild",
-					Pass:   "kwild", // would be ignored if pg_hba.conf set with trust
-					DBName: "kwil_test_db",
-				},
-			},
-		})
+		var c *pgx.Conn
+		c, err = pgx.Connect(ctx, conn.dsn())
 		if err == nil {
-			return db, nil
+			return c.Close(ctx)
 		}
 		if !strings.Contains(err.Error(), "failed to connect to") {
-			return nil, err
+			return err
 		}
 
 		time.Sleep(time.Second)
 	}
 
-	return nil, err
+	return err
 }
 
 // Options configures optional parameters for running the test.
@@ -654,6 +686,10 @@ type Options struct {
 	// true, then the container will be removed and recreated. If it
 	// returns false, then the test will fail.
 	ReplaceExistingContainer func() (bool, error)
+	// Coverage, if set, records the statements of actions that are executed
+	// by the tests. It can be written as a report with WriteLCOV or
+	// WriteCoverageHTML.
+	Coverage *interpreter.Coverage
 }
 
 // ConnConfig groups the basic connection settings used to construct the DSN
@@ -667,6 +703,19 @@ type ConnConfig struct {
 	DBName     string
 }
 
+// dsn returns the connection string of the settings.
+func (c *ConnConfig) dsn() string {
+	dsn := fmt.Sprintf("host=%s user=%s dbname=%s sslmode=disable", c.Host, c.User, c.DBName)
+	if c.Pass != "" {
+		dsn += " password=" + c.Pass
+	}
+	// Only add port for TCP connections, not UNIX domain sockets.
+	if !strings.HasPrefix(c.Host, "/") {
+		dsn += " port=" + c.Port
+	}
+	return dsn
+}
+
 func (d *Options) valid() error {
 	if d.UseTestContainer && d.Conn != nil {
 		return fmt.Errorf("test cannot both use a test container and specify a Postgres connection")
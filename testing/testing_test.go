@@ -11,6 +11,7 @@ import (
 	"github.com/stretchr/testify/require"
 	"github.com/trufnetwork/kwil-db/common"
 	"github.com/trufnetwork/kwil-db/extensions/precompiles"
+	"github.com/trufnetwork/kwil-db/node/engine/interpreter"
 )
 
 // testing the testing package
@@ -82,3 +83,99 @@ func Test_Testing(t *testing.T) {
 		},
 	})
 }
+
+func Test_YAML(t *testing.T) {
+	f := &TestFile{
+		Name:  "yaml",
+		Owner: "0xabc",
+		SeedStatements: []string{
+			`CREATE TABLE balances (owner TEXT PRIMARY KEY, balance INT8 NOT NULL);`,
+			`CREATE ACTION transfer($to TEXT, $amount INT8) public {
+				if @height < 5 {
+					error('too early');
+				}
+				UPDATE balances SET balance = balance - $amount WHERE owner = @caller;
+				INSERT INTO balances VALUES ($to, $amount)
+					ON CONFLICT (owner) DO UPDATE SET balance = balances.balance + $amount;
+				for $row in SELECT balance FROM balances WHERE owner = @caller {
+					if $row.balance < 0 {
+						error('insufficient balance');
+					}
+				}
+			}`,
+			`CREATE ACTION get_balance($owner TEXT) public view returns (balance INT8) {
+				for $row in SELECT balance FROM balances WHERE owner = $owner {
+					return $row.balance;
+				}
+			}`,
+		},
+		Fixtures: map[string][]string{
+			"funded": {`INSERT INTO balances VALUES ('alice', 100);`},
+		},
+		Tests: []*YAMLTest{
+			{
+				Name:     "transfer",
+				Fixtures: []string{"funded"},
+				Cases: []map[string]any{
+					{"name": "some", "amount": 10, "left": 90},
+					{"name": "all", "amount": 100, "left": 0},
+				},
+				Steps: []*Step{
+					{Action: "transfer", Caller: "alice", Args: []any{"bob", "$amount"}, Error: "too early"},
+					{Action: "transfer", Caller: "alice", Height: ptr[int64](5), Args: []any{"bob", "$amount"},
+						Assert: []*Assertion{{
+							SQL:     "SELECT balance FROM balances WHERE owner = $owner",
+							Params:  map[string]any{"owner": "alice"},
+							Returns: [][]any{{"$left"}},
+						}}},
+					// the height carries over
+					{Action: "transfer", Caller: "alice", Args: []any{"bob", 1000}, Error: "insufficient balance"},
+					{Action: "get_balance", Args: []any{"bob"}, Returns: [][]any{{"$amount"}}},
+				},
+			},
+			{
+				Name: "failing",
+				Steps: []*Step{
+					{SQL: "SELECT 1", Returns: [][]any{{2}}},
+				},
+			},
+		},
+	}
+
+	coverage := interpreter.NewCoverage()
+	report, err := RunTestFiles(context.Background(), []*TestFile{f}, &Options{
+		Coverage: coverage,
+		Conn: &ConnConfig{
+			Host:   "127.0.0.1",
+			Port:   "5432",
+			User:   "kwild",
+			Pass:   "kwild", // would be ignored if pg_hba.conf set with trust
+			DBName: "kwil_test_db",
+		},
+	}, 2)
+	require.NoError(t, err)
+
+	results := report.Suites[0].Tests
+	require.Len(t, results, 3)
+	assert.NoError(t, results[0].Err)
+	assert.NoError(t, results[1].Err)
+	assert.ErrorContains(t, results[2].Err, `expected "2", received "1"`)
+	assert.Equal(t, 1, report.Failed())
+
+	// every statement of transfer ran, including both errors
+	var transfer *interpreter.ActionCoverage
+	for _, act := range coverage.Actions() {
+		if act.Name == "transfer" {
+			transfer = act
+		}
+	}
+	require.NotNil(t, transfer)
+	assert.NotEmpty(t, transfer.Hits)
+	for line, n := range transfer.Hits {
+		assert.Positive(t, n, "line %d", line)
+	}
+}
+
+func ptr[T any](v T) *T {
+	return &v
+}
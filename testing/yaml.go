@@ -0,0 +1,629 @@
+package testing
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"sort"
+	"strconv"
+	"strings"
+
+	"gopkg.in/yaml.v3"
+
+	"github.com/trufnetwork/kwil-db/common"
+	"github.com/trufnetwork/kwil-db/core/types"
+)
+
+// TestFile is a file of declarative tests, written in YAML. Each test runs
+// a sequence of steps against freshly seeded schemas. For example:
+//
+//	name: token
+//	seed_scripts: [token.sql]
+//	fixtures:
+//	  funded: ["INSERT INTO balances VALUES ('alice', 100)"]
+//	tests:
+//	  - name: transfer
+//	    fixtures: [funded]
+//	    cases:
+//	      - {name: some, amount: 10, left: 90}
+//	      - {name: all, amount: 100, left: 0}
+//	    steps:
+//	      - action: transfer
+//	        caller: alice
+//	        height: 5
+//	        args: [bob, $amount]
+//	        assert:
+//	          - sql: SELECT balance FROM balances WHERE owner = 'alice'
+//	            returns: [[$left]]
+//	      - action: transfer
+//	        caller: alice
+//	        args: [bob, 1000]
+//	        error: insufficient balance
+type TestFile struct {
+	// Name is the name of the file's test suite. It defaults to the file name.
+	Name string `yaml:"name"`
+	// Owner is the owner of the database. If empty, a pre-defined deployer is
+	// used.
+	Owner string `yaml:"owner"`
+	// SeedScripts are paths to files of statements that are run before each
+	// test. Relative paths are relative to the test file.
+	SeedScripts []string `yaml:"seed_scripts"`
+	// SeedStatements are statements that are run before each test, after the
+	// seed scripts.
+	SeedStatements []string `yaml:"seed_statements"`
+	// Fixtures are named lists of statements that tests can run after the seed
+	// statements. They are run like the seed statements.
+	Fixtures map[string][]string `yaml:"fixtures"`
+	// Tests are the tests of the file.
+	Tests []*YAMLTest `yaml:"tests"`
+}
+
+// YAMLTest is a test in a TestFile.
+type YAMLTest struct {
+	// Name identifies the test.
+	Name string `yaml:"name"`
+	// Fixtures are the names of the fixtures to run before the steps.
+	Fixtures []string `yaml:"fixtures"`
+	// Cases parameterize the test. The test is run once for each case, and
+	// every "$var" value and "${var}" substring in the steps is replaced with
+	// the case's value for var. The "name" of a case identifies it.
+	Cases []map[string]any `yaml:"cases"`
+	// Steps are run in order. The test stops at the first failed step.
+	Steps []*Step `yaml:"steps"`
+}
+
+// Step calls an action or executes a statement. Exactly one of Action and SQL
+// must be set.
+type Step struct {
+	// Action is the action to call.
+	Action string `yaml:"action"`
+	// Namespace is the namespace of the action. It defaults to the main
+	// namespace.
+	Namespace string `yaml:"namespace"`
+	// Args are the arguments of the action.
+	Args []any `yaml:"args"`
+	// SQL is a statement to execute.
+	SQL string `yaml:"sql"`
+	// Params are the parameters of the statement.
+	Params map[string]any `yaml:"params"`
+	// Caller sets @caller, and its bytes are used as @signer. It defaults to
+	// the owner.
+	Caller string `yaml:"caller"`
+	// Height sets @height. If not set, the height of the previous step is
+	// used, starting at 0.
+	Height *int64 `yaml:"height"`
+	// BlockTime sets the time of the block, as seconds since the UNIX epoch.
+	// If not set, the time of the previous step is used, starting at 0.
+	BlockTime *int64 `yaml:"block_time"`
+	// Returns are the expected rows. If not set, the rows are not checked.
+	Returns [][]any `yaml:"returns"`
+	// Error is a substring of the expected error. If set, the step must fail,
+	// and its changes are rolled back.
+	Error string `yaml:"error"`
+	// Assert are queries of the state after the step.
+	Assert []*Assertion `yaml:"assert"`
+}
+
+// Assertion checks the result of a query. It is run by the owner, without
+// access checks.
+type Assertion struct {
+	// SQL is the query.
+	SQL string `yaml:"sql"`
+	// Params are the parameters of the query.
+	Params map[string]any `yaml:"params"`
+	// Returns are the expected rows.
+	Returns [][]any `yaml:"returns"`
+}
+
+// LoadTestFile reads a TestFile. Seed script paths are made relative to the
+// file.
+func LoadTestFile(path string) (*TestFile, error) {
+	bts, err := os.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+
+	var f TestFile
+	dec := yaml.NewDecoder(strings.NewReader(string(bts)))
+	dec.KnownFields(true)
+	if err = dec.Decode(&f); err != nil {
+		return nil, fmt.Errorf("%s: %w", path, err)
+	}
+
+	if f.Name == "" {
+		f.Name = strings.TrimSuffix(filepath.Base(path), filepath.Ext(path))
+	}
+	for i, script := range f.SeedScripts {
+		if !filepath.IsAbs(script) {
+			f.SeedScripts[i] = filepath.Join(filepath.Dir(path), script)
+		}
+	}
+
+	if err = f.validate(); err != nil {
+		return nil, fmt.Errorf("%s: %w", path, err)
+	}
+
+	return &f, nil
+}
+
+func (f *TestFile) validate() error {
+	for _, test := range f.Tests {
+		if test.Name == "" {
+			return errors.New("tests must have a name")
+		}
+		for _, fixture := range test.Fixtures {
+			if _, ok := f.Fixtures[fixture]; !ok {
+				return fmt.Errorf(`test "%s": unknown fixture "%s"`, test.Name, fixture)
+			}
+		}
+		for i, step := range test.Steps {
+			if (step.Action == "") == (step.SQL == "") {
+				return fmt.Errorf(`test "%s" step %d: exactly one of action and sql must be set`, test.Name, i+1)
+			}
+		}
+	}
+
+	return nil
+}
+
+// seedStatements reads the seed scripts, and returns them followed by the
+// seed statements.
+func (f *TestFile) seedStatements() ([]string, error) {
+	var stmts []string
+	for _, script := range f.SeedScripts {
+		bts, err := os.ReadFile(script)
+		if err != nil {
+			return nil, err
+		}
+		stmts = append(stmts, string(bts))
+	}
+	return append(stmts, f.SeedStatements...), nil
+}
+
+// caseRun is a test with the variables of one of its cases substituted.
+type caseRun struct {
+	name     string
+	fixtures []string
+	steps    []*Step
+}
+
+// expand returns a run of the test for each of its cases, or a single run if
+// it has no cases.
+func (t *YAMLTest) expand() []*caseRun {
+	if len(t.Cases) == 0 {
+		return []*caseRun{{name: t.Name, fixtures: t.Fixtures, steps: t.Steps}}
+	}
+
+	runs := make([]*caseRun, len(t.Cases))
+	for i, vars := range t.Cases {
+		name := fmt.Sprintf("%s/%d", t.Name, i+1)
+		if caseName, ok := vars["name"]; ok {
+			name = fmt.Sprintf("%s/%v", t.Name, caseName)
+		}
+
+		steps := make([]*Step, len(t.Steps))
+		for j, step := range t.Steps {
+			steps[j] = step.substitute(vars)
+		}
+
+		runs[i] = &caseRun{name: name, fixtures: t.Fixtures, steps: steps}
+	}
+
+	return runs
+}
+
+func (s *Step) substitute(vars map[string]any) *Step {
+	s2 := *s
+	s2.Action = substituteString(s.Action, vars)
+	s2.Namespace = substituteString(s.Namespace, vars)
+	s2.SQL = substituteString(s.SQL, vars)
+	s2.Caller = substituteString(s.Caller, vars)
+	s2.Error = substituteString(s.Error, vars)
+	s2.Args = substitute(s.Args, vars).([]any)
+	s2.Params = substitute(s.Params, vars).(map[string]any)
+	s2.Returns = substituteRows(s.Returns, vars)
+
+	s2.Assert = make([]*Assertion, len(s.Assert))
+	for i, a := range s.Assert {
+		s2.Assert[i] = &Assertion{
+			SQL:     substituteString(a.SQL, vars),
+			Params:  substitute(a.Params, vars).(map[string]any),
+			Returns: substituteRows(a.Returns, vars),
+		}
+	}
+
+	return &s2
+}
+
+func substituteRows(rows [][]any, vars map[string]any) [][]any {
+	if rows == nil {
+		return nil
+	}
+	res := make([][]any, len(rows))
+	for i, row := range rows {
+		res[i] = substitute(row, vars).([]any)
+	}
+	return res
+}
+
+// substitute replaces variables in a YAML value.
+func substitute(v any, vars map[string]any) any {
+	switch v := v.(type) {
+	case string:
+		if val, ok := vars[strings.TrimPrefix(v, "$")]; ok && strings.HasPrefix(v, "$") {
+			return val
+		}
+		return substituteString(v, vars)
+	case []any:
+		if v == nil {
+			return v
+		}
+		res := make([]any, len(v))
+		for i, elem := range v {
+			res[i] = substitute(elem, vars)
+		}
+		return res
+	case map[string]any:
+		if v == nil {
+			return v
+		}
+		res := make(map[string]any, len(v))
+		for k, elem := range v {
+			res[k] = substitute(elem, vars)
+		}
+		return res
+	default:
+		return v
+	}
+}
+
+// substituteString replaces the "${var}" substrings of a string.
+func substituteString(s string, vars map[string]any) string {
+	// sorted for determinism if a value contains another variable
+	names := make([]string, 0, len(vars))
+	for name := range vars {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	for _, name := range names {
+		s = strings.ReplaceAll(s, "${"+name+"}", fmt.Sprint(vars[name]))
+	}
+	return s
+}
+
+// blockState is the block that steps run in.
+type blockState struct {
+	height, timestamp int64
+}
+
+// run runs the step, and checks its results.
+func (s *Step) run(ctx context.Context, platform *Platform, owner string, block *blockState) error {
+	if s.Height != nil {
+		block.height = *s.Height
+	}
+	if s.BlockTime != nil {
+		block.timestamp = *s.BlockTime
+	}
+
+	caller := owner
+	if s.Caller != "" {
+		caller = s.Caller
+	}
+
+	// the step runs in its own transaction, which is rolled back if it fails,
+	// like a failed transaction on a network
+	tx, err := platform.DB.BeginTx(ctx)
+	if err != nil {
+		return err
+	}
+	defer tx.Rollback(ctx)
+
+	engineCtx := platform.engineCtx(ctx, caller, block)
+	var rows [][]any
+	collect := func(r *common.Row) error {
+		rows = append(rows, r.Values)
+		return nil
+	}
+
+	var receivedErr error
+	if s.Action != "" {
+		platform.Logger.Logf(`calling action "%s" as "%s"`, s.Action, caller)
+
+		args, err := convertYAMLValues(s.Args)
+		if err != nil {
+			return err
+		}
+
+		res, err := platform.Engine.Call(engineCtx, tx, s.Namespace, s.Action, args, collect)
+		if err != nil {
+			receivedErr = err
+		} else if res.Error != nil {
+			receivedErr = res.Error
+		}
+	} else {
+		platform.Logger.Logf(`executing statement as "%s"`, caller)
+
+		params, err := convertYAMLParams(s.Params)
+		if err != nil {
+			return err
+		}
+
+		receivedErr = platform.Engine.Execute(engineCtx, tx, s.SQL, params, collect)
+	}
+
+	switch {
+	case receivedErr != nil && s.Error == "":
+		return fmt.Errorf("unexpected error: %w", receivedErr)
+	case receivedErr != nil && !strings.Contains(receivedErr.Error(), s.Error):
+		return fmt.Errorf(`expected error message to contain substring "%s", received error: %w`, s.Error, receivedErr)
+	case receivedErr == nil && s.Error != "":
+		return fmt.Errorf(`expected error message to contain substring "%s", but the error didn't happen`, s.Error)
+	case receivedErr == nil:
+		if err = tx.Commit(ctx); err != nil {
+			return err
+		}
+	}
+
+	if s.Returns != nil {
+		if err = compareRows(s.Returns, rows); err != nil {
+			return err
+		}
+	}
+
+	for i, a := range s.Assert {
+		if err = a.check(ctx, platform, owner, block); err != nil {
+			return fmt.Errorf("assertion %d: %w", i+1, err)
+		}
+	}
+
+	return nil
+}
+
+func (a *Assertion) check(ctx context.Context, platform *Platform, owner string, block *blockState) error {
+	params, err := convertYAMLParams(a.Params)
+	if err != nil {
+		return err
+	}
+
+	engineCtx := platform.engineCtx(ctx, owner, block)
+	engineCtx.OverrideAuthz = true
+
+	var rows [][]any
+	err = platform.Engine.Execute(engineCtx, platform.DB, a.SQL, params, func(r *common.Row) error {
+		rows = append(rows, r.Values)
+		return nil
+	})
+	if err != nil {
+		return err
+	}
+
+	return compareRows(a.Returns, rows)
+}
+
+// engineCtx returns the context of a call by caller in the block.
+func (p *Platform) engineCtx(ctx context.Context, caller string, block *blockState) *common.EngineContext {
+	return &common.EngineContext{
+		TxContext: &common.TxContext{
+			Ctx:    ctx,
+			Signer: []byte(caller),
+			Caller: caller,
+			TxID:   p.Txid(),
+			BlockContext: &common.BlockContext{
+				Height:    block.height,
+				Timestamp: block.timestamp,
+				ChainContext: &common.ChainContext{
+					MigrationParams:   &common.MigrationContext{},
+					NetworkParameters: &common.NetworkParameters{},
+				},
+			},
+		},
+	}
+}
+
+// compareRows compares rows with the expected rows of a YAML test. Values are
+// compared by their text form, so that YAML numbers and strings can be used
+// for any type.
+func compareRows(expected, received [][]any) error {
+	if len(received) != len(expected) {
+		return fmt.Errorf("expected %d rows to be returned, received %d", len(expected), len(received))
+	}
+
+	for i, row := range received {
+		if len(row) != len(expected[i]) {
+			return fmt.Errorf("expected %d columns to be returned, received %d", len(expected[i]), len(row))
+		}
+
+		for j, col := range row {
+			want, got := formatValue(expected[i][j]), formatValue(col)
+			if want != got {
+				// add 1 to row and column index since they are 0 indexed.
+				return fmt.Errorf(`incorrect value for expected result: row %d, column %d. expected "%s", received "%s"`, i+1, j+1, want, got)
+			}
+		}
+	}
+
+	return nil
+}
+
+// formatValue formats a value in a canonical text form.
+func formatValue(v any) string {
+	switch v := v.(type) {
+	case nil:
+		return "null"
+	case []byte:
+		if v == nil {
+			return "null"
+		}
+		return "0x" + fmt.Sprintf("%x", v)
+	case *types.Decimal:
+		if v == nil {
+			return "null"
+		}
+		return trimDecimal(v.String())
+	case *types.UUID:
+		if v == nil {
+			return "null"
+		}
+		return v.String()
+	case float64:
+		return trimDecimal(strconv.FormatFloat(v, 'f', -1, 64))
+	case []any:
+		elems := make([]string, len(v))
+		for i, elem := range v {
+			elems[i] = formatValue(elem)
+		}
+		return "[" + strings.Join(elems, ", ") + "]"
+	case []*string:
+		return formatArray(v)
+	case []*int64:
+		return formatArray(v)
+	case []*bool:
+		return formatArray(v)
+	case []*types.Decimal:
+		return formatArray(v)
+	case []*types.UUID:
+		return formatArray(v)
+	case [][]byte:
+		return formatArray(v)
+	default:
+		return fmt.Sprint(v)
+	}
+}
+
+func formatArray[T any](arr []T) string {
+	elems := make([]any, len(arr))
+	for i, elem := range arr {
+		var v any = elem
+		switch p := v.(type) {
+		case *string:
+			if p != nil {
+				v = *p
+			} else {
+				v = nil
+			}
+		case *int64:
+			if p != nil {
+				v = *p
+			} else {
+				v = nil
+			}
+		case *bool:
+			if p != nil {
+				v = *p
+			} else {
+				v = nil
+			}
+		}
+		elems[i] = v
+	}
+	return formatValue(elems)
+}
+
+// trimDecimal removes trailing zeros from the fraction of a decimal, so that
+// numerics of different scales compare equal.
+func trimDecimal(s string) string {
+	if !strings.Contains(s, ".") {
+		return s
+	}
+	return strings.TrimSuffix(strings.TrimRight(s, "0"), ".")
+}
+
+// convertYAMLValues converts YAML values to values the engine accepts.
+func convertYAMLValues(vals []any) ([]any, error) {
+	res := make([]any, len(vals))
+	for i, v := range vals {
+		conv, err := convertYAMLValue(v)
+		if err != nil {
+			return nil, fmt.Errorf("argument %d: %w", i+1, err)
+		}
+		res[i] = conv
+	}
+	return res, nil
+}
+
+func convertYAMLParams(params map[string]any) (map[string]any, error) {
+	res := make(map[string]any, len(params))
+	for name, v := range params {
+		conv, err := convertYAMLValue(v)
+		if err != nil {
+			return nil, fmt.Errorf("parameter %s: %w", name, err)
+		}
+		res[name] = conv
+	}
+	return res, nil
+}
+
+// convertYAMLValue converts a YAML value to a value the engine accepts.
+// Integers are int8, other numbers are numeric, and lists are arrays of the
+// type of their first non-null element.
+func convertYAMLValue(v any) (any, error) {
+	switch v := v.(type) {
+	case nil, string, bool, int64:
+		return v, nil
+	case int:
+		return int64(v), nil
+	case float64:
+		return types.ParseDecimal(strconv.FormatFloat(v, 'f', -1, 64))
+	case []any:
+		return convertYAMLList(v)
+	default:
+		return nil, fmt.Errorf("unsupported value %v", v)
+	}
+}
+
+func convertYAMLList(list []any) (any, error) {
+	elems := make([]any, len(list))
+	var kind any
+	for i, v := range list {
+		conv, err := convertYAMLValue(v)
+		if err != nil {
+			return nil, err
+		}
+		if _, ok := conv.([]any); ok {
+			return nil, errors.New("nested lists are not supported")
+		}
+		if kind == nil {
+			kind = conv
+		}
+		elems[i] = conv
+	}
+
+	switch kind.(type) {
+	case nil, string:
+		return listOf[string](elems)
+	case int64:
+		return listOf[int64](elems)
+	case bool:
+		return listOf[bool](elems)
+	case *types.Decimal:
+		res := make([]*types.Decimal, len(elems))
+		for i, v := range elems {
+			d, ok := v.(*types.Decimal)
+			if v != nil && !ok {
+				return nil, errors.New("lists must have elements of a single type")
+			}
+			res[i] = d
+		}
+		return res, nil
+	default:
+		return nil, fmt.Errorf("unsupported list element %v", kind)
+	}
+}
+
+func listOf[T any](elems []any) ([]*T, error) {
+	res := make([]*T, len(elems))
+	for i, v := range elems {
+		if v == nil {
+			continue
+		}
+		t, ok := v.(T)
+		if !ok {
+			return nil, errors.New("lists must have elements of a single type")
+		}
+		res[i] = &t
+	}
+	return res, nil
+}
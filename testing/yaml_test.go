@@ -0,0 +1,142 @@
+package testing
+
+import (
+	"bytes"
+	"errors"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/trufnetwork/kwil-db/core/types"
+)
+
+const testYAML = `
+owner: "0xabc"
+seed_scripts: [schema.sql]
+fixtures:
+  funded: ["INSERT INTO balances VALUES ('alice', 100)"]
+tests:
+  - name: transfer
+    fixtures: [funded]
+    cases:
+      - {name: some, amount: 10, left: 90}
+      - {amount: 100, left: 0}
+    steps:
+      - action: transfer
+        caller: alice
+        height: 5
+        args: [bob, $amount]
+        error: "cannot send ${amount}"
+        assert:
+          - sql: SELECT balance FROM balances WHERE owner = $owner
+            params: {owner: alice}
+            returns: [[$left]]
+`
+
+func Test_LoadTestFile(t *testing.T) {
+	dir := t.TempDir()
+	path := filepath.Join(dir, "token.yaml")
+	require.NoError(t, os.WriteFile(path, []byte(testYAML), 0644))
+
+	f, err := LoadTestFile(path)
+	require.NoError(t, err)
+
+	assert.Equal(t, "token", f.Name)
+	assert.Equal(t, []string{filepath.Join(dir, "schema.sql")}, f.SeedScripts)
+
+	runs := f.Tests[0].expand()
+	require.Len(t, runs, 2)
+	assert.Equal(t, "transfer/some", runs[0].name)
+	assert.Equal(t, "transfer/2", runs[1].name)
+
+	step := runs[1].steps[0]
+	assert.Equal(t, []any{"bob", 100}, step.Args)
+	assert.Equal(t, int64(5), *step.Height)
+	assert.Equal(t, "cannot send 100", step.Error)
+	assert.Equal(t, [][]any{{0}}, step.Assert[0].Returns)
+	assert.Equal(t, map[string]any{"owner": "alice"}, step.Assert[0].Params)
+
+	// the test itself is not changed
+	assert.Equal(t, "$amount", f.Tests[0].Steps[0].Args[1])
+
+	invalid := map[string]string{
+		"unknown field":   "tests: [{name: a, steps: [{action: a, what: 1}]}]",
+		"unknown fixture": "tests: [{name: a, fixtures: [f]}]",
+		"no action":       "tests: [{name: a, steps: [{caller: a}]}]",
+		"both":            "tests: [{name: a, steps: [{action: a, sql: b}]}]",
+	}
+	for name, content := range invalid {
+		require.NoError(t, os.WriteFile(path, []byte(content), 0644))
+		_, err = LoadTestFile(path)
+		assert.Error(t, err, name)
+	}
+}
+
+func Test_ConvertYAMLValue(t *testing.T) {
+	a, b := "a", "b"
+	one, two := int64(1), int64(2)
+
+	for _, tc := range []struct {
+		in   any
+		want any
+	}{
+		{in: 1, want: int64(1)},
+		{in: "a", want: "a"},
+		{in: nil, want: nil},
+		{in: 1.5, want: types.MustParseDecimal("1.5")},
+		{in: []any{"a", nil, "b"}, want: []*string{&a, nil, &b}},
+		{in: []any{1, 2}, want: []*int64{&one, &two}},
+		{in: []any{}, want: []*string{}},
+	} {
+		got, err := convertYAMLValue(tc.in)
+		require.NoError(t, err)
+		assert.Equal(t, tc.want, got)
+	}
+
+	_, err := convertYAMLValue([]any{1, "a"})
+	assert.Error(t, err)
+	_, err = convertYAMLValue([]any{[]any{1}})
+	assert.Error(t, err)
+}
+
+func Test_CompareRows(t *testing.T) {
+	a := "a"
+	one := int64(1)
+	d, err := types.ParseDecimalExplicit("1.50", 10, 2)
+	require.NoError(t, err)
+
+	received := [][]any{{int64(1), d, []byte{0xab}, nil, []*string{&a, nil}, []*int64{&one}, true}}
+	assert.NoError(t, compareRows([][]any{{1, 1.5, "0xab", nil, []any{"a", nil}, []any{1}, true}}, received))
+	assert.NoError(t, compareRows([][]any{{"1", "1.5", "0xab", nil, []any{"a", nil}, []any{"1"}, "true"}}, received))
+
+	assert.ErrorContains(t, compareRows([][]any{{2, 1.5, "0xab", nil, []any{"a", nil}, []any{1}, true}}, received), "row 1, column 1")
+	assert.ErrorContains(t, compareRows(nil, received), "expected 0 rows")
+}
+
+func Test_WriteJUnit(t *testing.T) {
+	report := &Report{Suites: []*SuiteResult{{
+		Name: "token",
+		Tests: []*TestResult{
+			{Name: "ok", Duration: time.Second},
+			{Name: "bad", Duration: 500 * time.Millisecond, Err: errors.New(`step 1: "x" < y`)},
+		},
+	}}}
+	assert.Equal(t, 1, report.Failed())
+
+	var buf bytes.Buffer
+	require.NoError(t, WriteJUnit(&buf, report))
+	assert.Equal(t, `<?xml version="1.0" encoding="UTF-8"?>
+<testsuites tests="2" failures="1" time="1.500">
+  <testsuite name="token" tests="2" failures="1" time="1.500">
+    <testcase name="ok" classname="token" time="1.000"></testcase>
+    <testcase name="bad" classname="token" time="0.500">
+      <failure message="step 1: &#34;x&#34; &lt; y">step 1: &#34;x&#34; &lt; y</failure>
+    </testcase>
+  </testsuite>
+</testsuites>
+`, buf.String())
+}